@@ -0,0 +1,134 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/tehmaze-labs/dns/message"
+)
+
+// ErrRefused is returned by backends for queries that should be refused.
+var ErrRefused = errors.New("query refused")
+
+// ACL actions for denied queries
+const (
+	ACLEmpty   = "empty"
+	ACLRefused = "refused"
+	ACLDecoy   = "decoy"
+)
+
+// ACL limits which clients may query a zone. Both the remote address and the
+// EDNS client subnet are checked; a query is denied if either matches a deny
+// network, or if there are allow networks and either does not match them, or
+// no address is known at all:
+//
+//	acl:
+//	  allow: [172.23.0.0/16]
+//	  action: decoy
+//	  decoy:
+//	  - {type: "A", ttl: 60, content: "192.0.2.1"}
+//
+// Denied queries get an empty answer by default.
+type ACL struct {
+	Allow  []string  `yaml:"allow,omitempty"`
+	Deny   []string  `yaml:"deny,omitempty"`
+	Action string    `yaml:"action,omitempty"`
+	Decoy  []*Record `yaml:"decoy,omitempty"`
+
+	allow []*net.IPNet
+	deny  []*net.IPNet
+}
+
+func (a *ACL) check() (err error) {
+	if a == nil {
+		return nil
+	}
+	if a.allow, err = parseNetworks(a.Allow); err != nil {
+		return
+	}
+	if a.deny, err = parseNetworks(a.Deny); err != nil {
+		return
+	}
+
+	a.Action = strings.ToLower(a.Action)
+	switch a.Action {
+	case "":
+		a.Action = ACLEmpty
+	case ACLEmpty, ACLRefused:
+	case ACLDecoy:
+		if len(a.Decoy) == 0 {
+			return errors.New("ACL action decoy without decoy records")
+		}
+	default:
+		return fmt.Errorf("Unknown ACL action %q", a.Action)
+	}
+
+	for _, r := range a.Decoy {
+		if r.Class == "" {
+			r.Class = dns.ClassToString[dns.ClassINET]
+		}
+		if _, err = r.Message(); err != nil {
+			return fmt.Errorf("decoy: %v", err)
+		}
+	}
+	return nil
+}
+
+// Allowed reports if the client is allowed to query.
+func (a *ACL) Allowed(m *message.Message) bool {
+	if a == nil {
+		return true
+	}
+
+	addrs := []net.IP{m.RemoteAddr}
+	if client := m.Client(); !client.Equal(m.RemoteAddr) {
+		addrs = append(addrs, client)
+	}
+	var known bool
+	for _, ip := range addrs {
+		if ip == nil {
+			continue
+		}
+		known = true
+		for _, n := range a.deny {
+			if n.Contains(ip) {
+				return false
+			}
+		}
+		if len(a.allow) > 0 && !matchNetworks(a.allow, ip) {
+			return false
+		}
+	}
+	// Without any address an allow list can't match
+	return known || len(a.allow) == 0
+}
+
+// Filter checks if the query is allowed. If not, it returns the answers
+// of type qtype for the configured action.
+func (a *ACL) Filter(m *message.Message, qtype uint16) (allowed bool, r []*message.Message, err error) {
+	if a.Allowed(m) {
+		return true, nil, nil
+	}
+
+	switch a.Action {
+	case ACLRefused:
+		return false, nil, ErrRefused
+	case ACLDecoy:
+		for _, record := range a.Decoy {
+			p, err := record.Message()
+			if err != nil {
+				continue
+			}
+			if qtype != dns.TypeANY && p.Type != qtype {
+				continue
+			}
+			p.Name = m.Name
+			p.ID = m.ID
+			r = append(r, p)
+		}
+	}
+	return false, r, nil
+}
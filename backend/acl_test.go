@@ -0,0 +1,108 @@
+package backend
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/tehmaze-labs/dns/message"
+)
+
+const testACLConfig = `
+auto:
+  - encode: {base32: }
+    dns: [dns1.maze.io]
+    answers:
+      '172.23.40.0/24':
+        zone: pub.auto.maze.so
+      '172.23.41.0/24':
+        zone: int.auto.maze.so
+        acl:
+          allow: [172.23.0.0/16]
+          deny: [172.23.66.0/24]
+      '172.23.42.0/24':
+        zone: lab.auto.maze.so
+        acl:
+          allow: [172.23.0.0/16]
+          action: refused
+      '172.23.43.0/24':
+        zone: foo.auto.maze.so
+        acl:
+          allow: [172.23.0.0/16]
+          action: decoy
+          decoy:
+          - {type: "A", ttl: 60, content: "192.0.2.1"}
+          - {type: "PTR", ttl: 60, content: "decoy.maze.so"}
+`
+
+func TestACL(t *testing.T) {
+	b := testViewBackends(t, testACLConfig)[0]
+
+	tests := []struct {
+		Name    string
+		Type    uint16
+		Remote  string
+		Subnet  string
+		Answer  string
+		Refused bool
+	}{
+		{"1.40.23.172.in-addr.arpa", dns.TypePTR, "192.0.2.1", "", "04.pub.auto.maze.so", false},
+		{"1.41.23.172.in-addr.arpa", dns.TypePTR, "192.0.2.1", "", "", false},
+		{"1.41.23.172.in-addr.arpa", dns.TypePTR, "172.23.40.1", "", "04.int.auto.maze.so", false},
+		{"1.41.23.172.in-addr.arpa", dns.TypePTR, "172.23.66.1", "", "", false},
+		{"1.41.23.172.in-addr.arpa", dns.TypePTR, "172.23.40.1", "192.0.2.0/24", "", false},
+		{"1.41.23.172.in-addr.arpa", dns.TypePTR, "", "", "", false},
+		{"1.41.23.172.in-addr.arpa", dns.TypePTR, "", "172.23.40.0/24", "04.int.auto.maze.so", false},
+		{"1.40.23.172.in-addr.arpa", dns.TypePTR, "", "", "04.pub.auto.maze.so", false},
+		{"41.23.172.in-addr.arpa", dns.TypeSOA, "192.0.2.1", "", "", false},
+		{"1.42.23.172.in-addr.arpa", dns.TypePTR, "192.0.2.1", "", "", true},
+		{"42.23.172.in-addr.arpa", dns.TypeNS, "192.0.2.1", "", "", true},
+		{"1.43.23.172.in-addr.arpa", dns.TypePTR, "192.0.2.1", "", "decoy.maze.so", false},
+		{"04.foo.auto.maze.so", dns.TypeA, "192.0.2.1", "", "192.0.2.1", false},
+		{"04.foo.auto.maze.so", dns.TypeA, "172.23.40.1", "", "172.23.43.1", false},
+	}
+	for _, test := range tests {
+		m := &message.Message{
+			Name:       []byte(test.Name),
+			Class:      dns.ClassINET,
+			Type:       test.Type,
+			ID:         []byte("-1"),
+			RemoteAddr: net.ParseIP(test.Remote),
+		}
+		if test.Subnet != "" {
+			_, m.ClientSubnet, _ = net.ParseCIDR(test.Subnet)
+		}
+		r, err := b.Query(m)
+		if test.Refused {
+			if err != ErrRefused {
+				t.Errorf("%s from %s: got %v, want %v", test.Name, test.Remote, err, ErrRefused)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got string
+		if len(r) > 0 {
+			got = string(r[0].Content)
+		}
+		if len(r) > 1 || got != test.Answer {
+			t.Errorf("%s from %s: got %d answers %q, want %q", test.Name, test.Remote, len(r), got, test.Answer)
+		}
+	}
+}
+
+func TestACLConfig(t *testing.T) {
+	tests := []*ACL{
+		{Allow: []string{"bogus"}},
+		{Deny: []string{"172.23.0.0/33"}},
+		{Action: "drop"},
+		{Action: "decoy"},
+		{Action: "decoy", Decoy: []*Record{{Type: "BOGUS"}}},
+	}
+	for _, test := range tests {
+		if err := test.check(); err == nil {
+			t.Errorf("expected error for %+v", test)
+		}
+	}
+}
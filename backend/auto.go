@@ -3,19 +3,21 @@ package backend
 import (
 	"errors"
 	"fmt"
-	"log"
 	"math/big"
 	"net"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/tehmaze-labs/dns/encoder"
+	"github.com/tehmaze-labs/dns/logging"
 	"github.com/tehmaze-labs/dns/message"
+	"github.com/tehmaze-labs/dns/metrics"
 	"gopkg.in/yaml.v2"
 )
 
-const SOATemplate = "%s. hostmaster.localhost. 1 28800 7200 604800 86400"
+var autoLog = logging.For("auto")
 
 var (
 	typesA = map[uint16]bool{
@@ -41,29 +43,42 @@ var (
 )
 
 type AutoBackend struct {
-	Encode         yaml.MapSlice `yaml:"encode"`
-	Filler         bool          `yaml:"filler"`
-	Prefix, Suffix string
-	SOA            *SOA
-	DNS            []string
-	Answers        map[string]*AutoBackendAnswer
+	Encode  yaml.MapSlice                 `yaml:"encode,omitempty"`
+	Filler  bool                          `yaml:"filler,omitempty"`
+	Prefix  string                        `yaml:"prefix,omitempty"`
+	Suffix  string                        `yaml:"suffix,omitempty"`
+	SOA     *SOA                          `yaml:"soa,omitempty"`
+	DNS     []string                      `yaml:"dns,omitempty"`
+	Answers map[string]*AutoBackendAnswer `yaml:"answers,omitempty"`
+	Views   []string                      `yaml:"views,omitempty"`
+
+	// Source describes where the backend was configured, for errors
+	Source string `yaml:"-"`
 
 	encoders []encoder.Encoder
+	views    viewSet
+	scoped   bool
+	serials  string
+	modified time.Time
 }
 
 type AutoBackendAnswer struct {
-	Network        *net.IPNet
-	Size           int
-	Zone           string
-	Encode         yaml.MapSlice `yaml:"encode"`
-	Filler         bool          `yaml:"filler"`
-	Prefix, Suffix string
-	SOA            *SOA
-	DNS            []string
-	Version        uint8
+	Network *net.IPNet    `yaml:"-"`
+	Size    int           `yaml:"size,omitempty"`
+	Zone    string        `yaml:"zone,omitempty"`
+	Encode  yaml.MapSlice `yaml:"encode,omitempty"`
+	Filler  bool          `yaml:"filler,omitempty"`
+	Prefix  string        `yaml:"prefix,omitempty"`
+	Suffix  string        `yaml:"suffix,omitempty"`
+	SOA     *SOA          `yaml:"soa,omitempty"`
+	DNS     []string      `yaml:"dns,omitempty"`
+	Version uint8         `yaml:"version,omitempty"`
+	Views   []string      `yaml:"views,omitempty"`
+	ACL     *ACL          `yaml:"acl,omitempty"`
 
 	encoders []encoder.Encoder
 	network  *big.Int
+	views    viewSet
 }
 
 func loadEncoders(e yaml.MapSlice) (encoders []encoder.Encoder, err error) {
@@ -96,7 +111,7 @@ func loadEncoders(e yaml.MapSlice) (encoders []encoder.Encoder, err error) {
 }
 
 func (b *AutoBackend) Check() (err error) {
-	log.Println("auto: check")
+	autoLog.Debug("check")
 	if b.DNS == nil || len(b.DNS) == 0 {
 		return errors.New("auto: no DNS servers configured")
 	}
@@ -104,13 +119,17 @@ func (b *AutoBackend) Check() (err error) {
 		b.SOA = NewSOA()
 		b.SOA.Source = b.DNS[0]
 	}
-	log.Printf("auto: SOA %q\n", b.SOA.String())
+	if err = b.SOA.check(); err != nil {
+		return
+	}
+	autoLog.Debug("default SOA", "soa", b.SOA.String())
 	if b.Encode != nil {
 		if b.encoders, err = loadEncoders(b.Encode); err != nil {
 			return
 		}
 	}
 
+	b.scoped = len(b.views) > 0
 	for zone, answer := range b.Answers {
 		_, answer.Network, err = net.ParseCIDR(zone)
 		if err != nil {
@@ -126,7 +145,7 @@ func (b *AutoBackend) Check() (err error) {
 			if b.encoders == nil {
 				return fmt.Errorf("No encoders for zone %q and no default", zone)
 			}
-			log.Printf("auto: using default encoders for zone %q", zone)
+			autoLog.Info("using default encoders", "zone", zone)
 			for _, e := range b.encoders {
 				answer.encoders = append(answer.encoders, e)
 			}
@@ -138,6 +157,12 @@ func (b *AutoBackend) Check() (err error) {
 		if answer.Zone == "" {
 			return fmt.Errorf("No forward zone for zone %q", zone)
 		}
+		if err = answer.ACL.check(); err != nil {
+			return fmt.Errorf("%s: %v", zone, err)
+		}
+		if len(answer.views) > 0 || answer.ACL != nil {
+			b.scoped = true
+		}
 		if answer.Prefix == "" && b.Prefix != "" {
 			answer.Prefix = b.Prefix
 		}
@@ -155,15 +180,23 @@ func (b *AutoBackend) Check() (err error) {
 		if answer.SOA == nil {
 			answer.SOA = b.SOA.Copy()
 			answer.SOA.Source = answer.DNS[0]
+		} else if err = answer.SOA.check(); err != nil {
+			return fmt.Errorf("%s: %v", zone, err)
 		}
-		log.Printf("auto: %s SOA %q\n", answer.Zone, answer.SOA.String())
+		if err = b.updateSerial(answer); err != nil {
+			return fmt.Errorf("%s: %v", zone, err)
+		}
+		autoLog.Debug("zone SOA", "zone", answer.Zone, "soa", answer.SOA.String())
 	}
 
 	return
 }
 
 func (b *AutoBackend) Query(m *message.Message) (r []*message.Message, err error) {
-	log.Printf("auto: query for %s (%s)\n", m.Name, dns.TypeToString[m.Type])
+	autoLog.Debug("query", "name", string(m.Name), "type", dns.TypeToString[m.Type])
+	if !b.views.Match(m) {
+		return nil, nil
+	}
 
 	r = make([]*message.Message, 0)
 
@@ -202,6 +235,15 @@ func (b *AutoBackend) Query(m *message.Message) (r []*message.Message, err error
 	return
 }
 
+// Scope returns the client address if views or ACLs are used, answers are
+// the same for all other clients.
+func (b *AutoBackend) Scope(m *message.Message) string {
+	if b.scoped {
+		return clientScope(m, 32, 128, true)
+	}
+	return ""
+}
+
 func (b *AutoBackend) merge(r, replies []*message.Message) []*message.Message {
 	if replies != nil {
 		r = append(r, replies...)
@@ -209,10 +251,15 @@ func (b *AutoBackend) merge(r, replies []*message.Message) []*message.Message {
 	return r
 }
 
-func (b *AutoBackend) queryForward(m *message.Message, accept func(ip net.IP) bool) (r []*message.Message, err error) {
+func (b *AutoBackend) queryForward(m *message.Message, qtype uint16, accept func(ip net.IP) bool) (r []*message.Message, err error) {
 	r = make([]*message.Message, 0)
 
+	var denied bool
+
 	for _, answer := range b.Answers {
+		if !answer.views.Match(m) {
+			continue
+		}
 		name := string(m.Name)
 		if !strings.HasSuffix(name, "."+answer.Zone) {
 			continue
@@ -226,11 +273,27 @@ func (b *AutoBackend) queryForward(m *message.Message, accept func(ip net.IP) bo
 			continue
 		}
 		name = strings.TrimSuffix(name, answer.Suffix)
+		if ok, replies, err := answer.ACL.Filter(m, qtype); !ok {
+			if err != nil {
+				return nil, err
+			}
+			// Forward zones may span several answers, only decoy once
+			if !denied {
+				r = append(r, replies...)
+			}
+			denied = true
+			continue
+		}
+		// Failures only count if no encoder decodes the name
+		var failed []string
+		var decoded bool
 		for _, encoder := range answer.encoders {
 			d, err := encoder.Decode(name)
 			if err != nil {
+				failed = append(failed, encoderName(encoder))
 				continue
 			}
+			decoded = true
 			ipn := new(big.Int)
 			ipn.SetBytes(d)
 			ipn = ipn.Add(ipn, answer.network)
@@ -256,19 +319,24 @@ func (b *AutoBackend) queryForward(m *message.Message, accept func(ip net.IP) bo
 				r = append(r, p)
 			}
 		}
+		if !decoded {
+			for _, encoder := range failed {
+				metrics.DecodeFailures.WithLabelValues(encoder).Inc()
+			}
+		}
 	}
 
 	return
 }
 
 func (b *AutoBackend) queryA(m *message.Message) (rs []*message.Message, err error) {
-	return b.queryForward(m, func(ip net.IP) bool {
+	return b.queryForward(m, dns.TypeA, func(ip net.IP) bool {
 		return ip.To4() != nil
 	})
 }
 
 func (b *AutoBackend) queryAAAA(m *message.Message) (rs []*message.Message, err error) {
-	return b.queryForward(m, func(ip net.IP) bool {
+	return b.queryForward(m, dns.TypeAAAA, func(ip net.IP) bool {
 		return ip.To16() != nil && !isCanonicalIPv4(ip)
 	})
 }
@@ -278,10 +346,16 @@ func (b *AutoBackend) queryNS(m *message.Message) (r []*message.Message, err err
 
 	var name = string(m.Name)
 	for _, answer := range b.Answers {
+		if !answer.views.Match(m) {
+			continue
+		}
 		zone := ReverseNetwork(answer.Network)
 		if zone != name {
 			continue
 		}
+		if ok, replies, err := answer.ACL.Filter(m, dns.TypeNS); !ok {
+			return replies, err
+		}
 
 		for _, d := range answer.DNS {
 			p := &message.Message{
@@ -329,9 +403,19 @@ func (b *AutoBackend) queryPTR(m *message.Message) (r []*message.Message, err er
 
 	r = make([]*message.Message, 0)
 	for _, answer := range b.Answers {
+		if !answer.views.Match(m) {
+			continue
+		}
 		if answer.Network == nil || !answer.Network.Contains(ip) {
 			continue
 		}
+		if ok, replies, err := answer.ACL.Filter(m, dns.TypePTR); !ok {
+			if err != nil {
+				return nil, err
+			}
+			r = append(r, replies...)
+			continue
+		}
 		p := &message.Message{
 			Name:  m.Name,
 			Class: dns.ClassINET,
@@ -369,10 +453,16 @@ func (b *AutoBackend) querySOA(m *message.Message) (r []*message.Message, err er
 
 	var name = string(m.Name)
 	for _, answer := range b.Answers {
+		if !answer.views.Match(m) {
+			continue
+		}
 		zone := ReverseNetwork(answer.Network)
 		if zone != name {
 			continue
 		}
+		if ok, replies, err := answer.ACL.Filter(m, dns.TypeSOA); !ok {
+			return replies, err
+		}
 
 		p := &message.Message{
 			Name:    m.Name,
@@ -389,6 +479,10 @@ func (b *AutoBackend) querySOA(m *message.Message) (r []*message.Message, err er
 	return r, nil
 }
 
+func encoderName(e encoder.Encoder) string {
+	return strings.ToLower(strings.TrimPrefix(fmt.Sprintf("%T", e), "*encoder."))
+}
+
 func isCanonicalIPv4(ip net.IP) bool {
 	if ip.To16() == nil {
 		return false
@@ -3,6 +3,11 @@ package backend
 import (
 	"net"
 	"testing"
+
+	"github.com/miekg/dns"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/tehmaze-labs/dns/message"
+	"github.com/tehmaze-labs/dns/metrics"
 )
 
 func TestReverseNetwork(t *testing.T) {
@@ -30,3 +35,56 @@ func TestReverseNetwork(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeFailures(t *testing.T) {
+	b := testViewBackends(t, `
+auto:
+  - encode:
+      template: {format: "h-{octet4}"}
+      base32:
+    dns: [dns1.maze.io]
+    answers:
+      '172.23.40.0/24':
+        zone: pub.auto.maze.so
+`)[0]
+
+	failures := func(encoder string) float64 {
+		var m dto.Metric
+		if err := metrics.DecodeFailures.WithLabelValues(encoder).Write(&m); err != nil {
+			t.Fatal(err)
+		}
+		return m.GetCounter().GetValue()
+	}
+
+	// Failures only count if none of the encoders decodes the name
+	tests := []struct {
+		Name     string
+		Answers  int
+		Failures float64
+	}{
+		{"h-4.pub.auto.maze.so", 1, 0},
+		{"04.pub.auto.maze.so", 1, 0},
+		{"zz.pub.auto.maze.so", 0, 1},
+	}
+	for _, test := range tests {
+		template, base32 := failures("template"), failures("base32")
+		r, err := b.Query(&message.Message{
+			Name:  []byte(test.Name),
+			Class: dns.ClassINET,
+			Type:  dns.TypeA,
+			ID:    []byte("-1"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r) != test.Answers {
+			t.Errorf("%s: got %d answers, want %d", test.Name, len(r), test.Answers)
+		}
+		if got := failures("template") - template; got != test.Failures {
+			t.Errorf("%s: got %v template failures, want %v", test.Name, got, test.Failures)
+		}
+		if got := failures("base32") - base32; got != test.Failures {
+			t.Errorf("%s: got %v base32 failures, want %v", test.Name, got, test.Failures)
+		}
+	}
+}
@@ -1,13 +1,114 @@
 package backend
 
-import "github.com/tehmaze-labs/dns/message"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tehmaze-labs/dns/message"
+)
+
+// ErrUnknownCommand is returned by a Commander that does not handle a command.
+var ErrUnknownCommand = errors.New("unknown command")
 
 type Backend interface {
 	Check() error
 	Query(*message.Message) ([]*message.Message, error)
 }
 
+// Commander is implemented by backends that handle out-of-band commands, such
+// as the PowerDNS pipe CMD request. Each returned line is a separate reply.
+type Commander interface {
+	Command(name string, args []string) ([]string, error)
+}
+
+// Name returns the kind of backend, for logging and metrics.
+func Name(b Backend) string {
+	switch b := b.(type) {
+	case *cachedBackend:
+		return Name(b.Backend)
+	case *AutoBackend:
+		return "auto"
+	case *GeoBackend:
+		return "geo"
+	default:
+		return fmt.Sprintf("%T", b)
+	}
+}
+
 type BackendConfig struct {
-	AutoBackends []*AutoBackend `yaml:"auto"`
-	GeoBackends  []*GeoBackend  `yaml:"geo"`
+	Views        map[string]*View `yaml:"views,omitempty"`
+	AutoBackends []*AutoBackend   `yaml:"auto,omitempty"`
+	GeoBackends  []*GeoBackend    `yaml:"geo,omitempty"`
+
+	// Serials is the file with the last SOA serial of each zone
+	Serials string `yaml:"serials,omitempty"`
+
+	// Templates are set from the top-level templates section
+	Templates map[string]*RecordSet `yaml:"-"`
+
+	// Modified is the time the configuration was last modified
+	Modified time.Time `yaml:"-"`
+}
+
+// Backends resolves the views and templates of all backends, and returns the
+// checked backends.
+func (c *BackendConfig) Backends() (bs []Backend, err error) {
+	if err = checkTemplates(c.Templates); err != nil {
+		return nil, err
+	}
+	for name, v := range c.Views {
+		if v == nil {
+			return nil, fmt.Errorf("Empty view %q", name)
+		}
+		if err = v.check(); err != nil {
+			return nil, fmt.Errorf("view %q: %v", name, err)
+		}
+	}
+
+	bs = make([]Backend, 0)
+	for _, b := range c.AutoBackends {
+		if err = c.autoBackend(b); err != nil {
+			return nil, sourceError(b.Source, err)
+		}
+		bs = append(bs, b)
+	}
+	for _, b := range c.GeoBackends {
+		if err = c.geoBackend(b); err != nil {
+			return nil, sourceError(b.Source, err)
+		}
+		bs = append(bs, b)
+	}
+	return
+}
+
+func (c *BackendConfig) autoBackend(b *AutoBackend) (err error) {
+	b.serials, b.modified = c.Serials, c.Modified
+	if b.views, err = resolveViews(c.Views, b.Views); err != nil {
+		return
+	}
+	for zone, answer := range b.Answers {
+		if answer.views, err = resolveViews(c.Views, answer.Views); err != nil {
+			return fmt.Errorf("%s: %v", zone, err)
+		}
+	}
+	return b.Check()
+}
+
+func (c *BackendConfig) geoBackend(b *GeoBackend) (err error) {
+	if b.views, err = resolveViews(c.Views, b.Views); err != nil {
+		return
+	}
+	if err = b.resolveTemplates(c.Templates); err != nil {
+		return
+	}
+	return b.Check()
+}
+
+// sourceError prefixes err with the source of a backend, if known.
+func sourceError(source string, err error) error {
+	if source == "" {
+		return err
+	}
+	return fmt.Errorf("%s: %v", source, err)
 }
@@ -0,0 +1,275 @@
+package backend
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tehmaze-labs/dns/message"
+)
+
+// CacheConfig configures the answer cache:
+//
+//	cache:
+//	  size: 10000
+//	  negative: 60
+type CacheConfig struct {
+	// Size is the maximum number of cached answers
+	Size int `yaml:"size,omitempty"`
+	// Negative is the time in seconds to cache empty answers
+	Negative int `yaml:"negative,omitempty"`
+}
+
+// Scoper is implemented by backends whose answers depend on the client. The
+// scope is part of the cache key, so clients in the same scope share cached
+// answers. Backends that don't implement Scoper are cached per client and
+// resolver address.
+type Scoper interface {
+	Scope(*message.Message) string
+}
+
+// Cacher is implemented by backends whose answers may change between queries
+// from the same client, such as selected or health-checked records. If
+// Cacheable returns false, queries bypass the cache.
+type Cacher interface {
+	Cacheable() bool
+}
+
+// CacheStats are the counters of a Cache.
+type CacheStats struct {
+	Hits, Misses, Evictions uint64
+	Entries                 int
+}
+
+type cacheEntry struct {
+	key      string
+	answers  []*message.Message
+	expires  time.Time
+	lruEntry *list.Element
+}
+
+// Cache is a LRU cache for backend answers, shared by all wrapped backends.
+type Cache struct {
+	size     int
+	negative time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	lru     *list.List
+	next    int
+
+	hits, misses, evictions uint64
+}
+
+func NewCache(c *CacheConfig) (*Cache, error) {
+	if c.Size < 0 || c.Negative < 0 {
+		return nil, fmt.Errorf("Invalid cache size %d or negative TTL %d", c.Size, c.Negative)
+	}
+	return &Cache{
+		size:     pickInt(c.Size, 10000),
+		negative: time.Duration(pickInt(c.Negative, 60)) * time.Second,
+		now:      time.Now,
+		entries:  map[string]*cacheEntry{},
+		lru:      list.New(),
+	}, nil
+}
+
+// Wrap returns backend b with its answers cached.
+func (c *Cache) Wrap(b Backend) Backend {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.next++
+	return &cachedBackend{Backend: b, cache: c, id: strconv.Itoa(c.next)}
+}
+
+// WrapAll wraps all backends.
+func (c *Cache) WrapAll(bs []Backend) []Backend {
+	wrapped := make([]Backend, len(bs))
+	for i, b := range bs {
+		wrapped[i] = c.Wrap(b)
+	}
+	return wrapped
+}
+
+// Flush removes all cached answers.
+func (c *Cache) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]*cacheEntry{}
+	c.lru.Init()
+}
+
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	entries := len(c.entries)
+	c.mu.Unlock()
+	return CacheStats{
+		Hits:      atomic.LoadUint64(&c.hits),
+		Misses:    atomic.LoadUint64(&c.misses),
+		Evictions: atomic.LoadUint64(&c.evictions),
+		Entries:   entries,
+	}
+}
+
+// get returns copies of the cached answers for the query, with the TTL
+// reduced by the time spent in the cache.
+func (c *Cache) get(key string, m *message.Message) ([]*message.Message, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+	now := c.now()
+	if !now.Before(e.expires) {
+		c.remove(e)
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+	c.lru.MoveToFront(e.lruEntry)
+	atomic.AddUint64(&c.hits, 1)
+
+	ttl := int(math.Ceil(e.expires.Sub(now).Seconds()))
+	answers := make([]*message.Message, len(e.answers))
+	for i, a := range e.answers {
+		p := *a
+		p.Name = m.Name
+		p.ID = m.ID
+		if p.TTL > ttl {
+			p.TTL = ttl
+		}
+		answers[i] = &p
+	}
+	return answers, true
+}
+
+func (c *Cache) put(key string, answers []*message.Message) {
+	ttl := c.negative
+	for i, a := range answers {
+		if t := time.Duration(a.TTL) * time.Second; i == 0 || t < ttl {
+			ttl = t
+		}
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		c.remove(e)
+	}
+	e := &cacheEntry{key: key, answers: answers, expires: c.now().Add(ttl)}
+	e.lruEntry = c.lru.PushFront(e)
+	c.entries[key] = e
+
+	for len(c.entries) > c.size {
+		c.remove(c.lru.Back().Value.(*cacheEntry))
+		atomic.AddUint64(&c.evictions, 1)
+	}
+}
+
+// remove must be called with the lock held.
+func (c *Cache) remove(e *cacheEntry) {
+	c.lru.Remove(e.lruEntry)
+	delete(c.entries, e.key)
+}
+
+type cachedBackend struct {
+	Backend
+	cache *Cache
+	id    string
+}
+
+func (b *cachedBackend) key(m *message.Message) string {
+	var scope string
+	if s, ok := b.Backend.(Scoper); ok {
+		scope = s.Scope(m)
+	} else {
+		scope = clientScope(m, 32, 128, true)
+	}
+	return strings.Join([]string{
+		b.id,
+		strings.ToLower(string(m.Name)),
+		strconv.Itoa(int(m.Type)),
+		strconv.Itoa(int(m.Class)),
+		m.LocalAddr.String(),
+		scope,
+	}, "|")
+}
+
+func (b *cachedBackend) Query(m *message.Message) ([]*message.Message, error) {
+	if c, ok := b.Backend.(Cacher); ok && !c.Cacheable() {
+		return b.Backend.Query(m)
+	}
+
+	key := b.key(m)
+	if answers, ok := b.cache.get(key, m); ok {
+		return answers, nil
+	}
+
+	answers, err := b.Backend.Query(m)
+	if err != nil {
+		return answers, err
+	}
+	cached := make([]*message.Message, len(answers))
+	for i, a := range answers {
+		p := *a
+		cached[i] = &p
+	}
+	b.cache.put(key, cached)
+	return answers, nil
+}
+
+func (b *cachedBackend) Command(name string, args []string) ([]string, error) {
+	if c, ok := b.Backend.(Commander); ok {
+		return c.Command(name, args)
+	}
+	return nil, ErrUnknownCommand
+}
+
+func (b *cachedBackend) Close() error {
+	if c, ok := b.Backend.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// clientScope returns the client network with the given prefix lengths, and
+// the client subnet prefix length. Backends with views or ACLs also match on
+// the resolver address, in which case remote adds it to the scope.
+func clientScope(m *message.Message, ipv4Prefix, ipv6Prefix int, remote bool) string {
+	ip := m.Client()
+	if ip == nil {
+		return ""
+	}
+	var ones int
+	if m.ClientSubnet != nil {
+		ones, _ = m.ClientSubnet.Mask.Size()
+	}
+	var scope string
+	if ip4 := ip.To4(); ip4 != nil {
+		scope = ip4.Mask(net.CIDRMask(ipv4Prefix, 32)).String() + "/" + strconv.Itoa(ones)
+	} else {
+		scope = ip.Mask(net.CIDRMask(ipv6Prefix, 128)).String() + "/" + strconv.Itoa(ones)
+	}
+	if remote {
+		scope += "@" + m.RemoteAddr.String()
+	}
+	return scope
+}
+
+// Interface completeness validation
+var _ Backend = (*cachedBackend)(nil)
+var _ Commander = (*cachedBackend)(nil)
@@ -0,0 +1,248 @@
+package backend
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tehmaze-labs/dns/message"
+)
+
+type testCountingBackend struct {
+	queries int
+	ttl     int
+}
+
+func (b *testCountingBackend) Check() error { return nil }
+
+func (b *testCountingBackend) Query(m *message.Message) ([]*message.Message, error) {
+	b.queries++
+	if string(m.Name) == "empty.maze.io" {
+		return nil, nil
+	}
+	if string(m.Name) == "refused.maze.io" {
+		return nil, ErrRefused
+	}
+	return []*message.Message{{
+		Name:    m.Name,
+		Class:   dns.ClassINET,
+		Type:    dns.TypeA,
+		TTL:     b.ttl,
+		ID:      m.ID,
+		Content: []byte("10.0.0.1"),
+	}}, nil
+}
+
+func testCacheQuery(t *testing.T, b Backend, name, remote, id string) []*message.Message {
+	r, err := b.Query(&message.Message{
+		Name:       []byte(name),
+		Class:      dns.ClassINET,
+		Type:       dns.TypeA,
+		ID:         []byte(id),
+		RemoteAddr: net.ParseIP(remote),
+	})
+	if err != nil && err != ErrRefused {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestCache(t *testing.T) {
+	c, err := NewCache(&CacheConfig{Size: 2, Negative: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	inner := &testCountingBackend{ttl: 60}
+	b := c.Wrap(inner)
+
+	testCacheQuery(t, b, "www.maze.io", "192.0.2.1", "1")
+	now = now.Add(15 * time.Second)
+	r := testCacheQuery(t, b, "WWW.maze.io", "192.0.2.1", "2")
+	if inner.queries != 1 {
+		t.Errorf("got %d backend queries, want 1", inner.queries)
+	}
+	if len(r) != 1 || string(r[0].ID) != "2" || string(r[0].Name) != "WWW.maze.io" || r[0].TTL != 45 {
+		t.Errorf("got %+v, want cached answer with ID 2 and TTL 45", r[0])
+	}
+
+	// Backends without scope are cached per client
+	testCacheQuery(t, b, "www.maze.io", "192.0.2.2", "3")
+	if inner.queries != 2 {
+		t.Errorf("got %d backend queries, want 2", inner.queries)
+	}
+
+	// Expired
+	now = now.Add(time.Minute)
+	testCacheQuery(t, b, "www.maze.io", "192.0.2.1", "4")
+	if inner.queries != 3 {
+		t.Errorf("got %d backend queries, want 3", inner.queries)
+	}
+
+	// Negative answers use the negative TTL, errors are not cached
+	testCacheQuery(t, b, "empty.maze.io", "192.0.2.1", "5")
+	testCacheQuery(t, b, "empty.maze.io", "192.0.2.1", "6")
+	testCacheQuery(t, b, "refused.maze.io", "192.0.2.1", "7")
+	testCacheQuery(t, b, "refused.maze.io", "192.0.2.1", "8")
+	if inner.queries != 6 {
+		t.Errorf("got %d backend queries, want 6", inner.queries)
+	}
+
+	s := c.Stats()
+	t.Logf("stats: %+v", s)
+	if s.Entries != 2 || s.Evictions != 1 || s.Hits != 2 {
+		t.Errorf("got %+v", s)
+	}
+
+	c.Flush()
+	if s = c.Stats(); s.Entries != 0 {
+		t.Errorf("got %d entries after flush", s.Entries)
+	}
+}
+
+func TestCacheScope(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	g := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	g.Options.Database = filename
+	g.Options.Answers.Networks = map[string]*RecordSet{"89.160.20.112/30": testGeoRecords("10.2.0.1")}
+	g.Options.Answers.Default = testGeoRecords("10.0.0.9")
+	if err := g.Check(); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewCache(&CacheConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := c.Wrap(g)
+
+	tests := map[string]string{
+		"89.160.20.112": "10.2.0.1",
+		"89.160.20.113": "10.2.0.1",
+		"89.160.20.116": "10.0.0.9",
+		"89.160.20.117": "10.0.0.9",
+	}
+	for _, test := range []string{"89.160.20.112", "89.160.20.113", "89.160.20.116", "89.160.20.117"} {
+		r := testCacheQuery(t, b, "cdn.maze.io", test, "-1")
+		if len(r) != 1 || string(r[0].Content) != tests[test] {
+			t.Errorf("got %v, want %s for %s", r, tests[test], test)
+		}
+	}
+	if s := c.Stats(); s.Hits != 2 || s.Misses != 2 {
+		t.Errorf("got %+v, want 2 hits and 2 misses", s)
+	}
+}
+
+func TestCacheACL(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	g := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	g.Options.Database = filename
+	g.Options.Answers.Default = testGeoRecords("10.0.0.9")
+	g.ACL = &ACL{
+		Deny:   []string{"192.0.2.0/24"},
+		Action: ACLDecoy,
+		Decoy:  []*Record{{Type: "A", TTL: 60, Content: "192.0.2.99"}},
+	}
+	if err := g.Check(); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewCache(&CacheConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := c.Wrap(g)
+
+	// A denied resolver claiming the subnet of an allowed client must not
+	// get its cached answer, and the other way around
+	subnet := &net.IPNet{IP: net.ParseIP("89.160.20.112").To4(), Mask: net.CIDRMask(32, 32)}
+	tests := []struct {
+		Remote, Want string
+	}{
+		{"172.23.0.1", "10.0.0.9"},
+		{"192.0.2.1", "192.0.2.99"},
+		{"172.23.0.1", "10.0.0.9"},
+		{"192.0.2.2", "192.0.2.99"},
+	}
+	for _, test := range tests {
+		r, err := b.Query(&message.Message{
+			Name:         []byte("cdn.maze.io"),
+			Class:        dns.ClassINET,
+			Type:         dns.TypeA,
+			ID:           []byte("-1"),
+			RemoteAddr:   net.ParseIP(test.Remote),
+			ClientSubnet: subnet,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r) != 1 || string(r[0].Content) != test.Want {
+			t.Errorf("got %v, want %s for %s", r, test.Want, test.Remote)
+		}
+	}
+	if s := c.Stats(); s.Hits != 1 || s.Misses != 3 {
+		t.Errorf("got %+v, want 1 hit and 3 misses", s)
+	}
+}
+
+func TestCacheDynamic(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	tests := []struct {
+		Name  string
+		Set   *RecordSet
+		Cache bool
+		Want  []string
+	}{
+		{"all", &RecordSet{Records: []*Record{
+			{Type: "A", TTL: 60, Content: "10.0.0.1"},
+		}}, true, []string{"10.0.0.1", "10.0.0.1", "10.0.0.1"}},
+		{"roundrobin", &RecordSet{Select: SelectRoundRobin, Count: 1, Records: []*Record{
+			{Type: "A", TTL: 60, Content: "10.0.0.1"},
+			{Type: "A", TTL: 60, Content: "10.0.0.2"},
+		}}, false, []string{"10.0.0.1", "10.0.0.2", "10.0.0.1"}},
+		{"health", &RecordSet{Records: []*Record{
+			{Type: "A", TTL: 60, Content: "10.0.0.1", Check: &HealthCheck{TCP: l.Addr().String()}},
+		}}, false, []string{"10.0.0.1", "10.0.0.1", "10.0.0.1"}},
+	}
+	for _, test := range tests {
+		g := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+		g.Options.Database = filename
+		g.Options.Answers.Default = test.Set
+		if err := g.Check(); err != nil {
+			t.Fatal(err)
+		}
+		if g.Cacheable() != test.Cache {
+			t.Errorf("%s: got cacheable %t, want %t", test.Name, !test.Cache, test.Cache)
+		}
+		c, err := NewCache(&CacheConfig{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := c.Wrap(g)
+
+		for i, want := range test.Want {
+			r := testCacheQuery(t, b, "cdn.maze.io", "89.160.20.112", "-1")
+			if len(r) != 1 || string(r[0].Content) != want {
+				t.Errorf("%s: query %d got %v, want %s", test.Name, i, r, want)
+			}
+		}
+		if s := c.Stats(); test.Cache && s.Hits != 2 || !test.Cache && s.Hits+s.Misses+uint64(s.Entries) != 0 {
+			t.Errorf("%s: got %+v", test.Name, s)
+		}
+		g.Close()
+	}
+}
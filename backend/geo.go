@@ -1,91 +1,449 @@
 package backend
 
 import (
+	"bytes"
 	"fmt"
-	"log"
+	"net"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/miekg/dns"
-	"github.com/oschwald/geoip2-golang"
+	"github.com/tehmaze-labs/dns/logging"
 	"github.com/tehmaze-labs/dns/message"
+	"github.com/tehmaze-labs/dns/metrics"
 )
 
-var continents = []string{"AF", "AS", "EU", "NA", "OC", "SA"}
+var (
+	geoLog    = logging.For("geo")
+	zoneParam = []byte(ZoneParam)
+)
+
+// Answer rules, in their default order of precedence
+var geoRules = []string{"networks", "asn", "city", "subdivision", "metro", "country", "nearest", "continent", "unknown", "default"}
 
 type GeoBackend struct {
-	Zones   []string `yaml:"zones"`
+	Zones   []string `yaml:"zones,omitempty"`
+	Views   []string `yaml:"views,omitempty"`
+	ACL     *ACL     `yaml:"acl,omitempty"`
 	Options struct {
-		Database string `yaml:"database"`
-		Answers  struct {
-			Continent map[string][]*Record
-			Country   map[string][]*Record
-		}
+		Database    string        `yaml:"database,omitempty"`
+		ASNDatabase string        `yaml:"asndatabase,omitempty"`
+		Reload      time.Duration `yaml:"reload,omitempty"`
+		Debug       string        `yaml:"debug,omitempty"`
+		Order       []string      `yaml:"order,omitempty"`
+		Answers     struct {
+			Continent   map[string]*RecordSet `yaml:"continent,omitempty"`
+			Country     map[string]*RecordSet `yaml:"country,omitempty"`
+			Subdivision map[string]*RecordSet `yaml:"subdivision,omitempty"`
+			City        map[string]*RecordSet `yaml:"city,omitempty"`
+			Metro       map[string]*RecordSet `yaml:"metro,omitempty"`
+			ASN         map[string]*RecordSet `yaml:"asn,omitempty"`
+			Networks    map[string]*RecordSet `yaml:"networks,omitempty"`
+			Unknown     *RecordSet            `yaml:"unknown,omitempty"`
+			Default     *RecordSet            `yaml:"default,omitempty"`
+		} `yaml:"answers,omitempty"`
 		Default struct {
-			Continent string
-			Country   string
-		}
+			Continent string `yaml:"continent,omitempty"`
+			Country   string `yaml:"country,omitempty"`
+		} `yaml:"default,omitempty"`
+		PoPs    map[string]*GeoPoP `yaml:"pops,omitempty"`
+		Nearest struct {
+			Count    int      `yaml:"count,omitempty"`
+			Fallback []string `yaml:"fallback,omitempty"`
+		} `yaml:"nearest,omitempty"`
 	}
 
-	geoIP *geoip2.Reader
+	// Source describes where the backend was configured, for errors
+	Source string `yaml:"-"`
+
+	geoIP    *geoDatabase
+	asnIP    *geoDatabase
+	city     bool
+	networks *netTree
+	health   *healthMonitor
+	dynamic  bool
+	views    viewSet
+	scope    [2]int
+}
+
+// geoLocation is the result of a GeoIP lookup, with the keys used in the
+// answer maps.
+type geoLocation struct {
+	IP           net.IP
+	ASN          string
+	Continent    string
+	Country      string
+	CountryName  string
+	Subdivisions []string
+	City         string
+	CityID       string
+	Metro        string
+	Latitude     float64
+	Longitude    float64
 }
 
 func (b *GeoBackend) Check() (err error) {
-	b.geoIP, err = geoip2.Open(b.Options.Database)
-	if err != nil || b.geoIP == nil {
+	b.close()
+
+	b.geoIP, err = openGeoDatabase(b.Options.Database, validateGeoType("City", "Country"))
+	if err != nil {
 		return fmt.Errorf("error reading GeoIP datbases %q: %v", b.Options.Database, err)
 	}
+	b.city = strings.Contains(b.geoIP.DatabaseType(), "City")
+	if b.city {
+		// Reloaded databases must provide the same data
+		b.geoIP.validate = validateGeoType("City")
+	}
+
+	if b.Options.Reload <= 0 {
+		b.Options.Reload = DefaultGeoReload
+	}
+
+	if b.Options.ASNDatabase != "" {
+		b.asnIP, err = openGeoDatabase(b.Options.ASNDatabase, validateGeoType("ASN"))
+		if err != nil {
+			return fmt.Errorf("error reading GeoIP ASN database %q: %v", b.Options.ASNDatabase, err)
+		}
+	} else if len(b.Options.Answers.ASN) > 0 {
+		return fmt.Errorf("asn answers configured without asndatabase")
+	}
 
 	// Normalize
 	for n, zn := range b.Zones {
 		b.Zones[n] = strings.ToLower(zn)
 	}
+	b.Options.Debug = strings.ToLower(strings.Trim(b.Options.Debug, "."))
+	if err = b.ACL.check(); err != nil {
+		return
+	}
 
 	b.Options.Default.Continent = strings.ToUpper(b.Options.Default.Continent)
 	b.Options.Default.Country = strings.ToUpper(b.Options.Default.Country)
+	if c := b.Options.Default.Continent; c != "" && !isContinent(c) {
+		return fmt.Errorf("Unknown default continent %q", c)
+	}
+	if c := b.Options.Default.Country; c != "" && !isCountry(c) {
+		return fmt.Errorf("Unknown default country %q", c)
+	}
 
-	if err = b.checkAnswers(b.Options.Answers.Continent); err != nil {
+	if b.Options.Order == nil {
+		b.Options.Order = append([]string{}, geoRules...)
+	}
+	for n, rule := range b.Options.Order {
+		rule = strings.ToLower(rule)
+		if !stringInSlice(rule, geoRules) {
+			return fmt.Errorf("Unknown geo rule %q", rule)
+		}
+		b.Options.Order[n] = rule
+	}
+
+	if !b.city {
+		for _, answers := range []map[string]*RecordSet{
+			b.Options.Answers.Subdivision,
+			b.Options.Answers.City,
+			b.Options.Answers.Metro,
+		} {
+			if len(answers) > 0 {
+				return fmt.Errorf("GeoIP database %q has no city data", b.Options.Database)
+			}
+		}
+		if len(b.Options.PoPs) > 0 {
+			return fmt.Errorf("GeoIP database %q has no location data", b.Options.Database)
+		}
+	}
+
+	for name, pop := range b.Options.PoPs {
+		if err = pop.check(name); err != nil {
+			return
+		}
+		if err = pop.RecordSet.check(); err != nil {
+			return fmt.Errorf("PoP %q: %v", name, err)
+		}
+	}
+	if b.Options.Nearest.Count == 0 {
+		b.Options.Nearest.Count = 1
+	} else if b.Options.Nearest.Count < 0 {
+		return fmt.Errorf("Invalid nearest PoP count %d", b.Options.Nearest.Count)
+	}
+	for _, name := range b.Options.Nearest.Fallback {
+		if _, found := b.Options.PoPs[name]; !found {
+			return fmt.Errorf("Unknown fallback PoP %q", name)
+		}
+	}
+
+	if err = b.checkAnswers(b.Options.Answers.Continent, strings.ToUpper, isContinent); err != nil {
+		return
+	}
+	if err = b.checkAnswers(b.Options.Answers.Country, strings.ToUpper, isCountry); err != nil {
+		return
+	}
+	if err = b.checkAnswers(b.Options.Answers.Subdivision, strings.ToUpper, isSubdivision); err != nil {
 		return
 	}
-	if err = b.checkAnswers(b.Options.Answers.Country); err != nil {
+	if err = b.checkAnswers(b.Options.Answers.City, strings.ToLower, nil); err != nil {
 		return
 	}
+	if err = b.checkAnswers(b.Options.Answers.Metro, strings.TrimSpace, nil); err != nil {
+		return
+	}
+	if err = b.checkAnswers(b.Options.Answers.ASN, normalizeASN, nil); err != nil {
+		return
+	}
+	if err = b.checkAnswers(b.Options.Answers.Networks, strings.TrimSpace, nil); err != nil {
+		return
+	}
+	for name, set := range map[string]*RecordSet{
+		"unknown": b.Options.Answers.Unknown,
+		"default": b.Options.Answers.Default,
+	} {
+		if set == nil {
+			continue
+		}
+		if err = set.check(); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+
+	// Clients in the same scope get the same answers, unless the answer
+	// networks are more specific
+	b.scope = [2]int{24, 56}
+	if len(b.views) > 0 || b.ACL != nil {
+		b.scope = [2]int{32, 128}
+	}
+	b.networks = newNetTree()
+	for cidr, set := range b.Options.Answers.Networks {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("Invalid network %q: %v", cidr, err)
+		}
+		b.networks.Insert(ipnet, set)
+		if ones, bits := ipnet.Mask.Size(); bits == 32 && ones > b.scope[0] {
+			b.scope[0] = ones
+		} else if bits == 128 && ones > b.scope[1] {
+			b.scope[1] = ones
+		}
+	}
+
+	return b.checkHealth()
+}
+
+// checkHealth (re)starts the health checks of all answers, and notes if any
+// answer is dynamic.
+func (b *GeoBackend) checkHealth() error {
+	if b.health != nil {
+		b.health.Stop()
+	}
+	b.health = newHealthMonitor()
+	b.dynamic = false
 
+	a := b.Options.Answers
+	for _, answers := range []map[string]*RecordSet{
+		a.Continent, a.Country, a.Subdivision, a.City, a.Metro, a.ASN, a.Networks,
+	} {
+		for _, set := range answers {
+			if err := b.health.add(set); err != nil {
+				return err
+			}
+			b.dynamic = b.dynamic || set.dynamic()
+		}
+	}
+	for _, set := range []*RecordSet{a.Unknown, a.Default} {
+		if set == nil {
+			continue
+		}
+		if err := b.health.add(set); err != nil {
+			return err
+		}
+		b.dynamic = b.dynamic || set.dynamic()
+	}
+	for name, pop := range b.Options.PoPs {
+		if err := b.health.add(&pop.RecordSet); err != nil {
+			return fmt.Errorf("PoP %q: %v", name, err)
+		}
+		b.dynamic = b.dynamic || pop.RecordSet.dynamic()
+	}
+
+	b.health.Start()
 	return nil
 }
 
-func (r *GeoBackend) checkAnswers(answers map[string][]*Record) (err error) {
+func (r *GeoBackend) checkAnswers(answers map[string]*RecordSet, normalize func(string) string, valid func(string) bool) (err error) {
 	if answers == nil {
 		return
 	}
 	for n, a := range answers {
-		nu := strings.ToUpper(n)
+		nu := normalize(n)
+		if valid != nil && !valid(nu) {
+			return fmt.Errorf("Unknown answer key %q", n)
+		}
 		if n != nu {
 			answers[nu] = a
 			delete(answers, n)
 			n = nu
 		}
+		if a == nil {
+			answers[n] = &RecordSet{}
+			a = answers[n]
+		}
+		if err = a.check(); err != nil {
+			return fmt.Errorf("%s: %v", n, err)
+		}
+	}
+	return
+}
 
-		// Basic record pre-flight checks
-		for _, r := range a {
-			if r.Class == "" {
-				r.Class = dns.ClassToString[dns.ClassINET]
+// Close stops the health checks and closes the GeoIP databases.
+func (b *GeoBackend) Close() error {
+	if b.health != nil {
+		b.health.Stop()
+		b.health = nil
+	}
+	b.close()
+	return nil
+}
+
+// close releases the GeoIP databases of a previous Check.
+func (b *GeoBackend) close() {
+	if b.geoIP != nil {
+		b.geoIP.Close()
+		b.geoIP = nil
+	}
+	if b.asnIP != nil {
+		b.asnIP.Close()
+		b.asnIP = nil
+	}
+}
+
+// normalizeASN strips the AS prefix from autonomous system numbers.
+func normalizeASN(asn string) string {
+	asn = strings.ToUpper(strings.TrimSpace(asn))
+	return strings.TrimPrefix(asn, "AS")
+}
+
+// lookup finds the location for an IP address, using the city database if
+// available.
+func (b *GeoBackend) lookup(ip net.IP) (l *geoLocation, err error) {
+	l = &geoLocation{IP: ip}
+
+	if b.asnIP != nil {
+		if asn, err := b.asnIP.ASN(ip); err == nil && asn.AutonomousSystemNumber != 0 {
+			l.ASN = strconv.FormatUint(uint64(asn.AutonomousSystemNumber), 10)
+		}
+	}
+
+	if !b.city {
+		gi, err := b.geoIP.Country(ip)
+		if err != nil {
+			return l, err
+		}
+		l.Continent = gi.Continent.Code
+		l.Country = gi.Country.IsoCode
+		l.CountryName = gi.Country.Names["en"]
+		return l, nil
+	}
+
+	gi, err := b.geoIP.City(ip)
+	if err != nil {
+		return l, err
+	}
+	l.Continent = gi.Continent.Code
+	l.Country = gi.Country.IsoCode
+	l.CountryName = gi.Country.Names["en"]
+	// Most specific subdivision first
+	for i := len(gi.Subdivisions) - 1; i >= 0; i-- {
+		if gi.Subdivisions[i].IsoCode != "" {
+			l.Subdivisions = append(l.Subdivisions, l.Country+"-"+gi.Subdivisions[i].IsoCode)
+		}
+	}
+	l.City = strings.ToLower(gi.City.Names["en"])
+	if gi.City.GeoNameID != 0 {
+		l.CityID = strconv.FormatUint(uint64(gi.City.GeoNameID), 10)
+	}
+	if gi.Location.MetroCode != 0 {
+		l.Metro = strconv.FormatUint(uint64(gi.Location.MetroCode), 10)
+	}
+	l.Latitude = gi.Location.Latitude
+	l.Longitude = gi.Location.Longitude
+	return l, nil
+}
+
+// match returns the record sets for the first rule that has answers for the
+// location.
+func (b *GeoBackend) match(l *geoLocation) (rule string, sets []*RecordSet) {
+	a := b.Options.Answers
+	for _, rule = range b.Options.Order {
+		var keys []string
+		var answers map[string]*RecordSet
+
+		switch rule {
+		case "networks":
+			if v, found := b.networks.Lookup(l.IP); found {
+				if set := v.(*RecordSet); set.Available() {
+					return rule, []*RecordSet{set}
+				}
 			}
-			if _, ok := dns.StringToClass[r.Class]; !ok {
-				return fmt.Errorf("Unknown class %q", r.Class)
+		case "asn":
+			keys, answers = []string{l.ASN}, a.ASN
+		case "city":
+			keys, answers = []string{l.CityID, l.City}, a.City
+		case "subdivision":
+			keys, answers = l.Subdivisions, a.Subdivision
+		case "metro":
+			keys, answers = []string{l.Metro}, a.Metro
+		case "country":
+			keys, answers = []string{l.Country}, a.Country
+		case "continent":
+			keys, answers = []string{l.Continent}, a.Continent
+		case "nearest":
+			if sets = b.matchNearest(l); len(sets) > 0 {
+				return
 			}
-			if _, ok := dns.StringToType[r.Type]; !ok {
-				return fmt.Errorf("Unknown type %q", r.Type)
+		case "unknown":
+			if l.Continent == "" && l.Country == "" && a.Unknown.Available() {
+				return rule, []*RecordSet{a.Unknown}
+			}
+		case "default":
+			if set := a.Country[b.Options.Default.Country]; set.Available() {
+				return rule, []*RecordSet{set}
+			}
+			if set := a.Continent[b.Options.Default.Continent]; set.Available() {
+				return rule, []*RecordSet{set}
+			}
+			if a.Default.Available() {
+				return rule, []*RecordSet{a.Default}
+			}
+		}
+
+		for _, key := range keys {
+			if key == "" {
+				continue
+			}
+			if set := answers[key]; set.Available() {
+				return rule, []*RecordSet{set}
 			}
 		}
 	}
-	return
+	return "", nil
 }
 
 func (b *GeoBackend) Query(m *message.Message) (r []*message.Message, err error) {
-	if !stringInSlice(strings.ToLower(string(m.Name)), b.Zones) {
+	if !b.views.Match(m) {
 		return nil, nil
 	}
 
+	name := strings.ToLower(string(m.Name))
+	debug := b.Options.Debug != "" && b.isDebugName(name)
+	if !debug && !stringInSlice(name, b.Zones) {
+		return nil, nil
+	}
+	if ok, replies, err := b.ACL.Filter(m, m.Type); !ok {
+		return replies, err
+	}
+	if debug {
+		return b.queryDebug(m)
+	}
+
 	qtypes := map[uint16]bool{
 		dns.TypeAAAA: true,
 		dns.TypeA:    true,
@@ -97,72 +455,114 @@ func (b *GeoBackend) Query(m *message.Message) (r []*message.Message, err error)
 		}
 	}
 
-	var cc, cn, co string
+	r = make([]*message.Message, 0)
+	l := b.locate(m)
 
-	gi, err := b.geoIP.Country(m.RemoteAddr)
-	if err == nil {
-		cc = gi.Country.IsoCode
-		cn = gi.Country.Names["en"]
-		co = gi.Continent.Code
+	var records []*Record
+	rule, sets := b.match(l)
+	metrics.GeoRuleHits.WithLabelValues(pickStr(rule, "none"), l.Continent, l.Country).Inc()
+	for _, set := range sets {
+		records = append(records, set.Pick(func(record *Record) bool {
+			return qtypes[dns.StringToType[record.Type]]
+		})...)
 	}
 
-	if cc == "" {
-		cc = "XX"
-		cn = "Unknown"
-		if b.Options.Default.Continent != "" {
-			co = b.Options.Default.Continent
-		} else {
-			co = "EU"
+	for _, record := range records {
+		p, err := record.Message()
+		if err != nil {
+			geoLog.Warn("bogus record", "error", err)
+			continue
+		}
+
+		p.Name = m.Name
+		p.ID = m.ID
+		if bytes.Contains(p.Content, zoneParam) {
+			p.Content = bytes.Replace(p.Content, zoneParam, []byte(strings.TrimSuffix(name, ".")), -1)
 		}
-		if b.Options.Default.Country != "" {
-			cc = b.Options.Default.Country
-		} else {
-			cc = "XX"
+		if m.ClientSubnet != nil {
+			p.ScopeBits, _ = m.ClientSubnet.Mask.Size()
 		}
+		r = append(r, p)
 	}
 
-	r = make([]*message.Message, 0)
-	if qtypes[dns.TypeTXT] {
-		r = append(r, &message.Message{
-			Name:    m.Name,
-			Class:   dns.ClassINET,
-			Type:    dns.TypeTXT,
-			ID:      m.ID,
-			Content: []byte(fmt.Sprintf("dns geo result for %s in %s (%s)", m.RemoteAddr, cn, co)),
-		})
+	return
+}
+
+// locate looks up the location of the client, after reloading the databases
+// if they have changed.
+func (b *GeoBackend) locate(m *message.Message) *geoLocation {
+	b.geoIP.check(b.Options.Reload)
+	if b.asnIP != nil {
+		b.asnIP.check(b.Options.Reload)
 	}
 
-	var records []*Record
+	l, _ := b.lookup(m.Client())
+	return l
+}
 
-	if answers, ok := b.Options.Answers.Continent[co]; ok {
-		for _, answer := range answers {
-			records = append(records, answer)
+// isDebugName reports if name is the debug name under one of the zones.
+func (b *GeoBackend) isDebugName(name string) bool {
+	for _, zone := range b.Zones {
+		if name == b.Options.Debug+"."+zone {
+			return true
 		}
 	}
-	if answers, ok := b.Options.Answers.Country[cc]; ok {
-		for _, answer := range answers {
-			records = append(records, answer)
-		}
+	return false
+}
+
+// queryDebug answers a TXT record describing how the client was resolved.
+func (b *GeoBackend) queryDebug(m *message.Message) ([]*message.Message, error) {
+	if m.Type != dns.TypeTXT && m.Type != dns.TypeANY {
+		return nil, nil
 	}
 
-	for _, record := range records {
-		if !qtypes[dns.StringToType[record.Type]] {
-			continue
-		}
+	l := b.locate(m)
+	rule, _ := b.match(l)
+	if rule == "" {
+		rule = "none"
+	}
 
-		p, err := record.Message()
-		if err != nil {
-			log.Printf("bogus record: %v", err)
-			continue
-		}
+	country, continent := pickStr(l.CountryName, "unknown"), pickStr(l.Continent, "unknown")
+	text := fmt.Sprintf("dns geo result for %s in %s (%s), rule %s, database %s built %s",
+		l.IP, country, continent, rule,
+		b.geoIP.DatabaseType(), b.geoIP.BuildTime().Format("2006-01-02"))
+	return []*message.Message{{
+		Name:    m.Name,
+		Class:   dns.ClassINET,
+		Type:    dns.TypeTXT,
+		ID:      m.ID,
+		Content: []byte(strconv.Quote(text)),
+	}}, nil
+}
 
-		p.Name = m.Name
-		p.ID = m.ID
-		r = append(r, p)
+// Scope returns the client network used for caching answers.
+func (b *GeoBackend) Scope(m *message.Message) string {
+	return clientScope(m, b.scope[0], b.scope[1], len(b.views) > 0 || b.ACL != nil)
+}
+
+// Cacheable reports if answers may be cached, which is not the case if any
+// answer is selected per query or health-checked.
+func (b *GeoBackend) Cacheable() bool {
+	return !b.dynamic
+}
+
+// Command handles the "health" command, which lists the state of all health
+// checks.
+func (b *GeoBackend) Command(name string, args []string) ([]string, error) {
+	if name != "health" {
+		return nil, ErrUnknownCommand
 	}
 
-	return
+	zones := strings.Join(b.Zones, ",")
+	lines := b.health.Status()
+	for i, line := range lines {
+		lines[i] = zones + " " + line
+	}
+	return lines, nil
 }
 
 // Interface check
 var _ Backend = (*GeoBackend)(nil)
+var _ Commander = (*GeoBackend)(nil)
+var _ Scoper = (*GeoBackend)(nil)
+var _ Cacher = (*GeoBackend)(nil)
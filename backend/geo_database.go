@@ -0,0 +1,159 @@
+package backend
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oschwald/geoip2-golang"
+)
+
+// DefaultGeoReload is the default interval for checking if a GeoIP database
+// has changed on disk.
+const DefaultGeoReload = time.Minute
+
+// geoDatabase is a GeoIP database that is reloaded when the file changes. A
+// new database is only swapped in if it passes validation, the old database
+// is closed once all lookups using it have finished.
+type geoDatabase struct {
+	filename string
+	validate func(*geoip2.Reader) error
+
+	mu      sync.RWMutex
+	reader  *geoip2.Reader
+	modTime time.Time
+	size    int64
+
+	checkMu sync.Mutex
+	checked time.Time
+}
+
+func openGeoDatabase(filename string, validate func(*geoip2.Reader) error) (*geoDatabase, error) {
+	d := &geoDatabase{
+		filename: filename,
+		validate: validate,
+		checked:  time.Now(),
+	}
+	if err := d.load(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *geoDatabase) load() error {
+	i, err := os.Stat(d.filename)
+	if err != nil {
+		return err
+	}
+
+	reader, err := geoip2.Open(d.filename)
+	if err != nil {
+		return err
+	}
+	if err = d.validate(reader); err != nil {
+		reader.Close()
+		return err
+	}
+
+	d.mu.Lock()
+	old := d.reader
+	d.reader, d.modTime, d.size = reader, i.ModTime(), i.Size()
+	d.mu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+	return nil
+}
+
+// check reloads the database if it has changed since it was last read, at
+// most once per interval.
+func (d *geoDatabase) check(interval time.Duration) {
+	d.checkMu.Lock()
+	defer d.checkMu.Unlock()
+
+	if time.Since(d.checked) < interval {
+		return
+	}
+	d.checked = time.Now()
+
+	i, err := os.Stat(d.filename)
+	if err != nil {
+		geoLog.Warn("checking database", "error", err)
+		return
+	}
+	d.mu.RLock()
+	changed := !i.ModTime().Equal(d.modTime) || i.Size() != d.size
+	d.mu.RUnlock()
+	if !changed {
+		return
+	}
+
+	geoLog.Info("reloading database", "file", d.filename)
+	if err = d.load(); err != nil {
+		geoLog.Error("reloading database failed, keeping previous database", "file", d.filename, "error", err)
+		// Don't retry until the file changes again
+		d.mu.Lock()
+		d.modTime, d.size = i.ModTime(), i.Size()
+		d.mu.Unlock()
+	}
+}
+
+func (d *geoDatabase) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.reader == nil {
+		return nil
+	}
+	err := d.reader.Close()
+	d.reader = nil
+	return err
+}
+
+func (d *geoDatabase) DatabaseType() string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.reader.Metadata().DatabaseType
+}
+
+// BuildTime returns the time the database was built.
+func (d *geoDatabase) BuildTime() time.Time {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return time.Unix(int64(d.reader.Metadata().BuildEpoch), 0).UTC()
+}
+
+func (d *geoDatabase) ASN(ip net.IP) (*geoip2.ASN, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.reader.ASN(ip)
+}
+
+func (d *geoDatabase) City(ip net.IP) (*geoip2.City, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.reader.City(ip)
+}
+
+func (d *geoDatabase) Country(ip net.IP) (*geoip2.Country, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.reader.Country(ip)
+}
+
+// validateGeoType returns a validator that checks if the database type
+// contains any of the given types.
+func validateGeoType(types ...string) func(*geoip2.Reader) error {
+	return func(r *geoip2.Reader) error {
+		dbType := r.Metadata().DatabaseType
+		for _, t := range types {
+			if strings.Contains(dbType, t) {
+				return nil
+			}
+		}
+		return fmt.Errorf("unexpected database type %q", dbType)
+	}
+}
@@ -0,0 +1,74 @@
+package backend
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testGeoReplace replaces filename with the contents of src, and moves the
+// modification time forward so the change is always noticed.
+func testGeoReplace(t *testing.T, filename, src string) {
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	temp := filename + ".tmp"
+	if err = ioutil.WriteFile(temp, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Hour)
+	if err = os.Chtimes(temp, future, future); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Rename(temp, filename); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGeoBackendReload(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	updated := writeMMDB(t, "GeoIP2-City", map[string]interface{}{
+		"81.2.69.0/24": testGeoCountry("EU", "SE"),
+	})
+	defer os.RemoveAll(filepath.Dir(updated))
+	asn := writeMMDB(t, "GeoLite2-ASN", map[string]interface{}{})
+	defer os.RemoveAll(filepath.Dir(asn))
+	bogus := filepath.Join(filepath.Dir(updated), "bogus.mmdb")
+	if err := ioutil.WriteFile(bogus, []byte("not a database"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	b.Options.Database = filename
+	b.Options.Reload = time.Nanosecond
+	b.Options.Answers.Country = map[string]*RecordSet{
+		"gb": testGeoRecords("10.0.0.4"),
+		"se": testGeoRecords("10.0.0.5"),
+	}
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+	defer b.close()
+
+	if got := testGeoQuery(t, b, "81.2.69.160"); got != "10.0.0.4" {
+		t.Fatalf("got %q, want %q", got, "10.0.0.4")
+	}
+
+	// Invalid databases are not swapped in
+	for _, src := range []string{bogus, asn} {
+		testGeoReplace(t, filename, src)
+		if got := testGeoQuery(t, b, "81.2.69.160"); got != "10.0.0.4" {
+			t.Errorf("%s: got %q, want %q from previous database", filepath.Base(src), got, "10.0.0.4")
+		}
+	}
+
+	testGeoReplace(t, filename, updated)
+	if got := testGeoQuery(t, b, "81.2.69.160"); got != "10.0.0.5" {
+		t.Errorf("got %q, want %q from updated database", got, "10.0.0.5")
+	}
+}
@@ -0,0 +1,102 @@
+package backend
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Mean earth radius in kilometers
+const earthRadius = 6371.0
+
+// GeoPoP is a point of presence, with the records to hand out to clients
+// that are near.
+type GeoPoP struct {
+	Latitude  float64 `yaml:"latitude,omitempty"`
+	Longitude float64 `yaml:"longitude,omitempty"`
+	RecordSet `yaml:",inline"`
+}
+
+// plainRecordSet is a RecordSet without its YAML unmarshaler.
+type plainRecordSet RecordSet
+
+func (p *GeoPoP) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	// The unmarshaler of the embedded record set would decode the whole PoP
+	var pop struct {
+		Latitude  float64        `yaml:"latitude"`
+		Longitude float64        `yaml:"longitude"`
+		RecordSet plainRecordSet `yaml:",inline"`
+	}
+	if err := unmarshal(&pop); err != nil {
+		return err
+	}
+	p.Latitude, p.Longitude = pop.Latitude, pop.Longitude
+	p.RecordSet = RecordSet(pop.RecordSet)
+	return nil
+}
+
+func (p *GeoPoP) check(name string) error {
+	if p.Latitude < -90 || p.Latitude > 90 {
+		return fmt.Errorf("PoP %q latitude %f out of range", name, p.Latitude)
+	}
+	if p.Longitude < -180 || p.Longitude > 180 {
+		return fmt.Errorf("PoP %q longitude %f out of range", name, p.Longitude)
+	}
+	return nil
+}
+
+// distance returns the great-circle distance in kilometers, using the
+// haversine formula.
+func distance(lat1, lon1, lat2, lon2 float64) float64 {
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLon := (lon2 - lon1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// nearest returns the names of the count nearest available PoPs, closest
+// first.
+func (b *GeoBackend) nearest(l *geoLocation, count int) []string {
+	names := make([]string, 0, len(b.Options.PoPs))
+	dists := map[string]float64{}
+	for name, pop := range b.Options.PoPs {
+		if !pop.Available() {
+			continue
+		}
+		names = append(names, name)
+		dists[name] = distance(l.Latitude, l.Longitude, pop.Latitude, pop.Longitude)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if dists[names[i]] != dists[names[j]] {
+			return dists[names[i]] < dists[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	if count > 0 && count < len(names) {
+		names = names[:count]
+	}
+	return names
+}
+
+// matchNearest returns the record sets of the nearest PoPs, or the fallback
+// PoPs if the client location is unknown.
+func (b *GeoBackend) matchNearest(l *geoLocation) (sets []*RecordSet) {
+	if len(b.Options.PoPs) == 0 {
+		return nil
+	}
+
+	var names []string
+	if l.Latitude == 0 && l.Longitude == 0 {
+		names = b.Options.Nearest.Fallback
+	} else {
+		names = b.nearest(l, b.Options.Nearest.Count)
+	}
+	for _, name := range names {
+		if pop := b.Options.PoPs[name]; pop.Available() {
+			sets = append(sets, &pop.RecordSet)
+		}
+	}
+	return
+}
@@ -0,0 +1,476 @@
+package backend
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/tehmaze-labs/dns/message"
+	"gopkg.in/yaml.v2"
+)
+
+func testGeoCity(name string, id uint32) map[string]interface{} {
+	return map[string]interface{}{
+		"geoname_id": id,
+		"names":      map[string]interface{}{"en": name},
+	}
+}
+
+func testGeoCountry(continent, country string) map[string]interface{} {
+	return map[string]interface{}{
+		"continent": map[string]interface{}{"code": continent},
+		"country":   map[string]interface{}{"iso_code": country, "names": map[string]interface{}{"en": country}},
+	}
+}
+
+// testGeoDatabase writes a GeoIP2 City fixture database.
+func testGeoDatabase(t *testing.T) string {
+	milton := testGeoCountry("NA", "US")
+	milton["city"] = testGeoCity("Milton", 5803556)
+	milton["subdivisions"] = []interface{}{map[string]interface{}{"iso_code": "WA"}}
+	milton["location"] = map[string]interface{}{"latitude": 47.2513, "longitude": -122.3149, "metro_code": uint16(819)}
+
+	boston := testGeoCountry("NA", "US")
+	boston["city"] = testGeoCity("Boston", 4930956)
+	boston["subdivisions"] = []interface{}{map[string]interface{}{"iso_code": "MA"}}
+	boston["location"] = map[string]interface{}{"latitude": 42.3562, "longitude": -71.0631, "metro_code": uint16(506)}
+
+	london := testGeoCountry("EU", "GB")
+	london["city"] = testGeoCity("London", 2643743)
+	london["subdivisions"] = []interface{}{map[string]interface{}{"iso_code": "ENG"}}
+	london["location"] = map[string]interface{}{"latitude": 51.5142, "longitude": -0.0931}
+
+	linkoping := testGeoCountry("EU", "SE")
+	linkoping["city"] = testGeoCity("Linköping", 2694762)
+	linkoping["subdivisions"] = []interface{}{map[string]interface{}{"iso_code": "E"}}
+	linkoping["location"] = map[string]interface{}{"latitude": 58.4167, "longitude": 15.6167}
+
+	sydney := testGeoCountry("OC", "AU")
+	sydney["city"] = testGeoCity("Sydney", 2147714)
+	sydney["subdivisions"] = []interface{}{map[string]interface{}{"iso_code": "NSW"}}
+	sydney["location"] = map[string]interface{}{"latitude": -33.8591, "longitude": 151.2002}
+
+	return writeMMDB(t, "GeoIP2-City", map[string]interface{}{
+		"216.160.83.0/24": milton,
+		"128.197.0.0/16":  boston,
+		"81.2.69.0/24":    london,
+		"89.160.20.0/24":  linkoping,
+		"1.0.0.0/24":      sydney,
+		"175.16.199.0/24": testGeoCountry("AS", "CN"),
+		"2001:480::/32":   testGeoCountry("NA", "US"),
+		"67.43.156.0/24": map[string]interface{}{
+			"continent": map[string]interface{}{"code": "AS"},
+		},
+	})
+}
+
+func testGeoRecords(content string) *RecordSet {
+	return &RecordSet{Records: []*Record{{Type: "A", TTL: 60, Content: content}}}
+}
+
+func testGeoQuery(t *testing.T, b *GeoBackend, remote string) string {
+	r, err := b.Query(&message.Message{
+		Name:       []byte("cdn.maze.io"),
+		Class:      dns.ClassINET,
+		Type:       dns.TypeA,
+		ID:         []byte("-1"),
+		RemoteAddr: net.ParseIP(remote),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r) == 0 {
+		return ""
+	}
+	return string(r[0].Content)
+}
+
+func TestGeoBackendCity(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	b.Options.Database = filename
+	b.Options.Answers.City = map[string]*RecordSet{"Milton": testGeoRecords("10.0.0.1")}
+	b.Options.Answers.Subdivision = map[string]*RecordSet{"us-ma": testGeoRecords("10.0.0.2")}
+	b.Options.Answers.Metro = map[string]*RecordSet{"819": testGeoRecords("10.0.0.3")}
+	b.Options.Answers.Country = map[string]*RecordSet{"gb": testGeoRecords("10.0.0.4")}
+	b.Options.Answers.Continent = map[string]*RecordSet{
+		"eu": testGeoRecords("10.0.0.5"),
+		"na": testGeoRecords("10.0.0.6"),
+	}
+	b.Options.Default.Continent = "na"
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"216.160.83.56":  "10.0.0.1",
+		"128.197.1.1":    "10.0.0.2",
+		"81.2.69.160":    "10.0.0.4",
+		"89.160.20.112":  "10.0.0.5",
+		"2001:480::1":    "10.0.0.6",
+		"1.0.0.1":        "10.0.0.6",
+		"192.168.10.100": "10.0.0.6",
+	}
+	for test, want := range tests {
+		if got := testGeoQuery(t, b, test); got != want {
+			t.Errorf("got %q, want %q for %s", got, want, test)
+		} else {
+			t.Logf("test %s resolved to %q", test, got)
+		}
+	}
+}
+
+func TestGeoBackendOrder(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	b.Options.Database = filename
+	b.Options.Order = []string{"metro", "continent", "city"}
+	b.Options.Answers.City = map[string]*RecordSet{"milton": testGeoRecords("10.0.0.1")}
+	b.Options.Answers.Metro = map[string]*RecordSet{"819": testGeoRecords("10.0.0.3")}
+	b.Options.Answers.Continent = map[string]*RecordSet{"na": testGeoRecords("10.0.0.6")}
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"216.160.83.56": "10.0.0.3",
+		"128.197.1.1":   "10.0.0.6",
+	}
+	for test, want := range tests {
+		if got := testGeoQuery(t, b, test); got != want {
+			t.Errorf("got %q, want %q for %s", got, want, test)
+		}
+	}
+
+	b.Options.Order = []string{"planet"}
+	if err := b.Check(); err == nil {
+		t.Error("expected error for unknown rule")
+	}
+}
+
+func TestGeoBackendNearest(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	b.Options.Database = filename
+	b.Options.PoPs = map[string]*GeoPoP{
+		"ams": {Latitude: 52.3702, Longitude: 4.8952, RecordSet: *testGeoRecords("10.1.0.1")},
+		"nyc": {Latitude: 40.7128, Longitude: -74.0060, RecordSet: *testGeoRecords("10.1.0.2")},
+		"sea": {Latitude: 47.6062, Longitude: -122.3321, RecordSet: *testGeoRecords("10.1.0.3")},
+		"syd": {Latitude: -33.8688, Longitude: 151.2093, RecordSet: *testGeoRecords("10.1.0.4")},
+	}
+	b.Options.Nearest.Fallback = []string{"nyc"}
+	b.Options.Answers.Country = map[string]*RecordSet{"se": testGeoRecords("10.0.0.4")}
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"216.160.83.56": "10.1.0.3",
+		"128.197.1.1":   "10.1.0.2",
+		"81.2.69.160":   "10.1.0.1",
+		"89.160.20.112": "10.0.0.4",
+		"1.0.0.1":       "10.1.0.4",
+		"2001:480::1":   "10.1.0.2",
+	}
+	for test, want := range tests {
+		if got := testGeoQuery(t, b, test); got != want {
+			t.Errorf("got %q, want %q for %s", got, want, test)
+		} else {
+			t.Logf("test %s resolved to %q", test, got)
+		}
+	}
+
+	// Only the nearest PoP is returned by default
+	r, err := b.Query(&message.Message{
+		Name:       []byte("cdn.maze.io"),
+		Class:      dns.ClassINET,
+		Type:       dns.TypeA,
+		ID:         []byte("-1"),
+		RemoteAddr: net.ParseIP("81.2.69.160"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(r) != 1 {
+		t.Errorf("got %d answers, want 1 with the default count", len(r))
+	}
+
+	b.Options.Nearest.Count = 2
+	l, err := b.lookup(net.ParseIP("81.2.69.160"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := b.nearest(l, 2); len(got) != 2 || got[0] != "ams" || got[1] != "nyc" {
+		t.Errorf("got %v, want [ams nyc]", got)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	// Amsterdam to New York is about 5860 km
+	if d := distance(52.3702, 4.8952, 40.7128, -74.0060); d < 5800 || d > 5900 {
+		t.Errorf("got %f km, want about 5860 km", d)
+	}
+	if d := distance(10, 10, 10, 10); d != 0 {
+		t.Errorf("got %f km, want 0", d)
+	}
+}
+
+func TestGeoPoPConfig(t *testing.T) {
+	var pops map[string]*GeoPoP
+	config := "ams:\n  latitude: 52.3702\n  longitude: 4.8952\n  select: one\n  records:\n  - {type: A, content: 10.1.0.1}\n"
+	if err := yaml.UnmarshalStrict([]byte(config), &pops); err != nil {
+		t.Fatal(err)
+	}
+	p := pops["ams"]
+	if p.Latitude != 52.3702 || p.Longitude != 4.8952 {
+		t.Errorf("got %f,%f, want 52.3702,4.8952", p.Latitude, p.Longitude)
+	}
+	if p.Select != SelectOne || len(p.Records) != 1 || p.Records[0].Content != "10.1.0.1" {
+		t.Errorf("unexpected records %+v", p.RecordSet)
+	}
+	if err := yaml.UnmarshalStrict([]byte("ams: {latitude: 1, bogus: 2}"), &pops); err == nil {
+		t.Error("expected error for unknown field")
+	}
+}
+
+func TestGeoBackendNetworks(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+	asnFilename := writeMMDB(t, "GeoLite2-ASN", map[string]interface{}{
+		"81.2.69.0/24": map[string]interface{}{
+			"autonomous_system_number":       uint32(20712),
+			"autonomous_system_organization": "Andrews & Arnold Ltd",
+		},
+		"89.160.0.0/16": map[string]interface{}{
+			"autonomous_system_number":       uint32(29518),
+			"autonomous_system_organization": "Bredband2 AB",
+		},
+	})
+	defer os.RemoveAll(filepath.Dir(asnFilename))
+
+	b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	b.Options.Database = filename
+	b.Options.ASNDatabase = asnFilename
+	b.Options.Answers.Networks = map[string]*RecordSet{
+		"89.160.20.0/24":   testGeoRecords("10.2.0.1"),
+		"89.160.20.112/32": testGeoRecords("10.2.0.2"),
+		"2001:480::/48":    testGeoRecords("10.2.0.3"),
+	}
+	b.Options.Answers.ASN = map[string]*RecordSet{
+		"AS20712": testGeoRecords("10.2.0.4"),
+		"29518":   testGeoRecords("10.2.0.5"),
+	}
+	b.Options.Answers.Country = map[string]*RecordSet{
+		"gb": testGeoRecords("10.0.0.4"),
+		"se": testGeoRecords("10.0.0.5"),
+	}
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"89.160.20.112": "10.2.0.2",
+		"89.160.20.1":   "10.2.0.1",
+		"89.160.21.1":   "10.2.0.5",
+		"81.2.69.160":   "10.2.0.4",
+		"2001:480::1":   "10.2.0.3",
+		"2001:480:1::1": "",
+	}
+	for test, want := range tests {
+		if got := testGeoQuery(t, b, test); got != want {
+			t.Errorf("got %q, want %q for %s", got, want, test)
+		} else {
+			t.Logf("test %s resolved to %q", test, got)
+		}
+	}
+
+	b.Options.Answers.Networks = map[string]*RecordSet{"89.160.20.0/33": testGeoRecords("10.2.0.1")}
+	if err := b.Check(); err == nil {
+		t.Error("expected error for invalid network")
+	}
+}
+
+func TestGeoBackendDebug(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	b.Options.Database = filename
+	b.Options.Answers.Country = map[string]*RecordSet{"gb": testGeoRecords("10.0.0.4")}
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	query := func(name string, qtype uint16) []*message.Message {
+		r, err := b.Query(&message.Message{
+			Name:       []byte(name),
+			Class:      dns.ClassINET,
+			Type:       qtype,
+			ID:         []byte("-1"),
+			RemoteAddr: net.ParseIP("81.2.69.160"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	// Disabled by default, and never at the apex
+	for _, name := range []string{"cdn.maze.io", "_geo.cdn.maze.io"} {
+		for _, r := range query(name, dns.TypeANY) {
+			if r.Type == dns.TypeTXT {
+				t.Errorf("%s: unexpected TXT record %q", name, r.Content)
+			}
+		}
+	}
+
+	b.Options.Debug = "_GEO."
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+	if r := query("cdn.maze.io", dns.TypeTXT); len(r) != 0 {
+		t.Errorf("got %d records at apex, want none", len(r))
+	}
+	if r := query("_geo.cdn.maze.io", dns.TypeA); len(r) != 0 {
+		t.Errorf("got %d A records for debug name, want none", len(r))
+	}
+
+	r := query("_geo.cdn.maze.io", dns.TypeTXT)
+	want := `"dns geo result for 81.2.69.160 in GB (EU), rule country, database GeoIP2-City built 2015-05-05"`
+	if len(r) != 1 || string(r[0].Content) != want {
+		t.Errorf("got %v, want %s", r, want)
+	} else {
+		t.Logf("debug: %s", r[0].Content)
+	}
+}
+
+func TestGeoBackendFallback(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	b.Options.Database = filename
+	b.Options.Answers.Country = map[string]*RecordSet{"gb": testGeoRecords("10.0.0.4")}
+	b.Options.Answers.Continent = map[string]*RecordSet{
+		"an": testGeoRecords("10.0.0.5"),
+		"as": testGeoRecords("10.0.0.6"),
+		"eu": testGeoRecords("10.0.0.7"),
+	}
+	b.Options.Answers.Unknown = testGeoRecords("10.0.0.8")
+	b.Options.Answers.Default = testGeoRecords("10.0.0.9")
+	b.Options.Default.Continent = "eu"
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"81.2.69.160":    "10.0.0.4", // country
+		"89.160.20.112":  "10.0.0.7", // continent
+		"175.16.199.1":   "10.0.0.6", // continent
+		"67.43.156.1":    "10.0.0.6", // continent without country
+		"192.168.10.100": "10.0.0.8", // unknown
+		"1.0.0.1":        "10.0.0.7", // default continent
+	}
+	for test, want := range tests {
+		if got := testGeoQuery(t, b, test); got != want {
+			t.Errorf("got %q, want %q for %s", got, want, test)
+		} else {
+			t.Logf("test %s resolved to %q", test, got)
+		}
+	}
+
+	b.Options.Default.Continent = ""
+	b.Options.Order = nil
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+	if got := testGeoQuery(t, b, "1.0.0.1"); got != "10.0.0.9" {
+		t.Errorf("got %q, want default %q", got, "10.0.0.9")
+	}
+
+	b.Options.Answers.Unknown = nil
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+	if got := testGeoQuery(t, b, "192.168.10.100"); got != "10.0.0.9" {
+		t.Errorf("got %q, want default %q", got, "10.0.0.9")
+	}
+}
+
+func TestGeoBackendValidation(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	tests := []func(b *GeoBackend){
+		func(b *GeoBackend) { b.Options.Answers.Continent = map[string]*RecordSet{"eu": nil, "xy": nil} },
+		func(b *GeoBackend) { b.Options.Answers.Country = map[string]*RecordSet{"uk": nil} },
+		func(b *GeoBackend) { b.Options.Answers.Subdivision = map[string]*RecordSet{"WA": nil} },
+		func(b *GeoBackend) { b.Options.Answers.Subdivision = map[string]*RecordSet{"XX-WA": nil} },
+		func(b *GeoBackend) { b.Options.Default.Continent = "XX" },
+		func(b *GeoBackend) { b.Options.Default.Country = "EU" },
+		func(b *GeoBackend) { b.Options.Answers.Default = &RecordSet{Select: "bogus"} },
+	}
+	for i, test := range tests {
+		b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+		b.Options.Database = filename
+		test(b)
+		if err := b.Check(); err == nil {
+			t.Errorf("test %d: expected error", i)
+		} else {
+			t.Logf("test %d: %v", i, err)
+		}
+	}
+
+	b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	b.Options.Database = filename
+	b.Options.Answers.Continent = map[string]*RecordSet{"an": nil}
+	b.Options.Answers.Country = map[string]*RecordSet{"aq": nil, "xk": nil}
+	b.Options.Answers.Subdivision = map[string]*RecordSet{"us-wa": nil}
+	if err := b.Check(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGeoBackendACL(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	b.Options.Database = filename
+	b.Options.Answers.Default = testGeoRecords("10.0.0.9")
+	b.ACL = &ACL{Deny: []string{"81.2.69.0/24"}, Action: "decoy", Decoy: testGeoRecords("192.0.2.1").Records}
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"81.2.69.160":   "192.0.2.1",
+		"89.160.20.112": "10.0.0.9",
+	}
+	for test, want := range tests {
+		if got := testGeoQuery(t, b, test); got != want {
+			t.Errorf("got %q, want %q for %s", got, want, test)
+		}
+	}
+
+	b.ACL.Action = "refused"
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+	_, err := b.Query(&message.Message{
+		Name:       []byte("cdn.maze.io"),
+		Class:      dns.ClassINET,
+		Type:       dns.TypeA,
+		RemoteAddr: net.ParseIP("81.2.69.160"),
+	})
+	if err != ErrRefused {
+		t.Errorf("got %v, want %v", err, ErrRefused)
+	}
+}
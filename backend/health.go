@@ -0,0 +1,242 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Health check defaults
+const (
+	DefaultHealthInterval = 10 * time.Second
+	DefaultHealthTimeout  = 2 * time.Second
+)
+
+// HealthCheck is a health check for a record. Exactly one of TCP, HTTP or
+// Exec must be set:
+//
+//	check:
+//	  http: http://192.0.2.1/health
+//	  expect: 200
+//	  interval: 10s
+//	  timeout: 2s
+//
+// Records are assumed to be healthy until a check fails.
+type HealthCheck struct {
+	TCP      string        `yaml:"tcp,omitempty"`
+	HTTP     string        `yaml:"http,omitempty"`
+	Exec     string        `yaml:"exec,omitempty"`
+	Expect   int           `yaml:"expect,omitempty"`
+	Interval time.Duration `yaml:"interval,omitempty"`
+	Timeout  time.Duration `yaml:"timeout,omitempty"`
+
+	down    int32
+	mu      sync.Mutex
+	since   time.Time
+	lastErr error
+}
+
+func (c *HealthCheck) check() error {
+	var kinds int
+	for _, v := range []string{c.TCP, c.HTTP, c.Exec} {
+		if v != "" {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return errors.New("Health check needs exactly one of tcp, http or exec")
+	}
+	if c.TCP != "" {
+		if _, _, err := net.SplitHostPort(c.TCP); err != nil {
+			return fmt.Errorf("Invalid tcp health check address %q: %v", c.TCP, err)
+		}
+	}
+	if c.Exec != "" && len(strings.Fields(c.Exec)) == 0 {
+		return errors.New("Empty exec health check command")
+	}
+	if c.HTTP != "" && !strings.HasPrefix(c.HTTP, "http://") && !strings.HasPrefix(c.HTTP, "https://") {
+		return fmt.Errorf("Invalid http health check URL %q", c.HTTP)
+	}
+	if c.Expect == 0 {
+		c.Expect = http.StatusOK
+	}
+	if c.Interval <= 0 {
+		c.Interval = DefaultHealthInterval
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultHealthTimeout
+	}
+	return nil
+}
+
+func (c *HealthCheck) String() string {
+	switch {
+	case c.TCP != "":
+		return "tcp:" + c.TCP
+	case c.HTTP != "":
+		return "http:" + c.HTTP
+	default:
+		return "exec:" + c.Exec
+	}
+}
+
+// key identifies the check, checks with the same key are identical.
+func (c *HealthCheck) key() string {
+	return fmt.Sprintf("%s expect=%d interval=%s timeout=%s", c, c.Expect, c.Interval, c.Timeout)
+}
+
+// Healthy reports if the last check succeeded.
+func (c *HealthCheck) Healthy() bool {
+	return c == nil || atomic.LoadInt32(&c.down) == 0
+}
+
+// Status returns a one line description of the health state.
+func (c *HealthCheck) Status() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state := "up"
+	if !c.Healthy() {
+		state = "down"
+	}
+	if c.since.IsZero() {
+		return state
+	}
+	status := fmt.Sprintf("%s since %s", state, c.since.Format(time.RFC3339))
+	if c.lastErr != nil {
+		status += fmt.Sprintf(" (%v)", c.lastErr)
+	}
+	return status
+}
+
+// run performs the check once and updates the health state.
+func (c *HealthCheck) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+	defer cancel()
+
+	var err error
+	switch {
+	case c.TCP != "":
+		err = c.runTCP(ctx)
+	case c.HTTP != "":
+		err = c.runHTTP(ctx)
+	default:
+		err = c.runExec(ctx)
+	}
+
+	var down int32
+	if err != nil {
+		down = 1
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if atomic.SwapInt32(&c.down, down) != down || c.since.IsZero() {
+		c.since = time.Now()
+	}
+	c.lastErr = err
+}
+
+func (c *HealthCheck) runTCP(ctx context.Context) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", c.TCP)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (c *HealthCheck) runHTTP(ctx context.Context) error {
+	req, err := http.NewRequest("GET", c.HTTP, nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	if res.StatusCode != c.Expect {
+		return fmt.Errorf("status %d, expected %d", res.StatusCode, c.Expect)
+	}
+	return nil
+}
+
+func (c *HealthCheck) runExec(ctx context.Context) error {
+	args := strings.Fields(c.Exec)
+	return exec.CommandContext(ctx, args[0], args[1:]...).Run()
+}
+
+// healthMonitor runs the health checks of a backend in the background.
+// Identical checks are shared between records.
+type healthMonitor struct {
+	checks map[string]*HealthCheck
+	done   chan struct{}
+}
+
+func newHealthMonitor() *healthMonitor {
+	return &healthMonitor{
+		checks: map[string]*HealthCheck{},
+		done:   make(chan struct{}),
+	}
+}
+
+// add registers the health checks of a record set.
+func (m *healthMonitor) add(s *RecordSet) error {
+	for _, r := range s.Records {
+		if r.Check == nil {
+			continue
+		}
+		if err := r.Check.check(); err != nil {
+			return fmt.Errorf("%s %s: %v", r.Type, r.Content, err)
+		}
+		key := r.Check.key()
+		if c, found := m.checks[key]; found {
+			r.Check = c
+		} else {
+			m.checks[key] = r.Check
+		}
+	}
+	return nil
+}
+
+// Start runs all checks once, and then at their interval until Stop is
+// called.
+func (m *healthMonitor) Start() {
+	for _, c := range m.checks {
+		go func(c *HealthCheck) {
+			t := time.NewTicker(c.Interval)
+			defer t.Stop()
+			for {
+				c.run()
+				select {
+				case <-m.done:
+					return
+				case <-t.C:
+				}
+			}
+		}(c)
+	}
+}
+
+func (m *healthMonitor) Stop() {
+	close(m.done)
+}
+
+// Status returns the state of all checks, sorted by check.
+func (m *healthMonitor) Status() []string {
+	var lines []string
+	for _, c := range m.checks {
+		lines = append(lines, c.String()+" "+c.Status())
+	}
+	sort.Strings(lines)
+	return lines
+}
@@ -0,0 +1,184 @@
+package backend
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHealthCheck(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed.Close()
+
+	var status int32 = http.StatusOK
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer s.Close()
+
+	tests := []struct {
+		Check   *HealthCheck
+		Healthy bool
+	}{
+		{&HealthCheck{TCP: l.Addr().String()}, true},
+		{&HealthCheck{TCP: closed.Addr().String()}, false},
+		{&HealthCheck{HTTP: s.URL}, true},
+		{&HealthCheck{HTTP: s.URL, Expect: http.StatusNoContent}, false},
+		{&HealthCheck{Exec: "true"}, true},
+		{&HealthCheck{Exec: "false"}, false},
+		{&HealthCheck{Exec: "sleep 5", Timeout: 10 * time.Millisecond}, false},
+	}
+	for _, test := range tests {
+		if err := test.Check.check(); err != nil {
+			t.Fatal(err)
+		}
+		if !test.Check.Healthy() {
+			t.Errorf("%s: expected healthy before first check", test.Check)
+		}
+		test.Check.run()
+		if got := test.Check.Healthy(); got != test.Healthy {
+			t.Errorf("%s: got healthy %t, want %t (%s)", test.Check, got, test.Healthy, test.Check.Status())
+		} else {
+			t.Logf("%s: %s", test.Check, test.Check.Status())
+		}
+	}
+
+	c := &HealthCheck{HTTP: s.URL}
+	c.check()
+	c.run()
+	atomic.StoreInt32(&status, http.StatusServiceUnavailable)
+	c.run()
+	if c.Healthy() || !strings.HasPrefix(c.Status(), "down since ") {
+		t.Errorf("got %q, want down", c.Status())
+	}
+}
+
+func TestHealthCheckConfig(t *testing.T) {
+	tests := []*HealthCheck{
+		{},
+		{TCP: "127.0.0.1:80", Exec: "true"},
+		{TCP: "127.0.0.1"},
+		{HTTP: "ftp://127.0.0.1/"},
+		{Exec: " "},
+	}
+	for _, test := range tests {
+		if err := test.check(); err == nil {
+			t.Errorf("expected error for %+v", test)
+		}
+	}
+
+	c := &HealthCheck{TCP: "127.0.0.1:80"}
+	if err := c.check(); err != nil {
+		t.Fatal(err)
+	}
+	if c.Interval != DefaultHealthInterval || c.Timeout != DefaultHealthTimeout {
+		t.Errorf("got interval %s, timeout %s", c.Interval, c.Timeout)
+	}
+
+	// Checks are only shared if all their options are the same
+	m := newHealthMonitor()
+	set := &RecordSet{Records: []*Record{
+		{Type: "A", Content: "192.0.2.1", Check: &HealthCheck{HTTP: "http://192.0.2.1/"}},
+		{Type: "A", Content: "192.0.2.2", Check: &HealthCheck{HTTP: "http://192.0.2.1/", Expect: 200}},
+		{Type: "A", Content: "192.0.2.3", Check: &HealthCheck{HTTP: "http://192.0.2.1/", Expect: 204}},
+		{Type: "A", Content: "192.0.2.4", Check: &HealthCheck{HTTP: "http://192.0.2.1/", Interval: time.Minute}},
+	}}
+	if err := m.add(set); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(m.checks); n != 3 {
+		t.Errorf("got %d checks, want 3", n)
+	}
+}
+
+func TestGeoBackendHealth(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	var status int32 = http.StatusOK
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer s.Close()
+
+	checked := func(content string) *RecordSet {
+		set := testGeoRecords(content)
+		set.Records[0].Check = &HealthCheck{HTTP: s.URL, Interval: time.Hour}
+		return set
+	}
+
+	b := &GeoBackend{Zones: []string{"cdn.maze.io"}}
+	b.Options.Database = filename
+	b.Options.Answers.Country = map[string]*RecordSet{"gb": checked("10.0.0.4")}
+	b.Options.Answers.Continent = map[string]*RecordSet{"eu": testGeoRecords("10.0.0.5")}
+	b.Options.PoPs = map[string]*GeoPoP{
+		"ams": {Latitude: 52.3702, Longitude: 4.8952, RecordSet: *checked("10.1.0.1")},
+		"nyc": {Latitude: 40.7128, Longitude: -74.0060, RecordSet: *testGeoRecords("10.1.0.2")},
+	}
+	if err := b.Check(); err != nil {
+		t.Fatal(err)
+	}
+	defer b.health.Stop()
+
+	if n := len(b.health.checks); n != 1 {
+		t.Errorf("got %d checks, want 1 shared check", n)
+	}
+	run := func() {
+		for _, c := range b.health.checks {
+			c.run()
+		}
+	}
+
+	run()
+	tests := map[string]string{
+		"81.2.69.160":   "10.0.0.4",
+		"89.160.20.112": "10.1.0.1",
+	}
+	for test, want := range tests {
+		if got := testGeoQuery(t, b, test); got != want {
+			t.Errorf("got %q, want %q for %s", got, want, test)
+		}
+	}
+
+	atomic.StoreInt32(&status, http.StatusServiceUnavailable)
+	run()
+	tests = map[string]string{
+		"81.2.69.160":   "10.1.0.2",
+		"89.160.20.112": "10.1.0.2",
+	}
+	for test, want := range tests {
+		if got := testGeoQuery(t, b, test); got != want {
+			t.Errorf("got %q, want %q for %s", got, want, test)
+		}
+	}
+
+	b.Options.Order = []string{"country", "continent"}
+	if got := testGeoQuery(t, b, "81.2.69.160"); got != "10.0.0.5" {
+		t.Errorf("got %q, want %q", got, "10.0.0.5")
+	}
+
+	lines, err := b.Command("health", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 1 || !strings.HasPrefix(lines[0], "cdn.maze.io http:"+s.URL+" down since ") {
+		t.Errorf("got %q", lines)
+	}
+	if _, err := b.Command("bogus", nil); err != ErrUnknownCommand {
+		t.Errorf("got %v, want %v", err, ErrUnknownCommand)
+	}
+}
@@ -0,0 +1,221 @@
+package backend
+
+// Minimal MaxMind DB writer for test fixtures, see
+// https://maxmind.github.io/MaxMind-DB/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+)
+
+type mmdbData struct {
+	value interface{}
+}
+
+type mmdbNode struct {
+	child [2]*mmdbNode
+	data  *mmdbData
+	index int
+}
+
+func (n *mmdbNode) insert(ip net.IP, ones int, data *mmdbData) {
+	node := n
+	for i := 0; i < ones; i++ {
+		if node.data != nil {
+			// Split a larger network
+			node.child[0] = &mmdbNode{data: node.data}
+			node.child[1] = &mmdbNode{data: node.data}
+			node.data = nil
+		}
+		bit := (ip[i/8] >> uint(7-i%8)) & 1
+		if node.child[bit] == nil {
+			node.child[bit] = &mmdbNode{}
+		}
+		node = node.child[bit]
+	}
+	node.child = [2]*mmdbNode{}
+	node.data = data
+}
+
+// writeMMDB writes an IPv6 database with the given networks to a temporary
+// file; IPv4 networks are stored in the IPv4 compatible ::/96 subtree.
+func writeMMDB(t *testing.T, dbType string, networks map[string]interface{}) string {
+	type network struct {
+		ip   net.IP
+		ones int
+		data *mmdbData
+	}
+	var nets []network
+	for cidr, value := range networks {
+		ip, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ones, _ := ipnet.Mask.Size()
+		if ip.To4() != nil {
+			ip = append(make(net.IP, 12), ipnet.IP.To4()...)
+			ones += 96
+		} else {
+			ip = ipnet.IP
+		}
+		nets = append(nets, network{ip, ones, &mmdbData{value}})
+	}
+	sort.Slice(nets, func(i, j int) bool { return nets[i].ones < nets[j].ones })
+
+	root := &mmdbNode{}
+	for _, n := range nets {
+		root.insert(n.ip, n.ones, n.data)
+	}
+
+	// Number the nodes breadth first
+	var nodes []*mmdbNode
+	for queue := []*mmdbNode{root}; len(queue) > 0; queue = queue[1:] {
+		n := queue[0]
+		n.index = len(nodes)
+		nodes = append(nodes, n)
+		for _, c := range n.child {
+			if c != nil && c.data == nil {
+				queue = append(queue, c)
+			}
+		}
+	}
+
+	// Data section
+	var data bytes.Buffer
+	offsets := map[*mmdbData]int{}
+	for _, n := range nets {
+		offsets[n.data] = data.Len()
+		mmdbEncode(&data, n.data.value)
+	}
+
+	var out bytes.Buffer
+	count := uint32(len(nodes))
+	for _, n := range nodes {
+		for _, c := range n.child {
+			var record uint32
+			switch {
+			case c == nil:
+				record = count
+			case c.data != nil:
+				record = count + 16 + uint32(offsets[c.data])
+			default:
+				record = uint32(c.index)
+			}
+			binary.Write(&out, binary.BigEndian, record)
+		}
+	}
+	out.Write(make([]byte, 16))
+	out.Write(data.Bytes())
+	out.WriteString("\xab\xcd\xefMaxMind.com")
+	mmdbEncode(&out, map[string]interface{}{
+		"node_count":                  count,
+		"record_size":                 uint16(32),
+		"ip_version":                  uint16(6),
+		"database_type":               dbType,
+		"languages":                   []interface{}{"en"},
+		"binary_format_major_version": uint16(2),
+		"binary_format_minor_version": uint16(0),
+		"build_epoch":                 uint64(time.Date(2015, 5, 5, 0, 0, 0, 0, time.UTC).Unix()),
+		"description":                 map[string]interface{}{"en": "dns test database"},
+	})
+
+	dir, err := ioutil.TempDir("", "mmdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, dbType+".mmdb")
+	if err = ioutil.WriteFile(filename, out.Bytes(), 0644); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func mmdbControl(w *bytes.Buffer, kind int, size int) {
+	var first byte
+	if kind <= 7 {
+		first = byte(kind << 5)
+	}
+
+	var extra []byte
+	switch {
+	case size < 29:
+		first |= byte(size)
+	case size < 29+256:
+		first |= 29
+		extra = []byte{byte(size - 29)}
+	case size < 285+65536:
+		first |= 30
+		extra = []byte{byte((size - 285) >> 8), byte(size - 285)}
+	default:
+		first |= 31
+		size -= 65821
+		extra = []byte{byte(size >> 16), byte(size >> 8), byte(size)}
+	}
+
+	w.WriteByte(first)
+	if kind > 7 {
+		w.WriteByte(byte(kind - 7))
+	}
+	w.Write(extra)
+}
+
+func mmdbUint(w *bytes.Buffer, kind int, v uint64) {
+	var b []byte
+	for ; v > 0; v >>= 8 {
+		b = append([]byte{byte(v)}, b...)
+	}
+	mmdbControl(w, kind, len(b))
+	w.Write(b)
+}
+
+func mmdbEncode(w *bytes.Buffer, v interface{}) {
+	switch v := v.(type) {
+	case string:
+		mmdbControl(w, 2, len(v))
+		w.WriteString(v)
+	case float64:
+		mmdbControl(w, 3, 8)
+		binary.Write(w, binary.BigEndian, math.Float64bits(v))
+	case uint16:
+		mmdbUint(w, 5, uint64(v))
+	case uint32:
+		mmdbUint(w, 6, uint64(v))
+	case int:
+		mmdbUint(w, 6, uint64(v))
+	case uint64:
+		mmdbUint(w, 9, v)
+	case bool:
+		size := 0
+		if v {
+			size = 1
+		}
+		mmdbControl(w, 14, size)
+	case []interface{}:
+		mmdbControl(w, 11, len(v))
+		for _, item := range v {
+			mmdbEncode(w, item)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		mmdbControl(w, 7, len(keys))
+		for _, k := range keys {
+			mmdbEncode(w, k)
+			mmdbEncode(w, v[k])
+		}
+	default:
+		panic("mmdb: unsupported type")
+	}
+}
@@ -0,0 +1,71 @@
+package backend
+
+import "net"
+
+// netTree is a binary prefix tree, for longest prefix matching of addresses
+// against networks. IPv4 networks are stored as IPv4-mapped IPv6 networks.
+type netTree struct {
+	root netTreeNode
+	size int
+}
+
+type netTreeNode struct {
+	child [2]*netTreeNode
+	value interface{}
+	set   bool
+}
+
+func newNetTree() *netTree {
+	return &netTree{}
+}
+
+// Insert adds a network to the tree, replacing the value of an existing
+// identical network.
+func (t *netTree) Insert(n *net.IPNet, value interface{}) {
+	ip, ones := netTreeKey(n)
+	node := &t.root
+	for i := 0; i < ones; i++ {
+		bit := (ip[i/8] >> uint(7-i%8)) & 1
+		if node.child[bit] == nil {
+			node.child[bit] = &netTreeNode{}
+		}
+		node = node.child[bit]
+	}
+	if !node.set {
+		t.size++
+	}
+	node.value = value
+	node.set = true
+}
+
+// Lookup returns the value of the most specific network containing ip.
+func (t *netTree) Lookup(ip net.IP) (value interface{}, found bool) {
+	if ip = ip.To16(); ip == nil {
+		return nil, false
+	}
+
+	node := &t.root
+	for i := 0; node != nil; i++ {
+		if node.set {
+			value, found = node.value, true
+		}
+		if i == len(ip)*8 {
+			break
+		}
+		node = node.child[(ip[i/8]>>uint(7-i%8))&1]
+	}
+	return
+}
+
+// Len returns the number of networks in the tree.
+func (t *netTree) Len() int {
+	return t.size
+}
+
+func netTreeKey(n *net.IPNet) (net.IP, int) {
+	ones, bits := n.Mask.Size()
+	if bits == 32 {
+		return n.IP.To16(), ones + 96
+	}
+	return n.IP.To16(), ones
+}
@@ -0,0 +1,49 @@
+package backend
+
+import (
+	"net"
+	"testing"
+)
+
+func TestNetTree(t *testing.T) {
+	tree := newNetTree()
+	for _, cidr := range []string{
+		"0.0.0.0/0",
+		"10.0.0.0/8",
+		"10.1.0.0/16",
+		"10.1.2.3/32",
+		"2001:db8::/32",
+		"2001:db8:1::/48",
+	} {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tree.Insert(n, cidr)
+	}
+	if tree.Len() != 6 {
+		t.Errorf("got %d networks, want 6", tree.Len())
+	}
+
+	tests := map[string]string{
+		"10.1.2.3":      "10.1.2.3/32",
+		"10.1.2.4":      "10.1.0.0/16",
+		"10.2.0.1":      "10.0.0.0/8",
+		"192.168.1.1":   "0.0.0.0/0",
+		"2001:db8:1::1": "2001:db8:1::/48",
+		"2001:db8:2::1": "2001:db8::/32",
+		"2001:470::1":   "",
+	}
+	for test, want := range tests {
+		v, found := tree.Lookup(net.ParseIP(test))
+		if want == "" {
+			if found {
+				t.Errorf("got %v, want no match for %s", v, test)
+			}
+		} else if !found || v.(string) != want {
+			t.Errorf("got %v, want %s for %s", v, want, test)
+		} else {
+			t.Logf("test %s matched %s", test, v)
+		}
+	}
+}
@@ -9,10 +9,18 @@ import (
 )
 
 type Record struct {
-	Class   string `yaml:"class"`
-	Type    string `yaml:"type"`
-	TTL     int    `yaml:"ttl"`
-	Content string `yaml:"content"`
+	Class   string       `yaml:"class,omitempty"`
+	Type    string       `yaml:"type,omitempty"`
+	TTL     int          `yaml:"ttl,omitempty"`
+	Content string       `yaml:"content,omitempty"`
+	Weight  int          `yaml:"weight,omitempty"`
+	Check   *HealthCheck `yaml:"check,omitempty"`
+}
+
+// Healthy reports if the record passed its last health check, records
+// without health check are always healthy.
+func (r *Record) Healthy() bool {
+	return r.Check.Healthy()
 }
 
 func (r *Record) Message() (*message.Message, error) {
@@ -39,6 +47,7 @@ var defaultSOA = NewSOA()
 type SOA struct {
 	Source, Contact             string
 	Serial                      uint64
+	SerialMode                  string
 	Refresh, Retry, Expire, TTL uint32
 }
 
@@ -56,13 +65,14 @@ func NewSOA() *SOA {
 
 func (s *SOA) Copy() *SOA {
 	return &SOA{
-		Source:  s.Source,
-		Contact: s.Contact,
-		Serial:  s.Serial,
-		Refresh: s.Refresh,
-		Retry:   s.Retry,
-		Expire:  s.Expire,
-		TTL:     s.TTL,
+		Source:     s.Source,
+		Contact:    s.Contact,
+		Serial:     s.Serial,
+		SerialMode: s.SerialMode,
+		Refresh:    s.Refresh,
+		Retry:      s.Retry,
+		Expire:     s.Expire,
+		TTL:        s.TTL,
 	}
 }
 
@@ -0,0 +1,203 @@
+package backend
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+
+	"github.com/miekg/dns"
+)
+
+// Record selection policies
+const (
+	SelectAll        = "all"
+	SelectOne        = "one"
+	SelectRandom     = "random"
+	SelectRoundRobin = "roundrobin"
+)
+
+// RecordSet is a set of records with a selection policy. In the
+// configuration it is either a plain list of records, or a map with the
+// records and the policy:
+//
+//	eu:
+//	  select: random
+//	  count: 2
+//	  records:
+//	  - {type: "A", content: "192.0.2.1", weight: 90}
+//	  - {type: "A", content: "192.0.2.2", weight: 10}
+//
+// The policy is applied to each record type separately. Weights are only
+// used by the random policies; if no record in the set has a weight, all
+// records are equally likely. A set may also refer to a named template,
+// see resolve.
+type RecordSet struct {
+	Records  []*Record         `yaml:"records,omitempty"`
+	Select   string            `yaml:"select,omitempty"`
+	Count    int               `yaml:"count,omitempty"`
+	Template string            `yaml:"template,omitempty"`
+	Params   map[string]string `yaml:"params,omitempty"`
+
+	weighted bool
+	next     uint32
+}
+
+func (s *RecordSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var records []*Record
+	if err := unmarshal(&records); err == nil {
+		s.Records = records
+		return nil
+	}
+
+	type plain RecordSet
+	return unmarshal((*plain)(s))
+}
+
+func (s *RecordSet) check() error {
+	if s.Template != "" {
+		return fmt.Errorf("Unknown template %q", s.Template)
+	}
+
+	s.Select = strings.ToLower(s.Select)
+	switch s.Select {
+	case "":
+		s.Select = SelectAll
+	case SelectAll, SelectOne, SelectRandom, SelectRoundRobin:
+	case "round-robin":
+		s.Select = SelectRoundRobin
+	default:
+		return fmt.Errorf("Unknown select policy %q", s.Select)
+	}
+	if s.Count < 0 {
+		return fmt.Errorf("Invalid count %d", s.Count)
+	}
+
+	// Basic record pre-flight checks
+	s.weighted = false
+	for _, r := range s.Records {
+		if r.Class == "" {
+			r.Class = dns.ClassToString[dns.ClassINET]
+		}
+		if _, ok := dns.StringToClass[r.Class]; !ok {
+			return fmt.Errorf("Unknown class %q", r.Class)
+		}
+		if _, ok := dns.StringToType[r.Type]; !ok {
+			return fmt.Errorf("Unknown type %q", r.Type)
+		}
+		if r.Weight < 0 {
+			return fmt.Errorf("Invalid weight %d for %s %s", r.Weight, r.Type, r.Content)
+		}
+		if r.Weight > 0 {
+			s.weighted = true
+		}
+	}
+	return nil
+}
+
+// dynamic reports if the records picked from the set may change between
+// queries, due to the selection policy or health checks.
+func (s *RecordSet) dynamic() bool {
+	if s == nil {
+		return false
+	}
+	if s.Select != SelectAll {
+		return true
+	}
+	for _, r := range s.Records {
+		if r.Check != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Available reports if the set has any healthy records.
+func (s *RecordSet) Available() bool {
+	if s == nil {
+		return false
+	}
+	for _, r := range s.Records {
+		if r.Healthy() {
+			return true
+		}
+	}
+	return false
+}
+
+// Pick returns the healthy records of the set matching accept, after
+// applying the selection policy.
+func (s *RecordSet) Pick(accept func(*Record) bool) (out []*Record) {
+	if s == nil {
+		return nil
+	}
+
+	var types []string
+	byType := map[string][]*Record{}
+	for _, r := range s.Records {
+		if !r.Healthy() || !accept(r) {
+			continue
+		}
+		if _, found := byType[r.Type]; !found {
+			types = append(types, r.Type)
+		}
+		byType[r.Type] = append(byType[r.Type], r)
+	}
+
+	var offset int
+	if s.Select == SelectRoundRobin {
+		offset = int(atomic.AddUint32(&s.next, 1) - 1)
+	}
+
+	for _, t := range types {
+		records := byType[t]
+		switch s.Select {
+		case SelectOne:
+			out = append(out, s.random(records, 1)...)
+		case SelectRandom:
+			out = append(out, s.random(records, pickInt(s.Count, 1))...)
+		case SelectRoundRobin:
+			n := pickInt(s.Count, len(records))
+			for i := 0; i < n && i < len(records); i++ {
+				out = append(out, records[(offset+i)%len(records)])
+			}
+		default:
+			out = append(out, records...)
+		}
+	}
+	return
+}
+
+// random picks n records by weight, without replacement.
+func (s *RecordSet) random(records []*Record, n int) (out []*Record) {
+	pool := make([]*Record, 0, len(records))
+	total := 0
+	for _, r := range records {
+		if w := s.weight(r); w > 0 {
+			pool = append(pool, r)
+			total += w
+		}
+	}
+
+	for len(out) < n && len(pool) > 0 {
+		x := rand.Intn(total)
+		for i, r := range pool {
+			w := s.weight(r)
+			if x < w {
+				out = append(out, r)
+				pool = append(pool[:i], pool[i+1:]...)
+				total -= w
+				break
+			}
+			x -= w
+		}
+	}
+	return
+}
+
+func (s *RecordSet) weight(r *Record) int {
+	if !s.weighted {
+		return 1
+	}
+	return r.Weight
+}
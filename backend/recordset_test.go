@@ -0,0 +1,132 @@
+package backend
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func testRecordSet(t *testing.T, policy string, count int, weights ...int) *RecordSet {
+	s := &RecordSet{Select: policy, Count: count}
+	for i, w := range weights {
+		s.Records = append(s.Records, &Record{
+			Type:    "A",
+			TTL:     60,
+			Content: "10.0.0." + string('1'+rune(i)),
+			Weight:  w,
+		})
+	}
+	if err := s.check(); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func acceptAll(*Record) bool { return true }
+
+func TestRecordSetUnmarshal(t *testing.T) {
+	var sets map[string]*RecordSet
+	err := yaml.Unmarshal([]byte(`
+plain:
+- {type: "A", content: "10.0.0.1"}
+- {type: "A", content: "10.0.0.2"}
+policy:
+  select: random
+  count: 2
+  records:
+  - {type: "A", content: "10.0.0.3", weight: 90}
+  - {type: "A", content: "10.0.0.4", weight: 10}
+`), &sets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := sets["plain"]; len(s.Records) != 2 || s.Select != "" {
+		t.Errorf("plain: got %d records, policy %q", len(s.Records), s.Select)
+	}
+	if s := sets["policy"]; len(s.Records) != 2 || s.Select != SelectRandom || s.Count != 2 || s.Records[0].Weight != 90 {
+		t.Errorf("policy: got %+v", s)
+	}
+}
+
+func TestRecordSetCheck(t *testing.T) {
+	tests := []*RecordSet{
+		{Select: "weighted"},
+		{Count: -1},
+		{Records: []*Record{{Type: "A", Weight: -1}}},
+		{Records: []*Record{{Type: "BOGUS"}}},
+	}
+	for _, test := range tests {
+		if err := test.check(); err == nil {
+			t.Errorf("expected error for %+v", test)
+		}
+	}
+
+	s := &RecordSet{Select: "Round-Robin"}
+	if err := s.check(); err != nil || s.Select != SelectRoundRobin {
+		t.Errorf("got %q (%v), want %q", s.Select, err, SelectRoundRobin)
+	}
+}
+
+func TestRecordSetPick(t *testing.T) {
+	if got := testRecordSet(t, SelectAll, 0, 0, 0, 0).Pick(acceptAll); len(got) != 3 {
+		t.Errorf("all: got %d records, want 3", len(got))
+	}
+	if got := testRecordSet(t, SelectOne, 0, 0, 0, 0).Pick(acceptAll); len(got) != 1 {
+		t.Errorf("one: got %d records, want 1", len(got))
+	}
+
+	got := testRecordSet(t, SelectRandom, 2, 0, 0, 0).Pick(acceptAll)
+	if len(got) != 2 || got[0] == got[1] {
+		t.Errorf("random: got %v, want 2 distinct records", got)
+	}
+
+	// Zero weight records are never picked from a weighted set
+	s := testRecordSet(t, SelectRandom, 3, 1, 0, 1)
+	for i := 0; i < 100; i++ {
+		for _, r := range s.Pick(acceptAll) {
+			if r.Content == "10.0.0.2" {
+				t.Fatal("random: picked record with zero weight")
+			}
+		}
+	}
+
+	// The policy is applied per record type
+	s = testRecordSet(t, SelectOne, 0, 0, 0)
+	s.Records = append(s.Records, &Record{Type: "AAAA", Content: "2001:db8::1"})
+	if got := s.Pick(acceptAll); len(got) != 2 || got[0].Type == got[1].Type {
+		t.Errorf("one: got %v, want one A and one AAAA record", got)
+	}
+	if got := s.Pick(func(r *Record) bool { return r.Type == "AAAA" }); len(got) != 1 || got[0].Type != "AAAA" {
+		t.Errorf("one: got %v, want one AAAA record", got)
+	}
+}
+
+func TestRecordSetWeights(t *testing.T) {
+	s := testRecordSet(t, SelectOne, 0, 90, 10)
+
+	hits := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		hits[s.Pick(acceptAll)[0].Content]++
+	}
+	t.Logf("hits: %v", hits)
+	if n := hits["10.0.0.1"]; n < 8500 || n > 9500 {
+		t.Errorf("got %d hits for weight 90, want about 9000", n)
+	}
+}
+
+func TestRecordSetRoundRobin(t *testing.T) {
+	s := testRecordSet(t, SelectRoundRobin, 0, 0, 0, 0)
+	for i, want := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.1"} {
+		got := s.Pick(acceptAll)
+		if len(got) != 3 || got[0].Content != want {
+			t.Errorf("rotation %d: got %v, want %s first", i, got, want)
+		}
+	}
+
+	s = testRecordSet(t, SelectRoundRobin, 1, 0, 0)
+	for i, want := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.1"} {
+		if got := s.Pick(acceptAll); len(got) != 1 || got[0].Content != want {
+			t.Errorf("rotation %d: got %v, want [%s]", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package backend
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// SOA serial modes
+const (
+	SerialStatic   = "static"
+	SerialUnixTime = "unixtime"
+	SerialDate     = "date"
+	SerialHash     = "hash"
+)
+
+var serialNow = time.Now
+
+// serialState is the last serial of a zone in the serials file.
+type serialState struct {
+	Serial uint64 `yaml:"serial"`
+	Hash   uint32 `yaml:"hash"`
+}
+
+func (s *SOA) check() error {
+	switch s.SerialMode {
+	case "", SerialStatic, SerialUnixTime, SerialDate, SerialHash:
+	default:
+		return fmt.Errorf("Unknown SOA serial mode %q", s.SerialMode)
+	}
+
+	// Apply the defaults, so the resolved configuration shows what is served
+	s.Source = pickStr(s.Source, defaultSOA.Source)
+	s.Contact = pickStr(s.Contact, defaultSOA.Contact)
+	s.Serial = picku64(s.Serial, defaultSOA.Serial)
+	s.Refresh = picku32(s.Refresh, defaultSOA.Refresh)
+	s.Retry = picku32(s.Retry, defaultSOA.Retry)
+	s.Expire = picku32(s.Expire, defaultSOA.Expire)
+	s.TTL = picku32(s.TTL, defaultSOA.TTL)
+	return nil
+}
+
+// updateSerial sets the serial for zone according to the serial mode, content
+// is the resolved configuration of the zone:
+//
+//	static    the configured serial
+//	unixtime  the time the configuration was last modified
+//	date      YYYYMMDDnn, nn is bumped when the content changes
+//	hash      derived from the content
+//
+// The date serial keeps the last serial of each zone in the serials file, so
+// all processes using the file agree and serials survive restarts. The hash
+// serial is not sequential, a change can lower the serial and secondaries
+// ignore lower serials (RFC 1982), so it only suits zones without secondaries.
+func (s *SOA) updateSerial(zone string, content []byte, modified time.Time, serials string) error {
+	h := fnv.New32a()
+	h.Write(content)
+	hash := h.Sum32()
+
+	switch s.SerialMode {
+	case SerialUnixTime:
+		if modified.IsZero() {
+			modified = serialNow()
+		}
+		s.Serial = uint64(modified.Unix())
+	case SerialDate:
+		if serials == "" {
+			return fmt.Errorf("SOA serial mode %s needs a serials file", s.SerialMode)
+		}
+		return updateSerialState(serials, zone, func(last *serialState) {
+			if last.Serial == 0 || last.Hash != hash {
+				next, _ := strconv.ParseUint(serialNow().UTC().Format("20060102")+"00", 10, 64)
+				if next <= last.Serial {
+					next = last.Serial + 1
+				}
+				last.Serial, last.Hash = next, hash
+			}
+			s.Serial = last.Serial
+		})
+	case SerialHash:
+		s.Serial = uint64(hash)
+	}
+	return nil
+}
+
+// updateSerialState updates the state of zone in the serials file. A lock
+// file is held while updating, so processes sharing the file don't race.
+func updateSerialState(filename, zone string, update func(*serialState)) error {
+	lock, err := os.OpenFile(filename+".lock", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer lock.Close()
+	if err = syscall.Flock(int(lock.Fd()), syscall.LOCK_EX); err != nil {
+		return err
+	}
+
+	states := map[string]*serialState{}
+	data, err := ioutil.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err = yaml.Unmarshal(data, &states); err != nil {
+		return fmt.Errorf("%s: %v", filename, err)
+	}
+	if states[zone] == nil {
+		states[zone] = &serialState{}
+	}
+	update(states[zone])
+
+	if data, err = yaml.Marshal(states); err != nil {
+		return err
+	}
+	// Replace the file, so readers never see a partial file
+	if err = ioutil.WriteFile(filename+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(filename+".tmp", filename)
+}
+
+// updateSerial updates the SOA serial of an answer, the content includes the
+// encoders inherited from the backend.
+func (b *AutoBackend) updateSerial(answer *AutoBackendAnswer) error {
+	if answer.SOA.SerialMode == "" || answer.SOA.SerialMode == SerialStatic {
+		return nil
+	}
+	a := *answer
+	if a.Encode == nil {
+		a.Encode = b.Encode
+	}
+	soa := *a.SOA
+	soa.Serial = 0
+	a.SOA = &soa
+	content, err := yaml.Marshal(&a)
+	if err != nil {
+		return err
+	}
+	return answer.SOA.updateSerial(ReverseNetwork(answer.Network), content, b.modified, b.serials)
+}
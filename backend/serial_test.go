@@ -0,0 +1,103 @@
+package backend
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testSerialConfig = `
+serials: %s
+auto:
+- encode:
+    eui64:
+  dns: [dns1.maze.io]
+  soa: {source: dns1.maze.io, serial: 42, serialmode: %s}
+  answers:
+    '172.23.40.0/24':
+      zone: auto.maze.so
+      suffix: '%s'
+`
+
+func testSerial(t *testing.T, serials, mode, suffix string) uint64 {
+	b := testViewBackends(t, fmt.Sprintf(testSerialConfig, serials, mode, suffix))[0].(*AutoBackend)
+	return b.Answers["172.23.40.0/24"].SOA.Serial
+}
+
+func TestSerial(t *testing.T) {
+	defer func() { serialNow = time.Now }()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	serialNow = func() time.Time { return now }
+
+	var hash uint64
+	tests := []struct {
+		Mode, Suffix string
+		Later        time.Duration
+		Want         func(uint64) bool
+	}{
+		{"static", "", 0, func(s uint64) bool { return s == 42 }},
+		{"date", "", 0, func(s uint64) bool { return s == 2026101900 }},
+		{"date", "", 0, func(s uint64) bool { return s == 2026101900 }},
+		{"date", "-4", 0, func(s uint64) bool { return s == 2026101901 }},
+		{"date", "-6", time.Hour, func(s uint64) bool { return s == 2026101902 }},
+		{"date", "-6", 24 * time.Hour, func(s uint64) bool { return s == 2026101902 }},
+		{"date", "-4", 0, func(s uint64) bool { return s == 2026102000 }},
+		{"unixtime", "", 0, func(s uint64) bool { return s == uint64(now.Unix()) }},
+		{"unixtime", "-4", time.Hour, func(s uint64) bool { return s == uint64(now.Unix()) }},
+		{"hash", "", 0, func(s uint64) bool { hash = s; return s != 0 }},
+		{"hash", "-4", 0, func(s uint64) bool { return s != hash }},
+		{"hash", "", time.Hour, func(s uint64) bool { return s == hash }},
+	}
+	var serials, mode string
+	for i, test := range tests {
+		if test.Mode != mode {
+			// Start fresh, each mode has its own serials file
+			serials, mode = filepath.Join(t.TempDir(), "serials.yaml"), test.Mode
+		}
+		now = now.Add(test.Later)
+		got := testSerial(t, serials, test.Mode, test.Suffix)
+		if !test.Want(got) {
+			t.Errorf("test %d: unexpected %s serial %d", i, test.Mode, got)
+		} else {
+			t.Logf("test %d: %s serial %d", i, test.Mode, got)
+		}
+	}
+}
+
+func TestSerialShared(t *testing.T) {
+	serials := filepath.Join(t.TempDir(), "serials.yaml")
+
+	// Processes sharing the serials file agree on the serial, also after a
+	// restart on another day
+	first := testSerial(t, serials, "date", "")
+	defer func() { serialNow = time.Now }()
+	serialNow = func() time.Time { return time.Now().Add(48 * time.Hour) }
+	if got := testSerial(t, serials, "date", ""); got != first {
+		t.Errorf("expected serial %d, got %d", first, got)
+	}
+	if got := testSerial(t, serials, "date", "-4"); got <= first {
+		t.Errorf("expected serial above %d, got %d", first, got)
+	}
+
+	// The unixtime serial is the time the configuration was modified
+	c := testBackendConfig(t, fmt.Sprintf(testSerialConfig, serials, "unixtime", ""))
+	c.Modified = time.Unix(1700000000, 0)
+	bs, err := c.Backends()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bs[0].(*AutoBackend).Answers["172.23.40.0/24"].SOA.Serial; got != 1700000000 {
+		t.Errorf("expected serial 1700000000, got %d", got)
+	}
+}
+
+func TestSerialMode(t *testing.T) {
+	// Date serials need a serials file
+	for _, mode := range []string{"bogus", "date"} {
+		c := testBackendConfig(t, fmt.Sprintf(testSerialConfig, `""`, mode, ""))
+		if _, err := c.Backends(); err == nil {
+			t.Errorf("expected error for serial mode %q", mode)
+		}
+	}
+}
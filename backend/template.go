@@ -0,0 +1,119 @@
+package backend
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ZoneParam is substituted with the zone being queried at query time, unless
+// the template reference sets the zone parameter.
+const ZoneParam = "{{zone}}"
+
+var templateParam = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// checkTemplates checks the record set templates, templates can't refer to
+// other templates.
+func checkTemplates(templates map[string]*RecordSet) error {
+	for name, t := range templates {
+		if t == nil {
+			return fmt.Errorf("Empty template %q", name)
+		}
+		if t.Template != "" {
+			return fmt.Errorf("Template %q refers to template %q", name, t.Template)
+		}
+		if err := t.check(); err != nil {
+			return fmt.Errorf("template %q: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// resolve copies the records of the template the set refers to, with the
+// parameters substituted, followed by the records of the set itself:
+//
+//	eu:
+//	  template: geo_eu
+//	  params:
+//	    pop: ams
+//
+// The selection policy of the template is used unless the set has its own.
+// Once resolved, the set no longer refers to the template.
+func (s *RecordSet) resolve(templates map[string]*RecordSet) error {
+	if s == nil || s.Template == "" {
+		return nil
+	}
+	t, found := templates[s.Template]
+	if !found || t == nil {
+		return fmt.Errorf("Unknown template %q", s.Template)
+	}
+
+	records := make([]*Record, 0, len(t.Records)+len(s.Records))
+	for _, r := range t.Records {
+		c := *r
+		content, err := s.substitute(c.Content)
+		if err != nil {
+			return fmt.Errorf("template %q: %v", s.Template, err)
+		}
+		c.Content = content
+		records = append(records, &c)
+	}
+	s.Records = append(records, s.Records...)
+	if s.Select == "" {
+		s.Select, s.Count = t.Select, t.Count
+	}
+	s.Template, s.Params = "", nil
+	return nil
+}
+
+func (s *RecordSet) substitute(content string) (string, error) {
+	var err error
+	content = templateParam.ReplaceAllStringFunc(content, func(m string) string {
+		name := templateParam.FindStringSubmatch(m)[1]
+		if value, found := s.Params[name]; found {
+			return value
+		}
+		if name == "zone" {
+			return ZoneParam
+		}
+		if err == nil {
+			err = fmt.Errorf("Unknown template parameter %q", name)
+		}
+		return m
+	})
+	return content, err
+}
+
+// resolveTemplates resolves the templates of all answers.
+func (b *GeoBackend) resolveTemplates(templates map[string]*RecordSet) error {
+	a := b.Options.Answers
+	for rule, answers := range map[string]map[string]*RecordSet{
+		"continent":   a.Continent,
+		"country":     a.Country,
+		"subdivision": a.Subdivision,
+		"city":        a.City,
+		"metro":       a.Metro,
+		"asn":         a.ASN,
+		"networks":    a.Networks,
+	} {
+		for key, set := range answers {
+			if err := set.resolve(templates); err != nil {
+				return fmt.Errorf("%s %s: %v", rule, key, err)
+			}
+		}
+	}
+	if err := a.Unknown.resolve(templates); err != nil {
+		return fmt.Errorf("unknown: %v", err)
+	}
+	if err := a.Default.resolve(templates); err != nil {
+		return fmt.Errorf("default: %v", err)
+	}
+	for name, pop := range b.Options.PoPs {
+		if pop == nil {
+			continue
+		}
+		if err := pop.RecordSet.resolve(templates); err != nil {
+			return fmt.Errorf("PoP %q: %v", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package backend
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/tehmaze-labs/dns/message"
+)
+
+const testTemplateConfig = `
+templates:
+  web:
+  - {type: "A",   ttl: 60, content: "192.0.2.{{host}}"}
+  - {type: "TXT", ttl: 60, content: "served by {{ pop }} for {{zone}}"}
+  zone:
+    select: one
+    records:
+    - {type: "NS", ttl: 3600, content: "ns1.{{zone}}"}
+geo:
+- zones: [cdn.maze.io, www.maze.io]
+  options:
+    database: %s
+    answers:
+      continent:
+        eu: {template: web, params: {host: "1", pop: ams}}
+        na:
+          template: web
+          params: {host: "2", pop: nyc, zone: example.org}
+          records:
+          - {type: "AAAA", ttl: 60, content: "2001:db8::2"}
+      default: {template: zone}
+`
+
+func testTemplateQuery(t *testing.T, b Backend, name string, qtype uint16, remote string) []string {
+	r, err := b.Query(&message.Message{
+		Name:       []byte(name),
+		Class:      dns.ClassINET,
+		Type:       qtype,
+		ID:         []byte("-1"),
+		RemoteAddr: net.ParseIP(remote),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []string
+	for _, a := range r {
+		out = append(out, dns.TypeToString[a.Type]+" "+string(a.Content))
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestTemplates(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	bs := testViewBackends(t, fmt.Sprintf(testTemplateConfig, filename))
+	b := bs[0]
+
+	var tests = []struct {
+		Name   string
+		Type   uint16
+		Remote string
+		Want   string
+	}{
+		{"cdn.maze.io", dns.TypeANY, "81.2.69.160", "A 192.0.2.1,TXT served by ams for cdn.maze.io"},
+		{"www.maze.io", dns.TypeTXT, "81.2.69.160", "TXT served by ams for www.maze.io"},
+		{"cdn.maze.io", dns.TypeANY, "216.160.83.56", "A 192.0.2.2,AAAA 2001:db8::2,TXT served by nyc for example.org"},
+		{"cdn.maze.io", dns.TypeAAAA, "216.160.83.56", "AAAA 2001:db8::2"},
+		{"www.maze.io", dns.TypeNS, "1.0.0.1", "NS ns1.www.maze.io"},
+	}
+	for _, test := range tests {
+		got := strings.Join(testTemplateQuery(t, b, test.Name, test.Type, test.Remote), ",")
+		if got != test.Want {
+			t.Errorf("%s %s from %s: expected %q, got %q", test.Name, dns.TypeToString[test.Type], test.Remote, test.Want, got)
+		}
+	}
+
+	// The template itself is unchanged
+	c := testBackendConfig(t, fmt.Sprintf(testTemplateConfig, filename))
+	if _, err := c.Backends(); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.Templates["web"].Records[0].Content; got != "192.0.2.{{host}}" {
+		t.Errorf("template changed to %q", got)
+	}
+	if got := c.GeoBackends[0].Options.Answers.Default.Select; got != SelectOne {
+		t.Errorf("expected template select policy, got %q", got)
+	}
+}
+
+func TestTemplatesError(t *testing.T) {
+	filename := testGeoDatabase(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	var tests = []struct {
+		Templates, Answer, Want string
+	}{
+		{"web: [{type: A, content: 192.0.2.1}]", "{template: bogus}", `Unknown template "bogus"`},
+		{"web: [{type: A, content: '192.0.2.{{host}}'}]", "{template: web}", `Unknown template parameter "host"`},
+		{"web: {template: other}", "{template: web}", `Template "web" refers to template "other"`},
+		{"web: [{type: BOGUS, content: 192.0.2.1}]", "{template: web}", `Unknown type "BOGUS"`},
+		{"web:", "{template: web}", `Empty template "web"`},
+	}
+	for _, test := range tests {
+		config := fmt.Sprintf("templates:\n  %s\ngeo:\n- zones: [cdn.maze.io]\n  options:\n    database: %s\n    answers:\n      default: %s\n",
+			test.Templates, filename, test.Answer)
+		_, err := testBackendConfig(t, config).Backends()
+		if err == nil || !strings.Contains(err.Error(), test.Want) {
+			t.Errorf("expected error %q, got %v", test.Want, err)
+		}
+	}
+}
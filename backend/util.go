@@ -39,3 +39,10 @@ func stringRev(s string) string {
 	}
 	return string(runes)
 }
+
+func pickInt(a, b int) int {
+	if a > 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,109 @@
+package backend
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/tehmaze-labs/dns/message"
+)
+
+// View limits answers to queries received on a set of local addresses,
+// and/or to queries from a set of client networks. Views are defined by name
+// and referenced from backends and answers:
+//
+//	views:
+//	  internal:
+//	    local: [172.23.0.53, "2001:470:d510::53"]
+//	    clients: [172.23.0.0/16]
+//
+// An empty list matches all addresses.
+type View struct {
+	Local   []string `yaml:"local,omitempty"`
+	Clients []string `yaml:"clients,omitempty"`
+
+	local   []*net.IPNet
+	clients []*net.IPNet
+}
+
+func (v *View) check() (err error) {
+	if v.local, err = parseNetworks(v.Local); err != nil {
+		return
+	}
+	v.clients, err = parseNetworks(v.Clients)
+	return
+}
+
+// Match reports if the query was received on one of the local addresses,
+// from one of the client networks. Any resolver can send a client subnet, so
+// both the resolver and the client subnet have to be in the client networks.
+func (v *View) Match(m *message.Message) bool {
+	return matchNetworks(v.local, m.LocalAddr) &&
+		matchNetworks(v.clients, m.RemoteAddr) &&
+		matchNetworks(v.clients, m.Client())
+}
+
+// viewSet is a set of views, of which any has to match.
+type viewSet []*View
+
+// Match reports if any of the views match, an empty set matches all queries.
+func (s viewSet) Match(m *message.Message) bool {
+	if len(s) == 0 {
+		return true
+	}
+	for _, v := range s {
+		if v.Match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveViews looks up the views by name.
+func resolveViews(views map[string]*View, names []string) (viewSet, error) {
+	var s viewSet
+	for _, name := range names {
+		v, found := views[name]
+		if !found || v == nil {
+			return nil, fmt.Errorf("Unknown view %q", name)
+		}
+		s = append(s, v)
+	}
+	return s, nil
+}
+
+// parseNetworks parses a list of networks in CIDR notation, or single
+// addresses.
+func parseNetworks(s []string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, n := range s {
+		if _, ipnet, err := net.ParseCIDR(n); err == nil {
+			networks = append(networks, ipnet)
+			continue
+		}
+		ip := net.ParseIP(n)
+		if ip == nil {
+			return nil, fmt.Errorf("Invalid network %q", n)
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			networks = append(networks, &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)})
+		} else {
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)})
+		}
+	}
+	return networks, nil
+}
+
+func matchNetworks(networks []*net.IPNet, ip net.IP) bool {
+	if len(networks) == 0 {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, n := range networks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package backend
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/tehmaze-labs/dns/message"
+	"gopkg.in/yaml.v2"
+)
+
+const testViewConfig = `
+views:
+  internal:
+    local: [172.23.0.53, "2001:470:d510::53"]
+  office:
+    clients: [172.23.40.0/24]
+auto:
+  - encode: {base32: }
+    dns: [dns1.maze.io]
+    answers:
+      '172.23.40.0/24':
+        zone: pub.auto.maze.so
+      '172.23.41.0/24':
+        zone: int.auto.maze.so
+        views: [internal, office]
+`
+
+// testBackendConfig decodes a backend configuration, with the templates that
+// are a top-level section in the configuration file.
+func testBackendConfig(t *testing.T, config string) *BackendConfig {
+	var c struct {
+		Templates     map[string]*RecordSet `yaml:"templates"`
+		BackendConfig `yaml:",inline"`
+	}
+	if err := yaml.UnmarshalStrict([]byte(config), &c); err != nil {
+		t.Fatal(err)
+	}
+	c.BackendConfig.Templates = c.Templates
+	return &c.BackendConfig
+}
+
+func testViewBackends(t *testing.T, config string) []Backend {
+	bs, err := testBackendConfig(t, config).Backends()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bs
+}
+
+func TestViews(t *testing.T) {
+	b := testViewBackends(t, testViewConfig)[0]
+
+	tests := []struct {
+		Name          string
+		Remote, Local string
+		Answers       int
+	}{
+		{"1.40.23.172.in-addr.arpa", "192.0.2.1", "192.0.2.53", 1},
+		{"1.41.23.172.in-addr.arpa", "192.0.2.1", "192.0.2.53", 0},
+		{"1.41.23.172.in-addr.arpa", "192.0.2.1", "172.23.0.53", 1},
+		{"1.41.23.172.in-addr.arpa", "192.0.2.1", "2001:470:d510::53", 1},
+		{"1.41.23.172.in-addr.arpa", "172.23.40.1", "192.0.2.53", 1},
+		{"41.23.172.in-addr.arpa", "192.0.2.1", "192.0.2.53", 0},
+		{"41.23.172.in-addr.arpa", "192.0.2.1", "172.23.0.53", 1},
+	}
+	for _, test := range tests {
+		qtype := dns.TypePTR
+		if test.Name == "41.23.172.in-addr.arpa" {
+			qtype = dns.TypeSOA
+		}
+		r, err := b.Query(&message.Message{
+			Name:       []byte(test.Name),
+			Class:      dns.ClassINET,
+			Type:       qtype,
+			ID:         []byte("-1"),
+			RemoteAddr: net.ParseIP(test.Remote),
+			LocalAddr:  net.ParseIP(test.Local),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r) != test.Answers {
+			t.Errorf("%s from %s to %s: got %d answers, want %d", test.Name, test.Remote, test.Local, len(r), test.Answers)
+		} else {
+			for _, a := range r {
+				t.Logf("%s from %s to %s: %s", test.Name, test.Remote, test.Local, a.Content)
+			}
+		}
+	}
+}
+
+func TestViewsConfig(t *testing.T) {
+	tests := []string{
+		"views: {internal: {local: [172.23.0.0/33]}}\n",
+		"views: {internal: }\n",
+		"auto: [{views: [bogus]}]\n",
+		"views: {internal: {}}\nauto: [{dns: [dns1.maze.io], encode: {base32: }, answers: {'172.23.41.0/24': {zone: int.auto.maze.so, views: [external]}}}]\n",
+		"geo: [{views: [internal]}]\n",
+	}
+	for _, test := range tests {
+		c := &BackendConfig{}
+		if err := yaml.Unmarshal([]byte(test), c); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Backends(); err == nil {
+			t.Errorf("expected error for %q", test)
+		} else {
+			t.Logf("%v", err)
+		}
+	}
+
+	v := &View{Local: []string{"172.23.0.53"}, Clients: []string{"172.23.40.0/24"}}
+	if err := v.check(); err != nil {
+		t.Fatal(err)
+	}
+	inside := &net.IPNet{IP: net.ParseIP("172.23.40.0").To4(), Mask: net.CIDRMask(24, 32)}
+	outside := &net.IPNet{IP: net.ParseIP("192.0.2.0").To4(), Mask: net.CIDRMask(24, 32)}
+	matches := []struct {
+		Remote string
+		Subnet *net.IPNet
+		Match  bool
+	}{
+		{"192.0.2.1", nil, false},
+		{"192.0.2.1", inside, false},
+		{"172.23.40.1", nil, true},
+		{"172.23.40.1", inside, true},
+		{"172.23.40.1", outside, false},
+	}
+	for _, test := range matches {
+		m := &message.Message{
+			LocalAddr:    net.ParseIP("172.23.0.53"),
+			RemoteAddr:   net.ParseIP(test.Remote),
+			ClientSubnet: test.Subnet,
+		}
+		if got := v.Match(m); got != test.Match {
+			t.Errorf("from %s with subnet %v: got match %t, want %t", test.Remote, test.Subnet, got, test.Match)
+		}
+	}
+}
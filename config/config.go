@@ -3,17 +3,21 @@ package config
 import (
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"log"
 	"log/syslog"
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/tehmaze-labs/dns/backend"
-	"gopkg.in/yaml.v2"
+	"github.com/tehmaze-labs/dns/dnstap"
+	"github.com/tehmaze-labs/dns/logging"
+	"github.com/tehmaze-labs/dns/metrics"
+	"github.com/tehmaze-labs/dns/rrl"
 )
 
+var logger = logging.For("config")
+
 var syslogFacility = map[string]syslog.Priority{
 	"kern":   syslog.LOG_KERN,
 	"user":   syslog.LOG_USER,
@@ -37,58 +41,61 @@ var syslogFacility = map[string]syslog.Priority{
 }
 
 type Config struct {
-	Backend   *backend.BackendConfig `yaml:"backend"`
-	Templates interface{}            `yaml:"templates"`
+	Backend   *backend.BackendConfig        `yaml:"backend,omitempty"`
+	Include   []string                      `yaml:"include,omitempty"`
+	Templates map[string]*backend.RecordSet `yaml:"templates,omitempty"`
 	Options   struct {
-		Syslog string
+		// Strict rejects unknown keys, instead of logging a warning
+		Strict  bool                 `yaml:"strict,omitempty"`
+		Syslog  string               `yaml:"syslog,omitempty"`
+		Log     *logging.Config      `yaml:"log,omitempty"`
+		RRL     *rrl.Config          `yaml:"rrl,omitempty"`
+		Cache   *backend.CacheConfig `yaml:"cache,omitempty"`
+		Metrics *metrics.Config      `yaml:"metrics,omitempty"`
+		Dnstap  *dnstap.Config       `yaml:"dnstap,omitempty"`
 	}
+
+	// modified is the time the newest configuration file was modified
+	modified time.Time
+	// expanded maps expanded strings to their unexpanded value, for Dump
+	expanded map[string]string
 }
 
 func NewConfig(filename string) (c *Config, err error) {
-	var data []byte
-
-	if data, err = ioutil.ReadFile(filename); err != nil {
-		return nil, err
-	}
-
-	c = &Config{}
-	if err = yaml.Unmarshal(data, c); err != nil {
+	if c, err = load(filename, map[string]bool{}, false); err != nil {
 		return nil, err
 	}
 
-	// Log to syslog if requested
+	// Log to syslog if requested, the priority is set per message
+	var w *syslog.Writer
 	if c.Options.Syslog != "" {
 		c.Options.Syslog = strings.ToLower(c.Options.Syslog)
 		if f, found := syslogFacility[c.Options.Syslog]; found {
-			l, err := syslog.New(syslog.LOG_NOTICE|f, path.Base(os.Args[0]))
-			if err != nil {
+			if w, err = syslog.New(syslog.LOG_NOTICE|f, path.Base(os.Args[0])); err != nil {
 				return nil, err
 			}
-
-			log.SetFlags(log.Lshortfile)
-			log.SetOutput(l)
 		} else {
 			return nil, fmt.Errorf("Unknown syslog facility %q", c.Options.Syslog)
 		}
 	}
+	if err = logging.Setup(c.Options.Log, w); err != nil {
+		if w != nil {
+			w.Close()
+		}
+		return nil, err
+	}
 
 	return
 }
 
 func (c *Config) Backends() (bs []backend.Backend, err error) {
-	bs = make([]backend.Backend, 0)
-
-	for _, b := range c.Backend.AutoBackends {
-		if err = b.Check(); err != nil {
-			return nil, err
-		}
-		bs = append(bs, b)
+	if c.Backend == nil {
+		return nil, errors.New("no backends configured")
 	}
-	for _, b := range c.Backend.GeoBackends {
-		if err = b.Check(); err != nil {
-			return nil, err
-		}
-		bs = append(bs, b)
+	c.Backend.Templates = c.Templates
+	c.Backend.Modified = c.modified
+	if bs, err = c.Backend.Backends(); err != nil {
+		return nil, err
 	}
 
 	if len(bs) == 0 {
@@ -0,0 +1,334 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/tehmaze-labs/dns/backend"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	expandPattern = regexp.MustCompile(`\$\$|\$\{([^}]*)\}`)
+	envName       = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	mapItemType   = reflect.TypeOf(yaml.MapItem{})
+)
+
+// load reads a configuration file and the files it includes. The seen
+// files are tracked to prevent include loops. Unknown keys are logged, or
+// rejected if strict or if the file or an including file sets the strict
+// option.
+func load(filename string, seen map[string]bool, strict bool) (*Config, error) {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return nil, err
+	}
+	if seen[abs] {
+		return nil, fmt.Errorf("%s: already included", filename)
+	}
+	seen[abs] = true
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{modified: info.ModTime()}
+	if err = unmarshal(data, formatOf(filename), c, false); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	strict = strict || c.Options.Strict
+	if err = unmarshal(data, formatOf(filename), &Config{}, true); err != nil {
+		if strict {
+			return nil, fmt.Errorf("%s: %v", filename, err)
+		}
+		// Skip the "unmarshal errors:" header
+		lines := strings.Split(err.Error(), "\n")
+		if len(lines) > 1 {
+			lines = lines[1:]
+		}
+		for _, line := range lines {
+			if i := strings.Index(line, " in type "); i >= 0 {
+				line = line[:i]
+			}
+			logger.Warn("ignoring configuration", "file", filename, "error", strings.TrimSpace(line))
+		}
+	}
+	x := newExpander(filename, data)
+	if err = x.walk(reflect.ValueOf(c)); err != nil {
+		return nil, err
+	}
+	c.expanded = x.expanded
+	if c.Backend != nil {
+		for i, b := range c.Backend.AutoBackends {
+			b.Source = fmt.Sprintf("%s: auto backend %d", filename, i+1)
+		}
+		for i, b := range c.Backend.GeoBackends {
+			b.Source = fmt.Sprintf("%s: geo backend %d", filename, i+1)
+		}
+	}
+
+	for _, pattern := range c.Include {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(filepath.Dir(filename), pattern)
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: include %q: %v", filename, pattern, err)
+		}
+		if len(matches) == 0 && !hasMeta(pattern) {
+			return nil, fmt.Errorf("%s: include %q: no such file", filename, pattern)
+		}
+		for _, match := range matches {
+			included, err := load(match, seen, strict)
+			if err != nil {
+				return nil, err
+			}
+			if err = c.merge(included); err != nil {
+				return nil, fmt.Errorf("%s: %v", match, err)
+			}
+		}
+	}
+	return c, nil
+}
+
+// merge adds the options, templates, backends and serials file of an included
+// configuration. Templates, views and the serials file may only be defined
+// once, options only with the same value.
+func (c *Config) merge(o *Config) error {
+	if o.modified.After(c.modified) {
+		c.modified = o.modified
+	}
+	for value, raw := range o.expanded {
+		if c.expanded == nil {
+			c.expanded = map[string]string{}
+		}
+		c.expanded[value] = raw
+	}
+	options, included := reflect.ValueOf(&c.Options).Elem(), reflect.ValueOf(o.Options)
+	for i := 0; i < included.NumField(); i++ {
+		if included.Field(i).IsZero() {
+			continue
+		}
+		if !options.Field(i).IsZero() && !reflect.DeepEqual(options.Field(i).Interface(), included.Field(i).Interface()) {
+			name := strings.Split(options.Type().Field(i).Tag.Get("yaml"), ",")[0]
+			return fmt.Errorf("option %q already defined", name)
+		}
+		options.Field(i).Set(included.Field(i))
+	}
+	for name, t := range o.Templates {
+		if _, found := c.Templates[name]; found {
+			return fmt.Errorf("Template %q already defined", name)
+		}
+		if c.Templates == nil {
+			c.Templates = map[string]*backend.RecordSet{}
+		}
+		c.Templates[name] = t
+	}
+	if o.Backend == nil {
+		return nil
+	}
+	if c.Backend == nil {
+		c.Backend = &backend.BackendConfig{}
+	}
+	for name, view := range o.Backend.Views {
+		if _, found := c.Backend.Views[name]; found {
+			return fmt.Errorf("view %q already defined", name)
+		}
+		if c.Backend.Views == nil {
+			c.Backend.Views = map[string]*backend.View{}
+		}
+		c.Backend.Views[name] = view
+	}
+	if o.Backend.Serials != "" {
+		if c.Backend.Serials != "" {
+			return errors.New("serials file already defined")
+		}
+		c.Backend.Serials = o.Backend.Serials
+	}
+	c.Backend.AutoBackends = append(c.Backend.AutoBackends, o.Backend.AutoBackends...)
+	c.Backend.GeoBackends = append(c.Backend.GeoBackends, o.Backend.GeoBackends...)
+	return nil
+}
+
+func hasMeta(pattern string) bool {
+	return strings.ContainsAny(pattern, `*?[\`)
+}
+
+// expander replaces ${VAR} and ${VAR:-default} with environment variables,
+// and ${file:path} with the contents of a file, relative paths are relative
+// to the configuration file. Use $$ for a literal $. Only decoded string
+// values are expanded, so a value can never add keys to the configuration;
+// numbers and durations can not be expanded.
+type expander struct {
+	filename string
+	data     []byte
+	seen     map[uintptr]bool
+	// expanded maps expanded strings to their unexpanded value
+	expanded map[string]string
+}
+
+func newExpander(filename string, data []byte) *expander {
+	return &expander{filename: filename, data: data, seen: map[uintptr]bool{}, expanded: map[string]string{}}
+}
+
+// walk expands all strings reachable from v, v must be settable.
+func (x *expander) walk(v reflect.Value) (err error) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || x.seen[v.Pointer()] {
+			return nil
+		}
+		x.seen[v.Pointer()] = true
+		return x.walk(v.Elem())
+	case reflect.Struct:
+		if v.Type() == mapItemType {
+			// Keys of a MapSlice are names, not values
+			return x.walk(v.FieldByName("Value"))
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.CanSet() {
+				if err = x.walk(f); err != nil {
+					return
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err = x.walk(v.Index(i)); err != nil {
+				return
+			}
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			e := reflect.New(v.Type().Elem()).Elem()
+			e.Set(v.MapIndex(k))
+			if err = x.walk(e); err != nil {
+				return
+			}
+			v.SetMapIndex(k, e)
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if s, ok := v.Elem().Interface().(string); ok {
+			var value interface{}
+			if value, err = x.expandScalar(s); err == nil {
+				v.Set(reflect.ValueOf(value))
+			}
+			return
+		}
+		e := reflect.New(v.Elem().Type()).Elem()
+		e.Set(v.Elem())
+		if err = x.walk(e); err == nil {
+			v.Set(e)
+		}
+	case reflect.String:
+		var s string
+		if s, err = x.expand(v.String()); err == nil {
+			v.SetString(s)
+		}
+	}
+	return
+}
+
+// expandScalar expands an untyped value, such as encoder options. If the
+// expanded value is a number or boolean it is typed as YAML would.
+func (x *expander) expandScalar(s string) (interface{}, error) {
+	value, err := x.expand(s)
+	if err != nil || value == s || strings.ContainsAny(value, "\r\n") {
+		return value, err
+	}
+	var v interface{}
+	if yaml.Unmarshal([]byte(value), &v) == nil {
+		switch v.(type) {
+		case int, int64, uint64, float64, bool:
+			return v, nil
+		}
+	}
+	return value, nil
+}
+
+func (x *expander) expand(s string) (string, error) {
+	var err error
+	raw := s
+	s = expandPattern.ReplaceAllStringFunc(s, func(m string) string {
+		if err != nil {
+			return m
+		}
+		if m == "$$" {
+			return "$"
+		}
+		value, e := expandValue(m[2:len(m)-1], x.filename)
+		if e != nil {
+			// Decoded values have no position, report the first use
+			if i := bytes.Index(x.data, []byte(m)); i >= 0 {
+				err = fmt.Errorf("%s:%d: %v (first occurrence of %s)", x.filename, lineAt(x.data, int64(i)), e, m)
+			} else {
+				err = fmt.Errorf("%s: %v", x.filename, e)
+			}
+		}
+		return value
+	})
+	if err == nil && s != raw {
+		x.expanded[s] = x.unexpanded(raw)
+	}
+	return s, err
+}
+
+// unexpanded returns the unexpanded value with absolute file paths, so it can
+// be expanded from any other file.
+func (x *expander) unexpanded(s string) string {
+	return expandPattern.ReplaceAllStringFunc(s, func(m string) string {
+		if !strings.HasPrefix(m, "${file:") {
+			return m
+		}
+		name := m[7 : len(m)-1]
+		if !filepath.IsAbs(name) {
+			name = filepath.Join(filepath.Dir(x.filename), name)
+		}
+		return "${file:" + name + "}"
+	})
+}
+
+func expandValue(expr, filename string) (string, error) {
+	if strings.HasPrefix(expr, "file:") {
+		name := strings.TrimPrefix(expr, "file:")
+		if !filepath.IsAbs(name) {
+			name = filepath.Join(filepath.Dir(filename), name)
+		}
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	name, def := expr, ""
+	hasDefault := false
+	if i := strings.Index(expr, ":-"); i >= 0 {
+		name, def, hasDefault = expr[:i], expr[i+2:], true
+	}
+	if !envName.MatchString(name) {
+		return "", fmt.Errorf("invalid environment variable %q", name)
+	}
+	value, found := os.LookupEnv(name)
+	switch {
+	case hasDefault && value == "":
+		return def, nil
+	case !found:
+		return "", fmt.Errorf("environment variable %s not set", name)
+	}
+	return value, nil
+}
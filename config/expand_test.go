@@ -0,0 +1,268 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, data := range files {
+		name = filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestExpand(t *testing.T) {
+	t.Setenv("DNS_TEST_CONTACT", "hostmaster.example.org")
+	t.Setenv("DNS_TEST_EMPTY", "")
+	dir := writeFiles(t, map[string]string{"secret": "s3cr3t\n"})
+	x := newExpander(filepath.Join(dir, "dns.yaml"), nil)
+
+	var tests = []struct {
+		Test, Want string
+	}{
+		{"${DNS_TEST_CONTACT}", "hostmaster.example.org"},
+		{"${DNS_TEST_UNSET:-/var/lib/geoip.mmdb}", "/var/lib/geoip.mmdb"},
+		{"${DNS_TEST_EMPTY:-default}", "default"},
+		{"${DNS_TEST_EMPTY}", ""},
+		{"${file:secret}", "s3cr3t"},
+		{"${file:" + filepath.Join(dir, "secret") + "}", "s3cr3t"},
+		{"$$5", "$5"},
+	}
+	for _, test := range tests {
+		got, err := x.expand(test.Test)
+		if err != nil {
+			t.Errorf("%q: %v", test.Test, err)
+			continue
+		}
+		if got != test.Want {
+			t.Errorf("%q: expected %q, got %q", test.Test, test.Want, got)
+		}
+	}
+}
+
+func TestExpandValues(t *testing.T) {
+	t.Setenv("DNS_TEST_INJECT", "x\nsyslog: bogus\n#")
+	t.Setenv("DNS_TEST_QUOTE", `a" b: 'c`)
+	t.Setenv("DNS_TEST_BITS", "48")
+	dir := writeFiles(t, map[string]string{"dns.yaml": `
+options:
+  log:
+    # ${DNS_TEST_UNSET}
+    format: "${DNS_TEST_QUOTE}"
+backend:
+  auto:
+  - dns: [dns1.maze.io]
+    encode: {eui64: {bits: '${DNS_TEST_BITS}'}}
+    soa: {contact: '${DNS_TEST_INJECT}'}
+`})
+
+	c, err := load(filepath.Join(dir, "dns.yaml"), map[string]bool{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Options.Syslog != "" {
+		t.Errorf("value added syslog option %q", c.Options.Syslog)
+	}
+	if got := c.Options.Log.Format; got != `a" b: 'c` {
+		t.Errorf("expected quoted value, got %q", got)
+	}
+	b := c.Backend.AutoBackends[0]
+	if got := b.SOA.Contact; got != "x\nsyslog: bogus\n#" {
+		t.Errorf("expected literal value, got %q", got)
+	}
+	if got := b.Encode[0].Value.(yaml.MapSlice)[0]; got.Key != "bits" || got.Value != 48 {
+		t.Errorf("expected bits 48, got %v=%#v", got.Key, got.Value)
+	}
+}
+
+func TestExpandError(t *testing.T) {
+	var tests = []struct {
+		Test, Want string
+	}{
+		{"options:\n  syslog: ${DNS_TEST_UNSET}", "dns.yaml:2: environment variable DNS_TEST_UNSET not set"},
+		{"options: {syslog: '${not-a-name}'}", "dns.yaml:1: invalid environment variable"},
+		{"\n\noptions:\n  syslog: ${file:missing}", "dns.yaml:4: open"},
+		{"{\"options\": {\n  \"syslog\": \"${DNS_TEST_UNSET}\"}}", "dns.json:2: environment variable"},
+		{"# ${DNS_TEST_UNSET}\noptions:\n  syslog: ${DNS_TEST_UNSET}", "dns.yaml:1: environment variable DNS_TEST_UNSET not set (first occurrence of ${DNS_TEST_UNSET})"},
+	}
+	for _, test := range tests {
+		name := "dns.yaml"
+		if strings.HasPrefix(test.Test, "{") {
+			name = "dns.json"
+		}
+		dir := writeFiles(t, map[string]string{name: test.Test})
+		_, err := load(filepath.Join(dir, name), map[string]bool{}, false)
+		if err == nil || !strings.Contains(err.Error(), test.Want) {
+			t.Errorf("%q: expected error %q, got %v", test.Test, test.Want, err)
+		}
+	}
+}
+
+const testIncludeConfig = `
+include: [conf.d/*.yaml]
+backend:
+  views:
+    internal:
+      clients: [172.23.0.0/16]
+  auto:
+  - dns: [dns1.maze.io]
+    encode: {base32: }
+    soa:
+      contact: ${DNS_TEST_CONTACT:-hostmaster.maze.io}
+    answers:
+      '172.23.40.0/24':
+        zone: pub.auto.maze.so
+`
+
+func TestInclude(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"dns.yaml": testIncludeConfig,
+		"conf.d/10-int.yaml": `
+backend:
+  auto:
+  - dns: [dns1.maze.io]
+    encode: {base32: }
+    answers:
+      '172.23.41.0/24':
+        zone: int.auto.maze.so
+        views: [internal]
+`,
+		"conf.d/20-views.yaml": `
+backend:
+  views:
+    office:
+      clients: [172.23.40.0/24]
+`,
+		"conf.d/30-templates.yaml": `
+templates:
+  geo_eu:
+  - {type: "A", ttl: 60, content: "192.0.2.1"}
+`,
+		"conf.d/40-options.yaml": `
+options:
+  cache: {size: 100}
+`,
+		"conf.d/README": "not included",
+	})
+
+	c, err := NewConfig(filepath.Join(dir, "dns.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(c.Backend.AutoBackends); n != 2 {
+		t.Errorf("expected 2 auto backends, got %d", n)
+	}
+	if n := len(c.Backend.Views); n != 2 {
+		t.Errorf("expected 2 views, got %d", n)
+	}
+	if _, found := c.Templates["geo_eu"]; !found {
+		t.Error("expected included template geo_eu")
+	}
+	if got := c.Backend.AutoBackends[0].SOA.Contact; got != "hostmaster.maze.io" {
+		t.Errorf("expected default SOA contact, got %q", got)
+	}
+	if c.Options.Cache == nil || c.Options.Cache.Size != 100 {
+		t.Errorf("expected included cache options, got %+v", c.Options.Cache)
+	}
+	if _, err = c.Backends(); err != nil {
+		t.Error(err)
+	}
+	if c.Backend.Modified.IsZero() {
+		t.Error("expected the configuration modification time")
+	}
+}
+
+func TestIncludeError(t *testing.T) {
+	var tests = []struct {
+		Files map[string]string
+		Want  string
+	}{
+		{
+			map[string]string{"dns.yaml": "include: [missing.yaml]"},
+			"no such file",
+		},
+		{
+			map[string]string{"dns.yaml": "include: [dns.yaml]"},
+			"already included",
+		},
+		{
+			map[string]string{
+				"dns.yaml": "include: [a.yaml]",
+				"a.yaml":   "backend:\n  auto: [\n",
+			},
+			"a.yaml: yaml: line",
+		},
+		{
+			map[string]string{
+				"dns.yaml": "include: [a.yaml]\nbackend:\n  views: {office: {}}",
+				"a.yaml":   "backend:\n  views: {office: {}}",
+			},
+			`view "office" already defined`,
+		},
+		{
+			map[string]string{
+				"dns.yaml": "include: [a.yaml]",
+				"a.yaml":   "backend:\n  auto:\n  - soa: {contact: '${DNS_TEST_UNSET}'}",
+			},
+			"a.yaml:3: environment variable DNS_TEST_UNSET not set",
+		},
+		{
+			map[string]string{
+				"dns.yaml": "include: [a.yaml]\nbackend:\n  auto:\n  - dns: [dns1.maze.io]",
+				"a.yaml":   "backend:\n  auto:\n  - dns: [dns1.maze.io]\n  - dns: []",
+			},
+			"a.yaml: auto backend 2: ",
+		},
+		{
+			map[string]string{
+				"dns.yaml": "include: [a.yaml]\noptions: {strict: true}",
+				"a.yaml":   "backend:\n  templates: {web: []}",
+			},
+			"field templates not found",
+		},
+		{
+			map[string]string{
+				"dns.yaml": "include: [a.yaml]\ntemplates: {web: []}",
+				"a.yaml":   "templates: {web: []}",
+			},
+			`Template "web" already defined`,
+		},
+		{
+			map[string]string{
+				"dns.yaml": "include: [a.yaml]\nbackend:\n  serials: a",
+				"a.yaml":   "backend:\n  serials: b",
+			},
+			"serials file already defined",
+		},
+		{
+			map[string]string{
+				"dns.yaml": "include: [a.yaml]\noptions: {rrl: {rate: 5}}",
+				"a.yaml":   "options: {rrl: {rate: 10}}",
+			},
+			`a.yaml: option "rrl" already defined`,
+		},
+	}
+	for _, test := range tests {
+		dir := writeFiles(t, test.Files)
+		c, err := NewConfig(filepath.Join(dir, "dns.yaml"))
+		if err == nil {
+			_, err = c.Backends()
+		}
+		if err == nil || !strings.Contains(err.Error(), test.Want) {
+			t.Errorf("expected error %q, got %v", test.Want, err)
+		}
+	}
+}
@@ -0,0 +1,579 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// Configuration formats
+const (
+	FormatYAML = "yaml"
+	FormatJSON = "json"
+	FormatTOML = "toml"
+)
+
+var (
+	tomlBareKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	lineRef     = regexp.MustCompile(`line [0-9]+`)
+)
+
+// formatOf returns the configuration format for a file name, files without
+// a known extension are YAML.
+func formatOf(filename string) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		return FormatJSON
+	case ".toml":
+		return FormatTOML
+	default:
+		return FormatYAML
+	}
+}
+
+// node is a JSON or TOML value with the line it was found on. Tables are
+// []*member and arrays []*node, an empty table is not null.
+type node struct {
+	line  int
+	value interface{}
+}
+
+type member struct {
+	key  string
+	line int
+	node *node
+}
+
+// unmarshal decodes a configuration in any format into v, strict rejects
+// unknown and duplicate keys. JSON and TOML are converted to YAML, preserving
+// the order of keys, so all formats share the same keys. Errors refer to lines
+// in the original file.
+func unmarshal(data []byte, format string, v interface{}, strict bool) error {
+	var (
+		n   *node
+		err error
+	)
+	decode := yaml.Unmarshal
+	if strict {
+		decode = yaml.UnmarshalStrict
+	}
+	switch format {
+	case FormatYAML:
+		return decode(data, v)
+	case FormatJSON:
+		n, err = decodeJSON(data)
+	case FormatTOML:
+		n, err = decodeTOML(data)
+	default:
+		return fmt.Errorf("Unknown configuration format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+
+	e := &emitter{}
+	e.emit(n, 0, "")
+	if err = decode(e.buf.Bytes(), v); err != nil {
+		// Errors refer to lines in the emitted YAML
+		return errors.New(lineRef.ReplaceAllStringFunc(err.Error(), func(m string) string {
+			i, _ := strconv.Atoi(m[5:])
+			if i < 1 || i > len(e.lines) {
+				return m
+			}
+			return fmt.Sprintf("line %d", e.lines[i-1])
+		}))
+	}
+	return nil
+}
+
+// emitter writes a node as block style YAML, with each key and value on a
+// line of its own, and records the source line of every line written.
+type emitter struct {
+	buf   bytes.Buffer
+	lines []int
+}
+
+func (e *emitter) line(indent int, line int, text string) {
+	e.buf.WriteString(strings.Repeat(" ", indent))
+	e.buf.WriteString(text)
+	e.buf.WriteByte('\n')
+	e.lines = append(e.lines, line)
+}
+
+// emit writes a node, prefix is the key or sequence entry it belongs to.
+func (e *emitter) emit(n *node, indent int, prefix string) {
+	switch v := n.value.(type) {
+	case []*member:
+		if len(v) == 0 {
+			e.line(indent, n.line, prefix+"{}")
+			return
+		}
+		if prefix != "" {
+			e.line(indent, n.line, strings.TrimSuffix(prefix, " "))
+			indent += 2
+		}
+		for _, m := range v {
+			k, _ := json.Marshal(m.key)
+			e.emit(&node{line: m.line, value: m.node.value}, indent, string(k)+": ")
+		}
+	case []*node:
+		if len(v) == 0 {
+			e.line(indent, n.line, prefix+"[]")
+			return
+		}
+		if prefix != "" {
+			e.line(indent, n.line, strings.TrimSuffix(prefix, " "))
+			indent += 2
+		}
+		for _, item := range v {
+			e.emit(item, indent, "- ")
+		}
+	default:
+		e.line(indent, n.line, prefix+yamlScalar(v))
+	}
+}
+
+// yamlScalar formats a scalar, strings are always quoted so they are never
+// read as another type.
+func yamlScalar(v interface{}) string {
+	switch v.(type) {
+	case string:
+	case nil, bool, int64, float64, time.Time:
+		out, _ := yaml.Marshal(v)
+		return strings.TrimSuffix(string(out), "\n")
+	default:
+		v = fmt.Sprint(v)
+	}
+	out, _ := json.Marshal(v)
+	return string(out)
+}
+
+func decodeJSON(data []byte) (*node, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	n, err := decodeJSONValue(d, data)
+	if err == nil {
+		if _, err = d.Token(); err == io.EOF {
+			return n, nil
+		} else if err == nil {
+			err = fmt.Errorf("unexpected data after top-level value")
+		}
+	}
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return nil, fmt.Errorf("line %d: %v", lineAt(data, d.InputOffset()), err)
+}
+
+func decodeJSONValue(d *json.Decoder, data []byte) (*node, error) {
+	t, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+	n := &node{line: lineAt(data, d.InputOffset())}
+	switch t := t.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			m := []*member{}
+			for d.More() {
+				k, err := d.Token()
+				if err != nil {
+					return nil, err
+				}
+				line := lineAt(data, d.InputOffset())
+				v, err := decodeJSONValue(d, data)
+				if err != nil {
+					return nil, err
+				}
+				m = append(m, &member{key: k.(string), line: line, node: v})
+			}
+			n.value = m
+			_, err = d.Token()
+			return n, err
+		case '[':
+			l := []*node{}
+			for d.More() {
+				v, err := decodeJSONValue(d, data)
+				if err != nil {
+					return nil, err
+				}
+				l = append(l, v)
+			}
+			n.value = l
+			_, err = d.Token()
+			return n, err
+		}
+		return nil, fmt.Errorf("unexpected %s", t)
+	case json.Number:
+		if n.value, err = t.Int64(); err != nil {
+			n.value, err = t.Float64()
+		}
+		return n, err
+	default:
+		n.value = t
+		return n, nil
+	}
+}
+
+func lineAt(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+func decodeTOML(data []byte) (*node, error) {
+	var m map[string]interface{}
+	md, err := toml.Decode(string(data), &m)
+	if err != nil {
+		return nil, err
+	}
+
+	// Order keys as they appear in the file
+	t := &tomlTree{order: map[string]int{}, lines: tomlLines(string(data))}
+	for i, k := range md.Keys() {
+		if _, found := t.order[strings.Join(k, "\x00")]; !found {
+			t.order[strings.Join(k, "\x00")] = i
+		}
+	}
+	return t.node(m, nil, 1, 0), nil
+}
+
+// tomlTree converts decoded TOML to nodes, order and lines are keyed by the
+// path of a key.
+type tomlTree struct {
+	order map[string]int
+	lines map[string][]int
+}
+
+// line returns the first line of a key after a line, or def if not found.
+func (t *tomlTree) line(path []string, after, def int) int {
+	for _, line := range t.lines[strings.Join(path, "\x00")] {
+		if line > after {
+			return line
+		}
+	}
+	return def
+}
+
+// node converts a value found on a line, after is the line of the array of
+// tables entry it belongs to.
+func (t *tomlTree) node(v interface{}, path []string, line, after int) *node {
+	n := &node{line: line}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make([]*member, 0, len(v))
+		for k, value := range v {
+			sub := append(path[:len(path):len(path)], k)
+			l := t.line(sub, after, line)
+			m = append(m, &member{key: k, line: l, node: t.node(value, sub, l, after)})
+		}
+		sort.SliceStable(m, func(i, j int) bool {
+			pi := append(path[:len(path):len(path)], m[i].key)
+			pj := append(path[:len(path):len(path)], m[j].key)
+			return t.order[strings.Join(pi, "\x00")] < t.order[strings.Join(pj, "\x00")]
+		})
+		n.value = m
+	case []map[string]interface{}:
+		l := make([]*node, len(v))
+		for i, value := range v {
+			// Each entry starts at its own [[table]] header
+			if line = t.line(path, after, line); line > after {
+				after = line
+			}
+			l[i] = t.node(value, path, line, after)
+		}
+		n.value = l
+	case []interface{}:
+		l := make([]*node, len(v))
+		for i, value := range v {
+			l[i] = t.node(value, path, line, after)
+		}
+		n.value = l
+	default:
+		n.value = v
+	}
+	return n
+}
+
+// tomlLines returns the lines of table headers and keys by their path, each
+// time they appear. Implicitly defined tables are found on the line of the
+// first key or header that defines them.
+func tomlLines(data string) map[string][]int {
+	var (
+		lines     = map[string][]int{}
+		table     []string
+		multiline string
+	)
+	add := func(path []string, line, implicit int) {
+		for i := 1; i <= len(path); i++ {
+			k := strings.Join(path[:i], "\x00")
+			if i == len(path) || i > implicit || len(lines[k]) == 0 {
+				lines[k] = append(lines[k], line)
+			}
+		}
+	}
+	for i, text := range strings.Split(data, "\n") {
+		if multiline != "" {
+			if strings.Count(text, multiline)%2 == 1 {
+				multiline = ""
+			}
+			continue
+		}
+		text = strings.TrimSpace(text)
+		switch {
+		case strings.HasPrefix(text, "[["):
+			if path, rest := tomlKeyPath(text[2:]); strings.HasPrefix(rest, "]]") {
+				table = path
+				add(path, i+1, len(path))
+			}
+		case strings.HasPrefix(text, "["):
+			if path, rest := tomlKeyPath(text[1:]); strings.HasPrefix(rest, "]") {
+				table = path
+				add(path, i+1, len(path))
+			}
+		default:
+			path, rest := tomlKeyPath(text)
+			if len(path) == 0 || !strings.HasPrefix(rest, "=") {
+				continue
+			}
+			add(append(table[:len(table):len(table)], path...), i+1, len(table))
+			for _, quote := range []string{`"""`, `'''`} {
+				if strings.Count(rest, quote)%2 == 1 {
+					multiline = quote
+				}
+			}
+		}
+	}
+	return lines
+}
+
+// tomlKeyPath parses a dotted key, and returns the rest of the text.
+func tomlKeyPath(text string) (path []string, rest string) {
+	for {
+		text = strings.TrimLeft(text, " \t")
+		var (
+			key string
+			end int
+		)
+		switch {
+		case strings.HasPrefix(text, `"`):
+			for end = 1; end < len(text) && text[end] != '"'; end++ {
+				if text[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(text) {
+				return nil, text
+			}
+			end++
+			var err error
+			if key, err = strconv.Unquote(text[:end]); err != nil {
+				return nil, text
+			}
+		case strings.HasPrefix(text, "'"):
+			if end = strings.IndexByte(text[1:], '\'') + 2; end < 2 {
+				return nil, text
+			}
+			key = text[1 : end-1]
+		default:
+			for end < len(text) && tomlBareKey.MatchString(text[end:end+1]) {
+				end++
+			}
+			if end == 0 {
+				return nil, text
+			}
+			key = text[:end]
+		}
+		path = append(path, key)
+		text = strings.TrimLeft(text[end:], " \t")
+		if !strings.HasPrefix(text, ".") {
+			return path, text
+		}
+		text = text[1:]
+	}
+}
+
+// Dump returns the configuration in the given format. Expanded strings are
+// dumped unexpanded, so secrets from the environment or files don't end up in
+// the dump.
+func (c *Config) Dump(format string) ([]byte, error) {
+	d := *c
+	// Included files are already merged
+	d.Include = nil
+
+	data, err := yaml.Marshal(&d)
+	if err != nil {
+		return nil, err
+	}
+
+	var v yaml.MapSlice
+	if err = yaml.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	v = unexpand(v, c.expanded).(yaml.MapSlice)
+	switch format {
+	case FormatYAML:
+		return yaml.Marshal(v)
+	case FormatJSON:
+		if data, err = json.Marshal(jsonValue{v}); err != nil {
+			return nil, err
+		}
+		var out bytes.Buffer
+		if err = json.Indent(&out, data, "", "  "); err != nil {
+			return nil, err
+		}
+		out.WriteByte('\n')
+		return out.Bytes(), nil
+	case FormatTOML:
+		var out bytes.Buffer
+		writeTOML(&out, nil, v)
+		return out.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("Unknown configuration format %q", format)
+	}
+}
+
+// unexpand replaces the expanded strings in v by their unexpanded value.
+func unexpand(v interface{}, expanded map[string]string) interface{} {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		for i := range v {
+			v[i].Value = unexpand(v[i].Value, expanded)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = unexpand(v[i], expanded)
+		}
+	case string:
+		if raw, found := expanded[v]; found {
+			return raw
+		}
+	}
+	return v
+}
+
+// jsonValue marshals YAML values to JSON, preserving the order of keys.
+type jsonValue struct {
+	v interface{}
+}
+
+func (j jsonValue) MarshalJSON() ([]byte, error) {
+	switch v := j.v.(type) {
+	case yaml.MapSlice:
+		var buf bytes.Buffer
+		buf.WriteByte('{')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			k, _ := json.Marshal(fmt.Sprint(item.Key))
+			buf.Write(k)
+			buf.WriteByte(':')
+			value, err := json.Marshal(jsonValue{item.Value})
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(value)
+		}
+		buf.WriteByte('}')
+		return buf.Bytes(), nil
+	case []interface{}:
+		l := make([]jsonValue, len(v))
+		for i, value := range v {
+			l[i] = jsonValue{value}
+		}
+		return json.Marshal(l)
+	default:
+		return json.Marshal(v)
+	}
+}
+
+// writeTOML writes a table. Values are written first, followed by sub
+// tables and arrays of tables, each in their original order. Empty values
+// are written as empty tables, TOML has no null.
+func writeTOML(w *bytes.Buffer, path []string, m yaml.MapSlice) {
+	for _, item := range m {
+		if isTOMLTable(item.Value) || isTOMLTableArray(item.Value) {
+			continue
+		}
+		fmt.Fprintf(w, "%s = %s\n", tomlKey(fmt.Sprint(item.Key)), tomlValue(item.Value))
+	}
+	for _, item := range m {
+		sub := append(path[:len(path):len(path)], tomlKey(fmt.Sprint(item.Key)))
+		switch {
+		case isTOMLTable(item.Value):
+			fmt.Fprintf(w, "\n[%s]\n", strings.Join(sub, "."))
+			table, _ := item.Value.(yaml.MapSlice)
+			writeTOML(w, sub, table)
+		case isTOMLTableArray(item.Value):
+			for _, table := range item.Value.([]interface{}) {
+				fmt.Fprintf(w, "\n[[%s]]\n", strings.Join(sub, "."))
+				writeTOML(w, sub, table.(yaml.MapSlice))
+			}
+		}
+	}
+}
+
+func isTOMLTable(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	_, ok := v.(yaml.MapSlice)
+	return ok
+}
+
+func isTOMLTableArray(v interface{}) bool {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 {
+		return false
+	}
+	for _, item := range l {
+		if _, ok := item.(yaml.MapSlice); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func tomlKey(k string) string {
+	if tomlBareKey.MatchString(k) {
+		return k
+	}
+	return strconv.Quote(k)
+}
+
+func tomlValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "{}"
+	case string:
+		return strconv.Quote(v)
+	case yaml.MapSlice:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = tomlKey(fmt.Sprint(item.Key)) + " = " + tomlValue(item.Value)
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = tomlValue(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,404 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/tehmaze-labs/dns/backend"
+	"github.com/tehmaze-labs/dns/message"
+)
+
+const testFormatYAML = `
+options:
+  cache: {size: 100}
+backend:
+  views:
+    all: {}
+  auto:
+  - encode:
+      eui64: {}
+      base32: {}
+    views: [all]
+    prefix: node-
+    dns: [dns1.maze.io, dns2.maze.io]
+    soa: {source: dns1.maze.io, contact: hostmaster.maze.io, serial: 1}
+    answers:
+      '172.23.40.0/24':
+        zone: pub.auto.maze.so
+        suffix: '-4'
+        encode: {base32: {}}
+`
+
+const testFormatJSON = `{
+  "options": {"cache": {"size": 100}},
+  "backend": {
+    "views": {"all": {}},
+    "auto": [{
+      "encode": {"eui64": {}, "base32": {}},
+      "views": ["all"],
+      "prefix": "node-",
+      "dns": ["dns1.maze.io", "dns2.maze.io"],
+      "soa": {"source": "dns1.maze.io", "contact": "hostmaster.maze.io", "serial": 1},
+      "answers": {
+        "172.23.40.0/24": {"zone": "pub.auto.maze.so", "suffix": "-4", "encode": {"base32": {}}}
+      }
+    }]
+  }
+}`
+
+const testFormatTOML = `
+[options.cache]
+size = 100
+
+[backend.views.all]
+
+[[backend.auto]]
+views = ["all"]
+prefix = "node-"
+dns = ["dns1.maze.io", "dns2.maze.io"]
+soa = {source = "dns1.maze.io", contact = "hostmaster.maze.io", serial = 1}
+
+[backend.auto.encode.eui64]
+[backend.auto.encode.base32]
+
+[backend.auto.answers."172.23.40.0/24"]
+zone = "pub.auto.maze.so"
+suffix = "-4"
+encode = {base32 = {}}
+`
+
+func testAutoBackend(t *testing.T, c *Config) *backend.AutoBackend {
+	bs, err := c.Backends()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bs) != 1 {
+		t.Fatalf("expected 1 backend, got %d", len(bs))
+	}
+	return bs[0].(*backend.AutoBackend)
+}
+
+func TestFormats(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"dns.yaml": testFormatYAML,
+		"dns.json": testFormatJSON,
+		"dns.toml": testFormatTOML,
+	})
+
+	var want string
+	for _, name := range []string{"dns.yaml", "dns.json", "dns.toml"} {
+		c, err := NewConfig(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if c.Options.Cache == nil || c.Options.Cache.Size != 100 {
+			t.Errorf("%s: expected cache options, got %+v", name, c.Options.Cache)
+		}
+		b := testAutoBackend(t, c)
+		if len(b.Encode) != 2 || b.Encode[0].Key != "eui64" || b.Encode[1].Key != "base32" {
+			t.Errorf("%s: expected encoders eui64, base32 in order, got %v", name, b.Encode)
+		}
+
+		dump, err := c.Dump(FormatYAML)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want == "" {
+			want = string(dump)
+			t.Logf("%s:\n%s", name, dump)
+		} else if string(dump) != want {
+			t.Errorf("%s: resolved configuration differs from YAML:\n%s", name, dump)
+		}
+	}
+}
+
+func TestDump(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"dns.yaml": testFormatYAML})
+	c, err := NewConfig(filepath.Join(dir, "dns.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testAutoBackend(t, c)
+	want, err := c.Dump(FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Defaults are applied and the SOA is copied to the answers
+	for _, s := range []string{"prefix: node-", "source: dns1.maze.io", "- dns2.maze.io"} {
+		if strings.Count(string(want), s) < 2 {
+			t.Errorf("expected %q in backend and answer:\n%s", s, want)
+		}
+	}
+
+	// The dump reads back to the same configuration in all formats
+	for _, format := range []string{FormatYAML, FormatJSON, FormatTOML} {
+		dump, err := c.Dump(format)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		filename := filepath.Join(dir, "dump."+format)
+		if err = ioutil.WriteFile(filename, dump, 0644); err != nil {
+			t.Fatal(err)
+		}
+		d, err := NewConfig(filename)
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", format, err, dump)
+		}
+		testAutoBackend(t, d)
+		got, err := d.Dump(FormatYAML)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s: dump differs:\n%s\nexpected:\n%s", format, got, want)
+		}
+	}
+}
+
+func TestDumpSOA(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"dns.yaml": `
+backend:
+  auto:
+  - dns: [dns1.maze.io]
+    encode: {base32: }
+    soa: {contact: hostmaster.maze.io}
+    answers:
+      '172.23.40.0/24':
+        zone: pub.auto.maze.so
+      '172.23.41.0/24':
+        zone: int.auto.maze.so
+        soa: {source: dns2.maze.io, refresh: 7200, serialmode: hash}
+`})
+	c, err := NewConfig(filepath.Join(dir, "dns.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := testAutoBackend(t, c)
+	dump, err := c.Dump(FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The dumped SOA records are the ones served, without further defaults
+	d := &Config{}
+	if err = unmarshal(dump, FormatYAML, d, true); err != nil {
+		t.Fatal(err)
+	}
+	for network, answer := range d.Backend.AutoBackends[0].Answers {
+		s := answer.SOA
+		want := fmt.Sprintf("%s. %s. %d %d %d %d %d", s.Source, s.Contact, s.Serial, s.Refresh, s.Retry, s.Expire, s.TTL)
+		_, ipnet, _ := net.ParseCIDR(network)
+		r, err := b.Query(&message.Message{
+			Name:       []byte(backend.ReverseNetwork(ipnet)),
+			Class:      dns.ClassINET,
+			Type:       dns.TypeSOA,
+			ID:         []byte("-1"),
+			RemoteAddr: net.ParseIP("192.0.2.1"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r) != 1 || string(r[0].Content) != want {
+			t.Errorf("%s: served %v, dumped %q", network, r, want)
+		}
+	}
+	if s := d.Backend.AutoBackends[0].SOA; s.Serial == 0 || s.Refresh == 0 || s.TTL == 0 {
+		t.Errorf("expected SOA defaults in the dump, got %+v", s)
+	}
+}
+
+func TestDumpSecrets(t *testing.T) {
+	t.Setenv("DNS_TEST_SECRET", "s3cr3t-env")
+	dir := writeFiles(t, map[string]string{
+		"dns.yaml": `
+include: [conf.d/*.yaml]
+backend:
+  auto:
+  - dns: [dns1.maze.io]
+    encode: {base32: }
+    soa: {contact: '${DNS_TEST_SECRET}', source: '$${literal}'}
+    answers:
+      '172.23.40.0/24':
+        zone: pub.auto.maze.so
+`,
+		"conf.d/geo.yaml": `
+backend:
+  geo:
+  - zones: [cdn.maze.io]
+    options:
+      database: '${file:../secret.txt}'
+`,
+		"secret.txt": "s3cr3t-file\n",
+	})
+	c, err := NewConfig(filepath.Join(dir, "dns.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, format := range []string{FormatYAML, FormatJSON, FormatTOML} {
+		dump, err := c.Dump(format)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		for _, secret := range []string{"s3cr3t-env", "s3cr3t-file"} {
+			if strings.Contains(string(dump), secret) {
+				t.Errorf("%s: dump contains %q:\n%s", format, secret, dump)
+			}
+		}
+		for _, want := range []string{"${DNS_TEST_SECRET}", "$${literal}", "${file:" + filepath.Join(dir, "secret.txt") + "}"} {
+			if !strings.Contains(string(dump), want) {
+				t.Errorf("%s: dump misses %q:\n%s", format, want, dump)
+			}
+		}
+
+		// The dump expands to the same configuration from anywhere
+		filename := filepath.Join(t.TempDir(), "dump."+format)
+		if err = ioutil.WriteFile(filename, dump, 0644); err != nil {
+			t.Fatal(err)
+		}
+		d, err := NewConfig(filename)
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", format, err, dump)
+		}
+		if got := d.Backend.AutoBackends[0].SOA; got.Contact != "s3cr3t-env" || got.Source != "${literal}" {
+			t.Errorf("%s: got SOA %+v", format, got)
+		}
+		if got := d.Backend.GeoBackends[0].Options.Database; got != "s3cr3t-file" {
+			t.Errorf("%s: got database %q", format, got)
+		}
+	}
+}
+
+const testDumpGeo = `
+templates:
+  web:
+  - {type: A, ttl: 60, content: "192.0.2.{{host}}", weight: 2}
+  - {type: TXT, ttl: 60, content: "say \"hi\"\n# not a comment"}
+backend:
+  views:
+    all: {}
+    office:
+      clients: [172.23.40.0/24]
+  geo:
+  - zones: [cdn.maze.io]
+    views: [all, office]
+    options:
+      database: /var/lib/GeoIP/GeoLite2-City.mmdb
+      reload: 1h
+      answers:
+        continent:
+          eu: {template: web, params: {host: "1"}}
+        default:
+          select: one
+          records:
+          - {type: A, ttl: 60, content: 192.0.2.2}
+      pops:
+        ams: {latitude: 52.37, longitude: 4.89}
+`
+
+func TestDumpGeo(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"dns.yaml": testDumpGeo})
+	c, err := NewConfig(filepath.Join(dir, "dns.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := c.Dump(FormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("dns.yaml:\n%s", want)
+
+	for _, format := range []string{FormatYAML, FormatJSON, FormatTOML} {
+		dump, err := c.Dump(format)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		filename := filepath.Join(dir, "dump."+format)
+		if err = ioutil.WriteFile(filename, dump, 0644); err != nil {
+			t.Fatal(err)
+		}
+		d, err := NewConfig(filename)
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", format, err, dump)
+		}
+		if d.Backend.Views["all"] == nil {
+			t.Errorf("%s: expected empty view all", format)
+		}
+		got, err := d.Dump(FormatYAML)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s: dump differs:\n%s\nexpected:\n%s", format, got, want)
+		}
+	}
+}
+
+func TestStrict(t *testing.T) {
+	var tests = []struct {
+		Name, Data, Want string
+	}{
+		{"dns.yaml", "backend:\n  auto:\n  - options:\n    dns: [dns1.maze.io]\n", "field options not found"},
+		{"dns.json", `{"backend": {"geo": [{"zone": "maze.io"}]}}`, "field zone not found"},
+		{"dns.toml", "[options]\nsyslg = \"daemon\"\n", "field syslg not found"},
+		{"dns.json", "{\n  \"backend\": {\n    \"auto\": [}\n}", "dns.json: line 3:"},
+		{"dns.toml", "[options\n", "dns.toml: toml:"},
+		{"dns.json", testFormatJSON[:len(testFormatJSON)-4] + ", \"bogus\": 1}}", "dns.json: yaml: unmarshal errors:\n  line 15: field bogus not found"},
+		{"dns.json", "{\n  \"options\": {\n    \"cache\": {\"size\": \"many\"}}}", "line 3: cannot unmarshal !!str `many`"},
+		{"dns.toml", testFormatTOML + "bogus = 1\n", "line 20: field bogus not found"},
+		{"dns.toml", "[[backend.auto]]\ndns = []\n\n[[backend.auto]]\n\n[backend.auto.soa]\nsource = \"dns1.maze.io\"\nbogus = 1\n", "line 8: field bogus not found"},
+		{"dns.toml", "[options]\nsyslog = \"\"\"\nbogus = 1\n\"\"\"\n[options.'cache']\n  \"si\\u007Ae\" = \"many\"\n", "line 6: cannot unmarshal"},
+	}
+	for _, test := range tests {
+		dir := writeFiles(t, map[string]string{test.Name: test.Data})
+		_, err := load(filepath.Join(dir, test.Name), map[string]bool{}, true)
+		if err == nil || !strings.Contains(err.Error(), test.Want) {
+			t.Errorf("%s %q: expected error %q, got %v", test.Name, test.Data, test.Want, err)
+		}
+	}
+}
+
+func TestStrictOption(t *testing.T) {
+	var tests = []struct {
+		Files map[string]string
+		Want  string
+	}{
+		{map[string]string{"dns.yaml": "options:\n  syslg: daemon\n"}, ""},
+		{map[string]string{"dns.yaml": "options:\n  strict: true\n  syslg: daemon\n"}, "line 3: field syslg not found"},
+		{map[string]string{"dns.json": `{"options": {"strict": true, "syslg": "daemon"}}`}, "field syslg not found"},
+		{map[string]string{"dns.toml": "[options]\nstrict = true\nsyslg = \"daemon\"\n"}, "line 3: field syslg not found"},
+		{map[string]string{
+			"dns.yaml": "include: [a.yaml]\noptions: {strict: true}",
+			"a.yaml":   "options: {syslg: daemon}",
+		}, "a.yaml: yaml: unmarshal errors"},
+		{map[string]string{
+			"dns.yaml": "include: [a.yaml]\noptions: {strict: true}",
+			"a.yaml":   "options: {strict: true}",
+		}, ""},
+	}
+	for _, test := range tests {
+		dir := writeFiles(t, test.Files)
+		var name string
+		for name = range test.Files {
+			if strings.HasPrefix(name, "dns.") {
+				break
+			}
+		}
+		c, err := load(filepath.Join(dir, name), map[string]bool{}, false)
+		if test.Want == "" {
+			if err != nil {
+				t.Errorf("%v: %v", test.Files, err)
+			} else if c.Options.Syslog != "" {
+				t.Errorf("%v: unknown key decoded", test.Files)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), test.Want) {
+			t.Errorf("%v: expected error %q, got %v", test.Files, test.Want, err)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+// Package dnstap logs queries and responses in dnstap format, as framestream
+// over a unix socket or to a file.
+package dnstap
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	tap "github.com/dnstap/golang-dnstap"
+	"github.com/miekg/dns"
+	"github.com/tehmaze-labs/dns/message"
+	"google.golang.org/protobuf/proto"
+)
+
+// Config configures the dnstap output, exactly one of Socket or File must be
+// set:
+//
+//	dnstap:
+//	  socket: /run/dnstap.sock
+//	  identity: ns1.maze.so
+type Config struct {
+	Socket   string `yaml:"socket,omitempty"`
+	File     string `yaml:"file,omitempty"`
+	Identity string `yaml:"identity,omitempty"`
+	Version  string `yaml:"version,omitempty"`
+}
+
+// Logger sends dnstap messages to the output. Messages are dropped if the
+// output can't keep up.
+type Logger struct {
+	output   tap.Output
+	identity []byte
+	version  []byte
+	dropped  uint64
+}
+
+func New(c *Config) (*Logger, error) {
+	var (
+		output tap.Output
+		err    error
+	)
+	switch {
+	case c.Socket != "" && c.File != "":
+		return nil, errors.New("dnstap needs one of socket or file, not both")
+	case c.Socket != "":
+		output, err = tap.NewFrameStreamSockOutput(&net.UnixAddr{Name: c.Socket, Net: "unix"})
+	case c.File != "":
+		output, err = tap.NewFrameStreamOutputFromFilename(c.File)
+	default:
+		return nil, errors.New("dnstap needs a socket or file")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dnstap: %v", err)
+	}
+
+	l := &Logger{
+		output:   output,
+		identity: []byte(c.Identity),
+		version:  []byte(c.Version),
+	}
+	if len(l.version) == 0 {
+		l.version = []byte("dns-pdns")
+	}
+	go output.RunOutputLoop()
+	return l, nil
+}
+
+// Dropped returns the number of messages dropped.
+func (l *Logger) Dropped() uint64 {
+	return atomic.LoadUint64(&l.dropped)
+}
+
+// Close flushes pending messages and closes the output.
+func (l *Logger) Close() {
+	l.output.Close()
+}
+
+// Log sends an AUTH_QUERY and AUTH_RESPONSE message for the query m, received
+// at start, and its answers.
+func (l *Logger) Log(m *message.Message, answers []*message.Message, start time.Time) {
+	query := queryMsg(m)
+	response := query.Copy()
+	response.Response = true
+	response.Authoritative = true
+	var scope int
+	for _, a := range answers {
+		if rr := answerRR(a); rr != nil {
+			response.Answer = append(response.Answer, rr)
+		}
+		if a != nil && a.ScopeBits > scope {
+			scope = a.ScopeBits
+		}
+	}
+	if opt := response.IsEdns0(); opt != nil {
+		for _, o := range opt.Option {
+			if subnet, ok := o.(*dns.EDNS0_SUBNET); ok {
+				subnet.SourceScope = uint8(scope)
+			}
+		}
+	}
+
+	queryWire, err := query.Pack()
+	if err != nil {
+		return
+	}
+	responseWire, err := response.Pack()
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	q := l.message(m, tap.Message_AUTH_QUERY)
+	q.QueryTimeSec, q.QueryTimeNsec = timestamp(start)
+	q.QueryMessage = queryWire
+	l.send(q)
+
+	r := l.message(m, tap.Message_AUTH_RESPONSE)
+	r.QueryTimeSec, r.QueryTimeNsec = timestamp(start)
+	r.ResponseTimeSec, r.ResponseTimeNsec = timestamp(now)
+	r.QueryMessage = queryWire
+	r.ResponseMessage = responseWire
+	l.send(r)
+}
+
+// message returns a dnstap message with the addresses of m.
+func (l *Logger) message(m *message.Message, t tap.Message_Type) *tap.Message {
+	msg := &tap.Message{
+		Type:           &t,
+		SocketProtocol: tap.SocketProtocol_UDP.Enum(),
+	}
+	family := tap.SocketFamily_INET
+	if m.RemoteAddr != nil {
+		if ip4 := m.RemoteAddr.To4(); ip4 != nil {
+			msg.QueryAddress = ip4
+		} else {
+			msg.QueryAddress = m.RemoteAddr
+			family = tap.SocketFamily_INET6
+		}
+	}
+	if m.LocalAddr != nil {
+		if ip4 := m.LocalAddr.To4(); ip4 != nil {
+			msg.ResponseAddress = ip4
+		} else {
+			msg.ResponseAddress = m.LocalAddr
+		}
+	}
+	msg.SocketFamily = &family
+	return msg
+}
+
+func (l *Logger) send(m *tap.Message) {
+	t := tap.Dnstap_MESSAGE
+	frame, err := proto.Marshal(&tap.Dnstap{
+		Type:     &t,
+		Identity: l.identity,
+		Version:  l.version,
+		Message:  m,
+	})
+	if err != nil {
+		return
+	}
+	select {
+	case l.output.GetOutputChannel() <- frame:
+	default:
+		atomic.AddUint64(&l.dropped, 1)
+	}
+}
+
+func timestamp(t time.Time) (*uint64, *uint32) {
+	sec, nsec := uint64(t.Unix()), uint32(t.Nanosecond())
+	return &sec, &nsec
+}
+
+// queryMsg returns the DNS query for m, with the EDNS client subnet if known.
+func queryMsg(m *message.Message) *dns.Msg {
+	q := new(dns.Msg)
+	q.Question = []dns.Question{{
+		Name:   dns.Fqdn(string(m.Name)),
+		Qtype:  m.Type,
+		Qclass: m.Class,
+	}}
+	if m.ClientSubnet != nil {
+		ones, _ := m.ClientSubnet.Mask.Size()
+		subnet := &dns.EDNS0_SUBNET{
+			Code:          dns.EDNS0SUBNET,
+			SourceNetmask: uint8(ones),
+			Address:       m.ClientSubnet.IP,
+		}
+		if m.ClientSubnet.IP.To4() != nil {
+			subnet.Family = 1
+		} else {
+			subnet.Family = 2
+		}
+		opt := &dns.OPT{Hdr: dns.RR_Header{Name: ".", Rrtype: dns.TypeOPT}}
+		opt.SetUDPSize(dns.DefaultMsgSize)
+		opt.Option = append(opt.Option, subnet)
+		q.Extra = append(q.Extra, opt)
+	}
+	return q
+}
+
+// answerRR parses an answer into a resource record, or returns nil if the
+// answer can't be represented.
+func answerRR(a *message.Message) dns.RR {
+	if a == nil {
+		return nil
+	}
+	class, ok := dns.ClassToString[a.Class]
+	if !ok {
+		return nil
+	}
+	qtype, ok := dns.TypeToString[a.Type]
+	if !ok {
+		return nil
+	}
+	content := string(a.Content)
+	if a.Type == dns.TypeTXT && !strings.HasPrefix(content, `"`) {
+		content = fmt.Sprintf("%q", content)
+	}
+	rr, err := dns.NewRR(fmt.Sprintf("%s %d %s %s %s", dns.Fqdn(string(a.Name)), a.TTL, class, qtype, content))
+	if err != nil {
+		return nil
+	}
+	return rr
+}
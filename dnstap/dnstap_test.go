@@ -0,0 +1,120 @@
+package dnstap
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tap "github.com/dnstap/golang-dnstap"
+	"github.com/miekg/dns"
+	"github.com/tehmaze-labs/dns/message"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLogger(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "dnstap.fstrm")
+	l, err := New(&Config{File: filename, Identity: "ns1.example.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, subnet, _ := net.ParseCIDR("198.51.100.0/24")
+	m := &message.Message{
+		Name:         []byte("www.example.org"),
+		Class:        dns.ClassINET,
+		Type:         dns.TypeA,
+		ID:           []byte("-1"),
+		RemoteAddr:   net.ParseIP("192.0.2.1"),
+		LocalAddr:    net.ParseIP("2001:db8::53"),
+		ClientSubnet: subnet,
+	}
+	answers := []*message.Message{
+		{Name: m.Name, Class: dns.ClassINET, Type: dns.TypeA, TTL: 300, Content: []byte("192.0.2.80"), ScopeBits: 24},
+		{Name: m.Name, Class: dns.ClassINET, Type: dns.TypeTXT, TTL: 300, Content: []byte("hello world")},
+	}
+	l.Log(m, answers, time.Now())
+	l.Close()
+
+	input, err := tap.NewFrameStreamInputFromFilename(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frames := make(chan []byte, 8)
+	go func() {
+		input.ReadInto(frames)
+		close(frames)
+	}()
+
+	var got []*tap.Dnstap
+	for frame := range frames {
+		d := new(tap.Dnstap)
+		if err = proto.Unmarshal(frame, d); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, d)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(got))
+	}
+
+	for i, want := range []tap.Message_Type{tap.Message_AUTH_QUERY, tap.Message_AUTH_RESPONSE} {
+		d := got[i]
+		t.Logf("%s", d.Message.GetType())
+		if d.Message.GetType() != want {
+			t.Errorf("message %d: expected %s, got %s", i, want, d.Message.GetType())
+		}
+		if string(d.Identity) != "ns1.example.org" {
+			t.Errorf("message %d: expected identity, got %q", i, d.Identity)
+		}
+		if ip := net.IP(d.Message.QueryAddress); !ip.Equal(m.RemoteAddr) {
+			t.Errorf("message %d: expected query address %s, got %s", i, m.RemoteAddr, ip)
+		}
+		if ip := net.IP(d.Message.ResponseAddress); !ip.Equal(m.LocalAddr) {
+			t.Errorf("message %d: expected response address %s, got %s", i, m.LocalAddr, ip)
+		}
+
+		q := new(dns.Msg)
+		if err = q.Unpack(d.Message.QueryMessage); err != nil {
+			t.Fatal(err)
+		}
+		if q.Question[0].Name != "www.example.org." || q.Question[0].Qtype != dns.TypeA {
+			t.Errorf("message %d: unexpected question %s", i, q.Question[0].String())
+		}
+		opt := q.IsEdns0()
+		if opt == nil || len(opt.Option) != 1 {
+			t.Fatalf("message %d: expected client subnet option", i)
+		}
+		if s := opt.Option[0].(*dns.EDNS0_SUBNET); !s.Address.Equal(subnet.IP) || s.SourceNetmask != 24 {
+			t.Errorf("message %d: expected client subnet %s, got %s", i, subnet, s)
+		}
+	}
+
+	r := new(dns.Msg)
+	if err = r.Unpack(got[1].Message.ResponseMessage); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Answer) != 2 {
+		t.Fatalf("expected 2 answers, got %d", len(r.Answer))
+	}
+	for _, rr := range r.Answer {
+		t.Logf("%s", rr)
+	}
+	if a, ok := r.Answer[0].(*dns.A); !ok || a.A.String() != "192.0.2.80" {
+		t.Errorf("expected A 192.0.2.80, got %s", r.Answer[0])
+	}
+	if txt, ok := r.Answer[1].(*dns.TXT); !ok || txt.Txt[0] != "hello world" {
+		t.Errorf("expected TXT \"hello world\", got %s", r.Answer[1])
+	}
+	if s := r.IsEdns0().Option[0].(*dns.EDNS0_SUBNET); s.SourceScope != 24 {
+		t.Errorf("expected scope 24, got %d", s.SourceScope)
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	for _, c := range []*Config{{}, {Socket: "/tmp/a", File: "/tmp/b"}} {
+		if _, err := New(c); err == nil {
+			t.Errorf("expected error for %+v", c)
+		}
+	}
+}
@@ -1,6 +1,12 @@
 package encoder
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/tehmaze-labs/dns/logging"
+)
+
+var logger = logging.For("encoder")
 
 type Encoder interface {
 	Config(opt map[string]interface{}) (err error)
@@ -14,6 +20,10 @@ func NewEncoder(t string, opt map[string]interface{}) (e Encoder, err error) {
 		e = NewBase32()
 	case "eui64":
 		e = NewEUI64()
+	case "map":
+		e = NewMap()
+	case "template":
+		e = NewTemplate()
 	default:
 		return nil, fmt.Errorf("No encoder with type %q found", t)
 	}
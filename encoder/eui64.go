@@ -3,72 +3,129 @@ package encoder
 // http://standards.ieee.org/develop/regauth/tut/eui64.pdf
 
 import (
-	"bufio"
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"log"
 	"os"
-	"regexp"
 	"strconv"
 	"strings"
 )
 
 const hexDigit = "0123456789abcdef"
 
-var vendorStrip = regexp.MustCompile(`[^- a-z0-9]`)
-var vendorDashes = regexp.MustCompile(`-[-]*`)
-var vendorStopWords = map[string]bool{
-	"bv":            true,
-	"company":       true,
-	"co":            true,
-	"communication": true,
-	"corp":          true,
-	"corporate":     true,
-	"corporation":   true,
-	"coltd":         true,
-	"devices":       true,
-	"electronica":   true,
-	"electronics":   true,
-	"gmbh":          true,
-	"inc":           true,
-	"int":           true,
-	"international": true,
-	"limited":       true,
-	"llg":           true,
-	"ltd":           true,
-	"manufacturing": true,
-	"srl":           true,
-	"systemes":      true,
-	"systems":       true,
-	"technologies":  true,
-	"technology":    true,
-	"the":           true,
-}
-
 type EUI64 struct {
-	vendors map[string]string
+	vendors ouiRegistry
+	names   *vendorNames
+	classes map[iidClass]Encoder
+	tags    map[iidClass]string
 }
 
 func NewEUI64() *EUI64 {
-	return &EUI64{map[string]string{}}
+	return &EUI64{
+		vendors: ouiRegistry{},
+		names:   newVendorNames(),
+		classes: map[iidClass]Encoder{},
+		tags:    map[iidClass]string{},
+	}
 }
 
 func (e *EUI64) Config(opt map[string]interface{}) (err error) {
 	for k, v := range opt {
-		if k != "oui" {
-			return fmt.Errorf("Unknown eui64 option %q", k)
+		if c, found := iidClassNames[k]; found {
+			if e.classes[c], err = newEncoderSpec(v); err != nil {
+				return fmt.Errorf("eui64 %s: %v", k, err)
+			}
+			continue
+		}
+		if handled, err := e.names.config(k, v); handled {
+			if err != nil {
+				return err
+			}
+			continue
 		}
 
-		if filename, found := v.(string); found {
-			e.ParseOUI(filename)
+		switch k {
+		case "oui":
+			switch v := v.(type) {
+			case string:
+				err = e.ParseOUI(v)
+			case []interface{}:
+				for _, filename := range v {
+					if err != nil {
+						break
+					}
+					if filename, found := filename.(string); found {
+						err = e.ParseOUI(filename)
+					} else {
+						err = fmt.Errorf("Option %q expects a list of file names", k)
+					}
+				}
+			default:
+				err = fmt.Errorf("Option %q expects a file name, got %T", k, v)
+			}
+			if err != nil {
+				return
+			}
+		case "tags":
+			var tags map[string]interface{}
+			if tags, err = optMap(k, v); err != nil {
+				return
+			}
+			for name, tag := range tags {
+				c, found := iidClassNames[name]
+				if !found || c == iidEUI64 {
+					return fmt.Errorf("Unknown eui64 class %q", name)
+				}
+				if e.tags[c], err = optString(name, tag); err != nil {
+					return
+				}
+			}
+		default:
+			return fmt.Errorf("Unknown eui64 option %q", k)
 		}
 	}
 
 	return
 }
 
+func (e *EUI64) tag(c iidClass) string {
+	if tag, found := e.tags[c]; found {
+		return tag
+	}
+	return iidClassTags[c]
+}
+
+// class returns the sub encoder for an interface identifier class, falling
+// back to the default for all classes but EUI-64.
+func (e *EUI64) class(c iidClass) (Encoder, bool) {
+	if sub, found := e.classes[c]; found {
+		return sub, true
+	}
+	return iidDefaultEncoder(c)
+}
+
 func (e *EUI64) Decode(src string) (out []byte, err error) {
+	for c := iidISATAP; c <= iidRandom; c++ {
+		sub, _ := e.class(c)
+		if tag := e.tag(c) + "-"; strings.HasPrefix(src, tag) {
+			src = src[len(tag):]
+			universal := c == iidISATAP && strings.HasPrefix(src, "u-")
+			if universal {
+				src = src[2:]
+			}
+			if out, err = sub.Decode(src); err != nil {
+				return nil, err
+			}
+			if out, err = iidFromPayload(c, out); err == nil && universal {
+				out[0] |= 0x02
+			}
+			return
+		}
+	}
+	if sub, found := e.classes[iidEUI64]; found {
+		return sub.Decode(src)
+	}
+
 	p := strings.Split(src, "-")
 	if len(p) < 6 {
 		return nil, errors.New("No encoded OUI found")
@@ -77,7 +134,7 @@ func (e *EUI64) Decode(src string) (out []byte, err error) {
 		p = p[1:]
 	}
 
-	var i, ih, il uint64
+	var i uint64
 
 	for j := 0; j < 6; j++ {
 		k, err := strconv.ParseUint(p[j], 16, 8)
@@ -87,25 +144,46 @@ func (e *EUI64) Decode(src string) (out []byte, err error) {
 		i = (i << 8) | k
 	}
 
-	ih = (i >> 24) ^ 0x20000
-	il = (i & 0xffffff)
-	out = append(out, uint8(ih>>16))
-	out = append(out, uint8(ih>>8))
-	out = append(out, uint8(ih))
-	out = append(out, 0xff)
-	out = append(out, 0xfe)
-	out = append(out, uint8(il>>16))
-	out = append(out, uint8(il>>8))
-	out = append(out, uint8(il))
-	return out, nil
+	return macToEUI64(i), nil
 }
 
 func (e *EUI64) Encode(src []byte) (out string, err error) {
+	// Offsets within a network lose their leading zero octets
+	if len(src) < 8 {
+		src = append(make([]byte, 8-len(src)), src...)
+	}
+
+	a := binary.BigEndian.Uint64(src[len(src)-8:])
+	c := classifyIID(a)
+	if sub, found := e.class(c); found {
+		if out, err = sub.Encode(iidPayload(c, a)); err != nil {
+			return "", err
+		}
+		if c == iidEUI64 {
+			return out, nil
+		}
+		if c == iidISATAP && a&isatapUniversal != 0 {
+			out = "u-" + out
+		}
+		return e.tag(c) + "-" + out, nil
+	}
+
+	mac, err := eui64ToMAC(src)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%s", e.Vendor(fmt.Sprintf("%012x", mac)), formatMAC(mac)), nil
+}
+
+// eui64ToMAC extracts the MAC-48 address from a modified EUI-64 interface
+// identifier, found in the last 8 octets of src.
+func eui64ToMAC(src []byte) (uint64, error) {
 	if src == nil {
-		return "", fmt.Errorf("Not a valid EUI64 address, input nil")
+		return 0, fmt.Errorf("Not a valid EUI64 address, input nil")
 	}
 	if len(src) < 8 {
-		return "", fmt.Errorf("Not a valid EUI64 address, input too %d short", len(src))
+		return 0, fmt.Errorf("Not a valid EUI64 address, input too %d short", len(src))
 	}
 
 	// We're only interested in the last 8 octets
@@ -115,25 +193,35 @@ func (e *EUI64) Encode(src []byte) (out string, err error) {
 
 	// Test to see if this is an EUI64 address
 	a := binary.BigEndian.Uint64(src)
-	if a&0xfffe000000 == 0 {
-		return "", errors.New("Not a valid EUI64 address")
+	if classifyIID(a) != iidEUI64 {
+		return 0, errors.New("Not a valid EUI64 address")
 	}
 
 	ih := (a >> 40) ^ 0x020000
 	il := a & 0x00ffffff
+	return (ih << 24) | il, nil
+}
 
-	buf := make([]byte, 12)
-	binary.BigEndian.PutUint64(buf, (ih<<20)|il)
-
-	return fmt.Sprintf("%s-%02x-%02x-%02x-%02x-%02x-%02x",
-		e.Vendor(fmt.Sprintf("%06x", ih)),
-		(ih>>16)&0xff, (ih>>8)&0xff, ih&0xff,
-		(il>>16)&0xff, (il>>8)&0xff, il&0xff), nil
+// macToEUI64 builds the modified EUI-64 interface identifier for a MAC-48
+// address.
+func macToEUI64(mac uint64) []byte {
+	ih := (mac >> 24) ^ 0x20000
+	il := mac & 0xffffff
+	return []byte{
+		uint8(ih >> 16), uint8(ih >> 8), uint8(ih),
+		0xff, 0xfe,
+		uint8(il >> 16), uint8(il >> 8), uint8(il),
+	}
+}
 
+func formatMAC(mac uint64) string {
+	return fmt.Sprintf("%02x-%02x-%02x-%02x-%02x-%02x",
+		(mac>>40)&0xff, (mac>>32)&0xff, (mac>>24)&0xff,
+		(mac>>16)&0xff, (mac>>8)&0xff, mac&0xff)
 }
 
 func (e *EUI64) ParseOUI(filename string) error {
-	log.Printf("eui64: parsing %s\n", filename)
+	logger.Info("parsing OUI database", "encoder", "eui64", "file", filename)
 
 	f, err := os.Open(filename)
 	if err != nil {
@@ -141,52 +229,45 @@ func (e *EUI64) ParseOUI(filename string) error {
 	}
 	defer f.Close()
 
-	r := bufio.NewReader(f)
-	s := bufio.NewScanner(r)
-
-	// 00-00-00   (hex)              XEROX CORPORATION
-	for s.Scan() {
-		line := s.Text()
-		oui := make([]byte, 3)
-
-		if _, err := fmt.Sscanf(line, "  %02x-%02x-%02x   (hex)\t\t", &oui[0], &oui[1], &oui[2]); err == nil {
-			com := (strings.Split(line, "\t"))[2]
-			e.vendors[fmt.Sprintf("%06x", oui)] = parseVendor(com)
-		}
+	ouis, err := ReadOUI(f)
+	if err != nil {
+		return fmt.Errorf("eui64: error parsing %s: %v", filename, err)
+	}
+	for _, oui := range ouis {
+		e.vendors.add(oui, oui.Organization)
 	}
 
 	return nil
 }
 
-func (e *EUI64) Vendor(oui string) string {
-	var v = ""
-	if len(oui) >= 3 {
-		v = e.vendors[oui[:6]]
+// Vendor looks up the vendor for a (partial) MAC address in hex, using the
+// longest matching assignment. Assignments from parsed OUI files take
+// precedence over the compiled in registry.
+func (e *EUI64) Vendor(mac string) string {
+	if len(mac) < 6 || len(mac) > 12 {
+		return "unknown"
 	}
-	if v == "" {
+	a, err := strconv.ParseUint(mac, 16, 48)
+	if err != nil {
 		return "unknown"
 	}
-	return v
-}
-
-func parseVendor(v string) string {
-	var o, p []string
+	bits := len(mac) * 4
+	a <<= uint(48 - bits)
 
-	v = strings.ToLower(v)
-	v = strings.SplitN(v, "&", 2)[0]
-	v = vendorStrip.ReplaceAllString(v, "")
-	p = strings.Split(v, " ")
-	for _, s := range p {
-		if vendorStopWords[s] {
+	for _, n := range ouiPrefixLengths {
+		if n > bits {
 			continue
 		}
-		o = append(o, s)
+		prefix := a >> uint(48-n)
+		org, found := e.vendors.get(prefix, n)
+		if !found {
+			org = builtinOUI(prefix, n)
+		}
+		if org != "" {
+			return e.names.normalize(org)
+		}
 	}
-	v = strings.Join(o, "-")
-	v = vendorDashes.ReplaceAllString(v, "-")
-	v = strings.Trim(v, "-")
-
-	return v
+	return "unknown"
 }
 
 // Interface completeness validation
@@ -0,0 +1,130 @@
+package encoder
+
+// Interface identifier classes, as seen on SLAAC networks:
+//
+//   eui64   modified EUI-64 derived from a MAC-48 (RFC 4291)
+//   isatap  ISATAP, 0000:5efe or 0200:5efe followed by IPv4 (RFC 5214)
+//   ipv4    IPv4 address embedded in the low 32 bits
+//   manual  manually assigned, only the low 16 bits set
+//   random  anything else, privacy (RFC 4941) or stable-privacy (RFC 7217)
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+)
+
+type iidClass int
+
+const (
+	iidEUI64 iidClass = iota
+	iidISATAP
+	iidIPv4
+	iidManual
+	iidRandom
+)
+
+var iidClassNames = map[string]iidClass{
+	"eui64":  iidEUI64,
+	"isatap": iidISATAP,
+	"ipv4":   iidIPv4,
+	"manual": iidManual,
+	"random": iidRandom,
+}
+
+var iidClassTags = map[iidClass]string{
+	iidISATAP: "isatap",
+	iidIPv4:   "ipv4",
+	iidManual: "host",
+	iidRandom: "rnd",
+}
+
+// isatapUniversal is the u bit of an ISATAP interface identifier, it is
+// carried in the label as a "u-" prefix to the payload.
+const isatapUniversal = 0x0200000000000000
+
+// iidClassDefaults are the sub encoders for classes that are not configured.
+var iidClassDefaults = map[iidClass]interface{}{
+	iidISATAP: map[string]interface{}{"template": map[string]interface{}{"format": "{octet1}-{octet2}-{octet3}-{octet4}"}},
+	iidIPv4:   map[string]interface{}{"template": map[string]interface{}{"format": "{octet1}-{octet2}-{octet3}-{octet4}"}},
+	iidManual: map[string]interface{}{"template": map[string]interface{}{"format": "{offset:hex}"}},
+	iidRandom: "base32",
+}
+
+var (
+	iidDefaultEncoders     map[iidClass]Encoder
+	iidDefaultEncodersOnce sync.Once
+)
+
+// iidDefaultEncoder returns the default sub encoder for a class, the
+// encoders are built on first use as templates embed an EUI64 encoder.
+func iidDefaultEncoder(c iidClass) (Encoder, bool) {
+	iidDefaultEncodersOnce.Do(func() {
+		iidDefaultEncoders = map[iidClass]Encoder{}
+		for c, spec := range iidClassDefaults {
+			e, err := newEncoderSpec(spec)
+			if err != nil {
+				panic(err)
+			}
+			iidDefaultEncoders[c] = e
+		}
+	})
+	e, found := iidDefaultEncoders[c]
+	return e, found
+}
+
+func classifyIID(a uint64) iidClass {
+	switch {
+	case (a>>24)&0xffff == 0xfffe:
+		return iidEUI64
+	case (a>>32)&^0x02000000 == 0x5efe:
+		return iidISATAP
+	case a>>16 == 0:
+		return iidManual
+	case a>>32 == 0:
+		return iidIPv4
+	default:
+		return iidRandom
+	}
+}
+
+// iidPayload returns the part of the interface identifier that is passed on
+// to the sub encoder for its class.
+func iidPayload(c iidClass, a uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, a)
+	switch c {
+	case iidISATAP, iidIPv4:
+		return b[4:]
+	case iidManual:
+		return b[6:]
+	default:
+		return b
+	}
+}
+
+// iidFromPayload rebuilds the interface identifier from a decoded payload.
+func iidFromPayload(c iidClass, p []byte) ([]byte, error) {
+	// Trim left zero bytes, pad back to the payload size below
+	for len(p) > 0 && p[0] == 0x00 {
+		p = p[1:]
+	}
+
+	size := 8
+	switch c {
+	case iidISATAP, iidIPv4:
+		size = 4
+	case iidManual:
+		size = 2
+	}
+	if len(p) > size {
+		return nil, fmt.Errorf("Decoded interface identifier too long (%d > %d)", len(p), size)
+	}
+
+	out := make([]byte, 8)
+	copy(out[8-len(p):], p)
+	if c == iidISATAP {
+		out[2], out[3] = 0x5e, 0xfe
+	}
+	return out, nil
+}
@@ -1,3 +1,21399 @@
+// Code generated by oui2go; DO NOT EDIT.
+
 package encoder
 
-// go:generate oui2go oui.txt
+var ouiBuiltinNames = [...]string{
+	"XEROX CORPORATION",
+	"OMRON TATEISI ELECTRONICS CO.",
+	"MATRIX CORPORATION",
+	"CISCO SYSTEMS, INC.",
+	"FIBRONICS LTD.",
+	"FUJITSU LIMITED",
+	"NEXT, INC.",
+	"SYTEK INC.",
+	"NORMEREL SYSTEMES",
+	"INFORMATION TECHNOLOGY LIMITED",
+	"CAMEX",
+	"NETRONIX",
+	"DATAPOINT CORPORATION",
+	"DU PONT PIXEL SYSTEMS     .",
+	"Oracle",
+	"WEBSTER COMPUTER CORPORATION",
+	"APPLIED DYNAMICS INTERNATIONAL",
+	"ADVANCED MICRO DEVICES",
+	"NOVELL INC.",
+	"BELL TECHNOLOGIES",
+	"CABLETRON SYSTEMS, INC.",
+	"TELSIST INDUSTRIA ELECTRONICA",
+	"Telco Systems, Inc.",
+	"DATAINDUSTRIER DIAB AB",
+	"SUREMAN COMP. & COMMUN. CORP.",
+	"VISUAL TECHNOLOGY INC.",
+	"ABB INDUSTRIAL SYSTEMS AB",
+	"CONNECT AS",
+	"RAMTEK CORP.",
+	"SHA-KEN CO., LTD.",
+	"JAPAN RADIO COMPANY",
+	"PRODIGY SYSTEMS CORPORATION",
+	"IMC NETWORKS CORP.",
+	"TRW - SEDD/INP",
+	"CRISP AUTOMATION, INC",
+	"AUTOTOTE LIMITED",
+	"CHROMATICS INC",
+	"SOCIETE EVIRA",
+	"TIMEPLEX INC.",
+	"VG LABORATORY SYSTEMS LTD",
+	"QPSX COMMUNICATIONS PTY LTD",
+	"Marconi plc",
+	"EGAN MACHINERY COMPANY",
+	"NETWORK RESOURCES CORPORATION",
+	"SPECTRAGRAPHICS CORPORATION",
+	"ATARI CORPORATION",
+	"OXFORD METRICS LIMITED",
+	"CSS LABS",
+	"TOSHIBA CORPORATION",
+	"CHYRON CORPORATION",
+	"i Controls, Inc.",
+	"AUSPEX SYSTEMS INC.",
+	"UNISYS",
+	"SIMPACT",
+	"SYNTREX, INC.",
+	"APPLICON, INC.",
+	"ICE CORPORATION",
+	"METIER MANAGEMENT SYSTEMS LTD.",
+	"MICRO TECHNOLOGY",
+	"CASTELLE CORPORATION",
+	"FORD AEROSPACE & COMM. CORP.",
+	"OLIVETTI NORTH AMERICA",
+	"NICOLET INSTRUMENTS CORP.",
+	"SEIKO EPSON CORPORATION",
+	"APRICOT COMPUTERS, LTD",
+	"ADC CODENOLL TECHNOLOGY CORP.",
+	"ICL DATA OY",
+	"NEC CORPORATION",
+	"DCI CORPORATION",
+	"AMPEX CORPORATION",
+	"LOGICRAFT, INC.",
+	"RADISYS CORPORATION",
+	"HOB ELECTRONIC GMBH & CO. KG",
+	"Intrusion.com, Inc.",
+	"COMPUCORP",
+	"Schnieder Electric",
+	"COMMISSARIAT A L`ENERGIE ATOM.",
+	"DR. B. STRUCK",
+	"SCITEX CORPORATION LTD.",
+	"RACORE COMPUTER PRODUCTS INC.",
+	"HELLIGE GMBH",
+	"SysKonnect GmbH",
+	"ELTEC ELEKTRONIK AG",
+	"TELEMATICS INTERNATIONAL INC.",
+	"CS TELECOM",
+	"ICANN, IANA Department",
+	"SUMITOMO ELECTRIC IND., LTD.",
+	"KONTRON ELEKTRONIK GMBH",
+	"GATEWAY COMMUNICATIONS",
+	"BULL HN INFORMATION SYSTEMS",
+	"BARCO CONTROL ROOMS GMBH",
+	"Yokogawa Electric Corporation",
+	"Network General Corporation",
+	"TALARIS SYSTEMS, INC.",
+	"SOFT * RITE, INC.",
+	"ROSEMOUNT CONTROLS",
+	"CONCORD COMMUNICATIONS INC",
+	"COMPUTER CONSOLES INC.",
+	"SILICON GRAPHICS INC./MIPS",
+	"PRIVATE",
+	"CRAY COMMUNICATIONS, LTD.",
+	"ARTISOFT, INC.",
+	"Madge Ltd.",
+	"HCL LIMITED",
+	"ADRA SYSTEMS INC.",
+	"MINIWARE TECHNOLOGY",
+	"SIECOR CORPORATION",
+	"RICOH COMPANY LTD.",
+	"Nortel Networks",
+	"ABEKAS VIDEO SYSTEM",
+	"INTERPHASE CORPORATION",
+	"LABTAM LIMITED",
+	"NETWORTH INCORPORATED",
+	"DANA COMPUTER INC.",
+	"RESEARCH MACHINES",
+	"AMPERE INCORPORATED",
+	"Oracle Corporation",
+	"CLUSTRIX CORPORATION",
+	"LINOTYPE-HELL AG",
+	"CRAY COMMUNICATIONS A/S",
+	"BAY NETWORKS",
+	"LECTRA SYSTEMES SA",
+	"TADPOLE TECHNOLOGY PLC",
+	"SUPERNET",
+	"CANON INC.",
+	"MEGAHERTZ CORPORATION",
+	"HITACHI, LTD.",
+	"Brocade Communications Systems, Inc.",
+	"CAYMAN SYSTEMS INC.",
+	"DATAHOUSE INFORMATION SYSTEMS",
+	"INFOTRON",
+	"Alloy Computer Products (Australia) Pty Ltd",
+	"Cryptek Inc.",
+	"SOLBOURNE COMPUTER, INC.",
+	"Raytheon",
+	"MICROCOM",
+	"ANRITSU CORPORATION",
+	"COGENT DATA TECHNOLOGIES",
+	"PROTEON INC.",
+	"ASANTE TECHNOLOGIES",
+	"SONY TEKTRONIX CORP.",
+	"MARCONI ELECTRONICS LTD.",
+	"EMC Corporation",
+	"CROSSCOMM CORPORATION",
+	"MTX, INC.",
+	"RC COMPUTER A/S",
+	"INFORMATION INTERNATIONAL, INC",
+	"ROLM MIL-SPEC COMPUTERS",
+	"LOCUS COMPUTING CORPORATION",
+	"MARLI S.A.",
+	"AMERISTAR TECHNOLOGIES INC.",
+	"SANYO Electric Co., Ltd.",
+	"MARQUETTE ELECTRIC CO.",
+	"NETWORK APPLICATION TECHNOLOGY",
+	"ACORN COMPUTERS LIMITED",
+	"Tattile SRL",
+	"NETWORK GENERAL CORPORATION",
+	"NETWORK COMPUTING DEVICES INC.",
+	"STRATUS COMPUTER INC.",
+	"NETWORK SYSTEMS CORP.",
+	"LOGIC MODELING CORPORATION",
+	"CONWARE COMPUTER CONSULTING",
+	"BRUKER INSTRUMENTS INC.",
+	"DASSAULT ELECTRONIQUE",
+	"Canberra Industries, Inc.",
+	"RND-RAD NETWORK DEVICES",
+	"ALPHA MICROSYSTEMS INC.",
+	"TELEVIDEO SYSTEMS, INC.",
+	"CIMLINC INCORPORATED",
+	"EDIMAX COMPUTER COMPANY",
+	"DATABILITY SOFTWARE SYS. INC.",
+	"MICRO-MATIC RESEARCH",
+	"DOVE COMPUTER CORPORATION",
+	"SEIKOSHA CO., LTD.",
+	"MCDONNELL DOUGLAS COMPUTER SYS",
+	"SIIG, INC.",
+	"TRI-DATA",
+	"Rockwell Automation",
+	"MITSUBISHI CABLE COMPANY",
+	"THE NTI GROUP",
+	"SYMMETRIC COMPUTER SYSTEMS",
+	"WESTERN DIGITAL CORPORATION",
+	"INFORMATION PRESENTATION TECH.",
+	"HARRIS CORP COMPUTER SYS DIV",
+	"WATERS DIV. OF MILLIPORE",
+	"ARRIS Group, Inc.",
+	"EON SYSTEMS",
+	"ARIX CORPORATION",
+	"ALTOS COMPUTER SYSTEMS",
+	"Emulex Corporation",
+	"ARRIS International",
+	"COMPU-SHACK ELECTRONIC GMBH",
+	"DENSAN CO., LTD.",
+	"Allied Telesis Labs Ltd",
+	"MEGADATA CORP.",
+	"HAYES MICROCOMPUTER PRODUCTS",
+	"DEVELCON ELECTRONICS LTD.",
+	"ADAPTEC INCORPORATED",
+	"SBE, INC.",
+	"WANG LABORATORIES INC.",
+	"PURE DATA LTD.",
+	"MICROGNOSIS INTERNATIONAL",
+	"PUNCH LINE HOLDING",
+	"DARTMOUTH COLLEGE",
+	"NOVELL, INC.",
+	"NIPPON TELEGRAPH & TELEPHONE",
+	"ATEX",
+	"British Telecommunications plc",
+	"TCL INCORPORATED",
+	"CETIA",
+	"BELL & HOWELL PUB SYS DIV",
+	"QUADRAM CORP.",
+	"GRID SYSTEMS",
+	"ACER TECHNOLOGIES CORP.",
+	"INTEGRATED MICRO PRODUCTS LTD",
+	"IN2 GROUPE INTERTECHNIQUE",
+	"SIGMEX LTD.",
+	"APTOR PRODUITS DE COMM INDUST",
+	"STAR GATE TECHNOLOGIES",
+	"ACCTON TECHNOLOGY CORP.",
+	"ISICAD, INC.",
+	"UPNOD AB",
+	"MATSUSHITA COMM. IND. CO. LTD.",
+	"MICROPROCESS",
+	"APRIL",
+	"NETWORK DESIGNERS, LTD.",
+	"KTI",
+	"SAMSUNG ELECTRONICS CO., LTD.",
+	"MAGNA COMPUTER CORPORATION",
+	"SPIDER COMMUNICATIONS",
+	"GANDALF DATA LIMITED",
+	"Allied Telesis",
+	"DIAMOND SALES LIMITED",
+	"APPLIED MICROSYSTEMS CORP.",
+	"YOUTH KEEP ENTERPRISE CO LTD",
+	"DIGITAL EQUIPMENT CORPORATION",
+	"QUOTRON SYSTEMS INC.",
+	"MICROSAGE COMPUTER SYSTEMS INC",
+	"RECHNER ZUR KOMMUNIKATION",
+	"MEIKO",
+	"HIGH LEVEL HARDWARE",
+	"ANNAPOLIS MICRO SYSTEMS",
+	"CAMTEC ELECTRONICS LTD.",
+	"EQUIP'TRANS",
+	"3COM CORPORATION",
+	"DVICO Co., Ltd.",
+	"Beckhoff Automation GmbH",
+	"Tews Datentechnik GmbH",
+	"Leiser GmbH",
+	"AVLAB Technology, Inc.",
+	"Nagano Japan Radio Co., Ltd.",
+	"CIS TECHNOLOGY INC.",
+	"Space CyberLink, Inc.",
+	"System Talks Inc.",
+	"CORECO, INC.",
+	"Bri-Link Technologies Co., Ltd",
+	"Gotham Networks",
+	"iDigm Inc.",
+	"Shark Multimedia Inc.",
+	"OLYMPUS CORPORATION",
+	"KANDA TSUSHIN KOGYO CO., LTD.",
+	"EXTRATECH CORPORATION",
+	"Netspect Technologies, Inc.",
+	"CANAL +",
+	"EZ Digital Co., Ltd.",
+	"RTUnet (Australia)",
+	"Hoffmann und Burmeister GbR",
+	"Unizone Technologies, Inc.",
+	"Universal Talkware Corporation",
+	"Centillium Communications",
+	"Precidia Technologies, Inc.",
+	"RC Networks, Inc.",
+	"OSCILLOQUARTZ S.A.",
+	"Watchguard Technologies, Inc.",
+	"Trend Communications, Ltd.",
+	"DIGITAL ELECTRONICS CORP.",
+	"Acer Incorporated",
+	"YAESU MUSEN CO., LTD.",
+	"PAC Labs",
+	"OPEN Networks Pty Ltd",
+	"EnjoyWeb, Inc.",
+	"DFI Inc.",
+	"Telematica Sistems Inteligente",
+	"TELENET Co., Ltd.",
+	"Aravox Technologies, Inc.",
+	"Komodo Technology",
+	"PC Partner Ltd.",
+	"Twinhead International Corp",
+	"Extreme Networks",
+	"Bosch Security Systems, Inc.",
+	"Dranetz - BMI",
+	"KYOWA Electronic Instruments C",
+	"Selectron Systems AG",
+	"KDC Corp.",
+	"CyberTAN Technology, Inc.",
+	"IT Farm Corporation",
+	"XAVi Technologies Corp.",
+	"Point Multimedia Systems",
+	"SHELCAD COMMUNICATIONS, LTD.",
+	"BNA SYSTEMS",
+	"TIW SYSTEMS",
+	"RiscStation Ltd.",
+	"Ascom Tateco AB",
+	"Neighbor World Co., Ltd.",
+	"Sendtek Corporation",
+	"CABLE PRINT",
+	"WINSYSTEMS, INC.",
+	"Tesco Controls, Inc.",
+	"Zhone Technologies",
+	"X-traWeb Inc.",
+	"T.D.T. Transfer Data Test GmbH",
+	"Sony Corporation",
+	"Ennovate Networks, Inc.",
+	"Berkeley Process Control",
+	"Shin Kin Enterprises Co., Ltd",
+	"WIN Enterprises, Inc.",
+	"ADTRAN INC",
+	"GILAT COMMUNICATIONS, LTD.",
+	"Ensemble Communications",
+	"CHROMATEK INC.",
+	"ARCHTEK TELECOM CORPORATION",
+	"G3M Corporation",
+	"Promise Technology, Inc.",
+	"FIREWIREDIRECT.COM, INC.",
+	"SYSWAVE CO., LTD",
+	"Electro Industries/Gauge Tech",
+	"S1 Corporation",
+	"Digital Video Broadcasting",
+	"ITALTEL S.p.A/RF-UP-I",
+	"CADANT INC.",
+	"BEST TECHNOLOGY CO., LTD.",
+	"DIGITAL DESIGN GmbH",
+	"ELMEX Co., LTD.",
+	"Meta Machine Technology",
+	"Cygnet Technologies, Inc.",
+	"AirSwitch Corporation",
+	"TC GROUP A/S",
+	"HIOKI E.E. CORPORATION",
+	"VITANA CORPORATION",
+	"Celestix Networks Pte Ltd.",
+	"ALITEC",
+	"LightChip, Inc.",
+	"FOXCONN",
+	"CarrierComm Inc.",
+	"Conklin Corporation",
+	"Inkel Corp.",
+	"ESE Embedded System Engineer'g",
+	"Allied Data Technologies",
+	"TechnoLand Co., LTD.",
+	"AMCC",
+	"CyberOptics Corporation",
+	"Radiant Communications Corp.",
+	"Orient Silver Enterprises",
+	"EDSL",
+	"MARGI Systems, Inc.",
+	"WIRELESS TECHNOLOGY, INC.",
+	"Chengdu Maipu Electric Industrial Co., Ltd.",
+	"Heidelberger Druckmaschinen AG",
+	"AG-E GmbH",
+	"ThermoQuest",
+	"ADTEK System Science Co., Ltd.",
+	"Experience Music Project",
+	"AOpen, Inc.",
+	"DICA TECHNOLOGIES AG",
+	"ANITE TELECOMS",
+	"SIEB & MEYER AG",
+	"Hitachi Aloka Medical, Ltd.",
+	"Uwe Disch",
+	"I2SE GmbH",
+	"LXCO Technologies ag",
+	"Refraction Technology, Inc.",
+	"ROI COMPUTER AG",
+	"NetLinks Co., Ltd.",
+	"Mega Vision",
+	"AudeSi Technologies",
+	"Logitec Corporation",
+	"Kenetec, Inc.",
+	"SMK-M",
+	"SYRED Data Systems",
+	"Texas Digital Systems",
+	"Hanbyul Telecom Co., Ltd.",
+	"Capital Equipment Corporation",
+	"Sena Technologies, Inc.",
+	"Darim Vision",
+	"HeiSei Electronics",
+	"LEUNIG GmbH",
+	"Kyoto Microcomputer Co., Ltd.",
+	"JDS Uniphase Inc.",
+	"E-Control Systems, Inc.",
+	"ESS Technology, Inc.",
+	"ReadyNet",
+	"Infinilink Corporation",
+	"Mag-Tek, Inc.",
+	"Logical Co., Ltd.",
+	"GENESYS LOGIC, INC.",
+	"Microlink Corporation",
+	"Nextcomm, Inc.",
+	"Scientific-Atlanta Arcodan A/S",
+	"UNEX TECHNOLOGY CORPORATION",
+	"Welltech Computer Co., Ltd.",
+	"BMW AG",
+	"Airspan Communications, Ltd.",
+	"Main Street Networks",
+	"Sitara Networks, Inc.",
+	"Coach Master International  d.b.a. CMI Worldwide, Inc.",
+	"Trex Enterprises",
+	"Artesyn Embedded Technologies",
+	"Fulltek Technology Co., Ltd.",
+	"General Bandwidth",
+	"Digital Processing Systems, Inc.",
+	"Precision Electronic Manufacturing",
+	"Wayport, Inc.",
+	"Turin Networks, Inc.",
+	"SAEJIN T&M Co., Ltd.",
+	"Centos, Inc.",
+	"Netsensity, Inc.",
+	"SKF Condition Monitoring",
+	"IC-Net, Inc.",
+	"Frequentis",
+	"Brains Corporation",
+	"Peterson Electro-Musical Products, Inc.",
+	"Gigalink Co., Ltd.",
+	"Teleforce Co., Ltd.",
+	"CompuLab, Ltd.",
+	"Vitesse Semiconductor Corporation",
+	"ARK Research Corp.",
+	"Acromag, Inc.",
+	"NeoWave, Inc.",
+	"Simpler Networks",
+	"Quarry Technologies",
+	"CONRAD CORP.",
+	"Geocast Network Systems, Inc.",
+	"EVR",
+	"Japan Total Design Communication Co., Ltd.",
+	"ARtem",
+	"Custom Micro Products, Ltd.",
+	"Alpha Data Parallel Systems, Ltd.",
+	"VitalPoint, Inc.",
+	"CoNet Communications, Inc.",
+	"inXtron, Inc.",
+	"PAXCOMM, Inc.",
+	"Leisure Time, Inc.",
+	"HAEDONG INFO & COMM CO., LTD",
+	"manroland AG",
+	"F5 Networks, Inc.",
+	"Teltronics, Inc.",
+	"Sigma, Inc.",
+	"WINCOMM Corporation",
+	"Freecom Technologies GmbH",
+	"Activetelco",
+	"Avail Networks",
+	"Trango Systems, Inc.",
+	"ISDN Communications, Ltd.",
+	"Fast Systems, Inc.",
+	"Kinpo Electronics, Inc.",
+	"Ando Electric Corporation",
+	"Siemens AG",
+	"Sitera, Inc.",
+	"Supernet, Inc.",
+	"Hewlett-Packard Company",
+	"Force10 Networks, Inc.",
+	"Litton Marine Systems B.V.",
+	"Cirilium Corp.",
+	"C-COM Corporation",
+	"Ericsson Group",
+	"SETA Corp.",
+	"Comtrol Europe, Ltd.",
+	"Camtel Technology Corp.",
+	"Tridium, Inc.",
+	"Innovative Concepts, Inc.",
+	"Mark of the Unicorn, Inc.",
+	"QPS, Inc.",
+	"Enterasys Networks",
+	"ERIM S.A.",
+	"Association of Musical Electronics Industry",
+	"Image Display Systems, Inc.",
+	"Texio Technology Corporation",
+	"TeraGlobal Communications Corp.",
+	"HOROSCAS",
+	"DoTop Technology, Inc.",
+	"Keyence Corporation",
+	"Digital Voice Systems, Inc.",
+	"Data Direct Networks, Inc.",
+	"Net & Sys Co., Ltd.",
+	"IFM Electronic gmbh",
+	"Amino Communications, Ltd.",
+	"Woonsang Telecom, Inc.",
+	"Bodmann Industries Elektronik GmbH",
+	"Hitachi Denshi, Ltd.",
+	"Telital R&D Denmark A/S",
+	"VisionGlobal Network Corp.",
+	"Unify Networks, Inc.",
+	"Shenzhen SED Information Technology Co., Ltd.",
+	"Gefran Spa",
+	"Native Networks, Inc.",
+	"Metro-Optix",
+	"Micronpc.com",
+	"ECI Telecom, Ltd",
+	"AATR",
+	"Fenecom",
+	"Nature Worldwide Technology Corp.",
+	"SierraCom",
+	"S.D.E.L.",
+	"DTVRO",
+	"Cotas Computer Technology A/B",
+	"Advanced Scientific Corp",
+	"Paralon Technologies",
+	"Zuma Networks",
+	"Kollmorgen-Servotronix",
+	"Network Elements, Inc.",
+	"Data General Communication Ltd.",
+	"SIMTEL S.R.L.",
+	"Aculab PLC",
+	"CANON FINETECH INC.",
+	"DSP Application, Ltd.",
+	"Chromisys, Inc.",
+	"ClickTV",
+	"C-COR",
+	"One Stop Systems",
+	"XESystems, Inc.",
+	"ESD Electronic System Design GmbH",
+	"Necsom, Ltd.",
+	"Adtec Corporation",
+	"Asound Electronic",
+	"SAXA, Inc.",
+	"ABB Bomem, Inc.",
+	"Agere Systems",
+	"TEAC Corp. R& D",
+	"P-Cube, Ltd.",
+	"Intersoft Electronics",
+	"Ingersoll-Rand",
+	"Avision, Inc.",
+	"Mantra Communications, Inc.",
+	"Imperial Technology, Inc.",
+	"Paragon Networks International",
+	"INIT GmbH",
+	"Cosmo Research Corp.",
+	"Serome Technology, Inc.",
+	"Visicom",
+	"ZSK Stickmaschinen GmbH",
+	"Ericsson",
+	"Creative Technology, Ltd.",
+	"Cisco Systems, Inc.",
+	"Selta Telematica S.p.a",
+	"Compal Electronics, Inc.",
+	"Seedek Co., Ltd.",
+	"Amer.com",
+	"Videoframe Systems",
+	"Raysis Co., Ltd.",
+	"SURECOM Technology Co.",
+	"Lampus Co, Ltd.",
+	"All-Win Tech Co., Ltd.",
+	"Great Dragon Information Technology (Group) Co., Ltd.",
+	"Pilz GmbH & Co.",
+	"Aviv Infocom Co, Ltd.",
+	"SiByte, Inc.",
+	"Mannesman Dematic Colby Pty. Ltd.",
+	"Datacard Group",
+	"IPM Datacom S.R.L.",
+	"Geyser Networks, Inc.",
+	"Soma Networks, Inc.",
+	"Carrier Corporation",
+	"Televideo, Inc.",
+	"WorldGate",
+	"IBM Corp",
+	"Alpha Processor, Inc.",
+	"Microcom Corp.",
+	"Flying Packets Communications",
+	"Tsann Kuen China (Shanghai)Enterprise Co., Ltd. IT Group",
+	"Catena Networks",
+	"Cambridge Silicon Radio",
+	"SCI Systems (Kunshan) Co., Ltd.",
+	"Calix Networks",
+	"High Technology Ltd",
+	"Accordion Networks, Inc.",
+	"Tilgin AB",
+	"Soyo Group Soyo Com Tech Co., Ltd",
+	"UPS Manufacturing SRL",
+	"AudioRamp.com",
+	"Virditech Co. Ltd.",
+	"Thermalogic Corporation",
+	"NODE RUNNER, INC.",
+	"Harris Government Communications",
+	"Nadatel Co., Ltd",
+	"Cocess Telecom Co., Ltd.",
+	"BCM Computers Co., Ltd.",
+	"Philips CFT",
+	"Adept Telecom",
+	"NeGeN Access, Inc.",
+	"Senao International Co., Ltd.",
+	"Crewave Co., Ltd.",
+	"CC&C Technologies, Inc.",
+	"Coriolis Networks",
+	"Tommy Technologies Corp.",
+	"SMART Technologies, Inc.",
+	"Primax Electronics Ltd.",
+	"Cash Systemes Industrie",
+	"Samsung Electro-Mechanics Co., Ltd.",
+	"Control Applications, Ltd.",
+	"IOI Technology Corporation",
+	"Amplify Net, Inc.",
+	"Trilithic, Inc.",
+	"ask-technologies.com",
+	"Mu Net, Inc.",
+	"ViaClix, Inc.",
+	"Spectrum Controls, Inc.",
+	"AREVA T&D",
+	"Riverstone Networks",
+	"Occam Networks",
+	"Adapcom",
+	"GLOBAL VILLAGE COMMUNICATION",
+	"DNE Technologies",
+	"Ambit Microsystems Corporation",
+	"VDSL Systems OY",
+	"Micrel-Synergy Semiconductor",
+	"Movita Technologies, Inc.",
+	"Rapid 5 Networks, Inc.",
+	"Globetek, Inc.",
+	"Woorigisool, Inc.",
+	"Open Network Co., Ltd.",
+	"Logic Innovations, Inc.",
+	"Solid Data Systems",
+	"Tokyo Sokushin Co., Ltd.",
+	"IP.Access Limited",
+	"Lectron Co,. Ltd.",
+	"C-COR.net",
+	"Broadframe Corporation",
+	"Apex, Inc.",
+	"Storage Apps",
+	"Kreatel Communications AB",
+	"3COM",
+	"Merix Corp.",
+	"Information Equipment Co., Ltd.",
+	"L-3 Communication Aviation Recorders",
+	"Flatstack Ltd.",
+	"World Wide Packets",
+	"Hilscher GmbH",
+	"ABB Switzerland Ltd, Power Systems",
+	"AddPac Technology Co., Ltd.",
+	"Effinet Systems Co., Ltd.",
+	"Vivace Networks",
+	"Air Link Technology",
+	"RACOM, s.r.o.",
+	"PLcom Co., Ltd.",
+	"CTC Union Technologies Co., Ltd.",
+	"3PAR data",
+	"HOYA Corporation",
+	"Scannex Electronics Ltd.",
+	"TeleCruz Technology, Inc.",
+	"Hokubu Communication & Industrial Co., Ltd.",
+	"Anritsu, Ltd.",
+	"Cablevision",
+	"Intel Corporation",
+	"DAPHNE",
+	"Avnet, Inc.",
+	"Acrosser Technology Co., Ltd.",
+	"Watanabe Electric Industry Co., Ltd.",
+	"WHI KONSULT AB",
+	"Continuous Computing Corp",
+	"LVL 7 Systems, Inc.",
+	"Bionet Co., Ltd.",
+	"Totsu Engineering, Inc.",
+	"dotRocket, Inc.",
+	"Bencent Tzeng Industry Co., Ltd.",
+	"Innovative Electronic Designs, Inc.",
+	"Net Vision Telecom",
+	"Arelnet Ltd.",
+	"Vector International BVBA",
+	"Evertz Microsystems Ltd.",
+	"Data Track Technology PLC",
+	"ALPS ELECTRIC Co., Ltd.",
+	"Technocom Communications Technology (pte) Ltd",
+	"Mellanox Technologies",
+	"EndPoints, Inc.",
+	"TriState Ltd.",
+	"M.C.C.I",
+	"TeleDream, Inc.",
+	"FoxJet, Inc.",
+	"ZyGate Communications, Inc.",
+	"Comdial Corporation",
+	"Vivotek, Inc.",
+	"Workstation AG",
+	"NetBotz, Inc.",
+	"PDA Peripherals, Inc.",
+	"ACR",
+	"NICE Systems",
+	"EMPEG Ltd",
+	"BRECIS Communications Corporation",
+	"Reliable Controls",
+	"ExiO Communications, Inc.",
+	"NETSEC",
+	"Fujitsu General Limited",
+	"Bromax Communications, Ltd.",
+	"Astrodesign, Inc.",
+	"Net Com Systems, Inc.",
+	"ETAS GmbH",
+	"Integrated Network Corporation",
+	"NDC Infared Engineering",
+	"LITE-ON Communications, Inc.",
+	"JC HYUN Systems, Inc.",
+	"Timeware Ltd.",
+	"Gould Instrument Systems, Inc.",
+	"CAB GmbH & Co KG",
+	"E.D.&A.",
+	"CS Systemes De Securite - C3S",
+	"Focus Enhancements",
+	"Pico Communications",
+	"Maschoff Design Engineering",
+	"DXO Telecom Co., Ltd.",
+	"Nokia Danmark A/S",
+	"CCC Network Systems Group Ltd.",
+	"AME Optimedia Technology Co., Ltd.",
+	"Pinetron Co., Ltd.",
+	"eDevice, Inc.",
+	"Media Serve Co., Ltd.",
+	"PCTEL, Inc.",
+	"VIVE Synergies, Inc.",
+	"Equipe Communications",
+	"ARM",
+	"SEAKR Engineering, Inc.",
+	"MIMOS Berhad",
+	"DX Antenna Co., Ltd.",
+	"Baumuller Aulugen-Systemtechnik GmbH",
+	"Viditec, Inc.",
+	"Handan BroadInfoCom",
+	"Barracuda Networks, Inc.",
+	"EXFO",
+	"Charles Industries, Ltd.",
+	"JAMA Electronics Co., Ltd.",
+	"Pacific Broadband Communications",
+	"MSC Vertriebs GmbH",
+	"Fusion In Tech Co., Ltd.",
+	"Secure Works, Inc.",
+	"AM Communications, Inc.",
+	"Texcel Technology PLC",
+	"Argus Technologies",
+	"Hunter Technology, Inc.",
+	"Telesoft Technologies Ltd.",
+	"Uniwill Computer Corp.",
+	"Core Communications Co., Ltd.",
+	"Digital China (Shanghai) Networks Ltd.",
+	"E-Globaledge Corporation",
+	"Micro Technology Co., Ltd.",
+	"TR-Systemtechnik GmbH",
+	"Access Media SPA",
+	"Teleware Network Systems",
+	"Cidco Incorporated",
+	"Nobell Communications, Inc.",
+	"Merlin Systems, Inc.",
+	"Cyras Systems, Inc.",
+	"Infineon AG",
+	"Beijing Broad Telecom Ltd., China",
+	"Cellvision Systems, Inc.",
+	"Svenska Hardvarufabriken AB",
+	"Taiwan Commate Computer, Inc.",
+	"Optranet, Inc.",
+	"Condev Ltd.",
+	"Xpeed, Inc.",
+	"Reco Research Co., Ltd.",
+	"IDIS Co., Ltd.",
+	"Cornet Technology, Inc.",
+	"SANYO Consumer Electronics Co., Ltd.",
+	"Arima Computer Corp.",
+	"Iwasaki Information Systems Co., Ltd.",
+	"ACT'L",
+	"Mace Group, Inc.",
+	"F3, Inc.",
+	"UniData Communication Systems, Inc.",
+	"GAI Datenfunksysteme GmbH",
+	"ABB Switzerland Ltd",
+	"IBASE Technology, Inc.",
+	"Scope Information Management, Ltd.",
+	"Global Sun Technology, Inc.",
+	"Imagenics, Co., Ltd.",
+	"Digitel Co., Ltd.",
+	"Newport Electronics",
+	"Mirae Technology",
+	"Zetes Technologies",
+	"Vaone, Inc.",
+	"Oak Technology",
+	"Eurologic Systems, Ltd.",
+	"Silicon Wave, Inc.",
+	"TAMI Tech Co., Ltd.",
+	"Daiden Co., Ltd.",
+	"ILSHin Lab",
+	"Tateyama System Laboratory Co., Ltd.",
+	"BigBand Networks, Ltd.",
+	"Floware Wireless Systems, Ltd.",
+	"Axon Digital Design",
+	"Martin Professional A/S",
+	"Tietech.Co., Ltd.",
+	"Routrek Networks Corporation",
+	"Hitachi Kokusai Electric, Inc.",
+	"Norscan Instruments, Ltd.",
+	"Vidicode Datacommunicatie B.V.",
+	"RIAS Corporation",
+	"Shanghai DigiVision Technology Co., Ltd.",
+	"Chiaro Networks, Ltd.",
+	"Pos Data Company, Ltd.",
+	"Sur-Gard Security",
+	"BTICINO SPA",
+	"Diebold, Inc.",
+	"Colubris Networks",
+	"Mitac, Inc.",
+	"Fiber Logic Communications",
+	"TeraBeam Internet Systems",
+	"Wincor Nixdorf International GmbH",
+	"Intervoice-Brite, Inc.",
+	"Hanyang Digitech Co., Ltd.",
+	"DigitalSis",
+	"Photron Limited",
+	"BridgeWave Communications",
+	"Saint Song Corp.",
+	"Bosung Hi-Net Co., Ltd.",
+	"Metropolitan Area Networks, Inc.",
+	"Prüftechnik Condition Monitoring GmbH & Co. KG",
+	"PAC Interactive Technology, Inc.",
+	"Widcomm, Inc.",
+	"Vodtel Communications, Inc.",
+	"Miraesys Co., Ltd.",
+	"Scenix Semiconductor, Inc.",
+	"Kira Information & Communications, Ltd.",
+	"ASM Pacific Technology",
+	"Jasmine Networks, Inc.",
+	"Embedone Co., Ltd.",
+	"Nippon Antenna Co., Ltd.",
+	"Mainnet, Ltd.",
+	"Runtop, Inc.",
+	"Nicon Systems (Pty) Limited",
+	"Telsey SPA",
+	"NXTV, Inc.",
+	"Acomz Networks Corp.",
+	"ULAN",
+	"Aselsan A.S",
+	"Control Microsystems",
+	"NetMedia, Inc.",
+	"Graphtec Technology, Inc.",
+	"Gigabit Wireless",
+	"HUMAX Co., Ltd.",
+	"Proscend Communications, Inc.",
+	"Taiyo Yuden Co., Ltd.",
+	"IDEC IZUMI Corporation",
+	"Coax Media",
+	"Stellcom",
+	"PORTech Communications, Inc.",
+	"Atheros Communications, Inc.",
+	"SSH Communications Security Corp.",
+	"Ingenico International",
+	"A-One Co., Ltd.",
+	"Metera Networks, Inc.",
+	"AETA",
+	"Actelis Networks, Inc.",
+	"Ho Net, Inc.",
+	"Blaze Network Products",
+	"Fastfame Technology Co., Ltd.",
+	"Plantronics",
+	"America Online, Inc.",
+	"PLUS-ONE I&T, Inc.",
+	"Total Impact",
+	"PCS Revenue Control Systems, Inc.",
+	"Atoga Systems, Inc.",
+	"Weinschel Corporation",
+	"Digital Video Communications, Inc.",
+	"Advanced Digital Broadcast, Ltd.",
+	"Hyundai Teletek Co., Ltd.",
+	"Apple",
+	"Connect One",
+	"California Amplifier",
+	"EZ Cast Co., Ltd.",
+	"Watchfront Limited",
+	"WISI",
+	"Dongju Informations & Communications Co., Ltd.",
+	"SiConnect",
+	"NetChip Technology, Inc.",
+	"OptiMight Communications, Inc.",
+	"Qisda Corporation",
+	"Tera System Co., Ltd.",
+	"HIPER Information & Communication, Inc.",
+	"Catapult Communications",
+	"MAVIX, Ltd.",
+	"Imation Corp.",
+	"Medea Corporation",
+	"Traxit Technology, Inc.",
+	"Unixtar Technology, Inc.",
+	"IDOT Computers, Inc.",
+	"AXCENT Media AG",
+	"Watlow",
+	"Bridge Information Systems",
+	"Fronius Schweissmaschinen",
+	"Emerson Energy Systems AB",
+	"Allied Advanced Manufacturing Pte, Ltd.",
+	"Paragea Communications",
+	"Xsense Technology Corp.",
+	"Hospira Inc.",
+	"Radware",
+	"IA Link Systems Co., Ltd.",
+	"Macrotek International Corp.",
+	"Entra Technology Co.",
+	"QSI Corporation",
+	"ZACCESS Systems",
+	"NetKit Solutions, LLC",
+	"Hualong Telecom Co., Ltd.",
+	"Signal Communications Limited",
+	"COT GmbH",
+	"OmniCluster Technologies, Inc.",
+	"Netility",
+	"Centerpoint Broadband Technologies, Inc.",
+	"RFTNC Co., Ltd.",
+	"Packet Dynamics Ltd",
+	"Solphone K.K.",
+	"Micronik Multimedia",
+	"Tomra Systems ASA",
+	"Mobotix AG",
+	"ICUE Systems, Inc.",
+	"hopf Elektronik GmbH",
+	"CML Emergency Services",
+	"TECOM Co., Ltd.",
+	"MTS Systems Corp.",
+	"Nippon Systems Development Co., Ltd.",
+	"Momentum Computer, Inc.",
+	"Clovertech, Inc.",
+	"ETEN Technologies, Inc.",
+	"Muxcom, Inc.",
+	"KOANKEISO Co., Ltd.",
+	"Takaya Corporation",
+	"Crossbeam Systems, Inc.",
+	"Internet Energy Systems, Inc.",
+	"Alloptic, Inc.",
+	"Advanced Communications Co., Ltd.",
+	"RADVision, Ltd.",
+	"NextNet Wireless, Inc.",
+	"iMPath Networks, Inc.",
+	"Secheron SA",
+	"Takamisawa Cybernetics Co., Ltd.",
+	"Apogee Electronics Corp.",
+	"Lexar Media, Inc.",
+	"Comark Corp.",
+	"OTC Wireless",
+	"Desana Systems",
+	"Winmate Communication, Inc.",
+	"Comspace Corporation",
+	"Hermstedt SG",
+	"Entone, Inc.",
+	"Logostek Co. Ltd.",
+	"Wavelength Digital Limited",
+	"Akara Canada, Inc.",
+	"Mega System Technologies, Inc.",
+	"Atrica",
+	"ICG Research, Inc.",
+	"Shinkawa Electric Co., Ltd.",
+	"MKNet Corporation",
+	"Oneline AG",
+	"Redfern Broadband Networks",
+	"Cicada Semiconductor, Inc.",
+	"Seneca Networks",
+	"Dazzle Multimedia, Inc.",
+	"NetBurner",
+	"Chip2Chip",
+	"Allegro Networks, Inc.",
+	"Plast-Control GmbH",
+	"SanCastle Technologies, Inc.",
+	"Pleiades Communications, Inc.",
+	"TiMetra Networks",
+	"ENEGATE Co.,Ltd.",
+	"Intertex Data AB",
+	"Microsoft Corporation",
+	"LEXMARK INTERNATIONAL, INC.",
+	"Osaki Electric Co., Ltd.",
+	"Nexsan Technologies, Ltd.",
+	"Nexsi Corporation",
+	"Makino Milling Machine Co., Ltd.",
+	"ACN Technologies",
+	"Fa. Metabox AG",
+	"Topcon Positioning Systems, Inc.",
+	"Sanko Electronics Co., Ltd.",
+	"Cratos Networks",
+	"Sage Systems",
+	"3com Europe Ltd.",
+	"Kanno Works, Ltd.",
+	"Avaya, Inc.",
+	"AVM GmbH",
+	"Asus Network Technologies, Inc.",
+	"Spinnaker Networks, Inc.",
+	"Inkra Networks, Inc.",
+	"WaveSmith Networks, Inc.",
+	"SNOM Technology AG",
+	"Umezawa Musen Denki Co., Ltd.",
+	"Rasteme Systems Co., Ltd.",
+	"Parks S/A Comunicacoes Digitais",
+	"ELAU AG",
+	"Teltronic S.A.U.",
+	"Fibercycle Networks, Inc.",
+	"Ines Test and Measurement GmbH & CoKG",
+	"Bridgeworks Ltd.",
+	"ipDialog, Inc.",
+	"Corega of America",
+	"Shikoku Instrumentation Co., Ltd.",
+	"Sony Computer Entertainment, Inc.",
+	"Slim Devices, Inc.",
+	"Ocular Networks",
+	"Gordon Kapes, Inc.",
+	"TMC s.r.l.",
+	"Atmel Corporation",
+	"Autosys",
+	"Pixord Corporation",
+	"Wireless Networks, Inc.",
+	"IT Access Co., Ltd.",
+	"Minet, Inc.",
+	"Sarian Systems, Ltd.",
+	"Netous Technologies, Ltd.",
+	"International Communications Products, Inc.",
+	"Netgem",
+	"GlobalStreams, Inc.",
+	"Voyetra Turtle Beach, Inc.",
+	"Cyberboard A/S",
+	"Accelent Systems, Inc.",
+	"Comptek International, Inc.",
+	"ELANsat Technologies, Inc.",
+	"Powin Information Technology, Inc.",
+	"Rosco Entertainment Technology, Inc.",
+	"Intelligent Telecommunications, Inc.",
+	"Lava Computer Mfg., Inc.",
+	"SONOS Co., Ltd.",
+	"INDEL AG",
+	"Telencomm",
+	"ESTeem Wireless Modems, Inc",
+	"cyberPIXIE, Inc.",
+	"Half Dome Systems, Inc.",
+	"NACT",
+	"Agilent Technologies, Inc.",
+	"Western Multiplex Corporation",
+	"LMS Skalar Instruments GmbH",
+	"CYZENTECH Co., Ltd.",
+	"Acrowave Systems Co., Ltd.",
+	"Polaroid Corporation",
+	"Mapletree Networks",
+	"iPolicy Networks, Inc.",
+	"NVIDIA",
+	"JENOPTIK",
+	"Leukhardt Systemelektronik GmbH",
+	"DMD Computers SRL",
+	"Medrad, Inc.",
+	"RocketLogix, Inc.",
+	"YottaYotta, Inc.",
+	"Quadriga UK",
+	"ANTARA.net",
+	"Cambium Networks Limited",
+	"Universal Access Technology, Inc.",
+	"Fusion X Co., Ltd.",
+	"Veristar Corporation",
+	"The Linksys Group, Inc.",
+	"Techsan Electronics Co., Ltd.",
+	"Mobiwave Pte Ltd",
+	"BEKA Elektronik",
+	"PolyTrax Information Technology AG",
+	"Avalue Technology, Inc.",
+	"Knilink Technology, Inc.",
+	"EPOX Computer Co., Ltd.",
+	"DAKOS Data & Communication Co., Ltd.",
+	"Bosch Security Systems",
+	"Pulse-Link Inc",
+	"i.s.t isdn-support technik GmbH",
+	"ARMITEL Co.",
+	"Wuhan Research Institute of MII",
+	"Vivity, Inc.",
+	"Innocom, Inc.",
+	"Navini Networks",
+	"Palm Wireless, Inc.",
+	"Cyber Technology Co., Ltd.",
+	"Digitel S/A Industria Eletronica",
+	"ipUnplugged AB",
+	"IPrad",
+	"Telelynx, Inc.",
+	"Photonex Corporation",
+	"LEGRAND",
+	"3 Com Corporation",
+	"Scalant Systems, Inc.",
+	"G. Star Technology Corporation",
+	"Radius Co., Ltd.",
+	"AXXESSIT ASA",
+	"Schlumberger",
+	"Skidata AG",
+	"Pelco",
+	"Siqura B.V.",
+	"Chr. Mayr GmbH & Co. KG",
+	"Brocade Communications Systems, Inc",
+	"Econolite Control Products, Inc.",
+	"Medialogic Corp.",
+	"Deltron Technology, Inc.",
+	"Amann GmbH",
+	"PicoLight",
+	"ITTC, University of Kansas",
+	"Cogency Semiconductor, Inc.",
+	"Eurotherm Controls",
+	"YAFO Networks, Inc.",
+	"Temia Vertriebs GmbH",
+	"Poscon Corporation",
+	"Nayna Networks, Inc.",
+	"Teo Technologies, Inc",
+	"Ohm Tech Labs, Inc.",
+	"TD Systems Corporation",
+	"Optical Access",
+	"Technovision, Inc.",
+	"Hive Internet, Ltd.",
+	"Tsinghua Unisplendour Co., Ltd.",
+	"Breezecom, Ltd.",
+	"Tejas Networks India Limited",
+	"MacroSystem Digital Video AG",
+	"Mahi Networks",
+	"Chino Corporation",
+	"Surgient Networks, Inc.",
+	"Ipanema Technologies",
+	"Wirelink Co., Ltd.",
+	"Freescale Semiconductor",
+	"Verity Instruments, Inc.",
+	"Pathway Connectivity",
+	"L.S.I. Japan Co., Ltd.",
+	"Microchip Technology, Inc.",
+	"NetEnabled, Inc.",
+	"Barco Projection Systems NV",
+	"SAF Tehnika Ltd.",
+	"FabiaTech Corporation",
+	"Broadmax Technologies, Inc.",
+	"SandStream Technologies, Inc.",
+	"Jetstream Communications",
+	"Comverse Network Systems, Inc.",
+	"Malibu Networks",
+	"Sullair Corporation",
+	"Digital Fountain, Inc.",
+	"ELESIGN Co., Ltd.",
+	"Signal Technology, Inc.",
+	"ESSEGI SRL",
+	"Videotek, Inc.",
+	"CIAC",
+	"Equitrac Corporation",
+	"Stratex Networks, Inc.",
+	"AMB i.t. Holding",
+	"Kumahira Co., Ltd.",
+	"S.I. Soubou, Inc.",
+	"KDD Media Will Corporation",
+	"Bardac Corporation",
+	"Giantec, Inc.",
+	"OptXCon, Inc.",
+	"VersaLogic Corp.",
+	"Magnipix, Inc.",
+	"CASTOR Informatique",
+	"Allen & Heath Limited",
+	"ASE Technologies, USA",
+	"Yamaha Motor Co., Ltd.",
+	"NetMount",
+	"LIBA Maschinenfabrik GmbH",
+	"Micro Electron Co., Ltd.",
+	"FreeMs Corp.",
+	"Tdsoft Communication, Ltd.",
+	"Peek Traffic B.V.",
+	"Extenway Solutions Inc",
+	"Patria Ailon",
+	"Seagate Technology",
+	"Softlink s.r.o.",
+	"Drew Technologies, Inc.",
+	"Adcon Telemetry GmbH",
+	"Toyokeiki Co., Ltd.",
+	"Proview Electronics Co., Ltd.",
+	"Hitachi Information & Communication Engineering, Ltd.",
+	"Takagi Industrial Co., Ltd.",
+	"Omitec Instrumentation Ltd.",
+	"IPWireless, Inc.",
+	"Titan Electronics, Inc.",
+	"Relax Technology, Inc.",
+	"Tellus Group Corp.",
+	"Teracom Telematica Ltda.",
+	"Procket Networks",
+	"Infinior Microsystems",
+	"SMC Networks, Inc.",
+	"Accton Technology Corp.",
+	"Daeryung Ind., Inc.",
+	"Glonet Systems, Inc.",
+	"Banyan Network Private Limited",
+	"Lightpointe Communications, Inc",
+	"IER, Inc.",
+	"Infiniswitch Corporation",
+	"Paxonet Communications, Inc.",
+	"Memobox SA",
+	"Billion Electric Co., Ltd.",
+	"Lincoln Electric Company",
+	"Polestar Corp.",
+	"International Computers, Ltd",
+	"WhereNet",
+	"Polycom",
+	"FS FORTH-SYSTEME GmbH",
+	"Infinite Electronics Inc.",
+	"SnowShore Networks, Inc.",
+	"Amphus",
+	"Omega Band, Inc.",
+	"QUALICABLE TV Industria E Com., Ltda",
+	"Xtera Communications, Inc.",
+	"NBS Technologies Inc.",
+	"Commtech, Inc.",
+	"Stratus Computer (DE), Inc.",
+	"Japan Control Engineering Co., Ltd.",
+	"Pelago Networks",
+	"Acronet Co., Ltd.",
+	"ICONAG",
+	"Naray Information & Communication Enterprise",
+	"Systems Integration Solutions, Inc.",
+	"Reddo Networks AB",
+	"Fine Appliance Corp.",
+	"Inetcam, Inc.",
+	"AVOC Nishimura Ltd.",
+	"ICS Spa",
+	"SICOM Systems, Inc.",
+	"Network Photonics, Inc.",
+	"Midstream Technologies, Inc.",
+	"3ware, Inc.",
+	"Tanaka S/S Ltd.",
+	"Infinite Shanghai Communication Terminals Ltd.",
+	"Complementary Technologies Ltd",
+	"Zebra Technologies Inc",
+	"VTLinx Multimedia Systems, Inc.",
+	"KDT Systems Co., Ltd.",
+	"Nuark Co., Ltd.",
+	"SMART Modular Technologies",
+	"Shellcomm, Inc.",
+	"Jupiters Technology",
+	"Siemens Building Technologies AG,",
+	"3Com Europe Ltd.",
+	"Magic Control Technology Corporation",
+	"Xnet Technology Corp.",
+	"Airocon, Inc.",
+	"Taijin Media Co., Ltd.",
+	"Smartronix, Inc.",
+	"LEA*D Corporation, Inc.",
+	"AVL List GmbH",
+	"BTL System (HK) Limited",
+	"Puretek Industrial Co., Ltd.",
+	"IPAS GmbH",
+	"SJ Tek Co. Ltd",
+	"New Focus, Inc.",
+	"Shanghai Broadan Communication Technology Co., Ltd",
+	"Ikegami Tsushinki Co., Ltd.",
+	"HORIBA, Ltd.",
+	"Supreme Magic Corporation",
+	"Zoltrix International Limited",
+	"Cinta Networks",
+	"Leviton Network Solutions",
+	"Andiamo Systems, Inc.",
+	"Northstar Engineering Ltd.",
+	"Chip PC Ltd.",
+	"Danam Communications, Inc.",
+	"Nets Technology Co., Ltd.",
+	"Merilus, Inc.",
+	"A Brand New World in Sweden AB",
+	"Willowglen Services Pte Ltd",
+	"Harbour Networks Ltd., Co. Beijing",
+	"Xircom",
+	"KID Systeme GmbH",
+	"VisionTek, Inc.",
+	"FAST Corporation",
+	"Advanced Systems Co., Ltd.",
+	"Otari, Inc.",
+	"IQ Wireless GmbH",
+	"Valley Technologies, Inc.",
+	"Internet Photonics",
+	"KDDI Network & Solultions Inc.",
+	"Starent Networks",
+	"Disco Corporation",
+	"Salira Optical Network Systems",
+	"Ario Data Networks, Inc.",
+	"Eaton Automation AG",
+	"RF Innovations Pty Ltd",
+	"Brans Technologies, Inc.",
+	"Philips",
+	"Vcomms Connect Limited",
+	"F & S Elektronik Systeme GmbH",
+	"Xycotec Computer GmbH",
+	"DVC Company, Inc.",
+	"Rangestar Wireless",
+	"Japan Cash Machine Co., Ltd.",
+	"360 Systems",
+	"Agile TV Corporation",
+	"Synchronous, Inc.",
+	"Intracom S.A.",
+	"Power Dsine Ltd.",
+	"Kowa Company, Ltd.",
+	"D-Link Systems, Inc.",
+	"LEADER COMM.CO., LTD",
+	"nac Image Technology, Inc.",
+	"Digital View Limited",
+	"J-Works, Inc.",
+	"Tsinghua Bitway Co., Ltd.",
+	"Tailyn Communication Company Ltd.",
+	"Secui.com Corporation",
+	"Etymonic Design, Inc.",
+	"Piltofish Networks AB",
+	"VMware, Inc.",
+	"Heuft Systemtechnik GmbH",
+	"C.P. Technology Co., Ltd.",
+	"Hung Chang Co., Ltd.",
+	"Pacific Corporation",
+	"National Enhance Technology, Inc.",
+	"Innomedia Technologies Pvt. Ltd.",
+	"Baydel Ltd.",
+	"Seiwa Electronics Co.",
+	"Deonet Co., Ltd.",
+	"CDS-Electronics BV",
+	"NSM Technology Ltd.",
+	"SM Information & Communication",
+	"Universal Control Solution Corp.",
+	"Overture Networks",
+	"Chung Nam Electronic Co., Ltd.",
+	"RCO Security AB",
+	"Sun Communications, Inc.",
+	"Eckelmann Steuerungstechnik GmbH",
+	"Acqis Technology",
+	"FibroLAN Ltd.",
+	"Snell",
+	"ClearCube Technology",
+	"ImageCom Limited",
+	"AbsoluteValue Systems, Inc.",
+	"Juniper Networks, Inc.",
+	"Lucent Technologies",
+	"Locus, Incorporated",
+	"Sensoria Corp.",
+	"National Datacomputer",
+	"Netcom Co., Ltd.",
+	"IPmental, Inc.",
+	"Opentech Inc.",
+	"Lynx Photonic Networks, Inc.",
+	"Flextronics International GmbH & Co. Nfg. KG",
+	"CLCsoft co.",
+	"Swissvoice Ltd.",
+	"Active Silicon Ltd",
+	"Pultek Corp.",
+	"Grammar Engine Inc.",
+	"IXXAT Automation GmbH",
+	"Alesis Corporation",
+	"Genotech Co., Ltd.",
+	"Eagle Traffic Control Systems",
+	"CRONOS S.r.l.",
+	"DRS Test and Energy Management or DRS-TEM",
+	"Kleinknecht GmbH, Ing. Büro",
+	"Daniel Computing Systems, Inc.",
+	"Zinwell Corporation",
+	"Yotta Networks, Inc.",
+	"MOBILINE Kft.",
+	"Zenocom",
+	"CELOX Networks",
+	"QEI, Inc.",
+	"Lucid Voice Ltd.",
+	"KOTT",
+	"Extron Electronics",
+	"Hyperchip, Inc.",
+	"WYLE ELECTRONICS",
+	"Princeton Networks, Inc.",
+	"Moore Industries International Inc.",
+	"Cyber Fone, Inc.",
+	"Northern Digital, Inc.",
+	"Topspin Communications, Inc.",
+	"Mediaport USA",
+	"InnoScan Computing A/S",
+	"Korea Computer Technology Co., Ltd.",
+	"ASB Technology BV",
+	"Medison Co., Ltd.",
+	"Asahi-Engineering Co., Ltd.",
+	"Aceex Corporation",
+	"Broadcom Technologies",
+	"INSYS Microelectronics GmbH",
+	"Arbor Technology Corp.",
+	"Electronic Design Associates, Inc.",
+	"Airvana, Inc.",
+	"Area Netwoeks, Inc.",
+	"Myspace AB",
+	"Resource Data Management Ltd",
+	"ROAX BV",
+	"Kongsberg Seatex AS",
+	"JustEzy Technology, Inc.",
+	"Digital Network Alacarte Co., Ltd.",
+	"A-Kyung Motion, Inc.",
+	"Soronti, Inc.",
+	"Pacific Instruments, Inc.",
+	"Telect, Inc.",
+	"Flaga HF",
+	"Triz Communications",
+	"I/F-COM A/S",
+	"VERYTECH",
+	"LG Innotek Co., Ltd.",
+	"Hitron Technology, Inc.",
+	"ROIS Technologies, Inc.",
+	"Sumtel Communications, Inc.",
+	"Denon, Ltd.",
+	"Prolink Microsystems Corporation",
+	"Thunder River Technologies, Inc.",
+	"Solinet Systems",
+	"Metavector Technologies",
+	"DAP Technologies",
+	"eProduction Solutions, Inc.",
+	"FutureSmart Networks, Inc.",
+	"Speedcom Wireless",
+	"L-3 Linkabit",
+	"Vista Imaging, Inc.",
+	"Arescom, Inc.",
+	"Techno Valley, Inc.",
+	"Apex Automationstechnik",
+	"PSI Nentec GmbH",
+	"Gi Fone Korea, Inc.",
+	"Electronic Innovation, Inc.",
+	"Empirix Corp.",
+	"Trellis Photonics, Ltd.",
+	"Creativ Network Technologies",
+	"LightSand Communications, Inc.",
+	"Red Lion Controls Inc.",
+	"Renishaw PLC",
+	"Egenera, Inc.",
+	"Netrake an AudioCodes Company",
+	"TurboWave, Inc.",
+	"Unicess Network, Inc.",
+	"Rednix",
+	"Blue Ridge Networks, Inc.",
+	"Mosaic Systems Inc.",
+	"Technikum Joanneum GmbH",
+	"Siemens AB, Infrastructure & Cities, Building Technologies Division, IC BT SSP SP BA PR",
+	"ADOIR Digital Technology",
+	"SATEC",
+	"Vrcom, Inc.",
+	"Power R, Inc.",
+	"Webyn",
+	"System Base Co., Ltd.",
+	"Geospace Technologies",
+	"Young Chang Co. Ltd.",
+	"Analog Devices, Inc.",
+	"Real Time Access, Inc.",
+	"TOA Corporation",
+	"IPOptical, Inc.",
+	"ShareGate, Inc.",
+	"Schenck Pegasus Corp.",
+	"PacketLight Networks Ltd.",
+	"Traficon N.V.",
+	"SNS Solutions, Inc.",
+	"Toshiba Teli Corporation",
+	"Otanikeiki Co., Ltd.",
+	"Cirkitech Electronics Co.",
+	"Baker Hughes Inc.",
+	"@Track Communications, Inc.",
+	"Inncom International, Inc.",
+	"RapidWAN, Inc.",
+	"Omni Directional Control Technology Inc.",
+	"At-Sky SAS",
+	"Crossport Systems",
+	"Blue2space",
+	"Melco Industries, Inc.",
+	"Wave7 Optics",
+	"IGYS Systems, Inc.",
+	"Narad Networks Inc",
+	"Abeona Networks Inc",
+	"Zeus Wireless, Inc.",
+	"Accusys, Inc.",
+	"Kawasaki Microelectronics Incorporated",
+	"Prism Holdings",
+	"Kimoto Electric Co., Ltd.",
+	"Tel Net Co., Ltd.",
+	"Redswitch Inc.",
+	"DigiPower Manufacturing Inc.",
+	"Connection Technology Systems",
+	"Zetari Inc.",
+	"Notebook Development Lab.  Lenovo Japan Ltd.",
+	"Hoshino Metal Industries, Ltd.",
+	"MIP Telecom, Inc.",
+	"Maxan Systems",
+	"Vision Components GmbH",
+	"Serial System Ltd.",
+	"Hinox, Co., Ltd.",
+	"Chung Fu Chen Yeh Enterprise Corp.",
+	"MGE UPS Systems France",
+	"Gentner Communications Corp.",
+	"MWE GmbH",
+	"Uniwide Technologies, Inc.",
+	"INTRASERVER TECHNOLOGY",
+	"Bivio Networks",
+	"TouchStar Technologies, L.L.C.",
+	"Aristos Logic Corp.",
+	"Pivotech Systems Inc.",
+	"Adtranz Sweden",
+	"Calix",
+	"Mesco Engineering GmbH",
+	"Cross Match Technologies GmbH",
+	"GTE Airfone Inc.",
+	"PacketAir Networks, Inc.",
+	"Jedai Broadband Networks",
+	"Toptrend-Meta Information (ShenZhen) Inc.",
+	"Sungjin C&C Co., Ltd.",
+	"Newtec",
+	"Dura Micro, Inc.",
+	"Arcturus Networks Inc.",
+	"Intrinsyc Software International Inc.",
+	"Microwave Data Systems Inc.",
+	"Opthos Inc.",
+	"Everex Communications Inc.",
+	"White Rock Networks",
+	"ITCN",
+	"Genetel Systems Inc.",
+	"SONO Computer Co., Ltd.",
+	"neix,Inc",
+	"Meisei Electric Co. Ltd.",
+	"ShenZhen XunBao Network Technology Co Ltd",
+	"Etrali S.A.",
+	"Seedsware, Inc.",
+	"3M Deutschland GmbH",
+	"Honeywell Co., Ltd. (KOREA)",
+	"Alexon Co., Ltd.",
+	"Invicta Networks, Inc.",
+	"Sencore",
+	"Broad Net Technology Inc.",
+	"PRO-NETS Technology Corporation",
+	"Tiburon Networks, Inc.",
+	"Aspen Networks Inc.",
+	"Winpresa Building Automation Technologies GmbH",
+	"Yipee, Inc.",
+	"Tactel AB",
+	"Market Central, Inc.",
+	"Helmut Fischer GmbH Institut für Elektronik und Messtechnik",
+	"EAL (Apeldoorn) B.V.",
+	"Strix Systems",
+	"Dell Computer Corp.",
+	"Malachite Technologies, Inc.",
+	"Heidelberg Web Systems",
+	"Photuris, Inc.",
+	"ECI Telecom - NGTS Ltd.",
+	"NADEX Co., Ltd.",
+	"NIA Home Technologies Corp.",
+	"MBM Technology Ltd.",
+	"Human Technology Co., Ltd.",
+	"Fostex Corporation",
+	"Sunny Giken, Inc.",
+	"Roving Networks",
+	"Tripp Lite",
+	"Vicon Industries Inc.",
+	"Datasound Laboratories Ltd",
+	"InfiniCon Systems, Inc.",
+	"Sysmex Corporation",
+	"Robinson Corporation",
+	"Compuprint S.P.A.",
+	"Delta Electronics, Inc.",
+	"Korea Data Systems",
+	"Upponetti Oy",
+	"Softing AG",
+	"Netezza",
+	"TKH Security Solutions USA",
+	"Spectrum Control, Inc.",
+	"Banderacom, Inc.",
+	"Novra Technologies Inc.",
+	"SICK AG",
+	"Marantz Brand Company",
+	"Konami Corporation",
+	"JMP Systems",
+	"Toplink C&C Corporation",
+	"Takasago Ltd.",
+	"WinCom Systems, Inc.",
+	"Digeo, Inc.",
+	"Card Access, Inc.",
+	"Goepel Electronic GmbH",
+	"Convedia",
+	"Bravara Communications, Inc.",
+	"Biacore AB",
+	"NetNearU Corporation",
+	"ZARDCOM Co., Ltd.",
+	"Omnitron Systems Technology, Inc.",
+	"Telways Communication Co., Ltd.",
+	"yLez Technologies Pte Ltd",
+	"NeuronNet Co. Ltd. R&D Center",
+	"AirRunner Technologies, Inc.",
+	"3Com Corporation",
+	"SEPATON, Inc.",
+	"HID Corporation",
+	"Telemonitor, Inc.",
+	"Euracom Communication GmbH",
+	"PT Inovacao",
+	"Intruvert Networks, Inc.",
+	"Flexus Computer Technology, Inc.",
+	"Mobillian Corporation",
+	"Ensure Technologies, Inc.",
+	"Advent Networks",
+	"R & D Center",
+	"egnite GmbH",
+	"Vida Design Co.",
+	"e & Tel",
+	"AVT Audio Video Technologies GmbH",
+	"Transmode Systems AB",
+	"Petards Ltd",
+	"UNIQA, Inc.",
+	"Kuokoa Networks",
+	"Mx Imaging",
+	"Celsian Technologies, Inc.",
+	"Microtune, Inc.",
+	"Bitran Corporation",
+	"INNOWELL Corp.",
+	"PINON Corp.",
+	"Artistic Licence Engineering Ltd",
+	"Primarion",
+	"KC Technology, Inc.",
+	"Universal Instruments Corp.",
+	"VT Miltope",
+	"W-Link Systems, Inc.",
+	"Intersoft Co.",
+	"KB Electronics Ltd.",
+	"Himachal Futuristic Communications Ltd",
+	"Xalted Networks",
+	"Comtech EF Data Corp.",
+	"Sonicwall",
+	"Linxtek Co.",
+	"Diagraph Corporation",
+	"Vorne Industries, Inc.",
+	"Source Photonics, Inc.",
+	"Nir-Or Israel Ltd.",
+	"TELEM GmbH",
+	"Bandspeed Pty Ltd",
+	"A5TEK Corp.",
+	"Westwave Communications",
+	"ATI Technologies Inc.",
+	"Macrolink, Inc.",
+	"BNTECHNOLOGY Co., Ltd.",
+	"Baumer Optronic GmbH",
+	"Accella Technologies Co., Ltd.",
+	"United Internetworks, Inc.",
+	"Smartmatic Corporation",
+	"Schindler Elevator Ltd.",
+	"Piolink Inc.",
+	"INNOVI Technologies Limited",
+	"lesswire AG",
+	"RFNET Technologies Pte Ltd (S)",
+	"Sumitomo Metal Micro Devices, Inc.",
+	"Technical Marketing Research, Inc.",
+	"American Computer & Digital Components, Inc. (ACDC)",
+	"Jotron Electronics A/S",
+	"JMI Electronics Co., Ltd.",
+	"Leaf Imaging Ltd.",
+	"DATENO",
+	"Thales Avionics In-Flight Systems, LLC",
+	"Elgar Electronics Corp.",
+	"Tahoe Networks, Inc.",
+	"Tundra Semiconductor Corp.",
+	"Alpha Telecom, Inc. U.S.A.",
+	"Interactive Objects, Inc.",
+	"Diamond Systems Corp.",
+	"Maple Optical Systems",
+	"IPM-Net S.p.A.",
+	"ITRAN Communications Ltd.",
+	"ICHIPS Co., Ltd.",
+	"Syabas Technology (Amquest)",
+	"AT & T Laboratories - Cambridge Ltd",
+	"Flash Technology",
+	"AIDONIC Corporation",
+	"MAT Co., Ltd.",
+	"Techno Trade s.a",
+	"Ceemax Technology Co., Ltd.",
+	"Quantitative Imaging Corporation",
+	"Citel Technologies Ltd.",
+	"Fujian Newland Computer Ltd. Co.",
+	"DongYang Telecom Co., Ltd.",
+	"Bit Blitz Communications Inc.",
+	"Optical Network Testing, Inc.",
+	"Intime Corp.",
+	"ELZET80 Mikrocomputer GmbH&Co. KG",
+	"Global Data",
+	"Harris Corporation",
+	"Inara Networks",
+	"Shenyang Neu-era Information & Technology Stock Co., Ltd",
+	"Maxxan Systems, Inc.",
+	"Optillion",
+	"Platys Communications",
+	"AcceLight Networks",
+	"Prime Electronics & Satellitics Inc.",
+	"ALPS Co,. Ltd.",
+	"The Boeing Company",
+	"Mitsui Zosen Systems Research Inc.",
+	"IP SQUARE Co, Ltd.",
+	"Hitachi Printing Solutions, Ltd.",
+	"Fnet Co., Ltd.",
+	"Comjet Information Systems Corp.",
+	"Ambrado, Inc",
+	"Sheba Systems Co., Ltd.",
+	"Zettamedia Korea",
+	"RACAL-DATACOM",
+	"Varian Medical Systems",
+	"CSEE Transport",
+	"Endress & Hauser GmbH & Co",
+	"Sanritz Corporation",
+	"Interalia Inc.",
+	"Bitrage Inc.",
+	"Westerstrand Urfabrik AB",
+	"Unicom Automation Co., Ltd.",
+	"Novabase SGPS, SA",
+	"SVA-Intrusion.com Co. Ltd.",
+	"Fujant, Inc.",
+	"Adax, Inc.",
+	"Acterna",
+	"JAL Information Technology",
+	"IP One, Inc.",
+	"Brightcom",
+	"General Research of Electronics, Inc.",
+	"J & S Marine Ltd.",
+	"Wieland Electric GmbH",
+	"iCanTek Co., Ltd.",
+	"Mobiis Co., Ltd.",
+	"Finedigital Inc.",
+	"CDVI Americas Ltd",
+	"AT&T Fixed Wireless Services",
+	"Satelsa Sistemas Y Aplicaciones De Telecomunicaciones, S.A.",
+	"Tri-M Engineering / Nupak Dev. Corp.",
+	"European Systems Integration",
+	"Trutzschler GmbH & Co. KG",
+	"Formac Elektronik GmbH",
+	"The Nielsen Company",
+	"ELCON Systemtechnik GmbH",
+	"Telemax Co., Ltd.",
+	"Bematech International Corp.",
+	"Shenzhen Gongjin Electronics Co., Ltd.",
+	"Zi Corporation (HK) Ltd.",
+	"Neo Telecom",
+	"Kistler Instrumente AG",
+	"Innovance Networks",
+	"Jung Myung Telecom Co., Ltd.",
+	"Fabricom",
+	"CNSystems",
+	"North Node AB",
+	"Intransa, Inc.",
+	"Hutchison OPTEL Telecom Technology Co., Ltd.",
+	"Ophir-Spiricon LLC",
+	"AAEON Technology Inc.",
+	"DANCONTROL Engineering",
+	"ONStor, Inc.",
+	"Flarion Technologies, Inc.",
+	"Data Video Technologies Co., Ltd.",
+	"Soriya Co. Ltd.",
+	"Young Technology Co., Ltd.",
+	"Scotty Group Austria Gmbh",
+	"Inventel Systemes",
+	"Tenovis GmbH & Co KG",
+	"Telecom Design",
+	"Nanjing Postel Telecommunications Co., Ltd.",
+	"China Great-Wall Computer Shenzhen Co., Ltd.",
+	"Woojyun Systec Co., Ltd.",
+	"Buffalo Inc.",
+	"Sierra Automated Systems",
+	"Ormazabal",
+	"Chelsio Communications",
+	"Unico, Inc.",
+	"Radlan Computer Communications Ltd.",
+	"TURCK, Inc.",
+	"Mecalc",
+	"The Imaging Source Europe",
+	"CENiX Inc.",
+	"Carl Valentin GmbH",
+	"Daihen Corporation",
+	"Beicom Inc.",
+	"Zebra Technologies Corp.",
+	"IPFRONT Inc",
+	"m-u-t AG",
+	"Rhythm Watch Co., Ltd.",
+	"Beijing Qxcomm Technology Co., Ltd.",
+	"Xyterra Computing, Inc.",
+	"Lafon",
+	"Juyoung Telecom",
+	"Topcall International AG",
+	"Dragonwave",
+	"Boris Manufacturing Corp.",
+	"Air Products and Chemicals, Inc.",
+	"Gibson Guitars",
+	"Eastman Kodak Company",
+	"Celleritas Inc.",
+	"Ametek Power Instruments",
+	"VCS Video Communication Systems AG",
+	"TOMIS Information & Telecom Corp.",
+	"Logitech Europe SA",
+	"Group Sense Limited",
+	"Sunniwell Cyber Tech. Co., Ltd.",
+	"YoungWoo Telecom Co. Ltd.",
+	"Jade Quantum Technologies, Inc.",
+	"Chou Chin Industrial Co., Ltd.",
+	"Yuxing Electronics Company Limited",
+	"Danfoss A/S",
+	"Italiana Macchi SpA",
+	"NEXTEYE Co., Ltd.",
+	"Stralfors AB",
+	"Daehanet, Inc.",
+	"Flexlight Networks",
+	"Sinetica Corporation Limited",
+	"Synoptics Limited",
+	"Ubiquoss Inc",
+	"Embedded System Corporation",
+	"Alcatel Shanghai Bell Co., Ltd.",
+	"Ascom Powerline Communications Ltd.",
+	"GuangZhou Thinker Technology Co. Ltd.",
+	"Valence Semiconductor, Inc.",
+	"Federal APD",
+	"Motah Ltd.",
+	"GERSTEL GmbH & Co. KG",
+	"Sungil Telecom Co., Ltd.",
+	"Infoware System Co., Ltd.",
+	"Millimetrix Broadband Networks",
+	"Westermo Teleindustri AB",
+	"Elrest GmbH",
+	"J Communications Co., Ltd.",
+	"Bluegiga Technologies OY",
+	"Itron Inc.",
+	"SynCom Network, Inc.",
+	"Wireless Networks Inc.",
+	"Idea System Co., Ltd.",
+	"Clipcomm, Inc.",
+	"DONGWON SYSTEMS",
+	"Mentor Data System Inc.",
+	"Wegener Communications, Inc.",
+	"Elektronikspecialisten i Borlange AB",
+	"NetEngines Ltd.",
+	"Garz & Friche GmbH",
+	"Emkay Innovative Products",
+	"Tri-M Technologies (s) Limited",
+	"International Data Communications, Inc.",
+	"Sütron Electronic GmbH",
+	"Shin Satellite Public Company Limited",
+	"Simple Devices, Inc.",
+	"Elitegroup Computer System Co. (ECS)",
+	"LSI Systems, Inc.",
+	"Netpower Co., Ltd.",
+	"Selea SRL",
+	"Tipping Point Technologies, Inc.",
+	"Verint Systems Inc",
+	"Aurora Networks",
+	"Golden Electronics Technology Co., Ltd.",
+	"Musashi Co., Ltd.",
+	"Ilinx Co., Ltd.",
+	"Action Digital Inc.",
+	"e-Watch Inc.",
+	"VIASYS Healthcare GmbH",
+	"Opteon Corporation",
+	"Ositis Software, Inc.",
+	"GN Netcom Ltd.",
+	"Y.D.K Co. Ltd.",
+	"Home Automation, Inc.",
+	"A-Z Inc.",
+	"Haier Group Technologies Ltd.",
+	"Novasonics",
+	"Quantum Data Inc.",
+	"Samsung Electronics Co.,Ltd",
+	"Eolring",
+	"Pentacon GmbH Foto-und Feinwerktechnik",
+	"Britestream Networks, Inc.",
+	"Red Lion Controls, LP",
+	"Office Details, Inc.",
+	"Equator Technologies",
+	"Transaccess S.A.",
+	"Any One Wireless Ltd.",
+	"Telecom Technology Ltd.",
+	"Samurai Ind. Prods Eletronicos Ltda",
+	"Corvalent Corporation",
+	"Ginganet Corporation",
+	"UTStarcom, Inc.",
+	"Candera Inc.",
+	"Identix Inc.",
+	"Radionet Ltd.",
+	"DataLogic SpA",
+	"Armillaire Technologies, Inc.",
+	"NetZerver Inc.",
+	"Overture Networks, Inc.",
+	"Netsys Telecom",
+	"Thomson",
+	"JEAN Co. Ltd.",
+	"Gcom, Inc.",
+	"VDS Vosskuhler GmbH",
+	"Synectics Systems Limited",
+	"Brain21, Inc.",
+	"Technol Seven Co., Ltd.",
+	"Creatix Polymedia Ges Fur Kommunikaitonssysteme",
+	"Freebox SA",
+	"Kaba Benzing GmbH",
+	"Kumoh Electronic Co, Ltd",
+	"Cabletime Limited",
+	"Anoto AB",
+	"Automat Engenharia de Automação Ltda.",
+	"Spectrum Signal Processing Inc.",
+	"Logopak Systeme GmbH & Co. KG",
+	"SPGPrints B.V.",
+	"Zhejiang Yutong Network Communication Co Ltd.",
+	"3e Technologies Int;., Inc.",
+	"Commil Ltd.",
+	"Caporis Networks AG",
+	"Hitron Systems Inc.",
+	"Splicecom",
+	"Neuro Telecom Co., Ltd.",
+	"Kirana Networks, Inc.",
+	"Atek Co, Ltd.",
+	"Cradle Technologies",
+	"eCopilt AB",
+	"Vbrick Systems Inc.",
+	"Palm Inc.",
+	"WIS Communications Co. Ltd.",
+	"Bitworks, Inc.",
+	"Navcom Technology, Inc.",
+	"SoftRadio Co., Ltd.",
+	"Coup Corporation",
+	"edgeflow Canada Inc.",
+	"FreeWave Technologies",
+	"EdgeWave",
+	"Massana, Inc.",
+	"Altera Corporation",
+	"telco Informationssysteme GmbH",
+	"Lockheed Martin Tactical Systems",
+	"LogiSync LLC",
+	"TeraBurst Networks Inc.",
+	"IOA Corporation",
+	"Thinkengine Networks",
+	"Eletex Co., Ltd.",
+	"Bridgeco Co AG",
+	"Qqest Software Systems",
+	"Galtronics",
+	"ITDevices, Inc.",
+	"Sensaphone",
+	"ITT Co., Ltd.",
+	"Giga Stream UMTS Technologies GmbH",
+	"Adept Systems Inc.",
+	"LANergy Ltd.",
+	"Rigaku Corporation",
+	"Gluon Networks",
+	"MULTITECH SYSTEMS, INC.",
+	"HighSpeed Surfing Inc.",
+	"Cos Tron",
+	"ICA Inc.",
+	"Techno-Holon Corporation",
+	"Raonet Systems, Inc.",
+	"Access Devices Limited",
+	"PPT Vision, Inc.",
+	"Systemonic AG",
+	"Espera-Werke GmbH",
+	"Birka BPA Informationssystem AB",
+	"VDA Elettronica spa",
+	"Toshiba",
+	"Proximion Fiber Optics AB",
+	"Key Technology, Inc.",
+	"VOIX Corporation",
+	"GM-2 Corporation",
+	"Diskbank, Inc.",
+	"TIL Technologies",
+	"CATS Co., Ltd.",
+	"Bluelon ApS",
+	"EmergeCore Networks LLC",
+	"Pixelworks, Inc.",
+	"Banksys",
+	"Sanrad Intelligence Storage Communications (2000) Ltd.",
+	"Windigo Systems",
+	"@pos.com",
+	"Ipsil, Incorporated",
+	"Repeatit AB",
+	"Pou Yuen Tech Corp. Ltd.",
+	"InPro Comm",
+	"Texa Corp.",
+	"Nuance Document Imaging",
+	"Acme Packet",
+	"Colorado Med Tech",
+	"ADB Broadband Italia",
+	"Koei Engineering Ltd.",
+	"Aval Nagasaki Corporation",
+	"Powerwallz Network Security",
+	"Wooksung Electronics, Inc.",
+	"Homag AG",
+	"Indus Teqsite Private Limited",
+	"Multitone Electronics PLC",
+	"Cisco",
+	"DivergeNet, Inc.",
+	"Qualstar Corporation",
+	"Arizona Instrument Corp.",
+	"Canadian Bank Note Company, Ltd.",
+	"Davolink Co. Inc.",
+	"Schleicher GmbH & Co. Relaiswerke KG",
+	"Netronix, Inc.",
+	"NASA-Goddard Space Flight Center",
+	"Gamatronic Electronic Industries Ltd.",
+	"Polaris Networks, Inc.",
+	"Novatechnology Inc.",
+	"ShenZhen Unitone Electronics Co., Ltd.",
+	"IntiGate Inc.",
+	"Hanbit Electronics Co., Ltd.",
+	"Shanghai Dare Technologies Co. Ltd.",
+	"Aastra",
+	"PCO AG",
+	"Picanol N.V.",
+	"LodgeNet Entertainment Corp.",
+	"SoftEnergy Co., Ltd.",
+	"NEC Eluminant Technologies, Inc.",
+	"Entrisphere Inc.",
+	"Fasy S.p.A.",
+	"JASCOM CO., LTD",
+	"DSX Access Systems, Inc.",
+	"Uptime Devices",
+	"PurOptix",
+	"Command-e Technology Co.,Ltd.",
+	"Securiton Gmbh",
+	"MIPSYS",
+	"Plasmon LMS",
+	"Missouri FreeNet",
+	"Hyglo AB",
+	"Resources Computer Network Ltd.",
+	"Rasvia Systems, Inc.",
+	"NORTHDATA Co., Ltd.",
+	"Sorenson Communications",
+	"DapTechnology B.V.",
+	"Acorp Electronics Corp.",
+	"SDSystem",
+	"Liebert-Hiross Spa",
+	"Benchmark Storage Innovations",
+	"CEM Corporation",
+	"Wipotec GmbH",
+	"RTX Telecom A/S",
+	"Bon Electro-Telecom Inc.",
+	"SPAUN electronic GmbH & Co. KG",
+	"BroadTel Canada Communications inc.",
+	"DIGITAL HANDS CO.,LTD.",
+	"SIGMA CORPORATION",
+	"Index Braille AB",
+	"EMS Dr. Thomas Wünsche",
+	"Hansung Teliann, Inc.",
+	"Maschinenfabrik Reinhausen GmbH",
+	"OULLIM Information Technology Inc,.",
+	"Echostar Technologies Corp",
+	"Minds@Work",
+	"Tropic Networks Inc.",
+	"Quanta Network Systems Inc.",
+	"Sigma-Links Inc.",
+	"Nihon Computer Co., Ltd.",
+	"ADVANCED DIGITAL TECHNOLOGY",
+	"AVILINKS SA",
+	"Lyan Inc.",
+	"EM Solutions",
+	"LE INFORMATION COMMUNICATION INC.",
+	"InnoVISION Multimedia Ltd.",
+	"DIRC Technologie GmbH & Co.KG",
+	"Printronix, Inc.",
+	"Quake Technologies",
+	"Gigabit Optics Corporation",
+	"Netbind, Inc.",
+	"Alcatel Microelectronics",
+	"ICP Electronics Inc.",
+	"Elecs Industry Co., Ltd.",
+	"UHD-Elektronik",
+	"Beijing Enter-Net co.LTD",
+	"EFM Networks",
+	"Stotz Feinmesstechnik GmbH",
+	"CNet Technology Inc.",
+	"ADI Engineering, Inc.",
+	"Peninsula Systems Inc.",
+	"Multiware & Image Co., Ltd.",
+	"iLogic Inc.",
+	"Systec Co., Ltd.",
+	"SangSang Technology, Inc.",
+	"KARAM",
+	"EnerLinx.com, Inc.",
+	"Eltromat GmbH",
+	"Toyo-Linx Co., Ltd.",
+	"PacketFront Network Products AB",
+	"Novatec Corporation",
+	"BKtel communications GmbH",
+	"ProQuent Systems",
+	"SHENZHEN COMPASS TECHNOLOGY DEVELOPMENT CO.,LTD",
+	"Fastwel",
+	"SYSPOL",
+	"TAI GUEN ENTERPRISE CO., LTD",
+	"RouteFree, Inc.",
+	"HIT Incorporated",
+	"E.F. Johnson",
+	"KAON MEDIA Co., Ltd.",
+	"Erskine Systems Ltd",
+	"NetExcell",
+	"Ilevo AB",
+	"TEPG-US",
+	"XENPAK MSA Group",
+	"Aptus Elektronik AB",
+	"ASA SYSTEMS",
+	"Avistar Communications Corporation",
+	"Contex A/S",
+	"Hikari Co.,Ltd.",
+	"Liontech Co., Ltd.",
+	"Philips Consumer Communications",
+	"Soneticom, Inc.",
+	"TechniSat Digital GmbH",
+	"TwinHan Technology Co.,Ltd",
+	"Zeta Broadband Inc.",
+	"Remotec, Inc.",
+	"With-Net Inc",
+	"IPMobileNet Inc.",
+	"Nippon Koei Power Systems Co., Ltd.",
+	"Musashi Engineering Co., LTD.",
+	"KAREL INC.",
+	"ZOOM Networks Inc.",
+	"Hercules Technologies S.A.S.",
+	"IneoQuest Technologies, Inc",
+	"Vanguard Networks Solutions, LLC",
+	"HASSNET Inc.",
+	"HOW CORPORATION",
+	"Dowkey Microwave",
+	"Mitadenshi Co.,LTD",
+	"SofaWare Technologies Ltd.",
+	"Corrigent Systems",
+	"Wiznet",
+	"Telena Communications, Inc.",
+	"3UP Systems",
+	"Alistel Inc.",
+	"ATO Technology Ltd.",
+	"Barix AG",
+	"Envenergy Inc",
+	"IDK Corporation",
+	"Littlefeet",
+	"SHI ControlSystems,Ltd.",
+	"Excel Master Ltd.",
+	"NextGig",
+	"Motion Control Engineering, Inc",
+	"ROMWin Co.,Ltd.",
+	"Optical Zonu Corporation",
+	"ST&T Instrument Corp.",
+	"Logic Product Development",
+	"DIBAL,S.A.",
+	"Next Generation Systems, Inc.",
+	"Voltaire",
+	"C&S Technology",
+	"WANY",
+	"Bluetake Technology Co., Ltd.",
+	"YESTECHNOLOGY Co.,Ltd.",
+	"Sumitomo Electric System Solutions Co., Ltd.",
+	"Hitachi Ltd, Semiconductor & Integrated Circuits Gr",
+	"UTC CCS",
+	"Karl E.Brinkmann GmbH",
+	"SonoSite, Inc.",
+	"Gigaphoton Inc.",
+	"BlueKorea Co., Ltd.",
+	"UNIK C&C Co.,Ltd.",
+	"Trilogy Communications Ltd",
+	"TMT",
+	"Shenzhen Shixuntong Information & Technoligy Co",
+	"Redline Communications Inc.",
+	"Panasas, Inc",
+	"MONDIAL electronic",
+	"iTEC Technologies Ltd.",
+	"Esteem Networks",
+	"Chrysalis Development",
+	"VTech Technology Corp.",
+	"Telenor Connect A/S",
+	"SnedFar Technology Co., Ltd.",
+	"MTL  Instruments PLC",
+	"Mayekawa Mfg. Co. Ltd.",
+	"LEADER ELECTRONICS CORP.",
+	"Helix Technology Inc.",
+	"Fortinet Inc.",
+	"Simple Access Inc.",
+	"SystemK Corporation",
+	"COMPUTROLS INC.",
+	"CAS Corp.",
+	"Listman Home Technologies, Inc.",
+	"WEM Technology Inc",
+	"SAMSUNG TECHWIN CO.,LTD",
+	"MDS Gateways",
+	"Macat Optics & Electronics Co., Ltd.",
+	"Digital Generation Inc.",
+	"CacheVision, Inc",
+	"Proteam Computer Corporation",
+	"Firstech Technology Corp.",
+	"A&D Co., Ltd.",
+	"EpoX COMPUTER CO.,LTD.",
+	"Planmeca Oy",
+	"TST Biometrics GmbH",
+	"Heaman System Co., Ltd",
+	"Telebau GmbH",
+	"VSN Systemen BV",
+	"YODA COMMUNICATIONS, INC.",
+	"TOYOKEIKI CO.,LTD.",
+	"Telecore",
+	"Sanyo Industries (UK) Limited",
+	"MYTECS Co.,Ltd.",
+	"iQstor Networks, Inc.",
+	"Hitpoint Inc.",
+	"HTC Corporation",
+	"B&Tech System Inc.",
+	"Akom Technology Corporation",
+	"AeroConcierge Inc.",
+	"Future Internet, Inc.",
+	"Omnilux",
+	"Ophit Co.Ltd.",
+	"Dream-Multimedia-Tv GmbH",
+	"Sandvine Incorporated",
+	"Ipetronik GmbH & Co. KG",
+	"Inventec Appliance Corp",
+	"Allot Communications",
+	"ShibaSoku Co.,Ltd.",
+	"Molex Fiber Optics",
+	"HYUNDAI NETWORKS INC.",
+	"Jacques Technologies P/L",
+	"Newisys,Inc.",
+	"C&I Technologies",
+	"Double-Win Enterpirse CO., LTD",
+	"AGFEO GmbH & Co. KG",
+	"Allied Telesis K.K.",
+	"Wireless Technologies, Inc",
+	"Palmmicro Communications Inc",
+	"Cluster Labs GmbH",
+	"Aztek, Inc.",
+	"Vista Control Systems, Corp.",
+	"Glyph Technologies Inc.",
+	"Homenet Communications",
+	"FillFactory NV",
+	"Communication Weaver Co.,Ltd.",
+	"Braintree Communications Pty Ltd",
+	"BARTECH SYSTEMS INTERNATIONAL, INC",
+	"elmegt GmbH & Co. KG",
+	"Independent Storage Corporation",
+	"Apogee Imaging Systems",
+	"Auerswald GmbH & Co. KG",
+	"Linkage System Integration Co.Ltd.",
+	"AMiT spol. s. r. o.",
+	"Young Generation International Corp.",
+	"Network Systems Group, Ltd. (NSG)",
+	"Supercaller, Inc.",
+	"INTELNET S.A.",
+	"Sitecsoft",
+	"RACEWOOD TECHNOLOGY",
+	"Netgear, Inc.",
+	"Philips Medical Systems - Cardiac and Monitoring Systems (CM",
+	"Dialogue Technology Corp.",
+	"Masstech Group Inc.",
+	"Telebyte, Inc.",
+	"YOZAN Inc.",
+	"Switchgear and Instrumentation Ltd",
+	"Sonitor Technologies AS",
+	"Dominion Lasercom Inc.",
+	"Hi-Techniques, Inc.",
+	"HyunJu Computer Co., Ltd.",
+	"Thales Navigation",
+	"Tachyon, Inc",
+	"TECHNOVENTURE, INC.",
+	"Meret Optical Communications",
+	"Cloverleaf Communications Inc.",
+	"Imedia Semiconductor Corp.",
+	"Powernet Technologies Corp.",
+	"GIANT ELECTRONICS LTD.",
+	"Beijing Zhongqing Elegant Tech. Corp.,Limited",
+	"Vibration Research Corporation",
+	"Time Management, Inc.",
+	"Securebase,Inc",
+	"Lenten Technology Co., Ltd.",
+	"Innopia Technologies, Inc.",
+	"fSONA Communications Corporation",
+	"Datasoft ISDN Systems GmbH",
+	"Brunner Elektronik AG",
+	"AIJI System Co., Ltd.",
+	"Advanced Television Systems Committee, Inc.",
+	"Louis Design Labs.",
+	"SecWell Networks Oy",
+	"IMI TECHNOLOGY CO., LTD",
+	"Vsecure 2000 LTD.",
+	"Power Zenith Inc.",
+	"Newport Networks",
+	"Loewe Opta GmbH",
+	"GlobalTop Technology, Inc.",
+	"MyCasa Network Inc.",
+	"Auto Telecom Company",
+	"Metalink LTD.",
+	"NISHI NIPPON ELECTRIC WIRE & CABLE CO.,LTD.",
+	"Nudian Electron Co., Ltd.",
+	"VividLogic Inc.",
+	"EqualLogic Inc",
+	"Entropic Communications, Inc.",
+	"Option Wireless Sweden",
+	"Velocity Semiconductor",
+	"ipcas GmbH",
+	"Cetacean Networks",
+	"ACKSYS Communications & systems",
+	"GE Fanuc Automation Manufacturing, Inc.",
+	"InterEpoch Technology,INC.",
+	"Visteon Corporation",
+	"Cronyx Engineering",
+	"Castle Technology Ltd",
+	"RDI",
+	"Capinfo Company Limited",
+	"CP GEORGES RENAULT",
+	"ELMO COMPANY, LIMITED",
+	"Western Telematic Inc.",
+	"Naval Research Laboratory",
+	"Haliplex Communications",
+	"Testech, Inc.",
+	"VIDEX INC.",
+	"Microtechno Corporation",
+	"Telewise Communications, Inc.",
+	"Interface Co., Ltd.",
+	"Leadfly Techologies Corp. Ltd.",
+	"HARTEC Corporation",
+	"HANSUNG ELETRONIC INDUSTRIES DEVELOPMENT CO., LTD",
+	"Ignis Optics, Inc.",
+	"Bang & Olufsen A/S",
+	"Eastmode Pte Ltd",
+	"Ikanos Communications",
+	"Data Comm for Business, Inc.",
+	"Netcontrol Oy",
+	"LANVOICE",
+	"HYUNDAI SYSCOMM, INC.",
+	"OKANO ELECTRIC CO.,LTD",
+	"e-generis",
+	"Onkyo Corporation",
+	"Kanematsu Electronics, Ltd.",
+	"L&F Inc.",
+	"MCM Systems Ltd",
+	"KISAN TELECOM CO., LTD.",
+	"3J Tech. Co., Ltd.",
+	"Entise Systems",
+	"Action Imaging Solutions",
+	"MAKU Informationstechik GmbH",
+	"MathStar, Inc.",
+	"Digital Safety Technologies, Inc",
+	"Epygi Technologies, Ltd.",
+	"Mamiya-OP Co.,Ltd.",
+	"Nintendo Co., Ltd.",
+	"6WIND",
+	"PROCES-DATA A/S",
+	"Onity, Inc.",
+	"NETAS",
+	"Medicore Co., Ltd",
+	"KINGENE Technology Corporation",
+	"Visionics Corporation",
+	"Movistec",
+	"SINAGAWA TSUSHIN KEISOU SERVICE",
+	"BlueWINC Co., Ltd.",
+	"iMaxNetworks(Shenzhen)Limited.",
+	"HBrain",
+	"Moog GmbH",
+	"HUDSON SOFT CO.,LTD.",
+	"SpaceBridge Semiconductor Corp.",
+	"iAd GmbH",
+	"Solacom Technologies Inc.",
+	"SERANOA NETWORKS INC",
+	"Mai Logic Inc.",
+	"Western DataCom Co., Inc.",
+	"Transtech Networks",
+	"Signal Communication, Inc.",
+	"KNC One GmbH",
+	"DC Security Products",
+	"Fält Communications AB",
+	"Neoscale Systems, Inc",
+	"Control Module Inc.",
+	"eSpace",
+	"Galaxis Technology AG",
+	"Mavin Technology Inc.",
+	"Samjin Information & Communications Co., Ltd.",
+	"Vestel Komunikasyon Sanayi ve Ticaret A.S.",
+	"XEMICS S.A.",
+	"Gemtek Technology Co., Ltd.",
+	"Sinbon Electronics Co., Ltd.",
+	"Angel Iglesias S.A.",
+	"K Tech Infosystem Inc.",
+	"Hottinger Baldwin Messtechnik GmbH",
+	"Cyber Switching Inc.",
+	"ADC Techonology",
+	"YEM Inc.",
+	"HuMANDATA LTD.",
+	"Daktronics, Inc.",
+	"CipherOptics",
+	"MEIKYO ELECTRIC CO.,LTD",
+	"Vocera Communications",
+	"Shimizu Technology Inc.",
+	"Yamaki Electric Corporation",
+	"Cohu, Inc., Electronics Division",
+	"WELL Communication Corp.",
+	"Alcon Laboratories, Inc.",
+	"Emerson Network Power Co.,Ltd",
+	"Shenzhen Eastern Digital Tech Ltd.",
+	"SED, a division of Calian",
+	"UNIMO TECHNOLOGY CO., LTD.",
+	"ART JAPAN CO., LTD.",
+	"Philips Patient Monitoring",
+	"IPFLEX Inc.",
+	"Ubinetics Limited",
+	"Daisy Technologies, Inc.",
+	"X.net 2000 GmbH",
+	"Mediatek Corp.",
+	"SOHOware, Inc.",
+	"ANNSO CO., LTD.",
+	"ENDESA SERVICIOS, S.L.",
+	"3Com Ltd",
+	"Widax Corp.",
+	"Teledex LLC",
+	"WebWayOne Ltd",
+	"ALPINE ELECTRONICS, INC.",
+	"TaraCom Integrated Products, Inc.",
+	"SUNIX Co., Ltd.",
+	"Sealevel Systems, Inc.",
+	"Scientific Research Corporation",
+	"FCI Deutschland GmbH",
+	"Invivo Research Inc.",
+	"Ilryung Telesys, Inc",
+	"FAST media integrations AG",
+	"ExPet Technologies, Inc",
+	"Azylex Technology, Inc",
+	"Honeywell Video Systems",
+	"TECO a.s.",
+	"Silicon Data, Inc",
+	"Lassen Research",
+	"NESTAR COMMUNICATIONS, INC",
+	"Vichel Inc.",
+	"Valere Power, Inc.",
+	"Imerge Ltd",
+	"Stream Labs",
+	"Bridge Information Co., Ltd.",
+	"Optical Communications Products Inc.",
+	"Red-M Products Limited",
+	"ART WARE Telecommunication Co., Ltd.",
+	"SVA Networks, Inc.",
+	"Integra Telecom Co. Ltd",
+	"Amperion Inc",
+	"Parama Networks Inc",
+	"Octave Communications",
+	"CERAGON NETWORKS",
+	"CEIA S.p.A.",
+	"Motorola",
+	"Pan Dacom Networking AG",
+	"QSI Systems Inc.",
+	"Etherstuff",
+	"Active Tchnology Corporation",
+	"Cabot Communications Limited",
+	"MAPLE NETWORKS CO., LTD",
+	"Artnix Inc.",
+	"HCV Consulting",
+	"Xsido Corporation",
+	"Identicard Systems Incorporated",
+	"Xilinx",
+	"Synelec Telecom Multimedia",
+	"Procera Networks, Inc.",
+	"Apani Networks",
+	"LoPA Information Technology",
+	"J-THREE INTERNATIONAL Holding Co., Ltd.",
+	"GCT Semiconductor, Inc",
+	"Enerpoint Ltd.",
+	"Elo Sistemas Eletronicos S.A.",
+	"EADS Telecom",
+	"Data East Corporation",
+	"Crown Audio -- Harmanm International",
+	"Chunghwa Telecom Co., Ltd.",
+	"Avery Dennison Deutschland GmbH",
+	"Audio-Technica Corp.",
+	"ARO WELDING TECHNOLOGIES SAS",
+	"Allied Vision Technologies",
+	"Albatron Technology",
+	"Targa Systems Ltd.",
+	"DataPower Technology, Inc.",
+	"Molecular Devices Corporation",
+	"Noritz Corporation",
+	"UNITEK Electronics INC.",
+	"Brain Boxes Limited",
+	"REMOTEK CORPORATION",
+	"GyroSignal Technology Co., Ltd.",
+	"AsiaRF Ltd.",
+	"Intronics, Incorporated",
+	"Laguna Hills, Inc.",
+	"MARKEM Corporation",
+	"HITACHI Maxell Ltd.",
+	"Hewlett-Packard Company - Standards",
+	"Freyer & Siegel Elektronik GmbH & Co. KG",
+	"HW server",
+	"GreenNET Technologies Co.,Ltd.",
+	"Power-One as",
+	"Carel s.p.a.",
+	"FingerTec Worldwide Sdn Bhd",
+	"3COM Corporation",
+	"almedio inc.",
+	"Autostar Technology Pte Ltd",
+	"Cellinx Systems Inc.",
+	"Crinis Networks, Inc.",
+	"DHD GmbH",
+	"Eracom Technologies",
+	"GentechMedia.co.,ltd.",
+	"MITSUBISHI ELECTRIC SYSTEM & SERVICE CO.,LTD.",
+	"OngCorp",
+	"SolarFlare Communications, Inc.",
+	"SUNNY bell Technology Co., Ltd.",
+	"SVM Microwaves s.r.o.",
+	"Tadiran Telecom Business Systems LTD",
+	"Walchem Corporation",
+	"EKS Elektronikservice GmbH",
+	"Harmonic, Inc",
+	"ZyFLEX Technologies Inc",
+	"MPLS Forum",
+	"Avrio Technologies, Inc",
+	"STEC, INC.",
+	"Scientific Atlanta",
+	"Manticom Networks Inc.",
+	"Caterpillar, Inc",
+	"Beida Jade Bird Huaguang Technology Co.,Ltd",
+	"Bluewire Technologies LLC",
+	"OLITEC",
+	"corega K.K",
+	"Kyoritsu Electric Co., Ltd.",
+	"Cornelius Consult",
+	"Tecton Ltd",
+	"Valo, Inc.",
+	"The Advantage Group",
+	"Teradon Industries, Inc",
+	"Telkonet Inc.",
+	"TEIMA Audiotex S.L.",
+	"TATSUTA SYSTEM ELECTRONICS CO.,LTD.",
+	"SALTO SYSTEMS S.L.",
+	"Rainsun Enterprise Co., Ltd.",
+	"PLAT'C2,Inc",
+	"Lenze",
+	"Integrated Micromachines Inc.",
+	"InCypher S.A.",
+	"Creval Systems, Inc.",
+	"Guardware Systems Ltd.",
+	"EUROTHERM LIMITED",
+	"Invacom Ltd",
+	"Aska International Inc.",
+	"Bayside Interactive, Inc.",
+	"HemoCue AB",
+	"Presonus Corporation",
+	"W2 Networks, Inc.",
+	"ShangHai cellink CO., LTD",
+	"MEWTEL TECHNOLOGY INC.",
+	"SONICblue, Inc.",
+	"M+F Gwinner GmbH & Co",
+	"Calamp Wireless Networks Inc",
+	"Aiptek International Inc",
+	"TB Group Inc",
+	"Server Technology, Inc.",
+	"King Young Technology Co. Ltd.",
+	"BroadWeb Corportation",
+	"Pannaway Technologies, Inc.",
+	"Cedar Point Communications",
+	"V V S Limited",
+	"SYSTEK INC.",
+	"SHIMAFUJI ELECTRIC CO.,LTD.",
+	"SHANGHAI SURVEILLANCE TECHNOLOGY CO,LTD",
+	"MAXLINK INDUSTRIES LIMITED",
+	"Hochiki Corporation",
+	"FEI Electron Optics",
+	"ePipe Pty. Ltd.",
+	"Brooks Automation GmbH",
+	"AltiGen Communications Inc.",
+	"Toyota Technical Development Corporation",
+	"TerraTec Electronic GmbH",
+	"Stargames Corporation",
+	"Rosemount Process Analytical",
+	"Pipal Systems",
+	"LOYTEC electronics GmbH",
+	"GENETEC Corporation",
+	"Fresnel Wireless Systems",
+	"Fa. GIRA",
+	"ETIC Telecommunications",
+	"Digital Electronic Network",
+	"COMPUNETIX, INC",
+	"Astera Technologies Corp.",
+	"Arcon Technology Limited",
+	"Taiwan Secom Co,. Ltd",
+	"Seabridge Ltd.",
+	"Rupprecht & Patashnick Co.",
+	"OPNET Technologies CO., LTD.",
+	"HIROTA SS",
+	"Fuyoh Video Industry CO., LTD.",
+	"Futuretel",
+	"FiberHome Telecommunication Technologies CO.,LTD",
+	"eM Technics Co., Ltd.",
+	"Daewoo Teletech Co., Ltd",
+	"Color Kinetics",
+	"Overture Networks.",
+	"Unication Group",
+	"ZPSYS CO.,LTD. (Planning&Management)",
+	"Zambeel Inc",
+	"YOKOYAMA SHOKAI CO.,Ltd.",
+	"XPAK MSA Group",
+	"Winnow Networks, Inc.",
+	"Sunrich Technology Limited",
+	"RADIANTECH, INC.",
+	"PROVIDEO Multimedia Co. Ltd.",
+	"Niigata Develoment Center,  F.I.T. Co., Ltd.",
+	"MWS",
+	"JEPICO Corporation",
+	"INITECH Co., Ltd",
+	"CoreBell Systems Inc.",
+	"Brainchild Electronic Co., Ltd.",
+	"BeamReach Networks",
+	"Origin ELECTRIC CO.,LTD.",
+	"IPCserv Technology Corp.",
+	"Sony Ericsson Mobile Communications AB",
+	"Vindicator Technologies",
+	"SkyPilot Network, Inc",
+	"RuggedCom Inc.",
+	"Allworx Corp.",
+	"Happy Communication Co., Ltd.",
+	"Gennum Corporation",
+	"Fujitsu Softek",
+	"EG Technology",
+	"Binatone Electronics International, Ltd",
+	"YANG MEI TECHNOLOGY CO., LTD",
+	"Wistron Corp.",
+	"ScottCare Corporation",
+	"ELIOP S.A.",
+	"Cathay Roxus Information Technology Co. LTD",
+	"AirVast Technology Inc.",
+	"ADAM ELEKTRONIK LTD. ŞTI",
+	"Shenzhen Tp-Link Technology Co; Ltd.",
+	"Koatsu Gas Kogyo Co., Ltd.",
+	"HARTING Systems GmbH & Co KG",
+	"GCD Hard- & Software GmbH",
+	"OTRUM ASA",
+	"SHIN-OH ELECTRONICS CO., LTD. R&D",
+	"Clarity Design, Inc.",
+	"NeoAxiom Corp.",
+	"Airgo Networks, Inc.",
+	"Emerson Climate Technologies Retail Solutions, Inc.",
+	"Broadcom Corp.",
+	"American Telecare Inc.",
+	"HiConnect, Inc.",
+	"Traverse Technologies Australia",
+	"Ambri Limited",
+	"Core Tec Communications, LLC",
+	"Kentec Electronics",
+	"NovaPal Ltd",
+	"Kilchherr Elektronik AG",
+	"FUJIAN START COMPUTER EQUIPMENT CO.,LTD",
+	"DAIICHI ELECTRONICS CO., LTD.",
+	"Dallmeier electronic",
+	"Taekwang Industrial Co., Ltd",
+	"Volktek Corporation",
+	"Pacific Broadband Networks",
+	"Voxpath Networks",
+	"Pillar Data Systems",
+	"Ifoundry Systems Singapore",
+	"dBm Optics",
+	"Corrent Corporation",
+	"Agile Systems Inc.",
+	"Air2U, Inc.",
+	"Trapeze Networks",
+	"Bosch Rexroth",
+	"11wave Technonlogy Co.,Ltd",
+	"HIMEJI ABC TRADING CO.,LTD.",
+	"NURI Telecom Co., Ltd.",
+	"ZETRON INC",
+	"ViewSonic Corporation",
+	"Platypus Technology",
+	"Communication Machinery Corporation",
+	"MKS Instruments",
+	"Vernier Networks, Inc.",
+	"Industrial Defender, Inc.",
+	"Systronix, Inc.",
+	"SIBCO bv",
+	"LayerZero Power Systems, Inc.",
+	"KAPPA opto-electronics GmbH",
+	"I CON Computer Co.",
+	"Hirata corporation",
+	"G-Star Communications Inc.",
+	"Environmental Systems and Services",
+	"Siemens Subscriber Networks",
+	"AirLogic",
+	"Aeluros",
+	"Wetek Corporation",
+	"Scion Corporation",
+	"Quatech Inc.",
+	"LS(LG) Industrial Systems co.,Ltd",
+	"HOWTEL Co., Ltd.",
+	"HOSTNET CORPORATION",
+	"Eiki Industrial Co. Ltd.",
+	"Danfoss Inc.",
+	"Cal-Comp Electronics (Thailand) Public Company Limited Taipe",
+	"bplan GmbH",
+	"Beijing Gongye Science & Technology Co.,Ltd",
+	"Yantai ZhiYang Scientific and technology industry CO., LTD",
+	"VORMETRIC, INC.",
+	"Vivato Technologies",
+	"ShangHai Broadband Technologies CO.LTD",
+	"Quad Bit System co., Ltd.",
+	"Productivity Systems, Inc.",
+	"MANUFACTURE DES MONTRES ROLEX SA",
+	"Knürr GmbH",
+	"Keisoku Giken Co.,Ltd.",
+	"QuStream Corporation",
+	"devolo AG",
+	"Cygnal Integrated Products, Inc.",
+	"CONTAL OK Ltd.",
+	"BittWare, Inc",
+	"Anthology Solutions Inc.",
+	"Oclaro",
+	"Ing. Büro Dr. Beutlhauser",
+	"commax Co., Ltd.",
+	"Microscan Systems, Inc.",
+	"Concord IDea Corp.",
+	"Advanced Energy",
+	"sofrel",
+	"RF-Link System Inc.",
+	"Visimetrics (UK) Ltd",
+	"VISIOWAVE SA",
+	"Clarion (M) Sdn Bhd",
+	"Emuzed",
+	"VertexRSI, General Dynamics SatCOM Technologies, Inc.",
+	"Verifone, INC.",
+	"Oxygnet",
+	"Micetek International Inc.",
+	"JOYMAX ELECTRONICS CO. LTD.",
+	"INITIUM Co., Ltd.",
+	"BiTMICRO Networks, Inc.",
+	"ADInstruments",
+	"Cybernetics",
+	"Silicon Laboratories",
+	"Astronautics C.A  LTD",
+	"ScriptPro, LLC",
+	"HyperEdge",
+	"Rincon Research Corporation",
+	"Newtech Co.,Ltd",
+	"Audio Engineering Society Inc.",
+	"Friedrich Lütze GmbH & Co. KG",
+	"ib-mohnen KG",
+	"Kaleidescape",
+	"Kieback & Peter GmbH & Co KG",
+	"Sy.A.C. srl",
+	"Teralink Communications",
+	"Topview Technology Corporation",
+	"Addvalue Communications Pte Ltd",
+	"Franke Finland Oy",
+	"Asiarock Incorporation",
+	"Wistron Neweb Corp.",
+	"Sychip Inc.",
+	"SOLECTRON JAPAN NAKANIIDA",
+	"Neff Instrument Corp.",
+	"Media Streaming Networks Inc",
+	"Load Technology, Inc.",
+	"Litchfield Communications Inc.",
+	"Lawo AG",
+	"Kodeos Communications",
+	"Kingwave Technology Co., Ltd.",
+	"Iosoft Ltd.",
+	"ET&T Technology Co. Ltd.",
+	"Cogent Systems, Inc.",
+	"TAIFATECH INC.",
+	"X-COM, Inc.",
+	"Test-Um Inc.",
+	"Telex Communications",
+	"SOLOMON EXTREME INTERNATIONAL LTD.",
+	"SAGINOMIYA Seisakusho Inc.",
+	"Align Engineering LLC",
+	"Lycium Networks",
+	"Kaparel Corporation",
+	"Grandstream Networks, Inc.",
+	"DATAWATT B.V.",
+	"BODET",
+	"Aruba Networks",
+	"American Reliance Inc.",
+	"Vidisco ltd.",
+	"Top Global Technology, Ltd.",
+	"MITEQ Inc.",
+	"KERAJET, S.A.",
+	"Flextronics",
+	"Avvio Networks",
+	"Ascent Corporation",
+	"AKITA ELECTRONICS SYSTEMS CO.,LTD.",
+	"ADVA Optical Networking Ltd.",
+	"Aglaia Gesellschaft für Bildverarbeitung und Kommunikation mbH",
+	"Ascom Danmark A/S",
+	"Ritter Elektronik",
+	"Digital Monitoring Products, Inc.",
+	"eBet Gaming Systems Pty Ltd",
+	"Innotrac Diagnostics Oy",
+	"Matsushita Electric Industrial Co.,Ltd.",
+	"NiceTechVision",
+	"SensAble Technologies, Inc.",
+	"Shanghai Ulink Telecom Equipment Co. Ltd.",
+	"Sirius System Co, Ltd.",
+	"TriBeam Technologies, Inc.",
+	"TwinMOS Technologies Inc.",
+	"Yasing Technology Corp.",
+	"Neue ELSA GmbH",
+	"T&L Information Inc.",
+	"SYSCOM Ltd.",
+	"Sumitomo Electric Networks, Inc",
+	"Siemens AG, I&S",
+	"Shiron Satellite Communications Ltd. (1996)",
+	"Quasar Cipta Mandiri, PT",
+	"Miyakawa Electric Works Ltd.",
+	"Maranti Networks",
+	"HANBACK ELECTRONICS CO., LTD.",
+	"CloudShield Technologies, Inc.",
+	"Aiphone co.,Ltd",
+	"Advantech Technology (CHINA) Co., Ltd.",
+	"PC-PoS Inc.",
+	"Vitals System Inc.",
+	"WOOJU COMMUNICATIONS Co,.Ltd",
+	"Sysnet Telematica srl",
+	"Super Star Technology Co., Ltd.",
+	"SMALLBIG TECHNOLOGY",
+	"RiT technologies Ltd.",
+	"RDC Semiconductor Inc.,",
+	"nStor Technologies, Inc.",
+	"Metalligence Technology Corp.",
+	"Micro Systems Co.,Ltd.",
+	"Kihoku Electronic Co.",
+	"Imsys AB",
+	"Etin Systems Co., Ltd",
+	"En Garde Systems, Inc.",
+	"Connexionz Limited",
+	"China IWNComm Co., Ltd.",
+	"Bay Microsystems, Inc.",
+	"Corinex Communication Corp.",
+	"Multiplex, Inc.",
+	"BIOTRONIK GmbH & Co",
+	"ISAC, Inc.",
+	"ICET S.p.A.",
+	"AirFlow Networks",
+	"Electroline Equipment",
+	"DATAVAN International Corporation",
+	"Fagor Automation , S. Coop",
+	"JUSAN, S.A.",
+	"Free2move AB",
+	"AGFA NDT INC.",
+	"XiMeta Technology Americas Inc.",
+	"Aeronix, Inc.",
+	"Remopro Technology Inc.",
+	"cd3o",
+	"Beijing Wise Technology & Science Development Co.Ltd",
+	"Nvergence, Inc.",
+	"Paxton Access Ltd",
+	"DORMA Time + Access GmbH",
+	"Industrial Scientific Corp.",
+	"General Hydrogen",
+	"EyeCross Co.,Inc.",
+	"Dell Inc",
+	"AKCP",
+	"TOHOKU RICOH Co., LTD.",
+	"TELDIX GmbH",
+	"Shenzhen RouterD Networks Limited",
+	"SercoNet Ltd.",
+	"Nokia NET Product Operations",
+	"Lumenera Corporation",
+	"Key Stream Co., Ltd.",
+	"Hosiden Corporation",
+	"HIMS International Corporation",
+	"Datel Electronics",
+	"COMFLUX TECHNOLOGY INC.",
+	"AOIP",
+	"Actel Corporation",
+	"Zultys Technologies",
+	"Systegra AG",
+	"NIPPON ELECTRIC INSTRUMENT, INC.",
+	"ELM Inc.",
+	"inc.jet, Incorporated",
+	"Code Corporation",
+	"MoTEX Products Co., Ltd.",
+	"LAP Laser Applikations",
+	"Chih-Kan Technology Co., Ltd.",
+	"BAE SYSTEMS",
+	"Shanghai Sibo Telecom Technology Co.,Ltd",
+	"Nitgen Co., Ltd",
+	"NIDEK CO.,LTD",
+	"Infinera",
+	"Gemstone communications, Inc.",
+	"EXEMYS SRL",
+	"D-NET International Corporation",
+	"CASTEL Broadband Limited",
+	"Berkeley Camera Engineering",
+	"BEB Industrie-Elektronik AG",
+	"Abatron AG",
+	"ABB Oy",
+	"HDMI Licensing, LLC",
+	"Tecnova",
+	"RPA Reserch Co., Ltd.",
+	"Nixvue Systems  Pte Ltd",
+	"Iftest AG",
+	"HUMEX Technologies Corp.",
+	"Hitachi IE Systems Co., Ltd",
+	"Guangdong Province Electronic Technology Research Institute",
+	"Broadbus Technologies",
+	"APPRO TECHNOLOGY INC.",
+	"Communications & Power Industries / Satcom Division",
+	"XtremeSpectrum, Inc.",
+	"Techno-One Co., Ltd",
+	"PNI Corporation",
+	"NIPPON DEMPA CO.,LTD.",
+	"Micro-Optronic-Messtechnik GmbH",
+	"MediaQ",
+	"Diagnostic Instruments, Inc.",
+	"CyberPower Systems, Inc.",
+	"Concorde Microsystems Inc.",
+	"AJA Video Systems Inc",
+	"Zenisu Keisoku Inc.",
+	"Telio Communications GmbH",
+	"Quest Technical Solutions Inc.",
+	"ORACOM Co, Ltd.",
+	"MicroWeb Co., Ltd.",
+	"Mettler & Fuchs AG",
+	"Global Cache",
+	"Glimmerglass Networks",
+	"Fi WIn, Inc.",
+	"Faculty of Science and Technology, Keio University",
+	"Double D Electronics Ltd",
+	"Beijing Lanchuan Tech. Co., Ltd.",
+	"ANATOR",
+	"Allied Telesis Labs, Inc.",
+	"Weintek Labs. Inc.",
+	"Sammy Corporation",
+	"RIFATRON",
+	"OCTTEL Communication Co., Ltd.",
+	"ELIAS Technology, Inc.",
+	"Enwiser Inc.",
+	"FullWave Technology Co., Ltd.",
+	"Openet information technology(shenzhen) Co., Ltd.",
+	"SeorimTechnology Co.,Ltd.",
+	"Avionic Design Development GmbH",
+	"Compucase Enterprise Co. Ltd.",
+	"Vixen Co., Ltd.",
+	"KaVo Dental GmbH & Co. KG",
+	"SHARP TAKAYA ELECTRONICS INDUSTRY CO.,LTD.",
+	"Geomation, Inc.",
+	"TelcoBridges Inc.",
+	"Sentinel Wireless Inc.",
+	"Oxance",
+	"Orion Electric Co., Ltd.",
+	"MediaChorus, Inc.",
+	"Glsystech Co., Ltd.",
+	"Crest Audio",
+	"Cogent Defence & Security Networks,",
+	"Altech Controls",
+	"Cisco-Linksys",
+	"Routerboard.com",
+	"Ralink Technology, Corp.",
+	"Automated Interfaces, Inc.",
+	"Animation Technologies Inc.",
+	"Allied Telesyn Inc.",
+	"SK Teletech(R&D Planning Team)",
+	"QoStek Corporation",
+	"Dangaard Telecom RTC Division A/S",
+	"Cygnus Microsystems (P) Limited",
+	"Cheops Elektronik",
+	"Arcor AG&Co.",
+	"Curtiss-Wright Controls Avionics & Electronics",
+	"Winbest Technology CO,LT",
+	"UDTech Japan Corporation",
+	"Scientific Technologies Inc.",
+	"Roll Systems Inc.",
+	"Pedestal Networks, Inc",
+	"Microlink Communications Inc.",
+	"Megatel Computer (1986) Corp.",
+	"MACKIE Engineering Services Belgium BVBA",
+	"M&S Systems",
+	"Indyme Electronics, Inc.",
+	"IBSmm Embedded Electronics Consulting",
+	"HANWANG TECHNOLOGY CO.,LTD",
+	"GTN Systems B.V.",
+	"CHIC TECHNOLOGY (CHINA) CORP.",
+	"Calypso Medical",
+	"Avtec, Inc.",
+	"ACM Systems",
+	"AC Tech corporation DBA Advanced Digital",
+	"ABB AB, Cewe-Control",
+	"Zenith Electronics Corporation",
+	"X2 MSA Group",
+	"Sunin Telecom",
+	"Pronto Networks Inc",
+	"OYO ELECTRIC CO.,LTD",
+	"SigmaTel, Inc.",
+	"National Radio Astronomy Observatory",
+	"MBARI",
+	"Kurz Industrie-Elektronik GmbH",
+	"Elgato Systems LLC",
+	"Edwards Ltd.",
+	"ASUSTEK COMPUTER INC.",
+	"Amtek system co.,LTD.",
+	"ACC GmbH",
+	"Wybron, Inc",
+	"Tempearl Industrial Co., Ltd.",
+	"TELSON ELECTRONICS CO., LTD",
+	"RIVERTEC CORPORATION",
+	"Oriental integrated electronics. LTD",
+	"MICRO-STAR INTERNATIONAL CO., LTD.",
+	"Life Racing Ltd",
+	"In-Tech Electronics Limited",
+	"Extel Communications P/L",
+	"DaTARIUS Technologies GmbH",
+	"ALPHA PROJECT Co.,Ltd.",
+	"Internet Information Image Inc.",
+	"TEIKOKU ELECTRIC MFG. CO., LTD",
+	"Tellium Incorporated",
+	"synertronixx GmbH",
+	"Opelcomm Inc.",
+	"Schneider Electric (Australia)",
+	"NETWORK TECHNOLOGIES INC",
+	"Logical Solutions",
+	"Eazix, Inc.",
+	"AMD",
+	"Apache Micro Peripherals, Inc.",
+	"AC Electric Vehicles, Ltd.",
+	"Bose Corporation",
+	"Connect Tech Inc",
+	"KODICOM CO.,LTD.",
+	"MATRIX VISION GmbH",
+	"Mentor Engineering Inc",
+	"Nergal s.r.l.",
+	"Octasic Inc.",
+	"Riverhead Networks Inc.",
+	"WolfVision Gmbh",
+	"Xeline Co., Ltd.",
+	"United Electronic Industries, Inc. (EUI)",
+	"PrimeNet",
+	"OQO, Inc.",
+	"NV ADB TTV Technologies SA",
+	"LETEK Communications Inc.",
+	"HITEL LINK Co.,Ltd",
+	"Hitech Electronics Corp.",
+	"EE Solutions, Inc",
+	"Chongho information & communications",
+	"UbeeAirWalk, Inc.",
+	"MemoryLink Corp.",
+	"NKE Corporation",
+	"StorCase Technology, Inc.",
+	"SIGMACOM Co., LTD.",
+	"Harmonic Video Network",
+	"Rancho Technology, Inc.",
+	"Prompttec Product Management GmbH",
+	"Naman NZ LTd",
+	"Mintera Corporation",
+	"Metro (Suzhou) Technologies Co., Ltd.",
+	"Garuda Networks Corporation",
+	"Ebtron Inc.",
+	"Cubic Transportation Systems Inc",
+	"COMMEND International",
+	"Citizen Watch Co., Ltd.",
+	"BTU International",
+	"Ailocom Oy",
+	"TRI TERM CO.,LTD.",
+	"Star Semiconductor Corporation",
+	"Salland Engineering (Europe) BV",
+	"UNION co., ltd.",
+	"ROUND Co.,Ltd.",
+	"AutoCell Laboratories, Inc.",
+	"Premier Technolgies, Inc",
+	"NANJING SEU MOBILE & INTERNET TECHNOLOGY CO.,LTD",
+	"Nanjing Huazhuo Electronics Co., Ltd.",
+	"MEDION AG",
+	"LEA",
+	"Jamex, Inc.",
+	"ISKRAEMECO",
+	"Iscutum",
+	"Interface Masters, Inc",
+	"Innominate Security Technologies AG",
+	"Holy Stone Ent. Co., Ltd.",
+	"Genera Oy",
+	"Cooper Industries Inc.",
+	"ControlNet (India) Private Limited",
+	"BeWAN systems",
+	"Tiptel AG",
+	"Nextlink Co., Ltd.",
+	"Ka-Ro electronics GmbH",
+	"Intelligent Computer Solutions Inc.",
+	"Xytronix Research & Design, Inc.",
+	"ILWOO DATA & TECHNOLOGY CO.,LTD",
+	"HGST a Western Digital Company",
+	"Design Combus Ltd",
+	"Aeroscout Ltd.",
+	"IEC - TC57",
+	"Symetrix",
+	"SFOM Technology Corp.",
+	"Schaffner EMV AG",
+	"Prettl Elektronik Radeberg GmbH",
+	"Positron Public Safety Systems inc.",
+	"Passave Inc.",
+	"PARTNER TECH",
+	"Nallatech Ltd",
+	"M. K. Juchheim GmbH & Co",
+	"Itcare Co., Ltd",
+	"FreeHand Systems, Inc.",
+	"BECS Technology, Inc",
+	"AOS Technologies AG",
+	"ABB STOTZ-KONTAKT GmbH",
+	"PULNiX America, Inc",
+	"Trek Diagnostics Inc.",
+	"The Open Group",
+	"Rolls-Royce",
+	"Option International N.V.",
+	"NeuroCom International, Inc.",
+	"Meru Networks Inc",
+	"MediaTek Inc.",
+	"GuangZhou AnJuBao Co., Ltd",
+	"BLOOMBERG L.P.",
+	"aphona Kommunikationssysteme",
+	"CNMP Networks, Inc.",
+	"Spectracom Corp.",
+	"Real Digital Media",
+	"jp-embedded",
+	"Open Networks Engineering Ltd",
+	"M & N GmbH",
+	"GAMESA Eólica",
+	"CALL IMAGE SA",
+	"AKATSUKI ELECTRIC MFG.CO.,LTD.",
+	"InfoExpress",
+	"Sitecom Europe BV",
+	"Xylem Water Solutions",
+	"Digital Systems Corp",
+	"Korea Network Systems",
+	"S2io Technologies Corp",
+	"Hyundai ImageQuest Co.,Ltd.",
+	"Grand Electronic Co., Ltd",
+	"MRO-TEK LIMITED",
+	"Seaway Networks Inc.",
+	"P&E Microcomputer Systems, Inc.",
+	"NEC Platforms, Ltd.",
+	"Matrics, Inc.",
+	"Foxboro Eckardt Development GmbH",
+	"cybernet manufacturing inc.",
+	"Compulogic Limited",
+	"Calrec Audio Ltd",
+	"AboveCable, Inc.",
+	"Yuehua(Zhuhai) Electronic CO. LTD",
+	"Projectiondesign as",
+	"MDI Security Systems",
+	"ITSupported, LLC",
+	"Inqnet Systems, Inc.",
+	"Finlux Ltd",
+	"Embedtronics Oy",
+	"DENTSPLY - Gendex",
+	"AXELL Corporation",
+	"Wilhelm Rutenbeck GmbH&Co.KG",
+	"Vtech Innovation LP dba Advanced American Telephones",
+	"Voipac s.r.o.",
+	"UHS Systems Pty Ltd",
+	"Turbo Networks Co.Ltd",
+	"Mega-Trend Electronics CO., LTD.",
+	"ROBE Show lighting",
+	"Mustek System Inc.",
+	"Kyoto Electronics Manufacturing Co., Ltd.",
+	"Amesys Defense",
+	"HIGH-TEK HARNESS ENT. CO., LTD.",
+	"Control Techniques",
+	"AV Digital",
+	"ASAHIKASEI TECHNOSYSTEM CO.,LTD.",
+	"WISCORE Inc.",
+	"Unitronics LTD",
+	"Smart Solution, Inc",
+	"SENTEC E&E CO., LTD.",
+	"SANDEN CORPORATION",
+	"Primagraphics Limited",
+	"MICROPLEX Printware AG",
+	"Scanmatic AS",
+	"Racal Instruments",
+	"Patapsco Designs Ltd",
+	"NCT Deutschland GmbH",
+	"Matsushita Avionics Systems Corporation",
+	"AIN Comm.Tech.Co., LTD",
+	"IceFyre Semiconductor",
+	"Compellent Technologies, Inc.",
+	"DispenseSource, Inc.",
+	"Prediwave Corp.",
+	"Shell International Exploration and Production, Inc.",
+	"PAC International Ltd",
+	"Wu Han Routon Electronic Co., Ltd",
+	"WIPLUG",
+	"NISSIN INC.",
+	"Network Electronics",
+	"Microsoft Corp.",
+	"Microelectronics Technology Inc.",
+	"i.Tech Dynamic Ltd",
+	"Hammerhead Systems, Inc.",
+	"APLUX Communications Ltd.",
+	"VTI Instruments Corporation",
+	"Verint Loronix Video Solutions",
+	"Siemens AG ICM MP UC RD IT KLF1",
+	"Newbest Development Limited",
+	"DRS Tactical Systems Inc.",
+	"Audio BU - Logitech",
+	"Tottori SANYO Electric Co., Ltd.",
+	"Parker SSD Drives",
+	"Collex",
+	"AEWIN Technologies Co., Ltd.",
+	"Triton Systems of Delaware, Inc.",
+	"Steag ETA-Optik",
+	"Roku, LLC",
+	"Outline Electronics Ltd.",
+	"Ninelanes",
+	"NDR Co.,LTD.",
+	"Kenwood Corporation",
+	"Galazar Networks",
+	"DIVR Systems, Inc.",
+	"Comart system",
+	"Beijing 5w Communication Corp.",
+	"SANYCOM Technology Co.,Ltd",
+	"Fujitsu I-Network Systems Limited.",
+	"Amity Systems, Inc.",
+	"Tiesse SpA",
+	"Smart Empire Investments Limited",
+	"Robert Bosch GmbH, VT-ATMO",
+	"Raritan Computer, Inc",
+	"NEC Personal Products",
+	"Minds Inc",
+	"Giga-Byte Technology Co., Ltd.",
+	"Funkwerk Dabendorf GmbH",
+	"DENT Instruments, Inc.",
+	"COMAG Handels AG",
+	"Vinci Systems, Inc.",
+	"TMT&D Corporation",
+	"Redwood Technologies LTD",
+	"Mita-Teknik A/S",
+	"M-Audio",
+	"K-Tech Devices Corp.",
+	"K-Patents Oy",
+	"Ember Corporation",
+	"Datamax Corporation",
+	"boca systems",
+	"2Wire, Inc",
+	"Technical Support, Inc.",
+	"Sand Network Systems, Inc.",
+	"Kobian Pte Ltd - Taiwan Branch",
+	"Hokuto Denshi Co,. Ltd.",
+	"FalconStor Software",
+	"Engineering & Security",
+	"Dynamic Solutions Co,.Ltd.",
+	"DiGATTO Asia Pacific Pte Ltd",
+	"Consensys Computers Inc.",
+	"Codian Ltd",
+	"Afco Systems",
+	"Axiowave Networks, Inc.",
+	"MIDAS  COMMUNICATION TECHNOLOGIES PTE LTD ( Foreign Branch)",
+	"Online Development Inc",
+	"Pepperl+Fuchs GmbH",
+	"PHS srl",
+	"Sanmina-SCI Hungary  Ltd.",
+	"Makus Inc.",
+	"Tapwave, Inc.",
+	"Huber + Suhner AG",
+	"D-Link Corporation",
+	"Bils Technology Inc",
+	"Winners Electronics Co., Ltd.",
+	"T&D Corporation",
+	"Shanghai Wedone Digital Ltd. CO.",
+	"Prosoft Technology, Inc",
+	"Koden Electronics Co., Ltd.",
+	"King Tsushin Kogyo Co., LTD.",
+	"Factum Electronics AB",
+	"Eclipse (HQ Espana) S.L.",
+	"Arima Communication Corporation",
+	"AFAR Communications,Inc",
+	"Opti-cell, Inc.",
+	"Vtera Technology Inc.",
+	"Tropos Networks, Inc.",
+	"S.W.A.C. Schmitt-Walter Automation Consult GmbH",
+	"Orbital Sciences Corp.; Launch Systems Group",
+	"INFOTEC LTD",
+	"Heraeus Electro-Nite International N.V.",
+	"Elan GmbH & Co KG",
+	"TOKUDEN OHIZUMI SEISAKUSYO Co.,Ltd.",
+	"RF Micro Devices",
+	"NEDAP N.V.",
+	"MIRAE ITS Co.,LTD.",
+	"Infrant Technologies, Inc.",
+	"Emerging Technologies Limited",
+	"DOSCH & AMAND SYSTEMS AG",
+	"Fabric7 Systems, Inc",
+	"Universal Switching Corporation",
+	"Teletronics Technology Corporation",
+	"T.E.A.M. S.L.",
+	"S.A.Tehnology co.,Ltd.",
+	"Parker Hannifin GmbH Electromechanical Division Europe",
+	"Japan CBM Corporation",
+	"Dataprobe, Inc.",
+	"SAMSUNG HEAVY INDUSTRIES CO., LTD.",
+	"Plexus Corp (UK) Ltd",
+	"Olym-tech Co.,Ltd.",
+	"Japan Network Service Co., Ltd.",
+	"Ammasso, Inc.",
+	"SDO Communication Corperation",
+	"NETASQ",
+	"GLOBALSAT TECHNOLOGY CORPORATION",
+	"Broadcom Corporation",
+	"SANKO ELECTRIC CO,.LTD",
+	"SCHILLER AG",
+	"PC Engines GmbH",
+	"Océ Document Technologies GmbH",
+	"Nippon Dentsu Co.,Ltd.",
+	"Bel Fuse Europe Ltd.,UK",
+	"TekTone Sound & Signal Mfg., Inc.",
+	"Spagat AS",
+	"SafeWeb Inc",
+	"First Communication, Inc.",
+	"Emcore Corporation",
+	"EchoStar Global B.V.",
+	"DigiRose Technology Co., Ltd.",
+	"COSMIC ENGINEERING INC.",
+	"AirMagnet, Inc",
+	"THALES Elektronik Systeme GmbH",
+	"Tait Electronics",
+	"Petcomkorea Co., Ltd.",
+	"NEOSMART Corp.",
+	"GROUPE TXCOM",
+	"Dynavac Technology Pte Ltd",
+	"Cidra Corp.",
+	"TetraTec Instruments GmbH",
+	"Stryker Corporation",
+	"Simrad Optronics ASA",
+	"SAMWOO Telecommunication Co.,Ltd.",
+	"Symantec Corporation",
+	"O'RITE TECHNOLOGY CO.,LTD",
+	"ITI    LTD",
+	"Bright",
+	"BBN",
+	"Anton Paar GmbH",
+	"ALLIED TELESIS K.K.",
+	"AIRWAVE TECHNOLOGIES INC.",
+	"VAC",
+	"Profilo Telra Elektronik Sanayi ve Ticaret. A.Ş",
+	"Joyteck Co., Ltd.",
+	"Japan Image & Network Inc.",
+	"ICPDAS Co.,LTD",
+	"Control Products, Inc.",
+	"CMZ Sistemi Elettronici",
+	"AT Sweden AB",
+	"DIGINICS, Inc.",
+	"Samsung Thales",
+	"YOUNGBO ENGINEERING CO.,LTD",
+	"Snap-on OEM Group",
+	"Nasaco Electronics Pte. Ltd",
+	"Napatech Aps",
+	"Kingtel Telecommunication Corp.",
+	"CompXs Limited",
+	"Andrew RF Power Amplifier Group",
+	"Soc. Coop. Bilanciai",
+	"QCOM TECHNOLOGY INC.",
+	"IONIX INC.",
+	"Asmax Solutions",
+	"Watertek Co.",
+	"Teletronics International Inc.",
+	"Technology Thesaurus Corp.",
+	"Space Dynamics Lab",
+	"ORGA Kartensysteme GmbH",
+	"NDS Limited",
+	"Micro Control Systems Ltd.",
+	"Komax AG",
+	"ITFOR Inc.",
+	"Huges Hi-Tech Inc.,",
+	"Hauppauge Computer Works, Inc.",
+	"CHENMING MOLD INDUSTRY CORP.",
+	"Atrie",
+	"ASIP Technologies Inc.",
+	"Advantech AMT Inc.",
+	"CMA/Microdialysis AB",
+	"WIRELESS MATRIX CORP.",
+	"Team Simoco Ltd",
+	"Cisco Linksys LLC",
+	"Shenzhen Coship Software Co.,LTD.",
+	"SAKUMA DESIGN OFFICE",
+	"Netac Technology Co., Ltd.",
+	"Hesch Schröder GmbH",
+	"ESA elettronica S.P.A.",
+	"ERMME",
+	"C-guys, Inc.",
+	"BDT Büro und Datentechnik GmbH & Co.KG",
+	"Adaptive Micro Systems Inc.",
+	"Accu-Sort Systems inc.",
+	"Visionary Solutions, Inc.",
+	"Tadlys LTD",
+	"SouthWing S.L.",
+	"MyA Technology",
+	"LogicaCMG Pty Ltd",
+	"JPS Communications",
+	"IAV GmbH",
+	"Hach Company",
+	"ARION Technology Inc.",
+	"QLogic Corporation",
+	"TCL Networks Equipment Co., Ltd.",
+	"ACCESS Systems Americas, Inc.",
+	"MTU Friedrichshafen GmbH",
+	"Incipient, Inc.",
+	"Huwell Technology Inc.",
+	"Hannae Technology Co., Ltd",
+	"Gincom Technology Corp.",
+	"Crere Networks, Inc.",
+	"Dynamic Ratings P/L",
+	"Shester Communications Inc",
+	"Safari Technologies",
+	"Netcodec co.",
+	"Hyundai Digital Technology Co.,Ltd.",
+	"Edimax Technology Co., Ltd.",
+	"Roche Diagnostics GmbH",
+	"AERAS Networks, Inc.",
+	"Olympus Soft Imaging Solutions GmbH",
+	"Kontron Medical",
+	"Shuko Electronics Co.,Ltd",
+	"NexGen City, LP",
+	"Intel Corp",
+	"HEINESYS, Inc.",
+	"Harms & Wende GmbH & Co.KG",
+	"Cirrus Logic",
+	"Hawking Technologies, Inc.",
+	"Transact Technologies Inc",
+	"Televic N.V.",
+	"Sun Optronics Inc",
+	"NIHON MECHATRONICS CO.,LTD.",
+	"Motic Incoporation Ltd.",
+	"G-Tek Electronics Sdn. Bhd.",
+	"Digital 5, Inc.",
+	"Beijing Newtry Electronic Technology Ltd",
+	"Niigata Seimitsu Co.,Ltd.",
+	"NCI System Co.,Ltd.",
+	"Lipman TransAction Solutions",
+	"Forsway Scandinavia AB",
+	"Changchun Huayu WEBPAD Co.,LTD",
+	"atrium c and i",
+	"Bermai Inc.",
+	"Numesa Inc.",
+	"Waveplus Technology Co., Ltd.",
+	"Trajet GmbH",
+	"Thomson Telecom Belgium",
+	"tecna elettronica srl",
+	"Optium Corporation",
+	"AV TECH CORPORATION",
+	"AlphaCell Wireless Ltd.",
+	"AUVITRAN",
+	"4G Systems GmbH & Co. KG",
+	"Iworld Networking, Inc.",
+	"Sonos, Inc.",
+	"SAGEM SA",
+	"TELEFIELD inc.",
+	"ParkerVision - Direct2Data",
+	"Triple Play Technologies A/S",
+	"Raisecom Technology",
+	"activ-net GmbH & Co. KG",
+	"360SUN Digital Broadband Corporation",
+	"MICROTROL LIMITED",
+	"Lemke Diagnostics GmbH",
+	"Elphel, Inc",
+	"TransCore",
+	"Hitachi Industry & Control Solutions, Ltd.",
+	"Eltis Microelectronics Ltd.",
+	"E-TOP Network Technology Inc.",
+	"China Electric Power Research Institute",
+	"Janitza electronics GmbH",
+	"Device Drivers Limited",
+	"Murata Manufacturing Co., Ltd.",
+	"MAT S.A. (Mircrelec Advanced Technology)",
+	"IRIS Corporation Berhad",
+	"in2 Networks",
+	"Gemstar Technology Development Ltd.",
+	"CTS electronics",
+	"Tpack A/S",
+	"Solar Telecom. Tech",
+	"New York Air Brake Corp.",
+	"GEMSOC INNOVISION INC.",
+	"Decru, Inc.",
+	"Amtelco",
+	"Ample Communications Inc.",
+	"GemWon Communications Co., Ltd.",
+	"Televes S.A.",
+	"Electronics Line 3000 Ltd.",
+	"ionSign Oy",
+	"Thomson Technology Inc",
+	"Devicescape Software, Inc.",
+	"Commtech Wireless",
+	"Catalyst Enterprises, Inc.",
+	"Alcatel North America",
+	"adp Gauselmann GmbH",
+	"VIDEOTRON CORP.",
+	"CLEMATIC",
+	"Avara Technologies Pty. Ltd.",
+	"Astarte Technology Co, Ltd.",
+	"Siemens AG A&D ET",
+	"Systems in Progress Holding GmbH",
+	"SparkLAN Communications, Inc.",
+	"Sercomm Corp.",
+	"PONICO CORP.",
+	"Navico Auckland Ltd",
+	"Open Telecom",
+	"Milénio 3 Sistemas Electrónicos, Lda.",
+	"Maas International BV",
+	"Fujiya Denki Seisakusho Co.,Ltd.",
+	"Cubic Defense Applications, Inc.",
+	"Ultracker Technology CO., Inc",
+	"HME Clear-Com LTD.",
+	"Spectrum Digital, Inc",
+	"BOE TECHNOLOGY GROUP CO.,LTD",
+	"Benchmark Electronics",
+	"Tiscali UK Ltd",
+	"Topfield Co., Ltd",
+	"TEMIC SDS GmbH",
+	"NetKlass Technology Inc.",
+	"Formosa Teletek Corporation",
+	"McAfee, Inc",
+	"CNCR-IT CO.,LTD,HangZhou P.R.CHINA",
+	"Certance Inc.",
+	"BLIP Systems",
+	"Endace Technology",
+	"United Technologists Europe Limited",
+	"Shanghai Xun Shi Communications Equipment Ltd. Co.",
+	"Scalent Systems, Inc.",
+	"Cray Inc",
+	"MINTRON ENTERPRISE CO., LTD.",
+	"Metanoia Technologies, Inc.",
+	"GAWELL TECHNOLOGIES CORP.",
+	"CASTEL",
+	"Solutions Radio BV",
+	"Newcotech,Ltd",
+	"Micro-Research Finland Oy",
+	"Hewlett-Packard",
+	"GUANGZHOU GAOKE COMMUNICATIONS TECHNOLOGY CO.LTD.",
+	"Ecastle Electronics Co., Ltd.",
+	"Riverbed Technology, Inc.",
+	"Knovative, Inc.",
+	"Iiga co.,Ltd",
+	"HASHIMOTO Electronics Industry Co.,Ltd.",
+	"HANMI SEMICONDUCTOR CO., LTD.",
+	"Everbee Networks",
+	"Paragon Fidelity GmbH",
+	"Burdick, a Quinton Compny",
+	"B&B Electronics Manufacturing Co.",
+	"Remsdaq Limited",
+	"MYNAH Technologies",
+	"Lowrance Electronics, Inc.",
+	"Logic Controls, Inc.",
+	"Iskra Transmission d.d.",
+	"Digital Multitools Inc",
+	"ASIX ELECTRONICS CORP.",
+	"Motorola Korea",
+	"Zoran Corporation",
+	"YOKO Technology Corp.",
+	"WTSS Inc",
+	"VineSys Technology",
+	"Tableau, LLC",
+	"SKOV A/S",
+	"S.I.T.T.I. S.p.A.",
+	"PROFIBUS Nutzerorganisation e.V.",
+	"Privaris, Inc.",
+	"Osaka Micro Computer.",
+	"Filtronic plc",
+	"Epicenter, Inc.",
+	"CRESITT INDUSTRIE",
+	"COPAN Systems Inc.",
+	"Positron Access Solutions Corp",
+	"Aksys, Ltd.",
+	"C-TECH UNITED CORP.",
+	"XiNCOM Corp.",
+	"Tellion INC.",
+	"SHURE INCORPORATED",
+	"REMEC, Inc.",
+	"PLX Technology",
+	"Mcharge",
+	"ExtremeSpeed Inc.",
+	"Custom Engineering",
+	"Chiyu Technology Co.,Ltd",
+	"bitWallet, Inc.",
+	"Adimos Systems LTD",
+	"AAC ELECTRONICS CORP.",
+	"zioncom",
+	"WayTech Development, Inc.",
+	"Shadong Luneng Jicheng Electronics,Co.,Ltd",
+	"Sandmartin(zhong shan)Electronics Co.,Ltd",
+	"Orban",
+	"Muco Industrie BV",
+	"Festo AG & Co. KG",
+	"EZQUEST INC.",
+	"Infinico Corporation",
+	"Smarthome",
+	"Kasda Networks Inc",
+	"iPAC Technology Co., Ltd.",
+	"E-TEN Information Systems Co., Ltd.",
+	"Vulcan Portals Inc",
+	"SBC ASI",
+	"REA Elektronik GmbH",
+	"Optoway Technology Incorporation",
+	"Macey Enterprises",
+	"JTAG Technologies B.V.",
+	"FUJINON CORPORATION",
+	"EndRun Technologies LLC",
+	"Megasolution,Inc.",
+	"Legra Systems, Inc.",
+	"DIGITALKS INC",
+	"Digicube Technology Co., Ltd",
+	"COM&C CO., LTD",
+	"cim-usa inc",
+	"3B SYSTEM INC.",
+	"Mangrove Systems, Inc.",
+	"Indagon Oy",
+	"Clear Edge Networks",
+	"Kentima Technologies AB",
+	"SYNCHRONIC ENGINEERING",
+	"Hunt Electronic Co., Ltd.",
+	"WaveSplitter Technologies, Inc.",
+	"Real ID Technology Co., Ltd.",
+	"RDM Corporation",
+	"Prodrive B.V.",
+	"Panasonic Europe Ltd.",
+	"Nisca corporation",
+	"Mindray Co., Ltd.",
+	"Kjaerulff1 A/S",
+	"JAY HOW TECHNOLOGY CO.,",
+	"Insta Elektro GmbH",
+	"Industrial Control Systems",
+	"Boston Scientific",
+	"Gaming Support B.V.",
+	"Ego Systems Inc.",
+	"DigitAll World Co., Ltd",
+	"Cosmo Techs Co., Ltd.",
+	"Chengdu KT Electric Co.of High & New Technology",
+	"Scientific Atlanta, Inc",
+	"Helius, Inc.",
+	"AimValley B.V.",
+	"WorldAccxx  LLC",
+	"TEAL Electronics, Inc.",
+	"Itronix Corporation",
+	"Augmentix Corporation",
+	"Cableware Electronics",
+	"GREENBELL SYSTEMS",
+	"Uplogix, Inc.",
+	"CHUNG-HSIN ELECTRIC & MACHINERY MFG.CORP.",
+	"Megapower International Corp.",
+	"W-LINX TECHNOLOGY CO., LTD.",
+	"Raza Microelectronics Inc",
+	"Allied Vision Technologies Canada Inc",
+	"Lootom Telcovideo Network Wuxi Co Ltd",
+	"DUALi Inc.",
+	"Accurate Techhnologies, Inc.",
+	"Xambala Incorporated",
+	"Netstar",
+	"IRIS SENSORS",
+	"HISHARP",
+	"Fuji System Machines Co., Ltd.",
+	"Endeleo Limited",
+	"CardioNet, Inc",
+	"Big Bear Networks",
+	"Optical Internetworking Forum",
+	"Zipher Ltd",
+	"Xalyo Systems",
+	"Wasabi Systems Inc.",
+	"Tivella Inc.",
+	"Stretch, Inc.",
+	"SINAR AG",
+	"ROBOX SPA",
+	"Polypix Inc.",
+	"Northover Solutions Limited",
+	"Kyushu-kyohan co.,ltd",
+	"Elextech INC",
+	"TalkSwitch",
+	"Cellink",
+	"Cadmus Technology Ltd",
+	"StreamScale Limited",
+	"Azul Systems, Inc.",
+	"YORK Refrigeration, Marine & Controls",
+	"Solarflare Communications Inc",
+	"Entrelogic Corporation",
+	"Datawire Communication Networks Inc.",
+	"Continuum Photonics Inc",
+	"CABLELOGIC Co., Ltd.",
+	"Adder Technology Limited",
+	"Phonak Communications AG",
+	"Peribit Networks",
+	"Delta Information Systems, Inc.",
+	"Day One Digital Media Limited",
+	"Genexis BV",
+	"Veo",
+	"Nicety Technologies Inc. (NTS)",
+	"Lifetron Co.,Ltd",
+	"Alcatel Bell Space N.V.",
+	"Obzerv Technologies",
+	"D&R Electronica Weesp BV",
+	"icube Corp.",
+	"West Instruments",
+	"Vavic Network Technology, Inc.",
+	"SEW Eurodrive GmbH & Co. KG",
+	"GateWare Communications GmbH",
+	"ADDI-DATA GmbH",
+	"Midas Engineering",
+	"BBox",
+	"FTA Communication Technologies",
+	"Wintec Industries, inc.",
+	"Sanmei Electronics Co.,Ltd",
+	"Sandburst",
+	"RS Automation Co., Ltd",
+	"Qamcom Technology AB",
+	"First Silicon Solutions",
+	"Digital Keystone, Inc.",
+	"DENTUM CO.,LTD",
+	"Datacap Systems Inc",
+	"Bluetooth Interest Group Inc.",
+	"BeiJing NuQX Technology CO.,LTD",
+	"Arce Sistemas, S.A.",
+	"ACTi Corporation",
+	"Xirrus",
+	"Ablerex Electronics Co., LTD",
+	"UBSTORAGE Co.,Ltd.",
+	"Trinity Security Systems,Inc.",
+	"PAL Pacific Inc.",
+	"Mortara Instrument, Inc.",
+	"Brainium Technologies Inc.",
+	"Astute Networks, Inc.",
+	"ADDO-Japan Corporation",
+	"Research In Motion Limited",
+	"Maxcess International",
+	"AMETEK, Inc.",
+	"Winnertec System Co., Ltd.",
+	"WideView",
+	"Orion MultiSystems Inc",
+	"Gigawavetech Pte Ltd",
+	"FAST TV-Server AG",
+	"DONGYANG TELECOM CO.,LTD.",
+	"Aerotelecom Co.,Ltd.",
+	"Microhard Systems Inc.",
+	"Landis+Gyr Ltd.",
+	"ELECOM Co.,LTD Laneed Division",
+	"Avanex Corporation",
+	"Avamax Co. Ltd.",
+	"APAC opto Electronics Inc.",
+	"Synchrony, Inc.",
+	"Ross Video Limited",
+	"Panduit Corp",
+	"DisplayLink (UK) Ltd",
+	"Murrelektronik GmbH",
+	"CANON KOREA BUSINESS SOLUTIONS INC.",
+	"Gigabit Systems Inc.",
+	"2xWireless",
+	"Alpha Networks Inc.",
+	"Sprecher Automation GmbH",
+	"BWA Technology GmbH",
+	"S2 Security Corporation",
+	"Raptor Networks Technology",
+	"Photometrics, Inc.",
+	"PC Fabrik",
+	"Nexus Technologies",
+	"Kyushu Electronics Systems Inc.",
+	"IEEE 802.11",
+	"FMN communications GmbH",
+	"E2O Communications",
+	"Dialog Inc.",
+	"Compal Electronics,INC.",
+	"Cognio Inc.",
+	"Broadband Pacenet (India) Pvt. Ltd.",
+	"Actiontec Electronics, Inc",
+	"Timespace Technology",
+	"NETGEAR Inc",
+	"Europlex Technologies",
+	"Cavium Networks",
+	"CallURL Inc.",
+	"Adaptive Instruments",
+	"Tevebox AB",
+	"Nokia Siemens Networks GmbH & Co. KG.",
+	"Onkey Technologies, Inc.",
+	"MRV Communications (Networks) LTD",
+	"e-w/you Inc.",
+	"DGT Sp. z o.o.",
+	"DELCOMp",
+	"WAVE Corporation",
+	"Uniwell Corporation",
+	"PalmPalm Technology, Inc.",
+	"NST co.,LTD.",
+	"KeyMed Ltd",
+	"Eurocom Industries A/S",
+	"Dionica R&D Ltd.",
+	"Chantry Networks",
+	"Allnet GmbH",
+	"A-JIN TECHLINE CO, LTD",
+	"Kikusui Electronics Corp.",
+	"Datawind Research",
+	"ASTRI",
+	"Applied Wireless Identifications Group, Inc.",
+	"EWA Technologies, Inc.",
+	"Digium",
+	"Soundcraft",
+	"Schwechat - RISE",
+	"Sarotech Co., Ltd",
+	"Harman Music Group",
+	"Force, Inc.",
+	"FlexDSL Telecommunications AG",
+	"YAZAKI CORPORATION",
+	"Westell Technologies",
+	"Ueda Japan  Radio Co., Ltd.",
+	"SORDIN AB",
+	"SOLOMON Technology Corp.",
+	"NComputing Co.,Ltd.",
+	"ID DIGITAL CORPORATION",
+	"Hangzhou H3C Technologies Co., Ltd.",
+	"Damm Cellular Systems A/S",
+	"Pantech Co.,Ltd",
+	"MERCURY SECURITY CORPORATION",
+	"MBTech Systems, Inc.",
+	"Lutron Electronics Co., Inc.",
+	"Lobos, Inc.",
+	"GW TECHNOLOGIES CO.,LTD.",
+	"Giga-Byte Technology Co.,LTD.",
+	"Cylon Controls",
+	"ARKUS Inc.",
+	"Anam Electronics Co., Ltd",
+	"XTec, Incorporated",
+	"Thales e-Transactions GmbH",
+	"Sunray Co. Ltd.",
+	"nex-G Systems Pte.Ltd",
+	"Loud Technologies Inc.",
+	"Jung Myoung Communications&Technology",
+	"Guntermann & Drunck GmbH",
+	"GN&S company",
+	"Darfon Electronics Corp.",
+	"Valcretec, Inc.",
+	"Optinel Systems, Inc.",
+	"Nippon Denso Industry Co., Ltd.",
+	"Merit Li-Lin Ent.",
+	"Glorytek Network Inc.",
+	"G-PRO COMPUTER",
+	"Control4",
+	"CABLE TELEVISION LABORATORIES, INC.",
+	"Citel",
+	"ACTIA",
+	"IMATRON, INC.",
+	"THE BRANTLEY COILE COMPANY,INC",
+	"UEC COMMERCIAL",
+	"Thales Contact Solutions Ltd.",
+	"VIENNA SYSTEMS CORPORATION",
+	"HORO QUARTZ",
+	"WILLIAMS COMMUNICATIONS GROUP",
+	"ITO CO., LTD.",
+	"MICRO LINEAR COPORATION",
+	"INDUSTRIAL CPU SYSTEMS",
+	"INITIO CORPORATION",
+	"PROCESSOR SYSTEMS (I) PVT LTD",
+	"Kontron America, Inc.",
+	"OOmon Inc.",
+	"T.SQWARE",
+	"Bosch Access Systems GmbH",
+	"BROADCOM CORPORATION",
+	"SIRONA DENTAL SYSTEMS GmbH & Co. KG",
+	"PictureTel Corp.",
+	"CORNET TECHNOLOGY, INC.",
+	"OHM TECHNOLOGIES INTL, LLC",
+	"WINBOND ELECTRONICS CORP.",
+	"MATSUSHITA ELECTRONIC INSTRUMENTS CORP.",
+	"Hand Held Products Inc",
+	"ENCANTO NETWORKS, INC.",
+	"SatCom Media Corporation",
+	"Network Equipment Technologies",
+	"NAGOYA ELECTRIC WORKS CO., LTD",
+	"Grayhill, Inc",
+	"ACCELERATED NETWORKS, INC.",
+	"L-3 COMMUNICATIONS EAST",
+	"COMPUTER TECHNICA, INC.",
+	"ZF MICROSYSTEMS, INC.",
+	"UMAX DATA SYSTEMS, INC.",
+	"Lasat Networks A/S",
+	"HITACHI SOFTWARE ENGINEERING",
+	"NETWORK SYSTEMS & TECHNOLOGIES PVT. LTD.",
+	"EION Inc.",
+	"OBJECTIVE COMMUNICATIONS, INC.",
+	"ALTA TECHNOLOGY",
+	"ACCESSLAN COMMUNICATIONS, INC.",
+	"GNP Computers",
+	"ELITEGROUP COMPUTER SYSTEMS CO., LTD",
+	"INTER-TEL INTEGRATED SYSTEMS",
+	"CYQ've Technology Co., Ltd.",
+	"MICRO RESEARCH INSTITUTE, INC.",
+	"Vectron Systems AG",
+	"DIAMOND NETWORK TECH",
+	"HIPPI NETWORKING FORUM",
+	"IC ENSEMBLE, INC.",
+	"PHASECOM, LTD.",
+	"NETSCHOOLS CORPORATION",
+	"TOLLGRADE COMMUNICATIONS, INC.",
+	"INTERMEC CORPORATION",
+	"BRISTOL BABCOCK, INC.",
+	"Alacritech, Inc.",
+	"A2 CORPORATION",
+	"InnoLabs Corporation",
+	"ALCORN MCBRIDE INC.",
+	"ECHO ELETRIC CO. LTD.",
+	"HTRC AUTOMATION, INC.",
+	"ShoreTel, Inc",
+	"The Parvus Corporation",
+	"Teledyne LeCroy, Inc",
+	"SURTEC INDUSTRIES, INC.",
+	"CEOLOGIC",
+	"RION CO., LTD.",
+	"CMICRO CORPORATION",
+	"METTLER-TOLEDO (ALBSTADT) GMBH",
+	"COMPUTER TECHNOLOGY CORP.",
+	"FUJITSU MICROELECTRONICS, INC.",
+	"SODICK CO., LTD.",
+	"Rebel.com, Inc.",
+	"ArrowPoint Communications",
+	"DIABLO RESEARCH CO. LLC",
+	"NET INSIGHT AB",
+	"QUANTUM DESIGNS (H.K.) LTD.",
+	"Draeger Medical",
+	"Spirent plc, Service Assurance Broadband",
+	"ZODIAC DATA SYSTEMS",
+	"BILLIONTON SYSTEMS, INC.",
+	"HOSTLINK CORP.",
+	"NX SERVER, ILNC.",
+	"STARGUIDE DIGITAL NETWORKS",
+	"DNPG, LLC",
+	"RADYNE CORPORATION",
+	"ADVANCED CONTROL SYSTEMS, INC.",
+	"COMOS TELECOM",
+	"HELIOSS COMMUNICATIONS, INC.",
+	"DIGITAL MICROWAVE CORPORATION",
+	"SONUS NETWORKS, INC.",
+	"EDNT GmbH",
+	"Axxcelera Broadband Wireless",
+	"TADIRAN COM. LTD.",
+	"TRENTON TECHNOLOGY INC.",
+	"CARADON TREND LTD.",
+	"ADVANET INC.",
+	"GVN TECHNOLOGIES, INC.",
+	"Technobox, Inc.",
+	"ATEN INTERNATIONAL CO., LTD.",
+	"Segate Technology LLC",
+	"EUREM GmbH",
+	"SAF DRIVE SYSTEMS, LTD.",
+	"NUERA COMMUNICATIONS, INC.",
+	"AmbiCom, Inc.",
+	"P-COM, INC.",
+	"AURORA COMMUNICATIONS, LTD.",
+	"BACHMANN ELECTRONIC GmbH",
+	"CRESTRON ELECTRONICS, INC.",
+	"METAWAVE COMMUNICATIONS",
+	"DPS, INC.",
+	"JNA TELECOMMUNICATIONS LIMITED",
+	"HEWLETT-PACKARD COMPANY",
+	"K-BOT COMMUNICATIONS",
+	"POLARIS COMMUNICATIONS, INC.",
+	"ATTO Technology, Inc.",
+	"Xstreamis PLC",
+	"AMERICAN NETWORKS INC.",
+	"WebSonic",
+	"TeraLogic, Inc.",
+	"LASERANIMATION SOLLINGER GmbH",
+	"FUJITSU TELECOMMUNICATIONS EUROPE, LTD.",
+	"Johnson Controls, Inc.",
+	"HUGH SYMONS CONCEPT Technologies Ltd.",
+	"RAPTOR SYSTEMS",
+	"CIMETRICS, INC.",
+	"NO WIRES NEEDED BV",
+	"NETCORE INC.",
+	"CMS COMPUTERS, LTD.",
+	"Performance Analysis Broadband, Spirent plc",
+	"Thomson Inc.",
+	"TRACEWELL SYSTEMS, INC.",
+	"WinNet Metropolitan Communications Systems, Inc.",
+	"STARNET TECHNOLOGIES, INC.",
+	"InnoMedia, Inc.",
+	"NETLINE",
+	"M-SYSTEM CO., LTD.",
+	"CLARINET SYSTEMS, INC.",
+	"AWARE, INC.",
+	"PAVO, INC.",
+	"INNOVEX TECHNOLOGIES, INC.",
+	"KENDIN SEMICONDUCTOR, INC.",
+	"TNS",
+	"OMNITRONIX, INC.",
+	"XIRCOM",
+	"OXFORD INSTRUMENTS",
+	"RELIANCE COMPUTER CORP.",
+	"ADHOC TECHNOLOGIES",
+	"MEDIA4, INC.",
+	"KOITO ELECTRIC INDUSTRIES, LTD.",
+	"IMCI TECHNOLOGIES",
+	"SOFTRONICS USB, INC.",
+	"SHINKO ELECTRIC INDUSTRIES CO.",
+	"TAC SYSTEMS, INC.",
+	"MERIDIAN TECHNOLOGY CORP.",
+	"FOR-A CO., LTD.",
+	"COACTIVE AESTHETICS",
+	"NOKIA MULTIMEDIA TERMINALS",
+	"ATMOSPHERE NETWORKS",
+	"ACCTON TECHNOLOGY CORPORATION",
+	"ENTRATA COMMUNICATIONS CORP.",
+	"COYOTE TECHNOLOGIES, LLC",
+	"ISHIGAKI COMPUTER SYSTEM CO.",
+	"MAXTOR CORP.",
+	"MARTINHO-DAVIS SYSTEMS, INC.",
+	"DATA & INFORMATION TECHNOLOGY",
+	"Aastra Telecom",
+	"THE TELECOMMUNICATION TECHNOLOGY COMMITTEE (TTC)",
+	"MARCH NETWORKS CORPORATION",
+	"InterAir Wireless",
+	"ARMA, Inc.",
+	"OI ELECTRIC CO., LTD.",
+	"WILLNET, INC.",
+	"CSI-CONTROL SYSTEMS",
+	"MEDIA GLOBAL LINKS CO., LTD.",
+	"PROTOCOL TECHNOLOGIES, INC.",
+	"Universal Global Scientific Industrial Co., Ltd.",
+	"DATA TRANSMISSION NETWORK",
+	"COMMUNICATIONS ELECTRONICS SECURITY GROUP",
+	"MITSUBISHI ELECTRONICS LOGISTIC SUPPORT CO.",
+	"FACIT K.K.",
+	"CLP COMPUTER LOGISTIK PLANUNG GmbH",
+	"INTERFACE CONCEPT",
+	"VOLAMP, LTD.",
+	"FIBERLANE COMMUNICATIONS",
+	"WITCOM, LTD.",
+	"Top Layer Networks, Inc.",
+	"NITTO TSUSHINKI CO., LTD",
+	"GRIPS ELECTRONIC GMBH",
+	"STORAGE COMPUTER CORPORATION",
+	"IMASDE CANARIAS, S.A.",
+	"Exelis",
+	"ARGOSY RESEARCH INC.",
+	"CALISTA",
+	"IBM JAPAN, FUJISAWA MT+D",
+	"Kollmorgen Corp",
+	"ENABLE SEMICONDUCTOR, INC.",
+	"INTERNATIONAL DATACASTING CORPORATION",
+	"RISE COMPUTER INC.",
+	"S.I. TECH, INC.",
+	"ArrayComm, Inc.",
+	"NSI CORPORATION",
+	"SOLECTRON TEXAS",
+	"APPLIED INTELLIGENT SYSTEMS, INC.",
+	"BreezeCom",
+	"TELOCITY, INCORPORATED",
+	"RAIDTEC LTD.",
+	"ADEPT TECHNOLOGY",
+	"SELSIUS SYSTEMS, INC.",
+	"RPCG, LLC",
+	"SUNDANCE TECHNOLOGY, INC.",
+	"CTI PRODUCTS, INC.",
+	"DBTEL INCORPORATED",
+	"RITTAL-WERK RUDOLF LOH GmbH & Co.",
+	"I-O CORPORATION",
+	"ANTEC",
+	"Nexcom International Co., Ltd.",
+	"Vertical Communications",
+	"AMHERST SYSTEMS, INC.",
+	"IRIICHI TECHNOLOGIES Inc.",
+	"TEXIO TECHNOLOGY CORPORATION",
+	"UNIQUE SYSTEMS, INC.",
+	"ZIDA TECHNOLOGIES LIMITED",
+	"BROADBAND NETWORKS, INC.",
+	"COCOM A/S",
+	"Schneider Electric",
+	"CET Technologies Pte Ltd",
+	"Aurora Multimedia Corp.",
+	"kawamura electric inc.",
+	"TELEXY",
+	"Sunplus Technology Co., Ltd.",
+	"Siemens NV (Belgium)",
+	"RGB Networks Inc.",
+	"Orbital Data Corporation",
+	"Micro-Star International",
+	"Franklin Technology Systems",
+	"Atmark Techno, Inc.",
+	"SANBlaze Technology, Inc.",
+	"Tsurusaki Sealand Transportation Co. Ltd.",
+	"netplat,Inc.",
+	"Maxanna Technology Co., Ltd.",
+	"Honeywell CMSS",
+	"Fraunhofer FOKUS",
+	"EverFocus Electronics Corp.",
+	"EPIN Technologies, Inc.",
+	"COTEAU VERT CO., LTD.",
+	"CESNET",
+	"BLX IC Design Corp., Ltd.",
+	"Solteras, Inc.",
+	"Targa Systems Div L-3 Communications Canada",
+	"Pleora Technologies Inc.",
+	"Hectrix Limited",
+	"EPSG (Ethernet Powerlink Standardization Group)",
+	"Doremi Labs, Inc.",
+	"CIMSYS Inc",
+	"Appointech, Inc.",
+	"Venstar Inc.",
+	"TASI, Inc",
+	"Streamit",
+	"Paradise Datacom Ltd.",
+	"Niko NV",
+	"NetModule AG",
+	"IZT GmbH",
+	"iPulse Systems",
+	"CEICOM",
+	"ASUSTek Computer Inc.",
+	"Allied Telesis (Hong Kong) Ltd.",
+	"UNATECH. CO.,LTD",
+	"Synology Incorporated",
+	"Siemens Austria SIMEA",
+	"MediaCell, Inc.",
+	"Grandeye Ltd",
+	"Goodrich Sensor Systems",
+	"AICHI ELECTRIC CO., LTD.",
+	"TAISHIN CO., LTD.",
+	"STOEBER ANTRIEBSTECHNIK GmbH + Co. KG.",
+	"SHINBORAM",
+	"Micronet Communications Inc.",
+	"Micronas GmbH",
+	"KN SOLTEC CO.,LTD.",
+	"JL Corporation",
+	"Alcatel DI",
+	"Nanometrics Inc.",
+	"GoodMan Corporation",
+	"e-SMARTCOM  INC.",
+	"Assurance Technology Corp",
+	"ValuePoint Networks",
+	"Telecard-Pribor Ltd",
+	"Secom-Industry co.LTD.",
+	"Prolon Control Systems",
+	"Proliphix Inc.",
+	"KAYABA INDUSTRY Co,.Ltd.",
+	"Francotyp-Postalia GmbH",
+	"caffeina applied research ltd.",
+	"Atsumi Electric Co.,LTD.",
+	"690885 Ontario Inc.",
+	"US Digital Television, Inc",
+	"Belkin Corporation",
+	"Mykotronx",
+	"Eidsvoll Electronics AS",
+	"Trident Tek, Inc.",
+	"Webpro Technologies Inc.",
+	"Sevis Systems",
+	"Pharos Systems NZ",
+	"OF Networks Co., Ltd.",
+	"MATISSE NETWORKS INC",
+	"Ivoclar Vivadent AG",
+	"ProMinent Dosiertechnik GmbH",
+	"ITX Security Co., Ltd.",
+	"ARTDIO Company Co., LTD",
+	"NetStreams, LLC",
+	"STAR MICRONICS CO.,LTD.",
+	"SYSTEM SPA DEPT. ELECTRONICS",
+	"ACARD Technology Corp.",
+	"Znyx Networks",
+	"Taelim Electronics Co., Ltd.",
+	"Integrated System Solution Corp.",
+	"HomeLogic LLC",
+	"EMS Satcom",
+	"Domo Ltd",
+	"Digital Data Communications Asia Co.,Ltd",
+	"Nanwang Multimedia Inc.,Ltd",
+	"American Time and Signal",
+	"PePLink Ltd.",
+	"Netforyou Co., LTD.",
+	"GSC SRL",
+	"DEXTER Communications, Inc.",
+	"COTRON CORPORATION",
+	"SMART Storage Systems",
+	"Wibhu Technologies, Inc.",
+	"Intellambda Systems, Inc.",
+	"Coaxial Networks, Inc.",
+	"Chiron Technology Ltd",
+	"Singular Technology Co. Ltd.",
+	"Singim International Corp.",
+	"Büchi  Labortechnik AG",
+	"e-zy.net",
+	"ZMD America, Inc.",
+	"Progeny, A division of Midmark Corp",
+	"Neotune Information Technology Corporation,.LTD",
+	"InterEnergy Co.Ltd,",
+	"IMI Norgren Ltd",
+	"Datalogic ADC, Inc.",
+	"Humo Laboratory,Ltd.",
+	"Prime Systems, Inc.",
+	"Category Solutions, Inc",
+	"Enterasys",
+	"Aerotech Inc",
+	"Viewtran Technology Limited",
+	"Alcatel-Lucent, Enterprise Business Group",
+	"Missouri Department of Transportation",
+	"Hanchang System Corp.",
+	"Halytech Mace",
+	"EUTECH INSTRUMENTS PTE. LTD.",
+	"Digital Design Corporation",
+	"CTS-Clima Temperatur Systeme GmbH",
+	"Chi Mei Communication Systems, Inc.",
+	"Actuality Systems, Inc.",
+	"Monitoring Technologies Limited",
+	"Prism Media Products Limited",
+	"2wcom Systems GmbH",
+	"Alkeria srl",
+	"Telesynergy Research Inc.",
+	"EP&T Energy",
+	"Diginfo Technology Corporation",
+	"Solectron Brazil",
+	"Vtech Engineering Canada Ltd",
+	"VISION NETWARE CO.,LTD",
+	"Manufacturing Technology Inc",
+	"LanReady Technologies Inc.",
+	"JStream Technologies Inc.",
+	"Fortuna Electronic Corp.",
+	"Sypixx Networks",
+	"Infilco Degremont Inc.",
+	"Quest Technologies",
+	"MOIMSTONE Co., LTD",
+	"Uniclass Technology, Co., LTD",
+	"TRUSTABLE TECHNOLOGY CO.,LTD.",
+	"Simtec Electronics",
+	"Shanghai Ruijie Technology",
+	"Medialink-i,Inc",
+	"Fortelink Inc.",
+	"BlueExpert Technology Corp.",
+	"2001 Technology Inc.",
+	"YOSHIMIYA CO.,LTD.",
+	"Shenzhen Powercom Co.,Ltd",
+	"Open Systems International",
+	"Octalix B.V.",
+	"Liebherr - Elektronik GmbH",
+	"Inner Range Pty. Ltd.",
+	"Elexol Pty Ltd",
+	"Bombardier Transportation",
+	"AGP Telecom Co. Ltd",
+	"AESYS S.p.A.",
+	"Aday Technology Inc",
+	"4P MOBILE DATA PROCESSING",
+	"United Fiber Optic Communication",
+	"Transceiving System Technology Corporation",
+	"Terminales de Telecomunicacion Terrestre, S.L.",
+	"TEN Technology",
+	"Raymarine UK Ltd",
+	"Powercom Co., Ltd.",
+	"MTT Corporation",
+	"Long Range Systems, Inc.",
+	"Jacobsons AB",
+	"Guangzhou Jinpeng Group Co.,Ltd.",
+	"Axsun Technologies",
+	"Ubisense Limited",
+	"Thrane & Thrane A/S",
+	"Tandberg Data ASA",
+	"Soft Imaging System GmbH",
+	"Perception Digital Ltd",
+	"NextGenTel Holding ASA",
+	"NetEnrich, Inc",
+	"Hangzhou Sunyard System Engineering Co.,Ltd.",
+	"HandEra, Inc.",
+	"eWerks Inc",
+	"TiVo",
+	"Vivaas Technology Inc.",
+	"Land-Cellular Corporation",
+	"Glunz & Jensen",
+	"FROMUS TEC. Co., Ltd.",
+	"EURILOGIC",
+	"Current Energy",
+	"U-MEDIA Communications, Inc.",
+	"Arcelik A.S",
+	"Hua Jung Components Co., Ltd.",
+	"Thomson, Inc.",
+	"Danelec Electronics A/S",
+	"KCodes Corporation",
+	"WORLDSAT - Texas de France",
+	"Tixi.Com",
+	"STARNEX CO., LTD.",
+	"IWICS Inc.",
+	"Innovative Integration",
+	"AVIX INC.",
+	"802 Global",
+	"Estari, Inc.",
+	"Conitec Datensysteme GmbH",
+	"Wideful Limited",
+	"QinetiQ Ltd",
+	"Institute of Network Technologies",
+	"NeoMedia Europe AG",
+	"woori-net",
+	"ASKEY COMPUTER CORP.",
+	"Asia Pacific Microsystems , Inc.",
+	"Shenzhen Forward Industry Co., Ltd",
+	"AIRAYA Corp",
+	"Rane Corporation",
+	"Heidelberg Engineering GmbH",
+	"HARTING Electric Gmbh & Co.KG",
+	"KORG INC.",
+	"Keiyo System Research, Inc.",
+	"Digitro Tecnologia Ltda",
+	"Decrane Aerospace - Audio International Inc.",
+	"ActivNetworks",
+	"u10 Networks, Inc.",
+	"Terrasat Communications, Inc.",
+	"iQuest (NZ) Ltd",
+	"Head Strong International Limited",
+	"Gantner Instruments GmbH",
+	"Fastrax Ltd",
+	"Emerson Climate Technologies GmbH",
+	"Chinasys Technologies Limited",
+	"CE-Infosys Pte Ltd",
+	"Advanced Telecommunication Technologies, Inc.",
+	"AboCom",
+	"IEEE 802.3",
+	"WideRay Corp",
+	"Protechna Herbst GmbH & Co. KG",
+	"PLUS  Corporation",
+	"Metrohm AG",
+	"Koenig & Bauer AG",
+	"iStor Networks, Inc.",
+	"ICP Internet Communication Payment AG",
+	"Cisco-Linksys, LLC",
+	"ARUZE Corporation",
+	"Ahead Communication Systems Inc",
+	"Techno Soft Systemnics Inc.",
+	"Sound Devices, LLC",
+	"PARROT S.A.",
+	"Netfabric Corporation",
+	"Harding Instruments",
+	"Cadco Systems",
+	"B.Braun Melsungen AG",
+	"Skardin (UK) Ltd",
+	"Pixim",
+	"NexQL Corporation",
+	"Japan Direx Corporation",
+	"Franklin Electric Co., Inc.",
+	"Data Ltd.",
+	"BroadEasy Technologies Co.,Ltd",
+	"VTech Telecommunications Ltd.",
+	"Virbiage Pty Ltd",
+	"Soenen Controls N.V.",
+	"SiNett Corporation",
+	"Signal Technology - AISD",
+	"Sanei Electric Inc.",
+	"Picaso Infocommunication CO., LTD.",
+	"Motion Control Systems, Inc.",
+	"LeWiz Communications Inc.",
+	"JRC TOKKI Co.,Ltd.",
+	"Camille Bauer",
+	"Andrew Corporation",
+	"ConSentry Networks",
+	"Texas Instruments",
+	"SetaBox Technology Co., Ltd.",
+	"S Net Systems Inc.",
+	"Posystech Inc., Co.",
+	"KeRo Systems ApS",
+	"Second Rule LLC",
+	"GES Co, Ltd",
+	"ERUNE technology Co., Ltd.",
+	"AMOI ELECTRONICS CO.,LTD",
+	"a2i marketing center",
+	"Millennial Net",
+	"Zellweger Analytics, Inc.",
+	"T.O.M TECHNOLOGY INC..",
+	"Samsung Electronics Co., Ltd.",
+	"EMC Corporation (Kashya)",
+	"Delta Elettronica S.p.A.",
+	"Dedicated Devices, Inc.",
+	"BBWM Corporation",
+	"Inducon BV",
+	"XAC AUTOMATION CORP.",
+	"Pentair Thermal Management",
+	"Tokyo Aircaft Instrument Co., Ltd.",
+	"SILINK",
+	"Citronix, LLC",
+	"AudioDev AB",
+	"Spectra Technologies Holdings Company Ltd",
+	"NetEffect Incorporated",
+	"LG INFORMATION & COMM.",
+	"LeapComm Communication Technologies Inc.",
+	"Activis Polska",
+	"THERMO ELECTRON KARLSRUHE",
+	"KAIMEI ELECTRONI",
+	"Green Hills Software, Inc.",
+	"CyberNet Inc.",
+	"CAEN",
+	"AWIND Inc.",
+	"Stanton Magnetics,inc.",
+	"Adaptix, Inc",
+	"Data Voice Technologies GmbH",
+	"daum electronic gmbh",
+	"Enerdyne Technologies, Inc.",
+	"Swisscom Hospitality Services SA",
+	"Panasonic Corporation",
+	"IPS d.o.o.",
+	"Value Electronics",
+	"OPTOELECTRONICS Co., Ltd.",
+	"Ascalade Communications Limited",
+	"Visonic Ltd.",
+	"University of California, Berkeley",
+	"Seidel Elektronik GmbH Nfg.KG",
+	"Rayson Technology Co., Ltd.",
+	"NGES Denro Systems",
+	"Measurement Computing Corp",
+	"Redux Communications Ltd.",
+	"Stoke Inc",
+	"NIT lab",
+	"Sentilla Corporation",
+	"CG Power Systems Ireland Limited",
+	"Korenix Technologies Co., Ltd.",
+	"International Bar Code",
+	"Sanyu Industry Co.,Ltd.",
+	"VIA Networking Technologies, Inc.",
+	"SWEGON AB",
+	"MobileAria",
+	"Digital Lifestyles Group, Inc.",
+	"March Networks S.p.A.",
+	"Qovia",
+	"Lab33 Srl",
+	"Gizmondo Europe Ltd",
+	"ENDEVCO CORP",
+	"Digital Everywhere Unterhaltungselektronik GmbH",
+	"Advance Sterilization Products",
+	"Sensory Networks Inc",
+	"Woodward Governor",
+	"STB Datenservice GmbH",
+	"Q-Free ASA",
+	"Montilio",
+	"KYOWA Electric & Machinery Corp.",
+	"KWS Computersysteme GmbH",
+	"Griffin Technology",
+	"GE Energy",
+	"SUMITOMO ELECTRIC DEVICE INNOVATIONS, INC",
+	"Aiware Inc.",
+	"Addlogix",
+	"O2Micro, Inc.",
+	"MICO ELECTRIC(SHENZHEN) LIMITED",
+	"Ktech Telecommunications Inc",
+	"IRT Electronics Pty Ltd",
+	"E2S Electronic Engineering Solutions, S.L.",
+	"Yulinet",
+	"First International Computer do Brasil",
+	"Surf Communications Inc.",
+	"RAE Systems",
+	"NeoMeridian Sdn Bhd",
+	"BluePacket Communications Co., Ltd.",
+	"VITA",
+	"Trust International B.V.",
+	"ThingMagic, LLC",
+	"Stargen, Inc.",
+	"Dolby Australia",
+	"ISR TECHNOLOGIES Inc",
+	"intec GmbH",
+	"IEE, Inc.",
+	"WiLife, Inc.",
+	"ONTIMETEK INC.",
+	"IDS GmbH",
+	"HLS HARD-LINE Solutions Inc.",
+	"ELPRO Technologies",
+	"Efore Oyj   (Plc)",
+	"Dai Nippon Printing Co., Ltd",
+	"AVOLITES LTD.",
+	"Advance Wireless Technology Corp.",
+	"Work Microwave GmbH",
+	"Vialta, Inc.",
+	"Santa Barbara Infrared, Inc.",
+	"PTW Freiburg",
+	"G2 Microsystems",
+	"Fusion Digital Technology",
+	"FSI Systems, Inc.",
+	"Telecommunications Industry Association TR-41 Committee",
+	"Echolab LLC",
+	"Avantec Manufacturing Limited",
+	"Astek Corporation",
+	"Arcadyan Technology Corporation",
+	"HotLava Systems, Inc.",
+	"Check Point Software Technologies",
+	"Apex Electronics Factory",
+	"WIT S.A.",
+	"Viseon, Inc.",
+	"V-Show  Technology (China) Co.,Ltd",
+	"TGC America, Inc",
+	"SECURAY Technologies Ltd.Co.",
+	"Perfect tech",
+	"Mechatronic Brick Aps",
+	"CSS Inc.",
+	"Bitatek CO., LTD",
+	"ASEM SpA",
+	"Advanced Cybernetics Group",
+	"Accton Technology Corporation",
+	"Gossen-Metrawatt-GmbH",
+	"Texas Instruments Inc",
+	"Zetta Systems, Inc.",
+	"Princeton Technology, Ltd",
+	"Motion Reality Inc.",
+	"Jiangsu Yitong High-Tech Co.,Ltd",
+	"Invento Networks, Inc.",
+	"International Games System Co., Ltd.",
+	"ZIEHL industrie-elektronik GmbH + Co KG",
+	"SunCorp Industrial Limited",
+	"Shengqu Information Technology (Shanghai) Co., Ltd.",
+	"Radio Components Sweden AB",
+	"Novomatic AG",
+	"Codan Limited",
+	"Alliant Networks, Inc",
+	"ALAXALA Networks Corporation",
+	"Agat-RT, Ltd.",
+	"ZIEHL industrie-electronik GmbH + Co KG",
+	"Time America, Inc.",
+	"SPECTEC COMPUTER CO., LTD.",
+	"Projectek Networking Electronics Corp.",
+	"Fraunhofer IMS",
+	"Abbey Systems Ltd",
+	"Trane",
+	"PDH Solutions, LLC",
+	"Movacolor b.v.",
+	"AVG Advanced Technologies",
+	"OneAccess SA",
+	"Intel Corporate",
+	"IFOTEC",
+	"connectBlue AB",
+	"Belco International Co.,Ltd.",
+	"Imarda New Zealand Limited",
+	"MDK CO.,LTD.",
+	"Xiamen Xinglian Electronics Co., Ltd.",
+	"WNI Resources, LLC",
+	"URYU SEISAKU, LTD.",
+	"THX LTD",
+	"Samsung Electronics",
+	"PLANET System Co.,LTD",
+	"OPTIMUS IC S.A.",
+	"Lenovo Mobile Communication Technology Ltd.",
+	"Lely Industries N.V.",
+	"IT-FACTORY, INC.",
+	"IronGate S.L.",
+	"GateConnect",
+	"Flaircomm Technologies Co. LTD",
+	"Epicom, Inc.",
+	"Always On Wireless",
+	"Paravirtual Corporation",
+	"Nuvera Fuel Cells",
+	"Ocean Broadband Networks",
+	"Nortel",
+	"Mextal B.V.",
+	"HF System Corporation",
+	"GALILEO AVIONICA",
+	"Focusrite Audio Engineering Limited",
+	"EGEMEN Bilgisayar Muh San ve Tic LTD STI",
+	"Amedia Networks Inc.",
+	"GuangZhou Post & Telecom Equipment ltd",
+	"Asiamajor Inc.",
+	"SONY Computer Entertainment inc,",
+	"L-S-B Broadcast Technologies GmbH",
+	"GN Netcom as",
+	"DGSTATION Co., Ltd.",
+	"BeCell Innovations Corp.",
+	"LiteTouch, Inc.",
+	"Scanvaegt International A/S",
+	"Peiker acustic GmbH & Co. KG",
+	"NxtPhase T&D, Corp.",
+	"DAQ Electronics, Inc.",
+	"Cap Co., Ltd.",
+	"Schneider Electric Ultra Terminal",
+	"Cortina Systems Inc",
+	"ECM Systems Ltd",
+	"Data Acquisitions limited",
+	"Westech Korea Inc.,",
+	"VSST Co., LTD",
+	"Sitronics Telecom Solutions",
+	"Phoenix Digital",
+	"MAZ Brandenburg GmbH",
+	"iWise Communications",
+	"ITian Coporation",
+	"Interactek",
+	"EURO PROTECTION SURVEILLANCE",
+	"CellPoint Connect",
+	"Beijing Topsec Network Security Technology Co., Ltd.",
+	"BaudTec Corporation",
+	"Arkados, Inc.",
+	"VS Industry Berhad",
+	"Tianjin 712 Communication Broadcasting co., ltd.",
+	"Orient Power Home Network Ltd.",
+	"FRESENIUS-VIAL",
+	"CCV Deutschland GmbH",
+	"VadaTech Inc.",
+	"Speed Dragon Multimedia Limited",
+	"QUINTRON SYSTEMS INC.",
+	"Micro Memory Curtiss Wright Co",
+	"MetaSwitch",
+	"Eppendorf Instrumente GmbH",
+	"AD.EL s.r.l.",
+	"Shandong New Beiyang Information Technology Co.,Ltd",
+	"Vision Research, Inc.",
+	"Matsushita Electronic Components (Europe) GmbH",
+	"Fargo Electronics Inc.",
+	"Eaton Corporation",
+	"Artila Electronics Co., Ltd.",
+	"ZyXEL Communications Corporation",
+	"Engim, Inc.",
+	"ToGoldenNet Technology Inc.",
+	"YDT Technology International",
+	"Inepro BV",
+	"Valox Systems, Inc.",
+	"Tranzeo Wireless Technologies Inc.",
+	"Silver Spring Networks, Inc",
+	"Niles Audio Corporation",
+	"Naztec, Inc.",
+	"HYDAC Filtertechnik GMBH",
+	"Zcomax Technologies, Inc.",
+	"TOMEN Cyber-business Solutions, Inc.",
+	"FLIR Radiation Inc",
+	"Soyal Technology Co., Ltd.",
+	"Realm Systems, Inc.",
+	"ProTelevision Technologies A/S",
+	"Project T&E Limited",
+	"PanelLink Cinema, LLC",
+	"OnSite Systems, Inc.",
+	"NTTPC Communications, Inc.",
+	"EAB/RWI/K",
+	"Biospace Co., Ltd.",
+	"ShinHeung Precision Co., Ltd.",
+	"Verascape, Inc.",
+	"Paradigm Technology Inc..",
+	"Neturity Technologies Inc.",
+	"Narayon. Co., Ltd.",
+	"Saab Danmark A/S",
+	"Honda Electron Co., LED.",
+	"Hach Lange Sarl",
+	"E-TEC",
+	"TomTom",
+	"Tentaculus AB",
+	"Techmetro Corp.",
+	"PacketMotion, Inc.",
+	"BLwave Electronics Co., Ltd",
+	"American Security Products Co.",
+	"Tabor Electronics Ltd.",
+	"Samsung Electronics CO., LTD",
+	"Qsan Technology, Inc.",
+	"PONDER INFORMATION INDUSTRIES LTD.",
+	"Netvox Technology Co., Ltd.",
+	"Movon Corporation",
+	"Kaicom co., Ltd.",
+	"Dynalab, Inc.",
+	"CorEdge Networks, Inc.",
+	"CHIPS & Systems, Inc.",
+	"Cetacea Networks Corporation",
+	"Application Technologies and Engineering Research Laboratory",
+	"Advanced Motion Controls",
+	"Add-On Technology Co., LTD.",
+	"ABB Inc./Totalflow",
+	"27M Technologies AB",
+	"WiMedia Alliance",
+	"Redes de Telefonía Móvil S.A.",
+	"QINGDAO GOERTEK ELECTRONICS CO.,LTD.",
+	"Phantom Technologies LLC",
+	"Kumyoung.Co.Ltd",
+	"Kinghold",
+	"FOAB Elektronik AB",
+	"Termtek Computer Co., Ltd",
+	"OUEN CO.,LTD.",
+	"Ruckus Wireless",
+	"Panta Systems, Inc.",
+	"Infohand Co.,Ltd",
+	"congatec AG",
+	"Acbel Polytech Inc.",
+	"TrafficSim Co.,Ltd",
+	"STAC Corporation.",
+	"K-ubique ID Corp.",
+	"ioIMAGE Ltd.",
+	"Exavera Technologies, Inc.",
+	"Marvell Hispana S.L.",
+	"Ciara Technologies Inc.",
+	"Electronics Design Services, Co., Ltd.",
+	"ALGOSYSTEM Co., Ltd.",
+	"Crow Electronic Engeneering",
+	"MaxStream, Inc",
+	"Siemens Com CPE Devices",
+	"KeyEye Communications",
+	"General Solutions, LTD.",
+	"Extricom Ltd",
+	"BATTELLE MEMORIAL INSTITUTE",
+	"Tanisys Technology",
+	"ALS  & TEC Ltd.",
+	"Telemotive AG",
+	"Sunmyung Electronics Co., LTD",
+	"Sendo Ltd",
+	"Radiance Technologies, Inc.",
+	"NUMA Technology,Inc.",
+	"Jablotron",
+	"Intelligent Control Systems (Asia) Pte Ltd",
+	"Carallon Limited",
+	"Ecom Communications Technology Co., Ltd.",
+	"Appear TV",
+	"Wavesat",
+	"Sling Media, Inc.",
+	"Scantech ID",
+	"RyCo Electronic Systems Limited",
+	"BM SPA",
+	"ReadyLinks Inc",
+	"Smartvue Corporation",
+	"Artimi Ltd",
+	"HYMATOM SA",
+	"Virtual Conexions",
+	"Media System Planning Corp.",
+	"Trix Tecnologia Ltda.",
+	"Asoka USA Corporation",
+	"WACOM Co.,Ltd",
+	"LIGHTRON FIBER-OPTIC DEVICES INC.",
+	"OpenGear, Inc",
+	"IONOS Co.,Ltd.",
+	"Beyond Achieve Enterprises Ltd.",
+	"Pico Digital",
+	"Zenitel Norway AS",
+	"Tall Maple Systems",
+	"MTI co. LTD",
+	"4Access Communications",
+	"t+ Medical Ltd",
+	"KIRK telecom A/S",
+	"PAGE IBERICA, S.A.",
+	"ASUSTek COMPUTER INC.",
+	"RuggedCom",
+	"TII NETWORK TECHNOLOGIES, INC.",
+	"SPIDCOM Technologies SA",
+	"Princeton Instruments",
+	"Matrix Product Development, Inc.",
+	"Diskware Co., Ltd",
+	"SHOEI Electric Co.,Ltd",
+	"IBTEK INC.",
+	"Abbott Diagnostics",
+	"Adapt4, LLC",
+	"Ryvor Corp.",
+	"Iprobe AB",
+	"GeoVision Inc.",
+	"CoVi Technologies, Inc.",
+	"YANGJAE SYSTEMS CORP.",
+	"TENOSYS, INC.",
+	"Technolution",
+	"Halcro",
+	"VeriWave, Inc.",
+	"Kamstrup A/S",
+	"Sysmaster Corporation",
+	"Netsnapper Technologies SARL",
+	"PSIA",
+	"JBX Designs Inc.",
+	"Kingjon Digital Technology Co.,Ltd",
+	"Wavefront Semiconductor",
+	"AMOD Technology Co., Ltd.",
+	"Klas Ltd",
+	"Giga-byte Communications Inc.",
+	"Psitek (Pty) Ltd",
+	"Akimbi Systems",
+	"Cintech",
+	"Dex Security Solutions",
+	"Cavera Systems",
+	"LifeSize Communications, Inc",
+	"RKC INSTRUMENT INC.",
+	"SiCortex, Inc",
+	"GRANDTEC ELECTRONIC CORP.",
+	"Dage-MTI of MC, Inc.",
+	"MINERVA KOREA CO., LTD",
+	"Rivertree Networks Corp.",
+	"kk-electronic a/s",
+	"Renasis, LLC",
+	"OpenIB, Inc.",
+	"Go Networks",
+	"Sperian Protection Instrumentation",
+	"Eka Systems Inc.",
+	"MAGNETI MARELLI   S.E. S.p.A.",
+	"WEPIO Co., Ltd.",
+	"FIRST INTERNATIONAL COMPUTER, INC.",
+	"GKB CCTV CO., LTD.",
+	"Federal State Unitary Enterprise Leningrad R&D Institute of",
+	"Suzhou Keda Technology CO.,Ltd",
+	"Deutschmann Automation GmbH & Co. KG",
+	"S-TEC electronics AG",
+	"Trebing & Himstedt Prozeßautomation GmbH & Co. KG",
+	"Jumpnode Systems LLC.",
+	"Intec Automation Inc.",
+	"Scosche Industries, Inc.",
+	"RSE Informations Technologie GmbH",
+	"C4Line",
+	"SIDSA",
+	"DEICY CORPORATION",
+	"LTi DRIVES GmbH",
+	"P.A. Semi, Inc.",
+	"SunKwang Electronics Co., Ltd",
+	"G-Links networking company",
+	"Total Wireless Technologies Pte. Ltd.",
+	"J-S Co. NEUROCOM",
+	"Merry Electrics CO., LTD.",
+	"Galactic Computing Corp.",
+	"NL Technology",
+	"JazzMutant",
+	"Vocollect, Inc",
+	"V Center Technologies Co., Ltd.",
+	"Elitegroup Computer System Co., Ltd",
+	"Edata Communication Inc.",
+	"Koncept International, Inc.",
+	"Toradex AG",
+	"77 Elektronika Kft.",
+	"WildPackets",
+	"ViPowER, Inc",
+	"PDL Electronics Ltd",
+	"Tarallax Wireless, Inc.",
+	"Empower Technologies(Canada) Inc.",
+	"Keri Systems, Inc",
+	"CityCom Corp.",
+	"Qwerty Elektronik AB",
+	"GSTeletech Co.,Ltd.",
+	"Blonder Tongue Laboratories, Inc.",
+	"RAYTALK INTERNATIONAL SRL",
+	"Sensovation AG",
+	"Rheinmetall Canada Inc.",
+	"Aevoe Inc.",
+	"AirLink Communications, Inc.",
+	"Hotway Technology Corporation",
+	"ATOMIC Corporation",
+	"Innovation Sound Technology Co., LTD.",
+	"ATTO CORPORATION",
+	"Consultronics Europe Ltd",
+	"Grundfos Holding",
+	"Telefon-Gradnja d.o.o.",
+	"SuperVision Solutions LLC",
+	"BOAZ Inc.",
+	"Inventec Multimedia & Telecom Corporation",
+	"Sichuan Changhong Electric Ltd.",
+	"Taiwan Thick-Film Ind. Corp.",
+	"Hifn, Inc.",
+	"General Meters Corp.",
+	"Intelligent Systems",
+	"SRISA",
+	"Heim Systems GmbH",
+	"CALCULEX,INC.",
+	"ADVANTECH TECHNOLOGIES CO.,LTD",
+	"Symwave",
+	"Coder Electronics Corporation",
+	"Edge Products",
+	"T-VIPS AS",
+	"HS Automatic ApS",
+	"Moram Co., Ltd.",
+	"Neratec Solutions AG",
+	"SeekerNet Inc.",
+	"Intronics B.V.",
+	"WJ Communications, Inc.",
+	"ADITEC CO. LTD",
+	"Kyocera Wireless Corp.",
+	"CORONA CORPORATION",
+	"Digiwell Technology, inc",
+	"IDCS N.V.",
+	"Cryptosoft",
+	"Novo Nordisk A/S",
+	"Kleinhenz Elektronik GmbH",
+	"ArrowSpan Inc.",
+	"CelPlan International, Inc.",
+	"Anagran, Inc.",
+	"Netgear Inc.",
+	"RF Technologies",
+	"H. Stoll GmbH & Co. KG",
+	"Kohler Co",
+	"Prokom Software SA",
+	"Eastern Asia Technology Limited",
+	"China Broadband Wireless IP Standard Group",
+	"Bookham Inc",
+	"K40 Electronics",
+	"Wiline Networks, Inc.",
+	"MultiCom Industries Limited",
+	"Nertec  Inc.",
+	"ShenZhen TP-LINK Technologies Co., Ltd.",
+	"NEC Magnus Communications,Ltd.",
+	"Eubus GmbH",
+	"Iteris, Inc.",
+	"Aeon Digital International",
+	"InnerWireless",
+	"Hitachi-LG Data Storage Korea, Inc",
+	"Multilink Inc",
+	"eXS Inc.",
+	"Cermate Technologies Inc.",
+	"Giga-Byte",
+	"Echo Digital Audio Corporation",
+	"American Technology Integrators",
+	"Akorri",
+	"B15402100 - JANDEI, S.L.",
+	"Elin Ebg Traction Gmbh",
+	"Globo Electronic GmbH & Co. KG",
+	"Fortress Technologies",
+	"Cubic Defense Simulation Systems",
+	"Tele Power Inc.",
+	"Protronic (Far East) Ltd.",
+	"ASP Corporation",
+	"Daniels Electronics Ltd. dbo Codan Rado Communications",
+	"Liteon, Mobile Media Solution SBU",
+	"Systimax Solutions",
+	"ESU AG",
+	"2Wire, Inc.",
+	"Phonic Corp.",
+	"ZHIYUAN Eletronics co.,ltd.",
+	"Viking Design Technology",
+	"Helicomm Inc",
+	"Nokota Communications, LLC",
+	"HF Company",
+	"Sound ID Inc.",
+	"UbONE Co., Ltd",
+	"System and Chips, Inc.",
+	"Accsense, Inc.",
+	"Synchronous Communication Corp",
+	"Core Micro Systems Inc.",
+	"Vitelec BV",
+	"Hon Hai Precision Ind. Co., Ltd.",
+	"Teranetics, Inc.",
+	"Ashly Audio, Inc.",
+	"Senhai Electronic Technology Co., Ltd.",
+	"Bountiful WiFi",
+	"Gassner Wiege- und Meßtechnik GmbH",
+	"Wizlogics Co., Ltd.",
+	"Datasym POS Inc.",
+	"Naeil Community",
+	"Axell Wireless Limited",
+	"mCubelogics Corporation",
+	"CoreStar International Corp",
+	"General Dynamics United Kingdom Ltd",
+	"PHYSIOMETRIX,INC",
+	"Enswer Technology Inc.",
+	"AR Infotek Inc.",
+	"Hill-Rom",
+	"MSTAR SEMICONDUCTOR",
+	"Carvers SA de CV",
+	"Open Interface North America",
+	"SYNECTIC TELECOM EXPORTS PVT. LTD.",
+	"incNETWORKS, Inc",
+	"Wink communication technology CO.LTD",
+	"Cisco-Linksys LLC",
+	"Symstream Technology Group Ltd",
+	"U.S. Robotics Corporation",
+	"Vitelcom Mobile Technology",
+	"Alive Technologies Pty Ltd",
+	"Quixant Ltd",
+	"Contemporary Research Corp",
+	"Key Radio Systems Limited",
+	"LifeSync Corporation",
+	"Zetec, Inc.",
+	"DigitalZone Co., Ltd.",
+	"NF CORPORATION",
+	"INVISIO Communications",
+	"BTI Systems Inc.",
+	"TRENDnet",
+	"Kyuden Technosystems Corporation",
+	"SEPSA",
+	"K Technology Corporation",
+	"Datang Telecom Technology CO. , LCD,Optical Communication Br",
+	"Jeongmin Electronics Co.,Ltd.",
+	"Datastore Technology Corp",
+	"bio-logic SA",
+	"IP Fabrics, Inc.",
+	"Huntleigh Healthcare",
+	"Elma Trenew Electronic GmbH",
+	"Communication System Design & Manufacturing (CSDM)",
+	"Covergence Inc.",
+	"Sage Instruments Inc.",
+	"HI-P Tech Corporation",
+	"LET'S Corporation",
+	"Data Display AG",
+	"datacom systems inc.",
+	"mm-lab GmbH",
+	"infinias, LLC",
+	"Alticast",
+	"AIM Infrarotmodule GmbH",
+	"Stolinx,. Inc",
+	"Nortech International",
+	"S Digm Inc. (Safe Paradigm Inc.)",
+	"AwarePoint Corporation",
+	"Acro Telecom",
+	"Airak, Inc.",
+	"Western Digital Technologies, Inc.",
+	"TZero Technologies, Inc.",
+	"Business Security OL AB",
+	"ViXS Systems Inc",
+	"DekTec Digital Video B.V.",
+	"OSI Security Devices",
+	"CREVIS Co., LTD",
+	"Vantage Controls",
+	"AsGa S.A.",
+	"Technical Solutions Inc.",
+	"Extandon, Inc.",
+	"Thecus Technology Corp.",
+	"Artech Electronics",
+	"Precise Automation, Inc.",
+	"LexBox",
+	"BETA tech",
+	"PROFIcomms s.r.o.",
+	"GAME PLUS CO., LTD.",
+	"Neo Photonics",
+	"Renaissance Learning Inc",
+	"Global Target Enterprise Inc",
+	"Plus Technology Co., Ltd",
+	"Sonoa Systems, Inc",
+	"SAGE INFOTECH LTD.",
+	"Hoana Medical, Inc.",
+	"OPENBRAIN TECHNOLOGIES CO., LTD.",
+	"mingjong",
+	"Techsphere Co., Ltd",
+	"Data Center Systems",
+	"Zurich University of Applied Sciences",
+	"EFS sas",
+	"Hu Zhou NAVA Networks&Electronics Ltd.",
+	"Leipold+Co.GmbH",
+	"URIEL SYSTEMS INC.",
+	"Shenzhen 10MOONS Technology Development CO.,Ltd",
+	"StoreAge Networking Technologies",
+	"Hunter Engineering Company",
+	"Isilon Systems Inc.",
+	"LENECO",
+	"M2I CORPORATION",
+	"Ethernet Powerlink Standardization Group (EPSG)",
+	"Multivision Intelligent Surveillance (Hong Kong) Ltd",
+	"Radiocrafts AS",
+	"Horoquartz",
+	"Dea Security",
+	"Meteor Communications Corporation",
+	"Numatics, Inc.",
+	"Chamberlain Access Solutions",
+	"Remote Technologies Inc",
+	"Balboa Instruments",
+	"Beacon Medical Products LLC d.b.a. BeaconMedaes",
+	"N3 Corporation",
+	"Nokia GmbH",
+	"TenX Networks, LLC",
+	"PacketHop, Inc.",
+	"KOCOM",
+	"Consumer Technologies Group, LLC",
+	"NADAM.CO.,LTD",
+	"A Beltrónica-Companhia de Comunicações, Lda",
+	"OTE Spa",
+	"Powertech co.,Ltd",
+	"Ventus Networks",
+	"RFID, Inc.",
+	"Technodrive SRL",
+	"Shenzhen Syscan Technology Co.,Ltd.",
+	"EMH metering GmbH & Co. KG",
+	"Kprotech Co., Ltd.",
+	"ELIM PRODUCT CO.",
+	"Q-Matic Sweden AB",
+	"Alcatel Alenia Space Italia",
+	"StrataLight Communications, Inc.",
+	"MICROHARD S.R.L.",
+	"Aberdeen Test Center",
+	"coM.s.a.t. AG",
+	"SEECODE Co., Ltd.",
+	"ITG Worldwide Sdn Bhd",
+	"AiZen Solutions Inc.",
+	"CUBE TECHNOLOGIES",
+	"Dixtal Biomedica Ind. Com. Ltda",
+	"WANSHIH ELECTRONIC CO., LTD",
+	"Wonde Proud Technology Co., Ltd",
+	"Saunders Electronics",
+	"Netronome Systems, Inc.",
+	"IEC",
+	"one RF Technology",
+	"Nits Technology Inc",
+	"RadioPulse Inc.",
+	"Wi-Gear Inc.",
+	"Cytyc Corporation",
+	"Atalum Wireless S.A.",
+	"DFM GmbH",
+	"SAGEM COMMUNICATION",
+	"Olivetti",
+	"Securaplane Technologies, Inc.",
+	"DAINIPPON PHARMACEUTICAL CO., LTD.",
+	"Sampo Corporation",
+	"Dresser Wayne",
+	"Morgan Stanley",
+	"GreenPeak Technologies",
+	"JJPlus Corporation",
+	"BEHRINGER Spezielle Studiotechnik GmbH",
+	"XIAMEN YEALINK NETWORK TECHNOLOGY CO.,LTD",
+	"A-First Technology Co., Ltd.",
+	"RADWIN Inc.",
+	"Dilithium Networks",
+	"PECO II, Inc.",
+	"DG2L Technologies Pvt. Ltd.",
+	"Perfisans Networks Corp.",
+	"SANE SYSTEM CO., LTD",
+	"Ubiquiti Networks Inc.",
+	"A. W. Communication Systems Ltd",
+	"Xiranet Communications GmbH",
+	"Nolan Systems",
+	"Red-Lemon",
+	"NewSoft  Technology Corporation",
+	"Horizon Semiconductors Ltd.",
+	"Nevis Networks Inc.",
+	"LABiTec - Labor Biomedical Technologies GmbH",
+	"Audio / Video Innovations",
+	"Lunatone Industrielle Elektronik GmbH",
+	"Telefin S.p.A.",
+	"Leuze electronic GmbH + Co. KG",
+	"Dave Networks, Inc.",
+	"POSDATA CO., LTD.",
+	"Weidmüller Interface GmbH & Co. KG",
+	"ChuanG International Holding CO.,LTD.",
+	"U-WAY CORPORATION",
+	"MAKUS Inc.",
+	"Pulse Eight Limited",
+	"IVT corporation",
+	"Schenck Process GmbH",
+	"Aonvision Technolopy Corp.",
+	"Xiamen Overseas Chinese Electronic Co., Ltd.",
+	"Takenaka Seisakusho Co.,Ltd",
+	"Salutica Allied Solutions Sdn Bhd",
+	"D-MAX Technology Co.,Ltd",
+	"SURECOM Technology Corp.",
+	"Park Air Systems Ltd",
+	"Liab ApS",
+	"Jennic Ltd",
+	"Plustek.INC",
+	"NTT Advanced Technology Corporation",
+	"Hectronic GmbH",
+	"RLW Inc.",
+	"Facom UK Ltd (Melksham)",
+	"U4EA Technologies Inc.",
+	"BIXOLON CO.,LTD",
+	"Quester Tangent Corporation",
+	"AETA AUDIO SYSTEMS",
+	"Kolektor group",
+	"Samsung Electronics Co., LTD",
+	"B-KYUNG SYSTEM Co.,Ltd.",
+	"Mad Catz Interactive Inc",
+	"Terascala, Inc.",
+	"ECA-SINTERS",
+	"DCI Co., Ltd.",
+	"Digital Electronics Products Ltd.",
+	"Robatech AG",
+	"KWANG WOO I&C CO.,LTD",
+	"Rextechnik International Co.,",
+	"PRO CO SOUND INC",
+	"Capelon AB",
+	"Accedian Networks",
+	"kyung il",
+	"AzureWave Technologies, Inc.",
+	"AUTOTELENET CO.,LTD",
+	"Ambient Corporation",
+	"Advanced Industrial Computer, Inc.",
+	"Caretech AB",
+	"Polymap  Wireless LLC",
+	"CI Network Corp.",
+	"ShinMaywa Industries, Ltd.",
+	"Tahoe",
+	"iba AG",
+	"SMA Solar Technology AG",
+	"Develco",
+	"Group 4 Technology Ltd",
+	"Iqua Ltd.",
+	"technicob",
+	"DIGITAL TELEMEDIA CO.,LTD.",
+	"3M Germany",
+	"Ruf Telematik AG",
+	"FLOVEL CO., LTD.",
+	"FlexiPanel Ltd",
+	"Gumstix, Inc",
+	"TeraRecon, Inc.",
+	"Surf Communication Solutions Ltd.",
+	"UQUEST, LTD.",
+	"Exartech International Corp.",
+	"Xantech Corporation",
+	"Pantech&Curitel Communications, Inc.",
+	"Emitor AB",
+	"NICEVT",
+	"OSLiNK Sp. z o.o.",
+	"Reti Corporation",
+	"Interlink Electronics",
+	"PKC Electronics Oy",
+	"IRITEL A.D.",
+	"Canesta Inc.",
+	"KT&C Co., Ltd.",
+	"IP Control Systems Ltd.",
+	"Clivet S.p.A.",
+	"Picochip Ltd",
+	"Dr.Ing. Herbert Knauer GmbH",
+	"Dream Technologies Corporation",
+	"Zimmer Elektromedizin",
+	"Cheertek Inc.",
+	"MOBILE TECHNIKA Inc.",
+	"Quantec Tontechnik",
+	"Tellumat (Pty) Ltd",
+	"ZTE CORPORATION",
+	"Boca Devices LLC",
+	"Fulcrum Microsystems, Inc.",
+	"Omnex Control Systems",
+	"NEC TOKIN Corporation",
+	"EGO BV",
+	"KYLINK Communications Corp.",
+	"PELTOR AB",
+	"Eventide",
+	"Sustainable Energy Systems",
+	"SCIENCE AND ENGINEERING SERVICES, INC.",
+	"Wintecronics Ltd.",
+	"Kingtronics Industrial Co. Ltd.",
+	"setex schermuly textile computer gmbh",
+	"Littelfuse Startco",
+	"Complete Media Systems",
+	"SCHILLING ROBOTICS LLC",
+	"Novatel Wireless, Inc.",
+	"CelleBrite Mobile Synchronization",
+	"CEYON TECHNOLOGY CO.,LTD.",
+	"COOLKSKY Co., LTD",
+	"Sigpro",
+	"YORKVILLE SOUND INC.",
+	"Ideal Industries",
+	"Curves International Inc.",
+	"Sequans Communications",
+	"Unitech electronics co., ltd.",
+	"SWEEX Europe BV",
+	"TVWorks LLC",
+	"LPL  DEVELOPMENT S.A. DE C.V",
+	"Be Here Corporation",
+	"Optica Technologies Inc.",
+	"BADGER METER INC",
+	"Carina Technology",
+	"Altecon Srl",
+	"Otsuka Electronics Co., Ltd.",
+	"LibreStream Technologies Inc.",
+	"Picosecond Pulse Labs",
+	"Nittan Company, Limited",
+	"BROWAN COMMUNICATION INC.",
+	"MSI",
+	"HIVION Co., Ltd.",
+	"Lancelan Technologies S.L.",
+	"Dametric AB",
+	"Micronet Corporation",
+	"e:cue",
+	"Innovative Wireless Technologies, Inc.",
+	"Woojinnet",
+	"SUNWAVETEC Co., Ltd.",
+	"Colorado Vnet",
+	"BBH SYSTEMS GMBH",
+	"Interval Media",
+	"Teneros, Inc.",
+	"Impinj, Inc.",
+	"embedded-logic DESIGN AND MORE GmbH",
+	"Ultra Electronics Manufacturing and Card Systems",
+	"Nivus GmbH",
+	"Antik computers & communications s.r.o.",
+	"Togami Electric Mfg.co.,Ltd.",
+	"Xanboo",
+	"STNet Co., Ltd.",
+	"Space Shuttle Hi-Tech Co., Ltd.",
+	"Geutebrück GmbH",
+	"Vativ Technologies",
+	"Xteam",
+	"Oxford Diagnostics Ltd.",
+	"Mathtech, Inc.",
+	"Quanta Computer Inc.",
+	"CITEL SpA",
+	"UBIQUAM Co.,Ltd",
+	"YVES TECHNOLOGY CO., LTD.",
+	"VertexRSI/General Dynamics",
+	"Rebox B.V.",
+	"Tsinghua Tongfang Legend Silicon Tech. Co., Ltd.",
+	"Xensource, Inc.",
+	"CReTE SYSTEMS Inc.",
+	"Asmobile Communication Inc.",
+	"Pangolin",
+	"Sunhillo Corporation",
+	"LITE-ON Technology Corp.",
+	"Power Distribution, Inc.",
+	"SSD Company Limited",
+	"SetOne GmbH",
+	"Vibration Technology Limited",
+	"Quorion Data Systems GmbH",
+	"PLANET INT Co., Ltd",
+	"Alcatel North America IP Division",
+	"World Ethnic Broadcastin Inc.",
+	"Herley General Microwave Israel.",
+	"Exeo Systems",
+	"Hoatech Technologies, Inc.",
+	"LEGO System A/S IE Electronics Division",
+	"Flex-P Industries Sdn. Bhd.",
+	"FUHO TECHNOLOGY Co., LTD",
+	"Aegate Ltd",
+	"Fusiontech Technologies Inc.",
+	"Z.M.P. RADWAG",
+	"Harman Specialty Group",
+	"Grip Audio",
+	"Trackflow Ltd",
+	"AirDefense, Inc.",
+	"Precision I/O",
+	"Fairmount Automation",
+	"Novatium Solutions (P) Ltd",
+	"Liyuh Technology Ltd.",
+	"KBT Mobile",
+	"Prod-El SpA",
+	"Cellon France",
+	"Quantier Communication Inc.",
+	"A-TEC Subsystem INC.",
+	"Eishin Electronics",
+	"MRV Communication (Networks) LTD",
+	"TPS",
+	"Samsung Electonics Digital Video System Division",
+	"Yulong Computer Telecommunication Scientific(shenzhen)Co.,Lt",
+	"Arbitron Inc.",
+	"SKNET Corporation",
+	"Symphox Information Co.",
+	"Zenway enterprise ltd",
+	"Bury GmbH & Co. KG",
+	"EuroCB (Phils.), Inc.",
+	"Bihl + Wiedemann GmbH",
+	"SHENZHEN BAOAN GAOKE ELECTRONICS CO., LTD",
+	"eOn Communications",
+	"Skyworth Overseas Dvelopment Ltd.",
+	"Haver&Boecker",
+	"iRex Technologies BV",
+	"Sky-Line Information Co., Ltd.",
+	"DIBOSS.CO.,LTD",
+	"Bluebird Soft Inc.",
+	"Bally Gaming + Systems",
+	"Vector Informatik GmbH",
+	"Pro Dex, Inc",
+	"WEBIO International Co.,.Ltd.",
+	"Donjin Co.,Ltd.",
+	"Elisa Oyj",
+	"Karl Storz Imaging",
+	"Chubb CSC-Vendor AP",
+	"ServerEngines LLC",
+	"Pilkor Electronics Co., Ltd",
+	"id-Confirm Inc",
+	"Paralan Corporation",
+	"DSL Partner AS",
+	"KORWIN CO., Ltd.",
+	"Vimicro corporation",
+	"J-TEK INCORPORATION",
+	"Moser-Baer AG",
+	"Scientific-Atlanta, Inc.",
+	"PowerLink Technology Inc.",
+	"Sennheiser Communications A/S",
+	"AVC Technology (International) Limited",
+	"QDI Technology (H.K.) Limited",
+	"NEC Corporation",
+	"T&A Mobile Phones",
+	"Tonic DVB Marketing Ltd",
+	"Quadrics Ltd",
+	"Alstom Transport",
+	"TV One Ltd",
+	"Vimtron Electronics Co., Ltd.",
+	"Auto-Maskin",
+	"3Leaf Networks",
+	"CentraLite Systems, Inc.",
+	"Ingeteam Transmission&Distribution, S.A.",
+	"Ezurio Ltd",
+	"Tandberg Storage ASA",
+	"Dovado FZ-LLC",
+	"AWETA G&P",
+	"CWT CO., LTD.",
+	"2EI",
+	"Kei Communication Technology Inc.",
+	"Dansensor A/S",
+	"Toho Technology Corp.",
+	"BT-Links Company Limited",
+	"INVENTEL",
+	"Shenzhen Union Networks Equipment Co.,Ltd.",
+	"VK Corporation",
+	"KBS",
+	"DriveCam Inc",
+	"Photonicbridges (China) Co., Ltd.",
+	"Seoul Commtech",
+	"Sony Ericsson Mobile Communications",
+	"ProCurve Networking",
+	"WEATHERNEWS INC.",
+	"Law-Chain Computer Technology Co Ltd",
+	"ATI Industrial Automation",
+	"INFRANET, Inc.",
+	"PaloDEx Group Oy",
+	"Semtech Corporation",
+	"Eleksen Ltd",
+	"Avtec Systems Inc",
+	"BA Systems Inc",
+	"SiRF Technology, Inc.",
+	"Shenzhen Xing Feng Industry Co.,Ltd",
+	"North Atlantic Industries",
+	"NAT Seattle, Inc.",
+	"Xcute Mobile Corp.",
+	"HIJI HIGH-TECH CO., LTD.",
+	"ATech elektronika d.o.o.",
+	"ZAT a.s.",
+	"Caspian",
+	"Wistron Corporation",
+	"Compal Communications, Inc.",
+	"Synccom Co., Ltd",
+	"TDA Tech Pty Ltd",
+	"Sunways AG",
+	"Senea AB",
+	"NINGBO BIRD CO.,LTD.",
+	"Futronic Technology Co. Ltd.",
+	"ARCHOS",
+	"Gigabeam Corporation",
+	"FAST Inc",
+	"Lundinova AB",
+	"SiliconStor, Inc.",
+	"American Fibertek, Inc.",
+	"VANGUARD SECURITY ENGINEERING CORP.",
+	"FORDLEY DEVELOPMENT LIMITED",
+	"GIGA-BYTE TECHNOLOGY CO.,LTD.",
+	"Dynamix Promotions Limited",
+	"Sigma Designs, Inc.",
+	"Tiba Medical Inc",
+	"Elitegroup Computer Systems Co., Ltd.",
+	"RoyalDigital Inc.",
+	"Koko Fitness, Inc.",
+	"Dell",
+	"OmniSense, LLC",
+	"Dmobile System Co., Ltd.",
+	"CAST Information Co., Ltd",
+	"Eidicom Co., Ltd.",
+	"Dalian Golden Hualu Digital Technology Co.,Ltd",
+	"Video Products Group",
+	"L-3 Communications, Aviation Recorders",
+	"AVIQTECH TECHNOLOGY CO., LTD.",
+	"CETRTA POT, d.o.o., Kranj",
+	"ECI Telecom Ltd.",
+	"SHENZHEN MTC CO.,LTD.",
+	"TOHKEN CO.,LTD.",
+	"Jaty Electronics",
+	"Alps Electric Co., Ltd",
+	"Wamin Optocomm Mfg Corp",
+	"KDE, Inc.",
+	"Osung Midicom Co., Ltd",
+	"MOSDAN Internation Co.,Ltd",
+	"Shinco Electronics Group Co.,Ltd",
+	"Methode Electronics",
+	"Techfaith Wireless Communication Technology Limited.",
+	"InGrid, Inc",
+	"Exalt Communications",
+	"INEW DIGITAL COMPANY",
+	"Contela, Inc.",
+	"Twig Com Ltd.",
+	"Dust Networks Inc.",
+	"Casa Systems Inc.",
+	"GE Healthcare Bio-Sciences AB",
+	"ISCO International",
+	"Tiger NetCom",
+	"BR Controls Nederland bv",
+	"Qstik",
+	"Qno Technology Inc.",
+	"Leica Geosystems AG",
+	"Vansco Electronics Oy",
+	"AudioCodes USA, Inc",
+	"Winegard Company",
+	"Innovation Lab Corp.",
+	"NT MicroSystems, Inc.",
+	"DIGIT",
+	"Theo Benning GmbH & Co. KG",
+	"IMV Corporation",
+	"Image Sensing Systems, Inc.",
+	"FITRE S.p.A.",
+	"Hanazeder Electronic GmbH",
+	"Summit Data Communications",
+	"Studer Professional Audio GmbH",
+	"Liquid Computing",
+	"m2c Electronic Technology Ltd.",
+	"Thermo Ramsey Italia s.r.l.",
+	"Selex Communications",
+	"Ubicod Co.LTD",
+	"Proware Technology Corp.(By Unifosa)",
+	"Global Technologies Inc.",
+	"TAEJIN INFOTECH",
+	"Axcen Photonics Corporation",
+	"FXC Inc.",
+	"NeuLion Incorporated",
+	"Automation Electronics",
+	"Science-Technical Center \"RISSA\"",
+	"SFR",
+	"ADC Telecommunications",
+	"iiTron Inc.",
+	"Industrie Dial Face S.p.A.",
+	"International Business Machines",
+	"Bright Headphone Electronics Company",
+	"Reach Systems Inc.",
+	"Extreme Engineering Solutions",
+	"Neology",
+	"LeucotronEquipamentos Ltda.",
+	"Bluberi Gaming Technologies Inc",
+	"DEFIDEV",
+	"Deck Srl",
+	"Araneo Ltd.",
+	"INNOTZ CO., Ltd",
+	"Freedom9 Inc.",
+	"Trimble",
+	"Neokoros Brasil Ltda",
+	"HYUNDAE YONG-O-SA CO.,LTD",
+	"SOCOMEC",
+	"Millipore",
+	"DYNAMIC NETWORK FACTORY, INC.",
+	"Parama-tech Co.,Ltd.",
+	"iCatch Inc.",
+	"GSI Group, MicroE Systems",
+	"Online Corporation",
+	"DAGS, Inc",
+	"nFore Technology Inc.",
+	"Arkino HiTOP Corporation Limited",
+	"GE Security",
+	"Vinci Labs Oy",
+	"RIX TECHNOLOGY LIMITED",
+	"ThruVision Ltd",
+	"ACS Solutions Switzerland Ltd.",
+	"SHARP CORPORATION",
+	"Dongseo system.",
+	"Zed-3",
+	"XENOLINK Communications Co., Ltd.",
+	"Naito Densei Machida MFG.CO.,LTD",
+	"Solar Technology, Inc.",
+	"Essentia S.p.A.",
+	"ATMedia GmbH",
+	"Accense Technology, Inc.",
+	"Earforce AS",
+	"Zinwave Ltd",
+	"Cymphonix Corp",
+	"Avago Technologies",
+	"Kiyon, Inc.",
+	"Pivot3, Inc.",
+	"CORE CORPORATION",
+	"DUCATI SISTEMI",
+	"PAX Computer Technology(Shenzhen) Ltd.",
+	"Arti Industrial Electronics Ltd.",
+	"APD Communications Ltd",
+	"ASTRO Strobel Kommunikationssysteme GmbH",
+	"Laketune Technologies Co. Ltd",
+	"Elesta GmbH",
+	"TTE Germany GmbH",
+	"Meso Scale Diagnostics, LLC",
+	"Obsidian Research Corporation",
+	"Central Music Co.",
+	"QuickTel",
+	"ASSA ABLOY AB",
+	"Azalea Networks inc",
+	"Smartlink Network Systems Limited",
+	"IDT International Limited",
+	"Meshcom Technologies Inc.",
+	"Worldsmart Retech",
+	"Applied Biosystems B.V.",
+	"Greystone Data System, Inc.",
+	"LoBenn Inc.",
+	"Sparr Electronics Ltd",
+	"wisembed",
+	"Brother, Brother & Sons ApS",
+	"Philips Lighting BV",
+	"Zenitron Corporation",
+	"DARTS TECHNOLOGIES CORP.",
+	"Teledyne Technologies Incorporated",
+	"Independent Witness, Inc",
+	"Checkpoint Systems, Inc.",
+	"Gunnebo Cash Automation AB",
+	"NINGBO YIDONG ELECTRONIC CO.,LTD.",
+	"HYUNDAI DIGITECH Co, Ltd.",
+	"LinTech GmbH",
+	"Falcom Wireless Comunications Gmbh",
+	"Tigi Corporation",
+	"Rittmeyer AG",
+	"Telsy Elettronica S.p.A.",
+	"Azonic Technology Co., LTD",
+	"SmarTire Systems Inc.",
+	"Chant Sincere CO., LTD.",
+	"DEPRAG SCHULZ GMBH u. CO.",
+	"Kelman Limited",
+	"Sirit Inc",
+	"Apricorn",
+	"RoboTech srl",
+	"3soft inc.",
+	"Camrivox Ltd.",
+	"MIX s.r.l.",
+	"Ralink Technology Corp",
+	"YOSIN ELECTRONICS CO., LTD.",
+	"Mobile Computing Promotion Consortium",
+	"EDM Corporation",
+	"Sentivision",
+	"elab-experience inc.",
+	"O'Neil Product Development Inc.",
+	"AceNet Corporation",
+	"GAI-Tronics",
+	"Enermet",
+	"ACIST Medical Systems, Inc.",
+	"SK Telesys",
+	"Aftek Infosys Limited",
+	"Remote Security Systems, LLC",
+	"Peerless Systems Corporation",
+	"Aquantia",
+	"Tonze Technology Co.",
+	"NOVATRON CO., LTD.",
+	"Gambro Lundia AB",
+	"SEDO CO., LTD.",
+	"Syrinx Industrial Electronics",
+	"Touchtunes Music Corporation",
+	"Tibetsystem",
+	"Tratec Telecom B.V.",
+	"Coherent Research Limited",
+	"PureTech Systems, Inc.",
+	"CM Precision Technology LTD.",
+	"KTF Technologies Inc.",
+	"Quanta Microsystems, INC.",
+	"SonicWALL",
+	"Cross Match Technologies Inc",
+	"MARA Systems Consulting AB",
+	"KYOCERA Document Solutions Inc.",
+	"Juniper Networks",
+	"Alcatel-Lucent",
+	"CEC Wireless R&D Ltd.",
+	"Screen Service Spa",
+	"iMCA-GmbH",
+	"Opticom Communications, LLC",
+	"THINLINX PTY LTD",
+	"Etymotic Research, Inc.",
+	"Monsoon Multimedia, Inc",
+	"Bluechips Microhouse Co.,Ltd.",
+	"ION Geophysical Corporation Inc.",
+	"Magnum Semiconductor, Inc.",
+	"AAI Corporation",
+	"Spans Logic",
+	"CANKO TECHNOLOGIES INC.",
+	"DAEMYUNG ZERO1",
+	"Clipsal Australia",
+	"Advantage Six Ltd",
+	"DACOS Technologies Co., Ltd.",
+	"WooJooIT Ltd.",
+	"SZCOM Broadband Network Technology Co.,Ltd",
+	"Renu Electronics Pvt Ltd",
+	"Harris Corparation",
+	"ZERON ALLIANCE",
+	"LIG NEOPTEK",
+	"Pyramid Meriden Inc.",
+	"CEM Solutions Pvt Ltd",
+	"Motech Industries Inc.",
+	"Forcom Sp. z o.o.",
+	"FA",
+	"Suprema Inc.",
+	"Amulet Hotkey",
+	"TALOS SYSTEM INC.",
+	"PLAYLINE Co.,Ltd.",
+	"UNIGRAND LTD",
+	"ArcSoft Shanghai Co. LTD",
+	"E-TEK DIGITAL TECHNOLOGY LIMITED",
+	"Beijing InHand Networking Technology Co.,Ltd.",
+	"Hokkei Industries Co., Ltd.",
+	"Fanstel Corp.",
+	"SightLogix, Inc.",
+	"CRESYN",
+	"Meraki, Inc.",
+	"Brilliant Telecommunications",
+	"Optelian Access Networks",
+	"Terabytes Server Storage Tech Corp",
+	"Avega Systems",
+	"IPTrade S.A.",
+	"Neuros Technology International, LLC.",
+	"Beijing Xinwei Telecom Technology Co., Ltd.",
+	"Mitutoyo Corporation",
+	"GZ Technologies, Inc.",
+	"Ubixon Co., Ltd.",
+	"D. E. Shaw Research, LLC",
+	"AVerMedia Information Inc.",
+	"TaiJin Metal Co., Ltd.",
+	"Exterity Limited",
+	"ASIA ELECTRONICS CO.,LTD",
+	"GDX Technologies Ltd.",
+	"Palmmicro Communications",
+	"w5networks",
+	"SINDORICOH",
+	"CEC TELECOM CO.,LTD.",
+	"Kimaldi Electronics, S.L.",
+	"Cale Access AB",
+	"NEC UNIFIED SOLUTIONS NEDERLAND B.V.",
+	"e2v technologies (UK) ltd.",
+	"Gatsometer",
+	"Taiwan Video & Monitor",
+	"Softier",
+	"Ascend Networks, Inc.",
+	"Artec Design",
+	"XStreamHD, LLC",
+	"Thoratec / ITC",
+	"Reliance Electric Limited",
+	"Universal ABIT Co., Ltd.",
+	"PanAccess Communications,Inc.",
+	"CENITS Co., Ltd.",
+	"Encore Software Limited",
+	"Vertex Link Corporation",
+	"Digilent, Inc",
+	"3 Phoenix, Inc.",
+	"High Tech Computer Corp",
+	"Dawevision Ltd",
+	"Heads Up Technologies, Inc.",
+	"Pulsar-Telecom LLC.",
+	"Crypto S.A.",
+	"AceNet Technology Inc.",
+	"Vecima Networks Inc.",
+	"Pigeon Point Systems LLC",
+	"Catcher, Inc.",
+	"Las Vegas Gaming, Inc.",
+	"Bogen Communications",
+	"Lianhe Technologies, Inc.",
+	"8 Ways Technology Corp.",
+	"Secfone Kft",
+	"SWsoft",
+	"StorLink Semiconductors, Inc.",
+	"Atera Networks LTD.",
+	"Argard Co., Ltd",
+	"Aeromaritime Systembau GmbH",
+	"EyeFi, Inc",
+	"Unilever R&D",
+	"TagMaster AB",
+	"Strawberry Linux Co.,Ltd.",
+	"uControl, Inc.",
+	"Network Chemistry, Inc",
+	"EDS Lab Pte Ltd",
+	"TAIGUEN TECHNOLOGY (SHEN-ZHEN) CO., LTD.",
+	"Nexterm Inc.",
+	"TAC Inc.",
+	"SIM Technology Group Shanghai Simcom Ltd.,",
+	"Ooma, Inc.",
+	"Veritech Electronics Limited",
+	"Siemens Healthcare Diagnostics Manufacturing Ltd",
+	"Leutron Vision",
+	"Datalogic ADC",
+	"Scientific Atlanta, A Cisco Company",
+	"KINGJIM",
+	"Global Link Digital Technology Co,.LTD",
+	"Sambu Communics CO., LTD.",
+	"Neonode AB",
+	"Zhenjiang Sapphire Electronic Industry CO.",
+	"Setha Industria Eletronica LTDA",
+	"E28 Shanghai Limited",
+	"Expertise Engineering",
+	"AnaCise Testnology Pte Ltd",
+	"WowWee Ltd.",
+	"Amplex A/S",
+	"Mackware GmbH",
+	"dSys",
+	"Wiremold",
+	"4NSYS Co. Ltd.",
+	"INTERCROSS, LLC",
+	"Armorlink shanghai Co. Ltd",
+	"RGB Spectrum",
+	"ZODIANET",
+	"Maxim Integrated Products",
+	"Buyang Electronics Industrial Co., Ltd",
+	"Huawei Technologies Co., Ltd.",
+	"FORMOSA21 INC.",
+	"Fon Technology S.L.",
+	"Avigilon Corporation",
+	"EL-TECH, INC.",
+	"Metasystem SpA",
+	"GOTIVE a.s.",
+	"WinNet Solutions Limited",
+	"Infinova LLC",
+	"Mobile Action Technology Inc.",
+	"Ekahau, Inc.",
+	"Montgomery Technology, Inc.",
+	"RadioCOM, s.r.o.",
+	"Zhongshan General K-mate Electronics Co., Ltd",
+	"ads-tec GmbH",
+	"SHENZHEN PHOTON BROADBAND TECHNOLOGY CO.,LTD",
+	"NPCore, Inc.",
+	"Hansun Technologies Inc.",
+	"Great Well Electronic LTD",
+	"JESS-LINK PRODUCTS Co., LTD",
+	"KINGSTATE ELECTRONICS CORPORATION",
+	"ShenZhen jieshun Science&Technology Industry CO,LTD.",
+	"HANA Micron Inc.",
+	"Weldex Corporation",
+	"Navcast Inc.",
+	"OMNIKEY GmbH.",
+	"Lenntek Corporation",
+	"Cierma Ascenseurs",
+	"Tiqit Computers, Inc.",
+	"XIP Technology AB",
+	"ZIPPY TECHNOLOGY CORP.",
+	"ADigit Technologies Corp.",
+	"Persistent Systems, LLC",
+	"Yoggie Security Systems LTD.",
+	"AnNeal Technology Inc.",
+	"Ethernet Direct Corporation",
+	"Protec Fire Detection plc",
+	"BEIJING LHWT MICROELECTRONICS INC.",
+	"Shanghai Jiao Da HISYS Technology Co. Ltd.",
+	"NIDEC SANKYO CORPORATION",
+	"TVT CO.,LTD",
+	"ADEUNIS RF",
+	"TEC WizHome Co., Ltd.",
+	"Dawon Media Inc.",
+	"Magna Carta",
+	"S3C, Inc.",
+	"D3 LED, LLC",
+	"New Voice International AG",
+	"Eliwell Controls srl",
+	"ZAO NVP Bolid",
+	"SHENZHEN DVBWORLD TECHNOLOGY CO., LTD.",
+	"ANSA Corporation",
+	"Essence Technology Solution, Inc.",
+	"Almitec Informática e Comércio",
+	"Firetide, Inc",
+	"CS Corporation",
+	"Raba Technologies LLC",
+	"OPW Fuel Management Systems",
+	"Real Time Automation",
+	"ISONAS Inc.",
+	"EOps Technology Limited",
+	"Viprinet GmbH",
+	"Tecobest Technology Limited",
+	"AXIOHM SAS",
+	"Erae Electronics Industry Co., Ltd",
+	"Dreamtech Co., Ltd",
+	"Baldor Electric Company",
+	"AtRoad,  A Trimble Company",
+	"Siemens Home & Office Comm. Devices",
+	"High-Gain Antennas LLC",
+	"TEAMCAST",
+	"Unified Display Interface SIG",
+	"REIGNCOM",
+	"Swirlnet A/S",
+	"Javad Navigation Systems Inc.",
+	"ARCH METER Corporation",
+	"Santosha Internatonal, Inc",
+	"AMBER wireless GmbH",
+	"EPL Technology Ltd",
+	"Prostar Co., Ltd.",
+	"Silicondust Engineering Ltd",
+	"The Morey Corporation",
+	"ANAVEO",
+	"Verkerk Service Systemen",
+	"Topdata Sistemas de Automacao Ltda",
+	"Visualgate Systems, Inc.",
+	"YIGUANG",
+	"Adhoco AG",
+	"Computer Hardware Design SIA",
+	"Cameo Communications, INC.",
+	"Hacetron Corporation",
+	"Numata Corporation",
+	"Alltec GmbH",
+	"Blue Zen Enterprises Private Limited",
+	"Welding Technology Corporation",
+	"Accutech Ultrasystems Co., Ltd.",
+	"Videology Imaging Solutions, Inc.",
+	"Escape Communications, Inc.",
+	"JOYTOTO Co., Ltd.",
+	"Chunichi Denshi Co.,LTD.",
+	"Beijing Tianyu Communication Equipment Co., Ltd",
+	"EO TECHNICS Co., Ltd.",
+	"Shenzhen Streaming Video Technology Company Limited",
+	"Kameleon Technologies",
+	"VVOND, Inc.",
+	"Yushin Precision Equipment Co.,Ltd.",
+	"Compro Technology",
+	"Altec Electronic AG",
+	"Optimal Technologies International Inc.",
+	"PowerQuattro Co.",
+	"Intelliverese - DBA Voicecom",
+	"F1MEDIA",
+	"Cambridge Consultants Ltd",
+	"Bigfoot Networks Inc",
+	"WB Electronics Sp. z o.o.",
+	"SCHRACK Seconet AG",
+	"Duaxes Corporation",
+	"DEVI - Danfoss A/S",
+	"HASWARE INC.",
+	"Southern Vision Systems, Inc.",
+	"Encore Electronics, Inc.",
+	"IEEE 1394c",
+	"Atech Technology Co., Ltd.",
+	"Advansus Corp.",
+	"Knick Elektronische Messgeraete GmbH & Co. KG",
+	"Just In Mobile Information Technologies (Shanghai) Co., Ltd.",
+	"Welcat Inc",
+	"Chuang-Yi Network Equipment Co.Ltd.",
+	"Winix Co., Ltd",
+	"PayTec AG",
+	"Posiflex Inc.",
+	"Interactive Wear AG",
+	"ASTEL Inc.",
+	"IRLINK",
+	"Sputnik Engineering AG",
+	"Sensicast Systems",
+	"Beyondwiz Co., Ltd.",
+	"Microlink communications Inc.",
+	"KUME electric Co.,Ltd.",
+	"Elitegroup Computer System Co.",
+	"CM Comandos Lineares",
+	"Phonex Korea Co., LTD.",
+	"LBNL  Engineering",
+	"Intelicis Corporation",
+	"BitsGen Co., Ltd.",
+	"ImCoSys Ltd",
+	"Siemens AG, Transportation Systems",
+	"2M2B Montadora de Maquinas Bahia Brasil LTDA",
+	"Antiope Associates",
+	"Aclara RF Systems Inc.",
+	"Nokia Corporation",
+	"Spectral Instruments, Inc.",
+	"Balluff GmbH",
+	"Gude Analog- und Digialsysteme GmbH",
+	"Strix Systems, Inc.",
+	"TRENDON TOUCH TECHNOLOGY CORP.",
+	"DUERR DENTAL AG",
+	"STERLITE OPTICAL TECHNOLOGIES LIMITED",
+	"CommerceGuard AB",
+	"UMB Communications Co., Ltd.",
+	"Gigamips",
+	"OESOLUTIONS",
+	"Wilibox Deliberant Group LLC",
+	"HighPoint Technologies Incorporated",
+	"GMC Guardian Mobility Corp.",
+	"RDI technology(Shenzhen) Co.,LTD",
+	"Rackable Systems",
+	"Pitney Bowes, Inc",
+	"ON SOFTWARE INTERNATIONAL LIMITED",
+	"Belden",
+	"Fossil Partners, L.P.",
+	"RF COncepts, LLC",
+	"Cianet Industria e Comercio S/A",
+	"AireSpider Networks",
+	"TENTEL  COMTECH CO., LTD.",
+	"TESTO AG",
+	"Fujian Stelcom information & Technology CO.,Ltd",
+	"Avago Technologies Sdn Bhd",
+	"Ultra Electronics - TCS (Tactical Communication Systems)",
+	"Harman Multimedia",
+	"NETCONS, s.r.o.",
+	"ACOGITO Co., Ltd",
+	"Chainleader Communications Corp.",
+	"Leaf Corporation.",
+	"Saafnet Canada Inc.",
+	"Bluetooth SIG, Inc.",
+	"Staccato Communications Inc.",
+	"Jenaer Antriebstechnik GmbH",
+	"Innotech Corporation",
+	"ShenZhen XinHuaTong Opto Electronics Co.,Ltd",
+	"Valemount Networks Corporation",
+	"DoCoMo Systems, Inc.",
+	"Blaupunkt  Embedded Systems GmbH",
+	"Commerciant, LP",
+	"Doorking Inc.",
+	"YuHua TelTech (ShangHai) Co., Ltd.",
+	"Asiarock Technology Limited",
+	"TELDAT Sp.J.",
+	"Digital Video Networks(Shanghai) CO. LTD.",
+	"MikroM GmbH",
+	"Danpex Corporation",
+	"ETROVISION TECHNOLOGY",
+	"Raybit Systems Korea, Inc",
+	"Metacom (Pty) Ltd.",
+	"SensoPart GmbH",
+	"Z-Com, Inc.",
+	"Guangzhou Unicomp Technology Co.,Ltd",
+	"Plexus (Xiamen) Co.,ltd",
+	"Zeugma Systems",
+	"AboCom Systems, Inc.",
+	"Beijing Huisen networks technology Inc",
+	"Xipher Technologies, LLC",
+	"Aerohive Networks, Inc.",
+	"Datum Systems, Inc.",
+	"MAZeT GmbH",
+	"Picotest Corp.",
+	"Riedel Communications GmbH",
+	"Hon Hai Precision Ind. Co., Ltd",
+	"PLANTRONICS, INC.",
+	"Gridpoint Systems",
+	"Vivox Inc",
+	"SmarDTV",
+	"CCT R&D Limited",
+	"ESTIC Corporation",
+	"IT Watchdogs, Inc",
+	"Cheng Hongjian",
+	"Panasonic Mobile Communications Co., Ltd.",
+	"Wi2Wi, Inc",
+	"Sonitrol Corporation",
+	"Northrop Grumman Systems Corp.",
+	"Novera Optics Korea, Inc.",
+	"iXSea",
+	"Ocean Optics, Inc.",
+	"Oticon A/S",
+	"Alcatel Bell N.V.",
+	"ELM DATA Co., Ltd.",
+	"avinfo",
+	"ADTRAN INC.",
+	"Changshu Switchgear MFG. Co.,Ltd. (Former Changshu Switchgea",
+	"Jorjin Technologies Inc.",
+	"Jurong Hi-Tech (Suzhou)Co.ltd",
+	"TurboChef Technologies Inc.",
+	"Soft Device Sdn Bhd",
+	"SATO CORPORATION",
+	"Fujitsu Technology Solutions",
+	"EDO-EVI",
+	"Diversified Technical Systems, Inc.",
+	"CTRING",
+	"VIZIO, Inc.",
+	"Nifty",
+	"DKT A/S",
+	"NIHON DATA SYSTENS, INC.",
+	"ORDYN TECHNOLOGIES",
+	"asteel electronique atlantique",
+	"Austar Technology (hang zhou) Co.,Ltd",
+	"RadarFind Corporation",
+	"ITU-T",
+	"WiQuest Communications",
+	"Raycom CO ., LTD",
+	"GSP SYSTEMS Inc.",
+	"BOBST SA",
+	"Hopling Technologies b.v.",
+	"Rigol Technologies, Inc.",
+	"HanYang System",
+	"Arrow7 Corporation",
+	"XYnetsoft Co.,Ltd",
+	"Stanford Research Systems",
+	"Intellio Ltd",
+	"Famar Fueguina S.A.",
+	"Euro Emme s.r.l.",
+	"Boundary Devices",
+	"Dell Inc.",
+	"Paradox Security Systems Ltd",
+	"ELECTRO CHANCE SRL",
+	"New Media Life",
+	"Altai Technologies Limited",
+	"Citiway technology Co.,ltd",
+	"Equustek Solutions, Inc.",
+	"Qualitrol",
+	"Infocrypt Inc.",
+	"ZTE Corporation",
+	"Cambridge Industries(Group) Co.,Ltd.",
+	"AnyDATA Corporation",
+	"S&C ELECTRIC COMPANY",
+	"Broadata Communications, Inc",
+	"RCG (HK) Ltd",
+	"Chengdu ethercom information technology Ltd.",
+	"Progressive Gaming International",
+	"SALICRU, S.A.",
+	"Cathexis",
+	"TRAK Microwave",
+	"ICX Technologies",
+	"IP Innovations, Inc.",
+	"LS Cable and System Ltd.",
+	"FORTUNETEK CO., LTD",
+	"MAXFOR",
+	"Zeutschel GmbH",
+	"Welltrans O&E Technology Co. , Ltd.",
+	"ENENSYS Technologies",
+	"FEI-Zyfer, Inc.",
+	"MOBITEK",
+	"TP-LINK Technologies Co., Ltd.",
+	"Lynx Studio Technology, Inc.",
+	"TOYO MEDIC CO.,LTD.",
+	"S-Information Technolgy, Co., Ltd.",
+	"TeraMage Technologies Co., Ltd.",
+	"Pyronix Ltd",
+	"Sagamore Systems, Inc.",
+	"Axesstel Inc.",
+	"CARLO GAVAZZI CONTROLS SPA-Controls Division",
+	"SHENZHEN LINNKING ELECTRONICS CO.,LTD",
+	"UNIONMAN TECHNOLOGY CO.,LTD",
+	"Star Communication Network Technology Co.,Ltd",
+	"Teradyne K.K.",
+	"Cetis, Inc",
+	"Convergens Oy Ltd",
+	"Imagination Technologies Ltd",
+	"Acconet (PTE) Ltd",
+	"Onset Computer Corporation",
+	"Embedded Systems Design, Inc.",
+	"TDK-Lambda",
+	"Cable Vision Electronics CO., LTD.",
+	"BSkyB Ltd",
+	"PT. Ufoakses Sukses Luarbiasa",
+	"SHENZHEN SEECOMM TECHNOLOGY CO.,LTD.",
+	"Finnzymes",
+	"MATRIX INC.",
+	"Smiths Medical",
+	"SECURE CARE PRODUCTS, INC",
+	"Angel Electronics Co., Ltd.",
+	"Interay Solutions BV",
+	"OPTIBASE LTD",
+	"OpVista, Inc.",
+	"Arecont Vision",
+	"Simoco Ltd.",
+	"Wayfarer Transit Systems Ltd",
+	"Adaptive Micro-Ware Inc.",
+	"BONA TECHNOLOGY INC.",
+	"Swe-Dish Satellite Systems AB",
+	"HandHeld entertainment, Inc.",
+	"Cheng Uei Precision Industry Co.,Ltd",
+	"Sistemas Avanzados de Control, S.A.",
+	"LUCENT TRANS ELECTRONICS CO.,LTD",
+	"Google Inc.",
+	"Essilor",
+	"Wanlida Group Co., LTD",
+	"Xin Hua Control Engineering Co.,Ltd.",
+	"gemalto e-Payment",
+	"Teak Technologies, Inc.",
+	"Advanced Simulation Technology inc.",
+	"Computer Engineering Limited",
+	"Gentex Corporation/Electro-Acoustic Products",
+	"GT&T Engineering Pte Ltd",
+	"PChome Online Inc.",
+	"Coastal Environmental Systems",
+	"CMOTECH Co. Ltd.",
+	"Indac B.V.",
+	"eQ-3 Entwicklung GmbH",
+	"Ice Qube, Inc",
+	"Galaxy Telecom Technologies Ltd",
+	"DELTA DORE",
+	"Deltanode Solutions AB",
+	"Ubistar",
+	"ASWT Co., LTD. Taiwan Branch H.K.",
+	"Johnson Outdoors Marine Electronics, Inc",
+	"Ayecom Technology Co., Ltd.",
+	"SATEC Co.,LTD",
+	"The Navvo Group",
+	"Ziova Coporation",
+	"SCAN COIN Industries AB",
+	"ACTIVA MULTIMEDIA",
+	"ASI Communications, Inc.",
+	"Konka Group Co., Ltd.",
+	"BARTEC GmbH",
+	"Aipermon GmbH & Co. KG",
+	"Lear Corporation",
+	"Sanmina-SCI",
+	"Merten GmbH&CoKG",
+	"Dongahelecomm",
+	"Doah Elecom Inc.",
+	"Technowave Ltd.",
+	"Ajin Vision Co.,Ltd",
+	"Faster Technology LLC",
+	"intelbras",
+	"A-FOUR TECH CO., LTD.",
+	"INOCOVA Co.,Ltd",
+	"Techcity Technology co., Ltd.",
+	"Logical Link Communications",
+	"JWTrading Co., Ltd",
+	"Digital Multimedia Technology Co., Ltd",
+	"Agami Systems, Inc.",
+	"Takacom Corporation",
+	"Micro Vision Co.,LTD",
+	"Qumranet Inc.",
+	"Crossbow Technology, Inc",
+	"NTI AG / LinMot",
+	"PheeNet Technology Corp.",
+	"Alfred Mann Foundation",
+	"Meshlinx Wireless Inc.",
+	"Zylaya",
+	"Hip Shing Electronics Ltd.",
+	"ACA-Digital Corporation",
+	"ViewTel Co,. Ltd.",
+	"Matrix Design Group, LLC",
+	"CCV Deutschland GmbH - Celectronic eHealth Div.",
+	"Ircona",
+	"Korea Electric Power Data Network  (KDN) Co., Ltd",
+	"NetCare Service Co., Ltd.",
+	"Euchner GmbH+Co. KG",
+	"Mobinnova Corp.",
+	"Thincom Technology Co.,Ltd",
+	"KitWorks.fi Ltd.",
+	"Wave Electronics Co.,Ltd.",
+	"PacStar Corp.",
+	"Data Robotics, Incorporated",
+	"Elster Solutions, LLC,",
+	"Seluxit",
+	"Infinite QL Sdn Bhd",
+	"Weltec Enterprise Co., Ltd.",
+	"Wuhan Yangtze Optical Technology CO.,Ltd.",
+	"Tranzas, Inc.",
+	"Impro Technologies",
+	"MI.TEL s.r.l.",
+	"Diostech Co., Ltd.",
+	"Mosart Semiconductor Corp.",
+	"Procare International Co",
+	"SDT information Technology Co.,LTD.",
+	"ubtos",
+	"TELECOMUNICATION TECHNOLOGIES LTD.",
+	"Lismore Instruments Limited",
+	"Teleco, Inc.",
+	"Hirschmann Multimedia B.V.",
+	"cyber-blue(HK)Ltd",
+	"LN Srithai Comm Ltd.",
+	"GCI Science&Technology Co.,Ltd.",
+	"Zelax",
+	"PROBA Building Automation Co.,LTD",
+	"Pegasus Technologies Inc.",
+	"V One Multimedia Pte Ltd",
+	"NV Michel Van de Wiele",
+	"AdvancedIO Systems Inc",
+	"Canhold International Limited",
+	"Venergy,Co,Ltd",
+	"CHUNIL ELECTRIC IND., CO.",
+	"Sophos Ltd",
+	"AVECS Bergen GmbH",
+	"3Way Networks Ltd",
+	"Trópico Sistemas e Telecomunicações da Amazônia LTDA.",
+	"FusionDynamic Ltd.",
+	"ERCO Leuchten GmbH",
+	"Votronic GmbH",
+	"Hisense Mobile Communications Technoligy Co.,Ltd.",
+	"ECLER S.A.",
+	"fitivision technology Inc.",
+	"Asotel Communication Limited Taiwan Branch",
+	"Smarty (HZ) Information Electronics Co., Ltd",
+	"Skyworth Digital technology(shenzhen)co.ltd.",
+	"ADEC & Parter AG",
+	"RightHand Technologies, Inc.",
+	"Skipper Wireless, Inc.",
+	"ICON Digital International Limited",
+	"A-Link Ltd",
+	"DELORME",
+	"Future University-Hakodate",
+	"BRN Phoenix",
+	"Telefunken Radio Communication Systems GmbH &CO.KG",
+	"Torian Wireless",
+	"Mamiya Digital Imaging Co., Ltd.",
+	"FUJIAN STAR-NET COMMUNICATION CO.,LTD",
+	"Analogic Corp.",
+	"eWings s.r.l.",
+	"Corelatus AB",
+	"Savant Systems LLC",
+	"BLUSENS TECHNOLOGY",
+	"Signal Networks Pvt. Ltd.,",
+	"Asia Pacific Satellite Industries Co., Ltd.",
+	"Cyber Solutions Inc.",
+	"VISIONITE INC.",
+	"FFEI Ltd.",
+	"Home Network System",
+	"Ethos Networks LTD.",
+	"Anseri Corporation",
+	"PMC",
+	"Caton Overseas Limited",
+	"Fontal Technology Incorporation",
+	"U4EA Technologies Ltd",
+	"Impatica Inc.",
+	"COMPUTER HI-TECH INC.",
+	"TRUMPF Laser Marking Systems AG",
+	"JOYBIEN TECHNOLOGIES CO., LTD.",
+	"YEC Co.,Ltd.",
+	"Scientific-Atlanta, Inc",
+	"BreakingPoint Systems, Inc.",
+	"Micro Control Designs",
+	"UNIPOINT",
+	"ISL (Instrumentation Scientifique de Laboratoire)",
+	"SUZUKEN CO.,LTD",
+	"Tilera Corporation",
+	"Autocom Products Ltd",
+	"Celestial Semiconductor, Ltd",
+	"Tidel Engineering LP",
+	"YUPITERU CORPORATION",
+	"C.T. ELETTRONICA",
+	"Albis Technologies AG",
+	"FARGO CO., LTD.",
+	"Eletronica Nitron Ltda",
+	"Vamp Ltd.",
+	"iPOX Technology Co., Ltd.",
+	"KMC CHAIN INDUSTRIAL CO., LTD.",
+	"JIAGNSU AETNA ELECTRIC CO.,LTD",
+	"Christie Digital Systems, Inc.",
+	"AlsterAero GmbH",
+	"International Broadband Electric Communications, Inc.",
+	"Biz-2-Me Inc.",
+	"PePWave Ltd",
+	"Interactivetv Pty Limited",
+	"Mythology Tech Express Inc.",
+	"EDGE ACCESS INC",
+	"Medicis Technologies Corporation",
+	"Mvox Technologies Inc.",
+	"Atlanta Advanced Communications Holdings Limited",
+	"Aztek Networks, Inc.",
+	"Unify GmbH and Co KG",
+	"Radio Terminal Systems Pty Ltd",
+	"Keumbee Electronics Co.,Ltd.",
+	"INCOTEC GmbH",
+	"Shenztech Ltd",
+	"Loopcomm Technology, Inc.",
+	"Alcatel - IPD",
+	"Embedded Artists AB",
+	"Dynavisions Schweiz AG",
+	"Samyoung Electronics",
+	"Handreamnet",
+	"PENTAONE. CO., LTD.",
+	"Woven Systems, Inc.",
+	"dataschalt e+a GmbH",
+	"Copley Controls Corporation",
+	"AeroVIronment (AV Inc)",
+	"Welch Allyn, Inc.",
+	"Joby Inc.",
+	"ModusLink Corporation",
+	"EVOLIS",
+	"SOFACREAL",
+	"Wizyoung Tech.",
+	"Neopost Technologies",
+	"Applied Radio Technologies",
+	"ED Co.Ltd",
+	"Action Technology (SZ) Co., Ltd",
+	"Affinity International S.p.a",
+	"YMC AG",
+	"Ateliers R. LAUMONIER",
+	"Mendocino Software",
+	"Danfoss Drives A/S",
+	"Matrix Telecom Pvt. Ltd.",
+	"Intelligent Distributed Controls Ltd",
+	"Phidgets Inc.",
+	"InoTec GmbH Organisationssysteme",
+	"Petratec",
+	"ShenZhen Kang Hui Technology Co.,ltd",
+	"Apprion",
+	"Icron Technologies Corporation",
+	"Carex Lighting Equipment Factory",
+	"Voxtel, Inc.",
+	"Celtro Ltd.",
+	"Palo Alto Networks",
+	"Tsuken Electric Ind. Co.,Ltd",
+	"IEEE I&M Society TC9",
+	"e-trees Japan, Inc.",
+	"Siemens AG,",
+	"Coherent",
+	"Phoenix International Co., Ltd",
+	"HART Communication Foundation",
+	"DELTA - Danish Electronics, Light & Acoustics",
+	"TPine Technology",
+	"Palit Microsystems ( H.K.) Ltd.",
+	"SimpleComTools",
+	"RON-Telecom ZAO",
+	"Merlin CSI",
+	"POLYGON, JSC",
+	"Avantis.Co.,Ltd",
+	"ATRON electronic GmbH",
+	"Med-Eng Systems Inc.",
+	"Sinkyo Electron Inc",
+	"NETGEAR Inc.",
+	"Solitech Inc.",
+	"Neural Image. Co. Ltd.",
+	"Focus System Inc.",
+	"ChongQing JINOU Science & Technology Development CO.,Ltd",
+	"Tsubata Engineering Co.,Ltd. (Head Office)",
+	"Computec Oy",
+	"COMPAL INFORMATION (KUNSHAN) CO., LTD.",
+	"Proxicast",
+	"SIMS Corp.",
+	"Yi-Qing CO., LTD",
+	"Software Technologies Group,Inc.",
+	"EuroTel Spa",
+	"Curtis, Inc.",
+	"ProCurve Networking by HP",
+	"Network Automation mxc AB",
+	"General Infinity Co.,Ltd.",
+	"Wise & Blue",
+	"Beijing DG Telecommunications equipment Co.,Ltd",
+	"SanDisk Corporation",
+	"ABB AS, Division Automation Products",
+	"Blueone Technology Co.,Ltd",
+	"Futarque A/S",
+	"Shenzhen Lantech Electronics Co., Ltd.",
+	"Roberts Radio limited",
+	"W&W Communications, Inc.",
+	"SANION Co., Ltd.",
+	"Signtech",
+	"Areca Technology Corporation",
+	"Navman New Zealand",
+	"Avaya Inc.",
+	"Nizhny Novgorod Factory named after M.Frunze, FSUE (NZiF)",
+	"Vector Technology Corp.",
+	"Hurco Automation Ltd.",
+	"Tehuti Networks Ltd.",
+	"SEMINDIA SYSTEMS PRIVATE LIMITED",
+	"ACE CAD Enterprise Co., Ltd.",
+	"Apollo Imaging Technologies, Inc.",
+	"Azuretec Co., Ltd.",
+	"Vololink Pty Ltd",
+	"BPL Limited",
+	"Alien Technology",
+	"NAVIGON AG",
+	"Digital Acoustics, LLC",
+	"JHT Optoelectronics Co.,Ltd.",
+	"IsaacLandKorea Co., Ltd,",
+	"China Gridcom Co., Ltd",
+	"Sennheiser electronic GmbH & Co. KG",
+	"Cisco Systems Inc",
+	"Modnnet Co., Ltd",
+	"Equaline Corporation",
+	"Powerwave Technologies Sweden AB",
+	"Swyx Solutions AG",
+	"LookX Digital Media BV",
+	"Midtronics, Inc.",
+	"Anue Systems, Inc.",
+	"Teletrak Ltd",
+	"IRI Ubiteq, INC.",
+	"Telular Corp.",
+	"Sicep s.p.a.",
+	"DTL Broadcast Ltd",
+	"MiraLink Corporation",
+	"Hypermedia Systems",
+	"Ripcode, Inc.",
+	"FAIVELEY TRANSPORT",
+	"The Tintometer Ltd",
+	"A & R Cambridge",
+	"CXR Anderson Jacobson",
+	"Beckmann GmbH",
+	"TMN Technologies Telecomunicacoes Ltda",
+	"LORD Corporation",
+	"DATAQ Instruments, Inc.",
+	"Taiwan Semiconductor Co., Ltd.",
+	"Finsoft Ltd",
+	"Scan Engineering Telecom",
+	"MAN Diesel SE",
+	"Deepsound Tech. Co., Ltd",
+	"Divinet Access Technologies Ltd",
+	"EMZA Visual Sense Ltd.",
+	"2M Electronic A/S",
+	"JMicron Technology Corp.",
+	"Electronic Computer Systems, Inc.",
+	"Hulu Sweden AB",
+	"EFKON AG",
+	"l-acoustics",
+	"JC Decaux SA DNT",
+	"T.E.M.A. S.p.A.",
+	"VIDEO SYSTEMS SRL",
+	"General Sensing",
+	"Violin Technologies",
+	"KS System GmbH",
+	"Apollo Fire Detectors Ltd",
+	"Hose-McCann Communications",
+	"SATEL sp. z o.o.",
+	"Novus Security Sp. z o.o.",
+	"ASKEY  COMPUTER  CORP",
+	"Calyptech Pty Ltd",
+	"Awox",
+	"Åmic AB",
+	"IDS Imaging Development Systems GmbH",
+	"Flexit Group GmbH",
+	"S.A.E Afikim",
+	"MyungMin Systems, Inc.",
+	"intotech inc.",
+	"Lorica Solutions",
+	"UBI&MOBI,.Inc",
+	"BROTHER INDUSTRIES, LTD.",
+	"XenICs nv",
+	"Telchemy, Incorporated",
+	"Curtiss Wright Controls Embedded Computing",
+	"iControl Incorporated",
+	"Micro Control Systems, Inc",
+	"BHARAT ELECTRONICS",
+	"Intellect International NV",
+	"Condalo GmbH",
+	"Airvod Limited",
+	"ZF Electronics GmbH",
+	"Bird Electronic Corp.",
+	"Alta Heights Technology Corp.",
+	"BLUEWAY ELECTRONIC CO;LTD",
+	"RFTech Co.,Ltd",
+	"Silver Peak Systems, Inc.",
+	"FMC Kongsberg Subsea AS",
+	"ICOP Digital",
+	"HOLUX Technology, Inc.",
+	"Integrated Control Technology Limitied",
+	"Mobisolution Co.,Ltd",
+	"Ultratec, Inc.",
+	"IEEE REGISTRATION AUTHORITY  - Please see OUI36/MA-S public listing for more information.",
+	"Strato Rechenzentrum AG",
+	"StarVedia Technology Inc.",
+	"MIURA CO.,LTD",
+	"FSN DISPLAY INC",
+	"Beijing Run Technology LTD. Company",
+	"PEMPEK SYSTEMS PTY LTD",
+	"KINGTEK CCTV ALLIANCE CO., LTD.",
+	"DAVISCOMMS (S) PTE LTD",
+	"Measurement Devices Ltd",
+	"Dataupia Corporation",
+	"IDENTEC SOLUTIONS",
+	"SOGESTMATIC",
+	"ULTRA-X ASIA PACIFIC Inc.",
+	"Panasonic Corp. AVC Company",
+	"Kelvin Hughes Ltd",
+	"DVTel LTD",
+	"Edgewater Computer Systems",
+	"UTStarcom Inc",
+	"Valeo VECS",
+	"Vencer Co., Ltd.",
+	"Renkus-Heinz, Inc.",
+	"Iskra Sistemi d.d.",
+	"TELENOT ELECTRONIC GmbH",
+	"ViaLogy",
+	"AhnLab,Inc.",
+	"Health Hero Network, Inc.",
+	"TOWNET SRL",
+	"802automation Limited",
+	"VR AG",
+	"Postek Electronics Co., Ltd.",
+	"Ultratronik GmbH",
+	"DMP Electronics INC.",
+	"Netio Technologies Co., Ltd",
+	"Blossoms Digital Technology Co.,Ltd.",
+	"Value Platforms Limited",
+	"Nanjing SilverNet Software Co., Ltd.",
+	"KWORLD COMPUTER CO., LTD",
+	"TRANSRADIO SenderSysteme Berlin AG",
+	"KENWIN INDUSTRIAL(HK) LTD.",
+	"Tellink Sistemas de Telecomunicación S.L.",
+	"CONWISE Technology Corporation Ltd.",
+	"Lund IP Products AB",
+	"Digitrax Inc.",
+	"Intellitect Water Ltd",
+	"G.i.N. mbH",
+	"Dignsys Inc.",
+	"Zavio Inc.",
+	"Millennia Media inc.",
+	"Entry Point, LLC",
+	"ABB Oy Drives",
+	"Pano Logic",
+	"Betty TV Technology AG",
+	"Airgain, Inc.",
+	"Nonin Medical Inc.",
+	"Siemens Numerical Control Ltd., Nanjing",
+	"Cwlinux Limited",
+	"Echo360, Inc.",
+	"SAE Electronic Co.,Ltd.",
+	"Shenzhen AEE Technology Co.,Ltd.",
+	"SmartAnt Telecom",
+	"TANITA Corporation",
+	"G-Technology, Inc.",
+	"OPTSYS TECHNOLOGY CO., LTD.",
+	"VMware, Inc",
+	"iPhotonix LLC",
+	"ThyssenKrupp Elevator",
+	"Sicert S.r.L.",
+	"secunet Security Networks AG",
+	"Thomas Instrumentation, Inc",
+	"Hyperstone GmbH",
+	"Center Communication Systems GmbH",
+	"CHENZHOU GOSPELL DIGITAL TECHNOLOGY CO.,LTD",
+	"emtrion GmbH",
+	"Quest Retail Technology Pty Ltd",
+	"CLB Benelux",
+	"Nucsafe Inc.",
+	"Aeris Elettronica s.r.l.",
+	"Formosa Wireless Systems Corp.",
+	"Hon Hai Precision Ind. Co.,Ltd.",
+	"Sunell Electronics Co.",
+	"Sphairon Technologies GmbH",
+	"CORE DIGITAL ELECTRONICS CO., LTD",
+	"Envisacor Technologies Inc.",
+	"Alertme.com Limited",
+	"Synapse",
+	"FlexRadio Systems",
+	"HPN Supply Chain",
+	"Pfister GmbH",
+	"Mode Lighting (UK ) Ltd.",
+	"Mobile XP Technology Co., LTD",
+	"Telian Corporation",
+	"Sutron",
+	"HUEY CHIAO INTERNATIONAL CO., LTD.",
+	"iNEWiT NV",
+	"Callpod, Inc.",
+	"Bio-Rad Laboratories, Inc.",
+	"S Netsystems Inc.",
+	"Element Labs, Inc.",
+	"AmRoad Technology Inc.",
+	"Seon Design Inc.",
+	"WaveStorm",
+	"ECKey Corporation",
+	"International Police Technologies, Inc.",
+	"VDG-Security bv",
+	"scemtec Transponder Technology GmbH",
+	"Parallels, Inc.",
+	"Bosch Security Systems BV",
+	"Chenbro Micom Co., Ltd.",
+	"QTUM",
+	"Hangzhou Hollysys Automation Co., Ltd",
+	"WiDeFi, Inc.",
+	"Zoltan Technology Inc.",
+	"Gener8, Inc.",
+	"Petrotest Instruments",
+	"Aplix IP Holdings Corporation",
+	"TASA International Limited",
+	"MACAB AB",
+	"TCL Technoly Electronics(Huizhou)Co.,Ltd",
+	"Celeno Communications",
+	"VISIONEE SRL",
+	"Synergy Lighting Controls",
+	"Hillstone Networks Inc",
+	"Shenzhen Kaifa Technology Co.",
+	"Pado Systems, Inc.",
+	"DEVON IT",
+	"Advanced Relay Corporation",
+	"Chubb Electronic Security Systems Ltd",
+	"Integrated Medical Systems, Inc.",
+	"Leica Microsystems",
+	"ASTON France",
+	"Winland Electronics, Inc.",
+	"CSP Frontier Technologies,Inc.",
+	"Galaxy  Microsystems LImited",
+	"LG Electronics Inc",
+	"TRUEN",
+	"Landis+Gyr",
+	"JoeScan, Inc.",
+	"UCAMP CO.,LTD",
+	"Pumpkin Networks, Inc.",
+	"Anhui Sun Create Electronics Co., Ltd",
+	"Packet Vision Ltd",
+	"Weiss Engineering Ltd.",
+	"COVAX  Co. Ltd",
+	"Jabil Circuit (Guangzhou) Limited",
+	"KYOHRITSU ELECTRONIC INDUSTRY CO., LTD.",
+	"Newbury Networks, Inc.",
+	"Emfit Ltd",
+	"NOVACOMM LTDA",
+	"Emergent Electronics",
+	"Mayer & Cie GmbH & Co KG",
+	"Arista Networks, Inc.",
+	"Syswan Technologies Inc.",
+	"Segnet Ltd.",
+	"The Wandsworth Group Ltd",
+	"Prodys",
+	"WYPLAY SAS",
+	"Cohesive Financial Technologies LLC",
+	"Perfectone Netware Company Ltd",
+	"Castlenet Technology Inc.",
+	"PERQ SYSTEMS CORPORATION",
+	"Excelpoint Manufacturing Pte Ltd",
+	"New Business Division/Rhea-Information CO., LTD.",
+	"NextGen Venturi LTD",
+	"Genew Technologies",
+	"New Level Telecom Co., Ltd.",
+	"STL Solution Co.,Ltd.",
+	"Eunicorn",
+	"Cranite Systems, Inc.",
+	"Uriver Inc.",
+	"TRANSYSTEM INC.",
+	"Force Communications, Inc.",
+	"Cirrascale Corporation",
+	"MJ Innovations Ltd.",
+	"DIAL TECHNOLOGY LTD.",
+	"Mesa Imaging",
+	"Alcatel-Lucent IPD",
+	"Advanced Electronic Design, Inc.",
+	"Empacket Corporation",
+	"Gefen Inc.",
+	"Tervela",
+	"ExaDigm Inc",
+	"LI-COR Biosciences",
+	"Opticomm Corporation",
+	"Linkwise Technology Pte Ltd",
+	"Enzytek Technology Inc.,",
+	"LUCKY TECHNOLOGY (HK) COMPANY LIMITED",
+	"Shunra Software Ltd.",
+	"FEIG ELECTRONIC GmbH",
+	"Liecthi AG",
+	"Dualtech IT AB",
+	"Razorstream, LLC",
+	"Production Resource Group, LLC",
+	"AKAMAI TECHNOLOGIES, INC.",
+	"Terra",
+	"Zygo Corporation",
+	"Win4NET",
+	"International Quartz Limited",
+	"AirTies Wireless Networks",
+	"Audiomatica Srl",
+	"Bellon Pty Ltd",
+	"Meyer Sound Laboratories, Inc.",
+	"Qniq Technology Corp.",
+	"Wuhan Telecommunication Devices Co.,Ltd",
+	"WiChorus, Inc.",
+	"Plato Networks Inc.",
+	"BPT SPA",
+	"Iridium Satellite LLC",
+	"Neihua Network Technology Co.,LTD.(NHN)",
+	"Duzon CNT Co., Ltd.",
+	"USC DigiArk Corporation",
+	"CBC Co., Ltd",
+	"KWANG SUNG ELECTRONICS CO., LTD.",
+	"VerScient, Inc.",
+	"MusicianLink",
+	"CastGrabber, LLC",
+	"Ezze Mobile Tech., Inc.",
+	"Part II Research, Inc.",
+	"Pace plc",
+	"3COM LTD",
+	"ProStor Systems",
+	"Rembrandt Technologies, LLC d/b/a REMSTREAM",
+	"INDUSTRONIC Industrie-Electronic GmbH & Co. KG",
+	"Kaise Electronic Technology Co., Ltd.",
+	"Shanghai Gaozhi Science & Technology Development Co.",
+	"Forth Corporation Public Company Limited",
+	"Alektrona Corporation",
+	"By Techdesign",
+	"LIMETEK",
+	"Circleone Co.,Ltd.",
+	"Waves Audio LTD",
+	"King Champion (Hong Kong) Limited",
+	"ZP Engineering SEL",
+	"ZeeVee, Inc.",
+	"Harman/Becker Automotive Systems GmbH",
+	"BlueAnt Wireless",
+	"GlobalTop Technology Inc.",
+	"Exegin Technologies Limited",
+	"CARPOINT CO.,LTD",
+	"Custom Computer Services, Inc.",
+	"COWBELL ENGINEERING CO., LTD.",
+	"Interactive Multimedia eXchange Inc.",
+	"Belkin International Inc.",
+	"DASAN TPS",
+	"INDRA SISTEMAS, S.A.",
+	"Attero Tech, LLC.",
+	"Optimedical Systems",
+	"EleSy JSC",
+	"MBS Electronic Systems GmbH",
+	"INNES",
+	"Rocon PLC Research Centre",
+	"Cummins Inc",
+	"Galaxy Technology Limited",
+	"Mobilesoft (Aust.) Pty Ltd",
+	"ENVIRONNEMENT SA",
+	"SHARP Corporation",
+	"Primax Electronics LTD",
+	"SUPoX Technology Co. , LTD.",
+	"Tenlon Technology Co.,Ltd.",
+	"EVS BROADCAST EQUIPMENT",
+	"Media Technology Systems Inc",
+	"Wiseblue Technology Limited",
+	"AudioScience",
+	"Parade Technologies, Ltd.",
+	"Alarm.com",
+	"Suminet Communication Technologies (Shanghai) Co., Ltd.",
+	"Universal Electronics",
+	"Quartics Inc",
+	"Napera Networks Inc",
+	"Brivo Systems, LLC",
+	"Neptune Digital",
+	"Cybertech Telecom Development",
+	"Design Solutions Inc.",
+	"Zipit Wireless, Inc.",
+	"HM Electronics, Inc.",
+	"Shenzhen Sang Fei Consumer Communications Co.,Ltd",
+	"JIANGSU YINHE ELECTRONICS CO., LTD",
+	"Davis Instruments, Inc.",
+	"Power Standards Lab",
+	"MobileCompia",
+	"Sony Computer Entertainment inc.",
+	"Agapha Technology co., Ltd.",
+	"LightHaus Logic, Inc.",
+	"Analogue & Micro Ltd",
+	"ROHM CO., LTD.",
+	"NextGTV",
+	"SPERADTONE INFORMATION TECHNOLOGY LIMITED",
+	"Shenzhen Dolphin Electronic Co., Ltd",
+	"Digital Sky Corporation",
+	"Power Innovation GmbH",
+	"OvisLink S.A.",
+	"Sangean Electronics Inc.",
+	"Gennet s.a.",
+	"Inter-M Corporation",
+	"KYUSHU TEN CO.,LTD",
+	"Siauliu Tauro Televizoriai, JSC",
+	"COMTREND CO.",
+	"Alcad SL",
+	"Foss Analytical A/S",
+	"SENSUS",
+	"Aclara Power-Line Systems Inc.",
+	"Rockridgesound Technology Co.",
+	"NAC-INTERCOM",
+	"Doro AB",
+	"SHENZHEN BUL-TECH CO.,LTD.",
+	"Wuhan Pont Technology CO. , LTD",
+	"Wavetrend Technologies (Pty) Limited",
+	"Pylone, Inc.",
+	"QuantumVision Corporation",
+	"YX Wireless S.A.",
+	"HIGHPRO INTERNATIONAL R&D CO,.LTD.",
+	"Longkay Communication & Technology (Shanghai) Co. Ltd",
+	"Maverick Systems Inc.",
+	"SYRIS Technology Corp",
+	"Viconics Electronics Inc.",
+	"ELECTRONICS CORPORATION OF INDIA LIMITED",
+	"Thales-Panda Transportation System",
+	"MOOHADIGITAL CO., LTD",
+	"mh acoustics LLC",
+	"Muscle Corporation",
+	"Avidyne Corporation",
+	"SAKA TECHNO SCIENCE CO.,LTD",
+	"Mitron Pty Ltd",
+	"Intel – GE Care Innovations LLC",
+	"Hardy Instruments",
+	"Shenzhen G-link Digital Technology Co., Ltd.",
+	"KROHNE Messtechnik GmbH",
+	"Covote GmbH & Co KG",
+	"Sensor-Technik Wiedemann GmbH",
+	"Innovation Wireless Inc.",
+	"Carestream Health, Inc.",
+	"Grid Connect Inc.",
+	"Adaptive Recognition Hungary, Inc",
+	"TCM Mobile LLC",
+	"SPINETIX SA",
+	"Babcock & Wilcox Power Generation Group, Inc",
+	"Defzone B.V.",
+	"S&O Electronics (Malaysia) Sdn. Bhd.",
+	"Sunnic Technology & Merchandise INC.",
+	"ZANTAZ, Inc",
+	"Kramer Electronics Ltd.",
+	"CAETEC Messtechnik",
+	"CQ Inc",
+	"Mitra Energy & Infrastructure",
+	"2Wire Inc.",
+	"Tecvan Informática Ltda",
+	"Tom Communication Industrial Co.,Ltd.",
+	"Control Dynamics Pty. Ltd.",
+	"COMING MEDIA CORP.",
+	"OverSpeed SARL",
+	"BIJ Corporation",
+	"InPhase Technologies",
+	"Miele & Cie. KG",
+	"Adam Communications Systems Int Ltd",
+	"Microwave Radio Communications",
+	"Hyundai Telecom",
+	"AMEC",
+	"Knorr-Bremse IT-Services GmbH",
+	"ClariPhy Communications, Inc.",
+	"Confidant International LLC",
+	"Chainzone Technology Co., Ltd",
+	"Tianjin China-Silicon Microelectronics Co., Ltd.",
+	"Radioscape PLC",
+	"Eyeheight Ltd.",
+	"NSGate",
+	"Invengo Information Technology Co.,Ltd",
+	"SIGNAMAX LLC",
+	"Wideband Semiconductor, Inc.",
+	"Ice Energy, Inc.",
+	"ABE Elettronica S.p.A.",
+	"Tekron International Ltd",
+	"Beijing Huahuan Eletronics Co.,Ltd",
+	"GUANGZHOU GATEWAY ELECTRONICS CO., LTD",
+	"GN A/S (GN Netcom A/S)",
+	"Emitech Corporation",
+	"Gateway, Inc.",
+	"Call Direct Cellular Solutions",
+	"Shinwa Industries(China) Ltd.",
+	"VigTech Labs Sdn Bhd",
+	"Clearwire",
+	"VaultStor Corporation",
+	"TechTrex Inc",
+	"La Crosse Technology LTD",
+	"Raytek GmbH",
+	"Alereon, Inc.",
+	"PureWave Networks",
+	"EMCO Flow Systems",
+	"Digitize, Inc",
+	"MICRO-STAR INT'L CO.,LTD.",
+	"Modacom",
+	"Climax Technology Co., Ltd",
+	"Flash, Inc.",
+	"WatchGuard Video",
+	"Alertus Technologies LLC",
+	"Cyan Optic, Inc.",
+	"GODEX INTERNATIONAL CO., LTD",
+	"Hokuyo Automatic Co., Ltd.",
+	"ARTJOY INTERNATIONAL LIMITED",
+	"AXION TECHNOLOGIES",
+	"MATT   R.P.Traczynscy Sp.J.",
+	"Heng Yu Electronic Manufacturing Company Limited",
+	"SabiOso",
+	"Hangzhou System Technology CO., LTD",
+	"WB Electronics",
+	"Media Numerics Limited",
+	"Seamless Internet",
+	"Takahata Electronics Co.,Ltd",
+	"Castles Technology, Co., LTD",
+	"DrayTek Corp.",
+	"SwissQual License AG",
+	"Gigamon Systems LLC",
+	"Sinotech Engineering Consultants, Inc.  Geotechnical Enginee",
+	"CHANG TSENG TECHNOLOGY CO., LTD",
+	"FuJian HengTong Information Technology Co.,Ltd",
+	"Crescendo Networks",
+	"HOKKAIDO ELECTRIC ENGINEERING CO.,LTD.",
+	"KUMHO ENG CO.,LTD",
+	"Juniper networks",
+	"BestComm Networks, Inc.",
+	"Tendril Networks, Inc.",
+	"Intoto Inc.",
+	"Wellspring Wireless",
+	"Dynamic System Electronics Corp.",
+	"Versamed Inc.",
+	"Radiient Technologies, Inc.",
+	"Enphase Energy",
+	"Audinate Pty L",
+	"XORTEC OY",
+	"RIKOR TV, Ltd",
+	"AIOI Systems Co., Ltd.",
+	"Beijing Jiaxun Feihong Electricial Co., Ltd.",
+	"SNR Inc.",
+	"L-3 Communications Geneva Aerospace",
+	"Navionics Research Inc., dba SCADAmetrics",
+	"GainSpan Corp.",
+	"PAV Electronics Limited",
+	"Exéns Development Oy",
+	"Hetra Secure Solutions",
+	"Algolith",
+	"Hon Hai Precision Ind.Co.,Ltd.",
+	"Mikroelektronika spol. s r. o.",
+	"C-BEL Corporation",
+	"HangZhou DeChangLong Tech&Info Co.,Ltd",
+	"DAT H.K. LIMITED",
+	"Zhejiang Broadcast&Television Technology Co.,Ltd.",
+	"Sunitec Enterprise Co., Ltd.",
+	"Radionor Communications",
+	"Intuicom",
+	"Visioneered Image Systems",
+	"Marine Sonic Technology, Ltd.",
+	"Nikko Denki Tsushin Corporation(NDTC)",
+	"Commtest Instruments Ltd",
+	"DINEC International",
+	"Marusys",
+	"Grid Net, Inc.",
+	"NEXTVISION SISTEMAS DIGITAIS DE TELEVISÃO LTDA.",
+	"TRIMM, INC.",
+	"Vidient Systems, Inc.",
+	"Intego Systems, Inc.",
+	"Netflix, Inc.",
+	"SBS Science & Technology Co., Ltd",
+	"Magellan Technology Pty Limited",
+	"Sunshine Co,LTD",
+	"R. STAHL Schaltgeräte GmbH",
+	"Webpro Vision Technology Corporation",
+	"Cybiotronics (Far East) Limited",
+	"Fujian LANDI Commercial Equipment Co.,Ltd",
+	"NETCLEUS Systems Corporation",
+	"KSIC",
+	"Palm, Inc",
+	"Network Critical Solutions Ltd",
+	"Shantou Institute of Ultrasonic Instruments",
+	"Renesas Technology Sales Co., Ltd.",
+	"Sougou Keikaku Kougyou Co.,Ltd.",
+	"LiComm Co., Ltd.",
+	"Hanson Research Corporation",
+	"Xseed Technologies & Computing",
+	"WIBRAIN",
+	"Winy Technology Co., Ltd.",
+	"Centec Networks Inc",
+	"ZEFATEK Co.,LTD",
+	"Syba Tech Limited",
+	"Sherwood Information Partners, Inc.",
+	"Micran Ltd.",
+	"MAXI VIEW HOLDINGS LIMITED",
+	"Briot International",
+	"ShenZhen Huawei Communication Technologies Co.,Ltd.",
+	"ELELUX INTERNATIONAL LTD",
+	"Ecolab",
+	"Beech Hill Electronics",
+	"Keytronix",
+	"STN BV",
+	"Radio Activity srl",
+	"GTRI",
+	"Best Source Taiwan Inc.",
+	"Digital Stream Technology, Inc.",
+	"SWS Australia Pty Limited",
+	"East Coast Datacom, Inc.",
+	"Honeywell Life Safety",
+	"Intertain Inc.",
+	"Qisda Co.",
+	"ARVOO Imaging Products BV",
+	"Electronic Educational Devices, Inc",
+	"Zhejiang Bell Technology Co.,ltd",
+	"Intek Digital Inc",
+	"Digifriends Co. Ltd",
+	"SBN TECH Co.,Ltd.",
+	"Lumexis Corporation",
+	"Hypertherm Inc",
+	"Radio Systems Design, Inc.",
+	"CyVerse Corporation",
+	"STIM",
+	"SIRTI S.p.A.",
+	"DiMoto Pty Ltd",
+	"Shireen Inc",
+	"INFOMARK CO.,LTD.",
+	"Zensys",
+	"Inventec Corporation",
+	"CryptoMetrics",
+	"IPTE",
+	"Bluecard Software Technology Co., Ltd.",
+	"Comsys Communication Ltd.",
+	"Lyngbox Media AB",
+	"KMW Inc.",
+	"TrellisWare Technologies, Inc.",
+	"Shanghai DareGlobal Technologies  Co.,Ltd.",
+	"Microwave Communication & Component, Inc.",
+	"Teltonika",
+	"AISIN AW CO.,LTD.",
+	"SANTEC",
+	"PT. Hariff Daya Tunggal Engineering",
+	"Wi-Links",
+	"City Theatrical",
+	"Hon Hai Precision Ind.Co., Ltd.",
+	"Welkin Sciences, LLC",
+	"DAKO EDV-Ingenieur- und Systemhaus GmbH",
+	"BATTISTONI RESEARCH",
+	"Converter Industry Srl",
+	"Further Tech Co., LTD",
+	"TOYO ELECTRIC Corporation",
+	"COWON SYSTEMS,Inc.",
+	"Bally Wulff Entertainment GmbH",
+	"ALCOMA, spol. s r.o.",
+	"Silicon Turnkey Express, LLC",
+	"Unitron Company, Inc.",
+	"RB GeneralEkonomik",
+	"Holosys d.o.o.",
+	"COmputime Ltd.",
+	"KwikByte, LLC",
+	"Digital Lighting Systems, Inc",
+	"ITEC GmbH",
+	"Siemon",
+	"Vibro-Meter SA",
+	"RESOL Elektronische Regelungen GmbH",
+	"Quanta Computer",
+	"Beijing Bluexon Technology Co.,Ltd",
+	"Cisco SPVTG",
+	"Opaque Systems",
+	"IT R&D Center",
+	"Shenzhen First Mile Communications Ltd",
+	"Magna-Power Electronics, Inc.",
+	"Cobham Defence Communications Ltd",
+	"MIrcom Group of Companies",
+	"PCS",
+	"LG Electronics",
+	"Thermo Fisher Scientific",
+	"Air2App",
+	"Owitek Technology Ltd.,",
+	"R.I.CO. S.r.l.",
+	"Taiwick Limited",
+	"CBM of America",
+	"Last Mile Ltd.",
+	"CNB Technology Inc.",
+	"LAN/MAN Standards Association (LMSC)",
+	"Pika Technologies Inc.",
+	"Lagotek Corporation",
+	"MEL Co.,Ltd.",
+	"Realease Limited",
+	"ANDOR SYSTEM SUPPORT CO., LTD.",
+	"CRFS Limited",
+	"eCopy, Inc",
+	"Infra Access Korea Co., Ltd.",
+	"Hunkeler AG",
+	"Elitegroup Computer Systems Co",
+	"KIMIN Electronic Co., Ltd.",
+	"JEULIN S.A.",
+	"CiriTech Systems Inc",
+	"SUPERCOM TECHNOLOGY CORPORATION",
+	"SIGMALINK",
+	"Sepura Plc",
+	"Medium Link System Technology CO., LTD,",
+	"GreenLine Communications",
+	"Vantanol Industrial Corporation",
+	"HAMILTON Bonaduz AG",
+	"San-Eisha, Ltd.",
+	"Fidustron INC",
+	"Recall Technologies, Inc.",
+	"ddm hopt + schuler Gmbh + Co. KG",
+	"Visioneering Systems, Inc.",
+	"XLN-t",
+	"Brunata a/s",
+	"Symx Systems, Inc.",
+	"ROBOTOUS, Inc.",
+	"Best IT World (India) Pvt. Ltd.",
+	"ActionTec Electronics, Inc",
+	"Datang Mobile Communications Equipment CO.,LTD",
+	"E-Senza Technologies GmbH",
+	"TeleWell Oy",
+	"Armadeus Systems",
+	"Wingtech Group Limited",
+	"Continental Automotive Systems",
+	"Ophir Optronics Ltd",
+	"ImesD Electronica S.L.",
+	"Cryptsoft Pty Ltd",
+	"LG innotek",
+	"Primex Wireless",
+	"UNIFAT TECHNOLOGY LTD.",
+	"Ever Sparkle Technologies Ltd",
+	"TAG Heuer SA",
+	"TBTech, Co., Ltd.",
+	"Fortis, Inc.",
+	"Sing Fai Technology Limited",
+	"High Density Devices AS",
+	"BLUELIGHT TECHNOLOGY INC.",
+	"WINTECH AUTOMATION CO.,LTD.",
+	"Haas Automation Inc.",
+	"Microchip Technology Inc.",
+	"3COM EUROPE LTD",
+	"Kozio, Inc.",
+	"Celio Corp",
+	"Middle Atlantic Products Inc",
+	"Obvius Holdings LLC",
+	"Rapid Mobile (Pty) Ltd",
+	"\"RPC \"Energoautomatika\" Ltd",
+	"CDVI",
+	"KYLAND Technology Co. LTD",
+	"BISA Technologies (Hong Kong) Limited",
+	"PHILIPS ELECTRONICS UK LTD",
+	"Ingespace",
+	"Keyprocessor B.V.",
+	"Ray Shine Video Technology Inc",
+	"Dot Technology Int'l Co., Ltd.",
+	"Doble Engineering",
+	"Tekon-Automatics",
+	"Alentec & Orion AB",
+	"H-Stream Wireless, Inc.",
+	"Digital United Inc.",
+	"Mitsubishi Precision Co.,LTd.",
+	"Wesemann Elektrotechniek B.V.",
+	"Giken Trastem Co., Ltd.",
+	"WASKO S.A.",
+	"BYD COMPANY LIMITED",
+	"Master Industrialization Center Kista",
+	"Urmet Domus SpA",
+	"T&W Electronics (ShenZhen) Co.,Ltd",
+	"ACS Solutions France",
+	"Shenzhen Advanced Video Info-Tech Co., Ltd.",
+	"Epic Systems Inc",
+	"Mytek",
+	"Stoneridge Electronics AB",
+	"Sensor Switch, Inc.",
+	"Talk-A-Phone Co.",
+	"Adventiq Ltd.",
+	"ETL Systems Ltd",
+	"Cantronic International Limited",
+	"Gigafin Networks",
+	"Servimat",
+	"Micro Motion Inc",
+	"From2",
+	"L-3 Communications Display Systems",
+	"Hitek Automated Inc.",
+	"Emfinity Inc.",
+	"Pascom Kommunikations systeme GmbH.",
+	"PROTEI Ltd.",
+	"Trio Motion Technology Ltd",
+	"JSC \"MASSA-K\"",
+	"Microbit 2.0 AB",
+	"LEVEL s.r.o.",
+	"Mueller-Elektronik GmbH & Co. KG",
+	"Pixelmetrix Corporation Pte Ltd",
+	"NUM AG",
+	"Granch Ltd.",
+	"iTAS Technology Corp.",
+	"Integrated Dispatch Solutions",
+	"AZTEQ Mobile",
+	"RISCO LTD",
+	"JASTEC CO., LTD.",
+	"Federal State Unitary Enterprise Industrial Union\"Electropribor\"",
+	"Intelligent Digital Services GmbH",
+	"L3 Communications - Telemetry West",
+	"Japan Kyastem Co., Ltd",
+	"Select Engineered Systems",
+	"TOLEDO DO BRASIL INDUSTRIA DE BALANCAS  LTDA",
+	"OPENMOKO, INC.",
+	"S.& A.S. Ltd.",
+	"NexG",
+	"Bioscrypt Inc",
+	"IDX Company, Ltd.",
+	"Hakusan.Mfg.Co,.Ltd",
+	"BEN-RI ELECTRONICA S.A.",
+	"Prominvest",
+	"RoyalTek Company Ltd.",
+	"KOBISHI ELECTRIC Co.,Ltd.",
+	"Atlas Material Testing Technology LLC",
+	"Astec Technology Co., Ltd",
+	"Edimax Technology Co. Ltd.",
+	"Inner Mongolia Yin An Science & Technology Development Co.,L",
+	"Interacoustics",
+	"DIGITVIEW TECHNOLOGY CO., LTD.",
+	"MBS GmbH",
+	"ACCM",
+	"Orange Logic",
+	"Starbridge Networks",
+	"Electro-Optical Imaging, Inc.",
+	"Triangle Research Int'l Pte Ltd",
+	"Berker GmbH & Co. KG",
+	"Travelping",
+	"Radiocomp",
+	"Lung Hwa Electronics Co., Ltd.",
+	"AIR802 LLC",
+	"Bellwin Information Co. Ltd.,",
+	"Genesis I&C",
+	"POSITRON",
+	"Construcciones y Auxiliar de Ferrocarriles, S.A.",
+	"Qbit GmbH",
+	"RP-Technik e.K.",
+	"Speakercraft Inc.",
+	"Etherstack plc",
+	"ENTES ELEKTRONIK",
+	"GE Transportation Systems",
+	"MCS Logic Inc.",
+	"Mojix Inc.",
+	"Manhattan Technology Limited",
+	"Albentia Systems S.A.",
+	"Lineage Power",
+	"Roseman Engineering Ltd",
+	"Segnetics LLC",
+	"ConMed Linvatec",
+	"Thinkware Co. Ltd.",
+	"Swissdis AG",
+	"HD Communications Corp",
+	"UVT Unternehmensberatung fur Verkehr und Technik GmbH",
+	"GEMAC Gesellschaft für Mikroelektronikanwendung Chemnitz mbH",
+	"Lorex Technology Inc.",
+	"Honeywell Security (China) Co., Ltd.",
+	"DIGITAL FORECAST",
+	"Phonik Innovation Co.,LTD",
+	"EMH Energiemesstechnik GmbH",
+	"Kronback Tracers",
+	"Beckwith Electric Co.",
+	"Dyna Technology Co.,Ltd.",
+	"Blatand GmbH",
+	"COMPASS SYSTEMS CORP.",
+	"Talent Communication Networks Inc.",
+	"JSC \"Stilsoft\"",
+	"JSC Goodwin-Europa",
+	"Beijing Autelan Technology Inc.",
+	"KOREA ELECTRIC TERMINAL CO., LTD.",
+	"PLANAR LLC",
+	"Hitachi,Ltd.",
+	"Martinsson Elektronik AB",
+	"Pingood Technology Co., Ltd.",
+	"PacketFlux Technologies, Inc.",
+	"Vtech Engineering Corporation",
+	"Fujian Sunnada Communication Co.,Ltd.",
+	"Botik Technologies LTD",
+	"xG Technology, Inc.",
+	"QingDao Hiphone Technology Co,.Ltd",
+	"Teraview Technology Co., Ltd.",
+	"Eigen Development",
+	"GiBahn Media",
+	"AirLogic Systems Inc.",
+	"HEOL DESIGN",
+	"Blue Fox Porini Textile",
+	"Lodam Electronics A/S",
+	"WiWide Inc.",
+	"TechNexion Ltd.",
+	"Witelcom AS",
+	"embedded wireless GmbH",
+	"Phabrix Limited",
+	"Lucas Holding bv",
+	"Accel Semiconductor Corp",
+	"Cal-Comp Electronics & Communications Co., Ltd",
+	"Teleplan Technology Services Sdn Bhd",
+	"Gigle Semiconductor",
+	"Apriva ISS, LLC",
+	"digEcor",
+	"Skydigital Inc.",
+	"FMS Force Measuring Systems AG",
+	"Signalion GmbH",
+	"Ellion Digital Inc.",
+	"Cache IQ",
+	"CCS Inc.",
+	"Ingenieurbuero Stark GmbH und Ko. KG",
+	"Metris USA Inc.",
+	"Shanghai Bellmann Digital Source Co.,Ltd.",
+	"DBS Lodging Technologies, LLC",
+	"VideoIQ, Inc.",
+	"Xiotech Corporation",
+	"Lascar Electronics Ltd",
+	"APROTECH CO.LTD",
+	"BERTANA SRL",
+	"DAIICHI-DENTSU LTD.",
+	"SERONICS co.ltd",
+	"POSBRO",
+	"LEDCO",
+	"A10 Networks",
+	"Gtran Inc",
+	"Datron World Communications, Inc.",
+	"T&W Electronics(Shenzhen)Co.,Ltd.",
+	"ShenZhen Gongjin Electronics Co.,Ltd",
+	"Blue-White Industries",
+	"Stilo srl",
+	"Sony Computer Entertainment Inc.",
+	"Smart Energy Instruments Inc.",
+	"Atlanta DTH, Inc.",
+	"Taseon, Inc.",
+	"I.S HIGH TECH.INC",
+	"Goodmill Systems Ltd",
+	"Brown Innovations, Inc",
+	"Blick South Africa (Pty) Ltd",
+	"NextIO, Inc.",
+	"TimeIPS, Inc.",
+	"Cybertech Inc.",
+	"Sontheim Industrie Elektronik GmbH",
+	"2Wire",
+	"SmartShare Systems",
+	"I/O Interconnect Inc.",
+	"Chi Lin Technology Co., Ltd.",
+	"WiMate Technologies Corp.",
+	"Universal Remote Control, Inc.",
+	"Paltronics",
+	"BoYoung Tech. & Marketing, Inc.",
+	"Xenatech Co.,LTD",
+	"EVGA Corporation",
+	"Shenzhen Mopnet Industrial Co.,Ltd",
+	"Fulhua Microelectronics Corp. Taiwan Branch",
+	"Control Express Finland Oy",
+	"Hanlong Technology Co.,LTD",
+	"Jow Tong Technology Co Ltd",
+	"SmartSynch, Inc",
+	"Casio Hitachi Mobile Comunications Co., Ltd.",
+	"Up-Today Industrial Co., Ltd.",
+	"NIW Solutions",
+	"QTECH LLC",
+	"MSI Technology GmbH",
+	"OPTEX CO.,LTD.",
+	"COMMTECH TECHNOLOGY MACAO COMMERCIAL OFFSHORE LTD.",
+	"RIVA Networks Inc.",
+	"4IPNET, INC.",
+	"MICRORISC s.r.o.",
+	"Shenzhen Allywll",
+	"TELERAD SA",
+	"A-TRUST COMPUTER CORPORATION",
+	"RSD Communications Ltd",
+	"Network Supply Corp.,",
+	"Mobile Safe Track Ltd",
+	"GDI LLC",
+	"EdgeVelocity Corp",
+	"In-Circuit GmbH",
+	"Alphion Corporation",
+	"Simet",
+	"KURUSUGAWA Electronics Industry Inc,.",
+	"Printrex, Inc.",
+	"Applied Media Technologies Corporation",
+	"Trio Datacom Pty Ltd",
+	"Synapse Électronique",
+	"Tecan Systems Inc.",
+	"ubisys technologies GmbH",
+	"SHINSEI INDUSTRIES CO.,LTD",
+	"Audio Partnership",
+	"Paradox Hellas S.A.",
+	"VIA Technologies, Inc.",
+	"Power Monitors, Inc.",
+	"Kongsberg Defence & Aerospace",
+	"PS Audio International",
+	"Nakajima All Precision Co., Ltd.",
+	"Siemens AG, Sector Industry, Drive Technologies, Motion Control Systems",
+	"Advanced Knowledge Associates",
+	"Coretree, Co, Ltd",
+	"Green Packet Bhd",
+	"Riccius+Sohn GmbH",
+	"Indigo Mobile Technologies Corp.",
+	"Respironics, Inc.",
+	"DSP SOLUTIONS, INC.",
+	"SERITECH ENTERPRISE CO., LTD.",
+	"PIXEL POWER LTD.",
+	"YAMATAKE-HONEYWELL CO., LTD.",
+	"SIMPLE TECHNOLOGY",
+	"GARRETT COMMUNICATIONS, INC.",
+	"SFA, INC.",
+	"CABLE & COMPUTER TECHNOLOGY",
+	"PACKARD BELL ELEC., INC.",
+	"SOURCE-COMM CORP.",
+	"OCTAGON SYSTEMS CORP.",
+	"ADASTRA SYSTEMS CORP.",
+	"CARL ZEISS",
+	"SATELLITE TECHNOLOGY MGMT, INC",
+	"TANBAC CO., LTD.",
+	"JEOL SYSTEM TECHNOLOGY CO. LTD",
+	"CANOPUS CO., LTD.",
+	"CAMTRONICS MEDICAL SYSTEMS",
+	"DIVERSIFIED TECHNOLOGY, INC.",
+	"GLOBAL VIEW CO., LTD.",
+	"ACTIS COMPUTER SA",
+	"SHOWA ELECTRIC WIRE & CABLE CO",
+	"ORBOTECH",
+	"OHLER GmbH",
+	"MRV Communications, Inc.",
+	"NORTHERN TELECOM/NETWORK",
+	"EXCEL, INC.",
+	"KATANA PRODUCTS",
+	"NETQUEST CORPORATION",
+	"BEST POWER TECHNOLOGY, INC.",
+	"MEGATRON COMPUTER INDUSTRIES PTY, LTD.",
+	"ALGORITHMS SOFTWARE PVT. LTD.",
+	"NMS Communications",
+	"T.C. TECHNOLOGIES PTY. LTD",
+	"PACIFIC COMMUNICATION SCIENCES",
+	"CONTROL TECHNOLOGY, INC.",
+	"AMKLY SYSTEMS, INC.",
+	"MING FORTUNE INDUSTRY CO., LTD",
+	"WEST EGG SYSTEMS, INC.",
+	"TELEPROCESSING PRODUCTS, INC.",
+	"N.V. DZINE",
+	"ADVANCED TELECOMMUNICATIONS MODULES, LTD.",
+	"WELLTRONIX CO., LTD.",
+	"TAIYO CORPORATION",
+	"DAYSTAR DIGITAL",
+	"ZETA COMMUNICATIONS, LTD.",
+	"ANALOG & DIGITAL SYSTEMS",
+	"ALCATEL TAISEL",
+	"SYNAPSE TECHNOLOGIES, INC.",
+	"ROTEC INDUSTRIEAUTOMATION GMBH",
+	"BMC SOFTWARE",
+	"SEAGATE TECHNOLOGY",
+	"VME MICROSYSTEMS INTERNATIONAL CORPORATION",
+	"SCINETS",
+	"DIGITAL BI0METRICS INC.",
+	"WISDM LTD.",
+	"EUROTIME AB",
+	"Honeywell ECC",
+	"LogiCan Technologies, Inc.",
+	"JUKI CORPORATION",
+	"DATA NET",
+	"DATAMETRICS CORP.",
+	"NEURON COMPANY LIMITED",
+	"GENITECH PTY LTD",
+	"ION Networks, Inc.",
+	"CIPRICO, INC.",
+	"STEINBRECHER CORP.",
+	"Marconi Communications",
+	"COMTRON, INC.",
+	"PRONET GMBH",
+	"AUTOCOMPUTER CO., LTD.",
+	"MITRON COMPUTER PTE LTD.",
+	"INOVIS GMBH",
+	"NETWORK SECURITY SYSTEMS, INC.",
+	"DEUTSCHE AEROSPACE AG",
+	"KOREA COMPUTER INC.",
+	"Verilink Corporation",
+	"RAGULA SYSTEMS",
+	"HUNTSVILLE MICROSYSTEMS, INC.",
+	"Sycamore Networks",
+	"ALTECH CO., LTD.",
+	"NEOPRODUCTS",
+	"TITZE DATENTECHNIK GmbH",
+	"ALLIED SIGNAL INC.",
+	"MIRO COMPUTER PRODUCTS AG",
+	"COMPUTER IDENTICS",
+	"Kentrox, LLC",
+	"InterNet Systems of Florida, Inc.",
+	"NANOMATIC OY",
+	"CASTLE ROCK, INC.",
+	"GAMMADATA COMPUTER GMBH",
+	"ALCATEL ITALIA S.p.A.",
+	"GarrettCom, Inc.",
+	"SCORPION LOGIC, LTD.",
+	"WIPRO INFOTECH LTD.",
+	"PROTEC MICROSYSTEMS, INC.",
+	"SUPERNET NETWORKING INC.",
+	"GENERAL MAGIC, INC.",
+	"ISDYNE",
+	"ISDN SYSTEMS CORPORATION",
+	"OSAKA COMPUTER CORP.",
+	"KONICA MINOLTA HOLDINGS, INC.",
+	"EVERGREEN TECHNOLOGY CORP.",
+	"DATA RACE, INC.",
+	"XACT, INC.",
+	"FLOWPOINT CORPORATION",
+	"HYNET, LTD.",
+	"IBR GMBH",
+	"WORKLINK INNOVATIONS",
+	"FUSION SYSTEMS CORPORATION",
+	"SUNGWOON SYSTEMS",
+	"MOTOROLA COMMUNICATION ISRAEL",
+	"REUDO CORPORATION",
+	"KARDIOS SYSTEMS CORP.",
+	"RUNTOP, INC.",
+	"MIKRON GMBH",
+	"WiSE Communications, Inc.",
+	"AUTEC GmbH",
+	"ADVANCED COMPUTER APPLICATIONS",
+	"FINECOM Co., Ltd.",
+	"KYOEI SANGYO CO., LTD.",
+	"SYNERGY (UK) LTD.",
+	"TITAN ELECTRONICS",
+	"ONEAC CORPORATION",
+	"PRESTICOM INCORPORATED",
+	"OCE PRINTING SYSTEMS, GMBH",
+	"MICROTECH ELECTRONICS LIMITED",
+	"MEMOTEC, INC.",
+	"T3PLUS NETWORKING, INC.",
+	"SONIX COMMUNICATIONS, LTD.",
+	"LAPIS TECHNOLOGIES, INC.",
+	"GALAXY NETWORKS, INC.",
+	"CMD TECHNOLOGY",
+	"CHEVIN SOFTWARE ENG. LTD.",
+	"ECI TELECOM LTD.",
+	"ADVANCED COMPRESSION TECHNOLOGY, INC.",
+	"J125, NATIONAL SECURITY AGENCY",
+	"CHESS ENGINEERING B.V.",
+	"LANDINGS TECHNOLOGY CORP.",
+	"CUBIX CORPORATION",
+	"RIVA ELECTRONICS",
+	"Invensys",
+	"APPLIED SIGNAL TECHNOLOGY",
+	"HECTRONIC AB",
+	"BON ELECTRIC CO., LTD.",
+	"THE 3DO COMPANY",
+	"ERSAT ELECTRONIC GMBH",
+	"PRIMARY ACCESS CORP.",
+	"LIPPERT AUTOMATIONSTECHNIK",
+	"BROWN'S OPERATING SYSTEM SERVICES, LTD.",
+	"MERCURY COMPUTER SYSTEMS, INC.",
+	"OA LABORATORY CO., LTD.",
+	"DOVATRON",
+	"GALCOM NETWORKING LTD.",
+	"MULTIPOINT NETWORKS",
+	"API ENGINEERING",
+	"Proxim Wireless",
+	"PAIRGAIN TECHNOLOGIES, INC.",
+	"SAST TECHNOLOGY CORP.",
+	"WHITE HORSE INDUSTRIAL",
+	"Ericsson Television Limited",
+	"MICRO INDUSTRIES CORP.",
+	"INTERFLEX DATENSYSTEME GMBH",
+	"LINQ SYSTEMS",
+	"ORNET DATA COMMUNICATION TECH.",
+	"GATEWAY DEVICES, INC.",
+	"COMTECH RESEARCH INC.",
+	"GKD Gesellschaft Fur Kommunikation Und Datentechnik",
+	"TERMA ELEKTRONIK AS",
+	"YASKAWA ELECTRIC CORPORATION",
+	"AGILE NETWORKS, INC.",
+	"NAMAQUA COMPUTERWARE",
+	"PRIME OPTION, INC.",
+	"METRICOM, INC.",
+	"CENTER FOR HIGH PERFORMANCE",
+	"ZAX CORPORATION",
+	"Long Reach Networks Pty Ltd",
+	"NIOBRARA R & D CORPORATION",
+	"LAN ACCESS CORP.",
+	"AEHR TEST SYSTEMS",
+	"PULSE ELECTRONICS, INC.",
+	"TEXAS MEMORY SYSTEMS, INC.",
+	"COUNTER SOLUTIONS LTD.",
+	"INET,INC.",
+	"EAGLE TECHNOLOGY",
+	"NECTEC",
+	"AKAI Professional M.I. Corp.",
+	"LARSCOM INCORPORATED",
+	"VICTRON BV",
+	"DIGITAL OCEAN",
+	"PRETEC ELECTRONICS CORP.",
+	"DIGITAL SERVICES, LTD.",
+	"HYBRID NETWORKS, INC.",
+	"LOGICAL DESIGN GROUP, INC.",
+	"TEST & MEASUREMENT SYSTEMS INC",
+	"VERSALYNX CORPORATION",
+	"MICROCOMPUTER SYSTEMS (M) SDN.",
+	"RAD DATA COMMUNICATIONS, LTD.",
+	"OST (OUEST STANDARD TELEMATIQU",
+	"CABLETRON - ZEITTNET INC.",
+	"VIPA GMBH",
+	"BREEZECOM",
+	"JAPAN MINICOMPUTER SYSTEMS CO., Ltd.",
+	"PANASONIC TECHNOLOGIES, INC./MIECO-US",
+	"Alcatel North America ESD",
+	"XNET TECHNOLOGY, INC.",
+	"DENSITRON TAIWAN LTD.",
+	"Cybertec Pty Ltd",
+	"JAPAN DIGITAL LABORAT'Y CO.LTD",
+	"KYOSAN ELECTRIC MFG. CO., LTD.",
+	"Actiontec Electronics, Inc.",
+	"ALAMAR ELECTRONICS",
+	"INFORMATION RESOURCE ENGINEERING",
+	"MCD KENCOM CORPORATION",
+	"HSING TECH ENTERPRISE CO., LTD",
+	"APEX DATA, INC.",
+	"LIDKOPING MACHINE TOOLS AB",
+	"B&W NUCLEAR SERVICE COMPANY",
+	"DATATREK CORPORATION",
+	"DANTEL",
+	"EFFICIENT NETWORKS, INC.",
+	"CINCINNATI MICROWAVE, INC.",
+	"TECHWARE SYSTEMS CORP.",
+	"GIGA-BYTE TECHNOLOGY CO., LTD.",
+	"GTECH CORPORATION",
+	"USC CORPORATION",
+	"UNIVERSAL MICROELECTRONICS CO.",
+	"ALTOS INDIA LIMITED",
+	"RAYNET CORPORATION",
+	"SPECTRIX CORPORATION",
+	"PANDATEL AG",
+	"NET TEK  AND KARLNET, INC.",
+	"CYBERDATA CORPORATION",
+	"CARRERA COMPUTERS, INC.",
+	"PARALINK NETWORKS, INC.",
+	"GDE SYSTEMS, INC.",
+	"OCTEL COMMUNICATIONS CORP.",
+	"MATROX",
+	"ITV TECHNOLOGIES, INC.",
+	"TOPWARE INC. / GRAND COMPUTER",
+	"SYMMETRICAL TECHNOLOGIES",
+	"GemTek Technology Co., Ltd.",
+	"Aplicaciones Electronicas Quasar (AEQ)",
+	"UpdateLogic Inc.",
+	"GHI Electronics, LLC",
+	"Gigaset Communications GmbH",
+	"RIM Testing Services",
+	"Seowonintech Co Ltd.",
+	"byd:sign Corporation",
+	"GEMINI TRAZE RFID PVT. LTD.",
+	"Cymtec Systems, Inc.",
+	"SAMSIN INNOTEC",
+	"Orpak Systems L.T.D.",
+	"Cernium Corp",
+	"Clearbox Systems",
+	"Uniphone Inc.",
+	"WISCOM SYSTEM CO.,LTD",
+	"Padtec S/A",
+	"Hylab Technology Inc.",
+	"PHYWE Systeme GmbH & Co. KG",
+	"Transcon Electronic Systems, spol. s r. o.",
+	"Tellord",
+	"Athena Tech, Inc.",
+	"Samsung Electro-Mechanics",
+	"LInTech Corporation",
+	"Dataline AB",
+	"SHINSUNG DELTATECH CO.,LTD.",
+	"Sequel Technologies",
+	"VRmagic GmbH",
+	"Chip-pro Ltd.",
+	"Aerosat Avionics",
+	"Optos Plc",
+	"KUK JE TONG SHIN Co.,LTD",
+	"Shenzhen Torch Equipment Co., Ltd.",
+	"TP-LINK Technology Co., Ltd.",
+	"Audiovox Corporation",
+	"MSA Auer",
+	"SemIndia System Private Limited",
+	"SCIMOLEX CORPORATION",
+	"dresden-elektronik",
+	"Phoebe Micro Inc.",
+	"Keico Hightech Inc.",
+	"Blynke Inc.",
+	"Masterclock, Inc.",
+	"Building B, Inc",
+	"Brandywine Communications",
+	"ALCATEL-LUCENT",
+	"Bay Controls, LLC",
+	"Cepheid",
+	"Escherlogic Inc.",
+	"Winchester Systems Inc.",
+	"Berkshire Products, Inc",
+	"AliphCom",
+	"Cermetek Microelectronics, Inc.",
+	"A-Team Technology Ltd.",
+	"EN Technologies Inc.",
+	"RADLIVE",
+	"Advanced Control Systems doo",
+	"SS Telecoms",
+	"Semptian Technologies Ltd.",
+	"Kaco Solar Korea",
+	"China Daheng Group ,Inc.",
+	"Pixel Velocity, Inc",
+	"Shenzhen HAMP Science & Technology Co.,Ltd",
+	"Guangzhou Skytone Transmission Technology Com. Ltd.",
+	"GS Yuasa Power Supply Ltd.",
+	"ALPS Electric Co., Ltd",
+	"EYEVIEW ELECTRONICS",
+	"Millinet Co., Ltd.",
+	"General Satellite Research & Development Limited",
+	"SeaMicro Inc.",
+	"D-TACQ Solutions Ltd",
+	"National Datacast, Inc.",
+	"Style Flying Technology Co.",
+	"Inotive",
+	"IHSE GmbH",
+	"Hidea Solutions Co. Ltd.",
+	"Yournet Inc.",
+	"ASKEY COMPUTER CORP",
+	"Special Design Bureau for Seismic Instrumentation",
+	"Presstek Inc.",
+	"NovAtel Inc.",
+	"HWA JIN T&I Corp.",
+	"iVeia, LLC",
+	"Prologix, LLC.",
+	"ODVA",
+	"Soltech Co., Ltd.",
+	"Function ATI (Huizhou) Telecommunications Co., Ltd.",
+	"SymCom, Inc.",
+	"Wesung TNC Co., Ltd.",
+	"Seoultek Valley",
+	"Ion Torrent Systems, Inc.",
+	"AvaLAN Wireless",
+	"Pacific Satellite International Ltd.",
+	"YMax Telecom Ltd.",
+	"W. L. Gore & Associates",
+	"Matuschek Messtechnik GmbH",
+	"IOGEAR, Inc.",
+	"Sejin Electron, Inc.",
+	"Bastec AB",
+	"PYXIS S.R.L.",
+	"Telit Communication s.p.a",
+	"Intraco Technology Pte Ltd",
+	"Si2 Microsystems Limited",
+	"SandLinks Systems, Ltd.",
+	"VATECH HYDRO",
+	"POWERSOFT SRL",
+	"Universal Global Scientific Industrial Co., Ltd",
+	"Imacs GmbH",
+	"AppTech, Inc.",
+	"Electronic Design and Manufacturing Company",
+	"Wescon Technology, Inc.",
+	"TopControl GMBH",
+	"AP Router Ind. Eletronica LTDA",
+	"MEKICS CO., LTD.",
+	"Avantgarde Acoustic Lautsprechersysteme GmbH",
+	"Goliath Solutions",
+	"Baoding Galaxy Electronic Technology  Co.,Ltd",
+	"Videofon MV",
+	"Ping Communication",
+	"GWD Media Limited",
+	"Telsey  S.p.A.",
+	"ELITEGROUP COMPUTER SYSTEM",
+	"Thai Radio Co, LTD",
+	"Vacon Plc",
+	"Cambridge Visual Networks Ltd",
+	"Honeywld Technology Corp.",
+	"Adesys BV",
+	"SATEL OY",
+	"EKE-Electronics Ltd.",
+	"Micromint",
+	"Dbii Networks",
+	"ERLPhase Power Technologies Ltd.",
+	"Videotec Spa",
+	"Hantle System Co., Ltd.",
+	"Telephonics Corporation",
+	"Mobilink Telecom Co.,Ltd",
+	"Infrared Integrated Systems Ltd",
+	"Nordic ID Oy",
+	"ALCATEL-LUCENT FRANCE - WTD",
+	"Radio Frequency Systems",
+	"Tyco Telecommunications",
+	"DIGITAL SOLUTIONS LTD",
+	"Fiberblaze A/S",
+	"Ross Controls",
+	"APRO MEDIA CO., LTD",
+	"Galvanic Ltd",
+	"Triacta Power Technologies Inc.",
+	"Lexmark International Inc.",
+	"Inphi Corporation",
+	"Universal Devices Inc.",
+	"Riken Keiki Co., Ltd.",
+	"ZALA COMPUTER",
+	"Cisco, Service Provider Video Technology Group",
+	"Hitachi High-Tech Control Systems Corporation",
+	"Mobile Appliance, Inc.",
+	"ABB Oy / Medium Voltage Products",
+	"GL Communications Inc",
+	"CORNELL Communications, Inc.",
+	"Consilium AB",
+	"3DSP Corp",
+	"CSJ Global, Inc.",
+	"Russound",
+	"LOHUIS Networks",
+	"Wavecom Asia Pacific Limited",
+	"ART System Co., Ltd.",
+	"SMS TECNOLOGIA ELETRONICA LTDA",
+	"Flextronics International",
+	"LiveTV",
+	"NTC-Metrotek",
+	"The Crypto Group",
+	"Global Display Solutions Spa",
+	"BOCOM SECURITY(ASIA PACIFIC) LIMITED",
+	"Vollmer Werke GmbH",
+	"X2E GmbH",
+	"LXI Consortium",
+	"SEKONIC CORPORATION",
+	"Automation Products Group Inc.",
+	"Santachi Video Technology (Shenzhen) Co., Ltd.",
+	"TECNOALARM S.r.l.",
+	"Northstar Systems Corp",
+	"Firepro Wireless",
+	"Martin Christ GmbH",
+	"CommAgility Ltd",
+	"Creative Electronic GmbH",
+	"SerialTek LLC",
+	"I-WIN",
+	"Display Solution AG",
+	"Starlight Video Limited",
+	"Informatics Services Corporation",
+	"Bystronic Laser AG",
+	"ESP SYSTEMS, LLC",
+	"Solutronic GmbH",
+	"Telegesis",
+	"Full Spectrum Inc.",
+	"Kapsys",
+	"EW3 Technologies LLC",
+	"Tutus Data AB",
+	"EASY3CALL Technology Limited",
+	"Si14 SpA",
+	"INRange Systems, Inc",
+	"Western Engravers Supply, Inc.",
+	"Enseo, Inc.",
+	"WIRECOM Technologies",
+	"A4SP Technologies Ltd.",
+	"DSTA S.L.",
+	"Cyfrowy Polsat SA",
+	"Aksys Networks Inc",
+	"Excito Elektronik i Skåne AB",
+	"Glensound Electronics Ltd",
+	"KORATEK",
+	"WeLink Solutions, Inc.",
+	"Cyberdyne Inc.",
+	"Inteno Broadband Technology AB",
+	"Certicom Corp",
+	"Omron Healthcare Co., Ltd",
+	"OnLive, Inc",
+	"National Source Coding Center",
+	"Indigo Security Co., Ltd.",
+	"MoCA (Multimedia over Coax Alliance)",
+	"Rohati Systems",
+	"CAI Networks, Inc.",
+	"PCI CORPORATION",
+	"RINNAI KOREA",
+	"SHIBAURA VENDING MACHINE CORPORATION",
+	"Neat Electronics",
+	"Verivue Inc.",
+	"Audio Precision",
+	"Morega Systems",
+	"Freegene Technology LTD",
+	"Media Devices Co., Ltd.",
+	"eSang Technologies Co., Ltd.",
+	"Mitac Technology Corp",
+	"ITOH DENKI CO,LTD.",
+	"Schaffner Deutschland GmbH",
+	"TimeKeeping Systems, Inc.",
+	"Good Will Instrument Co., Ltd.",
+	"Thales Avionics Ltd",
+	"Avaak, Inc.",
+	"uv-electronic GmbH",
+	"Breeze Innovations Ltd.",
+	"Compumedics Ltd",
+	"SoundEar A/S",
+	"Nucomm, Inc.",
+	"Ceton Corp",
+	"SMC Networks Inc.",
+	"maintech GmbH",
+	"Open Grid Computing, Inc.",
+	"FutureLogic Inc.",
+	"SMT&C Co., Ltd.",
+	"Design Design Technology Ltd",
+	"Corventis Inc.",
+	"Strukton Systems bv",
+	"VECTOR SP. Z O.O.",
+	"Shinhint Group",
+	"LOGIPLUS",
+	"Indiana Life Sciences Incorporated",
+	"Scientific Atlanta, Cisco SPVT Group",
+	"Communication Networks, LLC",
+	"RATIO Entwicklungen GmbH",
+	"JumpGen Systems, LLC",
+	"IRTrans GmbH",
+	"Universal Telecom S/A",
+	"Alacron Inc.",
+	"Chengdu Linkon Communications Device Co., Ltd",
+	"Leine & Linde AB",
+	"Evoc Intelligent Technology Co.,Ltd.",
+	"DAC ENGINEERING CO., LTD.",
+	"HOME MULTIENERGY SL",
+	"RAYLASE AG",
+	"AIRTECH TECHNOLOGIES, INC.",
+	"MITAC INTERNATIONAL CORP.",
+	"SEEnergy Corp.",
+	"Byzoro Networks Ltd.",
+	"Point Six Wireless, LLC",
+	"Lumasense Technologies",
+	"ZOLL Lifecor Corporation",
+	"Entorian Technologies",
+	"Bigelow Aerospace",
+	"3Com Europe Ltd",
+	"Guangzhou New Postcom Equipment Co.,Ltd.",
+	"Garde Security AB",
+	"Teradici Corporation",
+	"Multimedia & Communication Technology",
+	"Digicable Network India Pvt. Ltd.",
+	"Uwin Technologies Co.,LTD",
+	"Liteon Technology Corporation",
+	"AFREEY Inc.",
+	"Frontier Silicon Ltd",
+	"BEP Marine",
+	"Koos Technical Services, Inc.",
+	"Honeywell",
+	"LinkSprite Technologies, Inc.",
+	"Shenzhen GIEC Electronics Co., Ltd.",
+	"Gowell Electronic Limited",
+	"3onedata Technology Co. Ltd.",
+	"ABK North America, LLC",
+	"Jäger Computergesteuerte Meßtechnik GmbH.",
+	"American Micro-Fuel Device Corp.",
+	"Techway",
+	"FamilyPhone AB",
+	"Triple EYE B.V.",
+	"NEC Australia Pty Ltd",
+	"Shenzhen  Tongfang Multimedia  Technology Co.,Ltd.",
+	"Nippon Conlux Co., Ltd.",
+	"Apogee Labs, Inc.",
+	"Woori SMT Co.,ltd",
+	"YE DATA INC.",
+	"Chengdu 30Kaitian Communication Industry Co.Ltd",
+	"A2B Electronics AB",
+	"Daintree Networks Pty",
+	"8086 Consultancy",
+	"DESAY A&V SCIENCE AND TECHNOLOGY CO.,LTD",
+	"NOMUS COMM SYSTEMS",
+	"ASTRON",
+	"Titan Wireless LLC",
+	"Sagrad, Inc.",
+	"Optosecurity Inc.",
+	"Teratronik elektronische systeme gmbh",
+	"Kensington Computer Products Group",
+	"Photon Europe GmbH",
+	"GBS Laboratories LLC",
+	"TV-NUMERIC",
+	"CNRS",
+	"Cinetal",
+	"Kyocera Corporation",
+	"SGM Technology for lighting spa",
+	"LinoWave Corporation",
+	"XMOS Semiconductor",
+	"Lastar, Inc.",
+	"AverLogic Technologies, Inc.",
+	"Verismo Networks Inc",
+	"PYUNG-HWA IND.CO.,LTD",
+	"Social Aid Research Co., Ltd.",
+	"Sensys Traffic AB",
+	"Delphi Corporation",
+	"Huawei Symantec Technologies Co.,Ltd.",
+	"Xtramus Technologies",
+	"California Eastern Laboratories",
+	"Sony Computer Entertainment America",
+	"Tyco Electronics AMP GmbH",
+	"Ouman Oy",
+	"Shenzhen Turbosight Technology Ltd",
+	"Hangzhou Siyuan Tech. Co., Ltd",
+	"TELESIS TECHNOLOGIES, INC.",
+	"Mattel Inc.",
+	"Safety Vision",
+	"Elbit Systems",
+	"4RF Communications Ltd",
+	"Sei S.p.A.",
+	"NOVITA",
+	"Superflow Technologies Group",
+	"GSS Grundig SAT-Systems GmbH",
+	"Norcott",
+	"Analogix Seminconductor, Inc",
+	"HUTH Elektronik Systeme GmbH",
+	"beyerdynamic GmbH & Co. KG",
+	"JDSU France SAS",
+	"SieAmp Group of Companies",
+	"Shenzhen Forcelink Electronic Co, Ltd",
+	"Active Storage Inc.",
+	"Proview Eletrônica do Brasil LTDA",
+	"Zeeport Technology Inc.",
+	"epro GmbH",
+	"INFORSON Co,Ltd.",
+	"Sutus Inc",
+	"SEGGER Microcontroller GmbH & Co. KG",
+	"Applied Instruments B.V.",
+	"Lenord, Bauer & Co GmbH",
+	"Anviz Biometric Tech. Co., Ltd.",
+	"IONODES Inc.",
+	"SciLog, Inc.",
+	"Ared Technology Co., Ltd.",
+	"PLANEX Communications INC",
+	"Polar Electro Oy",
+	"Albrecht Jung GmbH & Co. KG",
+	"All Earth Comércio de Eletrônicos LTDA.",
+	"Hub-Tech",
+	"ComWorth Co., Ltd.",
+	"Eaton Corp. Electrical Group Data Center Solutions - Pulizzi",
+	"Cypak AB",
+	"Shenzhen GST Security and Safety Technology Limited",
+	"Fortex Industrial Ltd.",
+	"ANATEK, LLC",
+	"Translogic Corporation",
+	"Vigil Health Solutions Inc.",
+	"Protecta Electronics Ltd",
+	"OPPO Digital, Inc.",
+	"TAMUZ Monitors",
+	"Atlantic Software Technologies S.r.L.",
+	"ZORT Labs, LLC.",
+	"WABTEC Transit Division",
+	"Amerigon",
+	"APASS TECHNOLOGY CO., LTD.",
+	"Fisher-Rosemount Systems Inc.",
+	"Intelligent Data",
+	"WPS Parking Systems",
+	"Applition Co., Ltd.",
+	"ProVision Communications",
+	"Rustelcom Inc.",
+	"Data Respons A/S",
+	"IDEALBT TECHNOLOGY CORPORATION",
+	"TSI Power Corporation",
+	"Algo Communication Products Ltd",
+	"iWDL Technologies",
+	"3 Greens Aviation Limited",
+	"SunPower Corp",
+	"AMPAK Technology, Inc.",
+	"Advanced Realtime Tracking GmbH",
+	"Syracuse Research Corporation",
+	"Conceptronic",
+	"PIMA Electronic Systems Ltd.",
+	"Pollin Electronic GmbH",
+	"Advanced Illumination",
+	"Cayee Computer Ltd.",
+	"Witron Technology Limited",
+	"Cobalt Digital, Inc.",
+	"LITE-ON IT Corporation",
+	"FUTURE INNOVATION TECH CO.,LTD",
+	"Janam Technologies LLC",
+	"ARBURG GmbH & Co KG",
+	"CLOVER ELECTRONICS CO.,LTD.",
+	"Gorba AG",
+	"Hirsch Electronics Corporation",
+	"LNC Technology Co., Ltd.",
+	"Gloscom Co., Ltd.",
+	"Qool Technologies Ltd.",
+	"KISAN ELECTRONICS CO",
+	"Lasercraft Inc",
+	"Sielox LLC",
+	"ITF Co., Ltd.",
+	"Danaher Motion - Kollmorgen",
+	"Fourier Systems Ltd.",
+	"Deltacom Electronics Ltd",
+	"Cezzer Multimedia Technologies",
+	"Guangda Electronic & Telecommunication Technology Development Co., Ltd.",
+	"Nicira Networks",
+	"Avitech International Corp",
+	"KISS Teknical Solutions, Inc.",
+	"Zylin AS",
+	"IOLAN Holding",
+	"Fujitsu Limited",
+	"Shouyo Electronics CO., LTD",
+	"ALCON TELECOMMUNICATIONS CO., LTD.",
+	"DDRdrive LLC",
+	"eonas IT-Beratung und -Entwicklung GmbH",
+	"IRD A/S",
+	"Senticare",
+	"SandForce",
+	"Kedah Electronics Engineering, LLC",
+	"Advanced Card Systems Ltd.",
+	"DIZIPIA, INC.",
+	"Linkflex Co.,Ltd",
+	"METEL s.r.o.",
+	"Global Star Solutions ULC",
+	"OJ-Electronics A/S",
+	"C-Matic Systems Ltd",
+	"Alflex",
+	"Novero holding B.V.",
+	"Alcatel-Lucent-IPD",
+	"Purechoice Inc",
+	"MiX Telematics",
+	"Coffee Equipment Company",
+	"TEM AG",
+	"Objective Interface Systems, Inc.",
+	"Vestac",
+	"Helmholtz Centre Berlin for Material and Energy",
+	"Inyuan Technology Inc.",
+	"KTC AB",
+	"Luminous Power Technologies Pvt. Ltd.",
+	"LynTec",
+	"DATASENSOR S.p.A.",
+	"F E T Elettronica snc",
+	"Kinco Automation(Shanghai) Ltd.",
+	"Packet Forensics LLC",
+	"Pitronot Technologies and Engineering P.T.E. Ltd.",
+	"SYSTEL SA",
+	"Benchmark Electronics ( Thailand ) Public Company Limited",
+	"COMPAL INFORMATION (KUNSHAN) CO., Ltd.",
+	"Gulfstream",
+	"Aprius, Inc.",
+	"Silicon Micro Sensors GmbH",
+	"Lookit Technology Co., Ltd",
+	"Unigen Corporation",
+	"Goldline Controls",
+	"Zhuhai RaySharp Technology Co., Ltd.",
+	"Power Instruments Pte Ltd",
+	"ELKA-Elektronik GmbH",
+	"Beijing Siasun Electronic System Co.,Ltd.",
+	"UniControls a.s.",
+	"SmartRG Inc",
+	"Xembedded, Inc.",
+	"ResMed Ltd",
+	"Burster GmbH & Co KG",
+	"DAQ System",
+	"SOAM Systel",
+	"MORE STAR INDUSTRIAL GROUP LIMITED",
+	"GridIron Systems, Inc.",
+	"Isotek Electronics Ltd",
+	"GN Netcom A/S",
+	"Union Business Machines Co. Ltd.",
+	"RIM",
+	"WHDI LLC",
+	"NEOTION",
+	"ELSTER GMBH",
+	"Nanoteq",
+	"Lengda Technology(Xiamen) Co.,Ltd.",
+	"Lih Rong Electronic Enterprise Co., Ltd.",
+	"InMage Systems Inc",
+	"GGH Engineering s.r.l.",
+	"ANTIPODE",
+	"Tour & Andersson AB",
+	"ThinkFlood, Inc.",
+	"V.T. Telematica S.p.a.",
+	"HANGZHOU H3C Technologies Co., Ltd.",
+	"Ciena Corporation",
+	"Techno Design Co., Ltd.",
+	"Pirelli Tyre S.p.A.",
+	"NIDEC COPAL CORPORATION",
+	"Algolware Corporation",
+	"Maxian",
+	"Proteus Industries Inc.",
+	"AJINEXTEK",
+	"Samjeon",
+	"ANDES TECHNOLOGY CORPORATION",
+	"Westell Technologies Inc.",
+	"Vutlan sro",
+	"VD Division, Samsung Electronics Co.",
+	"EasyData Hardware GmbH",
+	"Elster Solutions, LLC",
+	"Mapower Electronics Co., Ltd",
+	"Jiangsu Lemote Technology Corporation Limited",
+	"Institut für Prüftechnik",
+	"Hana CNS Co., LTD.",
+	"Trend Electronics Ltd",
+	"New Concepts Development Corp.",
+	"SageTV, LLC",
+	"E-Mon",
+	"Redpine Signals, Inc.",
+	"Marshall Electronics",
+	"Beijing Detianquan Electromechanical Equipment Co., Ltd",
+	"HFR, Inc.",
+	"Xmark Corporation",
+	"COMXION Technology Inc.",
+	"Longcheer Technology (Singapore) Pte Ltd",
+	"Intelligent Mechatronic Systems Inc",
+	"Lyyn AB",
+	"ORTANA LTD",
+	"SECURITE COMMUNICATIONS / HONEYWELL",
+	"Q-Light Co., Ltd.",
+	"Sichuan Jiuzhou Electronic Technology Co.,Ltd",
+	"EADS Deutschland GmbH",
+	"Chroma",
+	"Schmitt Industries",
+	"EQ-SYS GmbH",
+	"Digital Ally, Inc.",
+	"Mainpine, Inc.",
+	"Broadway Networks",
+	"Securitas Direct AB",
+	"SAMSUNG Electronics. Co. LTD",
+	"LogMeIn, Inc.",
+	"Lux Lumen",
+	"Radiation Safety and Control Services Inc",
+	"SMC Corporation",
+	"AVSystem",
+	"TEAM-R",
+	"Sichuan Tianyi Information Science & Technology Stock CO.,LTD",
+	"Behind The Set, LLC",
+	"Shenzhen Full-join Technology Co.,Ltd",
+	"TP-LINK TECHNOLOGIES CO., LTD.",
+	"KITA DENSHI CORPORATION",
+	"CUMMINS-ALLISON CORP.",
+	"Uniloc USA Inc.",
+	"TRG",
+	"Inhand Electronics, Inc.",
+	"AirLink WiFi Networking Corp.",
+	"WAREMA electronic GmbH",
+	"Samsung Electronics Co.,LTD",
+	"Ball-It Oy",
+	"Banner Engineering",
+	"Industrial Computer Source (Deutschland)GmbH",
+	"saxnet gmbh",
+	"Benein, Inc",
+	"ELGIN S.A.",
+	"Ansync Inc.",
+	"INO Therapeutics LLC",
+	"Cavena Image Products AB",
+	"SEA Signalisation",
+	"Microtronic AG",
+	"IPnect co. ltd.",
+	"IPaXiom Networks",
+	"Pirkus, Inc.",
+	"Hinke A/S",
+	"Demco Corp.",
+	"Algorithmix GmbH",
+	"Zuend Systemtechnik AG",
+	"Shanghai Jinghan Weighing Apparatus Co. Ltd.",
+	"TVLogic",
+	"Glocom, Inc.",
+	"Masternaut",
+	"WILO SE",
+	"Softwell Technology Co., Ltd.",
+	"Double-Take Software, INC.",
+	"RG Nets, Inc.",
+	"IP Datatel, LLC.",
+	"Ultra Stereo Labs, Inc",
+	"AFT Atlas Fahrzeugtechnik GmbH",
+	"Biodevices, SA",
+	"Beijing HTTC Technology Ltd.",
+	"Op-Tection GmbH",
+	"Dilog Nordic AB",
+	"Pointmobile",
+	"TELEM SAS",
+	"Pacific Biosciences",
+	"The Toro Company",
+	"US Beverage Net",
+	"Virtual Computer Inc.",
+	"DELEC GmbH",
+	"OnePath Networks LTD.",
+	"Inventec Besta Co., Ltd.",
+	"Ishii Tool & Engineering Corporation",
+	"NUETEQ Technology,Inc.",
+	"PharmaSmart LLC",
+	"Benign Technologies Co, Ltd.",
+	"Magnetic Autocontrol GmbH",
+	"Any Use",
+	"Nextwave Semiconductor",
+	"Red Beetle Inc.",
+	"iWOW Communications Pte Ltd",
+	"FuGang Electronic (DG) Co.,Ltd",
+	"DCT-Delta GmbH",
+	"NetUP Inc.",
+	"MICRO-STAR INT'L CO., LTD.",
+	"Knapp Logistik Automation GmbH",
+	"AzureWave Technologies (Shanghai) Inc.",
+	"Axis Network Technology",
+	"Shenzhenshi chuangzhicheng Technology Co.,Ltd",
+	"NOHMI BOSAI LTD.",
+	"SSI COMPUTER CORP",
+	"EnergyICT",
+	"MK MASTER INC.",
+	"Hittite Microwave Corporation",
+	"Datastrip Inc.",
+	"Micron",
+	"Ruby Tech Corp.",
+	"Uni-v co.,ltd",
+	"Neostar Technology Co.,LTD",
+	"Lectrosonics, Inc.",
+	"WIDE CORPORATION",
+	"Motorola - BSG",
+	"Digital Barriers Advanced Technologies",
+	"Ludl Electronic Products",
+	"CSSI (S) Pte Ltd",
+	"S.A.A.A.",
+	"Emerson Appliance Motors and Controls",
+	"Storwize, Inc.",
+	"Halo Monitoring, Inc.",
+	"Wanzl Metallwarenfabrik GmbH",
+	"Axona Limited",
+	"CommScope Canada Inc.",
+	"MMB Research Inc.",
+	"Kaztek Systems",
+	"SpiderCloud Wireless, Inc",
+	"Shen Zhen Lite Star Electronics Technology Co., Ltd",
+	"Voyant International",
+	"PERCEPTRON INC",
+	"Solartron Metrology Ltd",
+	"Hokkaido Electronics Corporation",
+	"RadChips, Inc.",
+	"Asantron Technologies Ltd.",
+	"Silicon Software GmbH",
+	"Initra d.o.o.",
+	"MuLogic BV",
+	"PA Bastion CC",
+	"Nanjing Panda Electronics Company Limited",
+	"RAIDON TECHNOLOGY, INC.",
+	"Design-Com Technologies Pty. Ltd.",
+	"Terberg besturingstechniek B.V.",
+	"Hivision Co.,ltd",
+	"Vine Telecom CO.,Ltd.",
+	"Giaval Science Development Co. Ltd.",
+	"Shin Wang Tech.",
+	"Rayzone Corporation",
+	"Phybridge Inc",
+	"Bridge Technologies Co AS",
+	"Elentec",
+	"Unitron nv",
+	"AOC International (Europe) GmbH",
+	"Sumavision Technologies Co.,Ltd",
+	"Smart Doorphones",
+	"Solid Year Co., Ltd.",
+	"Covia, Inc.",
+	"ARUBA NETWORKS, INC.",
+	"Weinzierl Engineering GmbH",
+	"Phihong USA Corp.",
+	"Onda Communication spa",
+	"AUROTECH ultrasound AS.",
+	"Fusion MultiSystems dba Fusion-io",
+	"ReDriven Power Inc.",
+	"Autronica Fire And Securirty",
+	"Compass System(Embedded Dept.)",
+	"TAP.tv",
+	"Tibbo Technology",
+	"Mag Tech Electronics Co Limited",
+	"Optec Displays, Inc.",
+	"FU YI CHENG Technology Co., Ltd.",
+	"Meteocontrol GmbH",
+	"Bang and Olufsen Medicom a/s",
+	"ConteXtream Ltd",
+	"DesignArt Networks",
+	"Blackboard Inc.",
+	"Centre For Development Of Telematics",
+	"Vodafone Omnitel N.V.",
+	"Kaga Electronics Co., Ltd.",
+	"HYBUS CO., LTD.",
+	"Infoware ZRt.",
+	"DO-MONIX",
+	"Motorola, Broadband Solutions Group",
+	"Shenzhen Baoxin Tech CO., Ltd.",
+	"Ginzinger electronic systems",
+	"Aquila Technologies",
+	"Beijing Zhongchuang Telecommunication Test Co., Ltd.",
+	"Action Star Enterprise Co., Ltd.",
+	"Bimeng Comunication System Co. Ltd",
+	"NES Technology Inc.",
+	"ADC-Elektronik GmbH",
+	"Hong Kong Middleware Technology Limited",
+	"Sonim Technologies Inc",
+	"Siklu Communication",
+	"TELESTAR DIGITAL GmbH",
+	"Advanced Video Communications Inc.",
+	"Ag Leader Technology",
+	"Dycor Technologies Ltd.",
+	"A7 Engineering, Inc.",
+	"Hangzhou DPtech Technologies Co., Ltd.",
+	"Adolf Thies Gmbh & Co. KG",
+	"Morpho",
+	"EchoStar Technologies",
+	"ESAB AB",
+	"Coulomb Technologies",
+	"Netgear",
+	"Graf-Syteco GmbH & Co. KG",
+	"ESCATRONIC GmbH",
+	"GridPoint, Inc.",
+	"free alliance sdn bhd",
+	"Wuhan Higheasy Electronic Technology Development Co.Ltd",
+	"CENTRAL Corporation",
+	"HuRob Co.,Ltd",
+	"Hainzl Industriesysteme GmbH",
+	"CIAT",
+	"NTI COMODO INC",
+	"Asumo Co.,Ltd.",
+	"Meridian Audio Limited",
+	"Hager Electro SAS",
+	"Mobilarm Ltd",
+	"Broadband Solutions Group",
+	"Tobii Technology AB",
+	"Autonet Mobile",
+	"Fascinations Toys and Gifts, Inc.",
+	"Willow Garage, Inc.",
+	"Exeltech Inc",
+	"Inscape Data Corporation",
+	"Shenzhen SOGOOD Industry CO.,LTD.",
+	"Askey Computer",
+	"QUALICA Inc.",
+	"FREEBOX SA",
+	"Winward Industrial Limited",
+	"IlSung Precision",
+	"BICOM, Inc.",
+	"Innovar Systems Limited",
+	"Alcohol Monitoring Systems",
+	"Centrak, Inc.",
+	"GLOBAL Technology Inc.",
+	"Digitalbox Europe GmbH",
+	"DS Tech, LLC",
+	"Convey Computer Corp.",
+	"HASEGAWA ELECTRIC CO.,LTD.",
+	"CAO Group",
+	"Withings",
+	"Seer Technology, Inc",
+	"In Motion Technology Inc.",
+	"Plaster Networks",
+	"Samsung Electronics Co., Ltd., Storage System Division",
+	"iris-GmbH infrared & intelligent sensors",
+	"ClearPath Networks, Inc.",
+	"United Information Technology Co.,Ltd.",
+	"YT Elec. Co,.Ltd.",
+	"Wynmax Inc.",
+	"Seanodes",
+	"Shenzhen Fanhai Sanjiang Electronics Co., Ltd.",
+	"Uniphone Telecommunication Co., Ltd.",
+	"Kaminario Technologies Ltd.",
+	"NDS Surgical Imaging",
+	"MIYOSHI ELECTRONICS CORPORATION",
+	"Technical Solutions Company Ltd.",
+	"Hilger u. Kern GMBH",
+	"QuoPin Co., Ltd.",
+	"Accedian Networks Inc",
+	"JSC \"Supertel\"",
+	"NaturalPoint",
+	"Valiant Communications Limited",
+	"eks Engel GmbH & Co. KG",
+	"A.I. ANTITACCHEGGIO ITALIA SRL",
+	"ASTAK Inc.",
+	"Maquet Cardiopulmonary AG",
+	"SHARETRONIC Group LTD",
+	"Security Expert Co. Ltd",
+	"CENTROFACTOR  INC",
+	"Enertrac",
+	"GZT Telkom-Telmor sp. z o.o.",
+	"gt german telematics gmbh",
+	"On-Ramp Wireless, Inc.",
+	"Pico-Tesla Magnetic Therapies",
+	"ELITEGROUP COMPUTER SYSTEM CO., LTD.",
+	"CXP DIGITAL BV",
+	"PC Worth Int'l Co., Ltd.",
+	"Integrated Design Tools, Inc.",
+	"Venntis, LLC",
+	"Power PLUS Communications AG",
+	"Viaas Inc",
+	"Psiber Data Systems Inc.",
+	"Philips CareServant",
+	"EDT",
+	"DSA Encore, LLC",
+	"ROTEL TECHNOLOGIES",
+	"ZYNUS VISION INC.",
+	"SMA Railway Technology GmbH",
+	"Logitek Electronic Systems, Inc.",
+	"ASRock Incorporation",
+	"OCP Inc.",
+	"Lightcomm Technology Co., Ltd",
+	"CTERA Networks Ltd.",
+	"Genuine Technologies Co., Ltd.",
+	"Bitrode Corp.",
+	"Daido Signal Co., Ltd.",
+	"COMELIT GROUP S.P.A",
+	"Chengdu GeeYa Technology Co.,LTD",
+	"Stirling Energy Systems",
+	"Entourage Systems, Inc.",
+	"Kiryung Electronics",
+	"Energy, Inc.",
+	"Aetas Systems Inc.",
+	"Cloud Engines, Inc.",
+	"Digital Recorders",
+	"WITTENSTEIN AG",
+	"Minimax GmbH & Co KG",
+	"Oki Electric Industry Co., Ltd.",
+	"Runcom Technologies Ltd.",
+	"Samsung Electronics Co., Ltd., Memory Division",
+	"IfTA GmbH",
+	"CEVA, Ltd.",
+	"din Dietmar Nocker Facilitymanagement GmbH",
+	"DRS Consolidated Controls",
+	"Sensus Metering Systems",
+	"Quasar Technologies, Inc.",
+	"Maquet Critical Care AB",
+	"Pittasoft",
+	"MONEYTECH",
+	"LoJack Corporation",
+	"Jeorich Tech. Co.,Ltd.",
+	"RingCube Technologies, Inc.",
+	"Videon Central, Inc.",
+	"Singapore Technologies Electronics Limited",
+	"Vertex Wireless Co., Ltd.",
+	"ELETTROLAB Srl",
+	"Riverbed Technology",
+	"SE-Elektronic GmbH",
+	"VXI CORPORATION",
+	"Pixel8 Networks",
+	"Visonic Technologies 1993 Ltd",
+	"Research In Motion",
+	"MPEDIA",
+	"Syphan Technologies Ltd",
+	"Tantalus Systems Corp.",
+	"CoachComm, LLC",
+	"Morningstar Corporation",
+	"Shanghai Dare Technologies Co.,Ltd.",
+	"SenTec AG",
+	"Ibridge Networks & Communications Ltd.",
+	"interbro Co. Ltd.",
+	"Luxtera Inc",
+	"Vizimax Inc.",
+	"Shenzhen Huawei Communication Technologies Co., Ltd",
+	"inIT - Institut Industrial IT",
+	"ATENIX E.E. s.r.l.",
+	"\"Azimut\" Production Association JSC",
+	"Broadband Forum",
+	"Van Breda B.V.",
+	"Dantherm Power",
+	"Eastern Communications Company Limited",
+	"Zhejiang Tianle Digital Electric Co.,Ltd",
+	"Nemo-Q International AB",
+	"ST Electronics (Info-Security) Pte Ltd",
+	"KUNIMI MEDIA DEVICE Co., Ltd.",
+	"FiberPlex Technologies, LLC",
+	"NELI TECHNOLOGIES",
+	"D-BOX Technologies",
+	"JSC \"Concern \"Sozvezdie\"",
+	"J & F Labs",
+	"CAMCO Produktions- und Vertriebs-GmbH für  Beschallungs- und Beleuchtungsanlagen",
+	"STJ  ELECTRONICS  PVT  LTD",
+	"Huachentel Technology Development Co., Ltd",
+	"PointRed Telecom Private Ltd.",
+	"NEW POS Technology Limited",
+	"CallTechSolution Co.,Ltd",
+	"Equipson S.A.",
+	"x-star networks Inc.",
+	"Maksat Technologies (P) Ltd",
+	"KOKUYO S&T Co., Ltd.",
+	"Vitality, Inc.",
+	"Genie Industries, Inc.",
+	"Hills Industries Limited",
+	"Pole/Zero Corporation",
+	"Mellanox Technologies Ltd",
+	"ESUS ELEKTRONIK SAN. VE DIS. TIC. LTD. STI.",
+	"Haier",
+	"The Weather Channel",
+	"Trident Microsystems, Inc.",
+	"Super Micro Computer, Inc.",
+	"NEXTEK, Inc.",
+	"Guangzhou Shirui Electronic Co., Ltd",
+	"DatNet Informatikai Kft.",
+	"Eurodesign BG LTD",
+	"Northwest Signal Supply, Inc",
+	"GIGAVISION srl",
+	"Kalki Communication Technologies",
+	"Zhong Shan City Litai Electronic Industrial Co. Ltd",
+	"Hedon e.d. B.V.",
+	"CEStronics GmbH",
+	"Beijing PKUNITY Microsystems Technology Co., Ltd",
+	"TechnoDigital Technologies GmbH",
+	"Enalasys",
+	"Alta Definicion LINCEO S.L.",
+	"Trimax Wireless, Inc.",
+	"EuroDesign embedded technologies GmbH",
+	"Walnut Media Network",
+	"Central Network Solution Co., Ltd.",
+	"Comverge, Inc.",
+	"Kontron (BeiJing) Technology Co.,Ltd",
+	"Shanghai Embedway Information Technologies Co.,Ltd",
+	"Beijing Soul Technology Co.,Ltd.",
+	"AIO LCD PC BU / TPV",
+	"I-Tech corporation",
+	"Manufacturing Resources International",
+	"COMFILE Technology",
+	"Schmartz Inc",
+	"Maya-Creation Corporation",
+	"MBDA Deutschland GmbH",
+	"Telecom FM",
+	"Costar  electronics, inc.,",
+	"Agile Communications, Inc.",
+	"Cypress Solutions Inc",
+	"INNERINT Co., Ltd.",
+	"Italdata Ingegneria dell'Idea S.p.A.",
+	"Tektrap Systems Inc.",
+	"Wireless Cables Inc.",
+	"ZillionTV Corporation",
+	"Nawoo Korea Corp.",
+	"RingBell Co.,Ltd.",
+	"Star Link Communication Pvt. Ltd.",
+	"kasercorp, ltd",
+	"altek Corporation",
+	"S-Access GmbH",
+	"SHENZHEN HUAPU DIGITAL CO., LTD",
+	"LS Research, LLC",
+	"Reiner SCT",
+	"Mobile Communications Korea Incorporated",
+	"Skylane Optics",
+	"InnerSpace",
+	"InpegVision Co., Ltd",
+	"AzureWave Technologies, Inc",
+	"Robonica (Pty) Ltd",
+	"The Kroger Co.",
+	"CEDO",
+	"KOREA MAINTENANCE",
+	"DataFab Systems Inc.",
+	"Secura Key",
+	"ATI Electronics(Shenzhen) Co., LTD",
+	"SUNNYTEK INFORMATION CO., LTD.",
+	"Probits Co., LTD.",
+	"CeedTec Sdn Bhd",
+	"SHANGHAI SEEYOO ELECTRONIC & TECHNOLOGY CO., LTD",
+	"Everspring Industry Co., Ltd.",
+	"Hanshinit Inc.",
+	"OMNI-WiFi, LLC",
+	"Belgian Monitoring Systems bvba",
+	"Idaho Technology",
+	"i-mate Development, Inc.",
+	"Iphion BV",
+	"Reutech Radar Systems (PTY) Ltd",
+	"Humanware",
+	"NuVo Technologies LLC",
+	"Avtex Ltd",
+	"I-TEC Co., Ltd.",
+	"Suga Electronics Limited",
+	"Nordwestdeutsche Zählerrevision",
+	"KoCo Connector AG",
+	"DVS Korea, Co., Ltd",
+	"netTALK.com, Inc.",
+	"Ansaldo STS USA",
+	"GMK electronic design GmbH",
+	"J&M Analytik AG",
+	"Tunstall Healthcare A/S",
+	"ENDA ENDUSTRIYEL ELEKTRONIK LTD. STI.",
+	"OBR Centrum Techniki Morskiej S.A.",
+	"Pilot Electronics Corporation",
+	"CreNova Multimedia Co., Ltd",
+	"TEAC Australia Pty Ltd.",
+	"Cutera Inc",
+	"SMART Temps LLC",
+	"Shenzhen Wistar Technology Co., Ltd",
+	"Audio Processing Technology Ltd",
+	"CC Systems AB",
+	"RAUMFELD GmbH",
+	"Enabling Technology Pty Ltd",
+	"Phyllis Co., Ltd.",
+	"Dataram",
+	"Mercury Systems, Inc.",
+	"Ablaze Systems, LLC",
+	"Linn Products Ltd",
+	"Apacewave Technologies",
+	"Licera AB",
+	"Space Exploration Technologies",
+	"Engel Axil S.L.",
+	"KTNF",
+	"Teracom Limited",
+	"Rosemount Inc.",
+	"OEM Worldwide",
+	"FRC",
+	"Femtocomm System Technology Corp.",
+	"LAUREL BANK MACHINES CO., LTD.",
+	"NEOVIA INC.",
+	"COP SECURITY SYSTEM CORP.",
+	"QINGBANG ELEC(SZ) CO., LTD",
+	"SAE Magnetics (H.K.) Ltd.",
+	"ISGUS GmbH",
+	"InteliCloud Technology Inc.",
+	"JRD Communication Inc",
+	"MediaSputnik",
+	"Geophysical Survey Systems, Inc.",
+	"Truesell",
+	"companytec automação e controle ltda.",
+	"Juphoon System Software Inc.",
+	"Proxense, LLC",
+	"Wongs Electronics Co. Ltd.",
+	"IKT Advanced Technologies s.r.o.",
+	"Chengdu Jiuzhou Electronic Technology Inc",
+	"HAMAMATSU TOA ELECTRONICS",
+	"ACOREL S.A.S",
+	"COMMTACT LTD",
+	"Instrumentation Technologies d.d.",
+	"MIR - Medical International Research",
+	"Infineta Systems, Inc",
+	"Bluetechnix GmbH",
+	"Xia Men Joyatech Co., Ltd.",
+	"T.M. Electronics, Inc.",
+	"Digitec Systems",
+	"Onbnetech",
+	"Bachmann Technology GmbH & Co. KG",
+	"MIA Corporation",
+	"LIOS Technology GmbH",
+	"Baustem Broadband Technologies, Ltd.",
+	"Circontrol S.A.",
+	"SHENYANG TONGFANG MULTIMEDIA TECHNOLOGY COMPANY LIMITED",
+	"WFE TECHNOLOGY CORP.",
+	"Emitech Corp.",
+	"Rail & Road Protec GmbH",
+	"Krüger &Gothe GmbH",
+	"DaySequerra Corporation",
+	"Sansonic Electronics USA",
+	"OOO NPP EKRA",
+	"T-Platforms (Cyprus) Limited",
+	"Hitron Technologies. Inc",
+	"Logiways",
+	"Irumtek Co., Ltd.",
+	"Shenzhen Huitaiwei Tech. Ltd, co.",
+	"Core System Japan",
+	"ProtectedLogic Corporation",
+	"CARECOM CO.,LTD.",
+	"ESSENSIUM NV",
+	"SHINE UNION ENTERPRISE LIMITED",
+	"Inventec",
+	"MobileAccess Networks",
+	"Nissho-denki Co.,LTD.",
+	"Coordiwise Technology Corp.",
+	"Cinch Connectors",
+	"AUTOVISION Co., Ltd",
+	"AAMP of America",
+	"RICOH COMPANY,LTD.",
+	"Electronic Solutions, Inc.",
+	"Aztech Electronics Pte Ltd",
+	"COMMidt AS",
+	"DEIF A/S",
+	"Logic Instrument SA",
+	"Euphonic Technologies, Inc.",
+	"wuhan hongxin telecommunication technologies co.,ltd",
+	"GSI Helmholtzzentrum für Schwerionenforschung GmbH",
+	"Metz-Werke GmbH & Co KG",
+	"A-Max Technology Macao Commercial Offshore Company Limited",
+	"Parrot SA",
+	"Zenterio AB",
+	"Lockie Innovation Pty Ltd",
+	"Interspiro AB",
+	"Ajoho Enterprise Co., Ltd.",
+	"KISAN SYSTEM",
+	"Digital Innovation",
+	"Quantenna Communcations, Inc.",
+	"Corega K.K",
+	"General Dynamics Robotic Systems",
+	"Terrier SC Ltd",
+	"Guangzhou Escene Computer Technology Limited",
+	"StarLeaf Ltd.",
+	"CellTel S.p.A.",
+	"Alta Solutions, Inc.",
+	"MTA SpA",
+	"I DO IT",
+	"Mitsubishi Electric Co.",
+	"QVidium Technologies, Inc.",
+	"Senscient Ltd",
+	"ZT Group Int'l Inc",
+	"NOOLIX Co., Ltd",
+	"Cheetah Technologies, L.P.",
+	"Carina System Co., Ltd.",
+	"SOKRAT Ltd.",
+	"ITUS JAPAN CO. LTD",
+	"M2Mnet Co., Ltd.",
+	"Quanta Computer Inc",
+	"moblic",
+	"Megger",
+	"Instrumentation Technology Systems",
+	"FQ Ingenieria Electronica S.A.",
+	"Novus Produtos Eletronicos Ltda",
+	"MICROROBOT.CO.,LTD",
+	"TRIXELL",
+	"CONNECT SRL",
+	"DAEHAP HYPER-TECH",
+	"Strong Technologies Pty Ltd",
+	"Kenmec Mechanical Engineering Co., Ltd.",
+	"Shanghai LUSTER Teraband photonic Co., Ltd.",
+	"Arada Systems, Inc.",
+	"Wireless Measurement Ltd",
+	"Duelco A/S",
+	"Navis Auto Motive Systems, Inc.",
+	"Setrix GmbH",
+	"Thales Communications Inc",
+	"Ford Motor Company",
+	"ICOMM Tele Ltd",
+	"Kingston Technology Company, Inc.",
+	"General Jack Technology Ltd.",
+	"JTEC Card & Communication Co., Ltd.",
+	"Schoonderbeek Elektronica Systemen B.V.",
+	"ShenZhen Temobi Science&Tech Development Co.,Ltd",
+	"EnergyHub",
+	"ARTRAY CO., LTD.",
+	"SCDI Co. LTD",
+	"Insightek Corp.",
+	"Cadmos microsystems S.r.l.",
+	"Guangdong Gosun Telecommunications Co.,Ltd",
+	"System Sensor",
+	"Proventix Systems, Inc.",
+	"PurpleComm, Inc.",
+	"Kozumi USA Corp.",
+	"DEKA R&D",
+	"Semihalf",
+	"S Squared Innovations Inc.",
+	"Pcube Systems, Inc.",
+	"Zeno Information System",
+	"IRCA SpA",
+	"Ory Solucoes em Comercio de Informatica Ltda.",
+	"Ningbo Andy Optoelectronic Co., Ltd.",
+	"KM Electornic Technology Co., Ltd.",
+	"Magic Point Inc.",
+	"Universal Media Corporation /Slovakia/ s.r.o.",
+	"Ionics EMS Inc.",
+	"Optical Systems Design",
+	"Fival Science & Technology Co.,Ltd.",
+	"FDI MATELEC",
+	"TaiDoc Technology Corp.",
+	"ASITEQ",
+	"Stanford University, OpenFlow Group",
+	"DTI",
+	"CANAL OVERSEAS",
+	"AEG Power Solutions",
+	"Visionhitech Co., Ltd.",
+	"Shanghai ONLAN Communication Tech. Co., Ltd.",
+	"SP Corp",
+	"Cheerchip Electronic Technology (ShangHai) Co., Ltd.",
+	"Advanced Spectrum Technology Co., Ltd.",
+	"Legrand Home Systems, Inc",
+	"zte corporation",
+	"TKM GmbH",
+	"Technology Advancement Group, Inc.",
+	"cTrixs International GmbH.",
+	"SMC Networks",
+	"Nesslab",
+	"XRPLUS Inc.",
+	"Military Communication Institute",
+	"Infosys Technologies Ltd.",
+	"Golden Highway Industry Development Co., Ltd.",
+	"S.E.M. srl",
+	"BandRich Inc.",
+	"AirDio Wireless, Inc.",
+	"AcSiP Technology Corp.",
+	"Interactive Intelligence",
+	"MKD Technology Inc.",
+	"Shenzhen Siglent Technology Co., Ltd.",
+	"INCOstartec GmbH",
+	"SolarEdge Technologies",
+	"Testech Electronics Pte Ltd",
+	"Accelerated Concepts, Inc",
+	"Sectronic",
+	"YOISYS",
+	"Lift Complex DS, JSC",
+	"Nordiag ASA",
+	"IEE S.A.",
+	"Adura Technologies",
+	"Envisionnovation Inc",
+	"LanPro Inc",
+	"MaxVision LLC",
+	"Grainmustards, Co,ltd.",
+	"Rebound Telecom. Co., Ltd",
+	"Adachi-Syokai Co., Ltd.",
+	"CE Digital(Zhenjiang)Co.,Ltd",
+	"Suzhou NEW SEAUNION Video Technology Co.,Ltd",
+	"Geenovo Technology Ltd.",
+	"Alec Sicherheitssysteme GmbH",
+	"MERCURY CORPORATION",
+	"Comba Telecom Systems (China) Ltd.",
+	"Xagyl Communications",
+	"MIPRO Electronics Co., Ltd",
+	"NEW-SOL COM",
+	"Shenzhen Baoan Fenda Industrial Co., Ltd",
+	"Ubiquiti Networks",
+	"LARsys-Automation GmbH",
+	"TITECH GmbH",
+	"ALLWELL TECHNOLOGY CORP.",
+	"SMP",
+	"Expand Networks",
+	"Phasys Ltd.",
+	"LEADTEK RESEARCH INC.",
+	"Fujitsu Siemens Computers",
+	"SUPERPOWER COMPUTER",
+	"OPTI, INC.",
+	"AVIO DIGITAL, INC.",
+	"Tachion Networks, Inc.",
+	"AZTECH Electronics Pte Ltd",
+	"mPHASE Technologies, Inc.",
+	"CONGRUENCY, LTD.",
+	"MMC Technology, Inc.",
+	"Klotz Digital AG",
+	"IMT - Information Management T",
+	"VISIONETICS INTERNATIONAL",
+	"HMS Industrial Networks",
+	"DIGITAL ENGINEERING LTD.",
+	"DIVIO, INC.",
+	"CP CLARE CORP.",
+	"ISHIDA CO., LTD.",
+	"BlueArc UK Ltd",
+	"Jetway Information Co., Ltd.",
+	"SMARTBRIDGES PTE. LTD.",
+	"SHUTTLE, INC.",
+	"ALTVATER AIRDATA SYSTEMS",
+	"SKYSTREAM, INC.",
+	"3COM Europe Ltd.",
+	"OPTICAL NETWORKS, INC.",
+	"TSI, Inc..",
+	"HSING TECH. ENTERPRISE CO.,LTD",
+	"Fong Kai Industrial Co., Ltd.",
+	"COGENT COMPUTER SYSTEMS, INC.",
+	"CHECKOUT COMPUTER SYSTEMS, LTD",
+	"HeiTel Digital Video GmbH",
+	"KERBANGO, INC.",
+	"FASE Saldatura srl",
+	"OPICOM",
+	"SOUTHERN INFORMATION",
+	"INALP NETWORKS, INC.",
+	"SYLANTRO SYSTEMS CORPORATION",
+	"QUANTUM BRIDGE COMMUNICATIONS",
+	"Hoft & Wessel AG",
+	"GE Aviation System",
+	"HARMONIX CORPORATION",
+	"LIGHTWAVE COMMUNICATIONS, INC.",
+	"MagicRam, Inc.",
+	"ORIENT TELECOM CO., LTD.",
+	"SET ENGINEERING",
+	"Corning Incorporated",
+	"RMP ELEKTRONIKSYSTEME GMBH",
+	"Packard Bell Nec Services",
+	"XCP, INC.",
+	"SOFTBOOK PRESS",
+	"MAATEL",
+	"PowerCom Technology",
+	"ONNTO CORP.",
+	"IVA CORPORATION",
+	"Radcom Ltd.",
+	"TurboComm Tech Inc.",
+	"SAEJIN T & M CO., LTD.",
+	"DeTeWe-Deutsche Telephonwerke",
+	"IDREAM TECHNOLOGIES, PTE. LTD.",
+	"CradlePoint, Inc",
+	"Village Networks, Inc. (VNI)",
+	"Controlled Electronic Manageme",
+	"NISSEI ELECTRIC CO., LTD.",
+	"Supermicro Computer, Inc.",
+	"BRYANT TECHNOLOGY, LTD.",
+	"Fraunhofer IPMS",
+	"ORBACOM SYSTEMS, INC.",
+	"APPIAN COMMUNICATIONS, INC.",
+	"ESI",
+	"BUSTEC PRODUCTION LTD.",
+	"PLANET Technology Corporation",
+	"Versa Technology",
+	"ORBIT AVIONIC & COMMUNICATION",
+	"ELASTIC NETWORKS",
+	"Basler AG",
+	"CASTLENET TECHNOLOGY, INC.",
+	"Renesas Technology America, Inc.",
+	"Beck IPC GmbH",
+	"QTelNet, Inc.",
+	"API MOTION",
+	"KONTRON COMPACT COMPUTERS AG",
+	"TELGEN CORPORATION",
+	"Toko Inc.",
+	"SMAR Laboratories Corp.",
+	"DIGITRA SYSTEMS, INC.",
+	"Abelko Innovation",
+	"Hasselblad",
+	"Powerfile, Inc.",
+	"MobyTEL",
+	"IP Video Networks Inc",
+	"SANTERA SYSTEMS, INC.",
+	"ADLINK TECHNOLOGY, INC.",
+	"RFM",
+	"BIOSTAR MICROTECH INT'L CORP.",
+	"CYBERNETICS TECH. CO., LTD.",
+	"IMPACCT TECHNOLOGY CORP.",
+	"PENTA MEDIA CO., LTD.",
+	"CMOS SYSTEMS, INC.",
+	"Hitex Holding GmbH",
+	"LUCENT TECHNOLOGIES",
+	"HEWLETT PACKARD",
+	"SEYEON TECH. CO., LTD.",
+	"1Net Corporation",
+	"Intellibyte Inc.",
+	"International Microsystems, In",
+	"EQUIINET LTD.",
+	"ADTECH",
+	"Akamba Corporation",
+	"ONPREM NETWORKS",
+	"CQOS, INC.",
+	"Advanced Technology & Systems",
+	"ADID SA",
+	"GRE AMERICA, INC.",
+	"Redflex Communication Systems",
+	"IRLAN LTD.",
+	"ALTOS C&C",
+	"TAIHAN ELECTRIC WIRE CO., LTD.",
+	"Ivron Systems",
+	"ALLIED TELESYN INTERNAIONAL",
+	"Transistor Devices, Inc.",
+	"VEGA GRIESHABER KG",
+	"Spectrapoint Wireless, LLC",
+	"NICOTRA SISTEMI S.P.A",
+	"Brix Networks",
+	"Quantum Corporation",
+	"Pinnacle Systems, Inc.",
+	"CROSS MATCH TECHNOLOGIES, INC.",
+	"MICRILOR, Inc.",
+	"CYRA TECHNOLOGIES, INC.",
+	"TAIWAN FIRST LINE ELEC. CORP.",
+	"ModuNORM GmbH",
+	"Sonnet Technologies, Inc",
+	"Procomp Informatics, Ltd.",
+	"AB Regin",
+	"Global Converging Technologies",
+	"BOENIG UND KALLENBACH OHG",
+	"ASTRO TERRA CORP.",
+	"Smartware",
+	"Timing Applications, Inc.",
+	"Nimble Microsystems, Inc.",
+	"WORKBIT CORPORATION.",
+	"AMBER NETWORKS",
+	"TYCO SUBMARINE SYSTEMS, LTD.",
+	"WEBGATE Inc.",
+	"Lightner Engineering",
+	"Woodwind Communications System",
+	"ACTIVE POWER",
+	"VIANET TECHNOLOGIES, LTD.",
+	"SCHWEITZER ENGINEERING",
+	"OL'E COMMUNICATIONS, INC.",
+	"Netiverse, Inc.",
+	"AXUS MICROSYSTEMS, INC.",
+	"DELTA NETWORKS, INC.",
+	"Systeme Lauer GmbH & Co., Ltd.",
+	"SHANGHAI COMMUNICATION",
+	"Times N System, Inc.",
+	"Honeywell GmbH",
+	"Convergenet Technologies",
+	"TrunkNet",
+	"L-3 Sonoma EO",
+	"San Valley Systems, Inc.",
+	"INTERSIL CORP.",
+	"Tadiran Microwave Networks",
+	"Teletrol Systems, Inc.",
+	"RiverDelta Networks",
+	"ECTEL",
+	"AC&T SYSTEM CO., LTD.",
+	"CacheFlow, Inc.",
+	"Optronic AG",
+	"BELKIN COMPONENTS",
+	"City-Net Technology, Inc.",
+	"MULTIDATA GMBH",
+	"Lara Technology, Inc.",
+	"HEWLETT-PACKARD",
+	"COMONE",
+	"FLUECKIGER ELEKTRONIK AG",
+	"Canon Imaging Systems Inc.",
+	"CADENCE DESIGN SYSTEMS",
+	"CONTROL SOLUTIONS, INC.",
+	"Macromate Corp.",
+	"GAD LINE, LTD.",
+	"LuxN, N",
+	"Discovery Com",
+	"OMNI FLOW COMPUTERS, INC.",
+	"Tenor Networks, Inc.",
+	"CONEXANT SYSTEMS, INC.",
+	"Zaffire",
+	"TWO TECHNOLOGIES, INC.",
+	"Tellabs",
+	"INOVA CORPORATION",
+	"WIN TECHNOLOGIES, CO., LTD.",
+	"Agilent Technologies",
+	"AAE Systems, Inc.",
+	"DResearch GmbH",
+	"MSC VERTRIEBS GMBH",
+	"Innovative Systems, L.L.C.",
+	"SITEK",
+	"DATACORE SOFTWARE CORP.",
+	"Mindready Solutions, Inc.",
+	"RIGHTECH CORPORATION",
+	"INDIGITA CORPORATION",
+	"WAGO Kontakttechnik GmbH",
+	"KB/TEL TELECOMUNICACIONES",
+	"OXFORD SEMICONDUCTOR LTD.",
+	"Network Equipment Technologies, Inc.",
+	"GARNET SYSTEMS CO., LTD.",
+	"SEDONA NETWORKS CORP.",
+	"CHIYODA SYSTEM RIKEN",
+	"Amper Datos S.A.",
+	"Draeger Medical Systems, Inc.",
+	"CNF MOBILE SOLUTIONS, INC.",
+	"ENSIM CORP.",
+	"GMA COMMUNICATION MANUFACT'G",
+	"TeraForce Technology Corporation",
+	"TURBONET COMMUNICATIONS, INC.",
+	"BORGARDT",
+	"Expert Magnetics Corp.",
+	"DSG Technology, Inc.",
+	"NEON TECHNOLOGY, INC.",
+	"Uniform Industrial Corp.",
+	"At Work Computers",
+	"STARDOT TECHNOLOGIES",
+	"Wild Lab. Ltd.",
+	"SECURELOGIX CORPORATION",
+	"RAMIX INC.",
+	"Dynapro Systems, Inc.",
+	"Sollae Systems Co., Ltd.",
+	"TELICA, INC.",
+	"AZS Technology AG",
+	"Terawave Communications, Inc.",
+	"INTEGRATED SYSTEMS DESIGN",
+	"DSA GmbH",
+	"DATAFAB SYSTEMS, INC.",
+	"so-logic",
+	"SynapSense Corporation",
+	"Radicom Research, Inc.",
+	"Electro-Metrics Corporation",
+	"Rosen Aviation",
+	"Conti Temic microelectronic GmbH",
+	"SuperVision",
+	"SAGEMCOM",
+	"BlueBit Ltd.",
+	"WONWOO Engineering Co., Ltd",
+	"Hatteland Computer AS",
+	"PCI COMPONENTES DA AMZONIA LTD",
+	"Zero One Technology Co. Ltd.",
+	"PERLE SYSTEMS LIMITED",
+	"Emerson Process Management Power & Water Solutions, Inc.",
+	"ICM CO. LTD.",
+	"ANI COMMUNICATIONS INC.",
+	"SAMPO TECHNOLOGY CORPORATION",
+	"TELMAT INFORMATIQUE",
+	"A PLUS INFO CORPORATION",
+	"TACHIBANA TECTRON CO., LTD.",
+	"PIVOTAL TECHNOLOGIES, INC.",
+	"GENERAL MICRO SYSTEMS, INC.",
+	"LANNET DATA COMMUNICATIONS,LTD",
+	"DATACOM TECHNOLOGIES",
+	"SONIC SYSTEMS, INC.",
+	"ANDOVER CONTROLS CORPORATION",
+	"WINDATA, INC.",
+	"NTT DATA COMM. SYSTEMS CORP.",
+	"COMSOFT GMBH",
+	"ASCOM INFRASYS AG",
+	"ADC - Global Connectivity Solutions Division",
+	"Silex Technology America",
+	"ADOBE SYSTEMS, INC.",
+	"AEON SYSTEMS, INC.",
+	"FUJI ELECTRIC CO., LTD.",
+	"PRINTER SYSTEMS CORP.",
+	"AST RESEARCH, INC.",
+	"INVISIBLE SOFTWARE, INC.",
+	"ICC",
+	"COLORGRAPH LTD",
+	"TE Connectivity Ltd.",
+	"RASTER GRAPHICS",
+	"KLEVER COMPUTERS, INC.",
+	"LOGIC CORPORATION",
+	"COMPAC INC.",
+	"MOLECULAR DYNAMICS",
+	"SMC MASSACHUSETTS, INC.",
+	"NETCOMM LIMITED",
+	"COMPEX",
+	"CANOGA-PERKINS",
+	"TRIGEM COMPUTER, INC.",
+	"ISIS DISTRIBUTED SYSTEMS, INC.",
+	"HARRIS ADACOM CORPORATION",
+	"PRECISION SOFTWARE, INC.",
+	"XLNT DESIGNS INC.",
+	"GK COMPUTER",
+	"KOKUSAI ELECTRIC CO., LTD",
+	"DIGITAL COMMUNICATIONS",
+	"ADDTRON TECHNOLOGY CO., LTD.",
+	"BUSTEK CORPORATION",
+	"OPCOM",
+	"Zoom Telephonics, Inc",
+	"SEA-ILAN, INC.",
+	"TALENT ELECTRIC INCORPORATED",
+	"OPTEC DAIICHI DENKO CO., LTD.",
+	"IMPACT TECHNOLOGIES",
+	"SYNERJET INTERNATIONAL CORP.",
+	"FORKS, INC.",
+	"Teradata Corporation",
+	"RASTER OPS CORPORATION",
+	"SSANGYONG COMPUTER SYSTEMS",
+	"RING ACCESS, INC.",
+	"FUJIKURA LTD.",
+	"N.A.T. GMBH",
+	"QNIX COMPUTER CO., LTD.",
+	"TWINHEAD CORPORATION",
+	"UDC RESEARCH LIMITED",
+	"WIND RIVER SYSTEMS",
+	"SMD INFORMATICA S.A.",
+	"Roche Diagnostics International Ltd.",
+	"WEST AUSTRALIAN DEPARTMENT",
+	"MAPLE COMPUTER SYSTEMS",
+	"HYPERTEC PTY LTD.",
+	"TELECOMMUNICATIONS TECHNIQUES",
+	"FLUENT, INC.",
+	"SPACE & NAVAL WARFARE SYSTEMS",
+	"IRONICS, INCORPORATED",
+	"GRACILIS, INC.",
+	"STAR TECHNOLOGIES, INC.",
+	"AMPRO COMPUTERS",
+	"CONNECTION MACHINES SERVICES",
+	"METRONIX GMBH",
+	"MCM JAPAN LTD.",
+	"LOCKHEED - SANDERS",
+	"KRONOS, INC.",
+	"YOSHIDA KOGYO K. K.",
+	"GOLDSTAR INFORMATION & COMM.",
+	"FUNASSET LIMITED",
+	"FUTURE SYSTEMS, INC.",
+	"STAR-TEK, INC.",
+	"NORTH HILLS ISRAEL",
+	"AFE COMPUTERS LTD.",
+	"COMENDEC LTD",
+	"DATATECH ENTERPRISES CO., LTD.",
+	"E-SYSTEMS, INC./GARLAND DIV.",
+	"VIA TECHNOLOGIES, INC.",
+	"KLA INSTRUMENTS CORPORATION",
+	"GTE SPACENET",
+	"Hitachi Metals, Ltd.",
+	"OMNIBYTE CORPORATION",
+	"EXTENDED SYSTEMS",
+	"LEMCOM SYSTEMS, INC.",
+	"KENTEK INFORMATION SYSTEMS,INC",
+	"SYSGEN",
+	"COPERNIQUE",
+	"LANCO, INC.",
+	"COROLLARY, INC.",
+	"SYNC RESEARCH INC.",
+	"INTERWARE CO., LTD.",
+	"ATM COMPUTER GMBH",
+	"Applied Innovation Inc.",
+	"BASS ASSOCIATES",
+	"CABLE AND WIRELESS",
+	"Sun Conversion Technologies",
+	"MAXTON TECHNOLOGY CORPORATION",
+	"WEARNES AUTOMATION PTE LTD",
+	"JUKO MANUFACTURE COMPANY, LTD.",
+	"SOCIETE D'EXPLOITATION DU CNIT",
+	"SCIENTIFIC ATLANTA",
+	"QUME CORPORATION",
+	"EXTENSION TECHNOLOGY CORP.",
+	"EVERGREEN SYSTEMS, INC.",
+	"FLIR Systems",
+	"ATHENIX CORPORATION",
+	"MANNESMANN SCANGRAPHIC GMBH",
+	"LABORATORY EQUIPMENT CORP.",
+	"TDA INDUSTRIA DE PRODUTOS",
+	"HONEYWELL ACS",
+	"SAAB INSTRUMENTS AB",
+	"MICHELS & KLEBERHOFF COMPUTER",
+	"UBITREX CORPORATION",
+	"MOBIUS TECHNOLOGIES, INC.",
+	"MEIDENSHA CORPORATION",
+	"TPS TELEPROCESSING SYS. GMBH",
+	"RAYLAN CORPORATION",
+	"AXIS COMMUNICATIONS AB",
+	"THE GOODYEAR TIRE & RUBBER CO.",
+	"WM-DATA MINFO AB",
+	"ANSEL COMMUNICATIONS",
+	"PROCOMP INDUSTRIA ELETRONICA",
+	"ASP COMPUTER PRODUCTS, INC.",
+	"PAXDATA NETWORKS LTD.",
+	"SHOGRAPHICS, INC.",
+	"R.P.T. INTERGROUPS INT'L LTD.",
+	"Cisco Systems",
+	"DATEX DIVISION OF",
+	"DRESSLER GMBH & CO.",
+	"NEWGEN SYSTEMS CORP.",
+	"NETWORK EXPRESS, INC.",
+	"HAL COMPUTER SYSTEMS INC.",
+	"TRANSWARE",
+	"DIGIBOARD, INC.",
+	"CONCURRENT TECHNOLOGIES  LTD.",
+	"GOLDSTAR CO., LTD.",
+	"ERGO COMPUTING",
+	"KINGSTAR TECHNOLOGY INC.",
+	"MICROUNITY SYSTEMS ENGINEERING",
+	"ROSE ELECTRONICS",
+	"CLINICOMP INTL.",
+	"Cray, Inc.",
+	"ITAUTEC PHILCO S.A.",
+	"IMF INTERNATIONAL LTD.",
+	"DATACOM INC.",
+	"Metso Automation",
+	"ROLAND DG CORPORATION",
+	"SUPER WORKSTATION, INC.",
+	"SMA REGELSYSTEME GMBH",
+	"DELTA CONTROLS, INC.",
+	"DIGITAL PRODUCTS, INC.",
+	"BYTEX CORPORATION, ENGINEERING",
+	"CODONICS INC.",
+	"SYSTEMFORSCHUNG",
+	"ParTech Inc.",
+	"NEXTCOM K.K.",
+	"VIDEO TECHNOLOGY COMPUTERS LTD",
+	"COMPUTERM  CORPORATION",
+	"STEALTH COMPUTER SYSTEMS",
+	"IDEA ASSOCIATES",
+	"MACQ ELECTRONIQUE SA",
+	"ALLIANT COMPUTER SYSTEMS CORP.",
+	"GOLDSTAR CABLE CO., LTD.",
+	"ALGORITHMICS LTD.",
+	"STARLIGHT NETWORKS, INC.",
+	"BOEING DEFENSE & SPACE",
+	"CHANNEL SYSTEMS INTERN'L INC.",
+	"VISTA CONTROLS CORPORATION",
+	"BIZERBA-WERKE WILHEIM KRAUT",
+	"APPLIED COMPUTING DEVICES",
+	"FISCHER AND PORTER CO.",
+	"KINKEI SYSTEM CORPORATION",
+	"MICOM COMMUNICATIONS INC.",
+	"FIBERNET RESEARCH, INC.",
+	"RUBY TECH CORPORATION",
+	"MILAN TECHNOLOGY CORPORATION",
+	"NCUBE",
+	"FIRST INTERNAT'L COMPUTER, INC",
+	"LANWAN TECHNOLOGIES",
+	"SILCOM MANUF'G TECHNOLOGY INC.",
+	"TERA MICROSYSTEMS, INC.",
+	"NET-SOURCE, INC.",
+	"STRAWBERRY TREE, INC.",
+	"FUKUDA DENSHI CO., LTD.",
+	"PAGINE CORPORATION",
+	"KIMPSION INTERNATIONAL CORP.",
+	"GAGE TALKER CORP.",
+	"Sartorius Mechatronics T&H GmbH",
+	"LOCAMATION B.V.",
+	"STUDIO GEN INC.",
+	"OCEAN OFFICE AUTOMATION LTD.",
+	"AMERICAN MEGATRENDS INC.",
+	"TELSPEC LTD",
+	"ADVANCED TECHNICAL SOLUTIONS",
+	"TRITEC ELECTRONIC GMBH",
+	"HONG TECHNOLOGIES",
+	"Elsag Datamat spa",
+	"DIGALOG SYSTEMS, INC.",
+	"ATOMWIDE LTD.",
+	"MARNER INTERNATIONAL, INC.",
+	"MESA RIDGE TECHNOLOGIES, INC.",
+	"QUIN SYSTEMS LTD",
+	"E-M TECHNOLOGY, INC.",
+	"SYBUS CORPORATION",
+	"C.A.E.N.",
+	"ARNOS INSTRUMENTS & COMPUTER",
+	"CHARLES RIVER DATA SYSTEMS,INC",
+	"ACCORD SYSTEMS, INC.",
+	"PLAIN TREE SYSTEMS INC",
+	"MARTIN MARIETTA CORPORATION",
+	"MIKASA SYSTEM ENGINEERING",
+	"NETWORK CONTROLS INT'NATL INC.",
+	"OPTIMEM",
+	"HYPERCOM, INC.",
+	"MicroBrain,Inc.",
+	"CHUO ELECTRONICS CO., LTD.",
+	"JANICH & KLASS COMPUTERTECHNIK",
+	"NETCOR",
+	"CAMEO COMMUNICATIONS, INC.",
+	"OEM ENGINES",
+	"KATRON COMPUTERS INC.",
+	"SYSTEMHAUS DISCOM",
+	"COMBINET",
+	"MICROBOARDS, INC.",
+	"CASCADE COMMUNICATIONS CORP.",
+	"IBR COMPUTER TECHNIK GMBH",
+	"LXE",
+	"SYMPLEX COMMUNICATIONS",
+	"TELEBIT CORPORATION",
+	"Wuxi Zhongxing Optoelectronics Technology Co.,Ltd.",
+	"RLX Technologies",
+	"KETRON S.R.L.",
+	"Versus Technology, Inc.",
+	"HUAWEI TECHNOLOGIES CO.,LTD",
+	"Andon Health Co.,Ltd.",
+	"NEXO COMMUNICATIONS, INC.",
+	"YAMASHITA SYSTEMS CORP.",
+	"OMNISEC AG",
+	"Xrite Inc",
+	"TAC AB",
+	"SIEMENS TELECOMMUNICATION SYSTEMS LIMITED",
+	"TIVA MICROCOMPUTER CORP. (TMC)",
+	"PHILIPS BROADBAND NETWORKS",
+	"IRIS TECHNOLOGIES, INC.",
+	"e-Tek Labs, Inc.",
+	"SATORI ELECTORIC CO., LTD.",
+	"CHROMATIS NETWORKS, INC.",
+	"NovaNET Learning, Inc.",
+	"CBL - GMBH",
+	"Chaparral Network Storage",
+	"BRIGHT STAR ENGINEERING",
+	"SST/WOODHEAD INDUSTRIES",
+	"RSR S.R.L.",
+	"AMIT, Inc.",
+	"SPRING TIDE NETWORKS, INC.",
+	"IQinVision",
+	"ABL CANADA, INC.",
+	"JATOM SYSTEMS, INC.",
+	"Miranda Technologies, Inc.",
+	"MRG SYSTEMS, LTD.",
+	"MEDIASTAR CO., LTD.",
+	"EIS INTERNATIONAL, INC.",
+	"ZONET TECHNOLOGY, INC.",
+	"PG DESIGN ELECTRONICS, INC.",
+	"NAVIC SYSTEMS, INC.",
+	"COSYSTEMS, INC.",
+	"GENICOM CORPORATION",
+	"AVAL COMMUNICATIONS",
+	"1394 PRINTER WORKING GROUP",
+	"GENRAD LTD.",
+	"SOYO COMPUTER, INC.",
+	"ACCEL, INC.",
+	"CAMBEX CORPORATION",
+	"TollBridge Technologies, Inc.",
+	"FUTURE PLUS SYSTEMS",
+	"AEROFLEX LABORATORIES, INC.",
+	"PICAZO COMMUNICATIONS, INC.",
+	"MAYAN NETWORKS",
+	"NETCAM, LTD.",
+	"KOGA ELECTRONICS CO.",
+	"DAIN TELECOM CO., LTD.",
+	"MARINER NETWORKS",
+	"DATONG ELECTRONICS LTD.",
+	"MEDIAFIRE CORPORATION",
+	"TSINGHUA NOVEL ELECTRONICS",
+	"ANCHOR GAMES",
+	"Panasonic Electric Works Co., Ltd.",
+	"Coretronic Corporation",
+	"SCI MANUFACTURING SINGAPORE PTE, LTD.",
+	"MARVELL SEMICONDUCTOR, INC.",
+	"ASACA CORPORATION",
+	"RIOWORKS SOLUTIONS, INC.",
+	"MENICX INTERNATIONAL CO., LTD.",
+	"INFOLIBRIA",
+	"Arbor Networks Inc",
+	"ELTECO A.S.",
+	"BARCONET N.V.",
+	"Galil Motion Control",
+	"Tokyo Electron Device Limited",
+	"SIERRA MONITOR CORP.",
+	"OLENCOM ELECTRONICS",
+	"IWATSU ELECTRIC CO., LTD.",
+	"TIARA NETWORKS, INC.",
+	"DOMS A/S",
+	"BROADBAND ACCESS SYSTEMS",
+	"VegaStream Group Limted",
+	"iBAHN",
+	"NETWORK ALCHEMY, INC.",
+	"KAWASAKI LSI U.S.A., INC.",
+	"TUNDO CORPORATION",
+	"DIGITEK MICROLOGIC S.A.",
+	"BRAND INNOVATORS",
+	"TANDBERG TELECOM AS",
+	"KOUWELL ELECTRONICS CORP.  **",
+	"OY COMSEL SYSTEM AB",
+	"CAE ELECTRONICS",
+	"TDK-Lambda Corporation",
+	"AtecoM GmbH advanced telecomunication modules",
+	"AEROCOMM, INC.",
+	"ELECTRONIC INDUSTRIES ASSOCIATION",
+	"PixStream Incorporated",
+	"EDEVA, INC.",
+	"SPX-ATEG",
+	"Beijer Electronics Products AB",
+	"VIDEOJET SYSTEMS",
+	"CORDER ENGINEERING CORPORATION",
+	"G-CONNECT",
+	"CHAINTECH COMPUTER CO., LTD.",
+	"AIWA CO., LTD.",
+	"CORVIS CORPORATION",
+	"ADVANCED HI-TECH CORP.",
+	"KESTREL SOLUTIONS",
+	"PROLIFIC TECHNOLOGY, INC.",
+	"MEGATON HOUSE, LTD.",
+	"XPEED, INC.",
+	"MERLOT COMMUNICATIONS",
+	"VIDEOCON AG",
+	"IFP",
+	"NEWER TECHNOLOGY",
+	"MURATA MACHINERY, LTD.",
+	"FORESSON CORPORATION",
+	"GILBARCO, INC.",
+	"ATL PRODUCTS",
+	"TELKOM SA, LTD.",
+	"TERASAKI ELECTRIC CO., LTD.",
+	"AMANO CORPORATION",
+	"SAFETY MANAGEMENT SYSTEMS",
+	"RSI SYSTEMS",
+	"ABIT COMPUTER CORPORATION",
+	"OPTIMATION, INC.",
+	"ASITA TECHNOLOGIES INT'L LTD.",
+	"DCTRI",
+	"NETACCESS, INC.",
+	"Rigaku Corporation Osaka Plant",
+	"BOEING",
+	"PACE plc",
+	"PERACOM NETWORKS",
+	"SALIX TECHNOLOGIES, INC.",
+	"MMC-EMBEDDED COMPUTERTECHNIK GmbH",
+	"GLOBALOOP, LTD.",
+	"3COM EUROPE, LTD.",
+	"TAG ELECTRONIC SYSTEMS",
+	"SWITCHCORE AB",
+	"BETA RESEARCH",
+	"THE INDUSTREE B.V.",
+	"Les Technologies SoftAcoustik Inc.",
+	"HORIZON COMPUTER",
+	"DELTA COMPUTER SYSTEMS, INC.",
+	"CARLO GAVAZZI, INC.",
+	"TransMedia Communications, Inc.",
+	"IO TECH, INC.",
+	"CAPITOL BUSINESS SYSTEMS, LTD.",
+	"OPTRONICS",
+	"OpenCon Systems, Inc.",
+	"MOLDAT WIRELESS TECHNOLGIES",
+	"NALTEC, Inc.",
+	"MAPLE COMPUTER CORPORATION",
+	"CommUnique Wireless Corp.",
+	"FDK Co., Ltd",
+	"INTERGON, INC.",
+	"TECHNOLOGY ATLANTA CORPORATION",
+	"GIDDINGS & LEWIS",
+	"BRODEL GmbH",
+	"VOICEBOARD CORPORATION",
+	"SATCHWELL CONTROL SYSTEMS, LTD",
+	"FICHET-BAUCHE",
+	"GOOD WAY IND. CO., LTD.",
+	"BOSER TECHNOLOGY CO., LTD.",
+	"INOVA COMPUTERS GMBH & CO. KG",
+	"XITRON TECHNOLOGIES, INC.",
+	"D-LINK",
+	"CMS TECHNOLOGIES",
+	"HAMMER STORAGE SOLUTIONS",
+	"FAST MULTIMEDIA AG",
+	"GATAN, INC.",
+	"GEMFLEX NETWORKS, LTD.",
+	"IEEE REGISTRATION AUTHORITY  - Please see IAB public listing for more information.",
+	"IMD",
+	"ADS Technologies, Inc",
+	"LOOP TELECOMMUNICATION INTERNATIONAL, INC.",
+	"Addonics Technologies, Inc.",
+	"MASPRO DENKOH CORP.",
+	"NET TO NET TECHNOLOGIES",
+	"JETTER",
+	"XYRATEX",
+	"DIGIANSWER A/S",
+	"LG INTERNATIONAL CORP.",
+	"VANLINK COMMUNICATION TECHNOLOGY RESEARCH INSTITUTE",
+	"MINERVA SYSTEMS",
+	"CMC Electronics Inc",
+	"DIGITAL AUDIO PROCESSING PTY. LTD.",
+	"JOOHONG INFORMATION &",
+	"AD SYSTEMS CORP.",
+	"ATLAS COPCO TOOLS AB",
+	"TELSTRAT",
+	"UNICORN COMPUTER CORP.",
+	"ENGETRON-ENGENHARIA ELETRONICA IND. e COM. LTDA",
+	"CONTEMPORARY CONTROL",
+	"TAS TELEFONBAU A. SCHWABE GMBH & CO. KG",
+	"SERRA SOLDADURA, S.A.",
+	"SIGNUM SYSTEMS CORP.",
+	"AirFiber, Inc.",
+	"NS TECH ELECTRONICS SDN BHD",
+	"HAKUSAN CORPORATION",
+	"PARADISE INNOVATIONS (ASIA)",
+	"NOMADIX INC.",
+	"XEL COMMUNICATIONS, INC.",
+	"ALPHA-TOP CORPORATION",
+	"OLICOM A/S",
+	"ANDA NETWORKS",
+	"TEK DIGITEL CORPORATION",
+	"SPE Systemhaus GmbH",
+	"MICROSOFT CORP.",
+	"GLOBAL NET INFORMATION CO., Ltd.",
+	"SIGMATEK GMBH & CO. KG",
+	"PAN-INTERNATIONAL INDUSTRIAL CORP.",
+	"VENTURE MANUFACTURING (SINGAPORE) LTD.",
+	"ENTREGA TECHNOLOGIES, INC.",
+	"Sensormatic Electronics LLC",
+	"OXTEL, LTD.",
+	"VSK ELECTRONICS",
+	"EDIMAX TECHNOLOGY CO., LTD.",
+	"VISIONCOMM CO., LTD.",
+	"PCTVnet ASA",
+	"HAKKO ELECTRONICS CO., LTD.",
+	"Wuxi Keboda Electron Co.Ltd",
+	"Continental Automotive Systems Inc.",
+	"LenovoEMC Products USA, LLC",
+	"SHENZHEN FAST TECHNOLOGIES CO., LTD.",
+	"Gospell DIGITAL TECHNOLOGY CO., LTD",
+	"Xilinx, Inc",
+	"XYCOM INC.",
+	"InnoSys, Inc.",
+	"SCREEN SUBTITLING SYSTEMS, LTD",
+	"TERAOKA WEIGH SYSTEM PTE, LTD.",
+	"COMPUTADORES MODULARES SA",
+	"FEEDBACK DATA LTD.",
+	"SOTEC CO., LTD",
+	"ACRES GAMING, INC.",
+	"SORD COMPUTER CORPORATION",
+	"LOGWARE GmbH",
+	"Eurotech Inc.",
+	"Digital Logic GmbH",
+	"WAVENET INTERNATIONAL, INC.",
+	"WESTELL, INC.",
+	"NETWORK MACHINES, INC.",
+	"CRYSTAL SEMICONDUCTOR CORP.",
+	"POWER COMPUTING CORPORATION",
+	"NETSTAL MASCHINEN AG",
+	"EDEC CO., LTD.",
+	"NET2NET CORPORATION",
+	"CLARIION",
+	"TOKIMEC INC.",
+	"STELLAR ONE CORPORATION",
+	"Roche Diagnostics",
+	"KEITHLEY INSTRUMENTS",
+	"MESA ELECTRONICS",
+	"TELXON CORPORATION",
+	"SOFTLAB, INC.",
+	"STALLION TECHNOLOGIES",
+	"PIVOTAL NETWORKING, INC.",
+	"DSC CORPORATION",
+	"VICOM SYSTEMS, INC.",
+	"PERICOM SEMICONDUCTOR CORP.",
+	"GRADIENT TECHNOLOGIES, INC.",
+	"ACTIVE IMAGING PLC",
+	"VIKING Modular Solutions",
+	"Superior Modular Products",
+	"MACROVISION CORPORATION",
+	"CARY PERIPHERALS INC.",
+	"SYMICRON COMPUTER COMMUNICATIONS, LTD.",
+	"PEAK AUDIO",
+	"LINX Data Terminals, Inc.",
+	"ALERTON TECHNOLOGIES, INC.",
+	"CYCLADES CORPORATION",
+	"VILLAGE TRONIC ENTWICKLUNG",
+	"HRK SYSTEMS",
+	"I-CUBE, INC.",
+	"ACUITY IMAGING, INC.",
+	"ROBERT BOSCH GmbH",
+	"DALLAS SEMICONDUCTOR, INC.",
+	"AIT Austrian Institute of Technology GmbH",
+	"NXP Semiconductors",
+	"SanCom Technology, Inc.",
+	"QUICK CONTROLS LTD.",
+	"AMTEC spa",
+	"HAGIWARA SYS-COM CO., LTD.",
+	"3CX",
+	"PATAPSCO DESIGNS",
+	"NETRO CORP.",
+	"TKS (USA), INC.",
+	"iDirect, INC.",
+	"LITTON/POLY-SCIENTIFIC",
+	"PATHLIGHT TECHNOLOGIES",
+	"VMETRO, INC.",
+	"EMC CORPORATION",
+	"VINA TECHNOLOGIES",
+	"SAIC IDEAS GROUP",
+	"Safe-com GmbH & Co. KG",
+	"MMC NETWORKS, INC.",
+	"CYCLE COMPUTER CORPORATION, INC.",
+	"INTERNIX INC.",
+	"QUALITY SEMICONDUCTOR",
+	"PERIPHERALS ENTERPRISE CO., Ltd.",
+	"TOYODA MACHINE WORKS, LTD.",
+	"CONTROLWARE GMBH",
+	"CORNELL UNIVERSITY",
+	"NETWORK TOOLS, INC.",
+	"MURATA MANUFACTURING CO., LTD.",
+	"COPPER MOUNTAIN COMMUNICATIONS, INC.",
+	"TECHNICAL COMMUNICATIONS CORP.",
+	"CELCORE, INC.",
+	"IntraServer Technology, Inc.",
+	"SCANIVALVE CORP.",
+	"LIBERTY TECHNOLOGY NETWORKING",
+	"NIPPON UNISOFT CORPORATION",
+	"Data Innovations North America",
+	"WHISTLE COMMUNICATIONS CORP.",
+	"TELESYNC, INC.",
+	"PSION DACOM PLC.",
+	"BERNECKER & RAINER INDUSTRIE-ELEKTRONIC GmbH",
+	"LACROIX Trafic",
+	"ACER NETXUS INC.",
+	"Dialogic Corporation",
+	"MITSUBISHI WIRELESS COMMUNICATIONS. INC.",
+	"Synclayer Inc.",
+	"ARESCOM",
+	"DIGITAL EQUIPMENT CORP.",
+	"DAVICOM SEMICONDUCTOR, INC.",
+	"CLARION CORPORATION OF AMERICA",
+	"MIDAS LAB, INC.",
+	"VXL INSTRUMENTS, LIMITED",
+	"REDCREEK COMMUNICATIONS, INC.",
+	"QSC AUDIO PRODUCTS",
+	"PENTEK, INC.",
+	"SCHLUMBERGER TECHNOLOGIES RETAIL PETROLEUM SYSTEMS",
+	"PRISA NETWORKS",
+	"POWER MEASUREMENT LTD.",
+	"Mainstream Data, Inc.",
+	"DVS GmbH",
+	"FORE SYSTEMS, INC.",
+	"WaveAccess, Ltd.",
+	"SENTIENT NETWORKS INC.",
+	"GIGALABS, INC.",
+	"AURORA TECHNOLOGIES, INC.",
+	"MICROTRONIX DATACOM LTD.",
+	"TV/COM INTERNATIONAL",
+	"NOVALINK TECHNOLOGIES, INC.",
+	"DIGITAL VIDEO",
+	"Storage Concepts",
+	"LOGIC REPLACEMENT TECH. LTD.",
+	"KANSAI ELECTRIC CO., LTD.",
+	"WHITE MOUNTAIN DSP, INC.",
+	"XATA",
+	"CITADEL COMPUTER",
+	"ConferTech International",
+	"UNIPULSE CORP.",
+	"HE ELECTRONICS, TECHNOLOGIE & SYSTEMTECHNIK GmbH",
+	"TEKRAM TECHNOLOGY CO., LTD.",
+	"Artiza Networks Inc",
+	"FIRST PACIFIC NETWORKS, INC.",
+	"MICRO/SYS, INC.",
+	"VARIAN",
+	"ACCU-TIME SYSTEMS, INC.",
+	"T.S. MICROTECH INC.",
+	"HT COMMUNICATIONS",
+	"SBE, Inc.",
+	"NJK TECHNO CO.",
+	"ASTRO-MED, INC.",
+	"Perkin-Elmer Incorporated",
+	"PMI FOOD EQUIPMENT GROUP",
+	"ASC X3 - INFORMATION TECHNOLOGY STANDARDS SECRETARIATS",
+	"PHAST CORPORATION",
+	"SWITCHED NETWORK TECHNOLOGIES, INC.",
+	"VPNet, Inc.",
+	"NIHON UNISYS LIMITED CO.",
+	"CONTINUUM TECHNOLOGY CORP.",
+	"GEW Technologies (PTY)Ltd",
+	"PERFORMANCE TELECOM CORP.",
+	"PARTICLE MEASURING SYSTEMS",
+	"MICROSENS GmbH & CO. KG",
+	"TIDOMAT AB",
+	"GESYTEC MbH",
+	"INTELLIGENT DEVICES INC. (IDI)",
+	"RESILIENCE CORPORATION",
+	"MegaChips Corporation",
+	"TRIO INFORMATION SYSTEMS AB",
+	"PACIFIC MICRO DATA, INC.",
+	"HEWLETT-PACKARD CO.",
+	"INPUT/OUTPUT, INC.",
+	"PROCESS CONTROL CORP.",
+	"Z-COM, INC.",
+	"GLENAYRE R&D INC.",
+	"KEBA GmbH",
+	"LAND COMPUTER CO., LTD.",
+	"CHANNELMATIC, INC.",
+	"CORELIS Inc.",
+	"NEC Platforms, Ltd",
+	"SAHARA NETWORKS, INC.",
+	"CABLETRON - NETLINK, INC.",
+	"KeunYoung Electronics & Communication Co., Ltd.",
+	"HUBBELL-PULSECOM",
+	"WEBTRONICS",
+	"MACRAIGOR SYSTEMS, INC.",
+	"Nera Networks AS",
+	"WaveSpan Corporation",
+	"MPL AG",
+	"NETVISION CORPORATION",
+	"SOLITON SYSTEMS K.K.",
+	"ANCOT CORP.",
+	"DCS AG",
+	"AMATI COMMUNICATIONS CORP.",
+	"KUKA WELDING SYSTEMS & ROBOTS",
+	"ControlNet, Inc.",
+	"HARMONIC SYSTEMS INCORPORATED",
+	"HITACHI ZOSEN CORPORATION",
+	"EMTRAK, INCORPORATED",
+	"VideoServer, Inc.",
+	"ACCLAIM COMMUNICATIONS",
+	"ALTEON NETWORKS, INC.",
+	"SNMP RESEARCH INCORPORATED",
+	"CASCADE COMMUNICATIONS",
+	"LUCENT TECHNOLOGIES TAIWAN TELECOMMUNICATIONS CO., LTD.",
+	"AT&T",
+	"ELDAT COMMUNICATION LTD.",
+	"MIYACHI TECHNOS CORP.",
+	"NovAtel Wireless Technologies Ltd.",
+	"ECOLE POLYTECHNIQUE FEDERALE DE LAUSANNE (EPFL)",
+	"ELMIC SYSTEMS, INC.",
+	"TRANSYS NETWORKS INC.",
+	"NTP ELEKTRONIK A/S",
+	"Toyo Network Systems  & System Integration Co. LTD",
+	"MYRICOM, INC.",
+	"Kayser-Threde GmbH",
+	"AXIOM TECHNOLOGY CO., LTD.",
+	"ORCKIT COMMUNICATIONS LTD.",
+	"QUEST ENGINEERING & DEVELOPMENT",
+	"ARBIN INSTRUMENTS",
+	"COMPUSERVE, INC.",
+	"FUJI AUTOMATION CO., LTD.",
+	"SHOMITI SYSTEMS INCORPORATED",
+	"RANDATA",
+	"HITACHI COMPUTER PRODUCTS (AMERICA), INC.",
+	"ATOP TECHNOLOGIES, INC.",
+	"StreamLogic",
+	"FOURTHTRACK SYSTEMS",
+	"HERMARY OPTO ELECTRONICS INC.",
+	"RICARDO TEST AUTOMATION LTD.",
+	"APOLLO",
+	"FLYTECH TECHNOLOGY CO., LTD.",
+	"JOHNSON & JOHNSON MEDICAL, INC",
+	"EXP COMPUTER, INC.",
+	"LASERGRAPHICS, INC.",
+	"ADVANCED COMPUTER SOLUTIONS, Inc.",
+	"ICON WEST, INC.",
+	"NEXTEST COMMUNICATIONS PRODUCTS, INC.",
+	"DATAFUSION SYSTEMS",
+	"Loran International Technologies Inc.",
+	"DIAMOND LANE COMMUNICATIONS",
+	"EDUCATIONAL TECHNOLOGY RESOURCES, INC.",
+	"PACKETEER, INC.",
+	"CONSERVATION THROUGH INNOVATION LTD.",
+	"NetICs, Inc.",
+	"LYNX SYSTEM DEVELOPERS, INC.",
+	"QuVis, Inc.",
+	"Maquet CardioVascular",
+	"Huawei Technologies Co., Ltd",
+	"Shanghai Feixun Communication Co.,Ltd.",
+	"VIZIO Inc",
+	"SHENZHEN UNIVERSAL INTELLISYS PTE LTD",
+	"Vutrix (UK) Ltd",
+	"M/A-COM INC. COMPANIES",
+	"DATA RECALL LTD.",
+	"PEGATRON CORPORATION",
+	"Tinno Mobile Technology Corp",
+	"INID BV",
+	"Ampt, LLC",
+	"Volkswagen Group of America",
+	"China Dragon Technology Limited",
+	"PERIPHONICS CORPORATION",
+	"SATELCOM (UK) LTD",
+	"HYTEC ELECTRONICS LTD.",
+	"ANTLOW COMMUNICATIONS, LTD.",
+	"CACTUS COMPUTER INC.",
+	"COMPUADD CORPORATION",
+	"DLOG NC-SYSTEME",
+	"DYNATECH COMPUTER SYSTEMS",
+	"JUPITER SYSTEMS, INC.",
+	"JAPAN COMPUTER CORP.",
+	"CSK CORPORATION",
+	"VIDECOM LIMITED",
+	"VOSSWINKEL F.U.",
+	"ATLANTIX CORPORATION",
+	"STANDARD MICROSYSTEMS",
+	"COMMODORE INTERNATIONAL",
+	"DIGITAL SYSTEMS INT'L. INC.",
+	"INTEGRATED MEASUREMENT SYSTEMS",
+	"THOMAS-CONRAD CORPORATION",
+	"ESPRIT SYSTEMS",
+	"SEIKO SYSTEMS, INC.",
+	"WANDEL AND GOLTERMANN",
+	"PFU LIMITED",
+	"KOBE STEEL, LTD.",
+	"DAYNA COMMUNICATIONS, INC.",
+	"BELL ATLANTIC",
+	"KODIAK TECHNOLOGY",
+	"NEWPORT SYSTEMS SOLUTIONS",
+	"INTEGRATED INFERENCE MACHINES",
+	"XINETRON, INC.",
+	"KRUPP ATLAS ELECTRONIK GMBH",
+	"NETWORK PRODUCTS",
+	"Alcatel Canada Inc.",
+	"SCAN-OPTICS",
+	"INTEGRATED BUSINESS NETWORKS",
+	"KALPANA, INC.",
+	"STOLLMANN GMBH",
+	"NETWORK PRODUCTS CORPORATION",
+	"ADAPTIVE SYSTEMS, INC.",
+	"TRADPOST (HK) LTD",
+	"EAGLE TECHNOLOGY, INC.",
+	"TEST SYSTEMS & SIMULATIONS INC",
+	"INTEGRATED MARKETING CO",
+	"THE SAGE GROUP PLC",
+	"XYLOGICS INC",
+	"CASTLE ROCK COMPUTING",
+	"NATIONAL INSTRUMENTS CORP.",
+	"NEXUS ELECTRONICS",
+	"BASYS, CORP.",
+	"ACCESS CO., LTD.",
+	"EMS Aviation, Inc.",
+	"SMT GOUPIL",
+	"TECHNOLOGY WORKS, INC.",
+	"REFLEX MANUFACTURING SYSTEMS",
+	"DATA RESEARCH & APPLICATIONS",
+	"ALCATEL STC AUSTRALIA",
+	"VARITYPER, INC.",
+	"APT COMMUNICATIONS, INC.",
+	"TVS ELECTRONICS LTD",
+	"SURIGIKEN CO.,  LTD.",
+	"SYNERNETICS",
+	"TATUNG COMPANY",
+	"JOHN FLUKE MANUFACTURING CO.",
+	"VEB KOMBINAT ROBOTRON",
+	"NETWORLD, INC.",
+	"SYSTECH COMPUTER CORP.",
+	"MATSUSHITA ELECTRIC IND. CO",
+	"IN-NET CORP.",
+	"COMPEX INCORPORATED",
+	"NISSIN ELECTRIC CO., LTD.",
+	"PRO-LOG",
+	"EAGLE TECHNOLOGIES PTY.LTD.",
+	"CONTEC CO., LTD.",
+	"CYCLONE MICROSYSTEMS, INC.",
+	"APEX COMPUTER COMPANY",
+	"DAIKIN INDUSTRIES, LTD.",
+	"ZIATECH CORPORATION",
+	"FIBERMUX",
+	"TECHNICALLY ELITE CONCEPTS",
+	"INTELLICOM, INC.",
+	"FRONTIER TECHNOLOGIES CORP.",
+	"FERMILAB",
+	"SPHINX ELEKTRONIK GMBH",
+	"ADSOFT, LTD.",
+	"PRINTER SYSTEMS CORPORATION",
+	"STANLEY ELECTRIC CO., LTD",
+	"TULIP COMPUTERS INTERNAT'L B.V",
+	"CONDOR SYSTEMS, INC.",
+	"AGILIS CORPORATION",
+	"CANSTAR",
+	"LSI LOGIC CORPORATION",
+	"NETWORK INTERFACE CORPORATION",
+	"LITTON SYSTEMS, INC.",
+	"INTERFACE  CO.",
+	"Hirschmann Automation and Control GmbH",
+	"WYSE TECHNOLOGY LLC",
+	"CYBERGRAPHIC SYSTEMS PTY LTD.",
+	"ARCOM CONTROL SYSTEMS, LTD.",
+	"SQUARE D COMPANY",
+	"YAMATECH SCIENTIFIC LTD.",
+	"COMPUTONE SYSTEMS",
+	"ERI (EMPAC RESEARCH INC.)",
+	"SCHMID TELECOMMUNICATION",
+	"CEGELEC PROJECTS LTD",
+	"CENTURY SYSTEMS CORP.",
+	"NIPPON STEEL CORPORATION",
+	"ONELAN LTD.",
+	"COMPUTADORAS MICRON",
+	"SAI TECHNOLOGY",
+	"MICROPLEX SYSTEMS LTD.",
+	"DWB ASSOCIATES",
+	"FISHER CONTROLS",
+	"PARSYTEC GMBH",
+	"MCNC",
+	"PRACTICAL PERIPHERALS, INC.",
+	"MICROBUS DESIGNS LTD.",
+	"AITECH SYSTEMS LTD.",
+	"ARTEL COMMUNICATIONS CORP.",
+	"FIBERCOM, INC.",
+	"EQUINOX SYSTEMS INC.",
+	"SOUTHERN PACIFIC LTD.",
+	"DY-4 INCORPORATED",
+	"DATAMEDIA CORPORATION",
+	"KENDALL SQUARE RESEARCH CORP.",
+	"PEP MODULAR COMPUTERS GMBH",
+	"AMDAHL",
+	"THE CLOUD INC.",
+	"H-THREE SYSTEMS CORPORATION",
+	"COMPUTER GENERATION INC.",
+	"OKI ELECTRIC INDUSTRY CO., LTD",
+	"VICTOR COMPANY OF JAPAN, LTD.",
+	"TECNETICS (PTY) LTD.",
+	"SUMMIT MICROSYSTEMS CORP.",
+	"DACOLL LIMITED",
+	"NetScout Systems, Inc.",
+	"WESTCOAST TECHNOLOGY B.V.",
+	"RADSTONE TECHNOLOGY",
+	"C. ITOH ELECTRONICS, INC.",
+	"MICROTEK INTERNATIONAL, INC.",
+	"TOKYO ELECTRIC CO.,LTD",
+	"Silex Technology, Inc.",
+	"XYRON CORPORATION",
+	"ALFA LAVAL AUTOMATION AB",
+	"BASIC MERTON HANDELSGES.M.B.H.",
+	"HUMAN DESIGNED SYSTEMS, INC.",
+	"CENTRALP AUTOMATISMES",
+	"TDK CORPORATION",
+	"Eaton Industries GmbH",
+	"NOVUS NETWORKS LTD",
+	"JUSTSYSTEM CORPORATION",
+	"LUXCOM, INC.",
+	"Commscraft Ltd.",
+	"DATUS GMBH",
+	"Alcatel-Lucent Enterprise",
+	"EDISA HEWLETT PACKARD S/A",
+	"MICROTEST, INC.",
+	"CREATIVE ELECTRONIC SYSTEMS",
+	"Lantronix",
+	"LIBERTY ELECTRONICS",
+	"SPEED INTERNATIONAL",
+	"REPUBLIC TECHNOLOGY, INC.",
+	"Honeywell International Inc",
+	"VITACOM CORPORATION",
+	"CLEARPOINT RESEARCH",
+	"MAXPEED",
+	"DUKANE NETWORK INTEGRATION",
+	"IMLOGIX, DIVISION OF GENESYS",
+	"CNET TECHNOLOGY, INC.",
+	"HUGHES NETWORK SYSTEMS",
+	"ALLUMER CO., LTD.",
+	"ADVANCED INFORMATION",
+	"SOFTCOM A/S",
+	"NETWORK EQUIPMENT TECHNOLOGIES",
+	"AVAL DATA CORPORATION",
+	"SOPHIA SYSTEMS",
+	"UNITED NETWORKS INC.",
+	"THEMIS COMPUTER",
+	"STELLAR COMPUTER",
+	"B.U.G. MORISEIKI, INCORPORATED",
+	"ARCHE TECHNOLIGIES INC.",
+	"SPECIALIX (ASIA) PTE, LTD",
+	"HUGHES LAN SYSTEMS",
+	"HITACHI ENGINEERING CO., LTD",
+	"THE FURUKAWA ELECTRIC CO., LTD",
+	"ARIES RESEARCH",
+	"TAKAOKA ELECTRIC MFG. CO. LTD.",
+	"PENRIL DATACOMM",
+	"LANEX CORPORATION",
+	"IEEE 802.1 COMMITTEE",
+	"BICC INFORMATION SYSTEMS & SVC",
+	"DOCUMENT TECHNOLOGIES, INC.",
+	"NOVELLCO DE MEXICO",
+	"NATIONAL DATACOMM CORPORATION",
+	"D-LINK SYSTEMS, INC.",
+	"ALBERTA MICROELECTRONIC CENTRE",
+	"NETCOM RESEARCH INCORPORATED",
+	"FALCO DATA PRODUCTS",
+	"MICROWAVE BYPASS SYSTEMS",
+	"MICRONICS COMPUTER, INC.",
+	"BROADCAST TELEVISION SYSTEMS",
+	"EMBEDDED PERFORMANCE INC.",
+	"COMPUTER PERIPHERALS, INC.",
+	"KIMTRON CORPORATION",
+	"SHINNIHONDENKO CO., LTD.",
+	"SHIVA CORP.",
+	"CHASE RESEARCH LTD.",
+	"CADRE TECHNOLOGIES",
+	"NUVOTECH, INC.",
+	"Fantum Engineering",
+	"NETWORK PERIPHERALS INC.",
+	"EMK Elektronik GmbH & Co. KG",
+	"Bruel & Kjaer Sound & Vibration Measurement A/S",
+	"GRAPHON CORPORATION",
+	"PICKER INTERNATIONAL",
+	"GMX INC/GIMIX",
+	"GIPSI S.A.",
+	"XTP SYSTEMS, INC.",
+	"STMICROELECTRONICS",
+	"T.D.I. CO., LTD.",
+	"CORAL NETWORK CORPORATION",
+	"NORTHWEST DIGITAL SYSTEMS, INC",
+	"NetApp, Inc",
+	"PEER NETWORKS, INC.",
+	"LYNWOOD SCIENTIFIC DEV. LTD.",
+	"CUMULUS CORPORATIION",
+	"COMPCONTROL B.V.",
+	"SUPERCOMPUTING SOLUTIONS, INC.",
+	"IQ TECHNOLOGIES, INC.",
+	"THOMSON CSF",
+	"RATIONAL",
+	"Panasonic Communications Co., Ltd.",
+	"OPUS SYSTEMS",
+	"RAYCOM SYSTEMS INC",
+	"SUN ELECTRONICS CORP.",
+	"TELEMECANIQUE ELECTRIQUE",
+	"Quantel Ltd",
+	"SYNERGY MICROSYSTEMS",
+	"ZENITH ELECTRONICS",
+	"MIZAR, INC.",
+	"HEURIKON CORPORATION",
+	"RWT GMBH",
+	"BVM LIMITED",
+	"AVATAR CORPORATION",
+	"EXSCEED CORPRATION",
+	"AZURE TECHNOLOGIES, INC.",
+	"SOC. DE TELEINFORMATIQUE RTC",
+	"RFTECH",
+	"Black Box Corp.",
+	"CJSC NII STT",
+	"Link One Co., Ltd.",
+	"NETGEAR INC.,",
+	"DIAMOND MULTIMEDIA",
+	"NISHIMU ELECTRONICS INDUSTRIES CO., LTD.",
+	"ALLGON AB",
+	"APLIO",
+	"3COM EUROPE LTD.",
+	"PROTECH SYSTEMS CO., LTD.",
+	"HAMAMATSU PHOTONICS K.K.",
+	"DOMEX TECHNOLOGY CORP.",
+	"HanA Systems Inc.",
+	"I Controls, Inc.",
+	"PROTON ELECTRONIC INDUSTRIAL CO., LTD.",
+	"LANNER ELECTRONICS, INC.",
+	"Overland Storage Inc.",
+	"HANDLINK TECHNOLOGIES, INC.",
+	"KAWASAKI HEAVY INDUSTRIES, LTD",
+	"SIMULATION LABORATORIES, INC.",
+	"WAVTrace, Inc.",
+	"GLOBESPAN SEMICONDUCTOR, INC.",
+	"SAMSAN CORP.",
+	"ROTORK INSTRUMENTS, LTD.",
+	"CENTIGRAM COMMUNICATIONS CORP.",
+	"ZAC",
+	"Zypcom, Inc",
+	"ITO ELECTRIC INDUSTRY CO, LTD.",
+	"HERMES ELECTRONICS CO., LTD.",
+	"UNISPHERE SOLUTIONS",
+	"DIGITAL CONTROLS",
+	"mps Software Gmbh",
+	"PEC (NZ) LTD.",
+	"Selesta Ingegneria S.p.A.",
+	"ADTEC PRODUCTIONS, INC.",
+	"PHILIPS ANALYTICAL X-RAY B.V.",
+	"IVEX",
+	"ZILOG INC.",
+	"PIPELINKS, INC.",
+	"BAE Systems Australia (Electronic Systems) Pty Ltd",
+	"ADVANCED SWITCHING COMMUNICATIONS, INC.",
+	"INTEL CORPORATION",
+	"NIPPON SIGNAL CO., LTD.",
+	"CRYPTO AG",
+	"COMMUNICATION DEVICES, INC.",
+	"DATA & CONTROL EQUIPMENT LTD.",
+	"DATA ELECTRONICS (AUST.) PTY, LTD.",
+	"NAMCO LIMITED",
+	"NETCORE SYSTEMS, INC.",
+	"HONEYWELL-DATING",
+	"MYSTICOM, LTD.",
+	"PELCOMBE GROUP LTD.",
+	"INNOVAPHONE AG",
+	"IMAGIC, INC.",
+	"ALPHA TELECOM, INC.",
+	"ens, inc.",
+	"ACUCOMM, INC.",
+	"FOUNTAIN TECHNOLOGIES, INC.",
+	"SHASTA NETWORKS",
+	"NIHON MEDIA TOOL INC.",
+	"TriEMS Research Lab, Inc.",
+	"ATLANTIC NETWORK SYSTEMS",
+	"BIOPAC SYSTEMS, INC.",
+	"N.V. PHILIPS INDUSTRIAL ACTIVITIES",
+	"AZTEC RADIOMEDIA",
+	"Siemens Network Convergence LLC",
+	"APPLIED DIGITAL ACCESS",
+	"ECCS, Inc.",
+	"ASSURED DIGITAL, INC.",
+	"DEXDYNE, LTD.",
+	"GIGA FAST E. LTD.",
+	"ZEAL CORPORATION",
+	"ENTRIDIA CORPORATION",
+	"CONCUR SYSTEM TECHNOLOGIES",
+	"EPIGRAM, INC.",
+	"SPEC S.A.",
+	"DELEM BV",
+	"ABB POWER T&D COMPANY, INC.",
+	"TELESTE OY",
+	"ULTIMATE TECHNOLOGY CORP.",
+	"SELCOM ELETTRONICA S.R.L.",
+	"DAEWOO ELECTRONICS CO., LTD.",
+	"INNOVATIVE SEMICONDUCTORS, INC",
+	"PARKER HANNIFIN CORPORATION COMPUMOTOR DIVISION",
+	"TELESTREAM, INC.",
+	"AANetcom, Inc.",
+	"Ultra Electronics Ltd., Command and Control Systems",
+	"TELECOM DEVICE K.K.",
+	"DEARBORN GROUP, INC.",
+	"RAYMOND AND LAE ENGINEERING",
+	"EDMI",
+	"NETCOM SICHERHEITSTECHNIK GmbH",
+	"RAULAND-BORG CORPORATION",
+	"SYSTEM CREATE CORP.",
+	"PACIFIC RESEARCH & ENGINEERING CORPORATION",
+	"ICP VORTEX COMPUTERSYSTEME GmbH",
+	"COHERENT COMMUNICATIONS SYSTEMS CORPORATION",
+	"FINISAR CORPORATION",
+	"Troika Networks, Inc.",
+	"WalkAbout Computers, Inc.",
+	"DVT CORP.",
+	"JUNIPER NETWORKS, INC.",
+	"TURNSTONE SYSTEMS, INC.",
+	"APPLIED RESOURCES, INC.",
+	"Sartorius Hamburg GmbH",
+	"PRAXON, INC.",
+	"NEO NETWORKS, INC.",
+	"SIMRAD AS",
+	"GAIO TECHNOLOGY",
+	"ARGON NETWORKS, INC.",
+	"NEC DO BRASIL S.A.",
+	"FMT AIRCRAFT GATE SUPPORT SYSTEMS AB",
+	"ADVANCED FIBRE COMMUNICATIONS",
+	"MER TELEMANAGEMENT SOLUTIONS, LTD.",
+	"ClearOne, Inc.",
+	"Spectralink, Inc",
+	"E-TECH, INC.",
+	"DIGITALCAST, INC.",
+	"Lake Communications",
+	"VETRONIX CORP.",
+	"WatchGuard Technologies, Inc.",
+	"NOT LIMITED, INC.",
+	"ALOHA NETWORKS, INC.",
+	"FORCE INSTITUTE",
+	"TURBO COMMUNICATION, INC.",
+	"ATECH SYSTEM",
+	"GOLDEN ENTERPRISES, INC.",
+	"ITIS",
+	"BAXALL SECURITY LTD.",
+	"SOFTCOM MICROSYSTEMS, INC.",
+	"BAYLY COMMUNICATIONS, INC.",
+	"ETREND ELECTRONICS, INC.",
+	"VICKERS ELECTRONICS SYSTEMS",
+	"Nortel Networks Broadband Access",
+	"AUDIO CODES LTD.",
+	"I-BUS",
+	"DigitalScape, Inc.",
+	"NANAO CORPORATION",
+	"OSPREY TECHNOLOGIES, INC.",
+	"UNIVERSAL AVIONICS",
+	"SBC DESIGNS, INC.",
+	"ALLIED TELESIS, K.K.",
+	"ONE WORLD SYSTEMS, INC.",
+	"MARKEM-IMAJE",
+	"NovaTech Process Solutions, LLC",
+	"Critical IO, LLC",
+	"DIGI-DATA CORPORATION",
+	"8X8 INC.",
+	"Flying Pig Systems/High End Systems Inc.",
+	"CYBERTAN TECHNOLOGY, INC.",
+	"Corecess Inc.",
+	"ALTIGA NETWORKS",
+	"SPECTRA LOGIC",
+	"CLIENTEC CORPORATION",
+	"NineTiles Networks, Ltd.",
+	"WESTERN DIGITAL",
+	"INDIGO ACTIVE VISION SYSTEMS LIMITED",
+	"OPTIVISION, INC.",
+	"ASPECT ELECTRONICS, INC.",
+	"ITALTEL S.p.A.",
+	"J. MORITA MFG. CORP.",
+	"VADEM",
+	"AVICI SYSTEMS INC.",
+	"AGRANAT SYSTEMS",
+	"WILLOWBROOK TECHNOLOGIES",
+	"NIKON CORPORATION",
+	"FIBEX SYSTEMS",
+	"DIGITAL LIGHTWAVE, INC.",
+	"ROHDE & SCHWARZ GMBH & CO. KG",
+	"BERAN INSTRUMENTS LTD.",
+	"VALID NETWORKS, INC.",
+	"TAINET COMMUNICATION SYSTEM Corp.",
+	"TELEMANN CO., LTD.",
+	"OMNIA COMMUNICATIONS, INC.",
+	"IBC/INTEGRATED BUSINESS COMPUTERS",
+	"K.J. LAW ENGINEERS, INC.",
+	"Peco II, Inc.",
+	"JK microsystems, Inc.",
+	"TOPIC SEMICONDUCTOR CORP.",
+	"JAVELIN SYSTEMS, INC.",
+	"INTERNET MAGIC, INC.",
+	"OPTIM SYSTEMS, INC.",
+	"ICOM INC.",
+	"WAVERIDER COMMUNICATIONS (CANADA) INC.",
+	"DPAC Technologies",
+	"ACCORD VIDEO TELECOMMUNICATIONS, LTD.",
+	"Wireless OnLine, Inc.",
+	"Planex Communications",
+	"ENT-EMPRESA NACIONAL DE TELECOMMUNICACOES, S.A.",
+	"TETRA GmbH",
+	"NORTEL",
+	"LEICHU ENTERPRISE CO., LTD.",
+	"ARTEL VIDEO SYSTEMS",
+	"GIESECKE & DEVRIENT GmbH",
+	"BindView Development Corp.",
+	"EUPHONIX, INC.",
+	"CRYSTAL GROUP",
+	"NetBoost Corp.",
+	"WHITECROSS SYSTEMS",
+	"DYNARC, INC.",
+	"NEXT LEVEL COMMUNICATIONS",
+	"TECO INFORMATION SYSTEMS",
+	"MIHARU COMMUNICATIONS Inc",
+	"CARDKEY SYSTEMS, INC.",
+	"MITSUBISHI CHEMICAL AMERICA, INC.",
+	"SYSTRAN CORP.",
+	"TELENA S.P.A.",
+	"DISTRIBUTED PROCESSING TECHNOLOGY",
+	"AVEX ELECTRONICS INC.",
+	"NEC AMERICA, INC.",
+	"TEKNEMA, INC.",
+	"ALi Corporation",
+	"HORSCH ELEKTRONIK AG",
+	"MOXA TECHNOLOGIES CORP., LTD.",
+	"JANZ COMPUTER AG",
+	"ALPHA TECHNOLOGIES, INC.",
+	"SENTRY TELECOM SYSTEMS",
+	"PYRESCOM",
+	"CENTRAL SYSTEM RESEARCH CO., LTD.",
+	"PERSONAL COMMUNICATIONS TECHNOLOGIES",
+	"INTEGRIX, INC.",
+	"Harmonic Video Systems Ltd.",
+	"DOT HILL SYSTEMS CORPORATION",
+	"ASPECT COMMUNICATIONS",
+	"LIGHTNING INSTRUMENTATION",
+	"CLEVO CO.",
+	"ESCALATE NETWORKS, INC.",
+	"NBASE COMMUNICATIONS LTD.",
+	"MEDIATRIX TELECOM",
+	"LEITCH",
+	"PORTWELL, INC.",
+	"NETWORK COMPUTING DEVICES",
+	"CopperCom, Inc.",
+	"ELECOM CO., LTD.  (LANEED DIV.)",
+	"TELLUS TECHNOLOGY INC.",
+	"Crystal Group, Inc.",
+	"Synapse Product Development",
+	"SHENZHEN WISKY TECHNOLOGY CO.,LTD",
+	"Uni-Link Technology Co., Ltd.",
+	"LSD Science and Technology Co.,Ltd.",
+	"Heimann Sensor GmbH",
+	"CARDIAC RECORDERS, INC.",
+	"Beijing Xiaomi Electronic Products Co., Ltd.",
+	"CENTILLION NETWORKS, INC.",
+	"DRS Signal Solutions",
+	"LEEDS & NORTHRUP AUSTRALIA PTY LTD",
+	"Siemens Switzerland Ltd., I B T HVP",
+	"NETPOWER, INC.",
+	"DANIEL INSTRUMENTS, LTD.",
+	"IMAGE DATA PROCESSING SYSTEM GROUP",
+	"APEXX TECHNOLOGY, INC.",
+	"NETCORP",
+	"WHITETREE NETWORK",
+	"Airspan",
+	"COMPUTEX CO., LTD.",
+	"KINGMAX TECHNOLOGY, INC.",
+	"THE PANDA PROJECT",
+	"VISUAL NETWORKS, INC.",
+	"Broadband Technologies",
+	"SYSLOGIC DATENTECHNIK AG",
+	"MUTOH INDUSTRIES LTD.",
+	"TELTREND LTD.",
+	"CSIR",
+	"WYLE",
+	"MICROPOLIS CORP.",
+	"J B M CORPORATION",
+	"CREATIVE CONTROLLERS, INC.",
+	"NEBULA CONSULTANTS, INC.",
+	"BINAR ELEKTRONIK AB",
+	"PREMISYS COMMUNICATIONS, INC.",
+	"NASCENT NETWORKS CORPORATION",
+	"EST CORPORATION",
+	"TRICORD SYSTEMS, INC.",
+	"CITICORP/TTI",
+	"General Dynamics",
+	"CENTRE FOR DEVELOPMENT OF ADVANCED COMPUTING",
+	"APPLIED CREATIVE TECHNOLOGY, INC.",
+	"REDCOM LABS INC.",
+	"TELDAT, S.A.",
+	"FIREPOWER SYSTEMS, INC.",
+	"CONNER PERIPHERALS",
+	"COULTER CORPORATION",
+	"TRANCELL SYSTEMS",
+	"TRANSITIONS RESEARCH CORP.",
+	"interWAVE Communications",
+	"1394 Trade Association",
+	"BRAND COMMUNICATIONS, LTD.",
+	"PIRELLI CAVI",
+	"CAPTOR NV/SA",
+	"HAZELTINE CORPORATION, MS 1-17",
+	"GES SINGAPORE PTE. LTD.",
+	"imc MeBsysteme GmbH",
+	"AXEL",
+	"CYLINK CORPORATION",
+	"APPLIED NETWORK TECHNOLOGY",
+	"Mindray DS USA, Inc.",
+	"EMAIL ELECTRONICS",
+	"ROSS TECHNOLOGY, INC.",
+	"KUBOTEK CORPORATION",
+	"TOSHIN ELECTRIC CO., LTD.",
+	"EG&G NUCLEAR INSTRUMENTS",
+	"OPTO-22",
+	"ATM FORUM",
+	"COMPUTER SOCIETY MICROPROCESSOR & MICROPROCESSOR STANDARDS C",
+	"INFICON",
+	"SPUR PRODUCTS CORP.",
+	"AMERICAN TECHNOLOGY LABS, INC.",
+	"NTT IT CO., LTD.",
+	"PHOENIX CONTACT GMBH & CO.",
+	"SCITEX CORP. LTD.",
+	"INTEGRATED FITNESS CORP.",
+	"QUESTECH, LTD.",
+	"DIGITECH INDUSTRIES, INC.",
+	"NISSHIN ELECTRIC CO., LTD.",
+	"TFL LAN INC.",
+	"INNOVATIVE SYSTEMS & TECHNOLOGIES, INC.",
+	"EDA INSTRUMENTS, INC.",
+	"VOELKER TECHNOLOGIES, INC.",
+	"AMERITEC CORP.",
+	"CYPRESS SEMICONDUCTOR",
+	"ANGIA COMMUNICATIONS. INC.",
+	"STANILITE ELECTRONICS PTY. LTD",
+	"COMPACT DEVICES, INC.",
+	"Data Device Corporation",
+	"MICROPROSS",
+	"LANCOM Systems GmbH",
+	"GLORY, LTD.",
+	"HAMILTON HALLMARK",
+	"KOFAX IMAGE PRODUCTS",
+	"MARQUIP, INC.",
+	"INVENTORY CONVERSION, INC./",
+	"CS COMPUTER SYSTEME GmbH",
+	"MYRIAD LOGIC INC.",
+	"BTG Electronics Design BV",
+	"ACER PERIPHERALS, INC.",
+	"PURITAN BENNETT",
+	"AES PRODATA",
+	"JRL SYSTEMS, INC.",
+	"KVB/ANALECT",
+	"ISA CO., LTD.",
+	"NETWORK SERVICES GROUP",
+	"BHP LIMITED",
+	"Symmetricom, Inc.",
+	"DMS DORSCH MIKROSYSTEM GMBH",
+	"SHINDENGEN ELECTRIC MFG. CO., LTD.",
+	"MANNESMANN TALLY CORPORATION",
+	"AUSTRON, INC.",
+	"THE APPCON GROUP, INC.",
+	"COASTCOM",
+	"VIDEO LOTTERY TECHNOLOGIES,INC",
+	"OVATION SYSTEMS LTD.",
+	"COM21, INC.",
+	"PERCEPTION TECHNOLOGY",
+	"MICRON TECHNOLOGY, INC.",
+	"CARDWARE LAB, INC.",
+	"FUJITSU NEXION, INC.",
+	"ALPS ELECTRIC (USA), INC.",
+	"ADVANCED PERIPHERALS TECHNOLOGIES, INC.",
+	"DAWN COMPUTER INCORPORATION",
+	"TONYANG NYLON CO., LTD.",
+	"SEEQ TECHNOLOGY, INC.",
+	"AVID TECHNOLOGY, INC.",
+	"GSM-SYNTEL, LTD.",
+	"ALCATEL DATA NETWORKS",
+	"NKT ELEKTRONIK A/S",
+	"ASIMMPHONY TURKEY",
+	"Dataplex Pty Ltd",
+	"AMBER WAVE SYSTEMS, INC.",
+	"Microsemi Corporation",
+	"ESSENTIAL COMMUNICATIONS",
+	"XPOINT TECHNOLOGIES, INC.",
+	"BROOKTROUT TECHNOLOGY, INC.",
+	"ASTON ELECTRONIC DESIGNS LTD.",
+	"MultiMedia LANs, Inc.",
+	"JACOMO CORPORATION",
+	"DESKNET SYSTEMS, INC.",
+	"TimeStep Corporation",
+	"APPLICOM INTERNATIONAL",
+	"H. BOLLMANN MANUFACTURERS, LTD",
+	"B/E AEROSPACE, Inc.",
+	"COMSAT CORPORATION",
+	"ACACIA NETWORKS, INC.",
+	"MITSUMI ELECTRIC CO., LTD.",
+	"JC INFORMATION SYSTEMS",
+	"NetApp",
+	"K-NET LTD.",
+	"NIHON KOHDEN AMERICA",
+	"QPSX COMMUNICATIONS, LTD.",
+	"Xyplex, Inc.",
+	"JOHNATHON FREEMAN TECHNOLOGIES",
+	"ICTV",
+	"COMMVISION CORP.",
+	"COMPACT DATA, LTD.",
+	"EPIC DATA INC.",
+	"DIGICOM S.P.A.",
+	"RELIABLE POWER METERS",
+	"MICROS SYSTEMS, INC.",
+	"TEKNOR MICROSYSTEME, INC.",
+	"M.I. SYSTEMS, K.K.",
+	"VORAX CORPORATION",
+	"RENEX CORPORATION",
+	"NAVTEL COMMUNICATIONS INC.",
+	"SPACELABS MEDICAL",
+	"NETCS INFORMATIONSTECHNIK GMBH",
+	"GILAT SATELLITE NETWORKS, LTD.",
+	"MARCONI SPA",
+	"NUCOM SYSTEMS, INC.",
+	"WMS INDUSTRIES",
+	"I-O DATA DEVICE, INC.",
+	"FIRST VIRTUAL CORPORATION",
+	"SHIMA SEIKI",
+	"ZYKRONIX",
+	"TEXAS MICROSYSTEMS, INC.",
+	"3H TECHNOLOGY",
+	"SANRITZ AUTOMATION CO., LTD.",
+	"CORDANT, INC.",
+	"SYMBIOS LOGIC INC.",
+	"PATTON ELECTRONICS CO.",
+	"HILAN GMBH",
+	"VIASAT, INCORPORATED",
+	"I-TECH CORP.",
+	"INTEGRATED CIRCUIT SYSTEMS, INC. COMMUNICATIONS GROUP",
+	"WIRELESS DATA GROUP MOTOROLA",
+	"DIGITAL LINK CORP.",
+	"ORTIVUS MEDICAL AB",
+	"R.A. SYSTEMS CO., LTD.",
+	"UNICOMPUTER GMBH",
+	"CRISTIE ELECTRONICS LTD.",
+	"QUALCOMM INCORPORATED",
+	"TADIRAN TELECOMMUNICATIONS",
+	"INTEL CORPORATION - HF1-06",
+	"FUJITSU DENSO LTD.",
+	"ARK TELECOMMUNICATIONS, INC.",
+	"LITE-ON COMMUNICATIONS, INC.",
+	"DR. JOHANNES HEIDENHAIN GmbH",
+	"Ecessa",
+	"SOTAS, INC.",
+	"TEN X TECHNOLOGY, INC.",
+	"INVENTEC CORPORATION",
+	"ALLIED TELESIS INTERNATIONAL CORPORATION",
+	"INSTEM COMPUTER SYSTEMS, LTD.",
+	"RADIOLAN,  INC.",
+	"SIERRA WIRELESS INC.",
+	"KASTEN CHASE APPLIED RESEARCH",
+	"SPECTRA - TEK",
+	"CONVEX COMPUTER CORPORATION",
+	"INTEGRATED SYSTEMS Technology, Inc.",
+	"FISHER & PAYKEL PRODUCTION",
+	"O.N. ELECTRONIC CO., LTD.",
+	"AZONIX CORPORATION",
+	"YAMAHA CORPORATION",
+	"STS TECHNOLOGIES, INC.",
+	"TENNYSON TECHNOLOGIES PTY LTD",
+	"WESTPORT RESEARCH ASSOCIATES, INC.",
+	"Keisokugiken Corporation",
+	"XKL SYSTEMS CORP.",
+	"OPTIQUEST",
+	"NHC COMMUNICATIONS",
+	"DIALOGIC CORPORATION",
+	"CENTRAL DATA CORPORATION",
+	"REUTERS HOLDINGS PLC",
+	"ELECTRONIC RETAILING SYSTEMS INTERNATIONAL",
+	"ETHERCOM CORP.",
+	"Encore Networks, Inc.",
+	"TRANSMITTON LTD.",
+	"Brooks Automation, Inc.",
+	"NASHOBA NETWORKS",
+	"LUCIDATA LTD.",
+	"TORONTO MICROELECTRONICS INC.",
+	"MTI",
+	"INFOTEK COMMUNICATIONS, INC.",
+	"STAUBLI",
+	"GE",
+	"RADGUARD LTD.",
+	"AutoGas Systems Inc.",
+	"V.I COMPUTER CORP.",
+	"BINTEC COMMUNICATIONS GMBH",
+	"Marconi Communication GmbH",
+	"TORAY ENGINEERING CO., LTD.",
+	"IMAGE SCIENCES, INC.",
+	"SCITEX DIGITAL PRINTING, INC.",
+	"BOSTON TECHNOLOGY, INC.",
+	"TELLABS OPERATIONS, INC.",
+	"ShenZhen ShiHua Technology CO.,LTD",
+	"INAT GmbH",
+	"Guangzhou Yuanyun Network Technology Co.,Ltd",
+	"abatec group AG",
+	"WigWag Inc.",
+	"ITX security",
+	"OLIVETTI TELECOM SPA (OLTECO)",
+	"Grass Valley Group",
+	"InfoGear Technology Corp.",
+	"Westport Technologies",
+	"Rantic Labs, Inc.",
+	"ORSYS GmbH",
+	"ViaGate Technologies, Inc.",
+	"OAO \"Izhevskiy radiozavod\"",
+	"HiQ Networks",
+	"Marconi Communications Inc.",
+	"Atheros Communications",
+	"Honewell Oy",
+	"Jones Futurex Inc.",
+	"Mannesmann Ipulsys B.V.",
+	"LocSoft Limited",
+	"Transmeta Corp.",
+	"Alaris, Inc.",
+	"Morrow Technologies Corp.",
+	"Point Grey Research Inc.",
+	"SIAE-Microelettronica S.p.A.",
+	"Symmetricom",
+	"Tellabs Operations, Inc.",
+	"TECHNOLOGY RESCUE",
+	"Nextcell, Inc.",
+	"Starboard Storage Systems",
+	"British Federal Ltd.",
+	"EACEM",
+	"Ajile Systems, Inc.",
+	"CALY NETWORKS",
+	"NetWorth Technologies, Inc.",
+	"Kontron Design Manufacturing Services (M) Sdn. Bhd",
+	"MacroSAN Technologies Co., Ltd.",
+	"David Electronics Co., LTD.",
+	"Omnibit Inc.",
+	"Nanjing Shining Electric Automation Co., Ltd",
+	"Shenzhen Super Rich Electronics Co.,Ltd",
+	"Biometric Access Company",
+	"OCTOTHORPE CORP.",
+	"HME Co., Ltd.",
+	"UNGERMANN-BASS INC.",
+	"Exar Corp.",
+	"Genetec Inc.",
+	"LANOPTICS, LTD.",
+	"DIATEK PATIENT MANAGMENT",
+	"SERCOMM CORPORATION",
+	"GLOBALNET COMMUNICATIONS",
+	"JAPAN BUSINESS COMPUTER CO.LTD",
+	"LIVINGSTON ENTERPRISES, INC.",
+	"NIPPON AVIONICS CO., LTD.",
+	"PINNACLE DATA SYSTEMS, INC.",
+	"SECO SRL",
+	"KT TECHNOLOGY (S) PTE LTD",
+	"MICRO CRAFT",
+	"NORCONTROL A.S.",
+	"RELIA TECHNOLGIES",
+	"ADVANCED LOGIC RESEARCH, INC.",
+	"PSITECH, INC.",
+	"QUANTUM SOFTWARE SYSTEMS LTD.",
+	"HIRAKAWA HEWTECH CORP.",
+	"INTERACTIVE COMPUTING DEVICES",
+	"NETSPAN CORPORATION",
+	"NETRIX",
+	"TELEMATICS CALABASAS INT'L,INC",
+	"NEW MEDIA CORPORATION",
+	"ELECTRONIC THEATRE CONTROLS",
+	"Fluke Corporation",
+	"LANART CORPORATION",
+	"LEAP TECHNOLOGY, INC.",
+	"COROMETRICS MEDICAL SYSTEMS",
+	"SOCKET COMMUNICATIONS, INC.",
+	"INTERLINK COMMUNICATIONS LTD.",
+	"GRAND JUNCTION NETWORKS, INC.",
+	"LA FRANCAISE DES JEUX",
+	"S.E.R.C.E.L.",
+	"ARCO ELECTRONIC, CONTROL LTD.",
+	"NETEXPRESS",
+	"LASERMASTER TECHNOLOGIES, INC.",
+	"TUTANKHAMON ELECTRONICS",
+	"EDEN SISTEMAS DE COMPUTACAO SA",
+	"DATAPRODUCTS CORPORATION",
+	"LANS TECHNOLOGY CO., LTD.",
+	"CIPHER SYSTEMS, INC.",
+	"JASCO CORPORATION",
+	"Nexans Deutschland GmbH - ANS",
+	"OHKURA ELECTRIC CO., LTD.",
+	"GERLOFF GESELLSCHAFT FUR",
+	"CENTRUM COMMUNICATIONS, INC.",
+	"FUJI PHOTO FILM CO., LTD.",
+	"NETWIZ",
+	"OKUMA CORPORATION",
+	"INTEGRATED ENGINEERING B. V.",
+	"DESIGN RESEARCH SYSTEMS, INC.",
+	"I-CUBED LIMITED",
+	"TELEBIT COMMUNICATIONS APS",
+	"TRANSACTION NETWORK",
+	"QUINTAR COMPANY",
+	"RAYTECH ELECTRONIC CORP.",
+	"DYNATEM",
+	"RASTER IMAGE PROCESSING SYSTEM",
+	"Teridian Semiconductor Corporation",
+	"MEN-MIKRO ELEKTRONIK GMBH",
+	"MULTIACCESS COMPUTING CORP.",
+	"TOWER TECH S.R.L.",
+	"WIESEMANN & THEIS GMBH",
+	"FA. GEBR. HELLER GMBH",
+	"STORES AUTOMATED SYSTEMS, INC.",
+	"ECCI",
+	"DIGITAL TRANSMISSION SYSTEMS",
+	"DATALUX CORP.",
+	"STRATACOM",
+	"EMCOM CORPORATION",
+	"ISOLATION SYSTEMS, LTD.",
+	"Blue Chip Technology Ltd",
+	"UNIMICRO SYSTEMS, INC.",
+	"BAY TECHNICAL ASSOCIATES",
+	"U.S. ROBOTICS, INC.",
+	"GROUP 2000 AG",
+	"CREATIVE MICROSYSTEMS",
+	"DEPARTMENT OF FOREIGN AFFAIRS",
+	"MITEC, INC.",
+	"COMTROL CORPORATION",
+	"DELL COMPUTER CORPORATION",
+	"TOYO DENKI SEIZO K.K.",
+	"ADVANCED INTEGRATION RESEARCH",
+	"BURR-BROWN",
+	"Aspect Software Inc.",
+	"NETWORK PERIPHERALS, LTD.",
+	"MODULAR COMPUTING TECHNOLOGIES",
+	"SOMELEC",
+	"MYCO ELECTRONICS",
+	"DATAEXPERT CORP.",
+	"DENSO CORPORATION",
+	"SEMAPHORE COMMUNICATIONS CORP.",
+	"NETWORKS NORTHWEST, INC.",
+	"ELONEX PLC",
+	"L&N TECHNOLOGIES",
+	"VARI-LITE, INC.",
+	"FINE-PAL COMPANY LIMITED",
+	"ID SCANDINAVIA AS",
+	"SOLECTEK CORPORATION",
+	"IMPULSE TECHNOLOGY",
+	"MORNING STAR TECHNOLOGIES, INC",
+	"GENERAL DATACOMM IND. INC.",
+	"SCOPE COMMUNICATIONS, INC.",
+	"DOCUPOINT, INC.",
+	"UNITED BARCODE INDUSTRIES",
+	"ZAHNER-ELEKTRIK GMBH & CO. KG",
+	"OSI PLUS CORPORATION",
+	"SVEC COMPUTER CORP.",
+	"BOCA RESEARCH, INC.",
+	"HAFT TECHNOLOGY, INC.",
+	"KOMATSU LTD.",
+	"SECTRA SECURE-TRANSMISSION AB",
+	"AREANEX COMMUNICATIONS, INC.",
+	"KNX LTD.",
+	"XEDIA CORPORATION",
+	"TOYODA AUTOMATIC LOOM",
+	"XANTE CORPORATION",
+	"I-DATA INTERNATIONAL A-S",
+	"DAEWOO TELECOM LTD.",
+	"COMPUTER SYSTEMS ENGINEERING",
+	"FONSYS CO.,LTD.",
+	"PRIVA B.V.",
+	"ASCEND COMMUNICATIONS, INC.",
+	"HIGHTECH INFORMATION",
+	"RISC DEVELOPMENTS LTD.",
+	"KUBOTA CORPORATION ELECTRONIC",
+	"NUPON COMPUTING CORP.",
+	"NETSTAR, INC.",
+	"METRODATA LTD.",
+	"MOORE PRODUCTS CO.",
+	"TRACE MOUNTAIN PRODUCTS, INC.",
+	"DATA LINK CORP. LTD.",
+	"ELECTRONICS FOR IMAGING, INC.",
+	"THE LYNK CORPORATION",
+	"UUNET TECHNOLOGIES, INC.",
+	"EKF ELEKTRONIK GMBH",
+	"TELINDUS DISTRIBUTION",
+	"Lauterbach GmbH",
+	"RISQ MODULAR SYSTEMS, INC.",
+	"PERFORMANCE TECHNOLOGIES, INC.",
+	"TRONIX PRODUCT DEVELOPMENT",
+	"NETWORK INFORMATION TECHNOLOGY",
+	"PRAIM S.R.L.",
+	"JABIL CIRCUIT, INC.",
+	"MENNEN MEDICAL INC.",
+	"ALTA RESEARCH CORP.",
+	"VMX INC.",
+	"ZNYX",
+	"TAMURA CORPORATION",
+	"ARCHIPEL SA",
+	"CHUNTEX ELECTRONIC CO., LTD.",
+	"YOSHIKI INDUSTRIAL CO.,LTD.",
+	"PHOTONICS CORPORATION",
+	"RELIANCE COMM/TEC, R-TEC",
+	"DISTRIBUTED SYSTEMS INT'L, INC",
+	"CACHE COMPUTERS, INC.",
+	"QUANTA COMPUTER, INC.",
+	"ADVANCE MICRO RESEARCH, INC.",
+	"TOKYO DENSHI SEKEI CO.",
+	"INTERMEDIUM A/S",
+	"DUAL ENTERPRISES CORPORATION",
+	"UNIGRAF OY",
+	"DICKENS DATA SYSTEMS",
+	"EXICOM AUSTRALIA PTY. LTD",
+	"SEEL LTD.",
+	"GVC CORPORATION",
+	"BARRON MCCANN LTD.",
+	"SILICON VALLEY COMPUTER",
+	"GAMBIT COMPUTER COMMUNICATIONS",
+	"MARBEN COMMUNICATION SYSTEMS",
+	"TOWERCOM CO. INC. DBA PC HOUSE",
+	"TEKLOGIX INC.",
+	"GCC TECHNOLOGIES,INC.",
+	"GENIUS NET CO.",
+	"NORAND CORPORATION",
+	"COMSTAT DATACOMM CORPORATION",
+	"MYSON TECHNOLOGY, INC.",
+	"CORPORATE NETWORK SYSTEMS,INC.",
+	"Overland Storage, Inc.",
+	"AMERICAN POWER CONVERSION CORP",
+	"FRASER'S HILL LTD.",
+	"FUNK SOFTWARE, INC.",
+	"NETVANTAGE",
+	"FORVAL CREATIVE, INC.",
+	"TELECOM AUSTRALIA/CSSC",
+	"INEX TECHNOLOGIES, INC.",
+	"ALCATEL - SEL",
+	"TECHNOLOGY CONCEPTS, LTD.",
+	"SHORE MICROSYSTEMS, INC.",
+	"QUAD/GRAPHICS, INC.",
+	"INFINITE NETWORKS LTD.",
+	"ACUSON COMPUTED SONOGRAPHY",
+	"COMPUTER OPERATIONAL",
+	"SID INFORMATICA",
+	"PERSONAL MEDIA CORP.",
+	"SPARKTRUM MICROSYSTEMS, INC.",
+	"MICRO BYTE PTY. LTD.",
+	"ELSAG BAILEY PROCESS",
+	"ALFA, INC.",
+	"CONTROL TECHNOLOGY CORPORATION",
+	"TELESCIENCES CO SYSTEMS, INC.",
+	"COMELTA, S.A.",
+	"CEI SYSTEMS & ENGINEERING PTE",
+	"IMATRAN VOIMA OY",
+	"RATOC SYSTEM INC.",
+	"COMTREE TECHNOLOGY CORPORATION",
+	"SYNTELLECT, INC.",
+	"OLYMPUS IMAGE SYSTEMS, INC.",
+	"AXON NETWORKS, INC.",
+	"Werbeagentur Jürgen Siebert",
+	"J1 SYSTEMS, INC.",
+	"TAIWAN TRADING CENTER DBA",
+	"UNIVERSAL DATA SYSTEMS",
+	"QUINTE NETWORK CONFIDENTIALITY",
+	"NICE SYSTEMS LTD.",
+	"IPC CORPORATION (PTE) LTD.",
+	"EOS TECHNOLOGIES, INC.",
+	"ZCOMM, INC.",
+	"KYE Systems Corp.",
+	"DSC COMMUNICATION CORP.",
+	"SONIC SOLUTIONS",
+	"CALCOMP, INC.",
+	"OSITECH COMMUNICATIONS, INC.",
+	"SIEMENS BUILDING",
+	"GESPAC, S.A.",
+	"FIBERDATA AB",
+	"PLEXCOM, INC.",
+	"OAK SOLUTIONS, LTD.",
+	"ARRAY TECHNOLOGY LTD.",
+	"SEH COMPUTERTECHNIK GMBH",
+	"DAUPHIN TECHNOLOGY",
+	"US ARMY ELECTRONIC",
+	"KYOCERA CORPORATION",
+	"ABIT CORPORATION",
+	"KINGSTON TECHNOLOGY CORP.",
+	"SHINKO ELECTRIC CO., LTD.",
+	"TRANSITION NETWORKS",
+	"NETWORK COMMUNICATIONS CORP.",
+	"INTERLINK SYSTEM CO., LTD.",
+	"METACOMP, INC.",
+	"CELAN TECHNOLOGY INC.",
+	"ENGAGE COMMUNICATION, INC.",
+	"ABOUT COMPUTING INC.",
+	"CANARY COMMUNICATIONS, INC.",
+	"ADVANCED TECHNOLOGY LABS",
+	"ELASTIC REALITY, INC.",
+	"PROSUM",
+	"APTEC COMPUTER SYSTEMS, INC.",
+	"DDL Co,.ltd.",
+	"Datatech Sistemas Digitales Avanzados SL",
+	"Cambridge Broadband Networks Ltd.",
+	"MAS Elektronik AG",
+	"COMMUNICATION MACHINERY CORP.",
+	"FERRAN SCIENTIFIC, INC.",
+	"VST TECHNOLOGIES, INC.",
+	"DITECH CORPORATION",
+	"COMDA ENTERPRISES CORP.",
+	"PENTACOM LTD.",
+	"ZHS ZEITMANAGEMENTSYSTEME",
+	"MIC ASSOCIATES, INC.",
+	"MACTELL CORPORATION",
+	"HSING TECH. ENTERPRISE CO. LTD",
+	"LANACCESS TELECOM S.A.",
+	"RHK TECHNOLOGY, INC.",
+	"SNIJDER MICRO SYSTEMS",
+	"MICROMERITICS INSTRUMENT",
+	"PLURIS, INC.",
+	"SPEECH DESIGN GMBH",
+	"CONVERGENT NETWORKS, INC.",
+	"PRISM VIDEO, INC.",
+	"GATEWORKS CORP.",
+	"PRIMEX AEROSPACE COMPANY",
+	"ROOT, INC.",
+	"UNIVEX MICROTECHNOLOGY CORP.",
+	"SCM MICROSYSTEMS, INC.",
+	"SYNTECH INFORMATION CO., LTD.",
+	"QWES. COM, INC.",
+	"DAINIPPON SCREEN CORPORATE",
+	"URMET  TLC S.P.A.",
+	"MIMAKI ENGINEERING CO., LTD.",
+	"SBS TECHNOLOGIES,",
+	"FURUNO ELECTRIC CO., LTD.",
+	"PINGTEL CORP.",
+	"Senetas Security",
+	"AIM SYSTEM, INC.",
+	"REGENT ELECTRONICS CORP.",
+	"INCREDIBLE TECHNOLOGIES, INC.",
+	"INFORTREND TECHNOLOGY, INC.",
+	"Cognex Corporation",
+	"XROSSTECH, INC.",
+	"HIRSCHMANN AUSTRIA GMBH",
+	"APPLIED AUTOMATION, INC.",
+	"WAKEFERN FOOD CORPORATION",
+	"Voxent Systems Ltd.",
+	"JETCELL, INC.",
+	"CAMPBELL SCIENTIFIC, INC.",
+	"ADEMCO",
+	"COMMUNICATION AUTOMATION CORP.",
+	"VLSI TECHNOLOGY INC.",
+	"Safetran Systems Corp",
+	"INDUSTRIAL LOGIC CORPORATION",
+	"YANO ELECTRIC CO., LTD.",
+	"DALIAN DAXIAN NETWORK",
+	"ORMEC SYSTEMS CORP.",
+	"BEHAVIOR TECH. COMPUTER CORP.",
+	"TECHNOLOGY ATLANTA CORP.",
+	"Pace France",
+	"FIVEMERE, LTD.",
+	"UTILICOM, INC.",
+	"ZONEWORX, INC.",
+	"VISION PRODUCTS PTY. LTD.",
+	"Vieo, Inc.",
+	"GALILEO TECHNOLOGY, LTD.",
+	"ROCKETCHIPS, INC.",
+	"AMERICAN COMMUNICATION",
+	"SYSMATE CO., LTD.",
+	"AMIGO TECHNOLOGY CO., LTD.",
+	"MAHLO GMBH & CO. UG",
+	"ZONAL RETAIL DATA SYSTEMS",
+	"ALIDIAN NETWORKS, INC.",
+	"KVASER AB",
+	"DOLBY LABORATORIES, INC.",
+	"XN TECHNOLOGIES",
+	"ECTON, INC.",
+	"IMPRESSTEK CO., LTD.",
+	"PRESENCE TECHNOLOGY GMBH",
+	"LA CIE GROUP S.A.",
+	"EUROTEL TELECOM LTD.",
+	"DIV OF RESEARCH & STATISTICS",
+	"LOGIBAG",
+	"BITRONICS, INC.",
+	"ISKRATEL",
+	"O2 MICRO, INC.",
+	"CONNECTED SYSTEMS",
+	"SAS INSTITUTE INC.",
+	"KATHREIN-WERKE KG",
+	"SOMAT CORPORATION",
+	"ULTRAK, INC.",
+	"AMBIT MICROSYSTEMS CORP.",
+	"SYMBIONICS, LTD.",
+	"ACROLOOP MOTION CONTROL",
+	"KATHREIN TechnoTrend GmbH",
+	"INTELLIWORXX, INC.",
+	"STRATABEAM TECHNOLOGY, INC.",
+	"VALCOM, INC.",
+	"TREMON ENTERPRISES CO., LTD.",
+	"DIGIGRAM",
+	"MULTITEL",
+	"TOKO ELECTRIC",
+	"WINTRISS ENGINEERING CORP.",
+	"CAMPIO COMMUNICATIONS",
+	"IWILL CORPORATION",
+	"TECHNOLOGIC SYSTEMS",
+	"LINKUP SYSTEMS CORPORATION",
+	"SR TELECOM INC.",
+	"SHAREWAVE, INC.",
+	"ACRISON, INC.",
+	"TRENDVIEW RECORDERS LTD.",
+	"KMC CONTROLS",
+	"LONG WELL ELECTRONICS CORP.",
+	"ECHELON CORP.",
+	"BROADLOGIC",
+	"ACN ADVANCED COMMUNICATIONS",
+	"TAQUA SYSTEMS, INC.",
+	"ALARIS MEDICAL SYSTEMS, INC.",
+	"Bank of America",
+	"Eltex of Sweden AB",
+	"AMAQUEST COMPUTER CORP.",
+	"COMCAM INTERNATIONAL INC",
+	"KOYO ELECTRONICS INC. CO.,LTD.",
+	"COSINE COMMUNICATIONS",
+	"KEYCORP LTD.",
+	"STRATEGY & TECHNOLOGY, LIMITED",
+	"EXABYTE CORPORATION",
+	"RTD Embedded Technologies, Inc.",
+	"IOWAVE INC.",
+	"INVERTEX, INC.",
+	"NEXCOMM SYSTEMS, INC.",
+	"OTIS ELEVATOR COMPANY",
+	"FOVEON, INC.",
+	"MICROFIRST INC.",
+	"DYNACOLOR, INC.",
+	"PHOTRON USA",
+	"GENOA TECHNOLOGY, INC.",
+	"PHOENIX GROUP, INC.",
+	"NVISION INC.",
+	"ARDENT TECHNOLOGIES, INC.",
+	"SMARTSAN SYSTEMS, INC.",
+	"GLENAYRE WESTERN MULTIPLEX",
+	"TQ - COMPONENTS GMBH",
+	"Seeion Control LLC",
+	"Photon Dynamics Canada Inc.",
+	"Elcard Wireless Systems Oy",
+	"FILANET CORPORATION",
+	"SPECTEL LTD.",
+	"KAPADIA COMMUNICATIONS",
+	"VERIS INDUSTRIES",
+	"2WIRE, INC.",
+	"NOVTEK TEST SYSTEMS",
+	"MIPS DENMARK",
+	"OSKAR VIERLING GMBH + CO. KG",
+	"INTEGRATED DEVICE",
+	"VOCAL DATA, INC.",
+	"ALANTRO COMMUNICATIONS",
+	"AMERICAN ARIUM",
+	"LANBIRD TECHNOLOGY CO., LTD.",
+	"TOKYO SOKKI KENKYUJO CO., LTD.",
+	"NETWORK ENGINES, INC.",
+	"SHINANO KENSHI CO., LTD.",
+	"CHASE COMMUNICATIONS",
+	"DELTAKABEL TELECOM CV",
+	"GRAYSON WIRELESS",
+	"TL INDUSTRIES",
+	"ORESIS COMMUNICATIONS, INC.",
+	"CUTLER-HAMMER, INC.",
+	"BITSWITCH LTD.",
+	"OMEGA ELECTRONICS SA",
+	"XIOTECH CORPORATION",
+	"DRS Technologies Canada Ltd",
+	"KATSUJIMA CO., LTD.",
+	"IPricot formerly DotCom",
+	"CRESCENT NETWORKS, INC.",
+	"Iomega Corporation",
+	"Silicon Image GmbH",
+	"EMUTEC INC.",
+	"PIVOTAL TECHNOLOGIES",
+	"HARMONIC DATA SYSTEMS, LTD.",
+	"BALTHAZAR TECHNOLOGY AB",
+	"VIVID TECHNOLOGY PTE, LTD.",
+	"TERATECH CORPORATION",
+	"COMPUTATIONAL SYSTEMS, INC.",
+	"THOMAS & BETTS CORP.",
+	"PATHWAY, INC.",
+	"Prevas A/S",
+	"ADVANTECH CO., LTD.",
+	"DASAN CO., LTD.",
+	"TECHNOLOGIES LYRE INC.",
+	"ATAN TECHNOLOGY INC.",
+	"ASYST ELECTRONIC",
+	"MORETON BAY",
+	"ZHONGXING TELECOM LTD.",
+	"EPILOG CORPORATION",
+	"V-BITS, INC.",
+	"GRUNDIG AG",
+	"AETHRA TELECOMUNICAZIONI",
+	"B2C2, INC.",
+	"DEDICATED MICROCOMPUTERS",
+	"TAICOM DATA SYSTEMS CO., LTD.",
+	"MCQUAY INTERNATIONAL",
+	"MODULAR MINING SYSTEMS, INC.",
+	"SUNRISE TELECOM, INC.",
+	"PHILIPS MULTIMEDIA NETWORK",
+	"KUZUMI ELECTRONICS, INC.",
+	"DOOIN ELECTRONICS CO.",
+	"AVIONITEK ISRAEL INC.",
+	"MRT MICRO, INC.",
+	"ELE-CHEM ENGINEERING CO., LTD.",
+	"SOLIDUM SYSTEMS CORP.",
+	"IBOND INC.",
+	"VCON TELECOMMUNICATION LTD.",
+	"MAC SYSTEM CO., LTD.",
+	"Advantage Century Telecommunication Corp.",
+	"NEXTONE COMMUNICATIONS, INC.",
+	"LIGHTERA NETWORKS, INC.",
+	"NAKAYO TELECOMMUNICATIONS, INC",
+	"XIOX",
+	"DICTAPHONE CORPORATION",
+	"IGT",
+	"CONVISION TECHNOLOGY GMBH",
+	"SEGA ENTERPRISES, LTD.",
+	"MONTEREY NETWORKS",
+	"SOLARI DI UDINE SPA",
+	"CARINTHIAN TECH INSTITUTE",
+	"ORANGE MICRO, INC.",
+	"Alcatel Canada",
+	"NEXT NETS CORPORATION",
+	"FUJIAN STAR TERMINAL",
+	"ACUTE COMMUNICATIONS CORP.",
+	"Thales e-Security Ltd.",
+	"TEK MICROSYSTEMS, INCORPORATED",
+	"GRANITE MICROSYSTEMS",
+	"OPTIMA TELE.COM, INC.",
+	"ASTRAL POINT",
+	"ACETEL",
+	"Hotel Technology Next Generation",
+	"Albedo Telecom SL",
+	"THAMWAY CO.,LTD.",
+	"STRAND LIGHTING LIMITED",
+	"CROSSROADS SYSTEMS, INC.",
+	"NOKIA WIRELESS BUSINESS COMMUN",
+	"PMC-SIERRA, INC.",
+	"TECHNICAL CORP.",
+	"SILICON INTEGRATED SYS. CORP.",
+	"Avaya ECS Ltd",
+	"AMAZING CONTROLS! INC.",
+	"MARATHON TECHNOLOGIES CORP.",
+	"DIBA, INC.",
+	"ROOFTOP COMMUNICATIONS CORP.",
+	"MOTOROLA",
+	"RADIANT SYSTEMS",
+	"AVALON IMAGING SYSTEMS, INC.",
+	"SHANGHAI BAUD DATA",
+	"HESS SB-AUTOMATENBAU GmbH",
+	"Uniden Corporation",
+	"PLUTO TECHNOLOGIES INTERNATIONAL INC.",
+	"EASTERN ELECTRONIC CO., LTD.",
+	"HEIWA CORPORATION",
+	"RAPID CITY COMMUNICATIONS",
+	"EXXACT GmbH",
+	"ING. GIORDANO ELETTRONICA",
+	"COMTEC SYSTEMS. CO., LTD.",
+	"SPHERE COMMUNICATIONS, INC.",
+	"Cradlepoint, Inc",
+	"WebTV NETWORKS, INC.",
+	"AVIDIA Systems, Inc.",
+	"TECNOMEN OY",
+	"FREEGATE CORP.",
+	"Analog Devices Inc.",
+	"TELRAD",
+	"GADZOOX NETWORKS",
+	"dit Co., Ltd.",
+	"Redlake MASD LLC",
+	"DUX, INC.",
+	"APTIX CORPORATION",
+	"STANDARD MICROSYSTEMS CORP.",
+	"TANDBERG TELEVISION AS",
+	"EXTREME NETWORKS",
+	"AST COMPUTER",
+	"InnoMediaLogic, Inc.",
+	"SPC ELECTRONICS CORPORATION",
+	"MCNS HOLDINGS, L.P.",
+	"MELITA INTERNATIONAL CORP.",
+	"HAGIWARA ELECTRIC CO., LTD.",
+	"MISYS FINANCIAL SYSTEMS, LTD.",
+	"E.E.P.D. GmbH",
+	"PIONEER CORPORATION",
+	"CENTURY CORPORATION",
+	"PROXIMA CORPORATION",
+	"PARADYNE CORP.",
+	"PROMINET CORPORATION",
+	"AdvanSys",
+	"FOCON ELECTRONIC SYSTEMS A/S",
+	"ALFATECH, INC.",
+	"JATON CORPORATION",
+	"DeskStation Technology, Inc.",
+	"CSPI",
+	"Pacom Systems Ltd.",
+	"VitalCom",
+	"LSICS CORPORATION",
+	"TOUCHWAVE, INC.",
+	"BENTLY NEVADA CORP.",
+	"InFocus Corporation",
+	"SDL COMMUNICATIONS, INC.",
+	"MICROWI ELECTRONIC GmbH",
+	"ZX Technologies, Inc",
+	"JUMP INDUSTRIELLE COMPUTERTECHNIK GmbH",
+	"REALTEK SEMICONDUCTOR CORP.",
+	"INTERNET INITIATIVE JAPAN, INC",
+	"SANYO DENKI CO., LTD.",
+	"EXECUTONE INFORMATION SYSTEMS, INC.",
+	"TALX CORPORATION",
+	"CELLPORT LABS, INC.",
+	"KODAI HITEC CO., LTD.",
+	"INGENIERIA ELECTRONICA COMERCIAL INELCOM S.A.",
+	"HOLONTECH CORPORATION",
+	"HAN MICROTELECOM. CO., LTD.",
+	"PHASE ONE DENMARK A/S",
+	"CONTROLLED ENVIRONMENTS, LTD.",
+	"GALEA NETWORK SECURITY",
+	"WEST END SYSTEMS CORP.",
+	"MATSUSHITA KOTOBUKI ELECTRONICS INDUSTRIES, LTD.",
+	"UNITEC CO., LTD.",
+	"JAPAN AVIATION ELECTRONICS INDUSTRY, LTD.",
+	"e-Net, Inc.",
+	"SHERWOOD",
+	"EdgePoint Networks, Inc.",
+	"HOST ENGINEERING",
+	"CABLETRON - YAGO SYSTEMS, INC.",
+	"SAMSUNG ELECTRONICS",
+	"OPTICAL ACCESS INTERNATIONAL",
+	"ProMax Systems, Inc.",
+	"eac AUTOMATION-CONSULTING GmbH",
+	"MERRIMAC SYSTEMS INC.",
+	"JAYCOR",
+	"KAPSCH AG",
+	"W&G SPECIAL PRODUCTS",
+	"Ultra Electronics Limited (AEP Networks)",
+	"COMPUWARE CORPORATION",
+	"FAR SYSTEMS S.p.A.",
+	"DH TECHNOLOGY",
+	"EPIS MICROCOMPUTER",
+	"LYNK",
+	"NATIONAL AMUSEMENT NETWORK, INC.",
+	"TIERNAN COMMUNICATIONS, INC.",
+	"DEVELOPMENT CONCEPTS, INC.",
+	"WEBGEAR, INC.",
+	"BERKELEY NETWORKS",
+	"A.T.N.R.",
+	"MIKRODIDAKT AB",
+	"METTLER-TOLEDO, INC.",
+	"NETRONIX, INC.",
+	"WALT DISNEY IMAGINEERING",
+	"LOGISTISTEM s.r.l.",
+	"CONTROL RESOURCES CORPORATION",
+	"TYAN COMPUTER CORP.",
+	"ANERMA",
+	"JATO TECHNOLOGIES, INC.",
+	"COMPULITE R&D",
+	"GLOBAL MAINTECH, INC.",
+	"Emerson Network Power, Avocent Division",
+	"LeCroy - Networking Productions Division",
+	"LTX-Credence CORPORATION",
+	"GEC AVERY, LTD.",
+	"QLogic Corp.",
+	"NEOPARADIGM LABS, INC.",
+	"PRESSURE SYSTEMS, INC.",
+	"UTSTARCOM",
+	"BECKMAN LAB. AUTOMATION DIV.",
+	"LG ELECTRONICS, INC.",
+	"ADMTEK INCORPORATED",
+	"ACKFIN NETWORKS",
+	"OSAI SRL",
+	"ADVANCED-VISION TECHNOLGIES CORP.",
+	"SHIMADZU CORPORATION",
+	"CARRIER ACCESS CORPORATION",
+	"SAMSON AG",
+	"Positron Inc.",
+	"ENGAGE NETWORKS, INC.",
+	"MII",
+	"SARNOFF CORPORATION",
+	"QUANTUM CORPORATION",
+	"PIXEL VISION",
+	"WILTRON CO.",
+	"HIMA PAUL HILDEBRANDT GmbH Co. KG",
+	"MICROSLATE INC.",
+	"ESAOTE S.p.A.",
+	"ComCore Semiconductor, Inc.",
+	"TELOGY NETWORKS, INC.",
+	"IPC INFORMATION SYSTEMS, INC.",
+	"SAT GmbH & Co.",
+	"FUNAI ELECTRIC CO., LTD.",
+	"ELECTROSONIC LTD.",
+	"DIMAT S.A.",
+	"MIDSCO, INC.",
+	"EES TECHNOLOGY, LTD.",
+	"XAQTI CORPORATION",
+	"GENERAL DYNAMICS INFORMATION SYSTEMS",
+	"TELMAX COMMUNICATIONS CORP.",
+	"EtherWAN Systems, Inc.",
+	"TECHNO SCOPE CO., LTD.",
+	"ARDENT COMMUNICATIONS CORP.",
+	"Entrada Networks",
+	"PI GROUP, LTD.",
+	"GATEWAY 2000",
+	"BYAS SYSTEMS",
+	"BERGHOF AUTOMATIONSTECHNIK GmbH",
+	"NBX CORPORATION",
+	"SYMON COMMUNICATIONS, INC.",
+	"INTERFACE SYSTEMS, INC.",
+	"GENROCO INTERNATIONAL, INC.",
+	"TORRENT NETWORKING TECHNOLOGIES CORP.",
+	"SEIWA ELECTRIC MFG. CO., LTD.",
+	"MEMOREX TELEX JAPAN, LTD.",
+	"NECSY S.p.A.",
+	"SAKAI SYSTEM DEVELOPMENT CORP.",
+	"HORNER ELECTRIC, INC.",
+	"BCOM ELECTRONICS INC.",
+	"LINK2IT, L.L.C.",
+	"EUROTECH SRL",
+	"VIRTUAL ACCESS, LTD.",
+	"AutomatedLogic Corporation",
+	"BEST DATA PRODUCTS",
+	"RESON, INC.",
+	"HERO SYSTEMS, LTD.",
+	"SAAB SENSIS CORPORATION",
+	"ARN",
+	"INTEGRATED DEVICE TECHNOLOGY, INC.",
+	"NETSPEED, INC.",
+	"TELSIS LIMITED",
+	"VERSANET COMMUNICATIONS, INC.",
+	"DATENTECHNIK GmbH",
+	"EXCELLENT COMPUTER",
+	"COMPUTER & COMMUNICATION RESEARCH LAB.",
+	"SUNSHINE ELECTRONICS, INC.",
+	"LANBit Computer, Inc.",
+	"TAZMO CO., LTD.",
+	"ViaVideo Communications, Inc.",
+	"NEXWARE CORP.",
+	"ZENITH ELECTRONICS CORPORATION",
+	"DATAX NV",
+	"KEYMILE GmbH",
+	"SI ELECTRONICS, LTD.",
+	"G2 NETWORKS, INC.",
+	"INNOVA CORP.",
+	"SK-ELEKTRONIK GmbH",
+	"FANUC ROBOTICS NORTH AMERICA, Inc.",
+	"CINCO NETWORKS, INC.",
+	"INCAA DATACOM B.V.",
+	"RAYTHEON E-SYSTEMS, INC.",
+	"GRETACODER Data Systems AG",
+	"DATA LABS, INC.",
+	"INNOVAT COMMUNICATIONS, INC.",
+	"DIGICOM SYSTEMS, INCORPORATED",
+	"CELESTICA INC.",
+	"SILICOM, LTD.",
+	"MAREL HF",
+	"DIONEX",
+	"ABLER TECHNOLOGY, INC.",
+	"THAT CORPORATION",
+	"ARLOTTO COMNET, INC.",
+	"WebSprint Communications, Inc.",
+	"INSIDE Technology A/S",
+	"TELES AG",
+	"DECISION EUROPE",
+	"DICNA CONTROL AB",
+	"TRL TECHNOLOGY, LTD.",
+	"LEIGHTRONIX, INC.",
+	"HUAWEI TECHNOLOGIES CO., LTD.",
+	"A-TREND TECHNOLOGY CO., LTD.",
+	"SECURITY DYNAMICS TECHNOLOGIES, Inc.",
+	"AK-Systems Ltd",
+	"ARIMA Communications Corp.",
+	"NIXDORF COMPUTER CORP.",
+	"Netzin Technology Corporation,.Ltd.",
+	"Meggitt Training Systems, Inc.",
+	"Sony Mobile Communications AB",
+	"KWB Gmbh",
+	"WOO Sports",
+	"Orbis Systems Oy",
+	"Samsung Elec Co.,Ltd",
+	"PT. Panggung Electric Citrabuana",
+	"CLOOS ELECTRONIC GMBH",
+	"WebSilicon Ltd.",
+	"Intrepid Control Systems, Inc.",
+	"NEVATEC",
+	"DATA RECALL LTD",
+	"CARDIAC RECORDERS INC.",
+	"OLIVETTI TELECOMM SPA (OLTECO)",
+	"NIXDORF COMPUTER CORPORATION",
+	"XMIT AG COMPUTER NETWORKS",
+	"ViewSonic Mobile China Limited",
+	"WaveIP",
+	"Host Mobility AB",
+	"Dream Ware Inc.",
+	"Panasonic AVC Networks Company",
+	"Wireless Standard Extensions",
+	"GFR Gesellschaft für Regelungstechnik und Energieeinsparung mbH",
+	"PicoCELA, Inc.",
+	"ATOCS (Shenzhen) LTD",
+	"Partron",
+	"Gyeyoung I&T",
+	"ChongQing QingJia Electronics CO.,LTD",
+	"TELECON GALICIA,S.A.",
+	"Ramaxel Technology (Shenzhen) limited company",
+	"GuangZhou Hedy Digital Technology Co., Ltd",
+	"Fujian Sanao Technology Co.,Ltd",
+	"Ericsson AB",
+	"Adapteva, Inc.",
+	"BriView (Xiamen) Corp.",
+	"Sertel Electronics UK Ltd",
+	"Sichuan Whayer information industry Co.,LTD",
+	"Zmodo Technology Corporation",
+	"gosund GROUP CO.,LTD",
+	"camtron industrial inc.",
+	"Shenzhen Yichen Technology Development Co.,LTD",
+	"ALSTOM HYDRO FRANCE",
+	"Nome Oy",
+	"scemtec Hard- und Software fuer Mess- und Steuerungstechnik GmbH",
+	"Bryston Ltd.",
+	"TaiYear Electronic Technology (Suzhou) Co., Ltd",
+	"Globalstar Inc.",
+	"Aligera Equipamentos Digitais Ltda",
+	"CSST",
+	"Clack Corporation",
+	"7INOVA TECHNOLOGY LIMITED",
+	"Eifelwerk Butler Systeme GmbH",
+	"Beats Electronics LLC",
+	"Avaya, Inc",
+	"Skspruce Technology Limited",
+	"ThinPAD Technology (Shenzhen)CO.,LTD",
+	"Netcore Technology Inc.",
+	"iway AG",
+	"CATCH THE WIND INC",
+	"ALPS Electric Co,. Ltd.",
+	"Shenzhen Yoostar Technology Co., Ltd",
+	"Eadingcore  Intelligent Technology Co., Ltd.",
+	"BMT Medical Technology s.r.o.",
+	"Smobile Co., Ltd.",
+	"Netscout Systems, Inc.",
+	"Emicon",
+	"Seamap (UK) Ltd",
+	"BSP Co., Ltd.",
+	"ISB Corporation",
+	"Tigo Energy",
+	"Tellabs Inc.",
+	"Fiberhome Telecommunication Tech.Co.,Ltd.",
+	"Samtec Inc",
+	"Phistek INC.",
+	"EchoStar Technologies Corp",
+	"Traka plc",
+	"Wilocity LTD.",
+	"MANYCOLORS, INC.",
+	"ZNV",
+	"Y&H E&C Co.,LTD.",
+	"Apple, Inc.",
+	"Suntech International Ltd.",
+	"Velocytech",
+	"Rockport Networks Inc",
+	"Car Connectivity Consortium",
+	"TRIUMPH-ADLER AG",
+	"IMS Soluções em Energia Ltda.",
+	"AEP Ticketing solutions srl",
+	"Cohda Wireless Pty Ltd",
+	"Acroname Inc.",
+	"AMPAK Technology Inc.",
+	"PJRC.COM, LLC",
+	"x-fabric GmbH",
+	"Compex Systems Pte Ltd",
+	"Tarana Wireless",
+	"Xena Networks",
+	"Flaircomm Microelectronics, Inc.",
+	"NOVAMEDIA INNOVISION SP. Z O.O.",
+	"COMPUTERVISION CORPORATION",
+	"BRIDGE COMMUNICATIONS INC.",
+	"ADVANCED COMPUTER COMM.",
+	"CROMEMCO INCORPORATED",
+	"SYMBOLICS INC.",
+	"SIEMENS AG",
+	"BOLT BERANEK AND NEWMAN INC.",
+	"NESTAR SYSTEMS INCORPORATED",
+	"UNISYS CORPORATION",
+	"MIKLYN DEVELOPMENT CO.",
+	"INTERNATIONAL COMPUTERS LTD.",
+	"NCR CORPORATION",
+	"MITEL CORPORATION",
+	"TEKTRONIX INC.",
+	"BELL ATLANTIC INTEGRATED SYST.",
+	"EXXON",
+	"EXCELAN",
+	"STC BUSINESS SYSTEMS",
+	"BARRISTER INFO SYS CORP",
+	"NATIONAL SEMICONDUCTOR",
+	"PIRELLI FOCOM NETWORKS",
+	"GENERAL ELECTRIC CORPORATION",
+	"TIARA/ 10NET",
+	"KDD-KOKUSAI DEBNSIN DENWA CO.",
+	"ABLE COMMUNICATIONS INC.",
+	"APOLLO COMPUTER INC.",
+	"3M COMPANY",
+	"NBI INC.",
+	"10NET COMMUNICATIONS/DCA",
+	"CONTROL DATA",
+	"NORSK DATA A.S.",
+	"CADMUS COMPUTER SYSTEMS",
+	"MEGATEK CORPORATION",
+	"MOSAIC TECHNOLOGIES INC.",
+	"BRITTON LEE INC.",
+	"LAN-TEC INC.",
+	"METAPHOR COMPUTER SYSTEMS",
+	"PRIME COMPUTER INC.",
+	"ROYAL MELBOURNE INST OF TECH",
+	"LITTLE MACHINES INC.",
+	"TIGAN INCORPORATED",
+	"BAUSCH & LOMB",
+	"FILENET CORPORATION",
+	"MICROFIVE CORPORATION",
+	"INTERGRAPH CORPORATION",
+	"FUJI-XEROX CO. LTD.",
+	"BULL S.A.S.",
+	"SPIDER SYSTEMS LIMITED",
+	"ORCATECH INC.",
+	"TORUS SYSTEMS LIMITED",
+	"SCHLUMBERGER WELL SERVICES",
+	"CADNETIX CORPORATIONS",
+	"CODEX CORPORATION",
+	"FRED KOSCHARA ENTERPRISES",
+	"FERRANTI COMPUTER SYS. LIMITED",
+	"RACAL-MILGO INFORMATION SYS..",
+	"JAPAN MACNICS CORP.",
+	"PIXEL COMPUTER INC.",
+	"DAVID SYSTEMS INC.",
+	"CONCURRENT COMPUTER CORP.",
+	"SEQUENT COMPUTER SYSTEMS INC.",
+	"EUROTHERM GAUGING SYSTEMS",
+	"UNIVATION",
+	"BANYAN SYSTEMS INC.",
+	"PLANNING RESEARCH CORP.",
+	"HYDRA COMPUTER SYSTEMS INC.",
+	"CORVUS SYSTEMS INC.",
+	"CYGNET SYSTEMS",
+	"DAISY SYSTEMS CORP.",
+	"EXPERDATA",
+	"INSYSTEC",
+	"MIDDLE EAST TECH. UNIVERSITY",
+	"STANFORD TELECOMM. INC.",
+	"STANFORD LINEAR ACCEL. CENTER",
+	"EVANS & SUTHERLAND",
+	"SYSTEMS CONCEPTS",
+	"A/S MYCRON",
+	"VTA TECHNOLOGIES INC.",
+	"FOUR PHASE SYSTEMS",
+	"GOULD INC.",
+	"COUNTERPOINT COMPUTER INC.",
+	"SABER TECHNOLOGY CORP.",
+	"INDUSTRIAL NETWORKING INC.",
+	"JAROGATE LTD.",
+	"GENERAL DYNAMICS",
+	"PLESSEY",
+	"Sitasys AG",
+	"GENRAD INC.",
+	"AGFA CORPORATION",
+	"COMDESIGN",
+	"RIDGE COMPUTERS",
+	"SILICON GRAPHICS INC.",
+	"ATT BELL LABORATORIES",
+	"ACCEL TECHNOLOGIES INC.",
+	"SUNTEK TECHNOLOGY INT'L",
+	"WHITECHAPEL COMPUTER WORKS",
+	"MASSCOMP",
+	"PHILIPS APELDOORN B.V.",
+	"MITSUBISHI ELECTRIC CORP.",
+	"MATRA (DSIE)",
+	"XEROX CORP UNIV GRANT PROGRAM",
+	"TECMAR INC.",
+	"CASIO COMPUTER CO. LTD.",
+	"DANSK DATA ELECTRONIK",
+	"PC LAN TECHNOLOGIES",
+	"TSL COMMUNICATIONS LTD.",
+	"ACCELL CORPORATION",
+	"THE DROID WORKS",
+	"INDATA",
+	"SANYO ELECTRIC CO. LTD.",
+	"VITALINK COMMUNICATIONS CORP.",
+	"AMALGAMATED WIRELESS(AUS) LTD",
+	"CARNEGIE-MELLON UNIVERSITY",
+	"AES DATA INC.",
+	"ASTECH INC.",
+	"VERITAS SOFTWARE",
+	"Seiko Instruments Inc.",
+	"TOMEN ELECTRONICS CORP.",
+	"ELXSI",
+	"XYPLEX",
+	"KINETICS",
+	"PerfTech, Inc.",
+	"PYRAMID TECHNOLOGY CORP.",
+	"NETWORK RESEARCH CORPORATION",
+	"XYVISION INC.",
+	"TANDEM COMPUTERS",
+	"CHIPCOM CORPORATION",
+	"SONOMA SYSTEMS",
+	"KRG CORPORATE",
+	"DongGuang EnMai Electronic Product Co.Ltd.",
+	"AMSC",
+	"Masimo Corp",
+	"SysMik GmbH Dresden",
+	"Mission Technology Group, dba Magma",
+	"GECO, Inc.",
+	"Velex s.r.l.",
+	"KSP INC.",
+	"Bitel Co., Ltd.",
+	"UNIBRAIN S.A.",
+	"Shenzhen Sea Star Technology Co.,Ltd",
+	"A. S. Thomas, Inc.",
+	"Shanghai Mexon Communication Technology Co.,Ltd",
+	"WondaLink Inc.",
+	"BinCube",
+	"ADVANSEE",
+	"APS systems/electronic AG",
+	"SRD Innovations Inc.",
+	"Network Instruments",
+	"Hewlett Packard",
+	"CASwell INC.",
+	"Topaz Co. LTD.",
+	"Funkwerk plettac electronic GmbH",
+	"Junilab, Inc.",
+	"Shinoda Plasma Co., Ltd.",
+	"Hon Hai Precision Ind.Co.Ltd",
+	"WSH GmbH",
+	"Intellian Technologies, Inc.",
+	"Gridstore Inc.",
+	"VODALYS Ingénierie",
+	"Raycore Taiwan Co., LTD.",
+	"H2A Systems, LLC",
+	"Broad Net Mux Corporation",
+	"Orion Diagnostica Oy",
+	"EbV Elektronikbau- und Vertriebs GmbH",
+	"TP-LINK TECHNOLOGIES CO.,LTD.",
+	"Recovision Technology Co., Ltd.",
+	"Fortinet, Inc.",
+	"Mercury Corporation",
+	"Japan System Design",
+	"EITO ELECTRONICS CO., LTD.",
+	"Shenzhen MIMOWAVE Technology Co.,Ltd",
+	"Winterhalter Gastronom GmbH",
+	"Obelux Oy",
+	"ViE Technologies Sdn. Bhd.",
+	"Auto Industrial Co., Ltd.",
+	"AIM GmbH",
+	"SVYAZKOMPLEKTSERVICE, LLC",
+	"Quintic Corp.",
+	"Altasec technology corporation",
+	"HongKong Ipro Technology Co., Limited",
+	"Belkin International, Inc.",
+	"Ryowa Electronics Co.,Ltd",
+	"SF Software Solutions",
+	"Hills Sound Vision & Lighting",
+	"Wistron Infocomm (Zhongshan) Corporation",
+	"Technicolor CH USA Inc",
+	"Shenzhen Strong Rising Electronics Co.,Ltd DongGuan Subsidiary",
+	"QUANTA COMPUTER INC.",
+	"LEROY AUTOMATION",
+	"ShenZhen EZL Technology Co., Ltd",
+	"Sunnovo International Limited",
+	"Azurewave",
+	"Benu Video, Inc.",
+	"Totus Solutions, Inc.",
+	"Cynny Italia S.r.L.",
+	"Abicom International",
+	"Lite-On Technogy Corp.",
+	"Wireless Seismic",
+	"AK-NORD EDV VERTRIEBSGES. mbH",
+	"Astrol Electronic AG",
+	"Toyou Feiji Electronics Co., Ltd.",
+	"samtec automotive electronics & software GmbH",
+	"HISENSE ELECTRIC CO.,LTD.",
+	"Proformatique",
+	"Techman Electronics (Changshu) Co., Ltd.",
+	"Seers Technology Co., Ltd",
+	"Shenzhen RF Technology Co,.Ltd",
+	"NANJING FUJITSU COMPUTER PRODUCTS CO.,LTD.",
+	"JEBSEE ELECTRONICS CO.,LTD.",
+	"Jiangsu Huitong Group Co.,Ltd.",
+	"Humax",
+	"World Elite Technology Co.,LTD",
+	"SAYME WIRELESS SENSOR NETWORK",
+	"Towerstream Corpration",
+	"Net One Partners Co.,Ltd.",
+	"GET Engineering",
+	"GLOBO Multimedia Sp. z o.o. Sp.k.",
+	"Fohhn Audio AG",
+	"OpenXS BV",
+	"Jantar d.o.o.",
+	"Juniper Systems",
+	"Ringslink (Xiamen) Network Communication Technologies Co., Ltd",
+	"Uniqoteq Ltd.",
+	"SDTEC Co., Ltd.",
+	"TELECSYS",
+	"Inform Electronik",
+	"Beijing Xiaomi communications co.,ltd",
+	"SeAH Networks",
+	"noax Technologies AG",
+	"Valuable Techologies Limited",
+	"electric imp, incorporated",
+	"Beijing General Research Institute of Mining and Metallurgy",
+	"QiiQ Communications Inc.",
+	"Fanvil Technology Co., Ltd.",
+	"Observator instruments",
+	"Dome Imaging Inc",
+	"Apple, Inc",
+	"MS Sedco",
+	"Mitrastar Technology",
+	"ASA-RT s.r.l.",
+	"CHAUVIN ARNOUX",
+	"Axiros GmbH",
+	"HyBroad Vision (Hong Kong) Technology Co Ltd",
+	"Mueller Systems",
+	"Axtion Sdn Bhd",
+	"DOLI Elektronik GmbH",
+	"Nanjing Signway Technology Co., Ltd",
+	"PrimeVOLT Co., Ltd.",
+	"BEIJING GEHUA CATV NETWORK CO.,LTD",
+	"Anaren Microwave, Inc.",
+	"Kexiang Information Technology Co, Ltd.",
+	"SHENZHEN MAGNUS TECHNOLOGIES CO.,LTD",
+	"Wuhan Huagong Genuine Optics Technology Co., Ltd",
+	"A.O. Smith Water Products",
+	"Zenovia Electronics Inc.",
+	"Kamo Technology Limited",
+	"Suunto Oy",
+	"TOP EIGHT IND CORP",
+	"Hui Zhou Gaoshengda Technology Co.,LTD",
+	"Rice Lake Weighing Systems",
+	"PT. Prasimax Inovasi Teknologi",
+	"BNS Solutions",
+	"Shanghai Magic Mobile Telecommunication Co.Ltd.",
+	"Consort Controls Ltd",
+	"Sankosha Corporation",
+	"Blinq Wireless Inc.",
+	"Chameleon Technology (UK) Limited",
+	"Alcatel Lucent IPD",
+	"OB Telecom Electronic Technology Co., Ltd",
+	"Sunitec Enterprise Co.,Ltd",
+	"Unitend Technologies Inc.",
+	"GENUS POWER INFRASTRUCTURES LIMITED",
+	"Digience Co.,Ltd.",
+	"Alcatel Lucent",
+	"TCT Mobile Limited",
+	"Genetec",
+	"MAGNETI MARELLI SISTEMAS ELECTRONICOS MEXICO",
+	"Meritec",
+	"EUCAST Co., Ltd.",
+	"Wuxi YSTen Technology Co.,Ltd.",
+	"DAGS",
+	"Summer Infant, Inc.",
+	"Samwin Hong Kong Limited",
+	"ASCO Numatics GmbH",
+	"EASTRIVER TECHNOLOGY CO., LTD.",
+	"EDIC Systems Inc.",
+	"SPRINGWAVE Co., Ltd",
+	"Binatone Telecommunication Pvt. Ltd",
+	"Westell",
+	"Amimon Ltd",
+	"Axium Technologies, Inc.",
+	"Hangzhou H3C Technologies Co., Limited",
+	"Inala Technologies",
+	"Plantronics, Inc",
+	"DH electronics GmbH",
+	"Fox Crypto B.V.",
+	"Bonfiglioli Vectron GmbH",
+	"ELIMOS srl",
+	"AnaCom Inc",
+	"IEEE REGISTRATION AUTHORITY  - Please see MAM public listing for more information.",
+	"Malgn Technology Co., Ltd.",
+	"Globalsat International Technology Ltd",
+	"Java Information",
+	"EM Microelectronic",
+	"Beijing Signalway Technologies Co.,Ltd",
+	"Airoha Technology Corp.,",
+	"LaonPeople",
+	"Ashley Butterworth",
+	"Janome Sewing Machine Co., Ltd.",
+	"pomdevices, LLC",
+	"Online Security Pty. Ltd.",
+	"Embedian, Inc.",
+	"NEC CASIO Mobile Communications",
+	"Fu Gang Electronic(KunShan)CO.,LTD",
+	"McCain Inc",
+	"Vivo International Corporation Pty Ltd",
+	"Korins Inc.",
+	"ITG, Inc.",
+	"Justec International Technology INC.",
+	"Elmo Motion Control",
+	"ON-Q LLC dba ON-Q Mesh Networks",
+	"ZeroDesktop, Inc.",
+	"TVIP",
+	"Morion Inc.",
+	"XIMEA",
+	"Looxcie Inc.",
+	"FLECTRON Co., LTD",
+	"Simlink AS",
+	"HFC Technology (Beijing) Ltd. Co.",
+	"Apple Inc",
+	"Soundmax Electronic Limited",
+	"Shaanxi Hitech Electronic Co., LTD",
+	"Norphonic AS",
+	"LNT-Automation GmbH",
+	"Beijing Duokan Technology Limited",
+	"Mitsubishi Electric Corporation",
+	"Innovative Computer Engineering",
+	"Shanghai Genvision Industries Co.,Ltd",
+	"Peplink International Ltd.",
+	"Perma-Pipe, Inc.",
+	"DuroByte Inc",
+	"Adatis GmbH & Co. KG",
+	"ADFweb.com s.r.l.",
+	"Core Brands LLC",
+	"IQSIM",
+	"Ad-Sol Nissin Corp",
+	"Cloud Telecomputers, LLC",
+	"EoCell",
+	"Shenzhen Jinkeyi Communication Co., Ltd.",
+	"Hanvit SI, Inc.",
+	"U&U ENGINEERING INC.",
+	"Microseven Systems, LLC",
+	"Daruma Telecomunicações e Informática S.A.",
+	"RAONIX Inc.",
+	"Tosibox Oy",
+	"FUJIKURA RUBBER LTD.",
+	"Murata Manufacturing Co.,Ltd.",
+	"Mobile Create Co.,Ltd.",
+	"SK Mtek Limited",
+	"Beijing Cyber Cloud Technology Co. ,Ltd.",
+	"Shenzhen ATUE Technology Co., Ltd",
+	"base Co.,Ltd.",
+	"Plastoform Industries Ltd.",
+	"Lika srl",
+	"GANA I&C CO., LTD",
+	"Blu-ray Disc Association",
+	"UTT Co., Ltd.",
+	"BIO SOUND LAB CO., LTD.",
+	"SHENZHEN JUCHIN TECHNOLOGY CO., LTD",
+	"Garmin International",
+	"Midas Klark Teknik Ltd",
+	"PRECIA",
+	"AXIMUM PRODUITS ELECTRONIQUES",
+	"INSTAR Deutschland GmbH",
+	"Maxway Electronics CO.,LTD",
+	"automationNEXT GmbH",
+	"Shenzhen CareDear Communication Technology Co.,Ltd",
+	"Qi Hardware Inc.",
+	"Seohwa Telecom",
+	"APR, LLC",
+	"Source Technologies, LLC",
+	"PhaseSpace",
+	"Canoga Perkins Corporation",
+	"Gridco Systems, Inc.",
+	"T3 Innovation",
+	"vivo Mobile Communication Co., Ltd.",
+	"Reacheng Communication Technology Co.,Ltd",
+	"KangSheng LTD.",
+	"Shany Electronic Co., Ltd.",
+	"Abrantix AG",
+	"FREEBOX SAS",
+	"Anakreon UK LLP",
+	"TOKYO COSMOS ELECTRIC CO.,LTD.",
+	"Treetech Sistemas Digitais",
+	"Motorola Mobility LLC",
+	"Volex Inc.",
+	"Intellimedia Systems Ltd",
+	"eero inc.",
+	"EUTRONIX CO., LTD.",
+	"NEMOA ELECTRONICS (HK) CO. LTD",
+	"Armtel Ltd.",
+	"Guangdong Appscomm Co.,Ltd",
+	"Incipio Technologies, Inc",
+	"Amphitech",
+	"Avermetrics",
+	"Mediabridge Products, LLC.",
+	"Future Designs, Inc.",
+	"PROCOM Systems",
+	"Dynapower Company LLC",
+	"Beijing Shidai Hongyuan Network Communication Co.,Ltd",
+	"Honeywell (China) Co., LTD",
+	"Monaco Enterprises, Inc.",
+	"Creative&Link Technology Limited",
+	"AVISTEL",
+	"Shenzhen Doov Technology Co.,Ltd",
+	"Digital Control Incorporated",
+	"Samsung Electro Mechanics co.,LTD.",
+	"Max Communication GmbH",
+	"Entis Co., Ltd.",
+	"Savitech Corp.",
+	"Logi-D inc",
+	"JABIL CIRCUIT (SHANGHAI) LTD.",
+	"Cypress Electronics Limited",
+	"Shenzhen Fortune Ship Technology Co., Ltd.",
+	"TUBITAK UEKAE",
+	"JOA TELECOM.CO.,LTD",
+	"Hefei Radio Communication Technology Co., Ltd",
+	"VIXTEL TECHNOLOGIES LIMTED",
+	"ADS GmbH",
+	"KongTop industrial(shen zhen)CO.,LTD",
+	"BLU CASTLE S.A.",
+	"S.M. Dezac S.A.",
+	"ShenZhen Telacom Science&Technology Co., Ltd",
+	"MST CORPORATION",
+	"Industrial Software Co",
+	"InfiniWing, Inc.",
+	"ARCHEAN Technologies",
+	"DUNE HD LTD",
+	"Sabtech Industries",
+	"TP-LINK TECHNOLOGIES CO.,LTD",
+	"OHSUNG ELECTRONICS CO., LTD.",
+	"D-Link International",
+	"CONCH ELECTRONIC Co.,Ltd",
+	"S NET MEDIA",
+	"mLogic LLC",
+	"BSMediasoft Co., Ltd.",
+	"Wächter GmbH Sicherheitssysteme",
+	"Kaiam Corporation",
+	"AirNav Systems LLC",
+	"Xtremio Ltd.",
+	"ShenYang ZhongKe-Allwin Technology Co.LTD",
+	"Xiaomi inc.",
+	"Wuhan FiberHome Digital Technology Co.,Ltd.",
+	"SAGITTAR LIMITED",
+	"Fitbit Inc.",
+	"Elektrobit Wireless Communications Ltd",
+	"IBT Interfaces",
+	"DILAX Intelcom GmbH",
+	"Nanotron Technologies GmbH",
+	"iSonea Limited",
+	"Westinghouse Electric Company, LLC",
+	"CEDINT-UPM",
+	"TEB SAS",
+	"DAEWOOIS",
+	"Cameo Communications, Inc.",
+	"Tamtron Oy",
+	"Aztech Associates Inc.",
+	"Sage Co., Ltd.",
+	"8D Technologies",
+	"Concept Development, Inc.",
+	"Woojin Industrial Systems Co., Ltd.",
+	"LAON TECHNOLOGY CO., LTD.",
+	"Elecsys International Corporation",
+	"Wuhan Lingjiu High-tech Co.,Ltd.",
+	"CAP-TECH INTERNATIONAL CO., LTD.",
+	"Unicoi Systems",
+	"BYD Precision Manufacture Company Ltd.",
+	"Riava Networks, Inc.",
+	"Fastback Networks",
+	"Alcatel-Lucent Shanghai Bell Co., Ltd",
+	"MESSOA TECHNOLOGIES INC.",
+	"Hanyang Digitech Co.Ltd",
+	"XIAOMI INC",
+	"Zenotech.Co.,Ltd",
+	"BobjGear LLC",
+	"SAGEMCOM SAS",
+	"Top Victory Electronics (Taiwan) Co., Ltd.",
+	"Veros Systems, Inc.",
+	"Hanover Displays Limited",
+	"KOMEG Industrielle Messtechnik GmbH",
+	"Beward R&D Co., Ltd.",
+	"Adanis Inc.",
+	"eta plus electronic gmbh",
+	"GMJ ELECTRIC LIMITED",
+	"AMICCOM Electronics Corporation",
+	"Valeo Vision Systems",
+	"shenzhen kaism technology Co. Ltd",
+	"Barberry Solutions Ltd",
+	"Alibaba Cloud Computing Ltd.",
+	"CoreTrust Inc.",
+	"DIGITAL ART SYSTEM",
+	"Beijing Jinhong Xi-Dian Information Technology Corp.",
+	"CerebrEX Inc.",
+	"TP Vision Belgium N.V. - innovation site Brugge",
+	"G2C Co. Ltd.",
+	"Virtual Instruments",
+	"TechFaith Wireless Technology Limited",
+	"CSE-Servelec Limited",
+	"PROVISION THAI CO., LTD.",
+	"Fon Technology",
+	"Ultra Electronics - Electrics",
+	"Polostar Technology Corporation",
+	"Siemens Convergence Creators GmbH&Co.KG",
+	"DIGITRONIC Automationsanlagen GmbH",
+	"Torrey Pines Logic, Inc",
+	"Netlogic AB",
+	"Nest Labs Inc.",
+	"I-Storm",
+	"Invoxia",
+	"L-TECH CORPORATION",
+	"Tucson Embedded Systems",
+	"Shenzhen Hualistone Technology Co.,Ltd",
+	"Philio Technology Corporation",
+	"SMG Holdings LLC",
+	"Inmarsat",
+	"Kurth Electronic GmbH",
+	"Qvis Labs, LLC",
+	"STT Condigi",
+	"Viking Electronics Inc.",
+	"MODULETEK",
+	"NetComm Wireless Limited",
+	"Multimedia Pacific Limited",
+	"i3 International Inc.",
+	"ISC applied systems corp",
+	"Changhe Electronics Co., Ltd.",
+	"Espressif Inc.",
+	"Shenzhen Rui Ying Da Technology Co., Ltd",
+	"IDY Corporation",
+	"Lg Innotek",
+	"EPICOM S.A",
+	"Sypro Optics GmbH",
+	"Wartsila Finland Oy",
+	"IEEE PES PSRC/SUB",
+	"Pinyon Technologies",
+	"ShenZhen RicherLink Technologies Co.,LTD",
+	"eyevis GmbH",
+	"Dalian Hi-Think Computer Technology, Corp",
+	"ITS Telecom",
+	"Innovation Wireless",
+	"NEW Lift Neue Elektronische Wege Steuerungsbau GmbH",
+	"Cloudium Systems Ltd.",
+	"AccuSpec Electronics, LLC",
+	"Sigma Koki Co.,Ltd.",
+	"Gemalto M2M GmbH",
+	"JAPAN CIRCUIT CO.,LTD",
+	"IMS Messsysteme GmbH",
+	"AMON INC",
+	"SMG ENTERPRISE, LLC",
+	"AzureWave",
+	"Techaya LTD",
+	"DONGGUAN HELE ELECTRONICS CO., LTD",
+	"FLAT DISPLAY TECHNOLOGY CORPORATION",
+	"Motorola Mobility LLC, a Lenovo Company",
+	"Samsung Eletronics Co., Ltd (Visual Display Divison)",
+	"Philips Electronics Nederland BV",
+	"PRIMA Cinema, Inc",
+	"Shenzhen Belzon Technology Co.,LTD.",
+	"Beijing Ereneben Information Technology Co.,Ltd Shenzhen Branch",
+	"OpenProducts 237 AB",
+	"Mitsunami Co., Ltd.",
+	"Terasic Technologies Inc.",
+	"Shenzhen Tencent Computer System Co., Ltd.",
+	"EID",
+	"Vitek Industrial Video Products, Inc.",
+	"Coriant GmbH",
+	"Fuji Xerox Co., Ltd.",
+	"3bumen.com",
+	"Hefei Bitland Information Technology Co.Ltd",
+	"Linked IP GmbH",
+	"FORMOSA WIRELESS COMMUNICATION CORP.",
+	"MCT CO., LTD.",
+	"DB Communication & Systems Co., ltd.",
+	"Phase Motion Control SpA",
+	"Integrated System Technologies Ltd",
+	"RUAG Schweiz AG",
+	"I-LAX ELECTRONICS INC.",
+	"Veethree Electronics And Marine LLC",
+	"Weifang goertek Electronics CO.,LTD",
+	"PRICOM Design",
+	"Murata Manufactuaring Co.,Ltd.",
+	"Zoovel Technologies",
+	"Beijing Nari Smartchip Microelectronics Company Limited",
+	"ruwido austria gmbh",
+	"Shenzhen Gongjin Electronics Co.,Ltd",
+	"SHENZHEN CHUANGWEI-RGB ELECTRONICS CO.,LT",
+	"Innovolt",
+	"Bosung Electronics Co., Ltd.",
+	"D-LINK INTERNATIONAL PTE LIMITED",
+	"TDC A/S",
+	"OJSC \"Ufimskiy Zavod \"Promsvyaz\"",
+	"Amplified Engineering Pty Ltd",
+	"Wavetronix",
+	"MileSight Technology Co., Ltd.",
+	"Absolute Acoustics",
+	"Shenzhen Huapu Digital CO.,Ltd",
+	"Kriwan Industrie-Elektronik GmbH",
+	"Marshal Corporation",
+	"Ilshin Elecom",
+	"Wearhaus Inc.",
+	"SCAPS GmbH",
+	"Turtle Industry Co., Ltd.",
+	"Realfiction ApS",
+	"IDentytech Solutins Ltd.",
+	"Linea Research Ltd",
+	"RADMAX COMMUNICATION PRIVATE LIMITED",
+	"OOO InProMedia",
+	"Xiangshan Giant Eagle Technology Developing co.,LTD",
+	"IEC – TC9 WG43",
+	"Most Lucky Trading Ltd",
+	"Scientech Materials Corporation",
+	"Shenzhen Sunchip Technology Co., Ltd",
+	"Elatec GmbH",
+	"Teleview",
+	"Visionscape Co,. Ltd.",
+	"Kong Yue Electronics & Information Industry (Xinhui) Ltd.",
+	"HDSN",
+	"Kuipers Electronic Engineering BV",
+	"feno GmbH",
+	"Smarteh d.o.o.",
+	"Schneider Electric Asia Pacific Ltd",
+	"VECOW Co., Ltd",
+	"Advanced Network Devices (dba:AND)",
+	"Hanscan Spain S.A.",
+	"Hugo Brennenstuhl Gmbh & Co. KG.",
+	"Axxana(israel) ltd",
+	"NETGEAR",
+	"Risk Technology Ltd",
+	"Salix Technology CO., Ltd.",
+	"Paragon Technologies Inc.",
+	"Coval",
+	"Shenzhen Wonhe Technology Co., Ltd",
+	"Yangzhou ChangLian Network Technology Co,ltd.",
+	"Abeeway",
+	"SAMSUNG ELECTRO MECHANICS CO.,LTD.",
+	"Pluto inc.",
+	"Wistron InfoComm Manufacturing(Kunshan)Co.,Ltd.",
+	"Atlas Elektronik UK Limited",
+	"Braemac CA LLC",
+	"Shenzhen Voxtech Co.,Ltd",
+	"Lenovo (Beijing) Limited.",
+	"Quanta Microsystems,Inc.",
+	"Assa",
+	"AES motomation",
+	"COMPAL INFORMATION (KUNSHAN) CO., LTD",
+	"PROFALUX",
+	"I'M SPA",
+	"Fujian Star-net Communication Co., Ltd",
+	"Volacomm Co., Ltd",
+	"JIAXING GLEAD Electronics Co.,Ltd",
+	"Lee-Dickens Ltd",
+	"Bointec Taiwan Corporation Limited",
+	"IP-NET LLC",
+	"Enclustra GmbH",
+	"Mimosa Networks",
+	"Omicron electronics GmbH",
+	"Jabil Circuit Hungary Ltd.",
+	"DVL",
+	"SHENZHEN SPACETEK TECHNOLOGY CO.,LTD",
+	"i Wit Digital Co., Limited",
+	"Shanghai annijie Information technology Co.,LTD",
+	"Panasonic Corporation AVC Networks Company",
+	"Texas Instruments, Inc",
+	"Peraso Technologies",
+	"Wincal Technology Corp.",
+	"Korea Infocom Co.,Ltd.",
+	"Samsung Eletronics Co., Ltd",
+	"Posh Mobile Limited",
+	"Iota, Inc.",
+	"Cheetah Hi-Tech, Inc.",
+	"Nanjing SAC Power Grid Automation Co., Ltd.",
+	"Spark srl",
+	"Siemens Healthcare Diagnostics, Inc",
+	"SHENZHEN RIOPINE ELECTRONICS CO., LTD",
+	"Ability enterprise co.,Ltd.",
+	"Elefirst Science & Tech Co ., ltd",
+	"MTData Developments Pty. Ltd.",
+	"Shenzhen Bilian electronic CO.,LTD",
+	"Codex Digital Limited",
+	"Delta Electronics",
+	"Cambridge Executive Limited",
+	"System In Frontier Inc.",
+	"M2M Solution S.A.S.",
+	"MTN Electronic Co. Ltd",
+	"Devlin Electronics Limited",
+	"AzureWaveTechnologies,Inc",
+	"Viettel Group",
+	"KOSTAL Industrie Elektrik GmbH",
+	"Shenzhen Ecsino Tecnical Co. Ltd",
+	"Hutek Co., Ltd.",
+	"Entropix, LLC",
+	"Chongqing Ehs Science and Technology Development Co.,Ltd.",
+	"Squarehead Technology AS",
+	"Shanghai Nutshell Electronic Co., Ltd.",
+	"Hangzhou Tuners Electronics Co., Ltd",
+	"SHENZHEN JEHE TECHNOLOGY DEVELOPMENT CO., LTD.",
+	"Calsys, Inc.",
+	"SHARP Corporation.",
+	"Toshiba Global Commerce Solutions",
+	"EMC Electronic Media Communication SA",
+	"Dynamode Group",
+	"Alinco,incorporated",
+	"GEMUE Gebr. Mueller Apparatebau",
+	"PentronicAB",
+	"Innovative Converged Devices Inc",
+	"iRule LLC",
+	"KYOCERA Corporation",
+	"sysmocom - systems for mobile communications GmbH",
+	"CYG SUNRI CO.,LTD.",
+	"innodisk Corporation",
+	"Jasmine Systems Inc.",
+	"IT-IS International",
+	"IOTTECH Corp",
+	"Shanghai Net Miles Fiber Optics Technology Co., LTD.",
+	"Westcontrol AS",
+	"KSH International Co., Ltd.",
+	"Prowave Technologies Ltd.",
+	"Ctek, Inc.",
+	"SEnergy Corporation",
+	"Voxtronic Technology Computer-Systeme GmbH",
+	"OPEN ROAD SOLUTIONS , INC.",
+	"Techvision Intelligent Technology Limited",
+	"KOUKAAM a.s.",
+	"Ubiquiti Networks, INC",
+	"Thales Canada Inc.",
+	"Panasonic Automotive Systems Asia Pacific(Thailand)Co.,Ltd.",
+	"PURE Storage",
+	"NEXGEN Mediatech Inc.",
+	"Shanghai Mobiletek Communication Ltd.",
+	"FRIEM SPA",
+	"Crenus Co.,Ltd.",
+	"Opzoon Technology Co.,Ltd.",
+	"Technical Consumer Products, Inc.",
+	"Absolute Analysis",
+	"Dali Wireless, Inc.",
+	"RSF",
+	"mywerk system GmbH",
+	"Chaney Instrument Co.",
+	"Genoray",
+	"MYK, Inc.",
+	"Shenzhen State Micro Technology Co., Ltd",
+	"MEXUS CO.,LTD",
+	"SmartDrive Systems Inc.",
+	"Sistemas de Gestión Energética S.A. de C.V",
+	"ShopperTrak RCT Corporation",
+	"Qingdao Hisense Communications Co.,Ltd",
+	"Philips Oral Healthcare, Inc.",
+	"JSC Zavod im. Kozitsky",
+	"Systeme Helmholz GmbH",
+	"SAET I.S. S.r.l.",
+	"Askey Computer Corp",
+	"CSG Science & Technology Co.,Ltd.Hefei",
+	"Chengdu Yingji Electronic Hi-tech Co Ltd",
+	"GHT Co., Ltd.",
+	"Radiant Zemax LLC",
+	"FERMAX ELECTRONICA S.A.U.",
+	"NINGBO GLOBAL USEFUL ELECTRIC CO.,LTD",
+	"ITL, LLC",
+	"Mikrosay Yazilim ve Elektronik A.S.",
+	"Lantis co., LTD.",
+	"Omnisense Ltd",
+	"Aditya Infotech Ltd.",
+	"Beijing Sinoix Communication Co., LTD",
+	"PBR electronics GmbH",
+	"Corsa Technology Incorporated",
+	"GlobalBeiMing technology (Beijing)Co. Ltd",
+	"Humax.co.,ltd",
+	"Enigma Diagnostics Limited",
+	"Shenzhen 3Nod Digital Technology Co., Ltd.",
+	"Gen2wave",
+	"Preceno Technology Pte.Ltd.",
+	"Cool Control LTD",
+	"C8 MediSensors, Inc.",
+	"OptiSense Network, LLC",
+	"GenesisTechnical Systems (UK) Ltd",
+	"GridCentric Inc.",
+	"Intune Networks",
+	"Luminator",
+	"OutSmart Power Systems, Inc.",
+	"Liaoning Wontel Science and Technology Development Co.,Ltd.",
+	"Shenzhen Prayfly Technology Co.,Ltd",
+	"Layon international Electronic & Telecom Co.,Ltd",
+	"Lantech Communications Global, Inc.",
+	"CAPELEC",
+	"Siemens AG - Industrial Automation - EWA",
+	"Keystone Microtech Corporation",
+	"SAMJIN Co., Ltd.",
+	"Spire Payments",
+	"Smartmatic Corp",
+	"ATHENA",
+	"IgniteNet",
+	"Realplay Digital Technology(Shenzhen) Co.,Ltd",
+	"Touch Networks",
+	"CashGuard Sverige AB",
+	"Stage Tec Entwicklungsgesellschaft für professionelle Audiotechnik mbH",
+	"Samhwa Telecom",
+	"SteelSeries ApS",
+	"Danfoss Turbocor Compressors, Inc",
+	"enblink",
+	"GERP Solution",
+	"ETEK TECHNOLOGY (SHENZHEN) CO.,LTD",
+	"exlar corp",
+	"Miller Electric Mfg. Co.",
+	"Shenzhen SDGI CATV Co., Ltd",
+	"Sirius XM Radio Inc",
+	"Xenya d.o.o.",
+	"Genmark Automation",
+	"Radisys Corporation",
+	"NextNav, LLC",
+	"RNET Technologies, Inc.",
+	"Technicolor USA Inc.",
+	"Yota Devices OY",
+	"Altierre",
+	"DellKing Industrial Co., Ltd",
+	"Taimag Corporation",
+	"One",
+	"Corporacion Empresarial Altra SL",
+	"Espotel Oy",
+	"Individual Computers GmbH",
+	"Shenzhen Dynamax Software Development Co.,Ltd.",
+	"LCFC(HeFei) Electronics Technology Co., Ltd.",
+	"Premier Wireless, Inc.",
+	"Telecor Inc.",
+	"Hangzhou Konke Technology Co.,Ltd.",
+	"Yuduan Mobile Co., Ltd.",
+	"Shanghai Guao Electronic Technology Co., Ltd",
+	"bioMerieux Inc.",
+	"Shanghai InfoTM Microelectronics Co.,Ltd.",
+	"Pi-Coral",
+	"Tokheim",
+	"SIS Sat Internet Services GmbH",
+	"Microtime Computer Inc.",
+	"JAG Jakob AG",
+	"Frontline Test Equipment",
+	"2C - Trifonov & Co",
+	"ADD-Engineering BV",
+	"Syes srl",
+	"Ragentek Technology Group",
+	"The Electric Controller and Manufacturing Co., LLC",
+	"Shenzhen Xin KingBrand enterprises Co.,Ltd",
+	"UNOWHY",
+	"EControls, LLC",
+	"XOS",
+	"NASCENT Technology, LLC - RemKon",
+	"Win Leader Inc.",
+	"DEVLINE LIMITED",
+	"Trend Electronics Co., Ltd.",
+	"IDN Telecom, Inc.",
+	"Electronics Company Limited",
+	"AERODEV",
+	"Babolat VS",
+	"BBK COMMUNICATIAO TECHNOLOGY CO.,LTD.",
+	"bct electronic GesmbH",
+	"ERCO & GENER",
+	"ELLIY Power CO..Ltd",
+	"Capisco Limited",
+	"ShenZhen Yifang Digital Technology Co.,LTD",
+	"CYBO CO.,LTD.",
+	"Fagor Electrónica",
+	"Netstor Technology Co., Ltd.",
+	"Alge-Timing GmbH",
+	"Spectrum Medical Limited",
+	"Shenzhen Kaixuan Visual Technology Co.,Limited",
+	"Shenzhen Winyao Electronic Limited",
+	"Gainspeed, Inc.",
+	"PROMATE ELECTRONIC CO.LTD",
+	"Centripetal Networks, Inc",
+	"Pertronic Industries",
+	"Finest Security Systems Co., Ltd",
+	"Regenersis (Glenrothes) Ltd",
+	"ShenZhen Zhengjili Electronics Co., LTD",
+	"RF Controls, LLC",
+	"HiveMotion",
+	"Soundmatters",
+	"Shanghai Dongzhou-Lawton Communication Technology Co. Ltd.",
+	"Milper Ltd",
+	"OOO Petr Telegin",
+	"Onzo Ltd",
+	"HARTING Inc. of North America",
+	"Hon Hai Precision Ind. Co.,Ltd",
+	"Hitachi Metals America Ltd",
+	"Eintechno Corporation",
+	"Kishu Giken Kogyou Company Ltd,.",
+	"Cincoze Co., Ltd.",
+	"I.C.Y. B.V.",
+	"NGI SpA",
+	"acromate, Inc.",
+	"Fortify Technologies, LLC",
+	"POWER DRAGON DEVELOPMENT LIMITED",
+	"True Technologies Inc.",
+	"SHENZHEN ZHILU TECHNOLOGY CO.,LTD",
+	"Soliton Technologies Pvt Ltd",
+	"RED Digital Cinema",
+	"Ingenieurbuero Bickele und Buehler GmbH",
+	"Ravello Systems",
+	"IAdea Corporation",
+	"Precor Inc",
+	"Summit Technology Group",
+	"Aqavi.com",
+	"Sanjole, Inc.",
+	"Discovergy GmbH",
+	"DeviceDesign",
+	"Alert Metalguard ApS",
+	"Alpheus Digital Company Limited",
+	"Petroleum Geo-Services",
+	"EMKO ELEKTRONIK SAN VE TIC AS",
+	"Seeed Technology Inc.",
+	"Brother industries, LTD.",
+	"Delta Mobile Systems, Inc.",
+	"Zhejiang Wellcom Technology Co.,Ltd.",
+	"Microsoft Mobile Oy",
+	"Aquantia Corporation",
+	"Piciorgros GmbH",
+	"Ubiquitous Communication Co. ltd.",
+	"ProLon",
+	"DEOS control systems GmbH",
+	"Mako Networks Ltd",
+	"Shenzhen Ostar Display Electronic Co.,Ltd",
+	"JDA, LLC (JDA Systems)",
+	"W-IE-NE-R Plein & Baus GmbH",
+	"Hanilstm Co., Ltd.",
+	"Boosty",
+	"Shenzhen Jinhengjia Electronic Co., Ltd.",
+	"GLINTT TES S.A.",
+	"Sonavox Canada Inc",
+	"ALTEC LANSING LLC",
+	"BURG-WÄCHTER KG",
+	"PLATH GmbH",
+	"Nanjing Z-Com Wireless Co.,Ltd",
+	"Panasonic Electric Works Automation Controls Techno Co.,Ltd.",
+	"Tianjin Techua Technology Co., Ltd.",
+	"BYK-Gardner GmbH",
+	"NST Co., LTD",
+	"Trex Network LLC",
+	"IRLAB LTD.",
+	"streamnow AG",
+	"Microsoft",
+	"Beissbarth",
+	"PAV GmbH",
+	"Paradom Inc.",
+	"Wistron (ChongQing)",
+	"Reach Technology Inc.",
+	"Skymotion Technology (HK) Limited",
+	"Validus Technologies",
+	"Hangzhou Prevail Optoelectronic Equipment Co.,LTD.",
+	"Inpeco SA",
+	"Maike Industry(Shenzhen)CO.,LTD",
+	"TIANJIN Golden Pentagon Electronics Co., Ltd.",
+	"Innowireless, Co. Ltd.",
+	"Asustek Computer Inc",
+	"Guangdong East Power Co.,",
+	"HONGKONG UCLOUDLINK NETWORK TECHNOLOGY LIMITED",
+	"Dropcam",
+	"Technicolor",
+	"SHANGHAI SUNMON COMMUNICATION TECHNOGY CO.,LTD",
+	"BBK Electronics Corp., Ltd.,",
+	"Shanghai Reallytek Information Technology Co.,Ltd",
+	"Deqing Dusun Electron CO., LTD",
+	"Radio Mobile Access",
+	"Hytec Geraetebau GmbH",
+	"Shenzhen Heguang Measurement & Control Technology Co.,Ltd",
+	"Aitexin Technology Co., Ltd",
+	"MIC Technology Group",
+	"Wi-Next s.r.l.",
+	"Samsung Electronics ITS, Printer division",
+	"Logosol, Inc.",
+	"Autosales Incorporated",
+	"Cedac Software S.r.l.",
+	"Vodafone UK",
+	"INTEK DIGITAL",
+	"Alstom Strongwish (Shenzhen) Co., Ltd.",
+	"+plugg srl",
+	"ESP",
+	"Thread Technology Co., Ltd",
+	"Tunai Creative",
+	"MACROTECH (USA) INC.",
+	"CloudBerry Technologies Private Limited",
+	"TOP-ACCESS ELECTRONICS CO LTD",
+	"MIOS ELETTRONICA SRL",
+	"Mediplan Limited",
+	"SHENZHEN WEIBU ELECTRONICS CO.,LTD.",
+	"Grandbeing Technology Co., Ltd",
+	"Jensen Scandinavia AS",
+	"Shenzhen Loadcom Technology Co.,Ltd",
+	"ATN International Limited",
+	"MCD ELECTRONICS SP. Z O.O.",
+	"Anywire corporation",
+	"Inlab Software GmbH",
+	"Fujian JinQianMao Electronic Technology Co.,Ltd.",
+	"IBM",
+	"HiTEM Engineering",
+	"amazipoint technology Ltd.",
+	"IO-Power Technology Co., Ltd.",
+	"R&amp;M AG",
+	"EKINOPS SAS",
+	"JID GLOBAL",
+	"EVI HEAT AB",
+	"Cargt Holdings LLC",
+	"Wytek",
+	"Teraworks Co., Ltd.",
+	"Pramod Telecom Pvt. Ltd",
+	"Ecosense",
+	"White Rodgers Division",
+	"O-NET Communications(Shenzhen) Limited",
+	"UNICARD SA",
+	"SST Taiwan Ltd.",
+	"Kayo Technology, Inc.",
+	"iFORCOM Co., Ltd",
+	"Heinz Lackmann GmbH & Co KG",
+	"Quectel Wireless Solution Co.,Ltd.",
+	"Logitech Far East",
+	"ADVANCED RF TECHNOLOGIES INC",
+	"VPI Engineering",
+	"Universal Flow Monitors, Inc.",
+	"ZBD Displays Ltd",
+	"Maquet GmbH",
+	"Heinzmann GmbH & CO. KG",
+	"Evervictory Electronic Co.Ltd",
+	"AWare, Inc",
+	"Terewave. Inc.",
+	"TECHNOSOFT INTERNATIONAL SRL",
+	"Sensorist ApS",
+	"Shine Profit Development Limited",
+	"Trevil srl",
+	"Fischer International Systems Corporation",
+	"KYOCERA Display Corporation",
+	"Mikrovisatos Servisas UAB",
+	"Shanghai Chint Power Systems Co., Ltd.",
+	"PLDS",
+	"Guangzhou Younghead Electronic Technology Co.,Ltd",
+	"Se-Kure Controls, Inc.",
+	"Asiatelco Technologies Co.",
+	"Beijing Ding Qing Technology, Ltd.",
+	"Shanghai WDK Industrial Co.,Ltd.",
+	"Hagleitner Hygiene International GmbH",
+	"Enecsys Ltd",
+	"EIDOLON COMMUNICATIONS TECHNOLOGY CO. LTD.",
+	"Nexpring Co. LTD.,",
+	"CommSky Technologies",
+	"Parsec (Pty) Ltd",
+	"MobilMAX Technology Inc.",
+	"RENA GmbH Print Systeme",
+	"Tributary Systems, Inc.",
+	"Fujikon Industrial Co.,Limited",
+	"DONGGUAN QISHENG ELECTRONICS INDUSTRIAL CO., LTD",
+	"Automatic Bar Controls Inc.",
+	"NTT Communications Corporation",
+	"Shenzhen Linghangyuan Digital Technology Co.,Ltd.",
+	"WizLAN Ltd.",
+	"Panasonic Taiwan Co.,Ltd.",
+	"ATEK Products, LLC",
+	"Guangzhou Robustel Technologies Co., Limited",
+	"ALCEA",
+	"Toshiba Samsung Storage Technolgoy Korea Corporation",
+	"A.D.C. GmbH",
+	"Silca Spa",
+	"Firefly Integrations",
+	"Sky-City Communication and Electronics Limited Company",
+	"Primax Electronics LTD.",
+	"V.P.S. Thai Co., Ltd",
+	"Dedicated Network Partners Oy",
+	"PROMZAKAZ LTD.",
+	"SIMCom Wireless Solutions Co.,Ltd.",
+	"H3C Technologies Co., Limited",
+	"UTran Technology",
+	"ANDTEK",
+	"Fujian Netcom Technology Co., LTD",
+	"ECO PLUGS ENTERPRISE CO., LTD",
+	"WEG",
+	"2wire",
+	"DBL Technology Ltd.",
+	"Wildeboer Bauteile GmbH",
+	"Ingenieurbuero Stahlkopf",
+	"Patrol Products Consortium LLC",
+	"MyCloud Technology corporation",
+	"AIRTAME ApS",
+	"Azurewave Technologies, Inc.",
+	"Alcatel-Lucent 7705",
+	"Panaccess Systems GmbH",
+	"MindMade sp. z o.o.",
+	"Beijing Zhongdun Security Technology Development Co.",
+	"Yu Jeong System, Co.Ltd",
+	"3NOD MULTIMEDIA(SHENZHEN)CO.,LTD",
+	"OOSIC Technology CO.,Ltd",
+	"Asia Optical Co., Inc.",
+	"Ivy Biomedical",
+	"Wasion Group Ltd.",
+	"COMTREND",
+	"AKELA, Inc.",
+	"Opticon Sensors Europe B.V.",
+	"ITC Networks",
+	"Fanhattan LLC",
+	"Xenox Holding BV",
+	"Beijing Tendyron Corporation",
+	"OTN Systems N.V.",
+	"Veenstra Instruments",
+	"SHENZHEN MEGMEET ELECTRICAL CO.,LTD",
+	"Moog, Ing",
+	"Orga BV",
+	"Actifio Inc",
+	"SAMSUNG ELECTRO-MECHANICS",
+	"Sonotronic Nagel GmbH",
+	"E.G.O. Elektro-Ger",
+	"Fijowave Limited",
+	"OzVision America LLC",
+	"Meizu technology co.,ltd",
+	"Espec Corp.",
+	"ALPS ELECTRIC CO.,LTD.",
+	"WiFiSong",
+	"CS Services Co.,Ltd.",
+	"SMART High Reliability Solutions, Inc.",
+	"Contour Design LLC",
+	"EasyIO Corporation Sdn. Bhd.",
+	"Sunbow Telecom Co., Ltd.",
+	"Mohlenhoff GmbH",
+	"b gmbh medien + datenbanken",
+	"Reco S.p.A.",
+	"Novatek Microelectronics Corp.",
+	"Anedo Ltd.",
+	"Vapor Stone Rail Systems",
+	"TATSUNO CORPORATION",
+	"home2net GmbH",
+	"National Resource Management, Inc.",
+	"V2COM PARTICIPACOES S.A.",
+	"Skyworth Digital Technology(Shenzhen) Co.,Ltd",
+	"Ellips B.V.",
+	"Creation Technologies LP",
+	"PRAVIS SYSTEMS Co.Ltd.,",
+	"Product Creation Studio",
+	"Beijing Yupont Electric Power Technology Co.,Ltd",
+	"Powerhouse Dynamics",
+	"Servergy, Inc.",
+	"KBC Networks",
+	"daesung network",
+	"ALBAHITH TECHNOLOGIES",
+	"TESCOM CO., LTD.",
+	"Luxshare Precision Industry Co.,Ltd.",
+	"GFI Chrono Time",
+	"ClearSky Data",
+	"Cardiopulmonary Corp",
+	"Huayuan Technology CO.,LTD",
+	"JADAK LLC",
+	"HANGZHOU SUNRISE TECHNOLOGY CO., LTD",
+	"Sotera Wireless",
+	"SLE quality engineering GmbH & Co. KG",
+	"杭州德澜科技有限公司（HangZhou Delan Technology Co.,Ltd）",
+	"SFORZATO Corp.",
+	"Dewar Electronics Pty Ltd",
+	"Qolsys Inc.",
+	"Arris Group, Inc.",
+	"ConnectQuest, llc",
+	"JW Electronics Co., Ltd.",
+	"Guangdong Pisen Electronics Co. Ltd.",
+	"ASSMANN Electronic GmbH",
+	"Infinity System S.L.",
+	"Etronic A/S",
+	"Kessler Crane Inc.",
+	"FiveCo",
+	"Google",
+	"Shenzhen First Blue Chip Technology Ltd",
+	"Synerchip Co., Ltd.",
+	"Samsung electronics CO., LTD",
+	"SHENZHEN JETNET TECHNOLOGY CO.,LTD.",
+	"Sciovid Inc.",
+	"Niigata Power Systems Co., Ltd.",
+	"Dexatek Technology LTD.",
+	"Mitron OY",
+	"Fiberpro Inc.",
+	"EnTek Systems, Inc.",
+	"MakerBot Industries",
+	"Advance Vision Electronics Co. Ltd.",
+	"Sangshin elecom .co,, LTD",
+	"KAPELSE",
+	"Tensun Information Technology(Hangzhou) Co.,LTD",
+	"Vexata Inc",
+	"Hangzhou Yulong Conmunication Co.,Ltd",
+	"ALONG COMMUNICATION TECHNOLOGY",
+	"Wistron InfoComm(Kunshan)Co.,Ltd.",
+	"IPS Technology Limited",
+	"Quest Controls, Inc.",
+	"Lansentechnology AB",
+	"Shenzhen CATIC Bit Communications Technology Co.,Ltd",
+	"Bless Information & Communications Co., Ltd",
+	"Oilfind International LLC",
+	"iKey, Ltd.",
+	"ESA Elektroschaltanlagen Grimma GmbH",
+	"Wattwatchers Pty Ld",
+	"Hitachi Maxell, Ltd., Optronics Division",
+	"Belden Deutschland GmbH",
+	"LG ELECTRONICS INC",
+	"d&b audiotechnik GmbH",
+	"AES Corporation",
+	"Melange Systems Pvt. Ltd.",
+	"XINHUA CONTROL ENGINEERING CO.,LTD",
+	"Huiyang Technology Co., Ltd",
+	"Iders Incorporated",
+	"TCT mobile ltd",
+	"Technische Alternative GmbH",
+	"Mercedes-Benz USA, LLC",
+	"Telepower Communication Co., Ltd",
+	"WirelessWERX, Inc",
+	"SK Mtek microelectronics(shenzhen)limited",
+	"Eclipse WiFi",
+	"KIDASEN INDUSTRIA E COMERCIO DE ANTENAS LTDA",
+	"LG Display",
+	"NSE AG",
+	"Virtualtek. Co. Ltd",
+	"DSPECIALISTS GmbH",
+	"Shenzhen Linsn Technology Development Co.,Ltd",
+	"Emcraft Systems LLC",
+	"Arista Corp",
+	"A&T",
+	"Railtec Systems GmbH",
+	"Tremol Ltd.",
+	"Sonance",
+	"Compass-EOS",
+	"Global ES",
+	"Protect America, Inc.",
+	"EKM Metering",
+	"Aerohive Networks Inc.",
+	"Biometric Associates, LP",
+	"Digital Projection Ltd",
+	"Mobileeco Co., Ltd",
+	"RFI Engineering",
+	"Conlog (Pty) Ltd",
+	"Godrej & Boyce Mfg. co. ltd",
+	"Macro Image Technology, Inc.",
+	"ZIV",
+	"Icomera",
+	"Spreadtrum Communications (Shanghai) Co., Ltd.",
+	"Addrek Smart Solutions",
+	"Higher Way Electronic Co., Ltd.",
+	"Milton Security Group LLC",
+	"Grandex International Corporation",
+	"Pico Technology",
+	"In Home Displays Ltd",
+	"ANOVO",
+	"Hawkeye Tech Co. Ltd",
+	"MICRO-STAR INT'L CO.,LTD",
+	"Stella-Green Co",
+	"Tele system communication",
+	"mediola - connected living AG",
+	"Thales UK Limited",
+	"Power Idea Technology Limited",
+	"Life Technology (China) Co., Ltd",
+	"aFUN TECHNOLOGY INC.",
+	"IMBEL - Industria de Material Belico do Brasil",
+	"Mettle Networks Inc.",
+	"Glory Star Technics (ShenZhen) Limited",
+	"Continental Automotive GmbH",
+	"Clavister AB",
+	"Beijing Ereneben Information Technology Limited Shenzhen Branch",
+	"TITENG CO., Ltd.",
+	"Shenzhen TCL New Technology Co; Ltd.",
+	"Aisino Corporation",
+	"BK Electronics cc",
+	"Casacom Solutions AG",
+	"Shenzhen Yourf Kwan Industrial Co., Ltd",
+	"BAEKCHUN I&C Co., Ltd.",
+	"Shenzhen Four Seas Global Link Network Technology Co., Ltd.",
+	"PassivSystems Ltd",
+	"Data Management, Inc.",
+	"Chiyoda Electronics Co.,Ltd.",
+	"Logital Co. Limited",
+	"SUNGSAM CO,.Ltd",
+	"Arima Communications Corp.",
+	"Cronoplast  S.L.",
+	"itelio GmbH",
+	"Physio-Control, Inc",
+	"Digistar Telecom. SA",
+	"Shenzhen Hexicom Technology Co., Ltd.",
+	"ChongQing Camyu Technology Development Co.,Ltd.",
+	"Shanghai Jing Ren Electronic Technology Co., Ltd.",
+	"Naviit Inc.",
+	"Z3 Technology",
+	"Biodata Ltd",
+	"MICRO S.E.R.I.",
+	"IEEE REGISTRATION AUTHORITY - Please see IAB public listing for more information.",
+	"DEY Storage Systems, Inc.",
+	"Shenzhen Siviton Technology Co.,Ltd",
+	"FOUNDER BROADBAND NETWORK SERVICE CO.,LTD",
+	"Fihonest communication co.,Ltd",
+	"ISE Europe SPRL",
+	"Leica Microsystems (Schweiz) AG",
+	"NetMan Co., Ltd.",
+	"Telegartner Karl Gartner GmbH",
+	"WKK TECHNOLOGY LTD.",
+	"Fitview",
+	"Hangzhou Hikvision Digital Technology Co.,Ltd.",
+	"ARVIDA Intelligent Electronics Technology  Co.,Ltd.",
+	"Farmage Co., Ltd.",
+	"Bamboo Entertainment Corporation",
+	"NietZsche enterprise Co.Ltd.",
+	"E3 Technology, Inc.",
+	"MXT INDUSTRIAL LTDA",
+	"Neterix",
+	"2 Save Energy Ltd",
+	"Young Electric Sign Co",
+	"Cumulus Networks, inc",
+	"Universal Global Scientific Industrial Co.,Ltd",
+	"Pintsch Tiefenbach GmbH",
+	"Nuvolt",
+	"DEOTRON Co., LTD.",
+	"Silverflare Ltd.",
+	"Pan Studios Co.,Ltd.",
+	"Raytheon BBN Technologies",
+	"Thompson Aerospace",
+	"PNC Technologies  Co., Ltd.",
+	"Spawn Labs, Inc",
+	"Criticare Systems, Inc",
+	"Razer Inc",
+	"Tonalite Holding B.V.",
+	"Shihlin Electric & Engineering Corp.",
+	"Intercel Group Limited",
+	"ecobee inc",
+	"FONsystem co. ltd.",
+	"Mega Video Electronic(HK) Industry Co., Ltd",
+	"IP-LINE",
+	"Orbit Irrigation",
+	"JUIN COMPANY, LIMITED",
+	"Reallin Electronic Co.,Ltd",
+	"IFFU",
+	"MING HONG TECHNOLOGY (SHEN ZHEN) LIMITED",
+	"DualShine Technology(SZ)Co.,Ltd",
+	"Innolight Technology Corporation",
+	"VTION INFORMATION TECHNOLOGY (FUJIAN) CO.,LTD",
+	"Trek Technology (S) Pte Ltd",
+	"Alpha and Omega, Inc",
+	"Star-Net",
+	"Siemens Low Voltage & Products",
+	"Camco Technologies NV",
+	"Micro-Star INT'L CO., LTD.",
+	"KTIS CO., Ltd",
+	"DT Research, Inc.",
+	"VIG",
+	"Shanghai Huaqin Telecom Technology Co.,Ltd",
+	"Qingdao Santong Digital Technology Co.Ltd",
+	"Aidon Oy",
+	"SHENZHEN FAST TECHNOLOGIES CO.,LTD",
+	"The Now Factory",
+	"Alcomp, Inc",
+	"PROMAX ELECTRONICA SA",
+	"THINKING TECHNOLOGY CO.,LTD",
+	"SEWOO TECH CO., LTD",
+	"udworks Co., Ltd.",
+	"Nanotec Electronic GmbH & Co. KG",
+	"Kuang-chi Institute of Advanced Technology",
+	"Guangzhou Comet Technology Development Co.Ltd",
+	"SIFROM Inc.",
+	"OOO RUBEZH NPO",
+	"Opticom Communication, LLC",
+	"NGN Easy Satfinder (Tianjin) Electronic Co., Ltd",
+	"Wuhan Feng Tian Information Network CO.,LTD",
+	"Greenwald Industries",
+	"Shanghai Kingto Information Technology Ltd",
+	"Seiko Epson Corporation",
+	"Anvia TV Oy",
+	"Talari Networks",
+	"Ubiquiti Networks, Inc.",
+	"PLANEX COMMUNICATIONS INC.",
+	"SEMINDIA SYSTEMS PVT LTD",
+	"OMNITRONICS PTY LTD",
+	"Myreka Technologies Sdn. Bhd.",
+	"Longicorn, inc.",
+	"Budelmann Elektronik GmbH",
+	"Union Pacific Railroad",
+	"B-Link Electronic Limited",
+	"DESAY ELECTRONICS(HUIZHOU)CO.,LTD",
+	"Tempered Networks, Inc.",
+	"NAKAYO TELECOMMUNICATIONS,INC",
+	"Luxcom Technologies Inc.",
+	"BBK Electronics Corp., Ltd.",
+	"MicroPower technologies",
+	"Shanghai Winaas Co. Equipment Co. Ltd.",
+	"Pointer Telocation Ltd",
+	"Intron Scientific co., ltd.",
+	"Tek-Air Systems, Inc.",
+	"Motorola Inc Business Light Radios",
+	"ZENNIO AVANCE Y TECNOLOGIA, S.L.",
+	"IEP GmbH",
+	"Syscor Controls &amp; Automation",
+	"Uros Oy",
+	"SOREEL",
+	"NET RULES TECNOLOGIA EIRELI",
+	"Facebook",
+	"WISOL",
+	"Verizon",
+	"Concern \"Axion\" JSC",
+	"Fleetwood Group Inc.",
+	"Pica8, Inc.",
+	"Davit System Technology Co., Ltd.",
+	"StorSimple Inc",
+	"SGB GROUP LTD.",
+	"Life Fitness / Div. of Brunswick",
+	"DIGALOG GmbH",
+	"Weinmann Geräte für Medizin GmbH + Co. KG",
+	"Shenzhen Reach software technology CO.,LTD",
+	"SCI Innovations Ltd",
+	"Flashbay Limited",
+	"Shenzhen Huaxuchang Telecom Technology Co.,Ltd",
+	"Kodofon JSC",
+	"GJsun Optical Science and Tech Co.,Ltd.",
+	"Store Electronic Systems",
+	"Marketaxess Corporation",
+	"Glory Horse Industries Ltd.",
+	"HOMEWINS TECHNOLOGY CO.,LTD.",
+	"PulseOn Oy",
+	"Teletics Inc.",
+	"Symanitron",
+	"Xirrus, Inc.",
+	"Simo Wireless,Inc.",
+	"SysTec GmbH",
+	"Cello Electronics (UK) Ltd",
+	"Universal Electronics, Inc.",
+	"Metis Communication Co.,Ltd",
+	"Jeda Networks",
+	"BLANKOM Antennentechnik GmbH",
+	"Telvent",
+	"ClarIDy Solutions, Inc.",
+	"Wuhan NEC Fibre Optic Communications industry Co. Ltd",
+	"Vity",
+	"Zhejiang Uniview Technologies Co., Ltd.",
+	"ETERNA TECHNOLOGY, INC.",
+	"daesung eltec",
+	"Silver Palm Technologies LLC",
+	"UTStarcom (China) Co.,Ltd",
+	"Ubizcore Co.,LTD",
+	"TechVision Holding  Internation Limited",
+	"Alcatel Lucent WT",
+	"Maestronic",
+	"Woodstream Corporation",
+	"HOMA B.V.",
+	"CMR KOREA CO., LTD",
+	"LEX COMPUTECH CO., LTD",
+	"Basler Electric Company",
+	"COMPUTER OFFICE Co.,Ltd.",
+	"Earda Electronics Co.,Ltd",
+	"ZHEJIANG DAHUA TECHNOLOGY CO.,LTD.",
+	"NOREGON SYSTEMS, INC",
+	"TCL Technoly Electronics (Huizhou) Co., Ltd.",
+	"PRIMA Research And Production Enterprise Ltd.",
+	"Novakon Co., Ltd.",
+	"cozybit, Inc.",
+	"Welgate Co., Ltd.",
+	"Beijing Skyway Technologies Co.,Ltd",
+	"Zhejiang KaNong Network Technology Co.,Ltd.",
+	"ICM Controls",
+	"Thales Transportation Systems GmbH",
+	"TELEDATA NETWORKS",
+	"M Rutty Holdings Pty. Ltd.",
+	"HPL Electric & Power Private Limited",
+	"Newtek Electronics co., Ltd.",
+	"VOGTEC(H.K.) Co., Ltd",
+	"Beijing Autelan Technology Co.,Ltd",
+	"Mobile Device, Inc.",
+	"Linepro Sp. z o.o.",
+	"Hamilton Systems",
+	"Turkcell Teknoloji",
+	"ACKme Networks Pty Ltd",
+	"Oy Finnish Electric Vehicle Technologies Ltd",
+	"airPointe of New Hampshire",
+	"SANMINA-SCI SYSTEM DE MEXICO S.A. DE C.V.",
+	"Application Solutions (Electronics and Vision) Ltd",
+	"Guangdong Leawin Group Co., Ltd",
+	"Comnect Technology CO.,LTD",
+	"Pegatron Corporation",
+	"Genius Bytes Software Solutions GmbH",
+	"KOVE",
+	"Mundo Reader (bq)",
+	"Embedded Wireless Labs",
+	"Arrowhead Alarm Products Ltd",
+	"Armstrong Monitoring Corp",
+	"Echostar Technologies",
+	"Grupo Digicon",
+	"Jastec",
+	"Zeo",
+	"KYOKKO ELECTRIC Co., Ltd.",
+	"Hanyang Navicom Co.,Ltd.",
+	"Baikal Electronics JSC",
+	"Insensi",
+	"KST technology",
+	"ShengHai Electronics (Shenzhen) Ltd",
+	"HRD (S) PTE., LTD.",
+	"Novi Security",
+	"SAM Electronics GmbH",
+	"Cambridge Mobile Telematics, Inc.",
+	"CONET CO., LTD.",
+	"Bison Electronics Inc.",
+	"Chicony Electronics Co., Ltd.",
+	"Shenzhen Hangsheng Electronics Co.,Ltd.",
+	"Shang Hai Tyd. Electon Technology Ltd.",
+	"Radios, Inc.",
+	"Shenzhen Aisat Electronic Co., Ltd.",
+	"Skyera, Inc.",
+	"Motorola Solutions Inc.",
+	"HUMAX.CO.,LTD",
+	"Qsono Electronics Co., Ltd",
+	"Helmer Scientific",
+	"Magneti Marelli Automotive Electronics (Guangzhou) Co. Ltd",
+	"TEAM ENGINEERS ADVANCE TECHNOLOGIES INDIA PVT LTD",
+	"Zhuhai HiFocus Technology Co., Ltd.",
+	"sclak srl",
+	"RailComm, LLC",
+	"SHC Netzwerktechnik GmbH",
+	"Vifa Denmark A/S",
+	"Blue Clover Devices",
+	"Scalable Network Technologies Inc",
+	"SamJi Electronics Co., Ltd",
+	"Hong Kong Telecommunications (HKT) Limited",
+	"CyWee Group Ltd",
+	"Foxda Technology Industrial(ShenZhen)Co.,LTD",
+	"TrafficCast International",
+	"SilverNet Ltd",
+	"Richfit Information Technology Co., Ltd",
+	"Emerson Climate Technologies Transportation Solutions",
+	"PixeLINK",
+	"Nethra Imaging Incorporated",
+	"TECHNART Co.,Ltd",
+	"NANJING IOT SENSOR TECHNOLOGY CO,LTD",
+	"Smart electronic GmbH",
+	"Telekom Research and Development Sdn Bhd",
+	"Phytec Messtechnik GmbH",
+	"Asahi Electronics Co.,Ltd",
+	"Syncmold Enterprise Corp",
+	"Liuhe Intelligence Technology Ltd.",
+	"BEIJING HAIHEJINSHENG NETWORK TECHNOLOGY CO. LTD.",
+	"Masimo Corporation",
+	"Loxone Electronics GmbH",
+	"Egardia",
+	"TAKT Corporation",
+	"Jolla Ltd",
+	"WyTec International, Inc.",
+	"GUANGDONG SUPER TELECOM CO.,LTD.",
+	"Xirrus Inc.",
+	"Indu-Sol GmbH",
+	"Greenlee",
+	"iTellus",
+	"Wi-Fi Alliance",
+	"He Shan World Fair Electronics Technology Limited",
+	"BEG Brueck Electronic GmbH",
+	"Tekpea, Inc.",
+	"Ecil Informatica Ind. Com. Ltda",
+	"Interexport Telecomunicaciones S.A.",
+	"BIODIT",
+	"Nuvyyo Inc",
+	"Uptmate Technology Co., LTD",
+	"SHENZHEN MAXMADE TECHNOLOGY CO., LTD.",
+	"DIRMEIER Schanktechnik GmbH &Co KG",
+	"CHAHOO Limited",
+	"Gradual Tecnologia Ltda.",
+	"Westinghouse Digital",
+	"Inventum Technologies Private Limited",
+	"Actineon",
+	"Alba Fiber Systems Inc.",
+	"David Clark Company",
+	"Aboundi, Inc.",
+	"Hoseo Telecom",
+	"Dynalec Corporation",
+	"Shenzhen Bitland Information Technology Co., Ltd.",
+	"Micropoint Biotechnologies,Inc.",
+	"wi2be Tecnologia S/A",
+	"ImTech Technologies LLC,",
+	"Carmanah Signs",
+	"SECURETECH INC",
+	"SKIPPER Electronics AS",
+	"Samsung Electro Mechanics",
+	"NanJing Chaoran Science & Technology Co.,Ltd.",
+	"Measy Electronics Ltd",
+	"Steffes Corporation",
+	"Takahata Precision",
+	"TurControlSystme AG",
+	"Changzhou Yongse Infotech Co.,Ltd",
+	"Egatel SL",
+	"Open Stack, Inc.",
+	"Leeo Inc",
+	"Kunshan JADE Technologies co., Ltd.",
+	"L-tek d.o.o.",
+	"Treehouse Labs",
+	"Sify Technologies Ltd",
+	"EBN Technology Corp.",
+	"Gigawave LTD",
+	"Vivago Oy",
+	"deister electronic GmbH",
+	"Sulzer Pump Solutions Finland Oy",
+	"Atamo Pty Ltd",
+	"Wingtech Group (HongKong) Limited",
+	"Techno-Innov",
+	"Freestyle Energy Ltd",
+	"Advantage Electronics",
+	"Tely Labs",
+	"Resolution Products",
+	"AzureWave Technonloies, Inc.",
+	"LSY Defense, LLC.",
+	"PROTECTRON",
+	"Euclid Laboratories, Inc.",
+	"Raster Vision Ltd",
+	"Symeo GmbH",
+	"1Verge Internet Technology (Beijing) Co., Ltd.",
+	"Edgewater Networks Inc",
+	"Shenzhen CZTIC Electronic Technology Co., Ltd",
+	"wenglor sensoric gmbh",
+	"AMG Systems Ltd.",
+	"Digital RF Corea, Inc",
+	"NL Technologies",
+	"Google Inc",
+	"ZODIAC AEROSPACE SAS",
+	"Zaplox AB",
+	"Sagemcom SAS",
+	"Toyo Electronics Corporation",
+	"Webtech Wireless",
+	"INGENICO",
+	"TELCO systems, s.r.o.",
+	"Eagle Research Corporation",
+	"Digital Devices GmbH",
+	"Samsung Electro Mechanics co., LTD.",
+	"Zelfy Inc",
+	"SHENZHEN TWOWING TECHNOLOGIES CO.,LTD.",
+	"Silvershore Technology Partners",
+	"Uzushio Electric Co.,Ltd.",
+	"EnerAccess inc",
+	"t-mac Technologies Ltd",
+	"Shenzhen Linkworld Technology Co,.LTD",
+	"NSC Communications Siberia Ltd",
+	"Minibar Systems",
+	"SUHDOL E&C Co.Ltd.",
+	"Hunan Fenghui Yinjia Science And Technology Co.,Ltd",
+	"Panasonic Mobile Communications Co.,Ltd.",
+	"Fujian Shenzhou Electronic Co.,Ltd",
+	"ShenZhen Apexis Electronic Co.,Ltd",
+	"AXIM Communications",
+	"MAX-TECH,INC",
+	"Universal Laser Systems, Inc",
+	"Ulterius Technologies, LLC",
+	"Intrakey technologies GmbH",
+	"Dongguan Aoyuan Electronics Technology Co., Ltd",
+	"JVL Industri Elektronik",
+	"FN-LINK TECHNOLOGY LIMITED",
+	"ShenZhen LingKeWeiEr Technology Co., Ltd.",
+	"Shenzhen Elink Technology Co., LTD",
+	"ORIENTAL PACIFIC INTERNATIONAL LIMITED",
+	"Berthold Technologies GmbH and Co.KG",
+	"ABB AG",
+	"WISEWARE, Lda",
+	"Scheidt & Bachmann GmbH",
+	"Mopria Alliance",
+	"Tianjin Huisun Technology Co.,Ltd.",
+	"LABRIS NETWORKS",
+	"Elettronica GF S.r.L.",
+	"Fiber Optic & telecommunication INC.",
+	"Kivic Inc.",
+	"Intelbras",
+	"Affinegy",
+	"Advanced Mobile Telecom co.,ltd.",
+	"Open-m technology limited",
+	"Tuttnaer LTD",
+	"KMB systems, s.r.o.",
+	"Technicolor CH USA",
+	"Lighting Science Group",
+	"Universal Electric Corporation",
+	"Glovast Technology Ltd.",
+	"Omneality Ltd.",
+	"LG Electronics (Mobile Communications)",
+	"Sigma International General Medical Apparatus, LLC.",
+	"Koncar Electronics and Informatics",
+	"Baxter Healthcare",
+	"Shenzhen Webridge Technology Co.,Ltd",
+	"COFLEC",
+	"Chitai Electronic Corp.",
+	"Chongqing Guohong Technology Development Company Limited",
+	"Digital One Technologies, Limited",
+	"Linear Equipamentos Eletronicos SA",
+	"TLS Corporation",
+	"Best Buy Corporation",
+	"Danfoss Solar Inverters",
+	"TPL SYSTEMES",
+	"INFOMARK CO., LTD.",
+	"BARNES&NOBLE.COM",
+	"Clare Controls Inc.",
+	"Fujian Ruijie Networks co, ltd",
+	"Fusion Transactive Ltd.",
+	"Shanghai Xiaoyi Technology Co., Ltd.",
+	"CJSC RTSoft",
+	"Beijing ECHO Technologies Co.,Ltd",
+	"DIGI I'S LTD",
+	"Stonesoft Corporation",
+	"AirPro Technology India Pvt. Ltd",
+	"Hisense Electric Co., Ltd",
+	"SONAR INDUSTRIAL CO., LTD.",
+	"S2M",
+	"IP500 Alliance e.V.",
+	"QSC AG",
+	"LITE-ON CLEAN ENERGY TECHNOLOGY CORP.",
+	"Shenzhen Coship Electronics Co., Ltd.",
+	"Kinetic Avionics Limited",
+	"Vertex Standard LMR, Inc.",
+	"Revolution Display",
+	"Shineway Technologies, Inc.",
+	"FreeBSD Foundation",
+	"iD corporation",
+	"ZuniData Systems Inc.",
+	"SOLEM Electronique",
+	"Crystalfontz America, Inc.",
+	"Sigrand",
+	"Lufkin Industries",
+	"BW Broadcast",
+	"IEEE 1904.1 Working Group",
+	"Dairy Cheq Inc",
+	"Fast Co., Ltd.",
+	"Micro Technic A/S",
+	"Compass Technologies Inc.",
+	"CENTRON COMMUNICATIONS TECHNOLOGIES FUJIAN CO.,LTD",
+	"EVRsafe Technologies",
+	"Deltanet AG",
+	"AUTONICS CORPORATION",
+	"Proteus Digital Health",
+	"Newport Corporation",
+	"Icon Time Systems",
+	"BLU Products Inc.",
+	"HCCP",
+	"Xia Men UnionCore Technology LTD.",
+	"Kyoto University",
+	"SECUDOS GmbH",
+	"Bravida Sakerhet AB",
+	"Applied Vision Corporation",
+	"Thought Creator",
+	"SAMSUNG ELECTRO-MECHANICS CO., LTD.",
+	"CELIZION Inc.",
+	"Seal One AG",
+	"Thyssenkrupp Aufzugswerke GmbH",
+	"ADVAN",
+	"AIDC TECHNOLOGY (S) PTE LTD",
+	"Big Switch Networks",
+	"I-View Now, LLC.",
+	"LGE",
+	"Talon Communications",
+	"Asoni Communication Co., Ltd.",
+	"DAE EUN ELETRONICS CO., LTD",
+	"Baltech AG",
+	"Avire Global Pte Ltd",
+	"Open Access Pty Ltd",
+	"Vivint",
+	"ABC(XiSheng) Electronics Co.,Ltd",
+	"Spazio Italia srl",
+	"Swissphone Telecom AG",
+	"Alpha Networkc Inc.",
+	"Compal Broadband Networks Inc.",
+	"There Corporation Oy",
+	"TCL King Electrical Appliances (Huizhou) Ltd.",
+	"Shanghai Super Electronics Technology Co.,LTD",
+	"Gehirn Inc.",
+	"Jefferson Audio Video Systems, Inc.",
+	"Wiatec International Ltd.",
+	"HAZEMEYER",
+	"Enguity Technology Corp",
+	"3pleplay Electronics Private Limited",
+	"Mist Systems, Inc.",
+	"YIK Corporation",
+	"NUVICO",
+	"KENTKART EGE ELEKTRONIK SAN. VE TIC. LTD. STI.",
+	"S.A. SISTEL",
+	"Haivision Network Video",
+	"Brightsource Industries Israel LTD",
+	"Secret Labs LLC",
+	"Cybertelbridge co.,ltd",
+	"Beijing Banner Electric Co.,Ltd",
+	"RTNET",
+	"TableTop Media (dba Ziosk)",
+	"Lokel s.r.o.",
+	"NovaComm Technologies Inc.",
+	"Allis Communications",
+	"HONGKONG MIRACLE EAGLE TECHNOLOGY(GROUP) LIMITED",
+	"Fr. Sauter AG",
+	"PALLADIUM ENERGY ELETRONICA DA AMAZONIA LTDA",
+	"Theben AG",
+	"Techroutes Network Pvt Ltd",
+	"CDYNE Corporation",
+	"Xtreme Power Systems",
+	"UCZOON Technology Co., LTD",
+	"Zektor",
+	"Qardio, Inc",
+	"FeiTian United (Beijing) System Technology Co., Ltd.",
+	"NIC.br- Nucleo de Informacao e Coordenacao do Ponto BR",
+	"Delphin Technology AG",
+	"New Singularity International Technical Development Co.,Ltd",
+	"R. STAHL HMI Systems GmbH",
+	"Cheerstar Technology Co., Ltd",
+	"Global Digitech Co LTD",
+	"Speco Technologies",
+	"CC&C Technologies, Inc",
+	"Institute of microelectronic applications",
+	"SYNTECH (HK) TECHNOLOGY LIMITED",
+	"Atomos Engineering P/L",
+	"Shenzhen Kezhonglong Optoelectronic Technology Co., Ltd",
+	"Wistron NeWeb Corp.",
+	"Billion Electric Co. Ltd.",
+	"POSBANK CO.,LTD",
+	"SilverPlus, Inc",
+	"Siama Systems Inc",
+	"Soluciones Tecnologicas para la Salud y el Bienestar SA",
+	"IdaTech",
+	"RRAMAC",
+	"VOLTRONIC POWER TECHNOLOGY(SHENZHEN) CORP.",
+	"HUIZHOU QIAOXING ELECTRONICS TECHNOLOGY CO., LTD.",
+	"Midnite Solar",
+	"EltexAlatau",
+	"STCUBE.INC",
+	"Jiangsu Zhongxun Electronic Technology Co., Ltd",
+	"CardioTek B.V.",
+	"Mplus technology",
+	"Buwon Technology",
+	"The Sapling Company",
+	"Alps Electric Co.,",
+	"ABB Ltd",
+	"COX CO., LTD",
+	"Easy Digital Ltd.",
+	"Liptel s.r.o.",
+	"XIAMEN VANN INTELLIGENT CO., LTD",
+	"FORICS Electronic Technology Co., Ltd.",
+	"Newbridge Technologies Int. Ltd.",
+	"SUYIN Corporation",
+	"Kinetek Sports",
+	"TCT mobile limited",
+	"FACTS Engineering",
+	"Eyedro Green Solutions Inc.",
+	"SZ DJI TECHNOLOGY CO.,LTD",
+	"Transcend Communication Beijing Co.,Ltd.",
+	"RADiflow Ltd.",
+	"isepos GmbH",
+	"Atmaca Elektronik",
+	"Velodyne",
+	"Custom Control Concepts",
+	"Helium Systems, Inc",
+	"GkWare e.K.",
+	"Soladigm, Inc.",
+	"Avery Weigh-Tronix, LLC",
+	"Thermo Fisher Scientific P.O.A.",
+	"Key Digital Systems",
+	"KAEL MÜHENDİSLİK ELEKTRONİK TİCARET SANAYİ LİMİTED ŞİRKETİ",
+	"Hanson Technology",
+	"Sentrus Government Systems Division, Inc",
+	"DSSD Inc",
+	"Vuzix / Lenovo",
+	"GVI SECURITY INC.",
+	"Vivonic GmbH",
+	"CloudSwitch",
+	"Nokia Solutions and Networks",
+	"Merchandising Technologies, Inc",
+	"ATH system",
+	"Elber Srl",
+	"Phorus",
+	"Deutron Electronics Corp.",
+	"RO.VE.R. Laboratories S.p.A.",
+	"Beijing HuaqinWorld Technology Co,Ltd",
+	"EWM Hightec Welding GmbH",
+	"Move Innovation",
+	"Fujian Great Power PLC Equipment Co.,Ltd",
+	"2Wire Inc",
+	"Beijing LT Honway Technology Co.,Ltd",
+	"Verifone, Inc.",
+	"Trymus",
+	"AirScape Inc.",
+	"Abloomy",
+	"Taiwan Carol Electronics., Ltd",
+	"Vishal Telecommunications Pvt Ltd",
+	"REALAND BIO CO., LTD.",
+	"Quatius Limited",
+	"Estech Co.,Ltd",
+	"HNS",
+	"SHINSEI ELECTRONICS CO LTD",
+	"Sino-Telecom Technology Co.,Ltd.",
+	"Ayla Networks, Inc",
+	"Quanta computer Inc.",
+	"JABLOCOM s.r.o.",
+	"TRANWO TECHNOLOGY CO., LTD.",
+	"VisionVera International Co., Ltd.",
+	"Logic Way GmbH",
+	"CRU-Dataport",
+	"TERUMO CORPORATION",
+	"China Palms Telecom.Ltd",
+	"2 Wire",
+	"Thomas & Betts",
+	"C.E. ELECTRONICS, INC",
+	"Powerlinq Co., LTD",
+	"NEW LIGHT LED",
+	"Beijing Superbee Wireless Technology Co.,Ltd",
+	"XIAOMI Electronics,CO.,LTD",
+	"Digital Telecom Technology Limited",
+	"Petra Systems",
+	"TAZTAG",
+	"Pluribus Networks, Inc.",
+	"HEXIUM Technical Development Co., Ltd.",
+	"Shehzhen Huawei Communication Technologies Co., Ltd.",
+	"Heliospectra/Woodhill Investments",
+	"DIGIBRAS INDUSTRIA DO BRASILS/A",
+	"Dowslake Microsystems",
+	"Nippon Denki Kagaku Co.,LTD",
+	"Shandong Taixin Electronic co.,Ltd",
+	"Xorcom Ltd.",
+	"SEUNGIL ELECTRONICS",
+	"Dexin Corporation",
+	"BITwave Pte Ltd",
+	"SICHUAN TIANYI COMHEART TELECOMCO.,LTD",
+	"Exablaze",
+	"Swisscom Energy Solutions AG",
+	"GuangDong Quick Network Computer CO.,LTD",
+	"Shanghai WOASiS Telecommunications Ltd., Co.",
+	"CalDigit, Inc",
+	"dSPACE GmbH",
+	"LENUS Co., Ltd.",
+	"Hyunjin.com",
+	"LONG BEN (DONGGUAN) ELECTRONIC TECHNOLOGY CO.,LTD.",
+	"The Chamberlain Group, Inc",
+	"Frauscher Sensortechnik",
+	"Equinox Payments",
+	"Intelight Inc.",
+	"NSFOCUS Information Technology Co., Ltd.",
+	"Vodafone Omnitel B.V.",
+	"Shenzhen Lifesense Medical Electronics Co., Ltd.",
+	"Yahoo! JAPAN",
+	"Nicolet Neuro",
+	"Cellient Co., Ltd.",
+	"juniper networks",
+	"Nuvon, Inc",
+	"Beijing Omnific Technology, Ltd.",
+	"Hytera Communications Co .,ltd",
+	"Radio Datacom LLC",
+	"Iskratel d.o.o.",
+	"GooWi Technology Co.,Limited",
+	"Arbiter Systems, Inc.",
+	"Innovative Security Designs",
+	"Ubee Interactive Corp.",
+	"YOKOTA INDUSTRIAL CO,.LTD",
+	"TEKTELIC Communications Inc.",
+	"VG Controls, Inc.",
+	"Alphatron Marine BV",
+	"IVT Electronic Co.,Ltd",
+	"AG Elektronik AB",
+	"V Technology Co., Ltd.",
+	"Qualcomm iSkoot, Inc.",
+	"Kone OYj",
+	"OOO Samlight",
+	"Wonderlan (Beijing) Technology Co., Ltd.",
+	"Juni Korea Co., Ltd",
+	"Polytec GmbH",
+	"Chengdu Phycom Tech Co., Ltd.",
+	"PowerComm Solutions LLC",
+	"ViVOtech, Inc.",
+	"SDJ Technologies, Inc.",
+	"CombiQ AB",
+	"South African Broadcasting Corporation",
+	"Barnes&Noble",
+	"AXERRA Networks Ltd",
+	"LARK Technologies, Inc",
+	"Next Generation Integration (NGI)",
+	"Keith & Koep GmbH",
+	"Solidica, Inc.",
+	"TAICANG AND W ELECTRONICS CO LTD",
+	"OpenPattern",
+	"Syszone Co., Ltd.",
+	"Static Systems Group PLC",
+	"Kingnetic Pte Ltd",
+	"DEP Corp.",
+	"Woxu Wireless Co., Ltd",
+	"Serialway Communication Technology Co. Ltd",
+	"Morio Denki Co., Ltd.",
+	"global moisture management system",
+	"SiboTech Automation Co., Ltd.",
+	"WOORI SYSTEMS Co., Ltd",
+	"Gerdes Aktiengesellschaft",
+	"Kinion Technology Company Limited",
+	"Kenade Electronics Technology Co.,LTD.",
+	"Avvasi Inc.",
+	"Zonar Systems",
+	"Yancheng Kecheng Optoelectronic Technology Co., Ltd",
+	"Special Instrument Development Co., Ltd.",
+	"Zaklady Elektroniki i Mechaniki Precyzyjnej R&G S.A.",
+	"Systems And Electronic Development FZCO",
+	"Digital Airways",
+	"Rosewill Inc.",
+	"Sunwave Communications Co., Ltd",
+	"InfoSight Corporation",
+	"Advanced Telemetry",
+	"Nihon Dengyo Kousaku",
+	"Dejai",
+	"Vubiq Networks, Inc.",
+	"Wuhan Changjiang Electro-Communication Equipment CO.,LTD",
+	"DriveScale, Inc.",
+	"Countwise LTD",
+	"Magic Intelligence Technology Limited",
+	"ERECA",
+	"Bhuu Limited",
+	"Galtronics Telemetry Inc.",
+	"Shenzhen Herotel Tech. Co., Ltd.",
+	"PowerCloud Systems, Inc.",
+	"Alcatel-Lucent - Nuage",
+	"enLighted Inc",
+	"POWERTECH INDUSTRIAL CO., LTD.",
+	"PowerRay Co., Ltd.",
+	"Advanced Digital Broadcast SA",
+	"Zycoo Co.,Ltd",
+	"ComAp s.r.o.",
+	"Wi3 Inc.",
+	"Prophet Electronic Technology Corp.,Ltd",
+	"CETORY.TV Company Limited",
+	"Westunitis Co., Ltd.",
+	"ELS-GmbH & Co. KG",
+	"Measurement Systems S. de R.L.",
+	"Y Soft Corporation, a.s.",
+	"Pandora Mobility Corporation",
+	"eSSys Co.,Ltd",
+	"IGI Mobile, Inc.",
+	"OuterLink Corporation",
+	"Orbotix, Inc.",
+	"INQ Mobile Limited",
+	"EDP Servicos",
+	"Shenzhen Costar Electronics Co. Ltd.",
+	"Society of Motion Picture &amp; Television Engineers",
+	"Honghao Mingchuan Technology (Beijing) CO.,Ltd.",
+	"BSH Bosch and Siemens Home Appliances GmbH",
+	"DATECS LTD.,",
+	"RF IDeas",
+	"Futura Mobility",
+	"INESA ELECTRON CO.,LTD",
+	"WaterFurnace International, Inc.",
+	"Act KDE, Inc.",
+	"b-plus GmbH",
+	"Octopus Systems Limited",
+	"Mine Site Technologies",
+	"U Tek Company Limited",
+	"L-3 Communications Infrared Products",
+	"Shenzhen Trimax Technology Co.,Ltd",
+	"Portalis LC",
+	"ProSys Development Services",
+	"Nantong Coship Electronics Co., Ltd",
+	"OPWILL Technologies CO .,LTD",
+	"PacketStorm Communications",
+	"Unglaube Identech GmbH",
+	"Angstrem Telecom",
+	"YODO Technology Corp. Ltd.",
+	"Shenzhen Seavo Technology Co.,Ltd",
+	"ALONG INDUSTRIAL CO., LIMITED",
+	"Shenzhen LiWiFi Technology Co., Ltd",
+	"Data Controls Inc.",
+	"LCFC(HeFei) Electronics Technology co., ltd",
+	"Redflow Limited",
+	"Generalplus Technology Inc.",
+	"Next Level Security Systems, Inc.",
+	"Shenzhen Jin Yun Video Equipment Co., Ltd.",
+	"RBH Access Technologies Inc.",
+	"Digiquest Electronics LTD",
+	"Universal Global Scientific Industrial Co.,Ltd.",
+	"JDC Tech Co., Ltd.",
+	"Erhardt+Leimer GmbH",
+	"Nautronix Limited",
+	"Decatur Electronics",
+	"Guangzhou YBDS IT Co.,Ltd",
+	"Ainsworth Game Technology",
+	"OOO NPP Systemotechnika-NN",
+	"Accelink Technologies Co.,Ltd.",
+	"B&B EXPORTING LIMITED",
+	"Indieon Technologies Pvt. Ltd.",
+	"Magicjack LP",
+	"Beijing ZhongHuaHun Network Information center",
+	"Shenzhen Aero-Startech. Co.Ltd",
+	"SoundHawk Corp",
+	"KE Knestel Elektronik GmbH",
+	"Nimbus Data Systems, Inc.",
+	"Zappware",
+	"Antex Electronic Corp.",
+	"Vossloh-Schwabe Deutschland GmbH",
+	"Aclima, Inc.",
+	"SHENZHEN MERCURY COMMUNICATION TECHNOLOGIES CO.,LTD.",
+	"Shenzhen Haitianxiong Electronic Co., Ltd.",
+	"SunReports, Inc.",
+	"ShenZhen Rapoo Technology Co., Ltd.",
+	"Ubiquitous Internet Telecom Co., Ltd",
+	"Rinicom Holdings",
+	"Micro-Star INT'L CO., LTD",
+	"Penguin Computing",
+	"Core Logic Inc.",
+	"Stereotaxis, Inc.",
+	"Novar GmbH",
+	"Mitsuba Corporation",
+	"Technonia",
+	"Otus Technologies Ltd",
+	"Wireless Glue Networks, Inc.",
+	"SanLogic Inc",
+	"Inspur Electronic Information Industry Co.,Ltd.",
+	"Ocedo GmbH",
+	"Valentine Research, Inc.",
+	"Nimble Storage",
+	"EDAM information & communications",
+	"YOUNGBO ENGINEERING INC.",
+	"Telefield Ltd",
+	"TransPacket AS",
+	"Digital Payment Technologies",
+	"Venetex Corp",
+	"Hubbell Building Automation, Inc.",
+	"KZ Broadband Technologies, Ltd.",
+	"IBM Corporation",
+	"Shenzhen Lianrui Electronics Co.,Ltd",
+	"Anhui comhigher tech co.,ltd",
+	"Noon Technology Co., Ltd",
+	"Smartek d.o.o.",
+	"WING SING ELECTRONICS HONG KONG LIMITED",
+	"Promethean Limited",
+	"Modcam AB",
+	"SOUND4",
+	"Villiger Security Solutions AG",
+	"Gastron Co., LTD.",
+	"SHENZHEN CLOU ELECTRONICS CO. LTD.",
+	"Mooredoll Inc.",
+	"Nanoptix Inc.",
+	"Proware Technologies Co Ltd.",
+	"MPB Communications Inc.",
+	"FATEK Automation Corporation",
+	"01DB-METRAVIB",
+	"Dewav Technology Company",
+	"SHENZHEN IKINLOOP TECHNOLOGY CO.,LTD.",
+	"Poindus Systems Corp.",
+	"Limited Liability Company",
+	"Liteon Tech Corp.",
+	"ADVAS CO., LTD.",
+	"Comtech Technology Co., Ltd.",
+	"fos4X GmbH",
+	"EpSpot AB",
+	"E-Domus International Limited",
+	"Wisol",
+	"Newings Communication CO., LTD.",
+	"PolyVision Inc.",
+	"Aava Mobile Oy",
+	"Nanjing Zhongke Menglian Information Technology Co.,LTD",
+	"ACX GmbH",
+	"Athena Wireless Communications Inc",
+	"Invensys Rail",
+	"Low Tech Solutions",
+	"Shenzhen Afoundry Electronic Co., Ltd",
+	"SEAWING Kft",
+	"Edwards Lifesciences LLC",
+	"CHYNG HONG ELECTRONIC CO., LTD.",
+	"Xstream Flow (Pty) Ltd",
+	"Rinstrum Pty Ltd",
+	"TMRG, Inc.",
+	"KWANGWON TECH CO., LTD.",
+	"Alfa Instrumentos Eletronicos Ltda.",
+	"Medallion Instrumentation Systems",
+	"OOO TTV",
+	"M2Communication Inc.",
+	"Konami Gaming Inc",
+	"Action Target, Inc.",
+	"LaVision GmbH",
+	"Calantec GmbH",
+	"ORBIS TECNOLOGIA ELECTRICA S.A.",
+	"Suzhou Hanming Technologies Co., Ltd.",
+	"Saijo Denki International Co., Ltd.",
+	"Purple Communications, Inc",
+	"Brain Corporation",
+	"EdgeCore Networks",
+	"Pace plc.",
+	"Oxyguard International A/S",
+	"LevelOne Communications (India) Private Limited",
+	"KERLINK",
+	"ADATA Technology Co., Ltd",
+	"NASTEC LTD.",
+	"as electronics GmbH",
+	"OleumTech Corporation",
+	"citygrow technology co., ltd",
+	"Intelligent Optical Network High Tech CO.,LTD.",
+	"Space Monkey, Inc.",
+	"Happyelectronics Co.,Ltd",
+	"Italian Institute of Technology",
+	"Shenzhen Y&D Electronics Co.,LTD.",
+	"Bryton Inc.",
+	"Nero AG",
+	"X6D Limited",
+	"Trendsetter Medical, LLC",
+	"Advanced Wireless Dynamics S.L.",
+	"Prox Dynamics AS",
+	"Malvern Instruments Ltd",
+	"Nishiyama Industry Co.,LTD.",
+	"Shenzhen Zowee Technology Co., Ltd",
+	"Shenou Communication Equipment Co.,Ltd",
+	"Hiltron s.r.l.",
+	"IEEE REGISTRATION AUTHORITY  - Please see OUI36 public listing for more information.",
+	"Embedded Technologies s.r.o.",
+	"Bosch Automotive Aftermarket",
+	"INNO S",
+	"Scalar Corporation",
+	"Wellcore Corporation",
+	"Metrum Technologies",
+	"Upos System sp. z o.o.",
+	"Cambridge Industries Group Co Ltd.",
+	"TECSEN",
+	"HONGYU COMMUNICATION TECHNOLOGY LIMITED",
+	"3view Ltd",
+	"SAE IT-systems GmbH & Co. KG",
+	"Wistron InfoComm(Zhongshan) Corporation",
+	"Beijing C&W Optical Communication Technology Co.,Ltd.",
+	"Netatmo",
+	"Data Modul AG",
+	"Xetawave LLC",
+	"Cheerzing Communication(Xiamen)Technology Co.,Ltd",
+	"Lenovo Mobile Communication (Wuhan) Company Limited",
+	"JSJS Designs (Europe) Limited",
+	"Optowiz Co., Ltd",
+	"SRT Wireless",
+	"Tri-Sen Systems Corporation",
+	"ChangYang Technology (Nanjing) Co., LTD",
+	"Infinidat Ltd.",
+	"Norfolk Elektronik AG",
+	"DirectPacket Research, Inc,",
+	"NT-ware Systemprg GmbH",
+	"Tongfang Shenzhen Cloudcomputing Technology Co.,Ltd",
+	"ANNAX Anzeigesysteme GmbH",
+	"Gentrice tech",
+	"EXPLORER HYPERTECH CO.,LTD",
+	"Arrive Systems Inc.",
+	"COMMSEN CO., LIMITED",
+	"TRUMPF Laser GmbH + Co. KG",
+	"TCT mobile ltd.",
+	"Samsung Semiconductor Inc.",
+	"TSL PPL",
+	"VTS GmbH",
+	"Atlinks",
+	"T:mi Ytti",
+	"Aperi Corporation",
+	"Rezolt Corporation",
+	"VS Vision Systems GmbH",
+	"MOVEK",
+	"Contec GmbH",
+	"Chipsip Technology Co., Ltd.",
+	"MICRODIGTAL Inc",
+	"Amazon Technologies Inc.",
+	"ServiceAssure",
+	"ETH Inc.",
+	"Aliwei Communications, Inc",
+	"Red Embedded Design Limited",
+	"Beijing Thomson CITIC Digital Technology Co. LTD.",
+	"Bestek Corp.",
+	"MasterImage 3D",
+	"MEN Mikro Elektronik GmbH",
+	"Renesas Electronics Corporation",
+	"Four systems Co.,Ltd.",
+	"AgJunction",
+	"Todaair Electronic Co., Ltd",
+	"Huizhou Desay SV Automotive Co., Ltd.",
+	"Art2Wave Canada Inc.",
+	"ZIMI CORPORATION",
+	"QRS Music Technologies, Inc.",
+	"Powerleader Science and Technology Co. Ltd.",
+	"China Mobile Group Device Co.,Ltd.",
+	"iNovo Broadband, Inc.",
+	"Network Video Technologies, Inc",
+	"Fujian JinQianMao Electronic Technology Co.,Ltd",
+	"Longconn Electornics(shenzhen)Co.,Ltd",
+	"HYUNTECK",
+	"Zhejiang Hite Renewable Energy Co.,LTD",
+	"Calxeda, Inc.",
+	"Smith Myers Communications Ltd.",
+	"Packet Force Technology Limited Company",
+	"ERICSSON AB",
+	"WYMA Tecnologia",
+	"Evrisko Systems",
+	"Ebay Inc",
+	"Ergophone GmbH",
+	"Vizmonet Pte Ltd",
+	"APISTE CORPORATION",
+	"RADSPIN",
+	"TP-LINK Technologies Co.,Ltd.",
+	"Acumen",
+	"BnCOM Co.,Ltd",
+	"Beijing HCHCOM Technology Co., Ltd",
+	"Maxwell Forest",
+	"Neuron Robotics",
+	"Berkeley Nucleonics Corp",
+	"Compupal (Group) Corporation",
+	"Wren Sound Systems, LLC",
+	"Adaptive Spectrum and Signal Alignment (ASSIA), Inc.",
+	"Z.U.K. Elzab S.A.",
+	"NBS Payment Solutions Inc.",
+	"ORANTEK LIMITED",
+	"NASCENT Technology",
+	"Jabil Inc",
+	"Affirmed Networks",
+	"Omnima Limited",
+	"Stephen Technologies Co.,Limited",
+	"UltraClenz, LLC",
+	"Millennium Group, Inc.",
+	"Kai-EE",
+	"TELNET Redes Inteligentes S.A.",
+	"EasySYNC Ltd.",
+	"FUJITU(HONG KONG) ELECTRONIC Co.,LTD.",
+	"Zioncom technology co.,ltd",
+	"CyberTAN Technology Inc.",
+	"The Will-Burt Company",
+	"f.robotics acquisitions ltd",
+	"LiveU Ltd.",
+	"Shenzhen Hojy Software Co., Ltd.",
+	"SankyuElectronics",
+	"Mobile Integration Workgroup",
+	"Vachen Co.,Ltd",
+	"RAFI GmbH & Co.KG",
+	"Hioso Technology Co., Ltd.",
+	"Argox Information Co., Ltd.",
+	"MITSUMI ELECTRIC CO.,LTD",
+	"ZTEC Instruments, Inc.",
+	"GiK mbH",
+	"Server Racks Australia Pty Ltd",
+	"INDRA ESPACIO S.A.",
+	"CMC",
+	"Eltek Technologies LTD",
+	"Q9 Networks Inc.",
+	"Nationz Technologies Inc",
+	"Musilab Electronics (DongGuan)Co.,Ltd.",
+	"MEDILINE ITALIA SRL",
+	"Shenzhen Aikede Technology Co., Ltd",
+	"HOERBIGER Elektronik GmbH",
+	"Siemens AG I IA PP PRM",
+	"iiNet Labs Pty Ltd",
+	"Advidia",
+	"SHENZHEN ZHIBOTONG ELECTRONICS CO.,LTD",
+	"Shenzhen Smarteye Digital Electronics Co., Ltd",
+	"Precidata",
+	"DAEYEON Control&Instrument Co,.Ltd",
+	"Amphenol",
+	"ABB Australia",
+	"Igneous Systems",
+	"Far South Networks",
+	"ShangHai sunup lighting CO.,LTD",
+	"Elo touch solutions",
+	"Ever Treasure Industrial Limited",
+	"AOBO Telecom Co.,Ltd",
+	"INTER SALES A/S",
+	"Shinybow Technology Co. Ltd.",
+	"Enhanced Vision",
+	"STULZ GmbH",
+	"H&D Wireless",
+	"Shenzhen Runsil Technology Co.,Ltd",
+	"Innovasic, Inc.",
+	"ELNO",
+	"DHC Software Co.,Ltd",
+	"SMC Networks Inc",
+	"Neousys Technology Inc.",
+	"Vicos",
+	"Pace-O-Matic, Inc.",
+	"HONGKONG YUNLINK TECHNOLOGY LIMITED",
+	"NAVIELEKTRO KY",
+	"Aristocrat Technologies Australia Pty. Ltd.",
+	"NuCom HK Ltd.",
+	"GBO Technology",
+	"c-scape",
+	"RainUs Co.,Ltd",
+	"Instituto Nacional de Tecnología Industrial",
+	"Shanghai Qihui Telecom Technology Co., LTD",
+	"Kyland-USA",
+	"Unetconvergence Co., Ltd.",
+	"BEGA Gantenbrink-Leuchten KG",
+	"Silverbrook Research",
+	"B Communications Pty Ltd",
+	"Socus networks",
+	"Shanghai Diveo Technology Co., Ltd",
+	"Curtis Instruments, Inc.",
+	"Hansung Electronics Co. LTD",
+	"RAFAEL LTD.",
+	"Ultra Electronics, CIS",
+	"Shanghai B-Star Technology Co",
+	"Bekey A/S",
+	"J-MEX Inc.",
+	"QianTang Technology",
+	"Damall Technologies SAS",
+	"Saia-Burgess Controls AG",
+	"8Locations Co., Ltd.",
+	"Dalian Co-Edifice Video Technology Co., Ltd",
+	"XIAOMI IMC",
+	"2N TELEKOMUNIKACE a.s.",
+	"KoamTac",
+	"chaowifi.com",
+	"Secure Electrans Ltd",
+	"Blackmagic Design",
+	"MEG Electronics Inc.",
+	"Real Time Logic",
+	"SSOMA SECURITY",
+	"Imago Group",
+	"Shenzhen Homecare Technology Co.,Ltd.",
+	"PATECH",
+	"E-Band Communications Corp.",
+	"Entertainment Solutions, S.L.",
+	"Plexus Manufacturing Sdn Bhd",
+	"Portsmith LLC",
+	"MindTree Wireless PVT Ltd",
+	"Red Sun Synthesis Pte Ltd",
+	"Scale Computing, Inc.",
+	"Metamako",
+	"YSI, Inc.",
+	"IBC TECHNOLOGIES INC.",
+	"GatesAir, Inc",
+	"SafeTone Technology Co.,Ltd",
+	"Tenyu Tech Co. Ltd.",
+	"Tigaro Wireless",
+	"PIXSYS SRL",
+	"AMS NEVE LTD",
+	"Caterpillar Trimble Control Technologies",
+	"ShenZhen ACTO Digital Video Technology Co.,Ltd.",
+	"Unikey Technologies",
+	"ENMAS GmbH",
+	"Phytrex Technology Corp.",
+	"Z'SEDAI KENKYUSHO CORPORATION",
+	"Jinmuyu Electronics Co., Ltd.",
+	"Nortec",
+	"Shenzhen Hikeen Technology CO.,LTD",
+	"Glen Dimplex Nordic as",
+	"Shanghai Hongzhuo Information Technology co.,LTD",
+	"Philips Healthcare PCCI",
+	"Openmatics s.r.o.",
+	"VSE valencia smart energy",
+	"GN ReSound A/S",
+	"King Slide Technology CO., LTD.",
+	"D.SignT GmbH & Co. KG",
+	"MHL, LLC",
+	"MESMO TECHNOLOGY INC.",
+	"Bosch Software Innovations GmbH",
+	"OSRAM GmbH",
+	"Satelco AG",
+	"Acacia Communications",
+	"ACES Technology",
+	"Paradigm Electronics Inc",
+	"Cosco Electronics Co., Ltd.",
+	"AE REFUsol",
+	"Mobilicom LTD",
+	"Devialet",
+	"Shenzhen RF-LINK Elec&Technology.,Ltd",
+	"Acro Associates, Inc.",
+	"TIANJIN YAAN TECHNOLOGY CO., LTD.",
+	"Damalisk",
+	"EMC",
+	"Antaira Technologies, LLC",
+	"MS-Magnet",
+	"Guangzhou Juzing Technology Co., Ltd",
+	"Shanghai SEARI Intelligent System Co., Ltd",
+	"INVENTEC Corporation",
+	"Freestyle Technology Pty Ltd",
+	"Enmotus Inc",
+	"New Cosmos Electric Co., Ltd.",
+	"Dongnian Networks Inc.",
+	"Chongqing MAS SCI&TECH.Co.,Ltd",
+	"IOXOS Technologies S.A.",
+	"Shenzhen Ogemray Technology Co., Ltd.",
+	"NEON Inc",
+	"Computer Performance, Inc. DBA Digital Loggers, Inc.",
+	"Quirky, Inc.",
+	"ESEN Optoelectronics Technology Co.,Ltd.",
+	"ASCT",
+	"MICROSOFT",
+	"Creative Product Design Pty. Ltd.",
+	"Inhon International Ltd.",
+	"Bee Beans Technologies, Inc.",
+	"Linkwell Telesystems Pvt Ltd",
+	"NUUO Inc.",
+	"Salutron Inc.",
+	"Shenzhen Safe vision Technology Co.,LTD",
+	"Huizhou Super Electron Technology Co.,Ltd.",
+	"Montage Technology Group Limited",
+	"Esson Technology Inc.",
+	"Keysight Technologies, Inc.",
+	"COMTEC co.,ltd",
+	"Chengdu XGimi Technology Co.,Ltd",
+	"ForgetBox",
+	"Sunlit System Technology Corp",
+	"Guangzhou V-SOLUTION Electronic Technology Co., Ltd.",
+	"Brunel University",
+	"Shanghai Reallytek Information Technology  Co.,Ltd",
+	"Avaya Inc",
+	"Trade FIDES, a.s.",
+	"Beijing Beny Wave Technology Co Ltd",
+	"Germaneers GmbH",
+	"Solarbridge Technologies",
+	"azeti Networks AG",
+	"Zurich Instruments AG",
+	"OT Systems Limited",
+	"Netgear Inc",
+	"LeapFrog Enterprises, Inc.",
+	"PATLITE CORPORATION",
+	"ABB Xiamen Low Voltage Equipment Co.,Ltd.",
+	"ghe-ces electronic ag",
+	"Winstars Technology Ltd",
+	"bytes at work AG",
+	"Adolf Tedsen GmbH & Co. KG",
+	"Packet Design, Inc.",
+	"SICHUAN TIANYI COMHEART TELECOM CO.,LTD",
+	"Ventilation Control",
+	"ThinkEco, Inc.",
+	"W & D TECHNOLOGY CORPORATION",
+	"NovaTec Kommunikationstechnik GmbH",
+	"Noviga",
+	"Shenzhen sangfei consumer communications co.,ltd",
+	"Nimbus Inc.",
+	"BenQ Corporation",
+	"Prescope Technologies CO.,LTD.",
+	"KAESER KOMPRESSOREN AG",
+	"NET New Electronic Technology GmbH",
+	"K's Co.,Ltd.",
+	"Newag SA",
+	"ShanDong Tecsunrise  Co.,Ltd",
+	"ABB Genway Xiamen Electrical Equipment CO., LTD",
+	"Corsair Components",
+	"Neosystem Co. Ltd.",
+	"Chongqing Sichuan Instrument Microcircuit Co.LTD.",
+	"TONGQING COMMUNICATION EQUIPMENT (SHENZHEN) Co.,Ltd",
+	"ATCOM Technology Co.Ltd.",
+	"Netronics Technologies Inc.",
+	"Shenzhen Runhuicheng Technology Co., Ltd",
+	"Lih Rong electronic Enterprise Co., Ltd.",
+	"AgileMesh, Inc.",
+	"Xapt GmbH",
+	"TOKYO RADAR CORPORATION",
+	"Hon Hai Precision Ind Co.,Ltd",
+	"Altenergy Power System,Inc.",
+	"Fiberhome Telecommunication Technologies Co.,LTD",
+	"Shanghai DareGlobal Technologies Co.,Ltd",
+	"Osterhout Design Group",
+	"USAG",
+	"ELEKTRON TECHNOLOGY UK LIMITED",
+	"Forworld Electronics Ltd.",
+	"Alstom Grid",
+	"ELFTECH Co., Ltd.",
+	"TeleAdapt Ltd",
+	"Neets",
+	"Satmap Systems Ltd",
+	"Remec Broadband Wireless , LLC",
+	"Endian s.r.l.",
+	"Openpeak, Inc",
+	"Theissen Training Systems GmbH",
+	"Embed, Inc",
+	"Hangzhou I'converge Technology Co.,Ltd",
+	"LzLabs GmbH",
+	"QSR Automations, Inc.",
+	"Power Quotient International Co., Ltd.",
+	"Dialog Semiconductor Hellas SA",
+	"Shuttle Inc.",
+	"Kyynel",
+	"IRCO Sistemas de Telecomunicación S.A.",
+	"RayTight",
+	"UNIDIS",
+	"Greyware Automation Products, Inc",
+	"SAMSUNG ELECTRO-MECHANICS CO., LTD",
+	"Shanghai GMT Digital Technologies Co., Ltd",
+	"GP Electronics (HK) Ltd.",
+	"Weifang GoerTek Electronics Co., Ltd",
+	"Osram GmbH",
+	"Shenzhen Excelsecu Data Technology Co.,Ltd",
+	"KERNEL-I Co.,LTD",
+	"Shenzhen Ginwave Technologies Ltd.",
+	"Silex Technology, Inc",
+	"Tariox Limited",
+	"BEIJING THOUGHT SCIENCE CO.,LTD.",
+	"Corporation of the Presiding Bishop of The Church of Jesus Christ of Latter-day Saints",
+	"EMPORIA TELECOM Produktions- und VertriebsgesmbH & Co KG",
+	"Huria Co.,Ltd.",
+	"Modelleisenbahn GmbH",
+	"Innokas Group",
+	"SkyHawke Technologies, LLC",
+	"ANHUI WANZTEN P&T CO., LTD",
+	"hyungseul publishing networks",
+	"Tri-Tech Manufacturing, Inc.",
+	"ServerU Inc",
+	"WOXTER TECHNOLOGY Co. Ltd",
+	"vArmour Networks, Inc.",
+	"Beijing Sankuai Online Technology Co.,Ltd",
+	"Ablelink Electronics Ltd",
+	"Coho Data, Inc.,",
+	"DVR C&C Co., Ltd.",
+	"Chabrier Services",
+	"Shenzhen Netcom Electronics Co.,Ltd",
+	"EuroCB (Phils), Inc.",
+	"Wireless Tsukamoto.,co.LTD",
+	"Park Assist LLC",
+	"I&C Technology",
+	"Letv Mobile and Intelligent Information Technology (Beijing) Corporation Ltd.",
+	"Addat S.r.o.",
+	"Beijing Huasun Unicreate Technology Co., Ltd",
+	"Newrun",
+	"Paradox Engineering SA",
+	"Hella Sonnen- und Wetterschutztechnik GmbH",
+	"Greenvity Communications",
+	"Rajant Corporation",
+	"Embertec Pty Ltd",
+	"Arnold & Richter Cine Technik",
+	"InCoax Networks Europe AB",
+	"Cathay Communication Co.,Ltd",
+	"Memjet Inc.",
+	"Centera Photonics Inc.",
+	"Cyber Trans Japan Co.,Ltd.",
+	"Beijing Novel Super Digital TV Technology Co., Ltd",
+	"Beat System Service Co,. Ltd.",
+	"Jiangsu Qinheng Co., Ltd.",
+	"Vaillant GmbH",
+	"Gnodal Ltd",
+	"C3PO S.A.",
+	"Incognito Software Inc.",
+	"Fairphone",
+	"IEEE 1905.1",
+	"Unipattern Co.,",
+	"Sierra Wireless Inc",
+	"Cilag GmbH International",
+	"Crystal Vision Ltd",
+	"NET2GRID BV",
+	"Chuango Security Technology Corporation",
+	"Shenzhen NEED technology Ltd.",
+	"Bluwan SA",
+	"Liang Herng Enterprise,Co.Ltd.",
+	"Vieworks",
+	"BBMC Co.,Ltd",
+	"Metrascale Inc.",
+	"OMS spol. s.r.o.",
+	"Cross Point BV",
+	"RTC Industries, Inc.",
+	"Arcadyan Technology Corp.",
+	"MTMCommunications",
+	"Huami Information Technology Co.,Ltd.",
+	"Jabil Circuits India Pvt Ltd,-EHTP unit",
+	"Panodic(ShenZhen) Electronics Limted",
+	"Qualcomm Atheros",
+	"Protonic Holland",
+	"Tamron Co., Ltd",
+	"LMI Technologies",
+	"Nebusens, S.L.",
+	"Watchnet DVR Inc",
+	"TTTech Computertechnik AG",
+	"Dalian Netmoon Tech Develop Co.,Ltd",
+	"storONE",
+	"Ivenix, Inc.",
+	"Transics",
+	"SRC Computers, LLC",
+	"Cheering Connection Co. Ltd.",
+	"Shenzhen Atsmart Technology Co.,Ltd.",
+	"ORBISAT DA AMAZONIA IND E AEROL SA",
+	"AirTies Wireless Netowrks",
+	"Telechips Inc.",
+	"Siemens AG, Healthcare Sector",
+	"XTA Electronics Ltd",
+	"Siano Mobile Silicon Ltd.",
+	"Shenzhen ChuangDao & Perpetual Eternal Technology Co.,Ltd",
+	"CHINA HOPEFUL GROUP HOPEFUL ELECTRIC CO.,LTD",
+	"Hangzhou Silan Microelectronic Inc",
+	"SC Professional Trading Co., Ltd.",
+	"K2E Tekpoint",
+	"Game Technologies SA",
+	"Simton Technologies, Ltd.",
+	"DarbeeVision Inc.",
+	"All Components Incorporated",
+	"GSI Electronics Inc.",
+	"Storage Appliance Corporation",
+	"Brady Corp Asia Pacific Ltd",
+	"Viewcooper Corp.",
+	"Racktivity",
+	"Gemicom Technology, Inc.",
+	"Unified Packet Systems Crop",
+	"TTC MARCONI s.r.o.",
+	"Entrypass Corporation Sdn. Bhd.",
+	"TERAON",
+	"BTB Korea INC",
+	"Amatis Controls",
+	"QPCOM INC.",
+	"Generiton Co., Ltd.",
+	"COMPAL INFORMATION(KUNSHAN)CO.,LTD",
+	"Delta Control GmbH",
+	"AirTight Networks, Inc.",
+	"Gembird Europe BV",
+	"Qfiednet Co., Ltd.",
+	"Simple Audio Ltd",
+	"Beijing Ereneben lnformation Technology Limited",
+	"Logitech - Ultimate Ears",
+	"Suzhou Torchstar Intelligent Technology Co.,Ltd",
+	"FirmTek, LLC",
+	"DEP Company",
+	"Canopus Systems US LLC",
+	"SENAO Networks, Inc.",
+	"Shenzhen VisionSTOR Technologies Co., Ltd",
+	"Art Beijing Science and Technology Development Co., Ltd.",
+	"Avotek corporation",
+	"Whirlpool Corporation",
+	"iKnowledge Integration Corp.",
+	"YONG TAI ELECTRONIC (DONGGUAN) LTD.",
+	"Tamaggo",
+	"Cudo Communication Co., Ltd.",
+	"cellon communications technology(shenzhen)Co.,Ltd.",
+	"Jetmobile Pte Ltd",
+	"LINEEYE CO., LTD",
+	"ShangHai WangYong Software Co., Ltd.",
+	"Koubachi AG",
+	"FLOW DATA INC",
+	"Remote Solution",
+	"Amper",
+	"GUANGDONG OPPO MOBILE TELECOMMUNICATIONS CORP.,LTD.",
+	"ABUS Security-Center GmbH & Co. KG",
+	"Shenzhen RF Technology Co., Ltd",
+	"RF Surgical System Inc.",
+	"QuantHouse",
+	"Vocollect Inc",
+	"IBA Dosimetry GmbH",
+	"EmFirst Co., Ltd.",
+	"HiteVision Digital Media Technology Co.,Ltd.",
+	"Skiva Technologies, Inc.",
+	"NAKAYO TELECOMMUNICATIONS,INC.",
+	"RDA Technologies Ltd.",
+	"Shanghai BroadMobi Communication Technology Co., Ltd.",
+	"GIGA TMS INC",
+	"3D Imaging & Simulations Corp",
+	"Unmonday Ltd",
+	"Cerio Corporation",
+	"Shenzhen ireadygo Information Technology CO.,LTD.",
+	"A&D ENGINEERING CO., LTD.",
+	"Imaging Solutions Group",
+	"LVX Western",
+	"C Technologies AB",
+	"Exeltech Solar Products",
+	"d-broad,INC",
+	"UCI Corporation Co.,Ltd.",
+	"Beijing Railway Signal Factory",
+	"Beyond Devices d.o.o.",
+	"Nortek-AS",
+	"Viogem Limited",
+	"Goden Tech Limited",
+	"LONGCHEER TELECOMMUNICATION LIMITED",
+	"Lytro, Inc.",
+	"Insigma Technology Co.,Ltd",
+	"Leica Camera AG",
+	"ESTUN AUTOMATION TECHNOLOY CO., LTD",
+	"taskit GmbH",
+	"Toyoshima Electric Technoeogy(Suzhou) Co.,Ltd.",
+	"Aus.Linx Technology Co., Ltd.",
+	"Encell Technology, Inc.",
+	"Beijing NeTopChip Technology Co.,LTD",
+	"Plugable Technologies",
+	"Y-cam Solutions Ltd",
+	"Airtech I&C Co., Ltd",
+	"Shenzhen UniStrong Science & Technology Co., Ltd",
+	"IPitomy Communications",
+	"Xiaomi Technology Co.,Ltd",
+	"Shanghai Xinyou Information Technology Ltd. Co.",
+	"ShenZhen Konka Telecommunication Technology Co.,Ltd",
+	"Radinet Communications Inc.",
+	"zhejiang ebang communication co.,ltd",
+	"ACTP, Inc.",
+	"BEFEGA GmbH",
+	"CG Mobile",
+	"VisSim AS",
+	"Ikor Metering",
+	"ESG Solutions",
+	"Wifly-City System Inc.",
+	"ISSC Technologies Corp.",
+	"Comlab Inc.",
+	"DK Networks",
+	"Sonardyne International Ltd",
+	"Precepscion Pty. Ltd.",
+	"ORANGE POLSKA",
+	"Power Automation pte Ltd",
+	"MESADA Technology Co.,Ltd.",
+	"QUALCOMM Incorporated",
+	"Shenzhen Shidean Legrand Electronic Products Co.,Ltd",
+	"ZHEJIANG DAHUA TECHNOLOGY CO.,LTD",
+	"PARROT",
+	"Far-sighted mobile",
+	"Wiio, Inc.",
+	"PSG Plastic Service GmbH",
+	"Alinket Electronic Technology Co., Ltd",
+	"Digimore Electronics Co., Ltd",
+	"Nanomegas",
+	"Apex Tool Group GmbH & Co OHG",
+	"Shanghai Meridian Technologies, Co. Ltd.",
+	"SCS SA",
+	"Fujitsu Technology Solutions GmbH",
+	"GREAT COMPUTER CORPORATION",
+	"BYD Precision Manufacture Co.,Ltd",
+	"General Engine Management Systems Ltd.",
+	"C-MAX Asia Limited",
+	"LabJack",
+	"Onyx Healthcare Inc.",
+	"Gatekeeper Systems, Inc.",
+	"Changzhou Tiannengbo System Co. Ltd.",
+	"Yunnan KSEC Digital Technology Co.,Ltd.",
+	"Shenzhen Wision Technology Holding Limited",
+	"Zicon Technology Corp.",
+	"Tokyo Boeki Medisys Inc.",
+	"Vadaro Pte Ltd",
+	"RORZE CORPORATION",
+	"Advanced PANMOBIL Systems GmbH & Co. KG",
+	"Elettronica Santerno SpA",
+	"TES ELECTRONIC SOLUTIONS",
+	"Ericsson, EAB/RWI/K",
+	"Lenbrook Industries Limited",
+	"Autotalks Ltd.",
+	"modas GmbH",
+	"Fida International (S) Pte Ltd",
+	"Alphion India Private Limited",
+	"DLG Automação Industrial Ltda",
+	"Garea Microsys Co.,Ltd.",
+	"Benchmark Electronics Romania SRL",
+	"Gebr. Bode GmbH & Co KG",
+	"Beijing Morncloud Information And Technology Co. Ltd.",
+	"SNUPI Technologies",
+	"UTEK TECHNOLOGY (SHENZHEN) CO.,LTD",
+	"General Electric Water & Process Technologies",
+	"IONICS TECHNOLOGY ME LTDA",
+	"Total Phase",
+	"H.K ZONGMU TECHNOLOGY CO., LTD.",
+	"GZ Weedong Networks Technology Co. , Ltd",
+	"GH Technologies",
+	"UNO System Co., Ltd",
+	"TRISON TECHNOLOGY CORPORATION",
+	"RSI VIDEO TECHNOLOGIES",
+	"Diehl BGT Defence GmbH & Co. KG",
+	"Impex-Sat GmbH&amp;Co KG",
+	"ELVEES NeoTek OJSC",
+	"Newland Design + Assoc. Inc.",
+	"Accutron Instruments Inc.",
+	"United Telecoms Ltd",
+	"GHEO SA",
+	"JSW PACIFIC CORPORATION",
+	"Pakedge Device and Software Inc.",
+	"BrightSign LLC",
+	"Joyent, Inc.",
+	"Johnson Outdoors Marine Electronics d/b/a Minnkota",
+	"Nanjing Jiahao Technology Co., Ltd.",
+	"Synaptics, Inc",
+	"Dlogixs Co Ltd",
+	"Qingdao Hisense Electric Co.,Ltd.",
+	"Palomar Medical Technologies",
+	"Bookeen",
+	"Comtec Co., Ltd.",
+	"HUG-WITSCHI AG",
+	"AVANU",
+	"FOCUS H&S Co., Ltd.",
+	"E-LEAD ELECTRONIC CO., LTD",
+	"s.m.s smart microwave sensors GmbH",
+	"IEEE 1722a Working Group",
+	"SPI Lasers Ltd",
+	"Hangzhou Anheng Info&Tech CO.,LTD",
+	"Radius Gateway",
+	"Kirisun Communications Co., Ltd.",
+	"Rand McNally",
+	"Phillips Machine & Welding Co., Inc.",
+	"Metro Ethernet Forum",
+	"AutoHotBox",
+	"Liling FullRiver Electronics & Technology Ltd",
+	"NetView Technologies(Shenzhen) Co., Ltd",
+	"Himax Technologies, Inc",
+	"ITF Fröschl GmbH",
+	"Point Core SARL",
+	"TLab West Systems AB",
+	"Stalmart Technology Limited",
+	"Shenzhen Junlan Electronic Ltd",
+	"Schneider Electric Canada Inc",
+	"Finsécur",
+	"Alphatronics BV",
+	"Taco Electronic Solutions, Inc.",
+	"Sichuan Bihong Broadcast &amp; Television New Technologies Co.,Ltd",
+	"KAONMEDIA",
+	"Anywave Communication Technologies, Inc.",
+	"LG Innotek",
+	"BitWise Controls",
+	"Sercomm Corporation",
+	"Rechnerbetriebsgruppe",
+	"Hyundai ESG",
+	"Rigado, LLC",
+	"EKE Building Technology Systems Ltd",
+	"TRILOBIT LTDA.",
+	"Pason Systems",
+	"WINFIRM TECHNOLOGY",
+	"QinetiQ North America",
+	"Polewall Norge AS",
+	"Azuray Technologies",
+	"Evantage Industries Corp",
+	"Surveillance Pro Corporation",
+	"EAGET Innovation and Technology Co., Ltd.",
+	"Beamex Oy Ab",
+	"INDUSTRIAS UNIDAS SA DE CV",
+	"Hughes Telematics, Inc.",
+	"Shanghai LISTEN TECH.LTD",
+	"Trans New Technology, Inc.",
+	"Alta Data Technologies",
+	"Optek Digital Technology company limited",
+	"ChengDu JiaFaAnTai Technology Co.,Ltd",
+	"BodyMedia, Inc.",
+	"Joview(Beijing) Technology Co. Ltd.",
+	"trivum technologies GmbH",
+	"Belden Hirschmann Industries (Suzhou) Ltd.",
+	"Aptos Technology",
+	"Visiontec da Amazônia Ltda.",
+	"eflow Inc. / Smart Device Planning and Development Division",
+	"Shenzhen Coship Electronics Co., Ltd",
+	"Sorter Sp. j. Konrad Grzeszczyk MichaA, Ziomek",
+	"Tallac Networks",
+	"SCA Schucker Gmbh & Co KG",
+	"PowerLayer Microsystems HongKong Limited",
+	"NOVA electronics, Inc.",
+	"Raylios Technology",
+	"Teseq AG",
+	"Honeywell Analytics",
+	"Creowave Oy",
+	"CTS Limited",
+	"Cydle Corp.",
+	"GPI KOREA INC.",
+	"shenzhen yunmao information technologies co., ltd",
+	"Shanghai DareGlobal Technologies Co., Ltd",
+	"ENELPS",
+	"SITCORP",
+	"A+V Link Technologies, Corp.",
+	"SmartOptics AS",
+	"IJ Electron CO.,Ltd.",
+	"HealthStream Taiwan Inc.",
+	"D. ORtiz Consulting, LLC",
+	"Boge Kompressoren Otto Boge GmbH & Co. KG",
+	"Xirka Dama Persada PT",
+	"FYLDE MICRO LTD",
+	"HUIZHOU MAORONG INTELLIGENT TECHNOLOGY CO.,LTD",
+	"Geminico co.,Ltd.",
+	"Tianjin Deviser Electronics Instrument Co., Ltd",
+	"Shenzhen Eycom Technology Co., Ltd",
+	"WhereWhen Corp",
+	"Shanghai Uniscope Technologies Co.,Ltd",
+	"Theobroma Systems GmbH",
+	"ABB n.v. Power Quality Products",
+	"Shenzhen Vsun communication technology Co.,ltd",
+	"IC Intracom",
+	"Definium Technologies",
+	"Applied Research Associates, Inc",
+	"Jaguar de Mexico, SA de CV",
+	"Fujian Start Computer Equipment Co.,Ltd",
+	"Resolution Audio",
+	"Beijing KEMACOM Technologies Co., Ltd.",
+	"DAIKYUNG VASCOM",
+	"Krauss Maffei Technologies GmbH",
+	"Sub10 Systems Ltd",
+	"Assembled Products Corporation",
+	"PT.Navicom Indonesia",
+	"AMPAK Technology Inc",
+	"China SSJ (Suzhou) Network Technology Inc.",
+	"SOL INDUSTRY PTE., LTD",
+	"INTERTECH",
+	"CHG Hospital Beds",
+	"Zhangzhou Keneng Electrical Equipment Co Ltd",
+	"Mantis Deposition",
+	"Starlight Marketing (H. K.) Ltd.",
+	"HON HAI-CCPBG  PRECISION IND.CO.,LTD.",
+	"SYSGRATION Ltd.",
+	"SBG Systems SAS",
+	"PeopleNet Communication",
+	"ConversDigital Co., Ltd.",
+	"EMW Co., Ltd.",
+	"Industrial Control Communications, Inc.",
+	"TOSHIBA MITSUBISHI-ELECTRIC INDUSTRIAL SYSTEMS CORPORATION",
+	"INNATECH SDN BHD",
+	"Sage Electronic Engineering LLC",
+	"Adafruit",
+	"Pep Digital Technology (Guangzhou) Co., Ltd",
+	"Emizon Networks Limited",
+	"Disruptive Ltd",
+	"Flyaudio corporation (China)",
+	"Anadem Information Inc.",
+	"Corintech Ltd.",
+	"ZHEJIANG BOXSAM ELECTRONIC CO.,LTD",
+	"NOXUS(BEIJING) TECHNOLOGY CO.,LTD",
+	"TE Connectivity Limerick",
+	"Linkpower Network System Inc Ltd.",
+	"LG Electronics Inc.",
+	"Skyworth Wireless Technology Ltd.",
+	"MCST ZAO",
+	"BLUE WAVE NETWORKING CO LTD",
+	"SVA TECHNOLOGIES CO.LTD",
+	"Edeltech Co.,Ltd.",
+	"Global Regency Ltd",
+	"PacketAccess",
+	"Virident Systems, Inc.",
+	"Shenzhen Bolutek Technology Co.,Ltd.",
+	"Chyi Lee industry Co., ltd.",
+	"Vuzix Corporation",
+	"UNITEC Co., Ltd.",
+	"Accutome",
+	"Foxconn(NanJing) Communication Co.,Ltd.",
+	"Cosesy ApS",
+	"Wistron InfoComm(ZhongShan)Corporation",
+	"OHSUNG ELECTRONICS CO.,LTD.",
+	"IDT Technology Limited",
+	"Marini Impianti Industriali s.r.l.",
+	"Grand Electronics (HK) Ltd",
+	"Ericsson - North America",
+	"OTEC(Shanghai)Technology Co.,Ltd.",
+	"Shenzhen Newabel Electronic Co., Ltd.",
+	"Beijing Winchannel Software Technology Co., Ltd",
+	"Tecmobile (International) Ltd.",
+	"Hytera Communications Corporation Limited",
+	"Tymphany HK Limited",
+	"Edata Elektronik San. ve Tic. A.Ş.",
+	"Accupix Inc.",
+	"TASCAN Service GmbH",
+	"Discovery Technology,LTD..",
+	"Continental Automotive Czech Republic s.r.o.",
+	"RWE Effizienz GmbH",
+	"Foshan Huadian Intelligent Communications Teachnologies Co.,Ltd",
+	"Kulite Semiconductor Products Inc",
+	"Nipro Diagnostics, Inc",
+	"EnvyLogic Co.,Ltd.",
+	"Hame  Technology Co.,  Limited",
+	"CHENGDU XUGUANG TECHNOLOGY CO, LTD",
+	"SwiftTest, Inc.",
+	"DIMEP Sistemas",
+	"Mesa Labs",
+	"ALT Systems Ltd",
+	"BoxCast",
+	"ENGICAM s.r.l.",
+	"Shenzhen My-power Technology Co.,Ltd",
+	"Zhengzhou VCOM Science and Technology Co.,Ltd",
+	"I.S.E. S.r.l.",
+	"Feitian Xunda(Beijing) Aeronautical Information Technology Co., Ltd.",
+	"NMR Corporation",
+	"FIREMAX INDÚSTRIA E COMÉRCIO DE PRODUTOS ELETRÔNICOS  LTDA",
+	"Aerohive Networks Inc",
+	"VTC Electronics Corp.",
+	"Calibre UK Ltd",
+	"Omni-ID USA, Inc.",
+	"Harman Consumer Group",
+	"Glodio Technolies Co.,Ltd Tianjin Branch",
+	"Octonion SA",
+	"QEES ApS.",
+	"Wildix srl",
+	"NADASNV",
+	"Suntec Software(Shanghai) Co., Ltd.",
+	"NEOLAB Convergence",
+	"SYSCABLE Korea Inc.",
+	"Phoenix Geophysics Ltd.",
+	"Simac Techniek NV",
+	"The Warehouse Limited",
+	"INTEG process group inc",
+	"Xerox Corporation",
+	"SmartDoor Systems, LLC",
+	"Guangzhou Sunrise Electronics Development Co., Ltd",
+	"Starkey Labs Inc.",
+	"SCLE SFE",
+	"Nike, Inc.",
+	"SAKURA Internet Inc.",
+	"Osorno Enterprises Inc.",
+	"Obihai Technology, Inc.",
+	"Ubiquitous Computing Technology Corporation",
+	"PROCENTEC",
+	"Rivet Networks",
+	"Creatcomm Technology Inc.",
+	"Shen Zhen RND Electronic Co.,LTD",
+	"SkyDisk, Inc.",
+	"Biosoundlab Co., Ltd.",
+	"PrintCounts, LLC",
+	"Conductix-Wampfler GmbH",
+	"CHENG UEI PRECISION INDUSTRY CO.,LTD",
+	"NCTech Ltd",
+	"Junger Audio-Studiotechnik GmbH",
+	"JULONG CO,.LTD.",
+	"Winduskorea co., Ltd",
+	"BizLink (Kunshan) Co.,Ltd",
+	"UTC Fire and Security",
+	"Ricardo Prague, s.r.o.",
+	"shenzhen eyunmei technology co,.ltd",
+	"AREVA NP GmbH",
+	"MESOMATIC GmbH & Co.KG",
+	"OTSL Inc.",
+	"Robert Bosch Healthcare GmbH",
+	"NEXPA System",
+	"Advanced Technical Support, Inc.",
+	"Wieson Technologies Co., Ltd.",
+	"SKTB SKiT",
+	"TABUCHI ELECTRIC CO.,LTD",
+	"HiTi Digital, Inc.",
+	"PARROT SA",
+	"Triteka LTD",
+	"Shenzhen Yidashi Electronics Co Ltd",
+	"Bertel S.p.a.",
+	"NIMAX TELECOM CO.,LTD.",
+	"Samsung Electronics Digital Imaging",
+	"TeleComp R&D Corp.",
+	"United Integrated Services Co., Led.",
+	"Comprehensive Power",
+	"Ettus Research LLC",
+	"PSS Belgium N.V.",
+	"Inspire Tech",
+	"Actioncable, Inc.",
+	"SAMWONFA Co.,Ltd.",
+	"NL Ministry of Defense",
+	"Tyan Computer Corp",
+	"Helixtech Corp.",
+	"V.D.S. Video Display Systems srl",
+	"Grand Products Nevada, Inc.",
+	"ENVINET GmbH",
+	"TMCT Co., LTD.",
+	"DIRECTV, Inc.",
+	"MELPER Co., Ltd.",
+	"VNPT TECHNOLOGY",
+	"Sicon s.r.l.",
+	"Wellav Technologies Ltd",
+	"Intelcan Technosystems Inc.",
+	"Nanotek Elektronik Sistemler Ltd. Sti.",
+	"Cashmaster International Limited",
+	"Rancore Technologies Private Limited",
+	"Vialis BV",
+	"Pantech Co., Ltd.",
+	"P.T.I Co.,LTD",
+	"Chengdu Fuhuaxin Technology co.,Ltd",
+	"SAEHAN HITEC Co., Ltd",
+	"HuiZhou KaiYue Electronic Co.,Ltd",
+	"Gerhard D. Wempe KG",
+	"Xtreme Technologies Corp",
+	"VEEDIMS,LLC",
+	"OpenVox Communication Co Ltd",
+	"Shandong Intelligent Optical Communication Development Co., Ltd.",
+	"Time Domain",
+	"Total Aviation Solutions Pty Ltd",
+	"DLI Taiwan Branch office",
+	"GPMS",
+	"Supercomputing Systems AG",
+	"Polytron Vertrieb GmbH",
+	"EraThink Technologies Corp.",
+	"JMR Electronics, Inc",
+	"ShenZhen Cando Electronics Co.,Ltd",
+	"GENERAL DYNAMICS C4 SYSEMS",
+	"HongKong THTF Co., Ltd",
+	"Acutvista Innovation Co., Ltd.",
+	"Skytap",
+	"Pixon Imaging",
+	"S.C. ADD-PRODUCTION S.R.L.",
+	"CORESYS",
+	"Costar Video Systems",
+	"Triton Electronic Systems Ltd.",
+	"ShenZhen ANYK Technology Co.,LTD",
+	"CE LINK LIMITED",
+	"AXPRO Technology Inc.",
+	"Nanjing Glarun Atten Technology Co. Ltd.",
+	"Becker-Antriebe GmbH",
+	"PolarLink Technologies, Ltd",
+	"JSC \"Irbis-T\"",
+	"AVTrace Ltd.(China)",
+	"Amicus SK, s.r.o.",
+	"DAT System Co.,Ltd",
+	"Stratec Biomedical AG",
+	"enimai Inc",
+	"Ningbo FreeWings Technologies Co.,Ltd",
+	"AirCUVE Inc.",
+	"Seine Image Int'l Co., Ltd",
+	"GE Medical System(China) Co., Ltd.",
+	"Telegrafia a.s.",
+	"AVAT Automation GmbH",
+	"ABIsystems Co., LTD",
+	"STA Infinity LLP",
+	"Alfred Kaercher GmbH &amp; Co KG",
+	"Carry Technology Co.,Ltd",
+	"Luxul",
+	"Wei Fang Goertek Electronics Co.,Ltd",
+	"Fastec Imaging Corporation",
+	"Open Networking Laboratory",
+	"FlatFrog Laboratories AB",
+	"Cambrionix Ltd",
+	"Shenzhen YOUHUA Technology Co., Ltd",
+	"bluesky",
+	"Masterwork Automodules",
+	"Fibrlink Communications Co.,Ltd.",
+	"Plastic Logic",
+	"Vers Inc",
+	"GUANGDONG OPPO MOBILE TELECOMMUNICATIONS CORP.,LTD",
+	"EOC Technology",
+	"AmTRAN Video Corporation",
+	"ST Electronics(Shanghai) Co.,Ltd",
+	"Sun Cupid Technology (HK) LTD",
+	"Adaptive Wireless Solutions, LLC",
+	"busware.de",
+	"fenglian Technology Co.,Ltd.",
+	"MCOT Corporation",
+	"smart-electronic GmbH",
+	"LTi REEnergy GmbH",
+	"DFT System Co.Ltd",
+	"KLINFO Corp",
+	"VIBICOM COMMUNICATIONS INC.",
+	"ULTIMEDIA Co Ltd,",
+	"ChargeStorm AB",
+	"Cobham plc",
+	"Q Electronics Ltd",
+	"ARK INFOSOLUTIONS PVT LTD",
+	"Lenovo",
+	"Vizio, Inc",
+	"CHINA GREATWALL COMPUTER SHENZHEN CO.,LTD",
+	"Hitachi Industrial Equipment Systems Co.,Ltd",
+	"FuJian Elite Power Tech CO.,LTD.",
+	"Burroughs Payment Systems, Inc.",
+	"Ketra, Inc.",
+	"AutoCrib, Inc.",
+	"Lyve Minds, Inc",
+	"Shanghai Rui Rui Communication Technology Co.Ltd.",
+	"Ericsson AB (EAB)",
+	"Innotube, Inc.",
+	"Bluebank Communication Technology Co.Ltd",
+	"Ragsdale Technology",
+	"Vitec Group, Camera Dynamics Ltd",
+	"Maestro Wireless Solutions ltd.",
+	"Arantia 2010 S.L.",
+	"H. ZANDER GmbH & Co. KG",
+	"Adaxys Solutions AG",
+	"JSC SDO Chromatec",
+	"PENTA Gesellschaft für elektronische Industriedatenverarbeitung mbH",
+	"Parking BOXX Inc.",
+	"Lime Instruments",
+	"EutroVision System, Inc.",
+	"ShenZhen Hitom Communication Technology Co..LTD",
+	"Hangzhou LEAD-IT Information & Technology Co.,Ltd",
+	"Erwin Peters Systemtechnik GmbH",
+	"Shenzhen Skyee Optical Fiber Communication Technology Ltd.",
+	"ECOtality North America",
+	"GLITEL Stropkov, s.r.o.",
+	"Gimbal, Inc",
+	"Bionics Corp.",
+	"Kingspan Environmental Ltd",
+	"Total Walther GmbH",
+	"FILIZOLA S.A. PESAGEM E AUTOMACAO",
+	"Silicon & Software Systems Ltd.",
+	"DENY FONTAINE",
+	"BlackBerry Limited",
+	"Connex GmbH",
+	"SISTEMAS AUDIOVISUALES ITELSIS S.L.",
+	"Honest Technology Co., Ltd",
+	"Telewave Co., Ltd.",
+	"Open Source Robotics Foundation, Inc.",
+	"CHOFU SEISAKUSHO CO.,LTD",
+	"LAN Accessories Co., Ltd.",
+	"Hangzhou Dunchong Technology Co.Ltd",
+	"Security Expert Ltd.",
+	"IMAGO Technologies GmbH",
+	"Elektronik System i Umeå AB",
+	"XTS CORP",
+	"Foxtel Management Pty Ltd",
+	"KRYPTON POLSKA",
+	"ZAO NPO Introtest",
+	"Precision Optical Transceivers, Inc.",
+	"Shina System Co., Ltd",
+	"Digicom Futuristic Technologies Ltd.",
+	"Dragino Technology Co., Limited",
+	"Firich Enterprises CO., LTD.",
+	"Lisantech Co., Ltd.",
+	"Pocketnet Technology Inc.",
+	"Shenzhen Dehoo Technology Co.,Ltd",
+	"Audivo GmbH",
+	"Cloudview Limited",
+	"JIWUMEDIA CO., LTD.",
+	"DISPLAIRE CORPORATION",
+	"nimbus 9, Inc",
+	"DONGGUAN YISHANG ELECTRONIC TECHNOLOGY CO., LIMITED",
+	"UniComm Inc.",
+	"PHOENIX CONTACT Electronics GmbH",
+	"FreeTek International Co., Ltd.",
+	"Aventura Technologies, Inc.",
+	"Zonoff",
+	"ShenZhen MovingComm Technology Co., Limited",
+	"BMEYE B.V.",
+	"CIBN Oriental Network(Beijing) CO.,Ltd",
+	"Broadband Antenna Tracking Systems",
+	"ARC Wireless LLC",
+	"MicroMade Galka i Drozdz sp.j.",
+	"SunDroid Global limited.",
+	"Beijing Yuecheng Technology Co. Ltd.",
+	"JIANGXI JINGGANGSHAN CKING COMMUNICATION TECHNOLOGY CO.,LTD",
+	"Aker Subsea Ltd",
+	"Shinbo Co., Ltd.",
+	"aizo ag",
+	"inMotion Ltd.",
+	"LEONI",
+	"ESSYS",
+	"Honey Bee (Hong Kong) Limited",
+	"TM-Research Inc.",
+	"EAST BEST CO., LTD.",
+	"Saab AB (publ)",
+	"CVC",
+	"Systech Electronics Ltd.",
+	"Lightware Visual Engineering",
+	"Wachendorff Elektronik  GmbH & Co. KG",
+	"USA 111 Inc",
+	"Bayer HealthCare",
+	"Wyconn",
+	"Moimstone Co.,Ltd",
+	"Tritonwave",
+	"SHEN ZHEN SHI JIN HUA TAI ELECTRONICS CO.,LTD",
+	"Fujian Newland Communication Science Technologies Co.,Ltd.",
+	"Eltex Enterprise Ltd.",
+	"WiseSec L.t.d",
+	"Consolidated Resource Imaging",
+	"Uriel Technologies SIA",
+	"HI Solutions, Inc.",
+	"RW Tecnologia Industria e Comercio Ltda",
+	"Comsis",
+	"Senselogix Ltd",
+	"Labor S.r.L.",
+	"Ekon GmbH - myGEKKO",
+	"Suzhou HOTEK  Video Technology Co. Ltd",
+	"ATAW  Co., Ltd.",
+	"wi-daq, inc.",
+	"Fibar Group sp. z o.o.",
+	"SUNGROW POWER SUPPLY CO.,LTD.",
+	"DMATEK Co., Ltd.",
+	"TXTR GmbH",
+	"Humannix Co.,Ltd.",
+	"Shenzhen TG-NET Botone Technology Co.,Ltd.",
+	"Shanhai GBCOM Communication Technology Co. Ltd",
+	"Roku",
+	"Nilan A/S",
+	"Instorescreen Aisa",
+	"HANGZHOU ZHIWAY TECHNOLOGIES CO.,LTD.",
+	"TAIYO YUDEN CO.,LTD",
+	"C&T Solution Inc.",
+	"Eclipse Electronic Systems Inc.",
+	"Revolabs Inc",
+	"Genelec",
+	"Hisense Broadband Multimedia Technology Co.,Ltd.",
+	"SVS-VISTEK GmbH",
+	"Pi-Coral Inc",
+	"MPI TECH",
+	"Cambridge Communication Systems Ltd",
+	"IEEE P1823 Standards Working Group",
+	"LAVA INTERNATIONAL(H.K) LIMITED",
+	"Human Assembler, Inc.",
+	"Pace Americas",
+	"Utillink",
+	"Drivven, Inc.",
+	"Jabil Circuit (Wuxi) Co. LTD",
+	"Electrocompaniet A.S.",
+	"Jenny Science AG",
+	"Enspert Inc",
+	"TATUNG Technology Inc.",
+	"Valueplus Inc.",
+	"Lexking Technology Co., Ltd.",
+	"iConnectivity",
+	"Hexatronic AB",
+	"Shenzhen Furtunetel Communication Co., Ltd",
+	"ImmediaTV Corporation",
+	"Open Mesh, Inc.",
+	"Create New Technology (HK) Limited Company",
+	"ROGER D.Wensker, G.Wensker sp.j.",
+	"Smartrove Inc",
+	"Envision Peripherals Inc",
+	"Lantiq Deutschland GmbH",
+	"Smak Tecnologia e Automacao",
+	"Baycity Technologies Ltd",
+	"Peerless AV",
+	"TrekStor GmbH",
+	"Audioengine, Ltd.",
+	"Lyngso Marine A/S",
+	"AthenTek Inc.",
+	"Uniband Electronic Corp,",
+	"IMAC CO.,LTD",
+	"Ufine Technologies Co.,Ltd.",
+	"Visualedge Technology Co., Ltd.",
+	"CLT INT'L IND. CORP.",
+	"Graphite Systems",
+	"Kohzu Precision Co., Ltd.",
+	"VITSMO CO., LTD.",
+	"Ness Corporation",
+	"Telldus Technologies AB",
+	"ODA Technologies",
+	"Virtual Electric Inc",
+	"Midokura Co., Ltd.",
+	"Hefcom Metering (Pty) Ltd",
+	"Axis Communications AB",
+	"HWA YAO TECHNOLOGIES CO., LTD",
+	"Hi-flying electronics technology Co.,Ltd",
+	"Crexendo Business Solutions, Inc.",
+	"ABB SPA, ABB SACE DIV.",
+	"Shaanxi Guolian Digital TV Technology Co., Ltd.",
+	"tci GmbH",
+	"Shenzhen Geniatech Inc, Ltd",
+	"ISAAC Instruments",
+	"MadgeTech, Inc",
+	"SK hynix",
+	"Shenzhen Baojia Battery Technology Co., Ltd.",
+	"Bytemark Computer Consulting Ltd",
+	"IoT Tech Limited",
+	"Hay Systems Ltd",
+	"GENIX INFOCOMM CO., LTD.",
+	"6harmonics Inc",
+	"Becker Electronics Taiwan Ltd.",
+	"XIAOMI CORPORATION",
+	"ELESYS INC.",
+	"Weifang GoerTek Electronics Co., Ltd.",
+	"Vital Connect, Inc.",
+	"Avizia",
+	"Dynamics Hong Kong Limited",
+	"Futaba-Kikaku",
+	"LIGHTSPEED INTERNATIONAL CO.",
+	"EDISON GLOBAL CIRCUITS LLC",
+	"Ontrol A.S.",
+	"Elim Co",
+	"Progeny Systems",
+	"Siliconware Precision Industries Co., Ltd.",
+	"Nanjing CAS-ZDC IOT SYSTEM CO.,LTD",
+	"ITTIM Technologies",
+	"NuLEDs, Inc.",
+	"mira fitness,LLC.",
+	"YACOUB Automation GmbH",
+	"MitraStar Technology Corp.",
+	"OMRON HEALTHCARE Co., Ltd.",
+	"Fresenius Medical Care Deutschland GmbH",
+	"SHENZHEN BASICOM ELECTRONIC CO.,LTD.",
+	"Holl technology CO.Ltd.",
+	"Vallox Oy",
+	"Broadcast Microwave Services, Inc",
+	"THERMO FISHER SCIENTIFIC S.P.A.",
+	"Ericsson-LG Enterprise",
+	"Shanghai Railway Communication Factory",
+	"Hangzhou OYE Technology Co. Ltd",
+	"DEI Sales, Inc.",
+	"3ality Digital Systems GmbH",
+	"QA Cafe",
+	"Cummings Engineering",
+	"Revolv Inc",
+	"Dipl.-Ing. H. Horstmann GmbH",
+	"Laser Light Engines",
+	"I-sys Corp",
+	"Chloride S.r.L",
+	"Strata Worldwide",
+	"URadio Systems Co., Ltd",
+	"Fulan Electronics Limited",
+	"Taleo",
+	"ISis ImageStream Internet Solutions, Inc",
+	"Lanbowan Technology Ltd.",
+	"E-Fuel Corporation",
+	"LG CNS",
+	"STEMMER IMAGING GmbH",
+	"GNH Software India Private Limited",
+	"Pivotal Systems Corporation",
+	"Qingdao Haier Electronics Co.,Ltd",
+	"Ensemble Designs, Inc.",
+	"Roku, Inc.",
+	"Slican Sp. z o.o.",
+	"Nanjing Nengrui Auto Equipment CO.,Ltd",
+	"Echostreams Innovative Solutions",
+	"ZAKLAD ELEKTRONICZNY SIMS",
+	"WIZITDONGDO",
+	"Jiangsu Cynray IOT Co., Ltd.",
+	"People Power Company",
+	"Beijing Symtech CO.,LTD",
+	"Zhejiang shenghui lighting co.,Ltd",
+	"MI-Zone Technology Ireland",
+	"Vello Systems, Inc.",
+	"Logipix Ltd",
+	"CERA ELECTRONIQUE",
+	"CAT SYSTEM CO.,LTD.",
+	"NRG SYSTEMS INC",
+	"Advanced Micro Peripherals",
+	"GMX SAS",
+	"Dhemax Ingenieros Ltda",
+	"CableWorld Ltd.",
+	"GCI Science & Technology Co.,LTD",
+	"Smartchip Integrated Inc.",
+	"SP Best Corporation Co., LTD.",
+	"TC Communications",
+	"DEXON Systems Ltd.",
+	"Smartisan Technology Co., Ltd.",
+	"Brusa Elektronik AG",
+	"HERAN",
+	"LG-Ericsson Co.,Ltd.",
+	"DTI Group Ltd",
+	"Internet Laboratories, Inc.",
+	"Beijing GuangXin Technology Co., Ltd",
+	"Dog Hunter LLC",
+	"Codetek Technology Co.,Ltd",
+	"E-Prime Co., Ltd.",
+	"ORBIT MERRET, spol. s r. o.",
+	"Zhejiang Weirong Electronic Co., Ltd",
+	"Direct Payment Solutions Limited",
+	"Aviwest",
+	"MATSUNICHI DIGITAL TECHNOLOGY (HONG KONG) LIMITED",
+	"Fujian Tian Cheng Electron Science & Technical Development Co.,Ltd.",
+	"Zhejiang Pearmain Electronics Co.ltd.",
+	"Consert, Inc.",
+	"Pen Generations, Inc.",
+	"Degreane Horizon",
+	"Viableware, Inc",
+	"Broadlink Pty Ltd",
+	"NR ELECTRIC CO., LTD",
+	"NB Software",
+	"Borea",
+	"CRemote, LLC",
+	"Thing-talk Wireless Communication Technologies Corporation Limited",
+	"Lumigon A/S",
+	"Exablox",
+	"raytest GmbH",
+	"Zealabs srl",
+	"Qingdao GoerTek Technology Co., Ltd.",
+	"Dalian Seasky Automation Co;Ltd",
+	"Hangzhou Treebear Networking Co., Ltd.",
+	"askey computer corp",
+	"Shenzhen Guzidi Technology Co.,Ltd",
+	"Foresight Manufacture (S) Pte Ltd",
+	"Research Products Corporation",
+	"AKG Acoustics GmbH",
+	"Relay2, Inc.",
+	"Amptown System Company GmbH",
+	"Coster T.E. S.P.A.",
+	"WeTelecom Co., Ltd.",
+	"Axion Technologies Inc.",
+	"Imagik Int'l Corp",
+	"SHENZHEN TECHNOLOGY CO LTD",
+	"Zen Eye Co.,Ltd",
+	"MODI GmbH",
+	"Shenzhen Concox Information Technology Co., Ltd",
+	"Histar Digital Electronics Co., Ltd.",
+	"GHIA Technology (Shenzhen) LTD",
+	"Ensequence, Inc.",
+	"MtM Technology Corporation",
+	"Circle Reliance, Inc DBA Cranberry Networks",
+	"DAEHO I&T",
+	"Hubbell Power Systems, Inc.",
+	"Minsung Electronics",
+	"Texa Spa",
+	"Thuh Company",
+	"VXL eTech Pvt Ltd",
+	"UMPI Elettronica",
+	"PROSYST",
+	"Shenzhen Jiuzhou Electric Co.,LTD",
+	"BetterBots",
+	"iota Computing, Inc.",
+	"ControlThings Oy Ab",
+	"Chromlech",
+	"Litemax Electronics Inc.",
+	"Fusion-io, Inc",
+	"Pie Digital, Inc.",
+	"Wohler Technologies",
+	"PETATEL INC.",
+	"SEMA Electronics(HK) CO.,LTD",
+	"Centro Sicurezza Italia SpA",
+	"Bayan Audio",
+	"ROPEX Industrie-Elektronik GmbH",
+	"Keen High Holding(HK) Ltd.",
+	"ORIENTAL MOTOR CO., LTD.",
+	"Nesys",
+	"Guangzhou Horizontal Information & Network Integration Co. Ltd",
+	"Magneti Marelli Slovakia s.r.o.",
+	"SWEDA INFORMATICA LTDA",
+	"ANOV France",
+	"Furukawa Industrial S.A. Produtos Elétricos",
+	"Raspberry Pi Foundation",
+	"Parker Hannifin Manufacturing (UK) Ltd",
+	"Blaster Tech",
+	"EFR Europäische Funk-Rundsteuerung GmbH",
+	"Honeywell HomMed",
+	"Road-Track Telematics Development",
+	"Videoswitch",
+	"Kyokko Tsushin System CO.,LTD",
+	"Worldplay (Canada) Inc.",
+	"Shenzhen Cultraview Digital Technology Co.,Ltd Shanghai Branch",
+	"Roku, Inc",
+	"ASP AG",
+	"Vlatacom",
+	"SanJet Technology Corp.",
+	"Zioncom Electronics (Shenzhen) Ltd.",
+	"ITT LLC",
+	"NUMERA, INC.",
+	"Ouya, Inc",
+	"Handaer Communication Technology (Beijing) Co., Ltd",
+	"Onnet Technologies and Innovations LLC",
+	"Accton Wireless Broadband(AWB), Corp.",
+	"ROBOTIS, Co, Ltd",
+	"CK Telecom Ltd",
+	"Bolymin, Inc.",
+	"Viessmann Elektronik GmbH",
+	"Convergence Technologies",
+	"PayPal, Inc.",
+	"Decagon Devices, Inc.",
+	"Secure Meters (UK) Limited",
+	"Siemens Ltd.",
+	"Good Mind Industries Co., Ltd.",
+	"Step Ahead Innovations Inc.",
+	"Chengdu InnoThings Technology Co., Ltd.",
+	"ILJIN ELECTRIC Co., Ltd.",
+	"Infinite Technologies JLT",
+	"Stateless Networks",
+	"Analytica GmbH",
+	"BG T&A",
+	"Retail Innovation HTT AB",
+	"AllDSP GmbH & Co. KG",
+	"BIOSTAR Microtech Int'l Corp.",
+	"Atlona Inc.",
+	"Gionee Communication Equipment Co,Ltd.ShenZhen",
+	"7signal Solutions, Inc",
+	"Cohere Technologies",
+	"ELITE OPTOELECTRONIC(ASIA)CO.,LTD",
+	"OceanServer Technology, Inc",
+	"ABB Power Systems Power Generation",
+	"BenRui Technology Co.,Ltd",
+	"Logic S.p.A.",
+	"BLUECOM",
+	"BT&COM CO.,LTD",
+	"DEREK (SHAOGUAN) LIMITED",
+	"2GIG Technologies",
+	"Shenzhen iBaby Labs, Inc.",
+	"Xi'an Jizhong Digital Communication Co.,Ltd",
+	"Cynove",
+	"ENERES Co.,Ltd.",
+	"TrendPoint Systems",
+	"Dragon Path Technologies Co., Limited",
+	"Henan Chengshi NetWork Technology Co.，Ltd",
+	"PRIMMCON INDUSTRIES INC",
+	"Shanghai GBCOM Communication Technology Co.,Ltd.",
+	"Penetek, Inc",
+	"Maxeler Technologies Ltd.",
+	"GUANGZHOU HKUST FOK YING TUNG RESEARCH INSTITUTE",
+	"M Seven System Ltd.",
+	"Strahlenschutz- Entwicklungs- und Ausruestungsgesellschaft mbH",
+	"Advanced Photonics, Inc.",
+	"IAI Corporation",
+	"SpotCam Co., Ltd.",
+	"Payter BV",
+	"9Solutions Oy",
+	"YWire Technologies, Inc.",
+	"SPS, INC.",
+	"iSun Smasher Communications Private Limited",
+	"Herrmann Ultraschalltechnik GmbH & Co. Kg",
+	"WayTools, LLC",
+	"Aryaka Networks Inc",
+	"Changshu Gaoshida Optoelectronic Technology Co. Ltd.",
+	"Zhejiang ROICX Microelectronics",
+	"Shanghai Typrotech Technology Co.Ltd",
+	"Stewart Audio",
+	"FORTUNE TECHGROUP CO.,LTD",
+	"Beijing  WisVideo  INC.",
+	"ITON Technology Limited",
+	"Taiwan Jantek Electronics,Ltd.",
+	"YF Technology Co., Ltd",
+	"Inspur (Shandong) Electronic Information Co., Ltd",
+	"3D Display Technologies Co., Ltd.",
+	"HONG KONG TECON TECHNOLOGY",
+	"NextBIT Computing Pvt. Ltd.",
+	"Rowley Associates Limited",
+	"Beijing Haier IC Design Co.,Ltd",
+	"Revogi Innovation Co., Ltd.",
+	"Bear Flag Design, Inc.",
+	"ThinGlobal LLC",
+	"Hydro Systems Company",
+	"Pandachip Limited",
+	"CSUN System Technology Co.,LTD",
+	"Accordance Systems Inc.",
+	"Codaco Electronic s.r.o.",
+	"Hang Zhou Huite Technology Co.,ltd.",
+	"SensingTek",
+	"CORE STAFF CO., LTD.",
+	"ZhongMiao Technology Co., Ltd.",
+	"Swann Communications Pty Ltd",
+	"Drogoo Technology Co., Ltd.",
+	"ELECOM CO.,LTD.",
+	"Telenet Systems P. Ltd.",
+	"Environics Oy",
+	"tdvine",
+	"Henge Docks LLC",
+	"Green Energy Options Ltd",
+	"XTrillion, Inc.",
+	"HangZhou JuRu Technology CO.,LTD",
+	"Rackspace US, Inc.",
+	"SBM Co., Ltd.",
+	"Radio Data Comms",
+	"Ingate Systems",
+	"BASIC Co.,Ltd.",
+	"VILLBAU Ltd.",
+	"NPP ELIKS America Inc. DBA T&M Atlantic",
+	"FonSee Technology Inc.",
+	"Beijing Huafei Technology Co., Ltd.",
+	"DASCOM Europe GmbH",
+	"Nabto",
+	"Cyber-Rain, Inc.",
+	"HONGKONG RAGENTEK COMMUNICATION TECHNOLOGY CO.,LIMITED",
+	"Cybera, Inc.",
+	"AREC Inc.",
+	"Kellendonk Elektronik GmbH",
+	"SKS Welding Systems GmbH",
+	"DinBox Sverige AB",
+	"Thomson Video Networks",
+	"Panasonic System Networks Co., Ltd.",
+	"SPECTRA EMBEDDED SYSTEMS",
+	"VOISMART",
+	"d2d technologies",
+	"ASR Co,.Ltd.",
+	"Eoslink",
+	"WATERWORLD Technology Co.,LTD",
+	"Quanzhou  TDX Electronics Co., Ltd",
+	"CityCom GmbH",
+	"Fujian Beifeng Telecom Technology Co., Ltd.",
+	"SHENZHEN CHUANGWEI-RGB ELECTRONICS CO.,LTD",
+	"Geomodeling Wuxi Technology Co. Ltd.",
+	"Xiamen DNAKE Technology Co.,Ltd",
+	"Altronic, LLC",
+	"TOPCON CORPORATION",
+	"Additech, Inc.",
+	"Fort-Telecom ltd.",
+	"Alpha Security Products",
+	"Pixavi AS",
+	"Nexxt Solutions",
+	"Beijing National Railway Research & Design Institute  of Signal & Communication Co., Ltd.",
+	"Audyssey Laboratories Inc.",
+	"XySystem",
+	"iNES. applied informatics GmbH",
+	"Shen Zhen Horn audio Co., Ltd.",
+	"Gigastone Corporation",
+	"A&R TECH",
+	"Velocytech Aps",
+	"Prosoft Systems LTD",
+	"Minicom Digital Signage",
+	"Shanghai Mochui Network Technology Co., Ltd",
+	"Biscotti, Inc.",
+	"Elitegroup Computer Systems Co., LTD",
+	"Epec Oy",
+	"Shenzhen Sinkna Electronics Co., LTD",
+	"MAITRISE TECHNOLOGIQUE",
+	"SERELEC",
+	"Pico Systems Co., Ltd.",
+	"V. Stonkaus firma \"Kodinis Raktas\"",
+	"SHENZHEN HUAXUN ARK TECHNOLOGIES CO.,LTD",
+	"Dobbs Stanford",
+	"MagneMotion, Inc.",
+	"SHENZHEN XDK COMMUNICATION EQUIPMENT CO.,LTD",
+	"Effigis GeoSolutions",
+	"SnD Tech Co., Ltd.",
+	"S I Sistemas Inteligentes Eletrônicos Ltda",
+	"Patriot Memory",
+	"Acer Inc.",
+	"University of Michigan",
+	"XIAMEN MEITU MOBILE TECHNOLOGY CO.LTD",
+	"COBY",
+	"Topicon HK Lmd.",
+	"FAIRFIELD INDUSTRIES",
+	"Multi Touch Oy",
+	"Eden Innovations",
+	"Abbott Point of Care",
+	"3D Systems Massachusetts",
+	"EarthCam, Inc.",
+	"OSASI Technos Inc.",
+	"Comigo Ltd.",
+	"Yoshiki Electronics Industry Ltd.",
+	"Beijing Xiaoyuer Technology Co. Ltd.",
+	"BitBox Ltd",
+	"ZPA Smart Energy a.s.",
+	"Automatic Systems",
+	"SHANGHAI LYNUC CNC TECHNOLOGY CO.,LTD",
+	"MITSUYA LABORATORIES INC.",
+	"Creative Electronics & Software, Inc.",
+	"Askey Computer Corp.",
+	"Hangzhou Sunyard Technology Co., Ltd.",
+	"Qingdao Eastsoft Communication Technology Co.,LTD",
+	"Conrad Electronic SE",
+	"DENX Computer Systems GmbH",
+	"Laird Technologies",
+	"OnePlus Tech (Shenzhen) Ltd",
+	"Pacidal Corporation Ltd.",
+	"Powercode",
+	"GME Standard Communications P/L",
+	"Lipi Data Systems Ltd.",
+	"MaxMedia Technology Limited",
+	"SeekTech INC",
+	"PRESITION (2000) CO., LTD.",
+	"Shenzhen UTEPO Tech Co., Ltd.",
+	"ACK Networks,Inc.",
+	"Hermes electronic GmbH",
+	"Prysm Inc",
+	"Dominion Voting Systems Corporation",
+	"Qualisys AB",
+	"HMI Sources Ltd.",
+	"WhizNets Inc.",
+	"Galvanic Applied Sciences Inc",
+	"Airo Wireless",
+	"KLEMSAN ELEKTRIK ELEKTRONIK SAN.VE TIC.AS.",
+	"Rusteletech Ltd.",
+	"TAGATEC CO.,LTD",
+	"Siconix Inc.",
+	"CYBELEC SA",
+	"SAMBON PRECISON and ELECTRONICS",
+	"Shanghai Yali Electron Co.,LTD",
+	"Satcom Direct, Inc.",
+	"FIREFLIES SYSTEMS",
+	"Omniprint Inc.",
+	"Wallys Communications  Teachnologies Co.,Ltd.",
+	"BlueN",
+	"Shenzhen Shiningworth Technology Co., Ltd.",
+	"Cadac Holdings Ltd",
+	"Bach-Simpson",
+	"Galleon Embedded Computing",
+	"Lava International Ltd.",
+	"Shenzhen TATFOOK Technology Co., Ltd.",
+	"Fugoo Coorporation",
+	"HDMI Forum",
+	"Everex Electronics Limited",
+	"ZPT Vigantice",
+	"U-Raku, Inc.",
+	"Libratone A/S",
+	"Turbulence Design Inc.",
+	"Xiaomi Technology,Inc.",
+	"myIDkey",
+	"DataGravity",
+	"Beijing JoinHope Image Technology Ltd.",
+	"NAVIS Inc.",
+	"A.N. Solutions GmbH",
+	"Inventek Systems",
+	"Fujian Newland Auto-ID Tech. Co,.Ltd.",
+	"Changzhou Uchip Electronics Co., LTD.",
+	"Shenzhen Sanland Electronic Co., ltd.",
+	"KEISOKUKI CENTER CO.,LTD.",
+	"8Devices",
+	"100fio networks technology llc",
+	"Speedytel technology",
+	"SHENZHEN WEIJIU INDUSTRY AND TRADE DEVELOPMENT CO., LTD",
+	"Minieum Networks, Inc",
+	"LG Electronics (Mobile Communicaitons)",
+	"SUMMIT DEVELOPMENT, spol.s r.o.",
+	"MEDIAEDGE Corporation",
+	"General Electric Digital Energy",
+	"I+ME Actia Informatik und Mikro-Elektronik GmbH",
+	"Beijing Winicssec Technologies Co., Ltd.",
+	"SKF GmbH",
+	"Texas Instruments.",
+	"MIDORI ELECTRONIC CO., LTD.",
+	"National Oilwell Varco Instrumentation, Monitoring, and Optimization (NOV IMO)",
+	"Beijing HuaqinWorld Technology Co.,Ltd",
+	"Energy Imports Ltd",
+	"Gugaoo   HK Limited",
+	"Beijing Boomsense Technology CO.,LTD.",
+	"JiangSu Joyque Information Industry Co.,Ltd",
+	"Tercel technology co.,ltd",
+	"NOBLEX SA",
+	"U2S co.",
+	"SCSpro Co.,Ltd",
+	"AB Sciex",
+	"RRCN SAS",
+	"VSS Monitoring",
+	"Oclaro, Inc.",
+	"Spica international",
+	"DishTV NZ Ltd",
+	"ITW GSE ApS",
+	"Beijing Newmine Technology",
+	"TDSi",
+	"AVM Audiovisuelles Marketing und Computersysteme GmbH",
+	"Le Shi Zhi Xin Electronic Technology (Tianjin)  Co.,Ltd",
+	"OmniLync Inc.",
+	"DLOGIC GmbH",
+	"Innova Security",
+	"ADV Security (S) Pte Ltd",
+	"Storagedata",
+	"Barun Electronics",
+	"Halfa Enterprise Co., Ltd.",
+	"eZEX corporation",
+	"Hunting Innova",
+	"Tenda Technology Co., Ltd.",
+	"JRI-Maxant",
+	"Pioneer DJ Coroporation",
+	"KUNBUS GmbH",
+	"IMK Networks Co.,Ltd",
+	"Shanghai Enlogic Electric Technology Co., Ltd.",
+	"Wyler AG",
+	"MEDISON Xray Co., Ltd",
+	"Intermas France",
+	"Sunflex Europe GmbH",
+	"Display Systems Ltd",
+	"Optcom Co., Ltd.",
+	"Aplicom Oy",
+	"Valink Co., Ltd.",
+	"Shenzhen Kingtech Communication Equipment Co.,Ltd",
+	"Sunrise Technologies",
+	"Beautiful Enterprise Co., Ltd",
+	"Net Optics",
+	"Dongguan HuaHong Electronics Co.,Ltd",
+	"Nolangroup S.P.A con Socio Unico",
+	"Pakton Technologies",
+	"MXCHIP Company Limited",
+	"Embedded Automation, Inc.",
+	"Jiangsu Datang  Electronic Products Co., Ltd",
+	"SHENZHEN COMMUNICATION TECHNOLOGIES CO.,LTD",
+	"VDII Innovation AB",
+	"Shenzhen Longway Technologies Co., Ltd",
+	"Neul Ltd",
+	"Nebula, Inc",
+	"Verizon Business",
+	"SYStronics Co., Ltd.",
+	"Shanghai TYD Elecronic Technology Co. Ltd",
+	"marco Systemanalyse und Entwicklung GmbH",
+	"Embrane",
+	"ZPM Industria e Comercio Ltda",
+	"RuggedTek Hangzhou Co., Ltd",
+	"Shenzhen Primestone Network Technologies.Co., Ltd.",
+	"Zero1.tv GmbH",
+	"Shanghai Tigercel Communication Technology Co.,Ltd",
+	"AGAiT Technology Corporation",
+	"Jetlun (Shenzhen) Corporation",
+	"Muehlbauer AG",
+	"FLIGHT DATA SYSTEMS",
+	"Cisco Consumer Products, LLC",
+	"Qingdao Haier Telecom Co.，Ltd",
+	"Integra Networks, Inc.",
+	"Milkyway Group Ltd",
+	"Vertu Corporation Limited",
+	"Technical Research Design and Development",
+	"TANGTOP TECHNOLOGY CO.,LTD",
+	"Pishion International Co. Ltd",
+	"Shenzhen SHX Technology Co., Ltd",
+	"Beijing Gefei Tech. Co., Ltd",
+	"Yamato Scale Co.,Ltd.",
+	"Shenzhen Xiaoniao Technology Co.,Ltd",
+	"S.E.TECHNOLOGIES LIMITED",
+	"Building Block Video",
+	"Seneca s.r.l.",
+	"NewSharp Technology(SuZhou)Co,Ltd",
+	"Bejing DAYO Mobile Communication Technology Ltd.",
+	"Dyson Limited",
+	"BETTINI SRL",
+	"G-WAY Microwave",
+	"Select Comfort",
+	"IMAQLIQ LTD",
+	"Miljovakt AS",
+	"Beijing Nan Bao Technology Co., Ltd.",
+	"Yichun MyEnergy Domain, Inc",
+	"Manzanita Systems, Inc.",
+	"PT Inovação e Sistemas SA",
+	"Metrological Group BV",
+	"InnoDigital Co., Ltd.",
+	"Verifi, LLC",
+	"Micro-Biz intelligence solutions Co.,Ltd",
+	"VAIO Corporation",
+	"GEWISS S.P.A.",
+	"Edgecore Networks Corportation",
+	"Shiningtek",
+	"SAMSUNG ELECTRO MECHANICS CO., LTD.",
+	"SA.S.S. Datentechnik AG",
+	"Intesis Software SL",
+	"Trump s.a.",
+	"Intercon Systems Co., Ltd.",
+	"Fourtec -Fourier Technologies",
+	"Hellberg Safety AB",
+	"KVH Industries, Inc.",
+	"Ocom Communications, Inc.",
+	"OnTime Networks AS",
+	"TOUMAZ LTD",
+	"Weightech Com. Imp. Exp. Equip. Pesagem Ltda",
+	"Information  System Research Institute,Inc.",
+	"Topwise 3G Communication Co., Ltd.",
+	"Empower RF Systems",
+	"Global Traffic Technologies, LLC",
+	"Minetec Wireless Technologies",
+	"Sound Masking Inc.",
+	"TJK Tietolaite Oy",
+	"Viscount Systems Inc.",
+	"Filmetrics Inc.",
+	"SEETECH",
+	"CMAX Wireless Co., Ltd.",
+	"SHENZHEN MDK DIGITAL TECHNOLOGY CO.,LTD",
+	"Hansong Tehnologies",
+	"TE Connectivity Touch Solutions",
+	"Pfeiffer Vacuum GmbH",
+	"VIZIO, Inc",
+	"LVS Co.,Ltd.",
+	"Transbit Sp. z o.o.",
+	"DZG Metering GmbH",
+	"Guangdong Guanglian Electronic Technology Co.Ltd",
+	"Shenzhen Sowell Technology Co., LTD",
+	"AIFA TECHNOLOGY CORP.",
+	"FUJITSU ISOTEC LIMITED",
+	"Fraunhofer ITWM",
+	"NECMagnusCommunications",
+	"AnB Securite s.a.",
+	"EAGLE KINGDOM TECHNOLOGIES LIMITED",
+	"Steinel GmbH",
+	"Ultimaker B.V.",
+	"OptiLogix BV",
+	"Applied Technical Systems",
+	"SHENZHEN DAJIAHAO TECHNOLOGY CO.,LTD",
+	"Tri-Systems Corporation",
+	"CIAS Elettronica srl",
+	"Sun Fountainhead USA. Corp",
+	"SM-Electronic GmbH",
+	"Janteq Corp",
+	"Shenzhen Bopengfa Elec&Technology CO.,Ltd",
+	"Aiconn Technology Corporation",
+	"SCR Engineers Ltd.",
+	"My Social Stuff",
+	"American Magnetics, Inc.",
+	"SOYEA Technology Co.,Ltd.",
+	"DCONWORKS  Co., Ltd",
+	"VAHLE DETO GmbH",
+	"Chi Mei Communication Systems, Inc",
+	"EUKREA ELECTROMATIQUE SARL",
+	"3isysnetworks",
+	"Ayecka Communication Systems LTD",
+	"Lumewave",
+	"Xi'an HISU Multimedia Technology Co.,Ltd.",
+	"RIZ TRANSMITTERS",
+	"Wireless Technology, Inc.",
+	"Yokogawa Digital Computer Corporation",
+	"Porsche Cars North America",
+	"TAICANG T AND W ELECTRONICS CO LTD",
+	"BIOS Corporation",
+	"Sunrex Technology Corp",
+	"UniPrint",
+	"Beijing Ctimes Digital Technology Co., Ltd.",
+	"littleBits Electronics, Inc.",
+	"Ingenic Semiconductor Co.,Ltd",
+	"Southwest Research Institute",
+	"DATTUS Inc",
+	"SINTRONES Technology Corp.",
+	"Physical Graph Corporation",
+	"Liteon Technology Co., Ltd.",
+	"Werma Signaltechnik GmbH & Co. KG",
+	"Active Control Technology Inc.",
+	"CeraMicro Technology Corporation",
+	"I-BT DIGITAL CO.,LTD",
+	"Shenzhen Pulier Tech CO.,Ltd",
+	"Sartura d.o.o.",
+	"Hitachi Data Systems",
+	"China Essence Technology (Zhumadian) Co., Ltd.",
+	"Meiko Maschinenbau GmbH &amp; Co. KG",
+	"SolidRun Ltd.",
+	"LUMINEX Lighting Control Equipment",
+	"Verto Medical Solutions, LLC",
+	"BSE CO.,LTD.",
+	"TOPWELL INTERNATIONAL HOLDINGS LIMITED",
+	"Mini-Circuits",
+	"DONG OH PRECISION CO., LTD.",
+	"LIFI LABS MANAGEMENT PTY LTD",
+	"Reno A&E",
+	"Forward Pay Systems, Inc.",
+	"XTel ApS",
+	"Sagemcom",
+	"APCON, Inc.",
+	"Skullcandy",
+	"Passif Semiconductor",
+	"UPWIS AB",
+	"Stonestreet One LLC",
+	"Emtronix",
+	"Foster Electric Company, Limited",
+	"LINKCOM",
+	"Chengdu TD Tech Ltd.",
+	"Neuberger Gebäudeautomation GmbH",
+	"Linktop Technology Co., LTD",
+	"SHENZHEN TINNO MOBILE TECHNOLOGY CO.,LTD.",
+	"Robert Bosch LLC Automotive Electronics",
+	"Bestcare Cloucal Corp.",
+	"SEPRO ROBOTIQUE",
+	"SHL Telemedicine International Ltd.",
+	"DS International",
+	"CNSLink Co., Ltd.",
+	"Actions Microelectronics Co., Ltd",
+	"Tamagawa Seiki Co.,Ltd.",
+	"Scientech Electronics",
+	"Energy Micro AS",
+	"K2NET Co.,Ltd.",
+	"Beckman Coulter K.K.",
+	"Mios, Ltd.",
+	"MVTECH co., Ltd",
+	"Wintop",
+	"Genie Networks Limited",
+	"Yoga",
+	"Wearable Inc.",
+	"Zhehua technology limited",
+	"Seowoo Inc.",
+	"SteadyServ Technoligies, LLC",
+	"Helmut Mauell GmbH",
+	"Qihoo  360  Technology Co.,Ltd",
+	"Phoenix Broadband Technologies, LLC.",
+	"MC Technologies GmbH",
+	"Delphian Systems LLC",
+	"Solid Semecs bv.",
+	"Applied Micro Electronics AME bv",
+	"iNFORM Systems AG",
+	"Huanshun Network Science and Technology Co., Ltd.",
+	"ShotSpotter, Inc.",
+	"Anobit Technologies Ltd.",
+	"Quadrant Components Inc. Ltd",
+	"Asia Pacific Brands",
+	"RCF S.P.A.",
+	"TOHO Electronics INC.",
+	"JAI Oy",
+	"Infopia Co., Ltd",
+	"ioBridge, Inc.",
+	"Zimory GmbH",
+	"Sky Light Digital Limited",
+	"Akenori PTE Ltd",
+	"Sinwatec",
+	"Fike Corporation",
+	"IGRS Engineering Lab Ltd.",
+	"DONGGUAN ipt INDUSTRIAL CO., LTD",
+	"Carma Industries Inc.",
+	"Micro-Star Int'l Co, Ltd",
+	"Changzhou Haojie Electric Co., Ltd.",
+	"TAIYO YUDEN CO., LTD.",
+	"Vuppalamritha Magnetic Components LTD",
+	"Shenzhen YOOBAO Technology Co.Ltd",
+	"Informtekhnika & Communication, LLC",
+	"Kemper Digital GmbH",
+	"CEIVA Logic, Inc",
+	"TangoWiFi.com",
+	"IBT Ingenieurbureau Broennimann Thun",
+	"nSTREAMS Technologies, Inc.",
+	"VIGO System S.A.",
+	"Fiber Mountain Inc.",
+	"Galleon Systems",
+	"Pro Concept Manufacturer Co.,Ltd.",
+	"CHENGDU USEE DIGITAL TECHNOLOGY CO., LTD",
+	"Riedo Networks GmbH",
+	"SAHAB TECHNOLOGY",
+	"Neoventus Design Group",
+	"Shenzhen Sundray Technologies Company Limited",
+	"Snap AV",
+	"Goodrich ISR",
+	"CSM GmbH",
+	"WAXESS USA Inc",
+	"Cameronet GmbH & Co. KG",
+	"NEO Monitors AS",
+	"HARTING Electronics GmbH",
+	"iDevices, LLC",
+	"Argosy Technologies, Ltd.",
+	"Meld Technology, Inc",
+	"Sogecam Industrial, S.A.",
+	"Electroacustica General Iberica, S.A.",
+	"Fidelix Oy",
+	"COSMO CO., LTD",
+	"Clover Network, Inc.",
+	"SUNGJIN C&T CO.,LTD",
+	"JayBird Gear LLC",
+	"University of FUKUI",
+	"Wuhan Zhongyuan Huadian Science & Technology Co.,",
+	"SMAX Technology Co., Ltd.",
+	"InView Technology Corporation",
+	"GreenWave Reality Inc",
+	"MICRO WORLD",
+	"BODi rS, LLC",
+	"Messcomp Datentechnik GmbH",
+	"SE Controls Asia Pacific Ltd",
+	"UPVEL",
+	"Acentic GmbH",
+	"u-blox AG",
+	"Enatel LTD",
+	"Shenzhen Sen5 Technology Co., Ltd.",
+	"Power Ethernet",
+	"Southwest Microwave, Inc",
+	"Shanghai Kaixiang Info Tech LTD",
+	"Korea CNO Tech Co., Ltd",
+	"GoPro",
+	"Alpinion Medical Systems",
+	"ValueHD Corporation",
+	"S. Siedle & Sohne",
+	"Harley-Davidson Motor Company",
+	"LinkedHope Intelligent Technologies Co., Ltd",
+	"HIWIFI Co., Ltd.",
+	"Navetas Energy Management",
+	"Napco Security Technologies",
+	"IPROAD.,Inc",
+	"IEA S.R.L.",
+	"Skyviia Corporation",
+	"Honeywell Fire System (Shanghai) Co,. Ltd.",
+	"Arcadia Networks Co. Ltd.",
+	"Cercacor Labs",
+	"C.G.V. S.A.S.",
+	"FXI TECHNOLOGIES AS",
+	"Nippon Electro-Sensory Devices",
+	"Conti Temic Microelectronic GmbH",
+	"Telesquare",
+	"TWINLINX CORPORATION",
+	"Compacta International, Ltd.",
+	"B&W Group Ltd",
+	"Guangdong Coagent Electronics S &T Co., Ltd.",
+	"MaxTronic International Co., Ltd.",
+	"General Electric Consumer and Industrial",
+	"Ascent Communication Technology",
+	"Best Wish Technology LTD",
+	"Leitner SpA",
+	"Shenzhen G.Credit Communication Technology Co., Ltd",
+	"Tricascade Inc.",
+	"Office FA.com Co.,Ltd.",
+	"Silicon Valley Global Marketing",
+	"OI ELECTRIC CO.,LTD",
+	"Cognitas Technologies, Inc.",
+	"CZ.NIC, z.s.p.o.",
+	"CAx soft GmbH",
+	"Busch-Jaeger Elektro GmbH",
+	"Objetivos y Sevicios de Valor Añadido",
+	"Eno Inc.",
+	"Toy's Myth Inc.",
+	"Shenzhen Daystar Technology Co.,ltd",
+	"BOXIN COMMUNICATION CO.,LTD.",
+	"Steinsvik",
+	"Escort, Inc.",
+	"KUHN SA",
+	"SANIX INCORPORATED",
+	"x.o.ware, inc.",
+	"AHN INC.",
+	"UNIT-EM",
+	"General Electric Global Research",
+	"C2 Development, Inc.",
+	"Grey Innovation",
+	"Beijing SHENQI Technology Co., Ltd.",
+	"eMegatech International Corp.",
+	"Sonavation, Inc.",
+	"Itibia Technologies",
+	"Hangzhou Bigbright Integrated communications system Co.,Ltd",
+	"Panasonic Appliances Company",
+	"Guangzhou Zonerich Business Machine Co., Ltd",
+	"Jinan USR IOT Technology Co., Ltd.",
+	"Comtrend Corporation",
+	"NetworkAccountant, Inc.",
+	"Blu Tether Limited",
+	"Nantworks",
+	"Triple Domain Vision Co.,Ltd.",
+	"Victory Concept Electronics Limited",
+	"Netgenetech.co.,ltd.",
+	"DETRACOM",
+	"Hichan Technology Corp.",
+	"EA DISPLAY LIMITED",
+	"Micro-Star INTL CO., LTD.",
+	"JEMA ENERGY, SA",
+	"Rainforest Automation, Inc.",
+	"GSK CNC EQUIPMENT CO.,LTD",
+	"APATOR S.A.",
+	"Kunshan Erlab ductless filtration system Co.,Ltd",
+	"BALMUDA Inc.",
+	"ISUNG CO.,LTD",
+	"beroNet GmbH",
+	"CIRTEC MEDICAL SYSTEMS",
+	"Wush, Inc",
+	"KEOPSYS",
+	"TRENDnet, Inc.",
+	"Moog Protokraft",
+	"Nokia",
+	"Zeebo Inc",
+	"Libre Wireless Technologies Inc.",
+	"AXACORE",
+	"IDFone Co., Ltd.",
+	"Meditech Kft",
+	"National Products Inc.",
+	"SIEMENS ENERGY AUTOMATION",
+	"Nabtesco  Corporation",
+	"HangZhou QiYang Technology Co.,Ltd.",
+	"Ge Ruili Intelligent Technology ( Beijing ) Co., Ltd.",
+	"Medtronic Diabetes",
+	"Hitachi High-Technologies Corporation",
+	"Captivate Network",
+	"U & B tech",
+	"Microstep-MIS spol. s r.o.",
+	"Accensus LLC",
+	"ASD Electronics Ltd",
+	"Shanghai Longjing Technology Co.",
+	"InfoBLOCK S.A. de C.V.",
+	"Zera GmbH",
+	"Iton Technology Limited",
+	"HCT. Co., Ltd.",
+	"Step forward Group Co., Ltd.",
+	"Heyrex Limited",
+	"TechSAT GmbH",
+	"Hunan HKT Electronic Technology Co., Ltd",
+	"Skyport Systems",
+	"Manufacturing System Insights, Inc.",
+	"Ticom Geomatics, Inc.",
+	"Solberg & Andersen AS",
+	"CASCO SIGNAL LTD",
+	"SHINYEI TECHNOLOGY CO., LTD.",
+	"Compal Broadband Networks, Inc.",
+	"Shenzhen Bococom Technology Co.,LTD",
+	"Power-One",
+	"Paterson Technology",
+	"Teledyne DALSA Professional Imaging",
+	"C.R.S. iiMotion GmbH",
+	"Apacer Technology Inc.",
+	"Livescribe, Inc.",
+	"Bay Storage Technology",
+	"JANUS, spol. s r.o.",
+	"Azurewave Technologies., inc.",
+	"Intercom, Inc.",
+	"Sapphire Technology Limited.",
+	"RBcloudtech",
+	"Beijing Lanbo Technology Co., Ltd.",
+	"Compressor Controls Corp",
+	"New Spin Golf, LLC.",
+	"MACANDC",
+	"GreenPriz",
+	"CELOXICA Ltd",
+	"Burkert Werke GmbH",
+	"Microsoft XCG",
+	"HUIZHOU QIAOXING TELECOMMUNICATION INDUSTRY CO.,LTD.",
+	"Shenzhen Kaiboer Technology Co., Ltd.",
+	"SOLiD Technologies, Inc.",
+	"Systembase Limited",
+	"BUHEUNG SYSTEM",
+	"Explora Technologies Inc",
+	"FE GLOBAL HONG KONG LIMITED",
+	"Shenzhen JSR Technology Co.,Ltd.",
+	"Beijing Rongcheng Hutong Technology Co., Ltd.",
+	"Bentek Systems Ltd.",
+	"HUMAX co.,tld",
+	"Sunny Heart Limited",
+	"Shenzhen JoinCyber Telecom Equipment Ltd",
+	"GETCK TECHNOLOGY,  INC",
+	"Tonfunk Systementwicklung und Service GmbH",
+	"Akyllor",
+	"Patrol Tag, Inc",
+	"Shenzhen Wintop Photoelectric Technology Co., Ltd",
+	"Lumens Digital Optics Inc.",
+	"Experimental Factory of Scientific Engineering and Special Design Department",
+	"AUG Elektronik GmbH",
+	"Heimi Network Technology Co., Ltd.",
+	"Letta Teknoloji",
+	"SITRONIK",
+	"Lorent Networks, Inc.",
+	"STRONG Ges.m.b.H.",
+	"ShenZhen Continental Wireless Technology Co., Ltd.",
+	"Inovonics",
+	"Modoosis Inc.",
+	"Bravo Tech, Inc.",
+	"Cambridge Industries(Group) Co.,Ltd",
+	"Anite Telecoms  US. Inc",
+	"Xslent Energy Technologies. LLC",
+	"Titan Pet Products",
+	"Intrigue Technologies, Inc.",
+	"TTC Next-generation Home Network System WG",
+	"Valve Corporation",
+	"SZ Telstar CO., LTD",
+	"Challenger Supply Holdings, LLC",
+	"Stage One International Co., Ltd.",
+	"Plexxi, Inc.",
+	"SHENZHEN JIAXINJIE ELECTRON CO.,LTD",
+	"Cavanagh Group International",
+	"data-complex GmbH",
+	"Hi-P Electronics Pte Ltd",
+	"Cisco Meraki",
+	"Emergent Vision Technologies Inc.",
+	"AxesNetwork Solutions inc.",
+	"Laerdal Medical",
+	"Innovid, Co., Ltd.",
+	"Detlef Fink Elektronik & Softwareentwicklung",
+	"HANGZHOU ZENOINTEL TECHNOLOGY CO., LTD",
+	"Jinan Jovision Science & Technology Co., Ltd.",
+	"DigiView S.r.l.",
+	"C-Data Technology Co., Ltd",
+	"ALPS ERECTORIC CO.,LTD.",
+	"Whistle Labs, Inc.",
+	"TECHBOARD SRL",
+	"EVIDENCE Network SIA",
+	"GreenBytes, Inc.",
+	"Nata-Info Ltd.",
+	"Exponent",
+	"REPOTEC CO., LTD.",
+	"ORTHOsoft inc, d/b/a Zimmer CAS",
+	"Atomax Inc.",
+	"NOJA Power Switchgear Pty Ltd",
+	"Pevco",
+	"GENERAL VISION ELECTRONICS CO. LTD.",
+	"Orb Networks, Inc.",
+	"Bender GmbH &amp; Co.KG",
+	"LOENK",
+	"C2 Microsystems, Inc.",
+	"Aisai Communication Technology Co., Ltd.",
+	"MilDef AB",
+	"SHENZHEN TW-SCIE Co., Ltd",
+	"Jireh Energy Tech., Ltd.",
+	"Shenzhen Unistar Communication Co.,LTD",
+	"Gemintek Corporation",
+	"Katoudenkikougyousyo co ltd",
+	"Aliph dba Jawbone",
+	"EQUES Technology Co., Limited",
+	"Hippih aps",
+	"JVC KENWOOD Corporation",
+	"Open Standard Digital-IF Interface for SATCOM Systems",
+	"Siemens Electrical Apparatus Ltd., Suzhou Chengdu Branch",
+	"SNB TECHNOLOGIES LIMITED",
+	"Olive Telecommunication Pvt. Ltd",
+	"vastriver Technology Co., Ltd",
+	"Shenzhen Friendcom Technology Development Co., Ltd",
+	"Panasonic Automotive Systems Company of America",
+	"Lintes Technology Co., Ltd.",
+	"Digitalwatt",
+	"Vaddio",
+	"CHENG UEI PRECISION INDUSTRY CO.,LTD.",
+	"Cloudena Corp.",
+	"Platan sp. z o.o. sp. k.",
+	"Softiron Inc.",
+	"TomTom Software Ltd",
+	"topsystem Systemhaus GmbH",
+	"V2 TECHNOLOGY, INC.",
+	"Mellanox Technologies, Inc.",
+	"SHENZHEN FUZHI SOFTWARE TECHNOLOGY CO.,LTD",
+	"Color-Chip",
+	"Smartlabs",
+	"Magneti Marelli S.p.A. Powertrain",
+	"Lilee Systems, Ltd.",
+	"Unicore communication Inc.",
+	"Hangzhou GoTo technology Co.Ltd",
+	"Sabre Technology (Hull) Ltd",
+	"HENRI DEPAEPE S.A.S.",
+	"Advantage Controls",
+	"Wuxi DSP Technologies Inc.",
+	"Ottec Technology GmbH",
+	"C&C TECHNIC TAIWAN CO., LTD.",
+	"Shenzhen Guo Wei Electronic Co,. Ltd.",
+	"Gardasoft VisionLimited",
+	"MA Lighting Technology GmbH",
+	"EDS Elektronik Destek San.Tic.Ltd.Sti",
+	"Dedicated Computing",
+	"Suttle Apparatus",
+	"Stuart Manufacturing, Inc.",
+	"Danish Interpretation Systems A/S",
+	"Dictum Health, Inc.",
+	"messMa GmbH",
+	"Securifi Ltd",
+	"Getinge Sterilization AB",
+	"AARTESYS AG",
+	"Minrray Industry Co.,Ltd",
+	"Beijing Hanbang Technology Corp.",
+	"Shenzhen ACT Industrial Co.,Ltd.",
+	"Geberit International AG",
+	"RF WINDOW CO., LTD.",
+	"Discovery Insure",
+	"Gridlink Tech. Co.,Ltd.",
+	"ALTOGRAPHICS Inc.",
+	"Shanghai VLC Technologies Ltd. Co.",
+	"Shanghai Artimen Technology Co., Ltd.",
+	"TRON LINK ELECTRONICS CO., LTD.",
+	"Cellco Partnership",
+	"UAB Selteka",
+	"SCL Elements",
+	"HES-SO",
+	"360 Fly Inc.",
+	"Objetivos y Servicios de Valor A",
+	"Airware",
+	"Mophie, LLC",
+	"Ceiec Electric Technology Inc.",
+	"Health & Life co., Ltd.",
+	"Oraya Therapeutics",
+	"En-Vision America, Inc.",
+	"LEIFHEIT AG",
+	"MR&D Manufacturing",
+	"Time-O-Matic, Inc.",
+	"Shanghai iComhome Co.,Ltd.",
+	"Datafox GmbH",
+	"Minxon Hotel Technology INC.",
+	"PAD Peripheral Advanced Design Inc.",
+	"EDMI Europe Ltd",
+	"ELECTRON INDIA",
+	"Throughtek Co., Ltd.",
+	"SHENZHEN BOOMTECH INDUSTRY CO.,LTD",
+	"Akib Systems Taiwan, INC",
+	"DAEYOUNG INFORMATION SYSTEM CO., LTD",
+	"Syncbak, Inc.",
+	"Really Simple Software, Inc",
+	"GuangZhou Bonsoninfo System CO.,LTD",
+	"IDEO Security Co., Ltd.",
+	"NIFTY Corporation",
+	"TMY Co., Ltd.",
+	"Cots Technology",
+	"Out of the Fog Research LLC",
+	"OCZ Technology Group",
+	"GEODESIC LTD.",
+	"QNAP Systems, Inc.",
+	"Bitdefender SRL",
+	"Advanced Automotive Antennas",
+	"EDUP INTERNATIONAL (HK) CO., LTD",
+	"TSMART Inc.",
+	"Yeonhab Precision Co.,LTD",
+	"NEO INFORMATION SYSTEMS CO., LTD.",
+	"LLC Emzior",
+	"Imaging Diagnostics",
+	"Luminate Wireless",
+	"Infratec Datentechnik GmbH",
+	"Dawning Information Industry Co.,Ltd",
+	"Black Diamond Advanced Technology, LLC",
+	"Melec Inc.",
+	"Datawise Systems",
+	"Supercomputers and Neurocomputers Research Center",
+	"Digit Mobile Inc",
+	"Control & Display Systems Ltd t/a CDSRail",
+	"Elsys Equipamentos Eletronicos Ltda",
+	"ADB BROADBAND ITALIA",
+	"FIRS Technologies(Shenzhen) Co., Ltd",
+	"BEOVIEW INTERCOM DOO",
+	"S5 Tech S.r.l.",
+	"GNTEK Electronics Co.,Ltd.",
+	"Loxley Public Company Limited",
+	"ZNYX Networks, Inc.",
+	"NSD Corporation",
+	"Arcontia International AB",
+	"Cogent Healthcare Systems Ltd",
+	"testo Instruments (Shenzhen) Co., Ltd.",
+	"PiiGAB, Processinformation i Goteborg AB",
+	"Fujian Landi Commercial Equipment Co.,Ltd",
+	"Signal Path International / Peachtree Audio",
+	"Deep Sea Electronics PLC",
+	"Shenzhen Reecam Tech.Ltd.",
+	"Shenzhen C&D Electronics Co.,Ltd",
+	"Sino Prime-Tech Limited",
+	"H-Displays (MSC) Bhd",
+	"Austco Communication Systems Pty Ltd",
+	"Micronet  LTD",
+	"SkyHawke Technologies, LLC.",
+	"MKS Instruments Deutschland GmbH",
+	"ULTIMATE Europe Transportation Equipment GmbH",
+	"Beijing BenyWave Technology Co., Ltd.",
+	"Zhuhai Tianrui Electrical Power Tech. Co., Ltd.",
+	"VideoHome Technology Corp.",
+	"PRF Co., Ltd.",
+	"GRAVOTECH MARKING SAS",
+	"Energotest",
+	"Warp9 Tech Design, Inc.",
+	"Shenzhen Kootion Technology Co., Ltd",
+	"iS5 Communications Inc.",
+	"StarTech.com",
+	"Denkovi Assembly Electroncs LTD",
+	"OPMEX Tech.",
+	"MILLSON CUSTOM SOLUTIONS INC.",
+	"Starcor Beijing Co.,Limited",
+	"RFTECH SRL",
+	"ELCOM Innovations Private Limited",
+	"TRINUS SYSTEMS INC.",
+	"ITECH INSTRUMENTS SAS",
+	"FloDesign Wind Turbine Corporation",
+	"Netonix",
+	"BioControl AS",
+	"Research Centre Module",
+	"VidaBox LLC",
+	"JiangSu NanJing University Electronic Information Technology Co.,Ltd",
+	"IntelliVoice Co.,Ltd.",
+	"Ypsomed AG",
+	"University of Tokyo",
+	"HITACHI-LG DATA STORAGE INC",
+	"NovelSat",
+	"SHEN ZHEN HENG SHENG HUI DIGITAL TECHNOLOGY CO.,LTD",
+	"MCNEX Co.,Ltd.",
+	"PERFORMANCE DESIGNED PRODUCTS, LLC",
+	"Institute 706, The Second Academy China Aerospace Science & Industry Corp",
+	"ADL Embedded Solutions, Inc.",
+	"AWCER Ltd.",
+	"TTK SAS",
+	"Meinberg Funkuhren GmbH & Co. KG",
+	"Redwire, LLC",
+	"Qihan Technology Co., Ltd",
+	"ZAO NPK RoTeK",
+	"Calix Inc.",
+	"Shanghai XiMei Electronic Technology Co. Ltd",
+	"Yulong Computer Telecommunication Scientific (Shenzhen) Co.,Ltd",
+	"MITSUBISHI HEAVY INDUSTRIES MECHATRONICS SYSTEMS,LTD.",
+	"AVI-ON LABS",
+	"Global411 Internet Services, LLC",
+	"ePBoard Design LLC",
+	"B&W Group LTD",
+	"Chengdu Volans Technology CO.,LTD",
+	"Shenzhen Baichuan Digital Technology Co., Ltd.",
+	"Justone Technologies Co., Ltd.",
+	"MEI",
+	"NovaSparks",
+	"RM Tech Co., Ltd.",
+	"DLX",
+	"Eddyfi NDT Inc",
+	"MEMMERT GmbH + Co. KG",
+	"2276427 Ontario Inc",
+	"Lufft Mess- und Regeltechnik GmbH",
+	"Beijing Risbo Network Technology Co.,Ltd",
+	"Kemppi Oy",
+	"ELITEGROUP COMPUTER SYSTEMS CO., LTD.",
+	"GUANGDONG GENIUS TECHNOLOGY CO.,LTD.",
+	"Acuro Networks, Inc",
+	"SHINANO E and E Co.Ltd.",
+	"CloudGenix Inc",
+	"GIROPTIC",
+	"Digivoice Tecnologia em Eletronica Ltda",
+	"FUSION Electronics Ltd",
+	"Accuenergy (CANADA) Inc",
+	"Allied Telesis, Inc.",
+	"MiraeRecognition Co., Ltd.",
+	"GEA Farm Technologies GmbH",
+	"Zhuhai Liming Industries Co., Ltd",
+	"RAMI",
+	"IRT SA",
+	"Lamprey Networks, Inc.",
+	"SKG Electric Group(Thailand) Co., Ltd.",
+	"tado GmbH",
+	"Hirschmann Automation",
+	"Omntec mfg. inc",
+	"SISTEMA SOLUCOES ELETRONICAS LTDA - EASYTECH",
+	"STI Ltd",
+	"Guang Zhou TRI-SUN Electronics Technology  Co., Ltd",
+	"DARFON LIGHTING CORP",
+	"ZTLX Network Technology Co.,Ltd",
+	"Abocom",
+	"NEOMONTANA ELECTRONICS",
+	"HD DIGITAL TECH CO., LTD.",
+	"The IMS Company",
+	"A. Eberle GmbH & Co. KG",
+	"BlueRadios, Inc.",
+	"Janz - Contadores de Energia, SA",
+	"Chrontel",
+	"SmarteBuilding",
+	"Shandong Bittel Electronics Co., Ltd",
+	"SHENZHEN FENDA TECHNOLOGY CO., LTD",
+	"KyungDong One Co., Ltd.",
+	"LG Chem",
+	"ORICO Technologies Co., Ltd",
+	"Cal-Comp Electronics & Communications Company Ltd.",
+	"Esan electronic co.",
+	"SHOWA DENKI CO.,LTD.",
+	"OPUS High Technology Corporation",
+	"Talaris (Sweden) AB",
+	"WAFA TECHNOLOGIES CO., LTD.",
+	"Dr. Sigrist AG",
+	"Creative Next Design",
+	"Waldo Networks, Inc.",
+	"Bi2-Vision",
+	"Huike Electronics (SHENZHEN) CO., LTD.",
+	"Bloombase, Inc.",
+	"Omega Elektronik AS",
+	"Actility",
+	"R L Drake",
+	"DVN(Shanghai)Ltd.",
+	"PYRAMID Computer GmbH",
+	"Scientific Production Association Siberian Arsenal, Ltd.",
+	"JTECH Communications, Inc.",
+	"CareView Communications",
+	"Dycon Limited",
+	"Duracell Powermat",
+	"Getriebebau NORD GmbH and Co. KG",
+	"Advantage Pharmacy Services, LLC",
+	"Shenzhen Egreat Tech Corp.,Ltd",
+	"Integrated Corporation",
+	"PEAK-System Technik",
+	"Sourcefire, Inc",
+	"Xcellen",
+	"Leopold Kostal GmbH &Co. KG",
+	"IRZ AUTOMATION TECHNOLOGIES LTD",
+	"JIANGSU HENGSION ELECTRONIC S and T CO.,LTD",
+	"COSTEL.,CO.LTD",
+	"VeloCloud Networks",
+	"NxtConect",
+	"Garland Technology",
+	"Shanghai Viroyal Electronic Technology Company Limited",
+	"RaonThink Inc.",
+	"GST Co., Ltd.",
+	"MITSUMI ELECTRIC CO.,LTD.",
+	"HBC-radiomatic",
+	"Globalscale Technologies, Inc.",
+	"Xi3 Corp",
+	"Poslab Technology Co., Ltd.",
+	"MaxID (Pty) Ltd",
+	"Sipod Inc.",
+	"Zhejiang FeiYue Digital Technology Co., Ltd",
+	"Mianyang Netop Telecom Equipment Co.,Ltd.",
+	"LeddarTech Inc.",
+	"LINEAR LLC",
+	"CobaltRay Co., Ltd",
+	"Sensometrix SA",
+	"ECHOSENS",
+	"Axema Passagekontroll AB",
+	"RLH INDUSTRIES,INC.",
+	"Yottabyte",
+	"Shanghai EDO Technologies Co.,Ltd.",
+	"ShangHai Y&Y Electronics Co., Ltd",
+	"Wistron InfoComm (Kunshan)Co",
+	"Drägerwerk AG & Co. KG aA",
+	"Essec",
+	"Bilkon Bilgisayar Kontrollu Cih. Im.Ltd.",
+	"VIPAR GmbH",
+	"Mobitec AB",
+	"Adaptrum Inc.",
+	"Whitesky Science & Technology Co.,Ltd.",
+	"Motion Analysis Corporation",
+	"Phorm",
+	"KEEBOX, Inc.",
+	"IES GmbH & Co. KG",
+	"Acurix Networks LP",
+	"Shanghai High-Flying Electronics Technology Co., Ltd",
+	"BeNeXt B.V.",
+	"Reduxio Systems",
+	"ValenceTech Limited",
+	"Hangzhou Bianfeng Networking Technology Co., Ltd.",
+	"WAVELINK",
+	"Shanghai Pateo Electronic Equipment Manufacturing Co., Ltd.",
+	"Simon-Kaloi Engineering",
+	"YAMABISHI Corporation",
+	"Cuciniale GmbH",
+	"MMPC Inc.",
+	"SPECTO PAINEIS ELETRONICOS LTDA",
+	"SENOR TECH CO LTD",
+	"Abilis Systems SARL",
+	"Shanghai Howell Electronic Co.,Ltd",
+	"FAG Industrial Services GmbH",
+	"Shenzhen Gongjin Electronics Co., Ltd",
+	"Benu Networks, Inc.",
+	"S & S Research Inc.",
+	"BND Co., Ltd.",
+	"Portable Innovation Technology Ltd.",
+	"Leading Public Performance Co., Ltd.",
+	"Nanjing Rousing Sci. and Tech. Industrial Co., Ltd",
+	"Amscreen Group Ltd",
+	"Actions Semiconductor Co.,Ltd.(Cayman Islands)",
+	"Telechips Inc",
+	"HENGBAO Corporation LTD.",
+	"Niceway CNC Technology Co.,Ltd.Hunan Province",
+	"Boxx TV Ltd",
+	"Matsufu Electronics distribution Company",
+	"DQ Technology Inc.",
+	"Panoptic Technology, Inc",
+	"Diffon Corporation",
+	"ShenZhen Topstar Technology Company",
+	"Adonit Corp. Ltd.",
+	"Conversion Sound Inc.",
+	"Viltechmeda UAB",
+	"Woojeon&Handan",
+	"SolidFire, Inc.",
+	"Infoblox",
+	"Tensorcom",
+	"Deciso B.V.",
+	"NexGen Storage",
+	"CountMax,  ltd",
+	"WEBER Schraubautomaten GmbH",
+	"EAGLE WORLD DEVELOPMENT CO., LIMITED",
+	"Hawa Technologies Inc",
+	"Lightning Telecommunications Technology Co. Ltd",
+	"WindowMaster A/S",
+	"Yeastar Technology Co., Ltd.",
+	"TerraSem Co.,Ltd",
+	"TIME INTERCONNECT LTD",
+	"Texas INstruments",
+	"Chengdu jinshi communication Co., LTD",
+	"Coagent International Enterprise Limited",
+	"blackned GmbH",
+	"WEY Elektronik AG",
+	"Vispiron Rotec GmbH",
+	"Yunnan Ideal Information&Technology.,Ltd",
+	"SEMOCON Co., Ltd",
+	"Beijing CCD Digital Technology Co., Ltd",
+	"Zhuhai Jiahe Communication Technology Co., limited",
+	"Delta Elektronika BV",
+	"Solar Power Technologies, Inc.",
+	"SAGEM",
+	"Nokia corporation",
+	"Dediprog Technology Co. Ltd.",
+	"ZOYI Company",
+	"Khomp",
+	"DRS Imaging and Targeting Solutions",
+	"Datang Telecom communication terminal (Tianjin) Co., Ltd.",
+	"ZyCast Technology Inc.",
+	"Wistron InfoComm(ZhongShan) Corporation",
+	"Natural Security SAS",
+	"Atopia Systems, LP",
+	"Telefonbau Behnke GmbH",
+	"Longdhua(Beijing) Controls Technology Co.,Ltd",
+	"Cypress Technology CO., LTD.",
+	"Yeelink",
+	"Jiangsu Switter Co., Ltd",
+	"RTW GmbH & Co. KG",
+	"eGauge Systems LLC",
+	"Alcatel-Lucent Telecom Limited",
+	"endeavour GmbH",
+	"Good Mind Innovation Co., Ltd.",
+	"Magenta Research Ltd.",
+	"Softlink Automation System Co., Ltd",
+	"Yasuda Joho Co.,ltd.",
+	"SYNTEC Incorporation",
+	"X2gen Digital Corp. Ltd",
+	"Hitachi, Ltd.",
+	"EUMTECH CO., LTD",
+	"Innometriks Inc",
+	"Verathon",
+	"Denwa Technology Corp.",
+	"VNL Europe AB",
+	"Core Brands, LLC",
+	"SB SYSTEMS Co.,Ltd",
+	"M-Cube Spa",
+	"IC Nexus Co. Ltd.",
+	"xn systems",
+	"Suzhou Chi-tek information technology Co., Ltd",
+	"Seibu Electric Co.,",
+	"Arcx Inc",
+	"The Goldman Sachs Group, Inc.",
+	"Neopis Co., Ltd.",
+	"Rosonix Technology, Inc.",
+	"FASTWEL INTERNATIONAL CO., LTD. Taiwan Branch",
+	"Amped Wireless",
+	"OVERKIZ",
+	"Yaojin Technology(Shenzhen)Co.,Ltd",
+	"KAISHUN ELECTRONIC TECHNOLOGY CO., LTD. BEIJING",
+	"Tenebraex",
+	"COMTREND CORPORATION",
+	"Google Fiber, Inc",
+	"GLP German Light Products GmbH",
+	"VOLANS",
+	"Proton Products Chengdu Ltd",
+	"DAESHIN-INFORMATION TECHNOLOGY CO., LTD.",
+	"Fortress Technology Inc",
+	"Control Technology Inc.",
+	"YAZAKI Energy System Corporation",
+	"Dinstar Technologies Co., Ltd.",
+	"RHEWA-WAAGENFABRIK August Freudewald GmbH &amp;Co. KG",
+	"PUISSANCE PLUS",
+	"Axview Technology (Shenzhen) Co.,Ltd",
+	"Deltenna Ltd",
+	"GUANGZHOU BOSMA TECHNOLOGY CO.,LTD",
+	"Guangzhou CHNAVS Digital Technology Co.,Ltd",
+	"Rosslare Enterprises Limited",
+	"Highgates Technology",
+	"NZXT Corp. Ltd.",
+	"Carefusion",
+	"AXAN Networks",
+	"Pumatronix Equipamentos Eletronicos Ltda.",
+	"Simm Tronic Limited",
+	"REV Ritter GmbH",
+	"EcoTech, Inc.",
+	"Beta LaserMike",
+	"Taishan Online Technology Co., Ltd.",
+	"PNY Technologies, INC.",
+	"Variscite LTD",
+	"µTech Tecnologia LTDA",
+	"Egker Kft.",
+	"Dipl.-Math. Michael Rauch",
+	"Newport Media Inc.",
+	"RackWare Inc.",
+	"Orion Networks International, Inc",
+	"G-Lab GmbH",
+	"Rawe Electonic GmbH",
+	"International Communications Corporation",
+	"SYN-TECH SYSTEMS INC",
+	"Santur Corporation",
+	"Reciprocal Labs Corp",
+	"Technico Japan Corporation",
+	"Shenzhen Communication Technology Co.,Ltd",
+	"VIEVU",
+	"FUNDACION TEKNIKER",
+	"Cortland Research, LLC",
+	"LRE Medical GmbH",
+	"Prentke Romich Company",
+	"ACTEON Group",
+	"GUANGDONG TONZE ELECTRIC CO.,LTD",
+	"Control Sistematizado S.A.",
+	"Logic3 plc",
+	"Global Apps Corp.",
+	"Taian Technology(Wuxi) Co.,Ltd.",
+	"InterCreative Co., Ltd",
+	"Cloud Vision Networks Technology Co.,Ltd.",
+	"V-ZUG AG",
+	"I Smart Cities HK Ltd",
+	"Autobase",
+	"IPEVO corp",
+	"EURECAM",
+	"Han Kyung I Net Co.,Ltd.",
+	"EosTek (Shenzhen) Co., Ltd.",
+	"TRANS ELECTRIC CO., LTD.",
+	"Connected Data, Inc.",
+	"Lorom Industrial Co.LTD.",
+	"CELOT Wireless Co., Ltd",
+	"Favite Inc.",
+	"Visteon corp",
+	"Henan Lanxin Technology Co., Ltd",
+	"Universal Audio, Inc",
+	"Swarco LEA d.o.o.",
+	"JIANGXI SHANSHUI OPTOELECTRONIC TECHNOLOGY CO.,LTD",
+	"INTERSENSOR S.R.L.",
+	"SIMEX Sp. z o.o.",
+	"Control iD",
+	"Shen Zhen Shi Xin Zhong Xin Technology Co.,Ltd.",
+	"Weibel Scientific A/S",
+	"MikroBits",
+	"Zhejiang Kangtai Electric Co., Ltd.",
+	"NEC Personal Products, Ltd",
+	"Beijing MDC Telecom",
+	"ALPS ERECTRIC CO.,LTD",
+	"Directed Perception, Inc",
+	"LXinstruments GmbH",
+	"BME CORPORATION",
+	"Hitachi High Technologies America, Inc.",
+	"FCI USA LLC",
+	"Trei technics",
+	"Intelligent Technology Inc.",
+	"UBIVELOX",
+	"Motus Global Inc.",
+	"Fidus Systems Inc",
+	"CONWIN.Tech. Ltd",
+	"PT. Callysta Multi Engineering",
+	"MIARTECH (SHANGHAI),INC.",
+	"QTS NETWORKS",
+	"Qulsar Inc",
+	"Socionext Inc.",
+	"Shenzhen Minicreate Technology Co.,Ltd",
+	"Ascon Ltd.",
+	"The Coca Cola Company",
+	"Messana Air.Ray Conditioning s.r.l.",
+	"Shenzhen SDMC Technology Co., Ltd.",
+	"Robert Bosch GmbH",
+	"Beijing Hesun Technologies Co.Ltd.",
+	"ENERVALLEY CO., LTD",
+	"G-Wearables Corp.",
+	"Shenzhen WeWins wireless Co.,Ltd",
+	"A3M Co., LTD",
+	"Sichuan Jinwangtong Electronic Science&Technology Co,.Ltd",
+	"Stable Imaging Solutions LLC",
+	"Array Networks",
+	"CLAY PAKY SPA",
+	"Hangzhou Lancable Technology Co.,Ltd",
+	"Arrayent",
+	"OPTEX-FA CO.,LTD.",
+	"TRONTEQ Electronic",
+	"Shanghai Baud Data Communication Co.,Ltd.",
+	"Hitachi Reftechno, Inc.",
+	"IEEE REGISTRATION AUTHORITY  - Please see MAL public listing for more information.",
+}
+
+var ouiBuiltin = [...]ouiEntry{
+	{0x000000000, 24, 0}, {0x000000001, 24, 0}, {0x000000002, 24, 0}, {0x000000003, 24, 0},
+	{0x000000004, 24, 0}, {0x000000005, 24, 0}, {0x000000006, 24, 0}, {0x000000007, 24, 0},
+	{0x000000008, 24, 0}, {0x000000009, 24, 0}, {0x00000000a, 24, 1}, {0x00000000b, 24, 2},
+	{0x00000000c, 24, 3}, {0x00000000d, 24, 4}, {0x00000000e, 24, 5}, {0x00000000f, 24, 6},
+	{0x000000010, 24, 7}, {0x000000011, 24, 8}, {0x000000012, 24, 9}, {0x000000013, 24, 10},
+	{0x000000014, 24, 11}, {0x000000015, 24, 12}, {0x000000016, 24, 13}, {0x000000017, 24, 14},
+	{0x000000018, 24, 15}, {0x000000019, 24, 16}, {0x00000001a, 24, 17}, {0x00000001b, 24, 18},
+	{0x00000001c, 24, 19}, {0x00000001d, 24, 20}, {0x00000001e, 24, 21}, {0x00000001f, 24, 22},
+	{0x000000020, 24, 23}, {0x000000021, 24, 24}, {0x000000022, 24, 25}, {0x000000023, 24, 26},
+	{0x000000024, 24, 27}, {0x000000025, 24, 28}, {0x000000026, 24, 29}, {0x000000027, 24, 30},
+	{0x000000028, 24, 31}, {0x000000029, 24, 32}, {0x00000002a, 24, 33}, {0x00000002b, 24, 34},
+	{0x00000002c, 24, 35}, {0x00000002d, 24, 36}, {0x00000002e, 24, 37}, {0x00000002f, 24, 38},
+	{0x000000030, 24, 39}, {0x000000031, 24, 40}, {0x000000032, 24, 41}, {0x000000033, 24, 42},
+	{0x000000034, 24, 43}, {0x000000035, 24, 44}, {0x000000036, 24, 45}, {0x000000037, 24, 46},
+	{0x000000038, 24, 47}, {0x000000039, 24, 48}, {0x00000003a, 24, 49}, {0x00000003b, 24, 50},
+	{0x00000003c, 24, 51}, {0x00000003d, 24, 52}, {0x00000003e, 24, 53}, {0x00000003f, 24, 54},
+	{0x000000040, 24, 55}, {0x000000041, 24, 56}, {0x000000042, 24, 57}, {0x000000043, 24, 58},
+	{0x000000044, 24, 59}, {0x000000045, 24, 60}, {0x000000046, 24, 61}, {0x000000047, 24, 62},
+	{0x000000048, 24, 63}, {0x000000049, 24, 64}, {0x00000004a, 24, 65}, {0x00000004b, 24, 66},
+	{0x00000004c, 24, 67}, {0x00000004d, 24, 68}, {0x00000004e, 24, 69}, {0x00000004f, 24, 70},
+	{0x000000050, 24, 71}, {0x000000051, 24, 72}, {0x000000052, 24, 73}, {0x000000053, 24, 74},
+	{0x000000054, 24, 75}, {0x000000055, 24, 76}, {0x000000056, 24, 77}, {0x000000057, 24, 78},
+	{0x000000058, 24, 79}, {0x000000059, 24, 80}, {0x00000005a, 24, 81}, {0x00000005b, 24, 82},
+	{0x00000005c, 24, 83}, {0x00000005d, 24, 84}, {0x00000005e, 24, 85}, {0x00000005f, 24, 86},
+	{0x000000060, 24, 87}, {0x000000061, 24, 88}, {0x000000062, 24, 89}, {0x000000063, 24, 90},
+	{0x000000064, 24, 91}, {0x000000065, 24, 92}, {0x000000066, 24, 93}, {0x000000067, 24, 94},
+	{0x000000068, 24, 95}, {0x000000069, 24, 96}, {0x00000006a, 24, 97}, {0x00000006b, 24, 98},
+	{0x00000006c, 24, 99}, {0x00000006d, 24, 100}, {0x00000006e, 24, 101}, {0x00000006f, 24, 102},
+	{0x000000070, 24, 103}, {0x000000071, 24, 104}, {0x000000072, 24, 105}, {0x000000073, 24, 106},
+	{0x000000074, 24, 107}, {0x000000075, 24, 108}, {0x000000076, 24, 109}, {0x000000077, 24, 110},
+	{0x000000078, 24, 111}, {0x000000079, 24, 112}, {0x00000007a, 24, 113}, {0x00000007b, 24, 114},
+	{0x00000007c, 24, 115}, {0x00000007d, 24, 116}, {0x00000007e, 24, 117}, {0x00000007f, 24, 118},
+	{0x000000080, 24, 119}, {0x000000081, 24, 120}, {0x000000082, 24, 121}, {0x000000083, 24, 122},
+	{0x000000084, 24, 123}, {0x000000085, 24, 124}, {0x000000086, 24, 125}, {0x000000087, 24, 126},
+	{0x000000088, 24, 127}, {0x000000089, 24, 128}, {0x00000008a, 24, 129}, {0x00000008b, 24, 130},
+	{0x00000008c, 24, 131}, {0x00000008d, 24, 132}, {0x00000008e, 24, 133}, {0x00000008f, 24, 134},
+	{0x000000090, 24, 135}, {0x000000091, 24, 136}, {0x000000092, 24, 137}, {0x000000093, 24, 138},
+	{0x000000094, 24, 139}, {0x000000095, 24, 140}, {0x000000096, 24, 141}, {0x000000097, 24, 142},
+	{0x000000098, 24, 143}, {0x000000099, 24, 144}, {0x00000009a, 24, 145}, {0x00000009b, 24, 146},
+	{0x00000009c, 24, 147}, {0x00000009d, 24, 148}, {0x00000009e, 24, 149}, {0x00000009f, 24, 150},
+	{0x0000000a0, 24, 151}, {0x0000000a1, 24, 152}, {0x0000000a2, 24, 120}, {0x0000000a3, 24, 153},
+	{0x0000000a4, 24, 154}, {0x0000000a5, 24, 155}, {0x0000000a6, 24, 156}, {0x0000000a7, 24, 157},
+	{0x0000000a8, 24, 158}, {0x0000000a9, 24, 159}, {0x0000000aa, 24, 0}, {0x0000000ab, 24, 160},
+	{0x0000000ac, 24, 161}, {0x0000000ad, 24, 162}, {0x0000000ae, 24, 163}, {0x0000000af, 24, 164},
+	{0x0000000b0, 24, 165}, {0x0000000b1, 24, 166}, {0x0000000b2, 24, 167}, {0x0000000b3, 24, 168},
+	{0x0000000b4, 24, 169}, {0x0000000b5, 24, 170}, {0x0000000b6, 24, 171}, {0x0000000b7, 24, 172},
+	{0x0000000b8, 24, 173}, {0x0000000b9, 24, 174}, {0x0000000ba, 24, 175}, {0x0000000bb, 24, 176},
+	{0x0000000bc, 24, 177}, {0x0000000bd, 24, 178}, {0x0000000be, 24, 179}, {0x0000000bf, 24, 180},
+	{0x0000000c0, 24, 181}, {0x0000000c1, 24, 102}, {0x0000000c2, 24, 182}, {0x0000000c3, 24, 183},
+	{0x0000000c4, 24, 184}, {0x0000000c5, 24, 185}, {0x0000000c6, 24, 186}, {0x0000000c7, 24, 187},
+	{0x0000000c8, 24, 188}, {0x0000000c9, 24, 189}, {0x0000000ca, 24, 190}, {0x0000000cb, 24, 191},
+	{0x0000000cc, 24, 192}, {0x0000000cd, 24, 193}, {0x0000000ce, 24, 194}, {0x0000000cf, 24, 195},
+	{0x0000000d0, 24, 196}, {0x0000000d1, 24, 197}, {0x0000000d2, 24, 198}, {0x0000000d3, 24, 199},
+	{0x0000000d4, 24, 200}, {0x0000000d5, 24, 201}, {0x0000000d6, 24, 202}, {0x0000000d7, 24, 203},
+	{0x0000000d8, 24, 204}, {0x0000000d9, 24, 205}, {0x0000000da, 24, 206}, {0x0000000db, 24, 207},
+	{0x0000000dc, 24, 195}, {0x0000000dd, 24, 208}, {0x0000000de, 24, 209}, {0x0000000df, 24, 210},
+	{0x0000000e0, 24, 211}, {0x0000000e1, 24, 212}, {0x0000000e2, 24, 213}, {0x0000000e3, 24, 214},
+	{0x0000000e4, 24, 215}, {0x0000000e5, 24, 216}, {0x0000000e6, 24, 217}, {0x0000000e7, 24, 218},
+	{0x0000000e8, 24, 219}, {0x0000000e9, 24, 220}, {0x0000000ea, 24, 221}, {0x0000000eb, 24, 222},
+	{0x0000000ec, 24, 223}, {0x0000000ed, 24, 224}, {0x0000000ee, 24, 225}, {0x0000000ef, 24, 226},
+	{0x0000000f0, 24, 227}, {0x0000000f1, 24, 228}, {0x0000000f2, 24, 229}, {0x0000000f3, 24, 230},
+	{0x0000000f4, 24, 231}, {0x0000000f5, 24, 232}, {0x0000000f6, 24, 233}, {0x0000000f7, 24, 234},
+	{0x0000000f8, 24, 235}, {0x0000000f9, 24, 236}, {0x0000000fa, 24, 237}, {0x0000000fb, 24, 238},
+	{0x0000000fc, 24, 239}, {0x0000000fd, 24, 240}, {0x0000000fe, 24, 241}, {0x0000000ff, 24, 242},
+	{0x000000100, 24, 243}, {0x000000101, 24, 99}, {0x000000102, 24, 244}, {0x000000103, 24, 244},
+	{0x000000104, 24, 245}, {0x000000105, 24, 246}, {0x000000106, 24, 247}, {0x000000107, 24, 248},
+	{0x000000108, 24, 249}, {0x000000109, 24, 250}, {0x00000010a, 24, 251}, {0x00000010b, 24, 252},
+	{0x00000010c, 24, 253}, {0x00000010d, 24, 254}, {0x00000010e, 24, 255}, {0x00000010f, 24, 127},
+	{0x000000110, 24, 256}, {0x000000111, 24, 257}, {0x000000112, 24, 258}, {0x000000113, 24, 259},
+	{0x000000114, 24, 260}, {0x000000115, 24, 261}, {0x000000116, 24, 262}, {0x000000117, 24, 263},
+	{0x000000118, 24, 264}, {0x000000119, 24, 265}, {0x00000011a, 24, 266}, {0x00000011b, 24, 267},
+	{0x00000011c, 24, 268}, {0x00000011d, 24, 269}, {0x00000011e, 24, 270}, {0x00000011f, 24, 271},
+	{0x000000120, 24, 272}, {0x000000121, 24, 273}, {0x000000122, 24, 274}, {0x000000123, 24, 275},
+	{0x000000124, 24, 276}, {0x000000125, 24, 277}, {0x000000126, 24, 278}, {0x000000127, 24, 279},
+	{0x000000128, 24, 280}, {0x000000129, 24, 281}, {0x00000012a, 24, 282}, {0x00000012b, 24, 283},
+	{0x00000012c, 24, 284}, {0x00000012d, 24, 285}, {0x00000012e, 24, 286}, {0x00000012f, 24, 287},
+	{0x000000130, 24, 288}, {0x000000131, 24, 289}, {0x000000132, 24, 290}, {0x000000133, 24, 291},
+	{0x000000134, 24, 292}, {0x000000135, 24, 293}, {0x000000136, 24, 294}, {0x000000137, 24, 295},
+	{0x000000138, 24, 296}, {0x000000139, 24, 297}, {0x00000013a, 24, 298}, {0x00000013b, 24, 299},
+	{0x00000013c, 24, 300}, {0x00000013d, 24, 301}, {0x00000013e, 24, 302}, {0x00000013f, 24, 303},
+	{0x000000140, 24, 304}, {0x000000141, 24, 305}, {0x000000142, 24, 3}, {0x000000143, 24, 3},
+	{0x000000144, 24, 142}, {0x000000145, 24, 306}, {0x000000146, 24, 307}, {0x000000147, 24, 308},
+	{0x000000148, 24, 309}, {0x000000149, 24, 310}, {0x00000014a, 24, 311}, {0x00000014b, 24, 312},
+	{0x00000014c, 24, 313}, {0x00000014d, 24, 314}, {0x00000014e, 24, 315}, {0x00000014f, 24, 316},
+	{0x000000150, 24, 317}, {0x000000151, 24, 318}, {0x000000152, 24, 319}, {0x000000153, 24, 320},
+	{0x000000154, 24, 321}, {0x000000155, 24, 322}, {0x000000156, 24, 323}, {0x000000157, 24, 324},
+	{0x000000158, 24, 325}, {0x000000159, 24, 326}, {0x00000015a, 24, 327}, {0x00000015b, 24, 328},
+	{0x00000015c, 24, 329}, {0x00000015d, 24, 116}, {0x00000015e, 24, 330}, {0x00000015f, 24, 331},
+	{0x000000160, 24, 332}, {0x000000161, 24, 333}, {0x000000162, 24, 334}, {0x000000163, 24, 3},
+	{0x000000164, 24, 3}, {0x000000165, 24, 335}, {0x000000166, 24, 336}, {0x000000167, 24, 337},
+	{0x000000168, 24, 338}, {0x000000169, 24, 339}, {0x00000016a, 24, 340}, {0x00000016b, 24, 341},
+	{0x00000016c, 24, 342}, {0x00000016d, 24, 343}, {0x00000016e, 24, 344}, {0x00000016f, 24, 345},
+	{0x000000170, 24, 346}, {0x000000171, 24, 347}, {0x000000172, 24, 348}, {0x000000173, 24, 349},
+	{0x000000174, 24, 350}, {0x000000175, 24, 351}, {0x000000176, 24, 352}, {0x000000177, 24, 353},
+	{0x000000178, 24, 354}, {0x000000179, 24, 355}, {0x00000017a, 24, 356}, {0x00000017b, 24, 357},
+	{0x00000017c, 24, 358}, {0x00000017d, 24, 359}, {0x00000017e, 24, 360}, {0x00000017f, 24, 361},
+	{0x000000180, 24, 362}, {0x000000181, 24, 108}, {0x000000182, 24, 363}, {0x000000183, 24, 364},
+	{0x000000184, 24, 365}, {0x000000185, 24, 366}, {0x000000186, 24, 367}, {0x000000187, 24, 368},
+	{0x000000188, 24, 369}, {0x000000189, 24, 370}, {0x00000018a, 24, 371}, {0x00000018b, 24, 372},
+	{0x00000018c, 24, 373}, {0x00000018d, 24, 374}, {0x00000018e, 24, 375}, {0x00000018f, 24, 376},
+	{0x000000190, 24, 377}, {0x000000191, 24, 378}, {0x000000192, 24, 379}, {0x000000193, 24, 380},
+	{0x000000194, 24, 381}, {0x000000195, 24, 382}, {0x000000196, 24, 3}, {0x000000197, 24, 3},
+	{0x000000198, 24, 383}, {0x000000199, 24, 384}, {0x00000019a, 24, 385}, {0x00000019b, 24, 386},
+	{0x00000019c, 24, 387}, {0x00000019d, 24, 388}, {0x00000019e, 24, 389}, {0x00000019f, 24, 390},
+	{0x0000001a0, 24, 391}, {0x0000001a1, 24, 392}, {0x0000001a2, 24, 393}, {0x0000001a3, 24, 394},
+	{0x0000001a4, 24, 395}, {0x0000001a5, 24, 396}, {0x0000001a6, 24, 397}, {0x0000001a7, 24, 398},
+	{0x0000001a8, 24, 399}, {0x0000001a9, 24, 400}, {0x0000001aa, 24, 401}, {0x0000001ab, 24, 402},
+	{0x0000001ac, 24, 403}, {0x0000001ad, 24, 404}, {0x0000001ae, 24, 405}, {0x0000001af, 24, 406},
+	{0x0000001b0, 24, 407}, {0x0000001b1, 24, 408}, {0x0000001b2, 24, 409}, {0x0000001b3, 24, 410},
+	{0x0000001b4, 24, 411}, {0x0000001b5, 24, 412}, {0x0000001b6, 24, 413}, {0x0000001b7, 24, 414},
+	{0x0000001b8, 24, 415}, {0x0000001b9, 24, 416}, {0x0000001ba, 24, 417}, {0x0000001bb, 24, 418},
+	{0x0000001bc, 24, 419}, {0x0000001bd, 24, 420}, {0x0000001be, 24, 421}, {0x0000001bf, 24, 422},
+	{0x0000001c0, 24, 423}, {0x0000001c1, 24, 424}, {0x0000001c2, 24, 425}, {0x0000001c3, 24, 426},
+	{0x0000001c4, 24, 427}, {0x0000001c5, 24, 428}, {0x0000001c6, 24, 429}, {0x0000001c7, 24, 3},
+	{0x0000001c8, 24, 430}, {0x0000001c9, 24, 3}, {0x0000001ca, 24, 431}, {0x0000001cb, 24, 432},
+	{0x0000001cc, 24, 433}, {0x0000001cd, 24, 434}, {0x0000001ce, 24, 435}, {0x0000001cf, 24, 436},
+	{0x0000001d0, 24, 437}, {0x0000001d1, 24, 438}, {0x0000001d2, 24, 439}, {0x0000001d3, 24, 440},
+	{0x0000001d4, 24, 441}, {0x0000001d5, 24, 442}, {0x0000001d6, 24, 443}, {0x0000001d7, 24, 444},
+	{0x0000001d8, 24, 445}, {0x0000001d9, 24, 446}, {0x0000001da, 24, 447}, {0x0000001db, 24, 448},
+	{0x0000001dc, 24, 449}, {0x0000001dd, 24, 450}, {0x0000001de, 24, 451}, {0x0000001df, 24, 452},
+	{0x0000001e0, 24, 453}, {0x0000001e1, 24, 454}, {0x0000001e2, 24, 455}, {0x0000001e3, 24, 456},
+	{0x0000001e4, 24, 457}, {0x0000001e5, 24, 458}, {0x0000001e6, 24, 459}, {0x0000001e7, 24, 459},
+	{0x0000001e8, 24, 460}, {0x0000001e9, 24, 461}, {0x0000001ea, 24, 462}, {0x0000001eb, 24, 463},
+	{0x0000001ec, 24, 464}, {0x0000001ed, 24, 465}, {0x0000001ee, 24, 466}, {0x0000001ef, 24, 467},
+	{0x0000001f0, 24, 468}, {0x0000001f1, 24, 469}, {0x0000001f2, 24, 470}, {0x0000001f3, 24, 471},
+	{0x0000001f4, 24, 472}, {0x0000001f5, 24, 473}, {0x0000001f6, 24, 474}, {0x0000001f7, 24, 475},
+	{0x0000001f8, 24, 476}, {0x0000001f9, 24, 477}, {0x0000001fa, 24, 478}, {0x0000001fb, 24, 479},
+	{0x0000001fc, 24, 480}, {0x0000001fd, 24, 481}, {0x0000001fe, 24, 235}, {0x0000001ff, 24, 482},
+	{0x000000200, 24, 483}, {0x000000201, 24, 484}, {0x000000202, 24, 485}, {0x000000203, 24, 486},
+	{0x000000204, 24, 487}, {0x000000205, 24, 488}, {0x000000206, 24, 489}, {0x000000207, 24, 490},
+	{0x000000208, 24, 491}, {0x000000209, 24, 492}, {0x00000020a, 24, 493}, {0x00000020b, 24, 494},
+	{0x00000020c, 24, 495}, {0x00000020d, 24, 496}, {0x00000020e, 24, 497}, {0x00000020f, 24, 498},
+	{0x000000210, 24, 499}, {0x000000211, 24, 500}, {0x000000212, 24, 501}, {0x000000213, 24, 502},
+	{0x000000214, 24, 503}, {0x000000215, 24, 504}, {0x000000216, 24, 3}, {0x000000217, 24, 3},
+	{0x000000218, 24, 505}, {0x000000219, 24, 506}, {0x00000021a, 24, 507}, {0x00000021b, 24, 508},
+	{0x00000021c, 24, 509}, {0x00000021d, 24, 510}, {0x00000021e, 24, 511}, {0x00000021f, 24, 512},
+	{0x000000220, 24, 513}, {0x000000221, 24, 514}, {0x000000222, 24, 515}, {0x000000223, 24, 516},
+	{0x000000224, 24, 517}, {0x000000225, 24, 518}, {0x000000226, 24, 519}, {0x000000227, 24, 520},
+	{0x000000228, 24, 521}, {0x000000229, 24, 522}, {0x00000022a, 24, 523}, {0x00000022b, 24, 524},
+	{0x00000022c, 24, 525}, {0x00000022d, 24, 526}, {0x00000022e, 24, 527}, {0x00000022f, 24, 528},
+	{0x000000230, 24, 529}, {0x000000231, 24, 530}, {0x000000232, 24, 531}, {0x000000233, 24, 532},
+	{0x000000234, 24, 533}, {0x000000235, 24, 534}, {0x000000236, 24, 535}, {0x000000237, 24, 536},
+	{0x000000238, 24, 537}, {0x000000239, 24, 538}, {0x00000023a, 24, 539}, {0x00000023b, 24, 540},
+	{0x00000023c, 24, 541}, {0x00000023d, 24, 542}, {0x00000023e, 24, 543}, {0x00000023f, 24, 544},
+	{0x000000240, 24, 545}, {0x000000241, 24, 546}, {0x000000242, 24, 547}, {0x000000243, 24, 548},
+	{0x000000244, 24, 549}, {0x000000245, 24, 550}, {0x000000246, 24, 551}, {0x000000247, 24, 552},
+	{0x000000248, 24, 553}, {0x000000249, 24, 554}, {0x00000024a, 24, 3}, {0x00000024b, 24, 3},
+	{0x00000024c, 24, 555}, {0x00000024d, 24, 556}, {0x00000024e, 24, 557}, {0x00000024f, 24, 558},
+	{0x000000250, 24, 559}, {0x000000251, 24, 560}, {0x000000252, 24, 561}, {0x000000253, 24, 562},
+	{0x000000254, 24, 563}, {0x000000255, 24, 564}, {0x000000256, 24, 565}, {0x000000257, 24, 566},
+	{0x000000258, 24, 567}, {0x000000259, 24, 568}, {0x00000025a, 24, 569}, {0x00000025b, 24, 570},
+	{0x00000025c, 24, 571}, {0x00000025d, 24, 572}, {0x00000025e, 24, 573}, {0x00000025f, 24, 108},
+	{0x000000260, 24, 574}, {0x000000261, 24, 575}, {0x000000262, 24, 576}, {0x000000263, 24, 577},
+	{0x000000264, 24, 578}, {0x000000265, 24, 579}, {0x000000266, 24, 580}, {0x000000267, 24, 581},
+	{0x000000268, 24, 582}, {0x000000269, 24, 583}, {0x00000026a, 24, 584}, {0x00000026b, 24, 585},
+	{0x00000026c, 24, 586}, {0x00000026d, 24, 587}, {0x00000026e, 24, 588}, {0x00000026f, 24, 589},
+	{0x000000270, 24, 590}, {0x000000271, 24, 308}, {0x000000272, 24, 591}, {0x000000273, 24, 592},
+	{0x000000274, 24, 593}, {0x000000275, 24, 594}, {0x000000276, 24, 595}, {0x000000277, 24, 596},
+	{0x000000278, 24, 597}, {0x000000279, 24, 598}, {0x00000027a, 24, 599}, {0x00000027b, 24, 600},
+	{0x00000027c, 24, 601}, {0x00000027d, 24, 3}, {0x00000027e, 24, 3}, {0x00000027f, 24, 602},
+	{0x000000280, 24, 603}, {0x000000281, 24, 102}, {0x000000282, 24, 604}, {0x000000283, 24, 605},
+	{0x000000284, 24, 606}, {0x000000285, 24, 607}, {0x000000286, 24, 608}, {0x000000287, 24, 609},
+	{0x000000288, 24, 610}, {0x000000289, 24, 611}, {0x00000028a, 24, 612}, {0x00000028b, 24, 613},
+	{0x00000028c, 24, 614}, {0x00000028d, 24, 615}, {0x00000028e, 24, 616}, {0x00000028f, 24, 617},
+	{0x000000290, 24, 618}, {0x000000291, 24, 619}, {0x000000292, 24, 620}, {0x000000293, 24, 621},
+	{0x000000294, 24, 622}, {0x000000295, 24, 623}, {0x000000296, 24, 624}, {0x000000297, 24, 625},
+	{0x000000298, 24, 626}, {0x000000299, 24, 627}, {0x00000029a, 24, 628}, {0x00000029b, 24, 629},
+	{0x00000029c, 24, 630}, {0x00000029d, 24, 631}, {0x00000029e, 24, 632}, {0x00000029f, 24, 633},
+	{0x0000002a0, 24, 634}, {0x0000002a1, 24, 635}, {0x0000002a2, 24, 636}, {0x0000002a3, 24, 637},
+	{0x0000002a4, 24, 638}, {0x0000002a5, 24, 459}, {0x0000002a6, 24, 639}, {0x0000002a7, 24, 640},
+	{0x0000002a8, 24, 641}, {0x0000002a9, 24, 642}, {0x0000002aa, 24, 643}, {0x0000002ab, 24, 644},
+	{0x0000002ac, 24, 645}, {0x0000002ad, 24, 646}, {0x0000002ae, 24, 647}, {0x0000002af, 24, 648},
+	{0x0000002b0, 24, 649}, {0x0000002b1, 24, 650}, {0x0000002b2, 24, 651}, {0x0000002b3, 24, 652},
+	{0x0000002b4, 24, 653}, {0x0000002b5, 24, 654}, {0x0000002b6, 24, 655}, {0x0000002b7, 24, 656},
+	{0x0000002b8, 24, 657}, {0x0000002b9, 24, 3}, {0x0000002ba, 24, 3}, {0x0000002bb, 24, 658},
+	{0x0000002bc, 24, 659}, {0x0000002bd, 24, 660}, {0x0000002be, 24, 661}, {0x0000002bf, 24, 662},
+	{0x0000002c0, 24, 663}, {0x0000002c1, 24, 664}, {0x0000002c2, 24, 665}, {0x0000002c3, 24, 666},
+	{0x0000002c4, 24, 667}, {0x0000002c5, 24, 668}, {0x0000002c6, 24, 669}, {0x0000002c7, 24, 670},
+	{0x0000002c8, 24, 671}, {0x0000002c9, 24, 672}, {0x0000002ca, 24, 673}, {0x0000002cb, 24, 674},
+	{0x0000002cc, 24, 675}, {0x0000002cd, 24, 676}, {0x0000002ce, 24, 677}, {0x0000002cf, 24, 678},
+	{0x0000002d0, 24, 679}, {0x0000002d1, 24, 680}, {0x0000002d2, 24, 681}, {0x0000002d3, 24, 682},
+	{0x0000002d4, 24, 683}, {0x0000002d5, 24, 684}, {0x0000002d6, 24, 685}, {0x0000002d7, 24, 686},
+	{0x0000002d8, 24, 687}, {0x0000002d9, 24, 688}, {0x0000002da, 24, 689}, {0x0000002db, 24, 690},
+	{0x0000002dc, 24, 691}, {0x0000002dd, 24, 692}, {0x0000002de, 24, 693}, {0x0000002df, 24, 694},
+	{0x0000002e0, 24, 695}, {0x0000002e1, 24, 696}, {0x0000002e2, 24, 697}, {0x0000002e3, 24, 698},
+	{0x0000002e4, 24, 699}, {0x0000002e5, 24, 700}, {0x0000002e6, 24, 701}, {0x0000002e7, 24, 702},
+	{0x0000002e8, 24, 703}, {0x0000002e9, 24, 704}, {0x0000002ea, 24, 705}, {0x0000002eb, 24, 706},
+	{0x0000002ec, 24, 707}, {0x0000002ed, 24, 708}, {0x0000002ee, 24, 709}, {0x0000002ef, 24, 710},
+	{0x0000002f0, 24, 711}, {0x0000002f1, 24, 712}, {0x0000002f2, 24, 713}, {0x0000002f3, 24, 714},
+	{0x0000002f4, 24, 715}, {0x0000002f5, 24, 716}, {0x0000002f6, 24, 717}, {0x0000002f7, 24, 718},
+	{0x0000002f8, 24, 719}, {0x0000002f9, 24, 720}, {0x0000002fa, 24, 721}, {0x0000002fb, 24, 722},
+	{0x0000002fc, 24, 3}, {0x0000002fd, 24, 3}, {0x0000002fe, 24, 723}, {0x0000002ff, 24, 724},
+	{0x000000300, 24, 725}, {0x000000301, 24, 726}, {0x000000302, 24, 727}, {0x000000303, 24, 728},
+	{0x000000304, 24, 729}, {0x000000305, 24, 730}, {0x000000306, 24, 731}, {0x000000307, 24, 732},
+	{0x000000308, 24, 733}, {0x000000309, 24, 734}, {0x00000030a, 24, 735}, {0x00000030b, 24, 736},
+	{0x00000030c, 24, 737}, {0x00000030d, 24, 738}, {0x00000030e, 24, 739}, {0x00000030f, 24, 740},
+	{0x000000310, 24, 741}, {0x000000311, 24, 742}, {0x000000312, 24, 743}, {0x000000313, 24, 744},
+	{0x000000314, 24, 745}, {0x000000315, 24, 746}, {0x000000316, 24, 747}, {0x000000317, 24, 748},
+	{0x000000318, 24, 749}, {0x000000319, 24, 750}, {0x00000031a, 24, 751}, {0x00000031b, 24, 752},
+	{0x00000031c, 24, 753}, {0x00000031d, 24, 754}, {0x00000031e, 24, 755}, {0x00000031f, 24, 756},
+	{0x000000320, 24, 757}, {0x000000321, 24, 758}, {0x000000322, 24, 759}, {0x000000323, 24, 760},
+	{0x000000324, 24, 761}, {0x000000325, 24, 762}, {0x000000326, 24, 763}, {0x000000327, 24, 764},
+	{0x000000328, 24, 765}, {0x000000329, 24, 766}, {0x00000032a, 24, 767}, {0x00000032b, 24, 768},
+	{0x00000032c, 24, 769}, {0x00000032d, 24, 770}, {0x00000032e, 24, 771}, {0x00000032f, 24, 772},
+	{0x000000330, 24, 773}, {0x000000331, 24, 3}, {0x000000332, 24, 3}, {0x000000333, 24, 774},
+	{0x000000334, 24, 775}, {0x000000335, 24, 776}, {0x000000336, 24, 777}, {0x000000337, 24, 778},
+	{0x000000338, 24, 779}, {0x000000339, 24, 780}, {0x00000033a, 24, 781}, {0x00000033b, 24, 782},
+	{0x00000033c, 24, 783}, {0x00000033d, 24, 784}, {0x00000033e, 24, 785}, {0x00000033f, 24, 786},
+	{0x000000340, 24, 787}, {0x000000341, 24, 788}, {0x000000342, 24, 108}, {0x000000343, 24, 789},
+	{0x000000344, 24, 790}, {0x000000345, 24, 791}, {0x000000346, 24, 792}, {0x000000347, 24, 652},
+	{0x000000348, 24, 793}, {0x000000349, 24, 794}, {0x00000034a, 24, 795}, {0x00000034b, 24, 108},
+	{0x00000034c, 24, 796}, {0x00000034d, 24, 797}, {0x00000034e, 24, 798}, {0x00000034f, 24, 799},
+	{0x000000350, 24, 800}, {0x000000351, 24, 801}, {0x000000352, 24, 802}, {0x000000353, 24, 803},
+	{0x000000354, 24, 804}, {0x000000355, 24, 805}, {0x000000356, 24, 806}, {0x000000357, 24, 807},
+	{0x000000358, 24, 808}, {0x000000359, 24, 809}, {0x00000035a, 24, 810}, {0x00000035b, 24, 811},
+	{0x00000035c, 24, 812}, {0x00000035d, 24, 813}, {0x00000035e, 24, 814}, {0x00000035f, 24, 815},
+	{0x000000360, 24, 816}, {0x000000361, 24, 817}, {0x000000362, 24, 818}, {0x000000363, 24, 819},
+	{0x000000364, 24, 820}, {0x000000365, 24, 821}, {0x000000366, 24, 822}, {0x000000367, 24, 823},
+	{0x000000368, 24, 824}, {0x000000369, 24, 825}, {0x00000036a, 24, 826}, {0x00000036b, 24, 3},
+	{0x00000036c, 24, 3}, {0x00000036d, 24, 827}, {0x00000036e, 24, 828}, {0x00000036f, 24, 829},
+	{0x000000370, 24, 830}, {0x000000371, 24, 831}, {0x000000372, 24, 832}, {0x000000373, 24, 833},
+	{0x000000374, 24, 834}, {0x000000375, 24, 835}, {0x000000376, 24, 836}, {0x000000377, 24, 837},
+	{0x000000378, 24, 838}, {0x000000379, 24, 839}, {0x00000037a, 24, 840}, {0x00000037b, 24, 841},
+	{0x00000037c, 24, 842}, {0x00000037d, 24, 843}, {0x00000037e, 24, 844}, {0x00000037f, 24, 845},
+	{0x000000380, 24, 846}, {0x000000381, 24, 847}, {0x000000382, 24, 848}, {0x000000383, 24, 849},
+	{0x000000384, 24, 850}, {0x000000385, 24, 851}, {0x000000386, 24, 852}, {0x000000387, 24, 853},
+	{0x000000388, 24, 854}, {0x000000389, 24, 855}, {0x00000038a, 24, 856}, {0x00000038b, 24, 857},
+	{0x00000038c, 24, 858}, {0x00000038d, 24, 859}, {0x00000038e, 24, 860}, {0x00000038f, 24, 861},
+	{0x000000390, 24, 862}, {0x000000391, 24, 863}, {0x000000392, 24, 864}, {0x000000393, 24, 865},
+	{0x000000394, 24, 866}, {0x000000395, 24, 867}, {0x000000396, 24, 868}, {0x000000397, 24, 869},
+	{0x000000398, 24, 870}, {0x000000399, 24, 871}, {0x00000039a, 24, 872}, {0x00000039b, 24, 873},
+	{0x00000039c, 24, 874}, {0x00000039d, 24, 875}, {0x00000039e, 24, 876}, {0x00000039f, 24, 3},
+	{0x0000003a0, 24, 3}, {0x0000003a1, 24, 877}, {0x0000003a2, 24, 878}, {0x0000003a3, 24, 879},
+	{0x0000003a4, 24, 880}, {0x0000003a5, 24, 881}, {0x0000003a6, 24, 882}, {0x0000003a7, 24, 883},
+	{0x0000003a8, 24, 884}, {0x0000003a9, 24, 885}, {0x0000003aa, 24, 886}, {0x0000003ab, 24, 887},
+	{0x0000003ac, 24, 888}, {0x0000003ad, 24, 889}, {0x0000003ae, 24, 890}, {0x0000003af, 24, 891},
+	{0x0000003b0, 24, 892}, {0x0000003b1, 24, 893}, {0x0000003b2, 24, 894}, {0x0000003b3, 24, 895},
+	{0x0000003b4, 24, 896}, {0x0000003b5, 24, 897}, {0x0000003b6, 24, 898}, {0x0000003b7, 24, 899},
+	{0x0000003b8, 24, 900}, {0x0000003b9, 24, 901}, {0x0000003ba, 24, 116}, {0x0000003bb, 24, 902},
+	{0x0000003bc, 24, 903}, {0x0000003bd, 24, 904}, {0x0000003be, 24, 905}, {0x0000003bf, 24, 906},
+	{0x0000003c0, 24, 907}, {0x0000003c1, 24, 908}, {0x0000003c2, 24, 909}, {0x0000003c3, 24, 910},
+	{0x0000003c4, 24, 911}, {0x0000003c5, 24, 912}, {0x0000003c6, 24, 913}, {0x0000003c7, 24, 914},
+	{0x0000003c8, 24, 915}, {0x0000003c9, 24, 916}, {0x0000003ca, 24, 917}, {0x0000003cb, 24, 918},
+	{0x0000003cc, 24, 919}, {0x0000003cd, 24, 920}, {0x0000003ce, 24, 921}, {0x0000003cf, 24, 922},
+	{0x0000003d0, 24, 923}, {0x0000003d1, 24, 924}, {0x0000003d2, 24, 925}, {0x0000003d3, 24, 926},
+	{0x0000003d4, 24, 927}, {0x0000003d5, 24, 928}, {0x0000003d6, 24, 929}, {0x0000003d7, 24, 930},
+	{0x0000003d8, 24, 931}, {0x0000003d9, 24, 932}, {0x0000003da, 24, 933}, {0x0000003db, 24, 934},
+	{0x0000003dc, 24, 935}, {0x0000003dd, 24, 936}, {0x0000003de, 24, 937}, {0x0000003df, 24, 938},
+	{0x0000003e0, 24, 185}, {0x0000003e1, 24, 939}, {0x0000003e2, 24, 940}, {0x0000003e3, 24, 3},
+	{0x0000003e4, 24, 3}, {0x0000003e5, 24, 941}, {0x0000003e6, 24, 942}, {0x0000003e7, 24, 943},
+	{0x0000003e8, 24, 944}, {0x0000003e9, 24, 945}, {0x0000003ea, 24, 946}, {0x0000003eb, 24, 947},
+	{0x0000003ec, 24, 948}, {0x0000003ed, 24, 949}, {0x0000003ee, 24, 950}, {0x0000003ef, 24, 951},
+	{0x0000003f0, 24, 952}, {0x0000003f1, 24, 953}, {0x0000003f2, 24, 954}, {0x0000003f3, 24, 955},
+	{0x0000003f4, 24, 956}, {0x0000003f5, 24, 957}, {0x0000003f6, 24, 958}, {0x0000003f7, 24, 959},
+	{0x0000003f8, 24, 960}, {0x0000003f9, 24, 961}, {0x0000003fa, 24, 962}, {0x0000003fb, 24, 963},
+	{0x0000003fc, 24, 964}, {0x0000003fd, 24, 3}, {0x0000003fe, 24, 3}, {0x0000003ff, 24, 965},
+	{0x000000400, 24, 966}, {0x000000401, 24, 967}, {0x000000402, 24, 968}, {0x000000403, 24, 969},
+	{0x000000404, 24, 970}, {0x000000405, 24, 971}, {0x000000406, 24, 972}, {0x000000407, 24, 973},
+	{0x000000408, 24, 974}, {0x000000409, 24, 975}, {0x00000040a, 24, 976}, {0x00000040b, 24, 977},
+	{0x00000040c, 24, 978}, {0x00000040d, 24, 979}, {0x00000040e, 24, 980}, {0x00000040f, 24, 981},
+	{0x000000410, 24, 982}, {0x000000411, 24, 983}, {0x000000412, 24, 984}, {0x000000413, 24, 985},
+	{0x000000414, 24, 986}, {0x000000415, 24, 987}, {0x000000416, 24, 988}, {0x000000417, 24, 989},
+	{0x000000418, 24, 990}, {0x000000419, 24, 991}, {0x00000041a, 24, 992}, {0x00000041b, 24, 993},
+	{0x00000041c, 24, 994}, {0x00000041d, 24, 995}, {0x00000041e, 24, 996}, {0x00000041f, 24, 997},
+	{0x000000420, 24, 998}, {0x000000421, 24, 999}, {0x000000422, 24, 1000}, {0x000000423, 24, 652},
+	{0x000000424, 24, 1001}, {0x000000425, 24, 1002}, {0x000000426, 24, 1003}, {0x000000427, 24, 3},
+	{0x000000428, 24, 3}, {0x000000429, 24, 1004}, {0x00000042a, 24, 1005}, {0x00000042b, 24, 1006},
+	{0x00000042c, 24, 1007}, {0x00000042d, 24, 1008}, {0x00000042e, 24, 1009}, {0x00000042f, 24, 1010},
+	{0x000000430, 24, 1011}, {0x000000431, 24, 1012}, {0x000000432, 24, 1013}, {0x000000433, 24, 1014},
+	{0x000000434, 24, 1015}, {0x000000435, 24, 1016}, {0x000000436, 24, 1017}, {0x000000437, 24, 1018},
+	{0x000000438, 24, 108}, {0x000000439, 24, 1019}, {0x00000043a, 24, 1020}, {0x00000043b, 24, 1021},
+	{0x00000043c, 24, 1022}, {0x00000043d, 24, 1023}, {0x00000043e, 24, 1024}, {0x00000043f, 24, 1025},
+	{0x000000440, 24, 1026}, {0x000000441, 24, 1027}, {0x000000442, 24, 1028}, {0x000000443, 24, 1029},
+	{0x000000444, 24, 1030}, {0x000000445, 24, 1031}, {0x000000446, 24, 1032}, {0x000000447, 24, 1033},
+	{0x000000448, 24, 1034}, {0x000000449, 24, 1035}, {0x00000044a, 24, 1036}, {0x00000044b, 24, 1037},
+	{0x00000044c, 24, 1038}, {0x00000044d, 24, 3}, {0x00000044e, 24, 3}, {0x00000044f, 24, 1039},
+	{0x000000450, 24, 1040}, {0x000000451, 24, 1041}, {0x000000452, 24, 1042}, {0x000000453, 24, 1043},
+	{0x000000454, 24, 1044}, {0x000000455, 24, 1045}, {0x000000456, 24, 1046}, {0x000000457, 24, 1047},
+	{0x000000458, 24, 1048}, {0x000000459, 24, 1049}, {0x00000045a, 24, 1050}, {0x00000045b, 24, 1051},
+	{0x00000045c, 24, 1052}, {0x00000045d, 24, 1053}, {0x00000045e, 24, 1054}, {0x00000045f, 24, 1055},
+	{0x000000460, 24, 1056}, {0x000000461, 24, 1057}, {0x000000462, 24, 1058}, {0x000000463, 24, 1059},
+	{0x000000464, 24, 1060}, {0x000000465, 24, 1061}, {0x000000466, 24, 1062}, {0x000000467, 24, 1063},
+	{0x000000468, 24, 1064}, {0x000000469, 24, 1065}, {0x00000046a, 24, 1066}, {0x00000046b, 24, 1067},
+	{0x00000046c, 24, 1068}, {0x00000046d, 24, 3}, {0x00000046e, 24, 3}, {0x00000046f, 24, 1069},
+	{0x000000470, 24, 1070}, {0x000000471, 24, 1071}, {0x000000472, 24, 1072}, {0x000000473, 24, 1073},
+	{0x000000474, 24, 1074}, {0x000000475, 24, 1075}, {0x000000476, 24, 1075}, {0x000000477, 24, 1076},
+	{0x000000478, 24, 1077}, {0x000000479, 24, 1078}, {0x00000047a, 24, 1079}, {0x00000047b, 24, 1080},
+	{0x00000047c, 24, 1081}, {0x00000047d, 24, 1082}, {0x00000047e, 24, 1083}, {0x00000047f, 24, 1084},
+	{0x000000480, 24, 1085}, {0x000000481, 24, 1086}, {0x000000482, 24, 1087}, {0x000000483, 24, 1088},
+	{0x000000484, 24, 1089}, {0x000000485, 24, 1090}, {0x000000486, 24, 1091}, {0x000000487, 24, 1092},
+	{0x000000488, 24, 1093}, {0x000000489, 24, 1094}, {0x00000048a, 24, 1095}, {0x00000048b, 24, 1096},
+	{0x00000048c, 24, 1097}, {0x00000048d, 24, 1098}, {0x00000048e, 24, 1099}, {0x00000048f, 24, 1100},
+	{0x000000490, 24, 1101}, {0x000000491, 24, 1102}, {0x000000492, 24, 1103}, {0x000000493, 24, 1104},
+	{0x000000494, 24, 1105}, {0x000000495, 24, 1106}, {0x000000496, 24, 288}, {0x000000497, 24, 1107},
+	{0x000000498, 24, 1108}, {0x000000499, 24, 1109}, {0x00000049a, 24, 3}, {0x00000049b, 24, 3},
+	{0x00000049c, 24, 1110}, {0x00000049d, 24, 1111}, {0x00000049e, 24, 1112}, {0x00000049f, 24, 1113},
+	{0x0000004a0, 24, 1114}, {0x0000004a1, 24, 1115}, {0x0000004a2, 24, 1116}, {0x0000004a3, 24, 1117},
+	{0x0000004a4, 24, 1118}, {0x0000004a5, 24, 1119}, {0x0000004a6, 24, 1120}, {0x0000004a7, 24, 1121},
+	{0x0000004a8, 24, 1122}, {0x0000004a9, 24, 1123}, {0x0000004aa, 24, 1124}, {0x0000004ab, 24, 1125},
+	{0x0000004ac, 24, 564}, {0x0000004ad, 24, 1126}, {0x0000004ae, 24, 1127}, {0x0000004af, 24, 1128},
+	{0x0000004b0, 24, 1129}, {0x0000004b1, 24, 1130}, {0x0000004b2, 24, 1131}, {0x0000004b3, 24, 1132},
+	{0x0000004b4, 24, 1133}, {0x0000004b5, 24, 1134}, {0x0000004b6, 24, 1135}, {0x0000004b7, 24, 1136},
+	{0x0000004b8, 24, 1137}, {0x0000004b9, 24, 1138}, {0x0000004ba, 24, 1139}, {0x0000004bb, 24, 1140},
+	{0x0000004bc, 24, 1141}, {0x0000004bd, 24, 185}, {0x0000004be, 24, 1142}, {0x0000004bf, 24, 1143},
+	{0x0000004c0, 24, 3}, {0x0000004c1, 24, 3}, {0x0000004c2, 24, 1144}, {0x0000004c3, 24, 1145},
+	{0x0000004c4, 24, 1146}, {0x0000004c5, 24, 1147}, {0x0000004c6, 24, 1148}, {0x0000004c7, 24, 1149},
+	{0x0000004c8, 24, 1150}, {0x0000004c9, 24, 1151}, {0x0000004ca, 24, 1152}, {0x0000004cb, 24, 1153},
+	{0x0000004cc, 24, 1154}, {0x0000004cd, 24, 1155}, {0x0000004ce, 24, 1156}, {0x0000004cf, 24, 1157},
+	{0x0000004d0, 24, 1158}, {0x0000004d1, 24, 1159}, {0x0000004d2, 24, 1160}, {0x0000004d3, 24, 1161},
+	{0x0000004d4, 24, 1162}, {0x0000004d5, 24, 1163}, {0x0000004d6, 24, 1164}, {0x0000004d7, 24, 1165},
+	{0x0000004d8, 24, 1166}, {0x0000004d9, 24, 1167}, {0x0000004da, 24, 1168}, {0x0000004db, 24, 1169},
+	{0x0000004dc, 24, 108}, {0x0000004dd, 24, 3}, {0x0000004de, 24, 3}, {0x0000004df, 24, 1170},
+	{0x0000004e0, 24, 1171}, {0x0000004e1, 24, 1172}, {0x0000004e2, 24, 1173}, {0x0000004e3, 24, 1174},
+	{0x0000004e4, 24, 1175}, {0x0000004e5, 24, 1176}, {0x0000004e6, 24, 1177}, {0x0000004e7, 24, 1178},
+	{0x0000004e8, 24, 1179}, {0x0000004e9, 24, 1180}, {0x0000004ea, 24, 459}, {0x0000004eb, 24, 1181},
+	{0x0000004ec, 24, 1182}, {0x0000004ed, 24, 1183}, {0x0000004ee, 24, 1184}, {0x0000004ef, 24, 1185},
+	{0x0000004f0, 24, 1186}, {0x0000004f1, 24, 1187}, {0x0000004f2, 24, 1188}, {0x0000004f3, 24, 1189},
+	{0x0000004f4, 24, 1190}, {0x0000004f5, 24, 1191}, {0x0000004f6, 24, 1192}, {0x0000004f7, 24, 1193},
+	{0x0000004f8, 24, 1194}, {0x0000004f9, 24, 1195}, {0x0000004fa, 24, 1196}, {0x0000004fb, 24, 1197},
+	{0x0000004fc, 24, 1198}, {0x0000004fd, 24, 1199}, {0x0000004fe, 24, 1200}, {0x0000004ff, 24, 1201},
+	{0x000000500, 24, 3}, {0x000000501, 24, 3}, {0x000000502, 24, 865}, {0x000000503, 24, 1202},
+	{0x000000504, 24, 1203}, {0x000000505, 24, 1204}, {0x000000506, 24, 1205}, {0x000000507, 24, 1206},
+	{0x000000508, 24, 1207}, {0x000000509, 24, 1208}, {0x00000050a, 24, 1209}, {0x00000050b, 24, 1210},
+	{0x00000050c, 24, 1211}, {0x00000050d, 24, 1212}, {0x00000050e, 24, 1213}, {0x00000050f, 24, 1214},
+	{0x000000510, 24, 1215}, {0x000000511, 24, 1216}, {0x000000512, 24, 1217}, {0x000000513, 24, 1218},
+	{0x000000514, 24, 1219}, {0x000000515, 24, 1220}, {0x000000516, 24, 1221}, {0x000000517, 24, 1222},
+	{0x000000518, 24, 1223}, {0x000000519, 24, 1224}, {0x00000051a, 24, 1225}, {0x00000051b, 24, 1226},
+	{0x00000051c, 24, 1227}, {0x00000051d, 24, 1228}, {0x00000051e, 24, 127}, {0x00000051f, 24, 1229},
+	{0x000000520, 24, 1230}, {0x000000521, 24, 834}, {0x000000522, 24, 1231}, {0x000000523, 24, 1232},
+	{0x000000524, 24, 1233}, {0x000000525, 24, 1234}, {0x000000526, 24, 1235}, {0x000000527, 24, 1236},
+	{0x000000528, 24, 1237}, {0x000000529, 24, 1238}, {0x00000052a, 24, 1239}, {0x00000052b, 24, 1240},
+	{0x00000052c, 24, 1241}, {0x00000052d, 24, 1242}, {0x00000052e, 24, 1243}, {0x00000052f, 24, 1244},
+	{0x000000530, 24, 1245}, {0x000000531, 24, 3}, {0x000000532, 24, 3}, {0x000000533, 24, 127},
+	{0x000000534, 24, 1246}, {0x000000535, 24, 1247}, {0x000000536, 24, 1248}, {0x000000537, 24, 1249},
+	{0x000000538, 24, 1250}, {0x000000539, 24, 1251}, {0x00000053a, 24, 1252}, {0x00000053b, 24, 1253},
+	{0x00000053c, 24, 1254}, {0x00000053d, 24, 526}, {0x00000053e, 24, 1255}, {0x00000053f, 24, 1256},
+	{0x000000540, 24, 1257}, {0x000000541, 24, 1258}, {0x000000542, 24, 1259}, {0x000000543, 24, 1260},
+	{0x000000544, 24, 1261}, {0x000000545, 24, 1262}, {0x000000546, 24, 1263}, {0x000000547, 24, 1264},
+	{0x000000548, 24, 1265}, {0x000000549, 24, 1266}, {0x00000054a, 24, 1267}, {0x00000054b, 24, 1268},
+	{0x00000054c, 24, 1269}, {0x00000054d, 24, 1270}, {0x00000054e, 24, 1271}, {0x00000054f, 24, 99},
+	{0x000000550, 24, 1272}, {0x000000551, 24, 1273}, {0x000000552, 24, 1274}, {0x000000553, 24, 1275},
+	{0x000000554, 24, 1276}, {0x000000555, 24, 1277}, {0x000000556, 24, 1278}, {0x000000557, 24, 1279},
+	{0x000000558, 24, 1280}, {0x000000559, 24, 1281}, {0x00000055a, 24, 1282}, {0x00000055b, 24, 727},
+	{0x00000055c, 24, 1283}, {0x00000055d, 24, 1284}, {0x00000055e, 24, 3}, {0x00000055f, 24, 3},
+	{0x000000560, 24, 1285}, {0x000000561, 24, 1286}, {0x000000562, 24, 1287}, {0x000000563, 24, 1288},
+	{0x000000564, 24, 1289}, {0x000000565, 24, 1290}, {0x000000566, 24, 1291}, {0x000000567, 24, 1292},
+	{0x000000568, 24, 1293}, {0x000000569, 24, 1294}, {0x00000056a, 24, 1295}, {0x00000056b, 24, 1296},
+	{0x00000056c, 24, 1297}, {0x00000056d, 24, 1298}, {0x00000056e, 24, 1299}, {0x00000056f, 24, 1300},
+	{0x000000570, 24, 1301}, {0x000000571, 24, 1302}, {0x000000572, 24, 1303}, {0x000000573, 24, 3},
+	{0x000000574, 24, 3}, {0x000000575, 24, 1304}, {0x000000576, 24, 1305}, {0x000000577, 24, 1306},
+	{0x000000578, 24, 99}, {0x000000579, 24, 1307}, {0x00000057a, 24, 1308}, {0x00000057b, 24, 1309},
+	{0x00000057c, 24, 1310}, {0x00000057d, 24, 1311}, {0x00000057e, 24, 1312}, {0x00000057f, 24, 1313},
+	{0x000000580, 24, 1314}, {0x000000581, 24, 1315}, {0x000000582, 24, 1316}, {0x000000583, 24, 1317},
+	{0x000000584, 24, 1318}, {0x000000585, 24, 1319}, {0x000000586, 24, 1320}, {0x000000587, 24, 1321},
+	{0x000000588, 24, 1322}, {0x000000589, 24, 1323}, {0x00000058a, 24, 1324}, {0x00000058b, 24, 1325},
+	{0x00000058c, 24, 1326}, {0x00000058d, 24, 1327}, {0x00000058e, 24, 1328}, {0x00000058f, 24, 1329},
+	{0x000000590, 24, 1330}, {0x000000591, 24, 1331}, {0x000000592, 24, 1332}, {0x000000593, 24, 1333},
+	{0x000000594, 24, 1334}, {0x000000595, 24, 1335}, {0x000000596, 24, 1336}, {0x000000597, 24, 1337},
+	{0x000000598, 24, 1338}, {0x000000599, 24, 1339}, {0x00000059a, 24, 3}, {0x00000059b, 24, 3},
+	{0x00000059c, 24, 1340}, {0x00000059d, 24, 1341}, {0x00000059e, 24, 1342}, {0x00000059f, 24, 1343},
+	{0x0000005a0, 24, 1344}, {0x0000005a1, 24, 1345}, {0x0000005a2, 24, 1346}, {0x0000005a3, 24, 1347},
+	{0x0000005a4, 24, 1348}, {0x0000005a5, 24, 1349}, {0x0000005a6, 24, 1350}, {0x0000005a7, 24, 1351},
+	{0x0000005a8, 24, 1352}, {0x0000005a9, 24, 1353}, {0x0000005aa, 24, 1354}, {0x0000005ab, 24, 1355},
+	{0x0000005ac, 24, 1356}, {0x0000005ad, 24, 1357}, {0x0000005ae, 24, 1358}, {0x0000005af, 24, 1359},
+	{0x0000005b0, 24, 1360}, {0x0000005b1, 24, 1361}, {0x0000005b2, 24, 1362}, {0x0000005b3, 24, 1363},
+	{0x0000005b4, 24, 1364}, {0x0000005b5, 24, 1365}, {0x0000005b6, 24, 1366}, {0x0000005b7, 24, 1367},
+	{0x0000005b8, 24, 1368}, {0x0000005b9, 24, 1369}, {0x0000005ba, 24, 1370}, {0x0000005bb, 24, 1371},
+	{0x0000005bc, 24, 1372}, {0x0000005bd, 24, 1373}, {0x0000005be, 24, 1374}, {0x0000005bf, 24, 1375},
+	{0x0000005c0, 24, 1376}, {0x0000005c1, 24, 1377}, {0x0000005c2, 24, 1378}, {0x0000005c3, 24, 1379},
+	{0x0000005c4, 24, 1380}, {0x0000005c5, 24, 1381}, {0x0000005c6, 24, 1382}, {0x0000005c7, 24, 1383},
+	{0x0000005c8, 24, 1384}, {0x0000005c9, 24, 1385}, {0x0000005ca, 24, 1386}, {0x0000005cb, 24, 1387},
+	{0x0000005cc, 24, 1388}, {0x0000005cd, 24, 1389}, {0x0000005ce, 24, 1390}, {0x0000005cf, 24, 1391},
+	{0x0000005d0, 24, 1392}, {0x0000005d1, 24, 1393}, {0x0000005d2, 24, 1394}, {0x0000005d3, 24, 1395},
+	{0x0000005d4, 24, 1396}, {0x0000005d5, 24, 1397}, {0x0000005d6, 24, 1398}, {0x0000005d7, 24, 1399},
+	{0x0000005d8, 24, 1400}, {0x0000005d9, 24, 1401}, {0x0000005da, 24, 1402}, {0x0000005db, 24, 1403},
+	{0x0000005dc, 24, 3}, {0x0000005dd, 24, 3}, {0x0000005de, 24, 1404}, {0x0000005df, 24, 1405},
+	{0x0000005e0, 24, 1406}, {0x0000005e1, 24, 1407}, {0x0000005e2, 24, 1408}, {0x0000005e3, 24, 1409},
+	{0x0000005e4, 24, 1410}, {0x0000005e5, 24, 1411}, {0x0000005e6, 24, 1412}, {0x0000005e7, 24, 1413},
+	{0x0000005e8, 24, 1414}, {0x0000005e9, 24, 1415}, {0x0000005ea, 24, 1416}, {0x0000005eb, 24, 1417},
+	{0x0000005ec, 24, 1418}, {0x0000005ed, 24, 1419}, {0x0000005ee, 24, 1420}, {0x0000005ef, 24, 1421},
+	{0x0000005f0, 24, 1422}, {0x0000005f1, 24, 1423}, {0x0000005f2, 24, 1424}, {0x0000005f3, 24, 1425},
+	{0x0000005f4, 24, 1426}, {0x0000005f5, 24, 1427}, {0x0000005f6, 24, 1428}, {0x0000005f7, 24, 1429},
+	{0x0000005f8, 24, 1430}, {0x0000005f9, 24, 1431}, {0x0000005fa, 24, 1432}, {0x0000005fb, 24, 1433},
+	{0x0000005fc, 24, 1434}, {0x0000005fd, 24, 1435}, {0x0000005fe, 24, 1436}, {0x0000005ff, 24, 1437},
+	{0x000000600, 24, 1438}, {0x000000601, 24, 1439}, {0x000000602, 24, 1440}, {0x000000603, 24, 1441},
+	{0x000000604, 24, 1442}, {0x000000605, 24, 1443}, {0x000000606, 24, 1444}, {0x000000607, 24, 1445},
+	{0x000000608, 24, 1446}, {0x000000609, 24, 1447}, {0x00000060a, 24, 1448}, {0x00000060b, 24, 406},
+	{0x00000060c, 24, 1449}, {0x00000060d, 24, 1450}, {0x00000060e, 24, 1451}, {0x00000060f, 24, 1452},
+	{0x000000610, 24, 1453}, {0x000000611, 24, 1454}, {0x000000612, 24, 1455}, {0x000000613, 24, 1456},
+	{0x000000614, 24, 1457}, {0x000000615, 24, 1458}, {0x000000616, 24, 1459}, {0x000000617, 24, 1460},
+	{0x000000618, 24, 1461}, {0x000000619, 24, 1462}, {0x00000061a, 24, 1463}, {0x00000061b, 24, 1464},
+	{0x00000061c, 24, 1465}, {0x00000061d, 24, 1466}, {0x00000061e, 24, 1467}, {0x00000061f, 24, 1468},
+	{0x000000620, 24, 1469}, {0x000000621, 24, 1470}, {0x000000622, 24, 1471}, {0x000000623, 24, 1472},
+	{0x000000624, 24, 1473}, {0x000000625, 24, 1050}, {0x000000626, 24, 1474}, {0x000000627, 24, 1475},
+	{0x000000628, 24, 3}, {0x000000629, 24, 564}, {0x00000062a, 24, 3}, {0x00000062b, 24, 1476},
+	{0x00000062c, 24, 1477}, {0x00000062d, 24, 1478}, {0x00000062e, 24, 1479}, {0x00000062f, 24, 1480},
+	{0x000000630, 24, 1481}, {0x000000631, 24, 1482}, {0x000000632, 24, 1483}, {0x000000633, 24, 1484},
+	{0x000000634, 24, 1485}, {0x000000635, 24, 1486}, {0x000000636, 24, 1487}, {0x000000637, 24, 1488},
+	{0x000000638, 24, 1489}, {0x000000639, 24, 1490}, {0x00000063a, 24, 1491}, {0x00000063b, 24, 1492},
+	{0x00000063c, 24, 1493}, {0x00000063d, 24, 1494}, {0x00000063e, 24, 1495}, {0x00000063f, 24, 1496},
+	{0x000000640, 24, 1497}, {0x000000641, 24, 1498}, {0x000000642, 24, 1499}, {0x000000643, 24, 1500},
+	{0x000000644, 24, 1501}, {0x000000645, 24, 1502}, {0x000000646, 24, 1503}, {0x000000647, 24, 1504},
+	{0x000000648, 24, 1505}, {0x000000649, 24, 1506}, {0x00000064a, 24, 1507}, {0x00000064b, 24, 1508},
+	{0x00000064c, 24, 1509}, {0x00000064d, 24, 1510}, {0x00000064e, 24, 1511}, {0x00000064f, 24, 1512},
+	{0x000000650, 24, 1513}, {0x000000651, 24, 1514}, {0x000000652, 24, 3}, {0x000000653, 24, 3},
+	{0x000000654, 24, 1515}, {0x000000655, 24, 1516}, {0x000000656, 24, 1517}, {0x000000657, 24, 1518},
+	{0x000000658, 24, 1519}, {0x000000659, 24, 1520}, {0x00000065a, 24, 1521}, {0x00000065b, 24, 1522},
+	{0x00000065c, 24, 1523}, {0x00000065d, 24, 1524}, {0x00000065e, 24, 1525}, {0x00000065f, 24, 1526},
+	{0x000000660, 24, 1527}, {0x000000661, 24, 1528}, {0x000000662, 24, 1529}, {0x000000663, 24, 1530},
+	{0x000000664, 24, 1531}, {0x000000665, 24, 1532}, {0x000000666, 24, 1533}, {0x000000667, 24, 1534},
+	{0x000000668, 24, 1535}, {0x000000669, 24, 1536}, {0x00000066a, 24, 1537}, {0x00000066b, 24, 1538},
+	{0x00000066c, 24, 1539}, {0x00000066d, 24, 1540}, {0x00000066e, 24, 1541}, {0x00000066f, 24, 1542},
+	{0x000000670, 24, 1543}, {0x000000671, 24, 1544}, {0x000000672, 24, 1545}, {0x000000673, 24, 1546},
+	{0x000000674, 24, 1547}, {0x000000675, 24, 1548}, {0x000000676, 24, 1549}, {0x000000677, 24, 1550},
+	{0x000000678, 24, 1551}, {0x000000679, 24, 1552}, {0x00000067a, 24, 1553}, {0x00000067b, 24, 1554},
+	{0x00000067c, 24, 3}, {0x00000067d, 24, 1555}, {0x00000067e, 24, 1556}, {0x00000067f, 24, 1557},
+	{0x000000680, 24, 1558}, {0x000000681, 24, 1559}, {0x000000682, 24, 1560}, {0x000000683, 24, 1561},
+	{0x000000684, 24, 1562}, {0x000000685, 24, 1563}, {0x000000686, 24, 1564}, {0x000000687, 24, 1565},
+	{0x000000688, 24, 1566}, {0x000000689, 24, 1567}, {0x00000068a, 24, 1568}, {0x00000068b, 24, 1569},
+	{0x00000068c, 24, 1570}, {0x00000068d, 24, 1571}, {0x00000068e, 24, 1572}, {0x00000068f, 24, 1573},
+	{0x000000690, 24, 1574}, {0x000000691, 24, 1575}, {0x000000692, 24, 1576}, {0x000000693, 24, 1577},
+	{0x000000694, 24, 1578}, {0x000000695, 24, 1579}, {0x000000696, 24, 1580}, {0x000000697, 24, 1581},
+	{0x000000698, 24, 1582}, {0x000000699, 24, 1583}, {0x00000069a, 24, 1584}, {0x00000069b, 24, 1585},
+	{0x00000069c, 24, 1586}, {0x00000069d, 24, 1587}, {0x00000069e, 24, 1588}, {0x00000069f, 24, 1589},
+	{0x0000006a0, 24, 1590}, {0x0000006a1, 24, 1591}, {0x0000006a2, 24, 1592}, {0x0000006a3, 24, 1593},
+	{0x0000006a4, 24, 1594}, {0x0000006a5, 24, 1595}, {0x0000006a6, 24, 1596}, {0x0000006a7, 24, 1597},
+	{0x0000006a8, 24, 1598}, {0x0000006a9, 24, 1599}, {0x0000006aa, 24, 1600}, {0x0000006ab, 24, 1601},
+	{0x0000006ac, 24, 1602}, {0x0000006ad, 24, 1603}, {0x0000006ae, 24, 1604}, {0x0000006af, 24, 1605},
+	{0x0000006b0, 24, 1606}, {0x0000006b1, 24, 1607}, {0x0000006b2, 24, 1608}, {0x0000006b3, 24, 1609},
+	{0x0000006b4, 24, 1610}, {0x0000006b5, 24, 1611}, {0x0000006b6, 24, 1612}, {0x0000006b7, 24, 1613},
+	{0x0000006b8, 24, 1614}, {0x0000006b9, 24, 1615}, {0x0000006ba, 24, 1616}, {0x0000006bb, 24, 1617},
+	{0x0000006bc, 24, 1618}, {0x0000006bd, 24, 1619}, {0x0000006be, 24, 1620}, {0x0000006bf, 24, 1621},
+	{0x0000006c0, 24, 1622}, {0x0000006c1, 24, 3}, {0x0000006c2, 24, 1623}, {0x0000006c3, 24, 1624},
+	{0x0000006c4, 24, 1625}, {0x0000006c5, 24, 1626}, {0x0000006c6, 24, 1627}, {0x0000006c7, 24, 1628},
+	{0x0000006c8, 24, 1629}, {0x0000006c9, 24, 1630}, {0x0000006ca, 24, 1631}, {0x0000006cb, 24, 1632},
+	{0x0000006cc, 24, 1633}, {0x0000006cd, 24, 1634}, {0x0000006ce, 24, 1635}, {0x0000006cf, 24, 1636},
+	{0x0000006d0, 24, 1637}, {0x0000006d1, 24, 1638}, {0x0000006d2, 24, 1639}, {0x0000006d3, 24, 1640},
+	{0x0000006d4, 24, 1641}, {0x0000006d5, 24, 1642}, {0x0000006d6, 24, 3}, {0x0000006d7, 24, 3},
+	{0x0000006d8, 24, 1643}, {0x0000006d9, 24, 1644}, {0x0000006da, 24, 1645}, {0x0000006db, 24, 1646},
+	{0x0000006dc, 24, 1647}, {0x0000006dd, 24, 1648}, {0x0000006de, 24, 1649}, {0x0000006df, 24, 1650},
+	{0x0000006e0, 24, 1651}, {0x0000006e1, 24, 1652}, {0x0000006e2, 24, 1653}, {0x0000006e3, 24, 1654},
+	{0x0000006e4, 24, 1655}, {0x0000006e5, 24, 1656}, {0x0000006e6, 24, 1657}, {0x0000006e7, 24, 1658},
+	{0x0000006e8, 24, 1659}, {0x0000006e9, 24, 1660}, {0x0000006ea, 24, 1661}, {0x0000006eb, 24, 1662},
+	{0x0000006ec, 24, 1663}, {0x0000006ed, 24, 1664}, {0x0000006ee, 24, 1665}, {0x0000006ef, 24, 1666},
+	{0x0000006f0, 24, 1557}, {0x0000006f1, 24, 1667}, {0x0000006f2, 24, 1668}, {0x0000006f3, 24, 1669},
+	{0x0000006f4, 24, 1670}, {0x0000006f5, 24, 1671}, {0x0000006f6, 24, 3}, {0x0000006f7, 24, 1671},
+	{0x0000006f8, 24, 1672}, {0x0000006f9, 24, 1673}, {0x0000006fa, 24, 1674}, {0x0000006fb, 24, 1675},
+	{0x0000006fc, 24, 1676}, {0x0000006fd, 24, 1677}, {0x0000006fe, 24, 1678}, {0x0000006ff, 24, 1679},
+	{0x000000700, 24, 1680}, {0x000000701, 24, 1681}, {0x000000702, 24, 1682}, {0x000000703, 24, 1683},
+	{0x000000704, 24, 1671}, {0x000000705, 24, 1684}, {0x000000706, 24, 1685}, {0x000000707, 24, 1686},
+	{0x000000708, 24, 1687}, {0x000000709, 24, 1688}, {0x00000070a, 24, 1689}, {0x00000070b, 24, 1690},
+	{0x00000070c, 24, 1691}, {0x00000070d, 24, 3}, {0x00000070e, 24, 3}, {0x00000070f, 24, 1692},
+	{0x000000710, 24, 1693}, {0x000000711, 24, 1694}, {0x000000712, 24, 1695}, {0x000000713, 24, 1696},
+	{0x000000714, 24, 1697}, {0x000000715, 24, 1698}, {0x000000716, 24, 1699}, {0x000000717, 24, 1700},
+	{0x000000718, 24, 1701}, {0x000000719, 24, 1702}, {0x00000071a, 24, 1703}, {0x00000071b, 24, 1704},
+	{0x00000071c, 24, 1705}, {0x00000071d, 24, 1706}, {0x00000071e, 24, 1707}, {0x00000071f, 24, 1708},
+	{0x000000720, 24, 1709}, {0x000000721, 24, 1710}, {0x000000722, 24, 1711}, {0x000000723, 24, 1712},
+	{0x000000724, 24, 1713}, {0x000000725, 24, 1714}, {0x000000726, 24, 1715}, {0x000000727, 24, 1716},
+	{0x000000728, 24, 1717}, {0x000000729, 24, 1718}, {0x00000072a, 24, 1719}, {0x00000072b, 24, 1720},
+	{0x00000072c, 24, 1721}, {0x00000072d, 24, 1722}, {0x00000072e, 24, 1723}, {0x00000072f, 24, 1724},
+	{0x000000730, 24, 1725}, {0x000000731, 24, 1726}, {0x000000732, 24, 1727}, {0x000000733, 24, 1728},
+	{0x000000734, 24, 1729}, {0x000000735, 24, 1730}, {0x000000736, 24, 1731}, {0x000000737, 24, 1732},
+	{0x000000738, 24, 1733}, {0x000000739, 24, 1734}, {0x00000073a, 24, 1735}, {0x00000073b, 24, 1736},
+	{0x00000073c, 24, 1737}, {0x00000073d, 24, 1738}, {0x00000073e, 24, 1739}, {0x00000073f, 24, 1740},
+	{0x000000740, 24, 1741}, {0x000000741, 24, 1742}, {0x000000742, 24, 1743}, {0x000000743, 24, 1744},
+	{0x000000744, 24, 1745}, {0x000000745, 24, 1746}, {0x000000746, 24, 1747}, {0x000000747, 24, 1748},
+	{0x000000748, 24, 1749}, {0x000000749, 24, 1750}, {0x00000074a, 24, 1751}, {0x00000074b, 24, 1752},
+	{0x00000074c, 24, 1753}, {0x00000074d, 24, 1754}, {0x00000074e, 24, 1755}, {0x00000074f, 24, 3},
+	{0x000000750, 24, 3}, {0x000000751, 24, 1756}, {0x000000752, 24, 1757}, {0x000000753, 24, 1758},
+	{0x000000754, 24, 1759}, {0x000000755, 24, 1760}, {0x000000756, 24, 1761}, {0x000000757, 24, 1762},
+	{0x000000758, 24, 1763}, {0x000000759, 24, 1764}, {0x00000075a, 24, 1765}, {0x00000075b, 24, 1766},
+	{0x00000075c, 24, 1767}, {0x00000075d, 24, 1768}, {0x00000075e, 24, 1769}, {0x00000075f, 24, 1770},
+	{0x000000760, 24, 1771}, {0x000000761, 24, 1772}, {0x000000762, 24, 1773}, {0x000000763, 24, 1774},
+	{0x000000764, 24, 1775}, {0x000000765, 24, 1776}, {0x000000766, 24, 1777}, {0x000000767, 24, 1778},
+	{0x000000768, 24, 1779}, {0x000000769, 24, 1780}, {0x00000076a, 24, 1781}, {0x00000076b, 24, 1782},
+	{0x00000076c, 24, 1783}, {0x00000076d, 24, 1784}, {0x00000076e, 24, 1785}, {0x00000076f, 24, 1786},
+	{0x000000770, 24, 1787}, {0x000000771, 24, 1788}, {0x000000772, 24, 1789}, {0x000000773, 24, 1790},
+	{0x000000774, 24, 1791}, {0x000000775, 24, 1792}, {0x000000776, 24, 1793}, {0x000000777, 24, 1794},
+	{0x000000778, 24, 1795}, {0x000000779, 24, 1796}, {0x00000077a, 24, 1797}, {0x00000077b, 24, 1798},
+	{0x00000077c, 24, 1799}, {0x00000077d, 24, 3}, {0x00000077e, 24, 1800}, {0x00000077f, 24, 1801},
+	{0x000000780, 24, 1802}, {0x000000781, 24, 1803}, {0x000000782, 24, 116}, {0x000000783, 24, 1804},
+	{0x000000784, 24, 3}, {0x000000785, 24, 3}, {0x000000786, 24, 1805}, {0x000000787, 24, 1806},
+	{0x000000788, 24, 1807}, {0x000000789, 24, 1808}, {0x00000078a, 24, 1809}, {0x00000078b, 24, 1810},
+	{0x00000078c, 24, 1811}, {0x00000078d, 24, 1812}, {0x00000078e, 24, 1813}, {0x00000078f, 24, 1814},
+	{0x000000790, 24, 1815}, {0x000000791, 24, 1816}, {0x000000792, 24, 1817}, {0x000000793, 24, 1818},
+	{0x000000794, 24, 1819}, {0x000000795, 24, 1820}, {0x000000796, 24, 1821}, {0x000000797, 24, 1822},
+	{0x000000798, 24, 1823}, {0x000000799, 24, 1824}, {0x00000079a, 24, 1825}, {0x00000079b, 24, 1826},
+	{0x00000079c, 24, 1827}, {0x00000079d, 24, 1828}, {0x00000079e, 24, 1829}, {0x00000079f, 24, 1830},
+	{0x0000007a0, 24, 1831}, {0x0000007a1, 24, 1832}, {0x0000007a2, 24, 1833}, {0x0000007a3, 24, 1834},
+	{0x0000007a4, 24, 1835}, {0x0000007a5, 24, 1836}, {0x0000007a6, 24, 1837}, {0x0000007a7, 24, 1838},
+	{0x0000007a8, 24, 1839}, {0x0000007a9, 24, 1840}, {0x0000007aa, 24, 1841}, {0x0000007ab, 24, 1842},
+	{0x0000007ac, 24, 1843}, {0x0000007ad, 24, 1844}, {0x0000007ae, 24, 1845}, {0x0000007af, 24, 1846},
+	{0x0000007b0, 24, 1847}, {0x0000007b1, 24, 1848}, {0x0000007b2, 24, 1849}, {0x0000007b3, 24, 3},
+	{0x0000007b4, 24, 3}, {0x0000007b5, 24, 1850}, {0x0000007b6, 24, 1851}, {0x0000007b7, 24, 1852},
+	{0x0000007b8, 24, 1853}, {0x0000007b9, 24, 1854}, {0x0000007ba, 24, 1855}, {0x0000007bb, 24, 1856},
+	{0x0000007bc, 24, 1857}, {0x0000007bd, 24, 1858}, {0x0000007be, 24, 1859}, {0x0000007bf, 24, 1860},
+	{0x0000007c0, 24, 1861}, {0x0000007c1, 24, 1862}, {0x0000007c2, 24, 1863}, {0x0000007c3, 24, 1864},
+	{0x0000007c4, 24, 1865}, {0x0000007c5, 24, 1866}, {0x0000007c6, 24, 1867}, {0x0000007c7, 24, 1868},
+	{0x0000007c8, 24, 1869}, {0x0000007c9, 24, 1870}, {0x0000007ca, 24, 1871}, {0x0000007cb, 24, 1872},
+	{0x0000007cc, 24, 1873}, {0x0000007cd, 24, 1874}, {0x0000007ce, 24, 1875}, {0x0000007cf, 24, 1876},
+	{0x0000007d0, 24, 1877}, {0x0000007d1, 24, 1878}, {0x0000007d2, 24, 1879}, {0x0000007d3, 24, 1880},
+	{0x0000007d4, 24, 1881}, {0x0000007d5, 24, 1882}, {0x0000007d6, 24, 1883}, {0x0000007d7, 24, 1884},
+	{0x0000007d8, 24, 1885}, {0x0000007d9, 24, 1886}, {0x0000007da, 24, 1887}, {0x0000007db, 24, 1888},
+	{0x0000007dc, 24, 1889}, {0x0000007dd, 24, 1890}, {0x0000007de, 24, 1891}, {0x0000007df, 24, 1892},
+	{0x0000007e0, 24, 1893}, {0x0000007e1, 24, 1894}, {0x0000007e2, 24, 1895}, {0x0000007e3, 24, 1896},
+	{0x0000007e4, 24, 1897}, {0x0000007e5, 24, 1898}, {0x0000007e6, 24, 1899}, {0x0000007e7, 24, 1900},
+	{0x0000007e8, 24, 1901}, {0x0000007e9, 24, 652}, {0x0000007ea, 24, 1902}, {0x0000007eb, 24, 3},
+	{0x0000007ec, 24, 3}, {0x0000007ed, 24, 1903}, {0x0000007ee, 24, 1904}, {0x0000007ef, 24, 1905},
+	{0x0000007f0, 24, 1906}, {0x0000007f1, 24, 1907}, {0x0000007f2, 24, 1908}, {0x0000007f3, 24, 1909},
+	{0x0000007f4, 24, 1910}, {0x0000007f5, 24, 1911}, {0x0000007f6, 24, 1912}, {0x0000007f7, 24, 1913},
+	{0x0000007f8, 24, 1914}, {0x0000007f9, 24, 1915}, {0x0000007fa, 24, 1916}, {0x0000007fb, 24, 1917},
+	{0x0000007fc, 24, 1918}, {0x0000007fd, 24, 1919}, {0x0000007fe, 24, 1920}, {0x0000007ff, 24, 1921},
+	{0x000000800, 24, 1922}, {0x000000801, 24, 1923}, {0x000000802, 24, 459}, {0x000000803, 24, 1924},
+	{0x000000804, 24, 1925}, {0x000000805, 24, 1926}, {0x000000806, 24, 1927}, {0x000000807, 24, 1928},
+	{0x000000808, 24, 1929}, {0x000000809, 24, 1930}, {0x00000080a, 24, 1931}, {0x00000080b, 24, 1932},
+	{0x00000080c, 24, 1933}, {0x00000080d, 24, 1934}, {0x00000080e, 24, 185}, {0x00000080f, 24, 1935},
+	{0x000000810, 24, 1936}, {0x000000811, 24, 1937}, {0x000000812, 24, 1938}, {0x000000813, 24, 1939},
+	{0x000000814, 24, 1940}, {0x000000815, 24, 1941}, {0x000000816, 24, 1942}, {0x000000817, 24, 1943},
+	{0x000000818, 24, 1944}, {0x000000819, 24, 1945}, {0x00000081a, 24, 1946}, {0x00000081b, 24, 1947},
+	{0x00000081c, 24, 1948}, {0x00000081d, 24, 1949}, {0x00000081e, 24, 1950}, {0x00000081f, 24, 1951},
+	{0x000000820, 24, 3}, {0x000000821, 24, 3}, {0x000000822, 24, 1952}, {0x000000823, 24, 1953},
+	{0x000000824, 24, 1954}, {0x000000825, 24, 1955}, {0x000000826, 24, 1956}, {0x000000827, 24, 1957},
+	{0x000000828, 24, 1958}, {0x000000829, 24, 1959}, {0x00000082a, 24, 1960}, {0x00000082b, 24, 1961},
+	{0x00000082c, 24, 1962}, {0x00000082d, 24, 1963}, {0x00000082e, 24, 1964}, {0x00000082f, 24, 3},
+	{0x000000830, 24, 3}, {0x000000831, 24, 3}, {0x000000832, 24, 1965}, {0x00000084e, 24, 1966},
+	{0x00000084f, 24, 1967}, {0x000000850, 24, 1968}, {0x000000851, 24, 1969}, {0x000000852, 24, 1970},
+	{0x000000853, 24, 1971}, {0x000000854, 24, 1972}, {0x000000855, 24, 1973}, {0x000000856, 24, 1974},
+	{0x000000857, 24, 1975}, {0x000000858, 24, 1976}, {0x000000859, 24, 1977}, {0x00000085a, 24, 1978},
+	{0x00000085b, 24, 1979}, {0x00000085c, 24, 1980}, {0x00000085d, 24, 1981}, {0x00000085e, 24, 1982},
+	{0x00000085f, 24, 1983}, {0x000000860, 24, 1984}, {0x000000861, 24, 1985}, {0x000000862, 24, 1986},
+	{0x000000863, 24, 1987}, {0x000000864, 24, 1988}, {0x000000865, 24, 1989}, {0x000000866, 24, 1990},
+	{0x000000867, 24, 1991}, {0x000000868, 24, 1992}, {0x000000869, 24, 1993}, {0x00000086a, 24, 1994},
+	{0x00000086b, 24, 1995}, {0x00000086c, 24, 1996}, {0x00000086d, 24, 1997}, {0x00000086e, 24, 1998},
+	{0x00000086f, 24, 1999}, {0x000000870, 24, 2000}, {0x000000871, 24, 2001}, {0x000000872, 24, 2002},
+	{0x000000873, 24, 2003}, {0x000000874, 24, 1522}, {0x000000875, 24, 2004}, {0x000000876, 24, 2005},
+	{0x000000877, 24, 2006}, {0x000000878, 24, 2007}, {0x000000879, 24, 2008}, {0x00000087a, 24, 2009},
+	{0x00000087b, 24, 2010}, {0x00000087c, 24, 3}, {0x00000087d, 24, 3}, {0x00000087e, 24, 2011},
+	{0x00000087f, 24, 2012}, {0x000000880, 24, 2013}, {0x000000881, 24, 2014}, {0x000000882, 24, 2015},
+	{0x000000883, 24, 459}, {0x000000884, 24, 2016}, {0x000000885, 24, 2017}, {0x000000886, 24, 2018},
+	{0x000000887, 24, 2019}, {0x000000888, 24, 2020}, {0x000000889, 24, 2021}, {0x00000088a, 24, 2022},
+	{0x00000088b, 24, 2023}, {0x00000088c, 24, 2024}, {0x00000088d, 24, 2025}, {0x00000088e, 24, 2026},
+	{0x00000088f, 24, 2027}, {0x000000890, 24, 2028}, {0x000000891, 24, 2029}, {0x000000892, 24, 2030},
+	{0x000000893, 24, 2031}, {0x000000894, 24, 2032}, {0x000000895, 24, 2033}, {0x000000896, 24, 2034},
+	{0x000000897, 24, 2035}, {0x000000898, 24, 2036}, {0x000000899, 24, 2037}, {0x00000089a, 24, 2038},
+	{0x00000089b, 24, 2039}, {0x00000089c, 24, 2040}, {0x00000089d, 24, 2041}, {0x00000089e, 24, 2042},
+	{0x00000089f, 24, 2043}, {0x0000008a0, 24, 2044}, {0x0000008a1, 24, 2045}, {0x0000008a2, 24, 2046},
+	{0x0000008a3, 24, 3}, {0x0000008a4, 24, 3}, {0x0000008a5, 24, 2047}, {0x0000008a6, 24, 2048},
+	{0x0000008a7, 24, 2049}, {0x0000008a8, 24, 2050}, {0x0000008a9, 24, 2051}, {0x0000008aa, 24, 2052},
+	{0x0000008ab, 24, 2053}, {0x0000008ac, 24, 2054}, {0x0000008ad, 24, 2055}, {0x0000008ae, 24, 2056},
+	{0x0000008af, 24, 2057}, {0x0000008b0, 24, 2058}, {0x0000008b1, 24, 2059}, {0x0000008b2, 24, 2060},
+	{0x0000008b3, 24, 2061}, {0x0000008b4, 24, 2062}, {0x0000008b5, 24, 2063}, {0x0000008b6, 24, 2064},
+	{0x0000008b7, 24, 2065}, {0x0000008b8, 24, 2066}, {0x0000008b9, 24, 2067}, {0x0000008ba, 24, 2068},
+	{0x0000008bb, 24, 2069}, {0x0000008bc, 24, 2070}, {0x0000008bd, 24, 2071}, {0x0000008be, 24, 2072},
+	{0x0000008bf, 24, 2073}, {0x0000008c0, 24, 2074}, {0x0000008c1, 24, 2075}, {0x0000008c2, 24, 3},
+	{0x0000008c3, 24, 2076}, {0x0000008c4, 24, 2077}, {0x0000008c5, 24, 2078}, {0x0000008c6, 24, 2079},
+	{0x0000008c7, 24, 459}, {0x0000008c8, 24, 2080}, {0x0000008c9, 24, 2081}, {0x0000008ca, 24, 2082},
+	{0x0000008cb, 24, 2083}, {0x0000008cc, 24, 2084}, {0x0000008cd, 24, 2085}, {0x0000008ce, 24, 2086},
+	{0x0000008cf, 24, 2087}, {0x0000008d0, 24, 2088}, {0x0000008d1, 24, 2089}, {0x0000008d2, 24, 2090},
+	{0x0000008d3, 24, 2091}, {0x0000008d4, 24, 2092}, {0x0000008d5, 24, 2093}, {0x0000008d6, 24, 2094},
+	{0x0000008d7, 24, 2095}, {0x0000008d8, 24, 2096}, {0x0000008d9, 24, 2097}, {0x0000008da, 24, 2098},
+	{0x0000008db, 24, 2099}, {0x0000008dc, 24, 2100}, {0x0000008dd, 24, 2101}, {0x0000008de, 24, 2102},
+	{0x0000008df, 24, 2103}, {0x0000008e0, 24, 2104}, {0x0000008e1, 24, 2105}, {0x0000008e2, 24, 3},
+	{0x0000008e3, 24, 3}, {0x0000008e4, 24, 2106}, {0x0000008e5, 24, 2107}, {0x0000008e6, 24, 2108},
+	{0x0000008e7, 24, 2109}, {0x0000008e8, 24, 2110}, {0x0000008e9, 24, 2111}, {0x0000008ea, 24, 2112},
+	{0x0000008eb, 24, 2113}, {0x0000008ec, 24, 2114}, {0x0000008ed, 24, 2115}, {0x0000008ee, 24, 2116},
+	{0x0000008ef, 24, 2117}, {0x0000008f0, 24, 2118}, {0x0000008f1, 24, 2119}, {0x0000008f2, 24, 2120},
+	{0x0000008f3, 24, 2121}, {0x0000008f4, 24, 2122}, {0x0000008f5, 24, 2123}, {0x0000008f6, 24, 2124},
+	{0x0000008f7, 24, 2125}, {0x0000008f8, 24, 2126}, {0x0000008f9, 24, 406}, {0x0000008fa, 24, 2127},
+	{0x0000008fb, 24, 2128}, {0x0000008fc, 24, 2129}, {0x0000008fd, 24, 2130}, {0x0000008fe, 24, 2131},
+	{0x0000008ff, 24, 2132}, {0x000000900, 24, 2133}, {0x000000901, 24, 2134}, {0x000000902, 24, 2135},
+	{0x000000903, 24, 2136}, {0x000000904, 24, 2137}, {0x000000905, 24, 2138}, {0x000000906, 24, 2139},
+	{0x000000907, 24, 2140}, {0x000000908, 24, 2141}, {0x000000909, 24, 2142}, {0x00000090a, 24, 2143},
+	{0x00000090b, 24, 2144}, {0x00000090c, 24, 2145}, {0x00000090d, 24, 2146}, {0x00000090e, 24, 2147},
+	{0x00000090f, 24, 2148}, {0x000000910, 24, 2149}, {0x000000911, 24, 3}, {0x000000912, 24, 3},
+	{0x000000913, 24, 2150}, {0x000000914, 24, 2151}, {0x000000915, 24, 2152}, {0x000000916, 24, 2153},
+	{0x000000917, 24, 2154}, {0x000000918, 24, 2155}, {0x000000919, 24, 2156}, {0x00000091a, 24, 2157},
+	{0x00000091b, 24, 2158}, {0x00000091c, 24, 2159}, {0x00000091d, 24, 2160}, {0x00000091e, 24, 2161},
+	{0x00000091f, 24, 2162}, {0x000000920, 24, 2163}, {0x000000921, 24, 2164}, {0x000000922, 24, 2165},
+	{0x000000923, 24, 2166}, {0x000000924, 24, 2167}, {0x000000925, 24, 2168}, {0x000000926, 24, 2169},
+	{0x000000927, 24, 2170}, {0x000000928, 24, 2171}, {0x000000929, 24, 2172}, {0x00000092a, 24, 2173},
+	{0x00000092b, 24, 2174}, {0x00000092c, 24, 2175}, {0x00000092d, 24, 2176}, {0x00000092e, 24, 2177},
+	{0x00000092f, 24, 2178}, {0x000000930, 24, 2179}, {0x000000931, 24, 2180}, {0x000000932, 24, 2181},
+	{0x000000933, 24, 2182}, {0x000000934, 24, 2183}, {0x000000935, 24, 2184}, {0x000000936, 24, 2185},
+	{0x000000937, 24, 2186}, {0x000000938, 24, 2187}, {0x000000939, 24, 2188}, {0x00000093a, 24, 2189},
+	{0x00000093b, 24, 2190}, {0x00000093c, 24, 2191}, {0x00000093d, 24, 2192}, {0x00000093e, 24, 2193},
+	{0x00000093f, 24, 2194}, {0x000000940, 24, 2195}, {0x000000941, 24, 2196}, {0x000000942, 24, 2197},
+	{0x000000943, 24, 3}, {0x000000944, 24, 3}, {0x000000945, 24, 2198}, {0x000000946, 24, 2199},
+	{0x000000947, 24, 2200}, {0x000000948, 24, 2201}, {0x000000949, 24, 2202}, {0x00000094a, 24, 2203},
+	{0x00000094b, 24, 2204}, {0x00000094c, 24, 2205}, {0x00000094d, 24, 2206}, {0x00000094e, 24, 2207},
+	{0x00000094f, 24, 2208}, {0x000000950, 24, 2209}, {0x000000951, 24, 2210}, {0x000000952, 24, 2211},
+	{0x000000953, 24, 2212}, {0x000000954, 24, 2213}, {0x000000955, 24, 2214}, {0x000000956, 24, 2215},
+	{0x000000957, 24, 2216}, {0x000000958, 24, 2217}, {0x000000959, 24, 2218}, {0x00000095a, 24, 2219},
+	{0x00000095b, 24, 2220}, {0x00000095c, 24, 2221}, {0x00000095d, 24, 2222}, {0x00000095e, 24, 2223},
+	{0x00000095f, 24, 2224}, {0x000000960, 24, 2225}, {0x000000961, 24, 2226}, {0x000000962, 24, 2227},
+	{0x000000963, 24, 2228}, {0x000000964, 24, 2229}, {0x000000965, 24, 2230}, {0x000000966, 24, 2231},
+	{0x000000967, 24, 2232}, {0x000000968, 24, 2233}, {0x000000969, 24, 2234}, {0x00000096a, 24, 2235},
+	{0x00000096b, 24, 564}, {0x00000096c, 24, 2236}, {0x00000096d, 24, 2237}, {0x00000096e, 24, 2238},
+	{0x00000096f, 24, 2239}, {0x000000970, 24, 2240}, {0x000000971, 24, 2241}, {0x000000972, 24, 2242},
+	{0x000000973, 24, 2243}, {0x000000974, 24, 2244}, {0x000000975, 24, 2245}, {0x000000976, 24, 2246},
+	{0x000000977, 24, 2247}, {0x000000978, 24, 2248}, {0x000000979, 24, 2249}, {0x00000097a, 24, 2250},
+	{0x00000097b, 24, 3}, {0x00000097c, 24, 3}, {0x00000097d, 24, 2251}, {0x00000097e, 24, 2252},
+	{0x00000097f, 24, 2253}, {0x000000980, 24, 2254}, {0x000000981, 24, 2255}, {0x000000982, 24, 2256},
+	{0x000000983, 24, 2257}, {0x000000984, 24, 2258}, {0x000000985, 24, 2259}, {0x000000986, 24, 2260},
+	{0x000000987, 24, 2261}, {0x000000988, 24, 2262}, {0x000000989, 24, 2263}, {0x00000098a, 24, 2264},
+	{0x00000098b, 24, 2265}, {0x00000098c, 24, 2266}, {0x00000098d, 24, 2267}, {0x00000098e, 24, 2268},
+	{0x00000098f, 24, 2269}, {0x000000990, 24, 2270}, {0x000000991, 24, 2271}, {0x000000992, 24, 2272},
+	{0x000000993, 24, 2273}, {0x000000994, 24, 2274}, {0x000000995, 24, 2275}, {0x000000996, 24, 2276},
+	{0x000000997, 24, 108}, {0x000000998, 24, 2277}, {0x000000999, 24, 2278}, {0x00000099a, 24, 2279},
+	{0x00000099b, 24, 2280}, {0x00000099c, 24, 2281}, {0x00000099d, 24, 2282}, {0x00000099e, 24, 2283},
+	{0x00000099f, 24, 2284}, {0x0000009a0, 24, 2285}, {0x0000009a1, 24, 2286}, {0x0000009a2, 24, 2287},
+	{0x0000009a3, 24, 2288}, {0x0000009a4, 24, 2289}, {0x0000009a5, 24, 2290}, {0x0000009a6, 24, 2291},
+	{0x0000009a7, 24, 2292}, {0x0000009a8, 24, 2293}, {0x0000009a9, 24, 2294}, {0x0000009aa, 24, 2295},
+	{0x0000009ab, 24, 2296}, {0x0000009ac, 24, 2297}, {0x0000009ad, 24, 2298}, {0x0000009ae, 24, 2299},
+	{0x0000009af, 24, 2300}, {0x0000009b0, 24, 2301}, {0x0000009b1, 24, 2302}, {0x0000009b2, 24, 2303},
+	{0x0000009b3, 24, 2304}, {0x0000009b4, 24, 2305}, {0x0000009b5, 24, 2306}, {0x0000009b6, 24, 3},
+	{0x0000009b7, 24, 3}, {0x0000009b8, 24, 2307}, {0x0000009b9, 24, 2308}, {0x0000009ba, 24, 2309},
+	{0x0000009bb, 24, 2310}, {0x0000009bc, 24, 2311}, {0x0000009bd, 24, 2312}, {0x0000009be, 24, 2313},
+	{0x0000009bf, 24, 2314}, {0x0000009c0, 24, 2315}, {0x0000009c1, 24, 2316}, {0x0000009c2, 24, 2317},
+	{0x0000009c3, 24, 2318}, {0x0000009c4, 24, 2319}, {0x0000009c5, 24, 2320}, {0x0000009c6, 24, 2321},
+	{0x0000009c7, 24, 2322}, {0x0000009c8, 24, 2323}, {0x0000009c9, 24, 2324}, {0x0000009ca, 24, 2325},
+	{0x0000009cb, 24, 2326}, {0x0000009cc, 24, 2327}, {0x0000009cd, 24, 2328}, {0x0000009ce, 24, 2329},
+	{0x0000009cf, 24, 2330}, {0x0000009d0, 24, 2331}, {0x0000009d1, 24, 2332}, {0x0000009d2, 24, 2333},
+	{0x0000009d3, 24, 2334}, {0x0000009d4, 24, 2335}, {0x0000009d5, 24, 2336}, {0x0000009d6, 24, 2337},
+	{0x0000009d7, 24, 2338}, {0x0000009d8, 24, 2339}, {0x0000009d9, 24, 2340}, {0x0000009da, 24, 2341},
+	{0x0000009db, 24, 2342}, {0x0000009dc, 24, 2343}, {0x0000009dd, 24, 2344}, {0x0000009de, 24, 2345},
+	{0x0000009df, 24, 2346}, {0x0000009e0, 24, 2347}, {0x0000009e1, 24, 2348}, {0x0000009e2, 24, 2349},
+	{0x0000009e3, 24, 2350}, {0x0000009e4, 24, 2351}, {0x0000009e5, 24, 2352}, {0x0000009e6, 24, 2353},
+	{0x0000009e7, 24, 2354}, {0x0000009e8, 24, 3}, {0x0000009e9, 24, 3}, {0x0000009ea, 24, 2355},
+	{0x0000009eb, 24, 2356}, {0x0000009ec, 24, 2357}, {0x0000009ed, 24, 2358}, {0x0000009ee, 24, 2359},
+	{0x0000009ef, 24, 2360}, {0x0000009f0, 24, 2361}, {0x0000009f1, 24, 2362}, {0x0000009f2, 24, 2363},
+	{0x0000009f3, 24, 2364}, {0x0000009f4, 24, 2365}, {0x0000009f5, 24, 2366}, {0x0000009f6, 24, 2367},
+	{0x0000009f7, 24, 2368}, {0x0000009f8, 24, 2369}, {0x0000009f9, 24, 2370}, {0x0000009fb, 24, 2371},
+	{0x0000009fc, 24, 2372}, {0x0000009fd, 24, 2373}, {0x0000009fe, 24, 2374}, {0x0000009ff, 24, 2375},
+	{0x000000a00, 24, 2376}, {0x000000a01, 24, 2377}, {0x000000a02, 24, 2378}, {0x000000a03, 24, 2379},
+	{0x000000a04, 24, 2380}, {0x000000a05, 24, 2381}, {0x000000a06, 24, 2382}, {0x000000a07, 24, 2383},
+	{0x000000a08, 24, 2384}, {0x000000a09, 24, 2385}, {0x000000a0a, 24, 2386}, {0x000000a0b, 24, 2387},
+	{0x000000a0c, 24, 2388}, {0x000000a0d, 24, 2389}, {0x000000a0e, 24, 2390}, {0x000000a0f, 24, 2391},
+	{0x000000a10, 24, 2392}, {0x000000a11, 24, 2393}, {0x000000a12, 24, 2394}, {0x000000a13, 24, 2395},
+	{0x000000a14, 24, 2396}, {0x000000a15, 24, 2397}, {0x000000a16, 24, 2398}, {0x000000a17, 24, 2399},
+	{0x000000a18, 24, 2400}, {0x000000a19, 24, 2401}, {0x000000a1a, 24, 2402}, {0x000000a1b, 24, 2403},
+	{0x000000a1c, 24, 2404}, {0x000000a1d, 24, 2405}, {0x000000a1e, 24, 2406}, {0x000000a1f, 24, 2407},
+	{0x000000a20, 24, 2408}, {0x000000a21, 24, 2409}, {0x000000a22, 24, 2410}, {0x000000a23, 24, 2411},
+	{0x000000a24, 24, 2412}, {0x000000a25, 24, 2413}, {0x000000a26, 24, 2414}, {0x000000a27, 24, 865},
+	{0x000000a28, 24, 2415}, {0x000000a29, 24, 2416}, {0x000000a2a, 24, 2417}, {0x000000a2b, 24, 2418},
+	{0x000000a2c, 24, 2419}, {0x000000a2d, 24, 2420}, {0x000000a2e, 24, 2421}, {0x000000a2f, 24, 2422},
+	{0x000000a30, 24, 2273}, {0x000000a31, 24, 2423}, {0x000000a32, 24, 2424}, {0x000000a33, 24, 189},
+	{0x000000a34, 24, 2425}, {0x000000a35, 24, 2426}, {0x000000a36, 24, 2427}, {0x000000a37, 24, 2428},
+	{0x000000a38, 24, 2429}, {0x000000a39, 24, 2430}, {0x000000a3a, 24, 2431}, {0x000000a3b, 24, 2432},
+	{0x000000a3c, 24, 2433}, {0x000000a3d, 24, 2434}, {0x000000a3e, 24, 2435}, {0x000000a3f, 24, 2436},
+	{0x000000a40, 24, 2437}, {0x000000a41, 24, 3}, {0x000000a42, 24, 3}, {0x000000a43, 24, 2438},
+	{0x000000a44, 24, 2439}, {0x000000a45, 24, 2440}, {0x000000a46, 24, 2441}, {0x000000a47, 24, 2442},
+	{0x000000a48, 24, 2443}, {0x000000a49, 24, 444}, {0x000000a4a, 24, 2444}, {0x000000a4b, 24, 2445},
+	{0x000000a4c, 24, 2446}, {0x000000a4d, 24, 2447}, {0x000000a4e, 24, 2448}, {0x000000a4f, 24, 2449},
+	{0x000000a50, 24, 2450}, {0x000000a51, 24, 2451}, {0x000000a52, 24, 2452}, {0x000000a53, 24, 2453},
+	{0x000000a54, 24, 2454}, {0x000000a55, 24, 2455}, {0x000000a56, 24, 2456}, {0x000000a57, 24, 2457},
+	{0x000000a58, 24, 2458}, {0x000000a59, 24, 2459}, {0x000000a5a, 24, 2460}, {0x000000a5b, 24, 2461},
+	{0x000000a5c, 24, 2462}, {0x000000a5d, 24, 2463}, {0x000000a5e, 24, 2464}, {0x000000a5f, 24, 2465},
+	{0x000000a60, 24, 2466}, {0x000000a61, 24, 2467}, {0x000000a62, 24, 2468}, {0x000000a63, 24, 2469},
+	{0x000000a64, 24, 2470}, {0x000000a65, 24, 2471}, {0x000000a66, 24, 2472}, {0x000000a67, 24, 2473},
+	{0x000000a68, 24, 2474}, {0x000000a69, 24, 2475}, {0x000000a6a, 24, 2476}, {0x000000a6b, 24, 2477},
+	{0x000000a6c, 24, 2478}, {0x000000a6d, 24, 2479}, {0x000000a6e, 24, 2480}, {0x000000a6f, 24, 2481},
+	{0x000000a70, 24, 2482}, {0x000000a71, 24, 2483}, {0x000000a72, 24, 2484}, {0x000000a73, 24, 2485},
+	{0x000000a74, 24, 2486}, {0x000000a75, 24, 2487}, {0x000000a76, 24, 2488}, {0x000000a77, 24, 2489},
+	{0x000000a78, 24, 2490}, {0x000000a79, 24, 2491}, {0x000000a7a, 24, 2492}, {0x000000a7b, 24, 2493},
+	{0x000000a7c, 24, 2494}, {0x000000a7d, 24, 2495}, {0x000000a7e, 24, 2496}, {0x000000a7f, 24, 2497},
+	{0x000000a80, 24, 2498}, {0x000000a81, 24, 2499}, {0x000000a82, 24, 2500}, {0x000000a83, 24, 2501},
+	{0x000000a84, 24, 2502}, {0x000000a85, 24, 2503}, {0x000000a86, 24, 2504}, {0x000000a87, 24, 2505},
+	{0x000000a88, 24, 2506}, {0x000000a89, 24, 2507}, {0x000000a8a, 24, 3}, {0x000000a8b, 24, 3},
+	{0x000000a8c, 24, 2508}, {0x000000a8d, 24, 2509}, {0x000000a8e, 24, 2510}, {0x000000a8f, 24, 2511},
+	{0x000000a90, 24, 2512}, {0x000000a91, 24, 2513}, {0x000000a92, 24, 2514}, {0x000000a93, 24, 2515},
+	{0x000000a94, 24, 2516}, {0x000000a95, 24, 865}, {0x000000a96, 24, 2517}, {0x000000a97, 24, 2518},
+	{0x000000a98, 24, 2519}, {0x000000a99, 24, 2520}, {0x000000a9a, 24, 2521}, {0x000000a9b, 24, 2522},
+	{0x000000a9c, 24, 2523}, {0x000000a9d, 24, 2524}, {0x000000a9e, 24, 2525}, {0x000000a9f, 24, 2526},
+	{0x000000aa0, 24, 2527}, {0x000000aa1, 24, 2528}, {0x000000aa2, 24, 2529}, {0x000000aa3, 24, 2530},
+	{0x000000aa4, 24, 2531}, {0x000000aa5, 24, 2532}, {0x000000aa6, 24, 2533}, {0x000000aa7, 24, 2534},
+	{0x000000aa8, 24, 2535}, {0x000000aa9, 24, 2536}, {0x000000aaa, 24, 2537}, {0x000000aab, 24, 2538},
+	{0x000000aac, 24, 2539}, {0x000000aad, 24, 2540}, {0x000000aae, 24, 2541}, {0x000000aaf, 24, 2542},
+	{0x000000ab0, 24, 2543}, {0x000000ab1, 24, 2544}, {0x000000ab2, 24, 2545}, {0x000000ab3, 24, 2546},
+	{0x000000ab4, 24, 2547}, {0x000000ab5, 24, 2548}, {0x000000ab6, 24, 2549}, {0x000000ab7, 24, 3},
+	{0x000000ab8, 24, 3}, {0x000000ab9, 24, 2550}, {0x000000aba, 24, 2551}, {0x000000abb, 24, 2552},
+	{0x000000abc, 24, 2553}, {0x000000abd, 24, 2554}, {0x000000abe, 24, 2555}, {0x000000abf, 24, 2556},
+	{0x000000ac0, 24, 2557}, {0x000000ac1, 24, 2558}, {0x000000ac2, 24, 2559}, {0x000000ac3, 24, 2560},
+	{0x000000ac4, 24, 2561}, {0x000000ac5, 24, 2562}, {0x000000ac6, 24, 2563}, {0x000000ac7, 24, 2564},
+	{0x000000ac8, 24, 2565}, {0x000000ac9, 24, 2566}, {0x000000aca, 24, 2567}, {0x000000acb, 24, 2568},
+	{0x000000acc, 24, 2569}, {0x000000acd, 24, 2570}, {0x000000ace, 24, 2571}, {0x000000acf, 24, 2572},
+	{0x000000ad0, 24, 2573}, {0x000000ad1, 24, 2574}, {0x000000ad2, 24, 2575}, {0x000000ad3, 24, 2576},
+	{0x000000ad4, 24, 2577}, {0x000000ad5, 24, 2578}, {0x000000ad6, 24, 2579}, {0x000000ad7, 24, 2580},
+	{0x000000ad8, 24, 2581}, {0x000000ad9, 24, 2582}, {0x000000ada, 24, 2583}, {0x000000adb, 24, 2584},
+	{0x000000adc, 24, 2585}, {0x000000add, 24, 2586}, {0x000000ade, 24, 2587}, {0x000000adf, 24, 2588},
+	{0x000000ae0, 24, 2589}, {0x000000ae1, 24, 2590}, {0x000000ae2, 24, 2591}, {0x000000ae3, 24, 2592},
+	{0x000000ae4, 24, 2593}, {0x000000ae5, 24, 2594}, {0x000000ae6, 24, 1820}, {0x000000ae7, 24, 2595},
+	{0x000000ae8, 24, 2596}, {0x000000ae9, 24, 2597}, {0x000000aea, 24, 2598}, {0x000000aeb, 24, 2599},
+	{0x000000aec, 24, 2600}, {0x000000aed, 24, 2601}, {0x000000aee, 24, 2602}, {0x000000aef, 24, 2603},
+	{0x000000af0, 24, 2604}, {0x000000af1, 24, 2605}, {0x000000af2, 24, 2606}, {0x000000af3, 24, 3},
+	{0x000000af4, 24, 3}, {0x000000af5, 24, 2607}, {0x000000af6, 24, 2608}, {0x000000af7, 24, 2609},
+	{0x000000af8, 24, 2610}, {0x000000af9, 24, 2611}, {0x000000afa, 24, 2612}, {0x000000afb, 24, 2613},
+	{0x000000afc, 24, 2614}, {0x000000afd, 24, 2615}, {0x000000afe, 24, 2616}, {0x000000aff, 24, 2617},
+	{0x000000b00, 24, 2618}, {0x000000b01, 24, 2619}, {0x000000b02, 24, 2620}, {0x000000b03, 24, 2621},
+	{0x000000b04, 24, 2622}, {0x000000b05, 24, 2623}, {0x000000b06, 24, 185}, {0x000000b07, 24, 2624},
+	{0x000000b08, 24, 2625}, {0x000000b09, 24, 2626}, {0x000000b0a, 24, 2627}, {0x000000b0b, 24, 2628},
+	{0x000000b0c, 24, 2629}, {0x000000b0d, 24, 2630}, {0x000000b0e, 24, 2631}, {0x000000b0f, 24, 2632},
+	{0x000000b10, 24, 2633}, {0x000000b11, 24, 2634}, {0x000000b12, 24, 2635}, {0x000000b13, 24, 2636},
+	{0x000000b14, 24, 2637}, {0x000000b15, 24, 2638}, {0x000000b16, 24, 2639}, {0x000000b17, 24, 2640},
+	{0x000000b18, 24, 99}, {0x000000b19, 24, 2641}, {0x000000b1a, 24, 2642}, {0x000000b1b, 24, 2643},
+	{0x000000b1c, 24, 2644}, {0x000000b1d, 24, 2645}, {0x000000b1e, 24, 2646}, {0x000000b1f, 24, 2647},
+	{0x000000b20, 24, 2648}, {0x000000b21, 24, 2649}, {0x000000b22, 24, 2650}, {0x000000b23, 24, 2651},
+	{0x000000b24, 24, 2652}, {0x000000b25, 24, 2653}, {0x000000b26, 24, 2654}, {0x000000b27, 24, 2655},
+	{0x000000b28, 24, 2656}, {0x000000b29, 24, 2657}, {0x000000b2a, 24, 2658}, {0x000000b2b, 24, 2659},
+	{0x000000b2c, 24, 2660}, {0x000000b2d, 24, 2661}, {0x000000b2e, 24, 2662}, {0x000000b2f, 24, 2663},
+	{0x000000b30, 24, 2664}, {0x000000b31, 24, 2665}, {0x000000b32, 24, 2666}, {0x000000b33, 24, 2667},
+	{0x000000b34, 24, 2668}, {0x000000b35, 24, 2669}, {0x000000b36, 24, 2670}, {0x000000b37, 24, 2671},
+	{0x000000b38, 24, 2672}, {0x000000b39, 24, 2673}, {0x000000b3a, 24, 2674}, {0x000000b3b, 24, 2675},
+	{0x000000b3c, 24, 2676}, {0x000000b3d, 24, 2677}, {0x000000b3e, 24, 2678}, {0x000000b3f, 24, 2679},
+	{0x000000b40, 24, 2680}, {0x000000b41, 24, 2681}, {0x000000b42, 24, 2682}, {0x000000b43, 24, 2683},
+	{0x000000b44, 24, 2684}, {0x000000b45, 24, 3}, {0x000000b46, 24, 3}, {0x000000b47, 24, 2685},
+	{0x000000b48, 24, 2686}, {0x000000b49, 24, 2687}, {0x000000b4a, 24, 2688}, {0x000000b4b, 24, 2689},
+	{0x000000b4c, 24, 2690}, {0x000000b4d, 24, 2691}, {0x000000b4e, 24, 2692}, {0x000000b4f, 24, 2693},
+	{0x000000b50, 24, 2694}, {0x000000b51, 24, 2695}, {0x000000b52, 24, 2696}, {0x000000b53, 24, 2697},
+	{0x000000b54, 24, 2698}, {0x000000b55, 24, 2699}, {0x000000b56, 24, 2700}, {0x000000b57, 24, 2701},
+	{0x000000b58, 24, 2702}, {0x000000b59, 24, 2703}, {0x000000b5a, 24, 2704}, {0x000000b5b, 24, 2705},
+	{0x000000b5c, 24, 2706}, {0x000000b5d, 24, 5}, {0x000000b5e, 24, 2707}, {0x000000b5f, 24, 3},
+	{0x000000b60, 24, 3}, {0x000000b61, 24, 2708}, {0x000000b62, 24, 2709}, {0x000000b63, 24, 2710},
+	{0x000000b64, 24, 2711}, {0x000000b65, 24, 2712}, {0x000000b66, 24, 2713}, {0x000000b67, 24, 2714},
+	{0x000000b68, 24, 2715}, {0x000000b69, 24, 2716}, {0x000000b6a, 24, 2717}, {0x000000b6b, 24, 2718},
+	{0x000000b6c, 24, 2719}, {0x000000b6d, 24, 2720}, {0x000000b6e, 24, 2721}, {0x000000b6f, 24, 2722},
+	{0x000000b70, 24, 2723}, {0x000000b71, 24, 2724}, {0x000000b72, 24, 2725}, {0x000000b73, 24, 2726},
+	{0x000000b74, 24, 2727}, {0x000000b75, 24, 2728}, {0x000000b76, 24, 2729}, {0x000000b77, 24, 2730},
+	{0x000000b78, 24, 2731}, {0x000000b79, 24, 2732}, {0x000000b7a, 24, 1398}, {0x000000b7b, 24, 2733},
+	{0x000000b7c, 24, 2734}, {0x000000b7d, 24, 2735}, {0x000000b7e, 24, 2736}, {0x000000b7f, 24, 2737},
+	{0x000000b80, 24, 2738}, {0x000000b81, 24, 2739}, {0x000000b82, 24, 2740}, {0x000000b83, 24, 2741},
+	{0x000000b84, 24, 2742}, {0x000000b85, 24, 3}, {0x000000b86, 24, 2743}, {0x000000b87, 24, 2744},
+	{0x000000b88, 24, 2745}, {0x000000b89, 24, 2746}, {0x000000b8a, 24, 2747}, {0x000000b8b, 24, 2748},
+	{0x000000b8c, 24, 2749}, {0x000000b8d, 24, 2750}, {0x000000b8e, 24, 2751}, {0x000000b8f, 24, 2752},
+	{0x000000b90, 24, 2753}, {0x000000b91, 24, 2754}, {0x000000b92, 24, 2755}, {0x000000b93, 24, 2756},
+	{0x000000b94, 24, 2757}, {0x000000b95, 24, 2758}, {0x000000b96, 24, 2759}, {0x000000b97, 24, 2760},
+	{0x000000b98, 24, 2761}, {0x000000b99, 24, 2762}, {0x000000b9a, 24, 2763}, {0x000000b9b, 24, 2764},
+	{0x000000b9c, 24, 2765}, {0x000000b9d, 24, 2766}, {0x000000b9e, 24, 2767}, {0x000000b9f, 24, 2768},
+	{0x000000ba0, 24, 2769}, {0x000000ba1, 24, 2770}, {0x000000ba2, 24, 2771}, {0x000000ba3, 24, 2772},
+	{0x000000ba4, 24, 2773}, {0x000000ba5, 24, 2774}, {0x000000ba6, 24, 2775}, {0x000000ba7, 24, 2776},
+	{0x000000ba8, 24, 2777}, {0x000000ba9, 24, 2778}, {0x000000baa, 24, 2779}, {0x000000bab, 24, 2780},
+	{0x000000bac, 24, 2380}, {0x000000bad, 24, 2781}, {0x000000bae, 24, 2782}, {0x000000baf, 24, 2783},
+	{0x000000bb0, 24, 2784}, {0x000000bb1, 24, 2785}, {0x000000bb2, 24, 2786}, {0x000000bb3, 24, 2787},
+	{0x000000bb4, 24, 2788}, {0x000000bb5, 24, 2789}, {0x000000bb6, 24, 2790}, {0x000000bb7, 24, 2791},
+	{0x000000bb8, 24, 2792}, {0x000000bb9, 24, 2793}, {0x000000bba, 24, 2480}, {0x000000bbb, 24, 2794},
+	{0x000000bbc, 24, 2795}, {0x000000bbd, 24, 2796}, {0x000000bbe, 24, 3}, {0x000000bbf, 24, 3},
+	{0x000000bc0, 24, 2797}, {0x000000bc1, 24, 2798}, {0x000000bc2, 24, 2799}, {0x000000bc3, 24, 2800},
+	{0x000000bc4, 24, 2801}, {0x000000bc5, 24, 1173}, {0x000000bc6, 24, 2802}, {0x000000bc7, 24, 2803},
+	{0x000000bc8, 24, 2804}, {0x000000bc9, 24, 2805}, {0x000000bca, 24, 2806}, {0x000000bcb, 24, 2807},
+	{0x000000bcc, 24, 2808}, {0x000000bcd, 24, 459}, {0x000000bce, 24, 2809}, {0x000000bcf, 24, 2810},
+	{0x000000bd0, 24, 2811}, {0x000000bd1, 24, 2812}, {0x000000bd2, 24, 2813}, {0x000000bd3, 24, 2814},
+	{0x000000bd4, 24, 2815}, {0x000000bd5, 24, 2816}, {0x000000bd6, 24, 2817}, {0x000000bd7, 24, 2818},
+	{0x000000bd8, 24, 2819}, {0x000000bd9, 24, 2820}, {0x000000bda, 24, 2821}, {0x000000bdb, 24, 2822},
+	{0x000000bdc, 24, 2823}, {0x000000bdd, 24, 2824}, {0x000000bde, 24, 2825}, {0x000000bdf, 24, 2826},
+	{0x000000be0, 24, 2827}, {0x000000be1, 24, 2828}, {0x000000be2, 24, 2829}, {0x000000be3, 24, 2830},
+	{0x000000be4, 24, 2831}, {0x000000be5, 24, 2832}, {0x000000be6, 24, 2833}, {0x000000be7, 24, 2834},
+	{0x000000be8, 24, 2835}, {0x000000be9, 24, 2836}, {0x000000bea, 24, 2837}, {0x000000beb, 24, 2838},
+	{0x000000bec, 24, 2839}, {0x000000bed, 24, 2840}, {0x000000bee, 24, 2841}, {0x000000bef, 24, 2842},
+	{0x000000bf0, 24, 2843}, {0x000000bf1, 24, 2844}, {0x000000bf2, 24, 2845}, {0x000000bf3, 24, 2846},
+	{0x000000bf4, 24, 99}, {0x000000bf5, 24, 2847}, {0x000000bf6, 24, 2848}, {0x000000bf7, 24, 2849},
+	{0x000000bf8, 24, 2850}, {0x000000bf9, 24, 2851}, {0x000000bfa, 24, 2852}, {0x000000bfb, 24, 2853},
+	{0x000000bfc, 24, 3}, {0x000000bfd, 24, 3}, {0x000000bfe, 24, 2854}, {0x000000bff, 24, 2855},
+	{0x000000c00, 24, 2856}, {0x000000c01, 24, 2857}, {0x000000c02, 24, 2858}, {0x000000c03, 24, 2859},
+	{0x000000c04, 24, 2860}, {0x000000c05, 24, 2861}, {0x000000c06, 24, 2862}, {0x000000c07, 24, 2863},
+	{0x000000c08, 24, 2864}, {0x000000c09, 24, 2865}, {0x000000c0a, 24, 2866}, {0x000000c0b, 24, 2867},
+	{0x000000c0c, 24, 2868}, {0x000000c0d, 24, 2869}, {0x000000c0e, 24, 2870}, {0x000000c0f, 24, 2871},
+	{0x000000c10, 24, 2872}, {0x000000c11, 24, 2873}, {0x000000c12, 24, 2874}, {0x000000c13, 24, 2875},
+	{0x000000c14, 24, 2876}, {0x000000c15, 24, 2877}, {0x000000c16, 24, 2878}, {0x000000c17, 24, 2879},
+	{0x000000c18, 24, 2880}, {0x000000c19, 24, 2881}, {0x000000c1a, 24, 2882}, {0x000000c1b, 24, 2883},
+	{0x000000c1c, 24, 2884}, {0x000000c1d, 24, 2885}, {0x000000c1e, 24, 2886}, {0x000000c1f, 24, 2887},
+	{0x000000c20, 24, 2888}, {0x000000c21, 24, 2889}, {0x000000c22, 24, 2890}, {0x000000c23, 24, 2891},
+	{0x000000c24, 24, 2892}, {0x000000c25, 24, 2893}, {0x000000c26, 24, 2894}, {0x000000c27, 24, 2895},
+	{0x000000c28, 24, 2896}, {0x000000c29, 24, 1294}, {0x000000c2a, 24, 2897}, {0x000000c2b, 24, 2898},
+	{0x000000c2c, 24, 2899}, {0x000000c2d, 24, 2900}, {0x000000c2e, 24, 2901}, {0x000000c2f, 24, 2902},
+	{0x000000c30, 24, 3}, {0x000000c31, 24, 3}, {0x000000c32, 24, 2903}, {0x000000c33, 24, 2904},
+	{0x000000c34, 24, 2905}, {0x000000c35, 24, 2906}, {0x000000c36, 24, 2907}, {0x000000c37, 24, 2908},
+	{0x000000c38, 24, 2909}, {0x000000c39, 24, 2910}, {0x000000c3a, 24, 2911}, {0x000000c3b, 24, 2912},
+	{0x000000c3c, 24, 2913}, {0x000000c3d, 24, 2914}, {0x000000c3e, 24, 2915}, {0x000000c3f, 24, 2916},
+	{0x000000c40, 24, 2917}, {0x000000c41, 24, 2918}, {0x000000c42, 24, 2919}, {0x000000c43, 24, 2920},
+	{0x000000c44, 24, 2921}, {0x000000c45, 24, 2922}, {0x000000c46, 24, 2923}, {0x000000c47, 24, 2924},
+	{0x000000c48, 24, 2925}, {0x000000c49, 24, 2926}, {0x000000c4a, 24, 2927}, {0x000000c4b, 24, 2928},
+	{0x000000c4c, 24, 2929}, {0x000000c4d, 24, 2930}, {0x000000c4e, 24, 2931}, {0x000000c4f, 24, 2932},
+	{0x000000c50, 24, 1157}, {0x000000c51, 24, 2933}, {0x000000c52, 24, 2934}, {0x000000c53, 24, 99},
+	{0x000000c54, 24, 2935}, {0x000000c55, 24, 2936}, {0x000000c56, 24, 2937}, {0x000000c57, 24, 2938},
+	{0x000000c58, 24, 2939}, {0x000000c59, 24, 2940}, {0x000000c5a, 24, 2941}, {0x000000c5b, 24, 2942},
+	{0x000000c5c, 24, 2943}, {0x000000c5d, 24, 2944}, {0x000000c5e, 24, 2945}, {0x000000c5f, 24, 2946},
+	{0x000000c60, 24, 2947}, {0x000000c61, 24, 2948}, {0x000000c62, 24, 2949}, {0x000000c63, 24, 2950},
+	{0x000000c64, 24, 2951}, {0x000000c65, 24, 2952}, {0x000000c66, 24, 2953}, {0x000000c67, 24, 2954},
+	{0x000000c68, 24, 2955}, {0x000000c69, 24, 2956}, {0x000000c6a, 24, 2957}, {0x000000c6b, 24, 2958},
+	{0x000000c6c, 24, 2959}, {0x000000c6d, 24, 2960}, {0x000000c6e, 24, 2961}, {0x000000c6f, 24, 2962},
+	{0x000000c70, 24, 2963}, {0x000000c71, 24, 2964}, {0x000000c72, 24, 2965}, {0x000000c73, 24, 2966},
+	{0x000000c74, 24, 2967}, {0x000000c75, 24, 2968}, {0x000000c76, 24, 2969}, {0x000000c77, 24, 2970},
+	{0x000000c78, 24, 2971}, {0x000000c79, 24, 2972}, {0x000000c7a, 24, 2973}, {0x000000c7b, 24, 2974},
+	{0x000000c7c, 24, 2975}, {0x000000c7d, 24, 2976}, {0x000000c7e, 24, 2977}, {0x000000c7f, 24, 2978},
+	{0x000000c80, 24, 2979}, {0x000000c81, 24, 2980}, {0x000000c82, 24, 2981}, {0x000000c83, 24, 2982},
+	{0x000000c84, 24, 2983}, {0x000000c85, 24, 3}, {0x000000c86, 24, 3}, {0x000000c87, 24, 2984},
+	{0x000000c88, 24, 2985}, {0x000000c89, 24, 2986}, {0x000000c8a, 24, 2987}, {0x000000c8b, 24, 2988},
+	{0x000000c8c, 24, 2989}, {0x000000c8d, 24, 2990}, {0x000000c8e, 24, 2991}, {0x000000c8f, 24, 2992},
+	{0x000000c90, 24, 2993}, {0x000000c91, 24, 2994}, {0x000000c92, 24, 2995}, {0x000000c93, 24, 2996},
+	{0x000000c94, 24, 2997}, {0x000000c95, 24, 2998}, {0x000000c96, 24, 2999}, {0x000000c97, 24, 3000},
+	{0x000000c98, 24, 3001}, {0x000000c99, 24, 3002}, {0x000000c9a, 24, 3003}, {0x000000c9b, 24, 3004},
+	{0x000000c9c, 24, 3005}, {0x000000c9d, 24, 3006}, {0x000000c9e, 24, 3007}, {0x000000c9f, 24, 3008},
+	{0x000000ca0, 24, 3009}, {0x000000ca1, 24, 3010}, {0x000000ca2, 24, 3011}, {0x000000ca3, 24, 3012},
+	{0x000000ca4, 24, 3013}, {0x000000ca5, 24, 3014}, {0x000000ca6, 24, 3015}, {0x000000ca7, 24, 3016},
+	{0x000000ca8, 24, 3017}, {0x000000ca9, 24, 3018}, {0x000000caa, 24, 3019}, {0x000000cab, 24, 3020},
+	{0x000000cac, 24, 3021}, {0x000000cad, 24, 3022}, {0x000000cae, 24, 3023}, {0x000000caf, 24, 3024},
+	{0x000000cb0, 24, 3025}, {0x000000cb1, 24, 3026}, {0x000000cb2, 24, 3027}, {0x000000cb3, 24, 3028},
+	{0x000000cb4, 24, 3029}, {0x000000cb5, 24, 3030}, {0x000000cb6, 24, 3031}, {0x000000cb7, 24, 3032},
+	{0x000000cb8, 24, 3033}, {0x000000cb9, 24, 3034}, {0x000000cba, 24, 3035}, {0x000000cbb, 24, 3036},
+	{0x000000cbc, 24, 3037}, {0x000000cbd, 24, 3038}, {0x000000cbe, 24, 3039}, {0x000000cbf, 24, 3040},
+	{0x000000cc0, 24, 3041}, {0x000000cc1, 24, 3042}, {0x000000cc2, 24, 3043}, {0x000000cc3, 24, 3044},
+	{0x000000cc4, 24, 3045}, {0x000000cc5, 24, 3046}, {0x000000cc6, 24, 3047}, {0x000000cc7, 24, 3048},
+	{0x000000cc8, 24, 3049}, {0x000000cc9, 24, 3050}, {0x000000cca, 24, 3051}, {0x000000ccb, 24, 3052},
+	{0x000000ccc, 24, 3053}, {0x000000ccd, 24, 3054}, {0x000000cce, 24, 3}, {0x000000ccf, 24, 3},
+	{0x000000cd0, 24, 3055}, {0x000000cd1, 24, 3056}, {0x000000cd2, 24, 3057}, {0x000000cd3, 24, 3058},
+	{0x000000cd4, 24, 3059}, {0x000000cd5, 24, 3060}, {0x000000cd6, 24, 3061}, {0x000000cd7, 24, 3062},
+	{0x000000cd8, 24, 3063}, {0x000000cd9, 24, 3064}, {0x000000cda, 24, 3065}, {0x000000cdb, 24, 1085},
+	{0x000000cdc, 24, 3066}, {0x000000cdd, 24, 3067}, {0x000000cde, 24, 3068}, {0x000000cdf, 24, 3069},
+	{0x000000ce0, 24, 3070}, {0x000000ce1, 24, 3071}, {0x000000ce2, 24, 3072}, {0x000000ce3, 24, 3073},
+	{0x000000ce4, 24, 3074}, {0x000000ce5, 24, 185}, {0x000000ce6, 24, 3075}, {0x000000ce7, 24, 3076},
+	{0x000000ce8, 24, 3077}, {0x000000ce9, 24, 3078}, {0x000000cea, 24, 3079}, {0x000000ceb, 24, 3080},
+	{0x000000cec, 24, 3081}, {0x000000ced, 24, 3082}, {0x000000cee, 24, 3083}, {0x000000cef, 24, 3084},
+	{0x000000cf0, 24, 3085}, {0x000000cf1, 24, 652}, {0x000000cf2, 24, 3086}, {0x000000cf3, 24, 3087},
+	{0x000000cf4, 24, 3088}, {0x000000cf5, 24, 3089}, {0x000000cf6, 24, 3090}, {0x000000cf7, 24, 108},
+	{0x000000cf8, 24, 108}, {0x000000cf9, 24, 3091}, {0x000000cfa, 24, 3092}, {0x000000cfb, 24, 3093},
+	{0x000000cfc, 24, 3094}, {0x000000cfd, 24, 3095}, {0x000000cfe, 24, 3096}, {0x000000cff, 24, 3097},
+	{0x000000d00, 24, 3098}, {0x000000d01, 24, 3099}, {0x000000d02, 24, 3100}, {0x000000d03, 24, 3101},
+	{0x000000d04, 24, 3102}, {0x000000d05, 24, 3103}, {0x000000d06, 24, 3104}, {0x000000d07, 24, 3105},
+	{0x000000d08, 24, 3106}, {0x000000d09, 24, 3107}, {0x000000d0a, 24, 3108}, {0x000000d0b, 24, 1741},
+	{0x000000d0c, 24, 3109}, {0x000000d0d, 24, 3110}, {0x000000d0e, 24, 3111}, {0x000000d0f, 24, 3112},
+	{0x000000d10, 24, 3113}, {0x000000d11, 24, 3114}, {0x000000d12, 24, 3115}, {0x000000d13, 24, 3116},
+	{0x000000d14, 24, 3117}, {0x000000d15, 24, 3118}, {0x000000d16, 24, 3119}, {0x000000d17, 24, 3120},
+	{0x000000d18, 24, 3121}, {0x000000d19, 24, 3122}, {0x000000d1a, 24, 3123}, {0x000000d1b, 24, 3124},
+	{0x000000d1c, 24, 3125}, {0x000000d1d, 24, 3126}, {0x000000d1e, 24, 3127}, {0x000000d1f, 24, 3128},
+	{0x000000d20, 24, 3129}, {0x000000d21, 24, 3130}, {0x000000d22, 24, 3131}, {0x000000d23, 24, 3132},
+	{0x000000d24, 24, 3133}, {0x000000d25, 24, 3134}, {0x000000d26, 24, 3135}, {0x000000d27, 24, 3136},
+	{0x000000d28, 24, 3}, {0x000000d29, 24, 3}, {0x000000d2a, 24, 3137}, {0x000000d2b, 24, 3138},
+	{0x000000d2c, 24, 3139}, {0x000000d2d, 24, 3140}, {0x000000d2e, 24, 3141}, {0x000000d2f, 24, 3142},
+	{0x000000d30, 24, 3143}, {0x000000d31, 24, 3144}, {0x000000d32, 24, 3145}, {0x000000d33, 24, 3146},
+	{0x000000d34, 24, 3147}, {0x000000d35, 24, 3148}, {0x000000d36, 24, 3149}, {0x000000d37, 24, 3150},
+	{0x000000d38, 24, 3151}, {0x000000d39, 24, 3152}, {0x000000d3a, 24, 3153}, {0x000000d3b, 24, 3154},
+	{0x000000d3c, 24, 3155}, {0x000000d3d, 24, 3156}, {0x000000d3e, 24, 3157}, {0x000000d3f, 24, 3158},
+	{0x000000d40, 24, 3159}, {0x000000d41, 24, 3160}, {0x000000d42, 24, 3161}, {0x000000d43, 24, 3162},
+	{0x000000d44, 24, 3163}, {0x000000d45, 24, 3164}, {0x000000d46, 24, 3165}, {0x000000d47, 24, 3166},
+	{0x000000d48, 24, 3167}, {0x000000d49, 24, 3168}, {0x000000d4a, 24, 3169}, {0x000000d4b, 24, 3170},
+	{0x000000d4c, 24, 3171}, {0x000000d4d, 24, 3172}, {0x000000d4e, 24, 3173}, {0x000000d4f, 24, 3174},
+	{0x000000d50, 24, 3175}, {0x000000d51, 24, 3176}, {0x000000d52, 24, 3177}, {0x000000d53, 24, 3178},
+	{0x000000d54, 24, 2380}, {0x000000d55, 24, 3179}, {0x000000d56, 24, 2822}, {0x000000d57, 24, 3180},
+	{0x000000d58, 24, 99}, {0x000000d59, 24, 3181}, {0x000000d5a, 24, 3182}, {0x000000d5b, 24, 3183},
+	{0x000000d5c, 24, 3184}, {0x000000d5d, 24, 3185}, {0x000000d5e, 24, 3186}, {0x000000d5f, 24, 3187},
+	{0x000000d60, 24, 564}, {0x000000d61, 24, 3188}, {0x000000d62, 24, 3189}, {0x000000d63, 24, 3190},
+	{0x000000d64, 24, 3191}, {0x000000d65, 24, 3}, {0x000000d66, 24, 3}, {0x000000d67, 24, 540},
+	{0x000000d68, 24, 3192}, {0x000000d69, 24, 3193}, {0x000000d6a, 24, 3194}, {0x000000d6b, 24, 3195},
+	{0x000000d6c, 24, 3196}, {0x000000d6d, 24, 3197}, {0x000000d6e, 24, 3198}, {0x000000d6f, 24, 3199},
+	{0x000000d70, 24, 3200}, {0x000000d71, 24, 3201}, {0x000000d72, 24, 3202}, {0x000000d73, 24, 3203},
+	{0x000000d74, 24, 3204}, {0x000000d75, 24, 3205}, {0x000000d76, 24, 3206}, {0x000000d77, 24, 3207},
+	{0x000000d78, 24, 3208}, {0x000000d79, 24, 3209}, {0x000000d7a, 24, 3210}, {0x000000d7b, 24, 3211},
+	{0x000000d7c, 24, 3212}, {0x000000d7d, 24, 3213}, {0x000000d7e, 24, 3214}, {0x000000d7f, 24, 3215},
+	{0x000000d80, 24, 3216}, {0x000000d81, 24, 3217}, {0x000000d82, 24, 3218}, {0x000000d83, 24, 3219},
+	{0x000000d84, 24, 3220}, {0x000000d85, 24, 3221}, {0x000000d86, 24, 3222}, {0x000000d87, 24, 1820},
+	{0x000000d88, 24, 3223}, {0x000000d89, 24, 3224}, {0x000000d8a, 24, 3225}, {0x000000d8b, 24, 3226},
+	{0x000000d8c, 24, 3227}, {0x000000d8d, 24, 3228}, {0x000000d8e, 24, 3229}, {0x000000d8f, 24, 3230},
+	{0x000000d90, 24, 3231}, {0x000000d91, 24, 3232}, {0x000000d92, 24, 3233}, {0x000000d93, 24, 865},
+	{0x000000d94, 24, 3234}, {0x000000d95, 24, 3235}, {0x000000d96, 24, 3236}, {0x000000d97, 24, 3237},
+	{0x000000d98, 24, 3238}, {0x000000d99, 24, 3239}, {0x000000d9a, 24, 3240}, {0x000000d9b, 24, 3241},
+	{0x000000d9c, 24, 3242}, {0x000000d9d, 24, 459}, {0x000000d9e, 24, 3243}, {0x000000d9f, 24, 3244},
+	{0x000000da0, 24, 3245}, {0x000000da1, 24, 3246}, {0x000000da2, 24, 3247}, {0x000000da3, 24, 3248},
+	{0x000000da4, 24, 3249}, {0x000000da5, 24, 3250}, {0x000000da6, 24, 3251}, {0x000000da7, 24, 99},
+	{0x000000da8, 24, 3252}, {0x000000da9, 24, 3253}, {0x000000daa, 24, 3254}, {0x000000dab, 24, 3255},
+	{0x000000dac, 24, 3256}, {0x000000dad, 24, 3257}, {0x000000dae, 24, 3258}, {0x000000daf, 24, 3259},
+	{0x000000db0, 24, 3260}, {0x000000db1, 24, 3261}, {0x000000db2, 24, 3262}, {0x000000db3, 24, 3263},
+	{0x000000db4, 24, 3264}, {0x000000db5, 24, 3265}, {0x000000db6, 24, 3266}, {0x000000db7, 24, 3267},
+	{0x000000db8, 24, 3268}, {0x000000db9, 24, 3269}, {0x000000dba, 24, 3270}, {0x000000dbb, 24, 3271},
+	{0x000000dbc, 24, 3}, {0x000000dbd, 24, 3}, {0x000000dbe, 24, 3272}, {0x000000dbf, 24, 3273},
+	{0x000000dc0, 24, 3274}, {0x000000dc1, 24, 3275}, {0x000000dc2, 24, 99}, {0x000000dc3, 24, 3276},
+	{0x000000dc4, 24, 3277}, {0x000000dc5, 24, 3278}, {0x000000dc6, 24, 3279}, {0x000000dc7, 24, 3280},
+	{0x000000dc8, 24, 3281}, {0x000000dc9, 24, 3282}, {0x000000dca, 24, 3283}, {0x000000dcb, 24, 3284},
+	{0x000000dcc, 24, 3285}, {0x000000dcd, 24, 3286}, {0x000000dce, 24, 3287}, {0x000000dcf, 24, 3288},
+	{0x000000dd0, 24, 3289}, {0x000000dd1, 24, 3290}, {0x000000dd2, 24, 3291}, {0x000000dd3, 24, 3292},
+	{0x000000dd4, 24, 3293}, {0x000000dd5, 24, 3294}, {0x000000dd6, 24, 3295}, {0x000000dd7, 24, 3296},
+	{0x000000dd8, 24, 3297}, {0x000000dd9, 24, 3298}, {0x000000dda, 24, 3299}, {0x000000ddb, 24, 3300},
+	{0x000000ddc, 24, 3301}, {0x000000ddd, 24, 3302}, {0x000000dde, 24, 3303}, {0x000000ddf, 24, 3304},
+	{0x000000de0, 24, 3305}, {0x000000de1, 24, 3306}, {0x000000de2, 24, 3307}, {0x000000de3, 24, 3308},
+	{0x000000de4, 24, 3309}, {0x000000de5, 24, 3310}, {0x000000de6, 24, 3311}, {0x000000de7, 24, 3312},
+	{0x000000de8, 24, 3313}, {0x000000de9, 24, 3314}, {0x000000dea, 24, 3315}, {0x000000deb, 24, 3316},
+	{0x000000dec, 24, 3}, {0x000000ded, 24, 3}, {0x000000dee, 24, 3317}, {0x000000def, 24, 3318},
+	{0x000000df0, 24, 3319}, {0x000000df1, 24, 3320}, {0x000000df2, 24, 99}, {0x000000df3, 24, 3321},
+	{0x000000df4, 24, 3322}, {0x000000df5, 24, 3323}, {0x000000df6, 24, 3324}, {0x000000df7, 24, 3325},
+	{0x000000df8, 24, 3326}, {0x000000df9, 24, 3327}, {0x000000dfa, 24, 3328}, {0x000000dfb, 24, 3329},
+	{0x000000dfc, 24, 3330}, {0x000000dfd, 24, 3331}, {0x000000dfe, 24, 3332}, {0x000000dff, 24, 3333},
+	{0x000000e00, 24, 3334}, {0x000000e01, 24, 3335}, {0x000000e02, 24, 3336}, {0x000000e03, 24, 189},
+	{0x000000e04, 24, 3337}, {0x000000e05, 24, 3338}, {0x000000e06, 24, 3339}, {0x000000e07, 24, 2582},
+	{0x000000e08, 24, 3340}, {0x000000e09, 24, 3341}, {0x000000e0a, 24, 3342}, {0x000000e0b, 24, 3343},
+	{0x000000e0c, 24, 652}, {0x000000e0d, 24, 3344}, {0x000000e0e, 24, 3345}, {0x000000e0f, 24, 3346},
+	{0x000000e10, 24, 3347}, {0x000000e11, 24, 3348}, {0x000000e12, 24, 3349}, {0x000000e13, 24, 3350},
+	{0x000000e14, 24, 3351}, {0x000000e15, 24, 3352}, {0x000000e16, 24, 3353}, {0x000000e17, 24, 99},
+	{0x000000e18, 24, 3354}, {0x000000e19, 24, 3355}, {0x000000e1a, 24, 3356}, {0x000000e1b, 24, 3357},
+	{0x000000e1c, 24, 3358}, {0x000000e1d, 24, 3359}, {0x000000e1e, 24, 3360}, {0x000000e1f, 24, 3361},
+	{0x000000e20, 24, 3362}, {0x000000e21, 24, 3363}, {0x000000e22, 24, 99}, {0x000000e23, 24, 3364},
+	{0x000000e24, 24, 3365}, {0x000000e25, 24, 3366}, {0x000000e26, 24, 3367}, {0x000000e27, 24, 3368},
+	{0x000000e28, 24, 3369}, {0x000000e29, 24, 3370}, {0x000000e2a, 24, 99}, {0x000000e2b, 24, 3371},
+	{0x000000e2c, 24, 3372}, {0x000000e2d, 24, 3373}, {0x000000e2e, 24, 3374}, {0x000000e2f, 24, 3375},
+	{0x000000e30, 24, 3376}, {0x000000e31, 24, 3377}, {0x000000e32, 24, 3378}, {0x000000e33, 24, 3379},
+	{0x000000e34, 24, 3380}, {0x000000e35, 24, 3381}, {0x000000e36, 24, 3382}, {0x000000e37, 24, 3383},
+	{0x000000e38, 24, 3}, {0x000000e39, 24, 3}, {0x000000e3a, 24, 3384}, {0x000000e3b, 24, 3385},
+	{0x000000e3c, 24, 3386}, {0x000000e3d, 24, 3387}, {0x000000e3e, 24, 3388}, {0x000000e3f, 24, 1378},
+	{0x000000e40, 24, 108}, {0x000000e41, 24, 3389}, {0x000000e42, 24, 3390}, {0x000000e43, 24, 3391},
+	{0x000000e44, 24, 3392}, {0x000000e45, 24, 3393}, {0x000000e46, 24, 3394}, {0x000000e47, 24, 3395},
+	{0x000000e48, 24, 3396}, {0x000000e49, 24, 3397}, {0x000000e4a, 24, 3398}, {0x000000e4b, 24, 3399},
+	{0x000000e4c, 24, 3400}, {0x000000e4d, 24, 3401}, {0x000000e4e, 24, 3402}, {0x000000e4f, 24, 3403},
+	{0x000000e50, 24, 3404}, {0x000000e51, 24, 3405}, {0x000000e52, 24, 3406}, {0x000000e53, 24, 3407},
+	{0x000000e54, 24, 3408}, {0x000000e55, 24, 3409}, {0x000000e56, 24, 3410}, {0x000000e57, 24, 3411},
+	{0x000000e58, 24, 3412}, {0x000000e59, 24, 3413}, {0x000000e5a, 24, 3414}, {0x000000e5b, 24, 3415},
+	{0x000000e5c, 24, 185}, {0x000000e5d, 24, 3416}, {0x000000e5e, 24, 3417}, {0x000000e5f, 24, 3418},
+	{0x000000e60, 24, 3419}, {0x000000e61, 24, 3420}, {0x000000e62, 24, 108}, {0x000000e63, 24, 3421},
+	{0x000000e64, 24, 3422}, {0x000000e65, 24, 3423}, {0x000000e66, 24, 3424}, {0x000000e67, 24, 3425},
+	{0x000000e68, 24, 3426}, {0x000000e69, 24, 3427}, {0x000000e6a, 24, 2380}, {0x000000e6b, 24, 3428},
+	{0x000000e6c, 24, 3429}, {0x000000e6d, 24, 3430}, {0x000000e6e, 24, 3431}, {0x000000e6f, 24, 3432},
+	{0x000000e70, 24, 3433}, {0x000000e71, 24, 3434}, {0x000000e72, 24, 3435}, {0x000000e73, 24, 3436},
+	{0x000000e74, 24, 3437}, {0x000000e75, 24, 3438}, {0x000000e76, 24, 3439}, {0x000000e77, 24, 3440},
+	{0x000000e78, 24, 3441}, {0x000000e79, 24, 3442}, {0x000000e7a, 24, 3443}, {0x000000e7b, 24, 1934},
+	{0x000000e7c, 24, 3444}, {0x000000e7d, 24, 3445}, {0x000000e7e, 24, 3446}, {0x000000e7f, 24, 459},
+	{0x000000e80, 24, 3447}, {0x000000e81, 24, 3448}, {0x000000e82, 24, 3449}, {0x000000e83, 24, 3},
+	{0x000000e84, 24, 3}, {0x000000e85, 24, 3450}, {0x000000e86, 24, 3451}, {0x000000e87, 24, 3452},
+	{0x000000e88, 24, 3453}, {0x000000e89, 24, 3454}, {0x000000e8a, 24, 3455}, {0x000000e8b, 24, 3456},
+	{0x000000e8c, 24, 3457}, {0x000000e8d, 24, 3458}, {0x000000e8e, 24, 3459}, {0x000000e8f, 24, 3460},
+	{0x000000e90, 24, 3461}, {0x000000e91, 24, 3462}, {0x000000e92, 24, 3463}, {0x000000e93, 24, 3464},
+	{0x000000e94, 24, 3465}, {0x000000e95, 24, 3466}, {0x000000e96, 24, 3467}, {0x000000e97, 24, 3468},
+	{0x000000e98, 24, 3469}, {0x000000e99, 24, 3470}, {0x000000e9a, 24, 3471}, {0x000000e9b, 24, 612},
+	{0x000000e9c, 24, 3472}, {0x000000e9d, 24, 3473}, {0x000000e9e, 24, 3474}, {0x000000e9f, 24, 3475},
+	{0x000000ea0, 24, 3476}, {0x000000ea1, 24, 3477}, {0x000000ea2, 24, 3478}, {0x000000ea3, 24, 3479},
+	{0x000000ea4, 24, 3480}, {0x000000ea5, 24, 3481}, {0x000000ea6, 24, 2961}, {0x000000ea7, 24, 3482},
+	{0x000000ea8, 24, 3483}, {0x000000ea9, 24, 3484}, {0x000000eaa, 24, 3485}, {0x000000eab, 24, 3486},
+	{0x000000eac, 24, 3487}, {0x000000ead, 24, 3488}, {0x000000eae, 24, 3489}, {0x000000eaf, 24, 3490},
+	{0x000000eb0, 24, 3491}, {0x000000eb1, 24, 3492}, {0x000000eb2, 24, 3493}, {0x000000eb3, 24, 3494},
+	{0x000000eb4, 24, 3495}, {0x000000eb5, 24, 3496}, {0x000000eb6, 24, 3497}, {0x000000eb7, 24, 3498},
+	{0x000000eb8, 24, 3499}, {0x000000eb9, 24, 3500}, {0x000000eba, 24, 3501}, {0x000000ebb, 24, 3502},
+	{0x000000ebc, 24, 3503}, {0x000000ebd, 24, 3504}, {0x000000ebe, 24, 3505}, {0x000000ebf, 24, 3506},
+	{0x000000ec0, 24, 108}, {0x000000ec1, 24, 3507}, {0x000000ec2, 24, 3508}, {0x000000ec3, 24, 3509},
+	{0x000000ec4, 24, 3510}, {0x000000ec5, 24, 3511}, {0x000000ec6, 24, 3512}, {0x000000ec7, 24, 3513},
+	{0x000000ec8, 24, 3514}, {0x000000ec9, 24, 3515}, {0x000000eca, 24, 3516}, {0x000000ecb, 24, 3517},
+	{0x000000ecc, 24, 3518}, {0x000000ecd, 24, 3519}, {0x000000ece, 24, 3520}, {0x000000ecf, 24, 3521},
+	{0x000000ed0, 24, 3522}, {0x000000ed1, 24, 3523}, {0x000000ed2, 24, 3524}, {0x000000ed3, 24, 3525},
+	{0x000000ed4, 24, 3526}, {0x000000ed5, 24, 3527}, {0x000000ed6, 24, 3}, {0x000000ed7, 24, 3},
+	{0x000000ed8, 24, 3528}, {0x000000ed9, 24, 3529}, {0x000000eda, 24, 3530}, {0x000000edb, 24, 3531},
+	{0x000000edc, 24, 3532}, {0x000000edd, 24, 3533}, {0x000000ede, 24, 3534}, {0x000000edf, 24, 3535},
+	{0x000000ee0, 24, 3536}, {0x000000ee1, 24, 3537}, {0x000000ee2, 24, 3538}, {0x000000ee3, 24, 3539},
+	{0x000000ee4, 24, 3471}, {0x000000ee5, 24, 3540}, {0x000000ee6, 24, 3541}, {0x000000ee7, 24, 3542},
+	{0x000000ee8, 24, 3543}, {0x000000ee9, 24, 3544}, {0x000000eea, 24, 3545}, {0x000000eeb, 24, 3546},
+	{0x000000eec, 24, 3547}, {0x000000eed, 24, 709}, {0x000000eee, 24, 3548}, {0x000000eef, 24, 99},
+	{0x000000ef0, 24, 3549}, {0x000000ef1, 24, 3550}, {0x000000ef2, 24, 3551}, {0x000000ef3, 24, 3552},
+	{0x000000ef4, 24, 3553}, {0x000000ef5, 24, 3554}, {0x000000ef6, 24, 3555}, {0x000000ef7, 24, 3556},
+	{0x000000ef8, 24, 3557}, {0x000000ef9, 24, 3558}, {0x000000efa, 24, 3559}, {0x000000efb, 24, 3560},
+	{0x000000efc, 24, 3561}, {0x000000efd, 24, 3562}, {0x000000efe, 24, 3563}, {0x000000eff, 24, 3564},
+	{0x000000f00, 24, 3565}, {0x000000f01, 24, 3566}, {0x000000f02, 24, 3567}, {0x000000f03, 24, 3568},
+	{0x000000f04, 24, 3569}, {0x000000f05, 24, 3570}, {0x000000f06, 24, 108}, {0x000000f07, 24, 3571},
+	{0x000000f08, 24, 3572}, {0x000000f09, 24, 99}, {0x000000f0a, 24, 3573}, {0x000000f0b, 24, 3574},
+	{0x000000f0c, 24, 3575}, {0x000000f0d, 24, 3576}, {0x000000f0e, 24, 3577}, {0x000000f0f, 24, 3578},
+	{0x000000f10, 24, 3579}, {0x000000f11, 24, 3580}, {0x000000f12, 24, 3581}, {0x000000f13, 24, 3582},
+	{0x000000f14, 24, 3583}, {0x000000f15, 24, 3584}, {0x000000f16, 24, 3585}, {0x000000f17, 24, 3586},
+	{0x000000f18, 24, 3587}, {0x000000f19, 24, 3588}, {0x000000f1a, 24, 3589}, {0x000000f1b, 24, 3590},
+	{0x000000f1c, 24, 3591}, {0x000000f1d, 24, 3592}, {0x000000f1e, 24, 3593}, {0x000000f1f, 24, 2822},
+	{0x000000f20, 24, 459}, {0x000000f21, 24, 3594}, {0x000000f22, 24, 3595}, {0x000000f23, 24, 3},
+	{0x000000f24, 24, 3}, {0x000000f25, 24, 3596}, {0x000000f26, 24, 3597}, {0x000000f27, 24, 3598},
+	{0x000000f28, 24, 3599}, {0x000000f29, 24, 3600}, {0x000000f2a, 24, 3601}, {0x000000f2b, 24, 3602},
+	{0x000000f2c, 24, 3603}, {0x000000f2d, 24, 3604}, {0x000000f2e, 24, 3605}, {0x000000f2f, 24, 3606},
+	{0x000000f30, 24, 3607}, {0x000000f31, 24, 3608}, {0x000000f32, 24, 3609}, {0x000000f33, 24, 3610},
+	{0x000000f34, 24, 3}, {0x000000f35, 24, 3}, {0x000000f36, 24, 3611}, {0x000000f37, 24, 3612},
+	{0x000000f38, 24, 3613}, {0x000000f39, 24, 3614}, {0x000000f3a, 24, 3615}, {0x000000f3b, 24, 3616},
+	{0x000000f3c, 24, 3617}, {0x000000f3d, 24, 3223}, {0x000000f3e, 24, 3618}, {0x000000f3f, 24, 3619},
+	{0x000000f40, 24, 3620}, {0x000000f41, 24, 3621}, {0x000000f42, 24, 3622}, {0x000000f43, 24, 3623},
+	{0x000000f44, 24, 3624}, {0x000000f45, 24, 3625}, {0x000000f46, 24, 3626}, {0x000000f47, 24, 3627},
+	{0x000000f48, 24, 3628}, {0x000000f49, 24, 3629}, {0x000000f4a, 24, 3630}, {0x000000f4b, 24, 116},
+	{0x000000f4c, 24, 3631}, {0x000000f4d, 24, 3632}, {0x000000f4e, 24, 3633}, {0x000000f4f, 24, 3634},
+	{0x000000f50, 24, 3635}, {0x000000f51, 24, 3636}, {0x000000f52, 24, 3637}, {0x000000f53, 24, 3638},
+	{0x000000f54, 24, 3639}, {0x000000f55, 24, 3640}, {0x000000f56, 24, 3641}, {0x000000f57, 24, 3642},
+	{0x000000f58, 24, 3643}, {0x000000f59, 24, 3644}, {0x000000f5a, 24, 3645}, {0x000000f5b, 24, 3646},
+	{0x000000f5c, 24, 3647}, {0x000000f5d, 24, 3648}, {0x000000f5e, 24, 3649}, {0x000000f5f, 24, 3650},
+	{0x000000f60, 24, 3651}, {0x000000f61, 24, 459}, {0x000000f62, 24, 3652}, {0x000000f63, 24, 3653},
+	{0x000000f64, 24, 3654}, {0x000000f65, 24, 3655}, {0x000000f66, 24, 2918}, {0x000000f67, 24, 3656},
+	{0x000000f68, 24, 3657}, {0x000000f69, 24, 3658}, {0x000000f6a, 24, 108}, {0x000000f6b, 24, 3659},
+	{0x000000f6c, 24, 3660}, {0x000000f6d, 24, 3661}, {0x000000f6e, 24, 3662}, {0x000000f6f, 24, 3663},
+	{0x000000f70, 24, 3664}, {0x000000f71, 24, 3665}, {0x000000f72, 24, 3666}, {0x000000f73, 24, 3667},
+	{0x000000f74, 24, 3668}, {0x000000f75, 24, 3669}, {0x000000f76, 24, 3670}, {0x000000f77, 24, 3671},
+	{0x000000f78, 24, 3672}, {0x000000f79, 24, 3673}, {0x000000f7a, 24, 3674}, {0x000000f7b, 24, 3675},
+	{0x000000f7c, 24, 3676}, {0x000000f7d, 24, 3677}, {0x000000f7e, 24, 3678}, {0x000000f7f, 24, 3679},
+	{0x000000f80, 24, 3680}, {0x000000f81, 24, 3681}, {0x000000f82, 24, 3682}, {0x000000f83, 24, 3683},
+	{0x000000f84, 24, 3684}, {0x000000f85, 24, 3685}, {0x000000f86, 24, 3686}, {0x000000f87, 24, 3687},
+	{0x000000f88, 24, 3688}, {0x000000f89, 24, 3689}, {0x000000f8a, 24, 3690}, {0x000000f8b, 24, 3691},
+	{0x000000f8c, 24, 3692}, {0x000000f8d, 24, 3693}, {0x000000f8e, 24, 3694}, {0x000000f8f, 24, 3},
+	{0x000000f90, 24, 3}, {0x000000f91, 24, 3695}, {0x000000f92, 24, 3696}, {0x000000f93, 24, 3697},
+	{0x000000f94, 24, 3648}, {0x000000f95, 24, 3698}, {0x000000f96, 24, 22}, {0x000000f97, 24, 3699},
+	{0x000000f98, 24, 3700}, {0x000000f99, 24, 3701}, {0x000000f9a, 24, 3702}, {0x000000f9b, 24, 3703},
+	{0x000000f9c, 24, 3704}, {0x000000f9d, 24, 3705}, {0x000000f9e, 24, 3706}, {0x000000f9f, 24, 185},
+	{0x000000fa0, 24, 3707}, {0x000000fa1, 24, 3708}, {0x000000fa2, 24, 3709}, {0x000000fa3, 24, 3710},
+	{0x000000fa4, 24, 3711}, {0x000000fa5, 24, 3712}, {0x000000fa6, 24, 3713}, {0x000000fa7, 24, 3714},
+	{0x000000fa8, 24, 3715}, {0x000000fa9, 24, 3716}, {0x000000faa, 24, 3717}, {0x000000fab, 24, 3718},
+	{0x000000fac, 24, 3719}, {0x000000fad, 24, 3720}, {0x000000fae, 24, 3721}, {0x000000faf, 24, 3722},
+	{0x000000fb0, 24, 3723}, {0x000000fb1, 24, 3724}, {0x000000fb2, 24, 3725}, {0x000000fb3, 24, 3726},
+	{0x000000fb4, 24, 3727}, {0x000000fb5, 24, 3728}, {0x000000fb6, 24, 3729}, {0x000000fb7, 24, 3730},
+	{0x000000fb8, 24, 3731}, {0x000000fb9, 24, 3732}, {0x000000fba, 24, 3733}, {0x000000fbb, 24, 3734},
+	{0x000000fbc, 24, 3735}, {0x000000fbd, 24, 3736}, {0x000000fbe, 24, 3737}, {0x000000fbf, 24, 3738},
+	{0x000000fc0, 24, 3739}, {0x000000fc1, 24, 3740}, {0x000000fc2, 24, 3741}, {0x000000fc3, 24, 3742},
+	{0x000000fc4, 24, 3743}, {0x000000fc5, 24, 3744}, {0x000000fc6, 24, 3745}, {0x000000fc7, 24, 3746},
+	{0x000000fc8, 24, 3747}, {0x000000fc9, 24, 3748}, {0x000000fca, 24, 3749}, {0x000000fcb, 24, 2380},
+	{0x000000fcc, 24, 185}, {0x000000fcd, 24, 108}, {0x000000fce, 24, 3750}, {0x000000fcf, 24, 3751},
+	{0x000000fd0, 24, 3752}, {0x000000fd1, 24, 3753}, {0x000000fd2, 24, 3754}, {0x000000fd3, 24, 3755},
+	{0x000000fd4, 24, 3756}, {0x000000fd5, 24, 3757}, {0x000000fd6, 24, 3758}, {0x000000fd7, 24, 3759},
+	{0x000000fd8, 24, 3760}, {0x000000fd9, 24, 3761}, {0x000000fda, 24, 3762}, {0x000000fdb, 24, 3763},
+	{0x000000fdc, 24, 3764}, {0x000000fdd, 24, 3765}, {0x000000fde, 24, 2582}, {0x000000fdf, 24, 3766},
+	{0x000000fe0, 24, 3767}, {0x000000fe1, 24, 3768}, {0x000000fe2, 24, 3769}, {0x000000fe3, 24, 3770},
+	{0x000000fe4, 24, 3771}, {0x000000fe5, 24, 3772}, {0x000000fe6, 24, 3773}, {0x000000fe7, 24, 3774},
+	{0x000000fe8, 24, 3775}, {0x000000fe9, 24, 3776}, {0x000000fea, 24, 3777}, {0x000000feb, 24, 3778},
+	{0x000000fec, 24, 3779}, {0x000000fed, 24, 3780}, {0x000000fee, 24, 3781}, {0x000000fef, 24, 3782},
+	{0x000000ff0, 24, 3783}, {0x000000ff1, 24, 3784}, {0x000000ff2, 24, 3785}, {0x000000ff3, 24, 3786},
+	{0x000000ff4, 24, 3787}, {0x000000ff5, 24, 3788}, {0x000000ff6, 24, 3789}, {0x000000ff7, 24, 3},
+	{0x000000ff8, 24, 3}, {0x000000ff9, 24, 3790}, {0x000000ffa, 24, 3791}, {0x000000ffb, 24, 3792},
+	{0x000000ffc, 24, 3793}, {0x000000ffd, 24, 3794}, {0x000000ffe, 24, 3795}, {0x000000fff, 24, 3796},
+	{0x000001000, 24, 3797}, {0x000001001, 24, 3798}, {0x000001002, 24, 3799}, {0x000001003, 24, 3800},
+	{0x000001004, 24, 3801}, {0x000001005, 24, 3802}, {0x000001006, 24, 3803}, {0x000001007, 24, 3},
+	{0x000001008, 24, 3804}, {0x000001009, 24, 3805}, {0x00000100a, 24, 3806}, {0x00000100b, 24, 3},
+	{0x00000100c, 24, 3807}, {0x00000100d, 24, 3}, {0x00000100e, 24, 3808}, {0x00000100f, 24, 3809},
+	{0x000001010, 24, 3810}, {0x000001011, 24, 3}, {0x000001012, 24, 3811}, {0x000001013, 24, 3812},
+	{0x000001014, 24, 3}, {0x000001015, 24, 3813}, {0x000001016, 24, 3814}, {0x000001017, 24, 3815},
+	{0x000001018, 24, 3816}, {0x000001019, 24, 3817}, {0x00000101a, 24, 3818}, {0x00000101b, 24, 3819},
+	{0x00000101c, 24, 3820}, {0x00000101d, 24, 3821}, {0x00000101e, 24, 3822}, {0x00000101f, 24, 3},
+	{0x000001020, 24, 3823}, {0x000001021, 24, 3824}, {0x000001022, 24, 3825}, {0x000001023, 24, 3826},
+	{0x000001024, 24, 3827}, {0x000001025, 24, 3828}, {0x000001026, 24, 3829}, {0x000001027, 24, 3830},
+	{0x000001028, 24, 3831}, {0x000001029, 24, 3}, {0x00000102a, 24, 3832}, {0x00000102b, 24, 3833},
+	{0x00000102c, 24, 3834}, {0x00000102d, 24, 3835}, {0x00000102e, 24, 3836}, {0x00000102f, 24, 3},
+	{0x000001030, 24, 3837}, {0x000001031, 24, 3838}, {0x000001032, 24, 3839}, {0x000001033, 24, 3840},
+	{0x000001034, 24, 3841}, {0x000001035, 24, 3842}, {0x000001036, 24, 3843}, {0x000001037, 24, 3844},
+	{0x000001038, 24, 3845}, {0x000001039, 24, 3846}, {0x00000103a, 24, 3847}, {0x00000103b, 24, 3848},
+	{0x00000103c, 24, 3849}, {0x00000103d, 24, 3850}, {0x00000103e, 24, 3851}, {0x00000103f, 24, 3852},
+	{0x000001040, 24, 3853}, {0x000001041, 24, 3854}, {0x000001042, 24, 3855}, {0x000001043, 24, 3856},
+	{0x000001044, 24, 3857}, {0x000001045, 24, 108}, {0x000001046, 24, 3858}, {0x000001047, 24, 3859},
+	{0x000001048, 24, 3860}, {0x000001049, 24, 3861}, {0x00000104a, 24, 3862}, {0x00000104b, 24, 244},
+	{0x00000104c, 24, 3863}, {0x00000104d, 24, 3864}, {0x00000104e, 24, 3865}, {0x00000104f, 24, 116},
+	{0x000001050, 24, 3866}, {0x000001051, 24, 3867}, {0x000001052, 24, 3868}, {0x000001053, 24, 3869},
+	{0x000001054, 24, 3}, {0x000001055, 24, 3870}, {0x000001056, 24, 3871}, {0x000001057, 24, 3872},
+	{0x000001058, 24, 3873}, {0x000001059, 24, 3874}, {0x00000105a, 24, 244}, {0x00000105b, 24, 3875},
+	{0x00000105c, 24, 3876}, {0x00000105d, 24, 3877}, {0x00000105e, 24, 3878}, {0x00000105f, 24, 3879},
+	{0x000001060, 24, 3880}, {0x000001061, 24, 3881}, {0x000001062, 24, 3882}, {0x000001063, 24, 3883},
+	{0x000001064, 24, 3884}, {0x000001065, 24, 3885}, {0x000001066, 24, 3886}, {0x000001067, 24, 540},
+	{0x000001068, 24, 3887}, {0x000001069, 24, 3888}, {0x00000106a, 24, 3889}, {0x00000106b, 24, 3890},
+	{0x00000106c, 24, 3891}, {0x00000106d, 24, 3892}, {0x00000106e, 24, 3893}, {0x00000106f, 24, 3894},
+	{0x000001070, 24, 3895}, {0x000001071, 24, 3896}, {0x000001072, 24, 3897}, {0x000001073, 24, 3898},
+	{0x000001074, 24, 3899}, {0x000001075, 24, 3900}, {0x000001076, 24, 3901}, {0x000001077, 24, 3902},
+	{0x000001078, 24, 3903}, {0x000001079, 24, 3}, {0x00000107a, 24, 3904}, {0x00000107b, 24, 3},
+	{0x00000107c, 24, 3905}, {0x00000107d, 24, 3906}, {0x00000107e, 24, 3907}, {0x00000107f, 24, 3908},
+	{0x000001080, 24, 3909}, {0x000001081, 24, 3910}, {0x000001082, 24, 3911}, {0x000001083, 24, 3912},
+	{0x000001084, 24, 3913}, {0x000001085, 24, 3914}, {0x000001086, 24, 3915}, {0x000001087, 24, 3916},
+	{0x000001088, 24, 3917}, {0x000001089, 24, 3918}, {0x00000108a, 24, 3919}, {0x00000108b, 24, 3920},
+	{0x00000108c, 24, 3921}, {0x00000108d, 24, 3922}, {0x00000108e, 24, 3923}, {0x00000108f, 24, 3924},
+	{0x000001090, 24, 3925}, {0x000001091, 24, 3926}, {0x000001092, 24, 3927}, {0x000001093, 24, 3928},
+	{0x000001094, 24, 3929}, {0x000001095, 24, 3930}, {0x000001096, 24, 3931}, {0x000001097, 24, 3932},
+	{0x000001098, 24, 3933}, {0x000001099, 24, 3934}, {0x00000109a, 24, 3935}, {0x00000109b, 24, 189},
+	{0x00000109c, 24, 3936}, {0x00000109d, 24, 3937}, {0x00000109e, 24, 3938}, {0x00000109f, 24, 3939},
+	{0x0000010a0, 24, 3940}, {0x0000010a1, 24, 3941}, {0x0000010a2, 24, 3942}, {0x0000010a3, 24, 3943},
+	{0x0000010a4, 24, 3944}, {0x0000010a5, 24, 3945}, {0x0000010a6, 24, 3}, {0x0000010a7, 24, 398},
+	{0x0000010a8, 24, 3946}, {0x0000010a9, 24, 3947}, {0x0000010aa, 24, 3948}, {0x0000010ab, 24, 3949},
+	{0x0000010ac, 24, 3950}, {0x0000010ad, 24, 3951}, {0x0000010ae, 24, 3952}, {0x0000010af, 24, 3953},
+	{0x0000010b0, 24, 3954}, {0x0000010b1, 24, 3955}, {0x0000010b2, 24, 3956}, {0x0000010b3, 24, 3957},
+	{0x0000010b4, 24, 3958}, {0x0000010b5, 24, 3959}, {0x0000010b6, 24, 3960}, {0x0000010b7, 24, 3961},
+	{0x0000010b8, 24, 3962}, {0x0000010b9, 24, 3963}, {0x0000010ba, 24, 3964}, {0x0000010bb, 24, 3965},
+	{0x0000010bc, 24, 3966}, {0x0000010bd, 24, 3967}, {0x0000010be, 24, 3968}, {0x0000010bf, 24, 3969},
+	{0x0000010c0, 24, 3970}, {0x0000010c1, 24, 3971}, {0x0000010c2, 24, 3972}, {0x0000010c3, 24, 3973},
+	{0x0000010c4, 24, 3974}, {0x0000010c5, 24, 3975}, {0x0000010c6, 24, 3976}, {0x0000010c7, 24, 3977},
+	{0x0000010c8, 24, 3978}, {0x0000010c9, 24, 3979}, {0x0000010ca, 24, 22}, {0x0000010cb, 24, 3980},
+	{0x0000010cc, 24, 3981}, {0x0000010cd, 24, 3982}, {0x0000010ce, 24, 3983}, {0x0000010cf, 24, 3984},
+	{0x0000010d0, 24, 3985}, {0x0000010d1, 24, 3986}, {0x0000010d2, 24, 3987}, {0x0000010d3, 24, 3988},
+	{0x0000010d4, 24, 3989}, {0x0000010d5, 24, 3990}, {0x0000010d6, 24, 3991}, {0x0000010d7, 24, 3992},
+	{0x0000010d8, 24, 3993}, {0x0000010d9, 24, 3994}, {0x0000010da, 24, 3995}, {0x0000010db, 24, 1319},
+	{0x0000010dc, 24, 2969}, {0x0000010dd, 24, 3996}, {0x0000010de, 24, 3997}, {0x0000010df, 24, 3998},
+	{0x0000010e0, 24, 116}, {0x0000010e1, 24, 3999}, {0x0000010e2, 24, 4000}, {0x0000010e3, 24, 459},
+	{0x0000010e4, 24, 4001}, {0x0000010e5, 24, 4002}, {0x0000010e6, 24, 4003}, {0x0000010e7, 24, 4004},
+	{0x0000010e8, 24, 4005}, {0x0000010e9, 24, 4006}, {0x0000010ea, 24, 4007}, {0x0000010eb, 24, 4008},
+	{0x0000010ec, 24, 4009}, {0x0000010ed, 24, 4010}, {0x0000010ee, 24, 4011}, {0x0000010ef, 24, 4012},
+	{0x0000010f0, 24, 4013}, {0x0000010f1, 24, 4014}, {0x0000010f2, 24, 4015}, {0x0000010f3, 24, 4016},
+	{0x0000010f4, 24, 4017}, {0x0000010f5, 24, 4018}, {0x0000010f6, 24, 3}, {0x0000010f7, 24, 4019},
+	{0x0000010f8, 24, 4020}, {0x0000010f9, 24, 4021}, {0x0000010fa, 24, 865}, {0x0000010fb, 24, 4022},
+	{0x0000010fc, 24, 4023}, {0x0000010fd, 24, 4024}, {0x0000010fe, 24, 235}, {0x0000010ff, 24, 3},
+	{0x000001100, 24, 4025}, {0x000001101, 24, 4026}, {0x000001102, 24, 4027}, {0x000001103, 24, 4028},
+	{0x000001104, 24, 4029}, {0x000001105, 24, 4030}, {0x000001106, 24, 4031}, {0x000001107, 24, 4032},
+	{0x000001108, 24, 4033}, {0x000001109, 24, 4034}, {0x00000110a, 24, 459}, {0x00000110b, 24, 4035},
+	{0x00000110c, 24, 4036}, {0x00000110d, 24, 4037}, {0x00000110e, 24, 4038}, {0x00000110f, 24, 4039},
+	{0x000001110, 24, 4040}, {0x000001111, 24, 652}, {0x000001112, 24, 4041}, {0x000001113, 24, 4042},
+	{0x000001114, 24, 4043}, {0x000001115, 24, 4044}, {0x000001116, 24, 4045}, {0x000001117, 24, 4046},
+	{0x000001118, 24, 4047}, {0x000001119, 24, 4048}, {0x00000111a, 24, 185}, {0x00000111b, 24, 4049},
+	{0x00000111c, 24, 4050}, {0x00000111d, 24, 4051}, {0x00000111e, 24, 4052}, {0x00000111f, 24, 4053},
+	{0x000001120, 24, 3}, {0x000001121, 24, 3}, {0x000001122, 24, 4054}, {0x000001123, 24, 4055},
+	{0x000001124, 24, 865}, {0x000001125, 24, 564}, {0x000001126, 24, 4056}, {0x000001127, 24, 4057},
+	{0x000001128, 24, 4058}, {0x000001129, 24, 4059}, {0x00000112a, 24, 4060}, {0x00000112b, 24, 4061},
+	{0x00000112c, 24, 4062}, {0x00000112d, 24, 4063}, {0x00000112e, 24, 4064}, {0x00000112f, 24, 4065},
+	{0x000001130, 24, 4066}, {0x000001131, 24, 4067}, {0x000001132, 24, 4068}, {0x000001133, 24, 4069},
+	{0x000001134, 24, 4070}, {0x000001135, 24, 4071}, {0x000001136, 24, 4072}, {0x000001137, 24, 4073},
+	{0x000001138, 24, 4074}, {0x000001139, 24, 4075}, {0x00000113a, 24, 4076}, {0x00000113b, 24, 4077},
+	{0x00000113c, 24, 4078}, {0x00000113d, 24, 4079}, {0x00000113e, 24, 4080}, {0x00000113f, 24, 4081},
+	{0x000001140, 24, 4082}, {0x000001141, 24, 4083}, {0x000001142, 24, 4084}, {0x000001143, 24, 2822},
+	{0x000001144, 24, 4085}, {0x000001145, 24, 4086}, {0x000001146, 24, 4087}, {0x000001147, 24, 4088},
+	{0x000001148, 24, 4089}, {0x000001149, 24, 4090}, {0x00000114a, 24, 4091}, {0x00000114b, 24, 4092},
+	{0x00000114c, 24, 4093}, {0x00000114d, 24, 4094}, {0x00000114e, 24, 4095}, {0x00000114f, 24, 4096},
+	{0x000001150, 24, 4097}, {0x000001151, 24, 4098}, {0x000001152, 24, 4099}, {0x000001153, 24, 4100},
+	{0x000001154, 24, 4101}, {0x000001155, 24, 4102}, {0x000001156, 24, 4103}, {0x000001157, 24, 4104},
+	{0x000001158, 24, 108}, {0x000001159, 24, 4105}, {0x00000115a, 24, 4106}, {0x00000115b, 24, 1820},
+	{0x00000115c, 24, 3}, {0x00000115d, 24, 3}, {0x00000115e, 24, 4107}, {0x00000115f, 24, 4108},
+	{0x000001160, 24, 4109}, {0x000001161, 24, 4110}, {0x000001162, 24, 4111}, {0x000001163, 24, 4112},
+	{0x000001164, 24, 4113}, {0x000001165, 24, 4114}, {0x000001166, 24, 4115}, {0x000001167, 24, 4116},
+	{0x000001168, 24, 4117}, {0x000001169, 24, 4118}, {0x00000116a, 24, 4119}, {0x00000116b, 24, 4120},
+	{0x00000116c, 24, 4121}, {0x00000116d, 24, 4122}, {0x00000116e, 24, 4123}, {0x00000116f, 24, 4124},
+	{0x000001170, 24, 4125}, {0x000001171, 24, 4126}, {0x000001172, 24, 4127}, {0x000001173, 24, 4128},
+	{0x000001174, 24, 4129}, {0x000001175, 24, 652}, {0x000001176, 24, 4130}, {0x000001177, 24, 4131},
+	{0x000001178, 24, 4132}, {0x000001179, 24, 4133}, {0x00000117a, 24, 4134}, {0x00000117b, 24, 4135},
+	{0x00000117c, 24, 4136}, {0x00000117d, 24, 4137}, {0x00000117e, 24, 4138}, {0x00000117f, 24, 4139},
+	{0x000001180, 24, 185}, {0x000001181, 24, 4140}, {0x000001182, 24, 4141}, {0x000001183, 24, 4142},
+	{0x000001184, 24, 4143}, {0x000001185, 24, 459}, {0x000001186, 24, 4144}, {0x000001187, 24, 4145},
+	{0x000001188, 24, 4146}, {0x000001189, 24, 4147}, {0x00000118a, 24, 4148}, {0x00000118b, 24, 4149},
+	{0x00000118c, 24, 4150}, {0x00000118d, 24, 4151}, {0x00000118e, 24, 4152}, {0x00000118f, 24, 4153},
+	{0x000001190, 24, 4154}, {0x000001191, 24, 4155}, {0x000001192, 24, 3}, {0x000001193, 24, 3},
+	{0x000001194, 24, 4156}, {0x000001195, 24, 3223}, {0x000001196, 24, 4157}, {0x000001197, 24, 4158},
+	{0x000001198, 24, 4159}, {0x000001199, 24, 4160}, {0x00000119a, 24, 4161}, {0x00000119b, 24, 4162},
+	{0x00000119c, 24, 4163}, {0x00000119d, 24, 4164}, {0x00000119e, 24, 4165}, {0x00000119f, 24, 709},
+	{0x0000011a0, 24, 4166}, {0x0000011a1, 24, 4167}, {0x0000011a2, 24, 4168}, {0x0000011a3, 24, 4169},
+	{0x0000011a4, 24, 4170}, {0x0000011a5, 24, 4171}, {0x0000011a6, 24, 4172}, {0x0000011a7, 24, 4173},
+	{0x0000011a8, 24, 4174}, {0x0000011a9, 24, 4175}, {0x0000011aa, 24, 4176}, {0x0000011ab, 24, 4177},
+	{0x0000011ac, 24, 4178}, {0x0000011ad, 24, 4179}, {0x0000011ae, 24, 185}, {0x0000011af, 24, 4180},
+	{0x0000011b0, 24, 4181}, {0x0000011b1, 24, 4182}, {0x0000011b2, 24, 4183}, {0x0000011b3, 24, 4184},
+	{0x0000011b4, 24, 1799}, {0x0000011b5, 24, 4185}, {0x0000011b6, 24, 4186}, {0x0000011b7, 24, 4187},
+	{0x0000011b8, 24, 4188}, {0x0000011b9, 24, 4189}, {0x0000011ba, 24, 4190}, {0x0000011bb, 24, 3},
+	{0x0000011bc, 24, 3}, {0x0000011bd, 24, 4191}, {0x0000011be, 24, 4192}, {0x0000011bf, 24, 4193},
+	{0x0000011c0, 24, 4194}, {0x0000011c1, 24, 4195}, {0x0000011c2, 24, 4196}, {0x0000011c3, 24, 4197},
+	{0x0000011c4, 24, 4198}, {0x0000011c5, 24, 4199}, {0x0000011c6, 24, 1157}, {0x0000011c7, 24, 4200},
+	{0x0000011c8, 24, 4201}, {0x0000011c9, 24, 4202}, {0x0000011ca, 24, 4203}, {0x0000011cb, 24, 4204},
+	{0x0000011cc, 24, 4205}, {0x0000011cd, 24, 4206}, {0x0000011ce, 24, 4207}, {0x0000011cf, 24, 4208},
+	{0x0000011d0, 24, 4209}, {0x0000011d1, 24, 4210}, {0x0000011d2, 24, 4211}, {0x0000011d3, 24, 4212},
+	{0x0000011d4, 24, 4213}, {0x0000011d5, 24, 4214}, {0x0000011d6, 24, 4215}, {0x0000011d7, 24, 4216},
+	{0x0000011d8, 24, 4065}, {0x0000011d9, 24, 4217}, {0x0000011da, 24, 4218}, {0x0000011db, 24, 4219},
+	{0x0000011dc, 24, 4220}, {0x0000011dd, 24, 4221}, {0x0000011de, 24, 4222}, {0x0000011df, 24, 4223},
+	{0x0000011e0, 24, 4224}, {0x0000011e1, 24, 4225}, {0x0000011e2, 24, 4226}, {0x0000011e3, 24, 4227},
+	{0x0000011e4, 24, 4228}, {0x0000011e5, 24, 4229}, {0x0000011e6, 24, 2485}, {0x0000011e7, 24, 4230},
+	{0x0000011e8, 24, 4231}, {0x0000011e9, 24, 4232}, {0x0000011ea, 24, 4233}, {0x0000011eb, 24, 4234},
+	{0x0000011ec, 24, 4235}, {0x0000011ed, 24, 4236}, {0x0000011ee, 24, 4237}, {0x0000011ef, 24, 4238},
+	{0x0000011f0, 24, 4239}, {0x0000011f1, 24, 4240}, {0x0000011f2, 24, 4241}, {0x0000011f3, 24, 4242},
+	{0x0000011f4, 24, 4243}, {0x0000011f5, 24, 4244}, {0x0000011f6, 24, 4245}, {0x0000011f7, 24, 4246},
+	{0x0000011f8, 24, 4247}, {0x0000011f9, 24, 108}, {0x0000011fa, 24, 4248}, {0x0000011fb, 24, 4249},
+	{0x0000011fc, 24, 4250}, {0x0000011fd, 24, 4251}, {0x0000011fe, 24, 4252}, {0x0000011ff, 24, 4253},
+	{0x000001200, 24, 3}, {0x000001201, 24, 3}, {0x000001202, 24, 4254}, {0x000001203, 24, 4255},
+	{0x000001204, 24, 4256}, {0x000001205, 24, 4257}, {0x000001206, 24, 4258}, {0x000001207, 24, 4259},
+	{0x000001208, 24, 4260}, {0x000001209, 24, 4261}, {0x00000120a, 24, 4262}, {0x00000120b, 24, 4263},
+	{0x00000120c, 24, 4264}, {0x00000120d, 24, 4265}, {0x00000120e, 24, 4266}, {0x00000120f, 24, 4267},
+	{0x000001210, 24, 4268}, {0x000001211, 24, 4269}, {0x000001212, 24, 4270}, {0x000001213, 24, 4271},
+	{0x000001214, 24, 4272}, {0x000001215, 24, 4273}, {0x000001216, 24, 4274}, {0x000001217, 24, 4275},
+	{0x000001218, 24, 4276}, {0x000001219, 24, 4277}, {0x00000121a, 24, 4278}, {0x00000121b, 24, 4279},
+	{0x00000121c, 24, 4280}, {0x00000121d, 24, 4281}, {0x00000121e, 24, 1319}, {0x00000121f, 24, 4282},
+	{0x000001220, 24, 4283}, {0x000001221, 24, 4284}, {0x000001222, 24, 4285}, {0x000001223, 24, 4286},
+	{0x000001224, 24, 4287}, {0x000001225, 24, 185}, {0x000001226, 24, 4288}, {0x000001227, 24, 4289},
+	{0x000001228, 24, 4290}, {0x000001229, 24, 4291}, {0x00000122a, 24, 4292}, {0x00000122b, 24, 4293},
+	{0x00000122c, 24, 4294}, {0x00000122d, 24, 4295}, {0x00000122e, 24, 4296}, {0x00000122f, 24, 4297},
+	{0x000001230, 24, 4298}, {0x000001231, 24, 4299}, {0x000001232, 24, 4300}, {0x000001233, 24, 4301},
+	{0x000001234, 24, 4302}, {0x000001235, 24, 4303}, {0x000001236, 24, 4304}, {0x000001237, 24, 4305},
+	{0x000001238, 24, 4306}, {0x000001239, 24, 4307}, {0x00000123a, 24, 4308}, {0x00000123b, 24, 4309},
+	{0x00000123c, 24, 4310}, {0x00000123d, 24, 4311}, {0x00000123e, 24, 4312}, {0x00000123f, 24, 2822},
+	{0x000001240, 24, 4313}, {0x000001241, 24, 4314}, {0x000001242, 24, 4315}, {0x000001243, 24, 3},
+	{0x000001244, 24, 3}, {0x000001245, 24, 4316}, {0x000001246, 24, 4317}, {0x000001247, 24, 4318},
+	{0x000001248, 24, 4319}, {0x000001249, 24, 4320}, {0x00000124a, 24, 4321}, {0x00000124b, 24, 4305},
+	{0x00000124c, 24, 4322}, {0x00000124d, 24, 4323}, {0x00000124e, 24, 4324}, {0x00000124f, 24, 4325},
+	{0x000001250, 24, 4326}, {0x000001251, 24, 4327}, {0x000001252, 24, 4328}, {0x000001253, 24, 4329},
+	{0x000001254, 24, 4330}, {0x000001255, 24, 4331}, {0x000001256, 24, 4332}, {0x000001257, 24, 4333},
+	{0x000001258, 24, 4334}, {0x000001259, 24, 4335}, {0x00000125a, 24, 965}, {0x00000125b, 24, 4336},
+	{0x00000125c, 24, 4337}, {0x00000125d, 24, 4338}, {0x00000125e, 24, 4339}, {0x00000125f, 24, 4340},
+	{0x000001260, 24, 4341}, {0x000001261, 24, 4342}, {0x000001262, 24, 709}, {0x000001263, 24, 4343},
+	{0x000001264, 24, 4344}, {0x000001265, 24, 4345}, {0x000001266, 24, 4346}, {0x000001267, 24, 4347},
+	{0x000001268, 24, 4348}, {0x000001269, 24, 4349}, {0x00000126a, 24, 4350}, {0x00000126b, 24, 4351},
+	{0x00000126c, 24, 4352}, {0x00000126d, 24, 4353}, {0x00000126e, 24, 4354}, {0x00000126f, 24, 4355},
+	{0x000001270, 24, 4356}, {0x000001271, 24, 4357}, {0x000001272, 24, 4358}, {0x000001273, 24, 4359},
+	{0x000001274, 24, 4360}, {0x000001275, 24, 4361}, {0x000001276, 24, 4362}, {0x000001277, 24, 4363},
+	{0x000001278, 24, 4364}, {0x000001279, 24, 459}, {0x00000127a, 24, 4365}, {0x00000127b, 24, 4366},
+	{0x00000127c, 24, 4367}, {0x00000127d, 24, 4368}, {0x00000127e, 24, 4369}, {0x00000127f, 24, 3},
+	{0x000001280, 24, 3}, {0x000001281, 24, 4370}, {0x000001282, 24, 4371}, {0x000001283, 24, 108},
+	{0x000001284, 24, 4372}, {0x000001285, 24, 4373}, {0x000001286, 24, 4374}, {0x000001287, 24, 4375},
+	{0x000001288, 24, 3202}, {0x000001289, 24, 4376}, {0x00000128a, 24, 185}, {0x00000128b, 24, 4377},
+	{0x00000128c, 24, 4378}, {0x00000128d, 24, 4379}, {0x00000128e, 24, 4380}, {0x00000128f, 24, 4381},
+	{0x000001290, 24, 4382}, {0x000001291, 24, 4383}, {0x000001292, 24, 4384}, {0x000001293, 24, 4385},
+	{0x000001294, 24, 4386}, {0x000001295, 24, 4387}, {0x000001296, 24, 4388}, {0x000001297, 24, 4389},
+	{0x000001298, 24, 4390}, {0x000001299, 24, 4391}, {0x00000129a, 24, 4392}, {0x00000129b, 24, 4393},
+	{0x00000129c, 24, 4394}, {0x00000129d, 24, 4395}, {0x00000129e, 24, 4396}, {0x00000129f, 24, 4397},
+	{0x0000012a0, 24, 4398}, {0x0000012a1, 24, 4399}, {0x0000012a2, 24, 4400}, {0x0000012a3, 24, 4401},
+	{0x0000012a4, 24, 4402}, {0x0000012a5, 24, 4403}, {0x0000012a6, 24, 4404}, {0x0000012a7, 24, 4405},
+	{0x0000012a8, 24, 4406}, {0x0000012a9, 24, 2380}, {0x0000012aa, 24, 4407}, {0x0000012ab, 24, 4408},
+	{0x0000012ac, 24, 4409}, {0x0000012ad, 24, 4410}, {0x0000012ae, 24, 4411}, {0x0000012af, 24, 4412},
+	{0x0000012b0, 24, 4413}, {0x0000012b1, 24, 4414}, {0x0000012b2, 24, 4415}, {0x0000012b3, 24, 4416},
+	{0x0000012b4, 24, 4417}, {0x0000012b5, 24, 4418}, {0x0000012b6, 24, 4419}, {0x0000012b7, 24, 4420},
+	{0x0000012b8, 24, 4421}, {0x0000012b9, 24, 4422}, {0x0000012ba, 24, 4423}, {0x0000012bb, 24, 4424},
+	{0x0000012bc, 24, 4425}, {0x0000012bd, 24, 4426}, {0x0000012be, 24, 4427}, {0x0000012bf, 24, 4428},
+	{0x0000012c0, 24, 4429}, {0x0000012c1, 24, 4430}, {0x0000012c2, 24, 4431}, {0x0000012c3, 24, 4432},
+	{0x0000012c4, 24, 4433}, {0x0000012c5, 24, 4434}, {0x0000012c6, 24, 4435}, {0x0000012c7, 24, 4436},
+	{0x0000012c8, 24, 4437}, {0x0000012c9, 24, 185}, {0x0000012ca, 24, 4438}, {0x0000012cb, 24, 4439},
+	{0x0000012cc, 24, 4440}, {0x0000012cd, 24, 4441}, {0x0000012ce, 24, 4442}, {0x0000012cf, 24, 4443},
+	{0x0000012d0, 24, 4444}, {0x0000012d1, 24, 4445}, {0x0000012d2, 24, 4305}, {0x0000012d3, 24, 4446},
+	{0x0000012d4, 24, 4447}, {0x0000012d5, 24, 4448}, {0x0000012d6, 24, 4449}, {0x0000012d7, 24, 4450},
+	{0x0000012d8, 24, 4451}, {0x0000012d9, 24, 3}, {0x0000012da, 24, 3}, {0x0000012db, 24, 4452},
+	{0x0000012dc, 24, 4453}, {0x0000012dd, 24, 4454}, {0x0000012de, 24, 4455}, {0x0000012df, 24, 4456},
+	{0x0000012e0, 24, 4457}, {0x0000012e1, 24, 4458}, {0x0000012e2, 24, 4459}, {0x0000012e3, 24, 4460},
+	{0x0000012e4, 24, 4461}, {0x0000012e5, 24, 4462}, {0x0000012e6, 24, 4463}, {0x0000012e7, 24, 4464},
+	{0x0000012e8, 24, 4465}, {0x0000012e9, 24, 4466}, {0x0000012ea, 24, 4467}, {0x0000012eb, 24, 4468},
+	{0x0000012ec, 24, 4469}, {0x0000012ed, 24, 4470}, {0x0000012ee, 24, 2582}, {0x0000012ef, 24, 4471},
+	{0x0000012f0, 24, 4472}, {0x0000012f1, 24, 4473}, {0x0000012f2, 24, 1085}, {0x0000012f3, 24, 4474},
+	{0x0000012f4, 24, 4475}, {0x0000012f5, 24, 4476}, {0x0000012f6, 24, 4477}, {0x0000012f7, 24, 4478},
+	{0x0000012f8, 24, 4479}, {0x0000012f9, 24, 4480}, {0x0000012fa, 24, 4481}, {0x0000012fb, 24, 4482},
+	{0x0000012fc, 24, 4483}, {0x0000012fd, 24, 4484}, {0x0000012fe, 24, 4485}, {0x0000012ff, 24, 4486},
+	{0x000001300, 24, 4487}, {0x000001301, 24, 4488}, {0x000001302, 24, 4472}, {0x000001303, 24, 4489},
+	{0x000001304, 24, 4490}, {0x000001305, 24, 4491}, {0x000001306, 24, 4492}, {0x000001307, 24, 4493},
+	{0x000001308, 24, 4494}, {0x000001309, 24, 4495}, {0x00000130a, 24, 4496}, {0x00000130b, 24, 4497},
+	{0x00000130c, 24, 4498}, {0x00000130d, 24, 4499}, {0x00000130e, 24, 4500}, {0x00000130f, 24, 4501},
+	{0x000001310, 24, 4275}, {0x000001311, 24, 190}, {0x000001312, 24, 4502}, {0x000001313, 24, 4503},
+	{0x000001314, 24, 4504}, {0x000001315, 24, 4505}, {0x000001316, 24, 4506}, {0x000001317, 24, 4507},
+	{0x000001318, 24, 4508}, {0x000001319, 24, 3}, {0x00000131a, 24, 3}, {0x00000131b, 24, 4509},
+	{0x00000131c, 24, 4510}, {0x00000131d, 24, 4511}, {0x00000131e, 24, 4512}, {0x00000131f, 24, 4513},
+	{0x000001320, 24, 4472}, {0x000001321, 24, 459}, {0x000001322, 24, 4514}, {0x000001323, 24, 4515},
+	{0x000001324, 24, 4516}, {0x000001325, 24, 4517}, {0x000001326, 24, 4518}, {0x000001327, 24, 4519},
+	{0x000001328, 24, 4520}, {0x000001329, 24, 4521}, {0x00000132a, 24, 4522}, {0x00000132b, 24, 4523},
+	{0x00000132c, 24, 4524}, {0x00000132d, 24, 4525}, {0x00000132e, 24, 4526}, {0x00000132f, 24, 4527},
+	{0x000001330, 24, 4528}, {0x000001331, 24, 4529}, {0x000001332, 24, 4530}, {0x000001333, 24, 4531},
+	{0x000001334, 24, 4532}, {0x000001335, 24, 4533}, {0x000001336, 24, 4534}, {0x000001337, 24, 4535},
+	{0x000001338, 24, 4536}, {0x000001339, 24, 4537}, {0x00000133a, 24, 4538}, {0x00000133b, 24, 4539},
+	{0x00000133c, 24, 4540}, {0x00000133d, 24, 4541}, {0x00000133e, 24, 4542}, {0x00000133f, 24, 4543},
+	{0x000001340, 24, 4544}, {0x000001341, 24, 4545}, {0x000001342, 24, 4546}, {0x000001343, 24, 4547},
+	{0x000001344, 24, 4548}, {0x000001345, 24, 4549}, {0x000001346, 24, 3223}, {0x000001347, 24, 1846},
+	{0x000001348, 24, 4550}, {0x000001349, 24, 4551}, {0x00000134a, 24, 4552}, {0x00000134b, 24, 4553},
+	{0x00000134c, 24, 4554}, {0x00000134d, 24, 4555}, {0x00000134e, 24, 4556}, {0x00000134f, 24, 4557},
+	{0x000001350, 24, 4558}, {0x000001351, 24, 4559}, {0x000001352, 24, 4560}, {0x000001353, 24, 4561},
+	{0x000001354, 24, 4562}, {0x000001355, 24, 4563}, {0x000001356, 24, 4564}, {0x000001357, 24, 4565},
+	{0x000001358, 24, 4566}, {0x000001359, 24, 4567}, {0x00000135a, 24, 4568}, {0x00000135b, 24, 4569},
+	{0x00000135c, 24, 4570}, {0x00000135d, 24, 4571}, {0x00000135e, 24, 4572}, {0x00000135f, 24, 3},
+	{0x000001360, 24, 3}, {0x000001361, 24, 4573}, {0x000001362, 24, 4574}, {0x000001363, 24, 4575},
+	{0x000001364, 24, 4576}, {0x000001365, 24, 4496}, {0x000001366, 24, 4577}, {0x000001367, 24, 4578},
+	{0x000001368, 24, 4579}, {0x000001369, 24, 4580}, {0x00000136a, 24, 4581}, {0x00000136b, 24, 4582},
+	{0x00000136c, 24, 4583}, {0x00000136d, 24, 4584}, {0x00000136e, 24, 4585}, {0x00000136f, 24, 4586},
+	{0x000001370, 24, 709}, {0x000001371, 24, 185}, {0x000001372, 24, 2822}, {0x000001373, 24, 4587},
+	{0x000001374, 24, 845}, {0x000001375, 24, 4588}, {0x000001376, 24, 4589}, {0x000001377, 24, 4590},
+	{0x000001378, 24, 4591}, {0x000001379, 24, 4592}, {0x00000137a, 24, 4593}, {0x00000137b, 24, 4594},
+	{0x00000137c, 24, 4595}, {0x00000137d, 24, 4596}, {0x00000137e, 24, 4597}, {0x00000137f, 24, 3},
+	{0x000001380, 24, 3}, {0x000001381, 24, 4598}, {0x000001382, 24, 4599}, {0x000001383, 24, 4600},
+	{0x000001384, 24, 4601}, {0x000001385, 24, 4602}, {0x000001386, 24, 4603}, {0x000001387, 24, 4604},
+	{0x000001388, 24, 4605}, {0x000001389, 24, 4606}, {0x00000138a, 24, 4607}, {0x00000138b, 24, 4608},
+	{0x00000138c, 24, 4609}, {0x00000138d, 24, 4610}, {0x00000138e, 24, 4611}, {0x00000138f, 24, 2717},
+	{0x000001390, 24, 4612}, {0x000001391, 24, 4613}, {0x000001392, 24, 4614}, {0x000001393, 24, 4615},
+	{0x000001394, 24, 4616}, {0x000001395, 24, 4617}, {0x000001396, 24, 4618}, {0x000001397, 24, 116},
+	{0x000001398, 24, 4619}, {0x000001399, 24, 4620}, {0x00000139a, 24, 4621}, {0x00000139b, 24, 4622},
+	{0x00000139c, 24, 4623}, {0x00000139d, 24, 4624}, {0x00000139e, 24, 4625}, {0x00000139f, 24, 4626},
+	{0x0000013a0, 24, 4627}, {0x0000013a1, 24, 4628}, {0x0000013a2, 24, 4629}, {0x0000013a3, 24, 4630},
+	{0x0000013a4, 24, 4631}, {0x0000013a5, 24, 4632}, {0x0000013a6, 24, 4633}, {0x0000013a7, 24, 4634},
+	{0x0000013a8, 24, 4635}, {0x0000013a9, 24, 311}, {0x0000013aa, 24, 4636}, {0x0000013ab, 24, 4637},
+	{0x0000013ac, 24, 4638}, {0x0000013ad, 24, 4639}, {0x0000013ae, 24, 4640}, {0x0000013af, 24, 4641},
+	{0x0000013b0, 24, 4642}, {0x0000013b1, 24, 4643}, {0x0000013b2, 24, 4644}, {0x0000013b3, 24, 4645},
+	{0x0000013b4, 24, 4646}, {0x0000013b5, 24, 4647}, {0x0000013b6, 24, 4648}, {0x0000013b7, 24, 4649},
+	{0x0000013b8, 24, 4650}, {0x0000013b9, 24, 4651}, {0x0000013ba, 24, 4652}, {0x0000013bb, 24, 4653},
+	{0x0000013bc, 24, 4654}, {0x0000013bd, 24, 4655}, {0x0000013be, 24, 4656}, {0x0000013bf, 24, 4657},
+	{0x0000013c0, 24, 4658}, {0x0000013c1, 24, 4659}, {0x0000013c2, 24, 4660}, {0x0000013c3, 24, 3},
+	{0x0000013c4, 24, 3}, {0x0000013c5, 24, 4661}, {0x0000013c6, 24, 4662}, {0x0000013c7, 24, 4663},
+	{0x0000013c8, 24, 1957}, {0x0000013c9, 24, 4664}, {0x0000013ca, 24, 4665}, {0x0000013cb, 24, 4666},
+	{0x0000013cc, 24, 4667}, {0x0000013cd, 24, 4668}, {0x0000013ce, 24, 4472}, {0x0000013cf, 24, 4669},
+	{0x0000013d0, 24, 4670}, {0x0000013d1, 24, 4671}, {0x0000013d2, 24, 4672}, {0x0000013d3, 24, 2969},
+	{0x0000013d4, 24, 4673}, {0x0000013d5, 24, 4674}, {0x0000013d6, 24, 4675}, {0x0000013d7, 24, 4676},
+	{0x0000013d8, 24, 4677}, {0x0000013d9, 24, 4678}, {0x0000013da, 24, 4679}, {0x0000013db, 24, 4680},
+	{0x0000013dc, 24, 4681}, {0x0000013dd, 24, 4682}, {0x0000013de, 24, 4683}, {0x0000013df, 24, 4684},
+	{0x0000013e0, 24, 3430}, {0x0000013e1, 24, 4685}, {0x0000013e2, 24, 4686}, {0x0000013e3, 24, 4687},
+	{0x0000013e4, 24, 4688}, {0x0000013e5, 24, 4689}, {0x0000013e6, 24, 4690}, {0x0000013e7, 24, 4691},
+	{0x0000013e8, 24, 4472}, {0x0000013e9, 24, 4692}, {0x0000013ea, 24, 4693}, {0x0000013eb, 24, 4694},
+	{0x0000013ec, 24, 4695}, {0x0000013ed, 24, 4696}, {0x0000013ee, 24, 4697}, {0x0000013ef, 24, 4698},
+	{0x0000013f0, 24, 4699}, {0x0000013f1, 24, 4700}, {0x0000013f2, 24, 4701}, {0x0000013f3, 24, 4702},
+	{0x0000013f4, 24, 4703}, {0x0000013f5, 24, 4704}, {0x0000013f6, 24, 4705}, {0x0000013f7, 24, 1173},
+	{0x0000013f8, 24, 4706}, {0x0000013f9, 24, 4707}, {0x0000013fa, 24, 4708}, {0x0000013fb, 24, 4709},
+	{0x0000013fc, 24, 4710}, {0x0000013fd, 24, 709}, {0x0000013fe, 24, 4711}, {0x0000013ff, 24, 4712},
+	{0x000001400, 24, 4713}, {0x000001401, 24, 4714}, {0x000001402, 24, 4715}, {0x000001403, 24, 4716},
+	{0x000001404, 24, 185}, {0x000001405, 24, 4717}, {0x000001406, 24, 4718}, {0x000001407, 24, 4719},
+	{0x000001408, 24, 4720}, {0x000001409, 24, 4721}, {0x00000140a, 24, 4722}, {0x00000140b, 24, 4723},
+	{0x00000140c, 24, 4724}, {0x00000140d, 24, 4496}, {0x00000140e, 24, 4496}, {0x00000140f, 24, 4725},
+	{0x000001410, 24, 4726}, {0x000001411, 24, 4727}, {0x000001412, 24, 4728}, {0x000001413, 24, 4729},
+	{0x000001414, 24, 4730}, {0x000001415, 24, 4731}, {0x000001416, 24, 4732}, {0x000001417, 24, 4733},
+	{0x000001418, 24, 4734}, {0x000001419, 24, 4735}, {0x00000141a, 24, 4736}, {0x00000141b, 24, 3},
+	{0x00000141c, 24, 3}, {0x00000141d, 24, 4737}, {0x00000141e, 24, 4738}, {0x00000141f, 24, 4739},
+	{0x000001420, 24, 4740}, {0x000001421, 24, 4741}, {0x000001422, 24, 2822}, {0x000001423, 24, 4742},
+	{0x000001424, 24, 4743}, {0x000001425, 24, 4744}, {0x000001426, 24, 4745}, {0x000001427, 24, 4746},
+	{0x000001428, 24, 4747}, {0x000001429, 24, 4748}, {0x00000142a, 24, 4749}, {0x00000142b, 24, 4750},
+	{0x00000142c, 24, 4751}, {0x00000142d, 24, 4752}, {0x00000142e, 24, 4753}, {0x00000142f, 24, 4754},
+	{0x000001430, 24, 4755}, {0x000001431, 24, 4756}, {0x000001432, 24, 4757}, {0x000001433, 24, 4758},
+	{0x000001434, 24, 4759}, {0x000001435, 24, 4760}, {0x000001436, 24, 4761}, {0x000001437, 24, 4762},
+	{0x000001438, 24, 459}, {0x000001439, 24, 4763}, {0x00000143a, 24, 4764}, {0x00000143b, 24, 4765},
+	{0x00000143c, 24, 4766}, {0x00000143d, 24, 4767}, {0x00000143e, 24, 4768}, {0x00000143f, 24, 4769},
+	{0x000001440, 24, 4770}, {0x000001441, 24, 4771}, {0x000001442, 24, 4772}, {0x000001443, 24, 4773},
+	{0x000001444, 24, 4774}, {0x000001445, 24, 4775}, {0x000001446, 24, 4776}, {0x000001447, 24, 4777},
+	{0x000001448, 24, 4778}, {0x000001449, 24, 4779}, {0x00000144a, 24, 4780}, {0x00000144b, 24, 4781},
+	{0x00000144c, 24, 4782}, {0x00000144d, 24, 4783}, {0x00000144e, 24, 4784}, {0x00000144f, 24, 116},
+	{0x000001450, 24, 4785}, {0x000001451, 24, 865}, {0x000001452, 24, 4786}, {0x000001453, 24, 4787},
+	{0x000001454, 24, 4788}, {0x000001455, 24, 4789}, {0x000001456, 24, 4790}, {0x000001457, 24, 4791},
+	{0x000001458, 24, 4792}, {0x000001459, 24, 4793}, {0x00000145a, 24, 4794}, {0x00000145b, 24, 4795},
+	{0x00000145c, 24, 4796}, {0x00000145d, 24, 4797}, {0x00000145e, 24, 564}, {0x00000145f, 24, 4798},
+	{0x000001460, 24, 4799}, {0x000001461, 24, 4800}, {0x000001462, 24, 4801}, {0x000001463, 24, 4802},
+	{0x000001464, 24, 4803}, {0x000001465, 24, 4804}, {0x000001466, 24, 4805}, {0x000001467, 24, 4806},
+	{0x000001468, 24, 4807}, {0x000001469, 24, 3}, {0x00000146a, 24, 3}, {0x00000146b, 24, 4808},
+	{0x00000146c, 24, 4809}, {0x00000146d, 24, 4810}, {0x00000146e, 24, 4811}, {0x00000146f, 24, 4812},
+	{0x000001470, 24, 4813}, {0x000001471, 24, 4814}, {0x000001472, 24, 4815}, {0x000001473, 24, 4816},
+	{0x000001474, 24, 4817}, {0x000001475, 24, 4818}, {0x000001476, 24, 4819}, {0x000001477, 24, 4820},
+	{0x000001478, 24, 4821}, {0x000001479, 24, 4822}, {0x00000147a, 24, 4823}, {0x00000147b, 24, 4824},
+	{0x00000147c, 24, 2380}, {0x00000147d, 24, 4825}, {0x00000147e, 24, 4826}, {0x00000147f, 24, 3404},
+	{0x000001480, 24, 4827}, {0x000001481, 24, 4828}, {0x000001482, 24, 1826}, {0x000001483, 24, 4829},
+	{0x000001484, 24, 4830}, {0x000001485, 24, 4831}, {0x000001486, 24, 4832}, {0x000001487, 24, 4833},
+	{0x000001488, 24, 4834}, {0x000001489, 24, 4835}, {0x00000148a, 24, 4836}, {0x00000148b, 24, 4837},
+	{0x00000148c, 24, 4838}, {0x00000148d, 24, 4839}, {0x00000148e, 24, 4840}, {0x00000148f, 24, 4841},
+	{0x000001490, 24, 4842}, {0x000001491, 24, 4843}, {0x000001492, 24, 4844}, {0x000001493, 24, 4845},
+	{0x000001494, 24, 4846}, {0x000001495, 24, 4847}, {0x000001496, 24, 4848}, {0x000001497, 24, 4849},
+	{0x000001498, 24, 4850}, {0x000001499, 24, 4851}, {0x00000149a, 24, 185}, {0x00000149b, 24, 4852},
+	{0x00000149c, 24, 4853}, {0x00000149d, 24, 4854}, {0x00000149e, 24, 4855}, {0x00000149f, 24, 4856},
+	{0x0000014a0, 24, 4857}, {0x0000014a1, 24, 4858}, {0x0000014a2, 24, 4859}, {0x0000014a3, 24, 4860},
+	{0x0000014a4, 24, 4861}, {0x0000014a5, 24, 2348}, {0x0000014a6, 24, 4862}, {0x0000014a7, 24, 709},
+	{0x0000014a8, 24, 3}, {0x0000014a9, 24, 3}, {0x0000014aa, 24, 4863}, {0x0000014ab, 24, 4864},
+	{0x0000014ac, 24, 4865}, {0x0000014ad, 24, 4866}, {0x0000014ae, 24, 4867}, {0x0000014af, 24, 4868},
+	{0x0000014b0, 24, 4869}, {0x0000014b1, 24, 4870}, {0x0000014b2, 24, 4871}, {0x0000014b3, 24, 4872},
+	{0x0000014b4, 24, 4873}, {0x0000014b5, 24, 4874}, {0x0000014b6, 24, 4875}, {0x0000014b7, 24, 4876},
+	{0x0000014b8, 24, 4877}, {0x0000014b9, 24, 4878}, {0x0000014ba, 24, 4879}, {0x0000014bb, 24, 4880},
+	{0x0000014bc, 24, 4881}, {0x0000014bd, 24, 4882}, {0x0000014be, 24, 4883}, {0x0000014bf, 24, 4884},
+	{0x0000014c0, 24, 4885}, {0x0000014c1, 24, 4886}, {0x0000014c2, 24, 459}, {0x0000014c3, 24, 1157},
+	{0x0000014c4, 24, 4887}, {0x0000014c5, 24, 4888}, {0x0000014c6, 24, 4889}, {0x0000014c7, 24, 4496},
+	{0x0000014c8, 24, 4890}, {0x0000014c9, 24, 127}, {0x0000014ca, 24, 4891}, {0x0000014cb, 24, 4892},
+	{0x0000014cc, 24, 4893}, {0x0000014cd, 24, 4894}, {0x0000014ce, 24, 4895}, {0x0000014cf, 24, 4896},
+	{0x0000014d0, 24, 4897}, {0x0000014d1, 24, 4898}, {0x0000014d2, 24, 4899}, {0x0000014d3, 24, 4900},
+	{0x0000014d4, 24, 4901}, {0x0000014d5, 24, 4902}, {0x0000014d6, 24, 4903}, {0x0000014d7, 24, 4904},
+	{0x0000014d8, 24, 4905}, {0x0000014d9, 24, 4906}, {0x0000014da, 24, 4907}, {0x0000014db, 24, 4908},
+	{0x0000014dc, 24, 4909}, {0x0000014dd, 24, 4910}, {0x0000014de, 24, 4911}, {0x0000014df, 24, 4912},
+	{0x0000014e0, 24, 4913}, {0x0000014e1, 24, 4914}, {0x0000014e2, 24, 4915}, {0x0000014e3, 24, 4916},
+	{0x0000014e4, 24, 4917}, {0x0000014e5, 24, 4918}, {0x0000014e6, 24, 4919}, {0x0000014e7, 24, 4920},
+	{0x0000014e8, 24, 185}, {0x0000014e9, 24, 4921}, {0x0000014ea, 24, 4922}, {0x0000014eb, 24, 4923},
+	{0x0000014ec, 24, 4924}, {0x0000014ed, 24, 4925}, {0x0000014ee, 24, 4926}, {0x0000014ef, 24, 4927},
+	{0x0000014f0, 24, 4928}, {0x0000014f1, 24, 3}, {0x0000014f2, 24, 3}, {0x0000014f3, 24, 4929},
+	{0x0000014f4, 24, 4930}, {0x0000014f5, 24, 4931}, {0x0000014f6, 24, 1319}, {0x0000014f7, 24, 4932},
+	{0x0000014f8, 24, 2485}, {0x0000014f9, 24, 4933}, {0x0000014fa, 24, 4934}, {0x0000014fb, 24, 4935},
+	{0x0000014fc, 24, 4936}, {0x0000014fd, 24, 4937}, {0x0000014fe, 24, 4938}, {0x0000014ff, 24, 4939},
+	{0x000001500, 24, 4472}, {0x000001501, 24, 4940}, {0x000001502, 24, 4941}, {0x000001503, 24, 4942},
+	{0x000001504, 24, 4943}, {0x000001505, 24, 3726}, {0x000001506, 24, 4944}, {0x000001507, 24, 4945},
+	{0x000001508, 24, 4946}, {0x000001509, 24, 4947}, {0x00000150a, 24, 4948}, {0x00000150b, 24, 4949},
+	{0x00000150c, 24, 980}, {0x00000150d, 24, 4950}, {0x00000150e, 24, 4951}, {0x00000150f, 24, 4952},
+	{0x000001510, 24, 4953}, {0x000001511, 24, 4954}, {0x000001512, 24, 4955}, {0x000001513, 24, 4956},
+	{0x000001514, 24, 4957}, {0x000001515, 24, 4958}, {0x000001516, 24, 4959}, {0x000001517, 24, 4472},
+	{0x000001518, 24, 4960}, {0x000001519, 24, 4961}, {0x00000151a, 24, 4962}, {0x00000151b, 24, 4963},
+	{0x00000151c, 24, 4964}, {0x00000151d, 24, 4965}, {0x00000151e, 24, 4966}, {0x00000151f, 24, 4967},
+	{0x000001520, 24, 4968}, {0x000001521, 24, 4969}, {0x000001522, 24, 4970}, {0x000001523, 24, 4971},
+	{0x000001524, 24, 4972}, {0x000001525, 24, 4973}, {0x000001526, 24, 4974}, {0x000001527, 24, 4975},
+	{0x000001528, 24, 4976}, {0x000001529, 24, 4977}, {0x00000152a, 24, 4978}, {0x00000152b, 24, 3},
+	{0x00000152c, 24, 3}, {0x00000152d, 24, 4979}, {0x00000152e, 24, 4980}, {0x00000152f, 24, 185},
+	{0x000001530, 24, 142}, {0x000001531, 24, 4981}, {0x000001532, 24, 4982}, {0x000001533, 24, 4983},
+	{0x000001534, 24, 4984}, {0x000001535, 24, 4985}, {0x000001536, 24, 4986}, {0x000001537, 24, 4987},
+	{0x000001538, 24, 4988}, {0x000001539, 24, 4989}, {0x00000153a, 24, 4990}, {0x00000153b, 24, 4991},
+	{0x00000153c, 24, 4992}, {0x00000153d, 24, 4993}, {0x00000153e, 24, 4994}, {0x00000153f, 24, 4995},
+	{0x000001540, 24, 4496}, {0x000001541, 24, 4996}, {0x000001542, 24, 4997}, {0x000001543, 24, 4998},
+	{0x000001544, 24, 4999}, {0x000001545, 24, 5000}, {0x000001546, 24, 5001}, {0x000001547, 24, 5002},
+	{0x000001548, 24, 5003}, {0x000001549, 24, 5004}, {0x00000154a, 24, 5005}, {0x00000154b, 24, 5006},
+	{0x00000154c, 24, 5007}, {0x00000154d, 24, 5008}, {0x00000154e, 24, 5009}, {0x00000154f, 24, 5010},
+	{0x000001550, 24, 5011}, {0x000001551, 24, 5012}, {0x000001552, 24, 5013}, {0x000001553, 24, 5014},
+	{0x000001554, 24, 5015}, {0x000001555, 24, 5016}, {0x000001556, 24, 5017}, {0x000001557, 24, 5018},
+	{0x000001558, 24, 342}, {0x000001559, 24, 5019}, {0x00000155a, 24, 5020}, {0x00000155b, 24, 5021},
+	{0x00000155c, 24, 5022}, {0x00000155d, 24, 965}, {0x00000155e, 24, 5023}, {0x00000155f, 24, 5024},
+	{0x000001560, 24, 459}, {0x000001561, 24, 5025}, {0x000001562, 24, 3}, {0x000001563, 24, 3},
+	{0x000001564, 24, 5026}, {0x000001565, 24, 5027}, {0x000001566, 24, 5028}, {0x000001567, 24, 5029},
+	{0x000001568, 24, 5030}, {0x000001569, 24, 5031}, {0x00000156a, 24, 5032}, {0x00000156b, 24, 5033},
+	{0x00000156c, 24, 5034}, {0x00000156d, 24, 5035}, {0x00000156e, 24, 5036}, {0x00000156f, 24, 5037},
+	{0x000001570, 24, 1217}, {0x000001571, 24, 5038}, {0x000001572, 24, 5039}, {0x000001573, 24, 5040},
+	{0x000001574, 24, 5041}, {0x000001575, 24, 5042}, {0x000001576, 24, 5043}, {0x000001577, 24, 231},
+	{0x000001578, 24, 5044}, {0x000001579, 24, 5045}, {0x00000157a, 24, 5046}, {0x00000157b, 24, 5047},
+	{0x00000157c, 24, 5048}, {0x00000157d, 24, 5049}, {0x00000157e, 24, 5050}, {0x00000157f, 24, 5051},
+	{0x000001580, 24, 5052}, {0x000001581, 24, 5053}, {0x000001582, 24, 5054}, {0x000001583, 24, 5055},
+	{0x000001584, 24, 5056}, {0x000001585, 24, 5057}, {0x000001586, 24, 5058}, {0x000001587, 24, 5059},
+	{0x000001588, 24, 5060}, {0x000001589, 24, 5061}, {0x00000158a, 24, 5062}, {0x00000158b, 24, 5063},
+	{0x00000158c, 24, 5064}, {0x00000158d, 24, 5065}, {0x00000158e, 24, 5066}, {0x00000158f, 24, 5067},
+	{0x000001590, 24, 5068}, {0x000001591, 24, 5069}, {0x000001592, 24, 5070}, {0x000001593, 24, 5071},
+	{0x000001594, 24, 5072}, {0x000001595, 24, 5073}, {0x000001596, 24, 190}, {0x000001597, 24, 5074},
+	{0x000001598, 24, 5075}, {0x000001599, 24, 5076}, {0x00000159a, 24, 185}, {0x00000159b, 24, 4496},
+	{0x00000159c, 24, 5077}, {0x00000159d, 24, 1534}, {0x00000159e, 24, 5078}, {0x00000159f, 24, 5079},
+	{0x0000015a0, 24, 709}, {0x0000015a1, 24, 5080}, {0x0000015a2, 24, 190}, {0x0000015a3, 24, 190},
+	{0x0000015a4, 24, 190}, {0x0000015a5, 24, 5081}, {0x0000015a6, 24, 5082}, {0x0000015a7, 24, 5083},
+	{0x0000015a8, 24, 185}, {0x0000015a9, 24, 5084}, {0x0000015aa, 24, 5085}, {0x0000015ab, 24, 5086},
+	{0x0000015ac, 24, 5087}, {0x0000015ad, 24, 5088}, {0x0000015ae, 24, 5089}, {0x0000015af, 24, 5090},
+	{0x0000015b0, 24, 5091}, {0x0000015b1, 24, 5092}, {0x0000015b2, 24, 5093}, {0x0000015b3, 24, 5094},
+	{0x0000015b4, 24, 5095}, {0x0000015b5, 24, 5096}, {0x0000015b6, 24, 5097}, {0x0000015b7, 24, 1934},
+	{0x0000015b8, 24, 5098}, {0x0000015b9, 24, 4318}, {0x0000015ba, 24, 5099}, {0x0000015bb, 24, 5100},
+	{0x0000015bc, 24, 5101}, {0x0000015bd, 24, 5102}, {0x0000015be, 24, 5103}, {0x0000015bf, 24, 5104},
+	{0x0000015c0, 24, 5105}, {0x0000015c1, 24, 4505}, {0x0000015c2, 24, 5106}, {0x0000015c3, 24, 5107},
+	{0x0000015c4, 24, 5108}, {0x0000015c5, 24, 2822}, {0x0000015c6, 24, 3}, {0x0000015c7, 24, 3},
+	{0x0000015c8, 24, 5109}, {0x0000015c9, 24, 5110}, {0x0000015ca, 24, 5111}, {0x0000015cb, 24, 5112},
+	{0x0000015cc, 24, 5113}, {0x0000015cd, 24, 5114}, {0x0000015ce, 24, 190}, {0x0000015cf, 24, 190},
+	{0x0000015d0, 24, 190}, {0x0000015d1, 24, 185}, {0x0000015d2, 24, 5115}, {0x0000015d3, 24, 5116},
+	{0x0000015d4, 24, 5117}, {0x0000015d5, 24, 5118}, {0x0000015d6, 24, 5119}, {0x0000015d7, 24, 5120},
+	{0x0000015d8, 24, 5121}, {0x0000015d9, 24, 5122}, {0x0000015da, 24, 5123}, {0x0000015db, 24, 5124},
+	{0x0000015dc, 24, 5125}, {0x0000015dd, 24, 5126}, {0x0000015de, 24, 709}, {0x0000015df, 24, 5127},
+	{0x0000015e0, 24, 540}, {0x0000015e1, 24, 5128}, {0x0000015e2, 24, 5129}, {0x0000015e3, 24, 5130},
+	{0x0000015e4, 24, 5131}, {0x0000015e5, 24, 5132}, {0x0000015e6, 24, 5133}, {0x0000015e7, 24, 5134},
+	{0x0000015e8, 24, 4496}, {0x0000015e9, 24, 3223}, {0x0000015ea, 24, 5135}, {0x0000015eb, 24, 5136},
+	{0x0000015ec, 24, 5137}, {0x0000015ed, 24, 5138}, {0x0000015ee, 24, 5139}, {0x0000015ef, 24, 5140},
+	{0x0000015f0, 24, 5141}, {0x0000015f1, 24, 5142}, {0x0000015f2, 24, 4673}, {0x0000015f3, 24, 5143},
+	{0x0000015f4, 24, 5144}, {0x0000015f5, 24, 5145}, {0x0000015f6, 24, 5146}, {0x0000015f7, 24, 5147},
+	{0x0000015f8, 24, 5148}, {0x0000015f9, 24, 3}, {0x0000015fa, 24, 3}, {0x0000015fb, 24, 5149},
+	{0x0000015fc, 24, 5150}, {0x0000015fd, 24, 5151}, {0x0000015fe, 24, 5152}, {0x0000015ff, 24, 5153},
+	{0x000001600, 24, 5154}, {0x000001601, 24, 1741}, {0x000001602, 24, 5155}, {0x000001603, 24, 5156},
+	{0x000001604, 24, 5157}, {0x000001605, 24, 5158}, {0x000001606, 24, 5159}, {0x000001607, 24, 5160},
+	{0x000001608, 24, 5161}, {0x000001609, 24, 5162}, {0x00000160a, 24, 5163}, {0x00000160b, 24, 5164},
+	{0x00000160c, 24, 5165}, {0x00000160d, 24, 5166}, {0x00000160e, 24, 5167}, {0x00000160f, 24, 5168},
+	{0x000001610, 24, 5169}, {0x000001611, 24, 5170}, {0x000001612, 24, 5171}, {0x000001613, 24, 5172},
+	{0x000001614, 24, 5173}, {0x000001615, 24, 5174}, {0x000001616, 24, 5175}, {0x000001617, 24, 5176},
+	{0x000001618, 24, 5177}, {0x000001619, 24, 5178}, {0x00000161a, 24, 5179}, {0x00000161b, 24, 5180},
+	{0x00000161c, 24, 5181}, {0x00000161d, 24, 5182}, {0x00000161e, 24, 5183}, {0x00000161f, 24, 5184},
+	{0x000001620, 24, 2582}, {0x000001621, 24, 5185}, {0x000001622, 24, 5186}, {0x000001623, 24, 5187},
+	{0x000001624, 24, 5188}, {0x000001625, 24, 5189}, {0x000001626, 24, 185}, {0x000001627, 24, 5190},
+	{0x000001628, 24, 5191}, {0x000001629, 24, 5192}, {0x00000162a, 24, 5193}, {0x00000162b, 24, 5194},
+	{0x00000162c, 24, 5195}, {0x00000162d, 24, 5196}, {0x00000162e, 24, 5197}, {0x00000162f, 24, 5198},
+	{0x000001630, 24, 5199}, {0x000001631, 24, 5200}, {0x000001632, 24, 227}, {0x000001633, 24, 5201},
+	{0x000001634, 24, 5202}, {0x000001635, 24, 459}, {0x000001636, 24, 5203}, {0x000001637, 24, 5204},
+	{0x000001638, 24, 916}, {0x000001639, 24, 5205}, {0x00000163a, 24, 5206}, {0x00000163b, 24, 5207},
+	{0x00000163c, 24, 5208}, {0x00000163d, 24, 5209}, {0x00000163e, 24, 5210}, {0x00000163f, 24, 5211},
+	{0x000001640, 24, 5212}, {0x000001641, 24, 3976}, {0x000001642, 24, 5213}, {0x000001643, 24, 5214},
+	{0x000001644, 24, 5215}, {0x000001645, 24, 5216}, {0x000001646, 24, 3}, {0x000001647, 24, 3},
+	{0x000001648, 24, 5217}, {0x000001649, 24, 5218}, {0x00000164a, 24, 5219}, {0x00000164b, 24, 5220},
+	{0x00000164c, 24, 5221}, {0x00000164d, 24, 5222}, {0x00000164e, 24, 709}, {0x00000164f, 24, 5223},
+	{0x000001650, 24, 5224}, {0x000001651, 24, 5225}, {0x000001652, 24, 5226}, {0x000001653, 24, 5227},
+	{0x000001654, 24, 5228}, {0x000001655, 24, 5229}, {0x000001656, 24, 2314}, {0x000001657, 24, 5230},
+	{0x000001658, 24, 5231}, {0x000001659, 24, 5232}, {0x00000165a, 24, 5233}, {0x00000165b, 24, 5234},
+	{0x00000165c, 24, 5235}, {0x00000165d, 24, 5236}, {0x00000165e, 24, 5237}, {0x00000165f, 24, 5238},
+	{0x000001660, 24, 4496}, {0x000001661, 24, 5239}, {0x000001662, 24, 5240}, {0x000001663, 24, 5241},
+	{0x000001664, 24, 5242}, {0x000001665, 24, 5243}, {0x000001666, 24, 5244}, {0x000001667, 24, 5245},
+	{0x000001668, 24, 5246}, {0x000001669, 24, 5247}, {0x00000166a, 24, 5248}, {0x00000166b, 24, 4482},
+	{0x00000166c, 24, 5249}, {0x00000166d, 24, 5250}, {0x00000166e, 24, 5251}, {0x00000166f, 24, 4472},
+	{0x000001670, 24, 5252}, {0x000001671, 24, 5253}, {0x000001672, 24, 5254}, {0x000001673, 24, 5255},
+	{0x000001674, 24, 5256}, {0x000001675, 24, 185}, {0x000001676, 24, 4472}, {0x000001677, 24, 5257},
+	{0x000001678, 24, 5258}, {0x000001679, 24, 5259}, {0x00000167a, 24, 5260}, {0x00000167b, 24, 5261},
+	{0x00000167c, 24, 5262}, {0x00000167d, 24, 5263}, {0x00000167e, 24, 5264}, {0x00000167f, 24, 5265},
+	{0x000001680, 24, 5266}, {0x000001681, 24, 5267}, {0x000001682, 24, 5268}, {0x000001683, 24, 5269},
+	{0x000001684, 24, 5270}, {0x000001685, 24, 5271}, {0x000001686, 24, 5272}, {0x000001687, 24, 5273},
+	{0x000001688, 24, 5274}, {0x000001689, 24, 5275}, {0x00000168a, 24, 5276}, {0x00000168b, 24, 5277},
+	{0x00000168c, 24, 5278}, {0x00000168d, 24, 5279}, {0x00000168e, 24, 5280}, {0x00000168f, 24, 4507},
+	{0x000001690, 24, 5281}, {0x000001691, 24, 5282}, {0x000001692, 24, 5283}, {0x000001693, 24, 5284},
+	{0x000001694, 24, 5285}, {0x000001695, 24, 5286}, {0x000001696, 24, 5287}, {0x000001697, 24, 5288},
+	{0x000001698, 24, 5289}, {0x000001699, 24, 5290}, {0x00000169a, 24, 5291}, {0x00000169b, 24, 5292},
+	{0x00000169c, 24, 3}, {0x00000169d, 24, 3}, {0x00000169e, 24, 5293}, {0x00000169f, 24, 5294},
+	{0x0000016a0, 24, 5295}, {0x0000016a1, 24, 5296}, {0x0000016a2, 24, 5297}, {0x0000016a3, 24, 5298},
+	{0x0000016a4, 24, 5299}, {0x0000016a5, 24, 5300}, {0x0000016a6, 24, 5301}, {0x0000016a7, 24, 5302},
+	{0x0000016a8, 24, 5303}, {0x0000016a9, 24, 5304}, {0x0000016aa, 24, 5305}, {0x0000016ab, 24, 5306},
+	{0x0000016ac, 24, 5307}, {0x0000016ad, 24, 5308}, {0x0000016ae, 24, 5309}, {0x0000016af, 24, 5310},
+	{0x0000016b0, 24, 5311}, {0x0000016b1, 24, 5312}, {0x0000016b2, 24, 5313}, {0x0000016b3, 24, 5314},
+	{0x0000016b4, 24, 99}, {0x0000016b5, 24, 185}, {0x0000016b6, 24, 2918}, {0x0000016b7, 24, 5315},
+	{0x0000016b8, 24, 5316}, {0x0000016b9, 24, 5317}, {0x0000016ba, 24, 5318}, {0x0000016bb, 24, 5319},
+	{0x0000016bc, 24, 709}, {0x0000016bd, 24, 5320}, {0x0000016be, 24, 5321}, {0x0000016bf, 24, 5322},
+	{0x0000016c0, 24, 5323}, {0x0000016c1, 24, 5324}, {0x0000016c2, 24, 5325}, {0x0000016c3, 24, 5326},
+	{0x0000016c4, 24, 5327}, {0x0000016c5, 24, 5328}, {0x0000016c6, 24, 5329}, {0x0000016c7, 24, 3},
+	{0x0000016c8, 24, 3}, {0x0000016c9, 24, 5330}, {0x0000016ca, 24, 4496}, {0x0000016cb, 24, 865},
+	{0x0000016cc, 24, 5331}, {0x0000016cd, 24, 5332}, {0x0000016ce, 24, 4861}, {0x0000016cf, 24, 4861},
+	{0x0000016d0, 24, 5333}, {0x0000016d1, 24, 5334}, {0x0000016d2, 24, 5335}, {0x0000016d3, 24, 5336},
+	{0x0000016d4, 24, 5337}, {0x0000016d5, 24, 5338}, {0x0000016d6, 24, 5339}, {0x0000016d7, 24, 5340},
+	{0x0000016d8, 24, 5341}, {0x0000016d9, 24, 5342}, {0x0000016da, 24, 5343}, {0x0000016db, 24, 4318},
+	{0x0000016dc, 24, 5344}, {0x0000016dd, 24, 5345}, {0x0000016de, 24, 5346}, {0x0000016df, 24, 5347},
+	{0x0000016e0, 24, 2380}, {0x0000016e1, 24, 5348}, {0x0000016e2, 24, 5349}, {0x0000016e3, 24, 4244},
+	{0x0000016e4, 24, 5350}, {0x0000016e5, 24, 5351}, {0x0000016e6, 24, 5352}, {0x0000016e7, 24, 5353},
+	{0x0000016e8, 24, 5354}, {0x0000016e9, 24, 5355}, {0x0000016ea, 24, 4472}, {0x0000016eb, 24, 4472},
+	{0x0000016ec, 24, 5356}, {0x0000016ed, 24, 2311}, {0x0000016ee, 24, 5357}, {0x0000016ef, 24, 5358},
+	{0x0000016f0, 24, 5359}, {0x0000016f1, 24, 5360}, {0x0000016f2, 24, 5361}, {0x0000016f3, 24, 5362},
+	{0x0000016f4, 24, 5363}, {0x0000016f5, 24, 5364}, {0x0000016f6, 24, 5365}, {0x0000016f7, 24, 5366},
+	{0x0000016f8, 24, 5367}, {0x0000016f9, 24, 5368}, {0x0000016fa, 24, 5369}, {0x0000016fb, 24, 5370},
+	{0x0000016fc, 24, 5371}, {0x0000016fd, 24, 5372}, {0x0000016fe, 24, 5373}, {0x0000016ff, 24, 5374},
+	{0x000001700, 24, 185}, {0x000001701, 24, 5375}, {0x000001702, 24, 5376}, {0x000001703, 24, 5377},
+	{0x000001704, 24, 5378}, {0x000001705, 24, 5379}, {0x000001706, 24, 5380}, {0x000001707, 24, 5381},
+	{0x000001708, 24, 459}, {0x000001709, 24, 5382}, {0x00000170a, 24, 5383}, {0x00000170b, 24, 5384},
+	{0x00000170c, 24, 5385}, {0x00000170d, 24, 5386}, {0x00000170e, 24, 3}, {0x00000170f, 24, 3},
+	{0x000001710, 24, 5387}, {0x000001711, 24, 5388}, {0x000001712, 24, 5389}, {0x000001713, 24, 5390},
+	{0x000001714, 24, 5391}, {0x000001715, 24, 5392}, {0x000001716, 24, 5393}, {0x000001717, 24, 5394},
+	{0x000001718, 24, 5395}, {0x000001719, 24, 5396}, {0x00000171a, 24, 5397}, {0x00000171b, 24, 5398},
+	{0x00000171c, 24, 5399}, {0x00000171d, 24, 5400}, {0x00000171e, 24, 5401}, {0x00000171f, 24, 5402},
+	{0x000001720, 24, 5403}, {0x000001721, 24, 5404}, {0x000001722, 24, 5405}, {0x000001723, 24, 5406},
+	{0x000001724, 24, 5407}, {0x000001725, 24, 5408}, {0x000001726, 24, 5409}, {0x000001727, 24, 5410},
+	{0x000001728, 24, 5411}, {0x000001729, 24, 5412}, {0x00000172a, 24, 5413}, {0x00000172b, 24, 5414},
+	{0x00000172c, 24, 5415}, {0x00000172d, 24, 5416}, {0x00000172e, 24, 5417}, {0x00000172f, 24, 5418},
+	{0x000001730, 24, 5419}, {0x000001731, 24, 4673}, {0x000001732, 24, 5420}, {0x000001733, 24, 5421},
+	{0x000001734, 24, 5422}, {0x000001735, 24, 99}, {0x000001736, 24, 5423}, {0x000001737, 24, 5424},
+	{0x000001738, 24, 5425}, {0x000001739, 24, 5426}, {0x00000173a, 24, 5427}, {0x00000173b, 24, 542},
+	{0x00000173c, 24, 5428}, {0x00000173d, 24, 5429}, {0x00000173e, 24, 5430}, {0x00000173f, 24, 4097},
+	{0x000001740, 24, 5431}, {0x000001741, 24, 5432}, {0x000001742, 24, 5}, {0x000001743, 24, 5433},
+	{0x000001744, 24, 5434}, {0x000001745, 24, 5435}, {0x000001746, 24, 5436}, {0x000001747, 24, 5437},
+	{0x000001748, 24, 5438}, {0x000001749, 24, 5439}, {0x00000174a, 24, 5440}, {0x00000174b, 24, 709},
+	{0x00000174c, 24, 5441}, {0x00000174d, 24, 5442}, {0x00000174e, 24, 5443}, {0x00000174f, 24, 5444},
+	{0x000001750, 24, 5445}, {0x000001751, 24, 5446}, {0x000001752, 24, 5447}, {0x000001753, 24, 5448},
+	{0x000001754, 24, 5449}, {0x000001755, 24, 5450}, {0x000001756, 24, 5451}, {0x000001757, 24, 5452},
+	{0x000001758, 24, 5453}, {0x000001759, 24, 3}, {0x00000175a, 24, 3}, {0x00000175b, 24, 5454},
+	{0x00000175c, 24, 5455}, {0x00000175d, 24, 5456}, {0x00000175e, 24, 5457}, {0x00000175f, 24, 5458},
+	{0x000001760, 24, 5459}, {0x000001761, 24, 99}, {0x000001762, 24, 5460}, {0x000001763, 24, 5461},
+	{0x000001764, 24, 5462}, {0x000001765, 24, 4496}, {0x000001766, 24, 5463}, {0x000001767, 24, 5464},
+	{0x000001768, 24, 5465}, {0x000001769, 24, 5466}, {0x00000176a, 24, 5467}, {0x00000176b, 24, 5468},
+	{0x00000176c, 24, 5469}, {0x00000176d, 24, 5470}, {0x00000176e, 24, 5471}, {0x00000176f, 24, 5472},
+	{0x000001770, 24, 5473}, {0x000001771, 24, 5474}, {0x000001772, 24, 5475}, {0x000001773, 24, 5476},
+	{0x000001774, 24, 5477}, {0x000001775, 24, 5478}, {0x000001776, 24, 5479}, {0x000001777, 24, 5480},
+	{0x000001778, 24, 5481}, {0x000001779, 24, 5482}, {0x00000177a, 24, 5483}, {0x00000177b, 24, 5484},
+	{0x00000177c, 24, 5485}, {0x00000177d, 24, 5486}, {0x00000177e, 24, 5487}, {0x00000177f, 24, 5488},
+	{0x000001780, 24, 5489}, {0x000001781, 24, 5490}, {0x000001782, 24, 5491}, {0x000001783, 24, 4305},
+	{0x000001784, 24, 185}, {0x000001785, 24, 5492}, {0x000001786, 24, 5493}, {0x000001787, 24, 5494},
+	{0x000001788, 24, 5495}, {0x000001789, 24, 5496}, {0x00000178a, 24, 5497}, {0x00000178b, 24, 5498},
+	{0x00000178c, 24, 5499}, {0x00000178d, 24, 5500}, {0x00000178e, 24, 5501}, {0x00000178f, 24, 5502},
+	{0x000001790, 24, 5503}, {0x000001791, 24, 5504}, {0x000001792, 24, 5505}, {0x000001793, 24, 5506},
+	{0x000001794, 24, 3}, {0x000001795, 24, 3}, {0x000001796, 24, 5507}, {0x000001797, 24, 5508},
+	{0x000001798, 24, 5509}, {0x000001799, 24, 5510}, {0x00000179a, 24, 3223}, {0x00000179b, 24, 5511},
+	{0x00000179c, 24, 5512}, {0x00000179d, 24, 5513}, {0x00000179e, 24, 5514}, {0x00000179f, 24, 5515},
+	{0x0000017a0, 24, 5516}, {0x0000017a1, 24, 5517}, {0x0000017a2, 24, 5518}, {0x0000017a3, 24, 5519},
+	{0x0000017a4, 24, 459}, {0x0000017a5, 24, 5520}, {0x0000017a6, 24, 5521}, {0x0000017a7, 24, 5522},
+	{0x0000017a8, 24, 5523}, {0x0000017a9, 24, 5524}, {0x0000017aa, 24, 5525}, {0x0000017ab, 24, 2314},
+	{0x0000017ac, 24, 5526}, {0x0000017ad, 24, 5527}, {0x0000017ae, 24, 5528}, {0x0000017af, 24, 5529},
+	{0x0000017b0, 24, 709}, {0x0000017b1, 24, 5530}, {0x0000017b2, 24, 5531}, {0x0000017b3, 24, 5532},
+	{0x0000017b4, 24, 5533}, {0x0000017b5, 24, 5534}, {0x0000017b6, 24, 5535}, {0x0000017b7, 24, 5536},
+	{0x0000017b8, 24, 5537}, {0x0000017b9, 24, 5538}, {0x0000017ba, 24, 5539}, {0x0000017bb, 24, 5540},
+	{0x0000017bc, 24, 5541}, {0x0000017bd, 24, 5542}, {0x0000017be, 24, 5543}, {0x0000017bf, 24, 5544},
+	{0x0000017c0, 24, 5545}, {0x0000017c1, 24, 5546}, {0x0000017c2, 24, 1957}, {0x0000017c3, 24, 5547},
+	{0x0000017c4, 24, 5548}, {0x0000017c5, 24, 5549}, {0x0000017c6, 24, 5550}, {0x0000017c7, 24, 5551},
+	{0x0000017c8, 24, 5552}, {0x0000017c9, 24, 4318}, {0x0000017ca, 24, 875}, {0x0000017cb, 24, 5553},
+	{0x0000017cc, 24, 5554}, {0x0000017cd, 24, 5555}, {0x0000017ce, 24, 5556}, {0x0000017cf, 24, 5557},
+	{0x0000017d0, 24, 5558}, {0x0000017d1, 24, 4496}, {0x0000017d2, 24, 5559}, {0x0000017d3, 24, 5560},
+	{0x0000017d4, 24, 5561}, {0x0000017d5, 24, 4318}, {0x0000017d6, 24, 5562}, {0x0000017d7, 24, 5563},
+	{0x0000017d8, 24, 5564}, {0x0000017d9, 24, 5565}, {0x0000017da, 24, 5566}, {0x0000017db, 24, 5567},
+	{0x0000017dc, 24, 5568}, {0x0000017dd, 24, 5569}, {0x0000017de, 24, 5570}, {0x0000017df, 24, 3},
+	{0x0000017e0, 24, 3}, {0x0000017e1, 24, 5571}, {0x0000017e2, 24, 185}, {0x0000017e3, 24, 4305},
+	{0x0000017e4, 24, 4305}, {0x0000017e5, 24, 4305}, {0x0000017e6, 24, 4305}, {0x0000017e7, 24, 4305},
+	{0x0000017e8, 24, 4305}, {0x0000017e9, 24, 4305}, {0x0000017ea, 24, 4305}, {0x0000017eb, 24, 4305},
+	{0x0000017ec, 24, 4305}, {0x0000017ed, 24, 5572}, {0x0000017ee, 24, 185}, {0x0000017ef, 24, 564},
+	{0x0000017f0, 24, 5573}, {0x0000017f1, 24, 5574}, {0x0000017f2, 24, 865}, {0x0000017f3, 24, 5575},
+	{0x0000017f4, 24, 5576}, {0x0000017f5, 24, 5577}, {0x0000017f6, 24, 5578}, {0x0000017f7, 24, 5579},
+	{0x0000017f8, 24, 5580}, {0x0000017f9, 24, 5581}, {0x0000017fa, 24, 965}, {0x0000017fb, 24, 5582},
+	{0x0000017fc, 24, 5583}, {0x0000017fd, 24, 5584}, {0x0000017fe, 24, 5585}, {0x0000017ff, 24, 5586},
+	{0x000001800, 24, 5587}, {0x000001801, 24, 3726}, {0x000001802, 24, 3710}, {0x000001803, 24, 5588},
+	{0x000001804, 24, 5589}, {0x000001805, 24, 5590}, {0x000001806, 24, 5591}, {0x000001807, 24, 5592},
+	{0x000001808, 24, 5593}, {0x000001809, 24, 5594}, {0x00000180a, 24, 5595}, {0x00000180b, 24, 5596},
+	{0x00000180c, 24, 5597}, {0x00000180d, 24, 5598}, {0x00000180e, 24, 5599}, {0x00000180f, 24, 709},
+	{0x000001810, 24, 5600}, {0x000001811, 24, 5601}, {0x000001812, 24, 5602}, {0x000001813, 24, 5316},
+	{0x000001814, 24, 5603}, {0x000001815, 24, 5604}, {0x000001816, 24, 5605}, {0x000001817, 24, 5606},
+	{0x000001818, 24, 3}, {0x000001819, 24, 3}, {0x00000181a, 24, 5607}, {0x00000181b, 24, 5608},
+	{0x00000181c, 24, 5609}, {0x00000181d, 24, 5610}, {0x00000181e, 24, 5611}, {0x00000181f, 24, 5612},
+	{0x000001820, 24, 5613}, {0x000001821, 24, 5614}, {0x000001822, 24, 5615}, {0x000001823, 24, 1541},
+	{0x000001824, 24, 5616}, {0x000001825, 24, 99}, {0x000001826, 24, 5617}, {0x000001827, 24, 5618},
+	{0x000001828, 24, 5619}, {0x000001829, 24, 5620}, {0x00000182a, 24, 5621}, {0x00000182b, 24, 5622},
+	{0x00000182c, 24, 5623}, {0x00000182d, 24, 5624}, {0x00000182e, 24, 5625}, {0x00000182f, 24, 4305},
+	{0x000001830, 24, 4305}, {0x000001831, 24, 4305}, {0x000001832, 24, 4305}, {0x000001833, 24, 4305},
+	{0x000001834, 24, 4305}, {0x000001835, 24, 5626}, {0x000001836, 24, 5627}, {0x000001837, 24, 5628},
+	{0x000001838, 24, 5629}, {0x000001839, 24, 4884}, {0x00000183a, 24, 3763}, {0x00000183b, 24, 5630},
+	{0x00000183c, 24, 5631}, {0x00000183d, 24, 5632}, {0x00000183e, 24, 5633}, {0x00000183f, 24, 3202},
+	{0x000001840, 24, 5634}, {0x000001841, 24, 5635}, {0x000001842, 24, 709}, {0x000001843, 24, 5636},
+	{0x000001844, 24, 5637}, {0x000001845, 24, 5638}, {0x000001846, 24, 5639}, {0x000001847, 24, 5640},
+	{0x000001848, 24, 5641}, {0x000001849, 24, 5642}, {0x00000184a, 24, 5643}, {0x00000184b, 24, 5644},
+	{0x00000184c, 24, 5645}, {0x00000184d, 24, 4809}, {0x00000184e, 24, 5646}, {0x00000184f, 24, 5647},
+	{0x000001850, 24, 5648}, {0x000001851, 24, 5649}, {0x000001852, 24, 5650}, {0x000001853, 24, 5651},
+	{0x000001854, 24, 5652}, {0x000001855, 24, 5653}, {0x000001856, 24, 5654}, {0x000001857, 24, 5655},
+	{0x000001858, 24, 5656}, {0x000001859, 24, 5657}, {0x00000185a, 24, 5658}, {0x00000185b, 24, 5659},
+	{0x00000185c, 24, 5660}, {0x00000185d, 24, 5661}, {0x00000185e, 24, 5662}, {0x00000185f, 24, 5663},
+	{0x000001860, 24, 5664}, {0x000001861, 24, 5665}, {0x000001862, 24, 1157}, {0x000001863, 24, 5666},
+	{0x000001864, 24, 4549}, {0x000001865, 24, 5667}, {0x000001866, 24, 5668}, {0x000001867, 24, 5669},
+	{0x000001868, 24, 5670}, {0x000001869, 24, 5671}, {0x00000186a, 24, 5672}, {0x00000186b, 24, 5673},
+	{0x00000186c, 24, 5674}, {0x00000186d, 24, 5675}, {0x00000186e, 24, 2380}, {0x00000186f, 24, 5676},
+	{0x000001870, 24, 5677}, {0x000001871, 24, 459}, {0x000001872, 24, 5678}, {0x000001873, 24, 3},
+	{0x000001874, 24, 3}, {0x000001875, 24, 5679}, {0x000001876, 24, 5680}, {0x000001877, 24, 5681},
+	{0x000001878, 24, 5682}, {0x000001879, 24, 5683}, {0x00000187a, 24, 5684}, {0x00000187b, 24, 5685},
+	{0x00000187c, 24, 5686}, {0x00000187d, 24, 5687}, {0x00000187e, 24, 5688}, {0x00000187f, 24, 5689},
+	{0x000001880, 24, 5690}, {0x000001881, 24, 5691}, {0x000001882, 24, 5692}, {0x000001883, 24, 5693},
+	{0x000001884, 24, 5694}, {0x000001885, 24, 5695}, {0x000001886, 24, 5696}, {0x000001887, 24, 5697},
+	{0x000001888, 24, 5698}, {0x000001889, 24, 5699}, {0x00000188a, 24, 5700}, {0x00000188b, 24, 2822},
+	{0x00000188c, 24, 5701}, {0x00000188d, 24, 709}, {0x00000188e, 24, 5702}, {0x00000188f, 24, 5703},
+	{0x000001890, 24, 5704}, {0x000001891, 24, 5705}, {0x000001892, 24, 5706}, {0x000001893, 24, 5707},
+	{0x000001894, 24, 5708}, {0x000001895, 24, 5709}, {0x000001896, 24, 5710}, {0x000001897, 24, 5711},
+	{0x000001898, 24, 5712}, {0x000001899, 24, 5713}, {0x00000189a, 24, 5714}, {0x00000189b, 24, 3930},
+	{0x00000189c, 24, 5715}, {0x00000189d, 24, 5716}, {0x00000189e, 24, 5717}, {0x00000189f, 24, 5718},
+	{0x0000018a0, 24, 5719}, {0x0000018a1, 24, 5720}, {0x0000018a2, 24, 5721}, {0x0000018a3, 24, 5722},
+	{0x0000018a4, 24, 185}, {0x0000018a5, 24, 5723}, {0x0000018a6, 24, 5724}, {0x0000018a7, 24, 5725},
+	{0x0000018a8, 24, 5726}, {0x0000018a9, 24, 5727}, {0x0000018aa, 24, 5728}, {0x0000018ab, 24, 5729},
+	{0x0000018ac, 24, 5730}, {0x0000018ad, 24, 5731}, {0x0000018ae, 24, 5732}, {0x0000018af, 24, 4318},
+	{0x0000018b0, 24, 4496}, {0x0000018b1, 24, 564}, {0x0000018b2, 24, 5733}, {0x0000018b3, 24, 5734},
+	{0x0000018b4, 24, 5735}, {0x0000018b5, 24, 5736}, {0x0000018b6, 24, 5737}, {0x0000018b7, 24, 5738},
+	{0x0000018b8, 24, 5739}, {0x0000018b9, 24, 3}, {0x0000018ba, 24, 3}, {0x0000018bb, 24, 5740},
+	{0x0000018bc, 24, 5741}, {0x0000018bd, 24, 5742}, {0x0000018be, 24, 5743}, {0x0000018bf, 24, 5744},
+	{0x0000018c0, 24, 185}, {0x0000018c1, 24, 5745}, {0x0000018c2, 24, 5746}, {0x0000018c3, 24, 5747},
+	{0x0000018c4, 24, 5748}, {0x0000018c5, 24, 709}, {0x0000018c6, 24, 5749}, {0x0000018c7, 24, 5750},
+	{0x0000018c8, 24, 5751}, {0x0000018c9, 24, 5752}, {0x0000018ca, 24, 5753}, {0x0000018cb, 24, 5754},
+	{0x0000018cc, 24, 5755}, {0x0000018cd, 24, 5756}, {0x0000018ce, 24, 5757}, {0x0000018cf, 24, 5758},
+	{0x0000018d0, 24, 5759}, {0x0000018d1, 24, 5760}, {0x0000018d2, 24, 5761}, {0x0000018d3, 24, 5762},
+	{0x0000018d4, 24, 5763}, {0x0000018d5, 24, 5764}, {0x0000018d6, 24, 5765}, {0x0000018d7, 24, 5766},
+	{0x0000018d8, 24, 5767}, {0x0000018d9, 24, 5768}, {0x0000018da, 24, 5769}, {0x0000018db, 24, 5770},
+	{0x0000018dc, 24, 5771}, {0x0000018dd, 24, 5772}, {0x0000018de, 24, 4472}, {0x0000018df, 24, 5773},
+	{0x0000018e0, 24, 5774}, {0x0000018e1, 24, 5775}, {0x0000018e2, 24, 5776}, {0x0000018e3, 24, 5777},
+	{0x0000018e4, 24, 5778}, {0x0000018e5, 24, 5779}, {0x0000018e6, 24, 5780}, {0x0000018e7, 24, 5781},
+	{0x0000018e8, 24, 5782}, {0x0000018e9, 24, 5783}, {0x0000018ea, 24, 5784}, {0x0000018eb, 24, 5785},
+	{0x0000018ec, 24, 5786}, {0x0000018ed, 24, 5787}, {0x0000018ee, 24, 5788}, {0x0000018ef, 24, 5789},
+	{0x0000018f0, 24, 5790}, {0x0000018f1, 24, 5791}, {0x0000018f2, 24, 5792}, {0x0000018f3, 24, 4673},
+	{0x0000018f4, 24, 5793}, {0x0000018f5, 24, 5794}, {0x0000018f6, 24, 3404}, {0x0000018f7, 24, 5795},
+	{0x0000018f8, 24, 4884}, {0x0000018f9, 24, 5796}, {0x0000018fa, 24, 5797}, {0x0000018fb, 24, 5798},
+	{0x0000018fc, 24, 5799}, {0x0000018fd, 24, 5800}, {0x0000018fe, 24, 459}, {0x0000018ff, 24, 5801},
+	{0x000001900, 24, 5802}, {0x000001901, 24, 5803}, {0x000001902, 24, 5804}, {0x000001903, 24, 5805},
+	{0x000001904, 24, 5806}, {0x000001905, 24, 5807}, {0x000001906, 24, 3}, {0x000001907, 24, 3},
+	{0x000001908, 24, 5808}, {0x000001909, 24, 5809}, {0x00000190a, 24, 5810}, {0x00000190b, 24, 5811},
+	{0x00000190c, 24, 5812}, {0x00000190d, 24, 5813}, {0x00000190e, 24, 5814}, {0x00000190f, 24, 5815},
+	{0x000001910, 24, 5816}, {0x000001911, 24, 5817}, {0x000001912, 24, 5818}, {0x000001913, 24, 5819},
+	{0x000001914, 24, 5820}, {0x000001915, 24, 916}, {0x000001916, 24, 5821}, {0x000001917, 24, 5822},
+	{0x000001918, 24, 5823}, {0x000001919, 24, 5824}, {0x00000191a, 24, 5825}, {0x00000191b, 24, 5826},
+	{0x00000191c, 24, 5827}, {0x00000191d, 24, 2314}, {0x00000191e, 24, 5828}, {0x00000191f, 24, 5829},
+	{0x000001920, 24, 5830}, {0x000001921, 24, 5831}, {0x000001922, 24, 5832}, {0x000001923, 24, 5833},
+	{0x000001924, 24, 5834}, {0x000001925, 24, 5835}, {0x000001926, 24, 5836}, {0x000001927, 24, 5837},
+	{0x000001928, 24, 5838}, {0x000001929, 24, 5839}, {0x00000192a, 24, 5840}, {0x00000192b, 24, 5841},
+	{0x00000192c, 24, 185}, {0x00000192d, 24, 5842}, {0x00000192e, 24, 5843}, {0x00000192f, 24, 3},
+	{0x000001930, 24, 3}, {0x000001931, 24, 5844}, {0x000001932, 24, 5845}, {0x000001933, 24, 5846},
+	{0x000001934, 24, 5847}, {0x000001935, 24, 5848}, {0x000001936, 24, 5849}, {0x000001937, 24, 5850},
+	{0x000001938, 24, 5851}, {0x000001939, 24, 5852}, {0x00000193a, 24, 5853}, {0x00000193b, 24, 5854},
+	{0x00000193c, 24, 5855}, {0x00000193d, 24, 5856}, {0x00000193e, 24, 1957}, {0x00000193f, 24, 5857},
+	{0x000001940, 24, 5858}, {0x000001941, 24, 5859}, {0x000001942, 24, 5860}, {0x000001943, 24, 5861},
+	{0x000001944, 24, 5862}, {0x000001945, 24, 5863}, {0x000001946, 24, 5864}, {0x000001947, 24, 5670},
+	{0x000001948, 24, 5865}, {0x000001949, 24, 5866}, {0x00000194a, 24, 5867}, {0x00000194b, 24, 5017},
+	{0x00000194c, 24, 5868}, {0x00000194d, 24, 5869}, {0x00000194e, 24, 5870}, {0x00000194f, 24, 709},
+	{0x000001950, 24, 5871}, {0x000001951, 24, 5872}, {0x000001952, 24, 5873}, {0x000001953, 24, 5874},
+	{0x000001954, 24, 5875}, {0x000001955, 24, 3}, {0x000001956, 24, 3}, {0x000001957, 24, 5876},
+	{0x000001958, 24, 5877}, {0x000001959, 24, 5878}, {0x00000195a, 24, 5879}, {0x00000195b, 24, 3223},
+	{0x00000195c, 24, 5880}, {0x00000195d, 24, 5881}, {0x00000195e, 24, 185}, {0x00000195f, 24, 5882},
+	{0x000001960, 24, 5883}, {0x000001961, 24, 5884}, {0x000001962, 24, 5885}, {0x000001963, 24, 2582},
+	{0x000001964, 24, 5886}, {0x000001965, 24, 5887}, {0x000001966, 24, 5888}, {0x000001967, 24, 5889},
+	{0x000001968, 24, 5890}, {0x000001969, 24, 4496}, {0x00000196a, 24, 5891}, {0x00000196b, 24, 5892},
+	{0x00000196c, 24, 5893}, {0x00000196d, 24, 5894}, {0x00000196e, 24, 5895}, {0x00000196f, 24, 5896},
+	{0x000001970, 24, 5897}, {0x000001971, 24, 5898}, {0x000001972, 24, 5899}, {0x000001973, 24, 5900},
+	{0x000001974, 24, 5901}, {0x000001975, 24, 5902}, {0x000001976, 24, 5903}, {0x000001977, 24, 5904},
+	{0x000001978, 24, 5905}, {0x000001979, 24, 709}, {0x00000197a, 24, 5906}, {0x00000197b, 24, 5907},
+	{0x00000197c, 24, 5908}, {0x00000197d, 24, 5909}, {0x00000197e, 24, 5909}, {0x00000197f, 24, 5910},
+	{0x000001980, 24, 5911}, {0x000001981, 24, 5912}, {0x000001982, 24, 5913}, {0x000001983, 24, 5914},
+	{0x000001984, 24, 5915}, {0x000001985, 24, 5916}, {0x000001986, 24, 5917}, {0x000001987, 24, 5918},
+	{0x000001988, 24, 5919}, {0x000001989, 24, 5920}, {0x00000198a, 24, 5921}, {0x00000198b, 24, 5922},
+	{0x00000198c, 24, 5923}, {0x00000198d, 24, 5924}, {0x00000198e, 24, 5925}, {0x00000198f, 24, 5926},
+	{0x000001990, 24, 5927}, {0x000001991, 24, 5928}, {0x000001992, 24, 5929}, {0x000001993, 24, 5930},
+	{0x000001994, 24, 5931}, {0x000001995, 24, 5932}, {0x000001996, 24, 5933}, {0x000001997, 24, 5934},
+	{0x000001998, 24, 5935}, {0x000001999, 24, 5936}, {0x00000199a, 24, 5937}, {0x00000199b, 24, 5938},
+	{0x00000199c, 24, 5939}, {0x00000199d, 24, 5940}, {0x00000199e, 24, 5941}, {0x00000199f, 24, 5942},
+	{0x0000019a0, 24, 5943}, {0x0000019a1, 24, 4332}, {0x0000019a2, 24, 5944}, {0x0000019a3, 24, 5945},
+	{0x0000019a4, 24, 5946}, {0x0000019a5, 24, 5947}, {0x0000019a6, 24, 185}, {0x0000019a7, 24, 5948},
+	{0x0000019a8, 24, 5949}, {0x0000019a9, 24, 3}, {0x0000019aa, 24, 3}, {0x0000019ab, 24, 5950},
+	{0x0000019ac, 24, 5951}, {0x0000019ad, 24, 5952}, {0x0000019ae, 24, 5953}, {0x0000019af, 24, 5954},
+	{0x0000019b0, 24, 5955}, {0x0000019b1, 24, 5956}, {0x0000019b2, 24, 5957}, {0x0000019b3, 24, 5958},
+	{0x0000019b4, 24, 5959}, {0x0000019b5, 24, 5960}, {0x0000019b6, 24, 5961}, {0x0000019b7, 24, 709},
+	{0x0000019b8, 24, 5962}, {0x0000019b9, 24, 5963}, {0x0000019ba, 24, 5964}, {0x0000019bb, 24, 459},
+	{0x0000019bc, 24, 5965}, {0x0000019bd, 24, 5966}, {0x0000019be, 24, 5967}, {0x0000019bf, 24, 5968},
+	{0x0000019c0, 24, 185}, {0x0000019c1, 24, 5373}, {0x0000019c2, 24, 5969}, {0x0000019c3, 24, 5970},
+	{0x0000019c4, 24, 5971}, {0x0000019c5, 24, 4505}, {0x0000019c6, 24, 5972}, {0x0000019c7, 24, 5973},
+	{0x0000019c8, 24, 5974}, {0x0000019c9, 24, 5975}, {0x0000019ca, 24, 5976}, {0x0000019cb, 24, 4551},
+	{0x0000019cc, 24, 5977}, {0x0000019cd, 24, 5978}, {0x0000019ce, 24, 5979}, {0x0000019cf, 24, 5980},
+	{0x0000019d0, 24, 5981}, {0x0000019d1, 24, 4472}, {0x0000019d2, 24, 4472}, {0x0000019d3, 24, 5982},
+	{0x0000019d4, 24, 5983}, {0x0000019d5, 24, 5984}, {0x0000019d6, 24, 5985}, {0x0000019d7, 24, 5986},
+	{0x0000019d8, 24, 5987}, {0x0000019d9, 24, 5988}, {0x0000019da, 24, 5989}, {0x0000019db, 24, 2969},
+	{0x0000019dc, 24, 5990}, {0x0000019dd, 24, 5991}, {0x0000019de, 24, 5992}, {0x0000019df, 24, 3930},
+	{0x0000019e0, 24, 5993}, {0x0000019e1, 24, 4496}, {0x0000019e2, 24, 5553}, {0x0000019e3, 24, 865},
+	{0x0000019e4, 24, 3202}, {0x0000019e5, 24, 5994}, {0x0000019e6, 24, 5995}, {0x0000019e7, 24, 3},
+	{0x0000019e8, 24, 3}, {0x0000019e9, 24, 5996}, {0x0000019ea, 24, 5997}, {0x0000019eb, 24, 5998},
+	{0x0000019ec, 24, 5999}, {0x0000019ed, 24, 6000}, {0x0000019ee, 24, 6001}, {0x0000019ef, 24, 6002},
+	{0x0000019f0, 24, 6003}, {0x0000019f1, 24, 6004}, {0x0000019f2, 24, 6005}, {0x0000019f3, 24, 6006},
+	{0x0000019f4, 24, 6007}, {0x0000019f5, 24, 6008}, {0x0000019f6, 24, 6009}, {0x0000019f7, 24, 6010},
+	{0x0000019f8, 24, 6011}, {0x0000019f9, 24, 6012}, {0x0000019fa, 24, 6013}, {0x0000019fb, 24, 6014},
+	{0x0000019fc, 24, 6015}, {0x0000019fd, 24, 2314}, {0x0000019fe, 24, 6016}, {0x0000019ff, 24, 6017},
+	{0x000001a00, 24, 6018}, {0x000001a01, 24, 6019}, {0x000001a02, 24, 6020}, {0x000001a03, 24, 6021},
+	{0x000001a04, 24, 6022}, {0x000001a05, 24, 6023}, {0x000001a06, 24, 6024}, {0x000001a07, 24, 6025},
+	{0x000001a08, 24, 6026}, {0x000001a09, 24, 6027}, {0x000001a0a, 24, 6028}, {0x000001a0b, 24, 6029},
+	{0x000001a0c, 24, 6030}, {0x000001a0d, 24, 6031}, {0x000001a0e, 24, 6032}, {0x000001a0f, 24, 6033},
+	{0x000001a10, 24, 6034}, {0x000001a11, 24, 6035}, {0x000001a12, 24, 6036}, {0x000001a13, 24, 6037},
+	{0x000001a14, 24, 6038}, {0x000001a15, 24, 6039}, {0x000001a16, 24, 709}, {0x000001a17, 24, 6040},
+	{0x000001a18, 24, 6041}, {0x000001a19, 24, 6042}, {0x000001a1a, 24, 6043}, {0x000001a1b, 24, 185},
+	{0x000001a1c, 24, 6044}, {0x000001a1d, 24, 6045}, {0x000001a1e, 24, 2743}, {0x000001a1f, 24, 6046},
+	{0x000001a20, 24, 6047}, {0x000001a21, 24, 6048}, {0x000001a22, 24, 6049}, {0x000001a23, 24, 6050},
+	{0x000001a24, 24, 6051}, {0x000001a25, 24, 6052}, {0x000001a26, 24, 6053}, {0x000001a27, 24, 6054},
+	{0x000001a28, 24, 6055}, {0x000001a29, 24, 6056}, {0x000001a2a, 24, 4428}, {0x000001a2b, 24, 6057},
+	{0x000001a2c, 24, 6058}, {0x000001a2d, 24, 6059}, {0x000001a2e, 24, 6060}, {0x000001a2f, 24, 3},
+	{0x000001a30, 24, 3}, {0x000001a31, 24, 6061}, {0x000001a32, 24, 6062}, {0x000001a33, 24, 6063},
+	{0x000001a34, 24, 6064}, {0x000001a35, 24, 6065}, {0x000001a36, 24, 6066}, {0x000001a37, 24, 6067},
+	{0x000001a38, 24, 6068}, {0x000001a39, 24, 6069}, {0x000001a3a, 24, 6070}, {0x000001a3b, 24, 6071},
+	{0x000001a3c, 24, 6072}, {0x000001a3d, 24, 6073}, {0x000001a3e, 24, 6074}, {0x000001a3f, 24, 6075},
+	{0x000001a40, 24, 6076}, {0x000001a41, 24, 6077}, {0x000001a42, 24, 6078}, {0x000001a43, 24, 6079},
+	{0x000001a44, 24, 6080}, {0x000001a45, 24, 4507}, {0x000001a46, 24, 6081}, {0x000001a47, 24, 6082},
+	{0x000001a48, 24, 6083}, {0x000001a49, 24, 6084}, {0x000001a4a, 24, 6085}, {0x000001a4b, 24, 459},
+	{0x000001a4c, 24, 6086}, {0x000001a4d, 24, 5352}, {0x000001a4e, 24, 6087}, {0x000001a4f, 24, 980},
+	{0x000001a50, 24, 6088}, {0x000001a51, 24, 6089}, {0x000001a52, 24, 6090}, {0x000001a53, 24, 6091},
+	{0x000001a54, 24, 6092}, {0x000001a55, 24, 6093}, {0x000001a56, 24, 6094}, {0x000001a57, 24, 6095},
+	{0x000001a58, 24, 6096}, {0x000001a59, 24, 6097}, {0x000001a5a, 24, 6098}, {0x000001a5b, 24, 6099},
+	{0x000001a5c, 24, 6100}, {0x000001a5d, 24, 6101}, {0x000001a5e, 24, 6102}, {0x000001a5f, 24, 6103},
+	{0x000001a60, 24, 6104}, {0x000001a61, 24, 6105}, {0x000001a62, 24, 6106}, {0x000001a63, 24, 6107},
+	{0x000001a64, 24, 564}, {0x000001a65, 24, 6108}, {0x000001a66, 24, 185}, {0x000001a67, 24, 6109},
+	{0x000001a68, 24, 6110}, {0x000001a69, 24, 6111}, {0x000001a6a, 24, 6112}, {0x000001a6b, 24, 3976},
+	{0x000001a6c, 24, 3}, {0x000001a6d, 24, 3}, {0x000001a6e, 24, 6113}, {0x000001a6f, 24, 6114},
+	{0x000001a70, 24, 4275}, {0x000001a71, 24, 6115}, {0x000001a72, 24, 6116}, {0x000001a73, 24, 2348},
+	{0x000001a74, 24, 6117}, {0x000001a75, 24, 5316}, {0x000001a76, 24, 6118}, {0x000001a77, 24, 185},
+	{0x000001a78, 24, 6119}, {0x000001a79, 24, 6120}, {0x000001a7a, 24, 6121}, {0x000001a7b, 24, 6122},
+	{0x000001a7c, 24, 6123}, {0x000001a7d, 24, 6124}, {0x000001a7e, 24, 6125}, {0x000001a7f, 24, 6126},
+	{0x000001a80, 24, 311}, {0x000001a81, 24, 6127}, {0x000001a82, 24, 6128}, {0x000001a83, 24, 6129},
+	{0x000001a84, 24, 6130}, {0x000001a85, 24, 6131}, {0x000001a86, 24, 6132}, {0x000001a87, 24, 6133},
+	{0x000001a88, 24, 6134}, {0x000001a89, 24, 709}, {0x000001a8a, 24, 4318}, {0x000001a8b, 24, 6135},
+	{0x000001a8c, 24, 6136}, {0x000001a8d, 24, 6137}, {0x000001a8e, 24, 6138}, {0x000001a8f, 24, 4496},
+	{0x000001a90, 24, 6139}, {0x000001a91, 24, 6140}, {0x000001a92, 24, 4673}, {0x000001a93, 24, 6141},
+	{0x000001a94, 24, 6142}, {0x000001a95, 24, 6143}, {0x000001a96, 24, 6144}, {0x000001a97, 24, 6145},
+	{0x000001a98, 24, 6146}, {0x000001a99, 24, 6147}, {0x000001a9a, 24, 6148}, {0x000001a9b, 24, 6149},
+	{0x000001a9c, 24, 6150}, {0x000001a9d, 24, 6151}, {0x000001a9e, 24, 6152}, {0x000001a9f, 24, 6153},
+	{0x000001aa0, 24, 2822}, {0x000001aa1, 24, 3}, {0x000001aa2, 24, 3}, {0x000001aa3, 24, 6154},
+	{0x000001aa4, 24, 6155}, {0x000001aa5, 24, 6156}, {0x000001aa6, 24, 6157}, {0x000001aa7, 24, 6158},
+	{0x000001aa8, 24, 6159}, {0x000001aa9, 24, 6160}, {0x000001aaa, 24, 6161}, {0x000001aab, 24, 6162},
+	{0x000001aac, 24, 6163}, {0x000001aad, 24, 185}, {0x000001aae, 24, 6164}, {0x000001aaf, 24, 6165},
+	{0x000001ab0, 24, 6166}, {0x000001ab1, 24, 6167}, {0x000001ab2, 24, 6168}, {0x000001ab3, 24, 6169},
+	{0x000001ab4, 24, 6170}, {0x000001ab5, 24, 6171}, {0x000001ab6, 24, 4305}, {0x000001ab7, 24, 6172},
+	{0x000001ab8, 24, 6173}, {0x000001ab9, 24, 6174}, {0x000001aba, 24, 6175}, {0x000001abb, 24, 6176},
+	{0x000001abc, 24, 6177}, {0x000001abd, 24, 6178}, {0x000001abe, 24, 6179}, {0x000001abf, 24, 6180},
+	{0x000001ac0, 24, 6181}, {0x000001ac1, 24, 2380}, {0x000001ac2, 24, 6182}, {0x000001ac3, 24, 6183},
+	{0x000001ac4, 24, 3202}, {0x000001ac5, 24, 6184}, {0x000001ac6, 24, 6185}, {0x000001ac7, 24, 6186},
+	{0x000001ac8, 24, 6187}, {0x000001ac9, 24, 6188}, {0x000001aca, 24, 6189}, {0x000001acb, 24, 6190},
+	{0x000001acc, 24, 6191}, {0x000001acd, 24, 6192}, {0x000001ace, 24, 6193}, {0x000001acf, 24, 6194},
+	{0x000001ad0, 24, 6195}, {0x000001ad1, 24, 6196}, {0x000001ad2, 24, 6197}, {0x000001ad3, 24, 6198},
+	{0x000001ad4, 24, 6199}, {0x000001ad5, 24, 6200}, {0x000001ad6, 24, 6201}, {0x000001ad7, 24, 6202},
+	{0x000001ad8, 24, 6203}, {0x000001ad9, 24, 6204}, {0x000001ada, 24, 6205}, {0x000001adb, 24, 185},
+	{0x000001adc, 24, 709}, {0x000001add, 24, 6206}, {0x000001ade, 24, 185}, {0x000001adf, 24, 6207},
+	{0x000001ae0, 24, 6208}, {0x000001ae1, 24, 6209}, {0x000001ae2, 24, 3}, {0x000001ae3, 24, 3},
+	{0x000001ae4, 24, 6210}, {0x000001ae5, 24, 6211}, {0x000001ae6, 24, 6212}, {0x000001ae7, 24, 6213},
+	{0x000001ae8, 24, 6214}, {0x000001ae9, 24, 2314}, {0x000001aea, 24, 6215}, {0x000001aeb, 24, 2196},
+	{0x000001aec, 24, 6216}, {0x000001aed, 24, 6217}, {0x000001aee, 24, 6218}, {0x000001aef, 24, 6219},
+	{0x000001af0, 24, 6220}, {0x000001af1, 24, 6221}, {0x000001af2, 24, 6222}, {0x000001af3, 24, 6223},
+	{0x000001af4, 24, 6224}, {0x000001af5, 24, 6225}, {0x000001af6, 24, 6226}, {0x000001af7, 24, 6227},
+	{0x000001af8, 24, 6228}, {0x000001af9, 24, 6229}, {0x000001afa, 24, 6230}, {0x000001afb, 24, 6231},
+	{0x000001afc, 24, 6232}, {0x000001afd, 24, 6233}, {0x000001afe, 24, 6234}, {0x000001aff, 24, 6235},
+	{0x000001b00, 24, 6236}, {0x000001b01, 24, 6237}, {0x000001b02, 24, 6238}, {0x000001b03, 24, 6239},
+	{0x000001b04, 24, 6240}, {0x000001b05, 24, 6241}, {0x000001b06, 24, 6242}, {0x000001b07, 24, 6243},
+	{0x000001b08, 24, 6244}, {0x000001b09, 24, 6245}, {0x000001b0a, 24, 6246}, {0x000001b0b, 24, 6247},
+	{0x000001b0c, 24, 3}, {0x000001b0d, 24, 3}, {0x000001b0e, 24, 6248}, {0x000001b0f, 24, 6249},
+	{0x000001b10, 24, 6250}, {0x000001b11, 24, 3223}, {0x000001b12, 24, 6251}, {0x000001b13, 24, 6252},
+	{0x000001b14, 24, 6253}, {0x000001b15, 24, 6254}, {0x000001b16, 24, 6255}, {0x000001b17, 24, 6256},
+	{0x000001b18, 24, 6257}, {0x000001b19, 24, 6258}, {0x000001b1a, 24, 6259}, {0x000001b1b, 24, 6260},
+	{0x000001b1c, 24, 6261}, {0x000001b1d, 24, 6262}, {0x000001b1e, 24, 6263}, {0x000001b1f, 24, 6264},
+	{0x000001b20, 24, 6265}, {0x000001b21, 24, 4472}, {0x000001b22, 24, 6266}, {0x000001b23, 24, 6267},
+	{0x000001b24, 24, 5203}, {0x000001b25, 24, 4496}, {0x000001b26, 24, 6268}, {0x000001b27, 24, 6269},
+	{0x000001b28, 24, 6270}, {0x000001b29, 24, 6271}, {0x000001b2a, 24, 3}, {0x000001b2b, 24, 3},
+	{0x000001b2c, 24, 6272}, {0x000001b2d, 24, 6273}, {0x000001b2e, 24, 6274}, {0x000001b2f, 24, 6275},
+	{0x000001b30, 24, 6276}, {0x000001b31, 24, 6277}, {0x000001b32, 24, 3360}, {0x000001b33, 24, 709},
+	{0x000001b34, 24, 6278}, {0x000001b35, 24, 6279}, {0x000001b36, 24, 6280}, {0x000001b37, 24, 6281},
+	{0x000001b38, 24, 6282}, {0x000001b39, 24, 6283}, {0x000001b3a, 24, 6284}, {0x000001b3b, 24, 6285},
+	{0x000001b3c, 24, 6286}, {0x000001b3d, 24, 6287}, {0x000001b3e, 24, 6288}, {0x000001b3f, 24, 6289},
+	{0x000001b40, 24, 6290}, {0x000001b41, 24, 6291}, {0x000001b42, 24, 6292}, {0x000001b43, 24, 6293},
+	{0x000001b44, 24, 6294}, {0x000001b45, 24, 6295}, {0x000001b46, 24, 6296}, {0x000001b47, 24, 6297},
+	{0x000001b48, 24, 6298}, {0x000001b49, 24, 6299}, {0x000001b4a, 24, 6300}, {0x000001b4b, 24, 6301},
+	{0x000001b4c, 24, 6302}, {0x000001b4d, 24, 6303}, {0x000001b4e, 24, 6304}, {0x000001b4f, 24, 6305},
+	{0x000001b50, 24, 6306}, {0x000001b51, 24, 6307}, {0x000001b52, 24, 185}, {0x000001b53, 24, 3},
+	{0x000001b54, 24, 3}, {0x000001b55, 24, 6308}, {0x000001b56, 24, 6309}, {0x000001b57, 24, 6310},
+	{0x000001b58, 24, 6311}, {0x000001b59, 24, 2582}, {0x000001b5a, 24, 6312}, {0x000001b5b, 24, 4847},
+	{0x000001b5c, 24, 6313}, {0x000001b5d, 24, 6314}, {0x000001b5e, 24, 6315}, {0x000001b5f, 24, 6316},
+	{0x000001b60, 24, 6317}, {0x000001b61, 24, 6318}, {0x000001b62, 24, 6319}, {0x000001b63, 24, 865},
+	{0x000001b64, 24, 6320}, {0x000001b65, 24, 6321}, {0x000001b66, 24, 6322}, {0x000001b67, 24, 6323},
+	{0x000001b68, 24, 6324}, {0x000001b69, 24, 6325}, {0x000001b6a, 24, 6326}, {0x000001b6b, 24, 6327},
+	{0x000001b6c, 24, 6328}, {0x000001b6d, 24, 6329}, {0x000001b6e, 24, 6330}, {0x000001b6f, 24, 6331},
+	{0x000001b70, 24, 6332}, {0x000001b71, 24, 6333}, {0x000001b72, 24, 6334}, {0x000001b73, 24, 6335},
+	{0x000001b74, 24, 6336}, {0x000001b75, 24, 6337}, {0x000001b76, 24, 6338}, {0x000001b77, 24, 4472},
+	{0x000001b78, 24, 459}, {0x000001b79, 24, 6339}, {0x000001b7a, 24, 2314}, {0x000001b7b, 24, 6340},
+	{0x000001b7c, 24, 6341}, {0x000001b7d, 24, 6342}, {0x000001b7e, 24, 6343}, {0x000001b7f, 24, 6344},
+	{0x000001b80, 24, 6345}, {0x000001b81, 24, 6346}, {0x000001b82, 24, 6347}, {0x000001b83, 24, 6348},
+	{0x000001b84, 24, 6349}, {0x000001b85, 24, 6350}, {0x000001b86, 24, 3815}, {0x000001b87, 24, 6351},
+	{0x000001b88, 24, 6352}, {0x000001b89, 24, 6353}, {0x000001b8a, 24, 6354}, {0x000001b8b, 24, 3100},
+	{0x000001b8c, 24, 6355}, {0x000001b8d, 24, 6356}, {0x000001b8e, 24, 6357}, {0x000001b8f, 24, 3},
+	{0x000001b90, 24, 3}, {0x000001b91, 24, 6358}, {0x000001b92, 24, 6359}, {0x000001b93, 24, 6360},
+	{0x000001b94, 24, 6361}, {0x000001b95, 24, 6362}, {0x000001b96, 24, 6363}, {0x000001b97, 24, 6364},
+	{0x000001b98, 24, 4318}, {0x000001b99, 24, 6365}, {0x000001b9a, 24, 6366}, {0x000001b9b, 24, 6367},
+	{0x000001b9c, 24, 6368}, {0x000001b9d, 24, 6369}, {0x000001b9e, 24, 6370}, {0x000001b9f, 24, 6371},
+	{0x000001ba0, 24, 6372}, {0x000001ba1, 24, 6373}, {0x000001ba2, 24, 6374}, {0x000001ba3, 24, 6375},
+	{0x000001ba4, 24, 6376}, {0x000001ba5, 24, 6377}, {0x000001ba6, 24, 6378}, {0x000001ba7, 24, 6379},
+	{0x000001ba8, 24, 6380}, {0x000001ba9, 24, 6381}, {0x000001baa, 24, 6382}, {0x000001bab, 24, 6383},
+	{0x000001bac, 24, 6384}, {0x000001bad, 24, 6385}, {0x000001bae, 24, 6386}, {0x000001baf, 24, 709},
+	{0x000001bb0, 24, 6387}, {0x000001bb1, 24, 2718}, {0x000001bb2, 24, 6388}, {0x000001bb3, 24, 6389},
+	{0x000001bb4, 24, 6390}, {0x000001bb5, 24, 6391}, {0x000001bb6, 24, 6392}, {0x000001bb7, 24, 6393},
+	{0x000001bb8, 24, 6394}, {0x000001bb9, 24, 5831}, {0x000001bba, 24, 4496}, {0x000001bbb, 24, 6395},
+	{0x000001bbc, 24, 6396}, {0x000001bbd, 24, 6397}, {0x000001bbe, 24, 6398}, {0x000001bbf, 24, 5017},
+	{0x000001bc0, 24, 5553}, {0x000001bc1, 24, 6399}, {0x000001bc2, 24, 6400}, {0x000001bc3, 24, 6401},
+	{0x000001bc4, 24, 6402}, {0x000001bc5, 24, 6403}, {0x000001bc6, 24, 6404}, {0x000001bc7, 24, 6405},
+	{0x000001bc8, 24, 6406}, {0x000001bc9, 24, 6407}, {0x000001bca, 24, 6408}, {0x000001bcb, 24, 6409},
+	{0x000001bcc, 24, 6410}, {0x000001bcd, 24, 6411}, {0x000001bce, 24, 6412}, {0x000001bcf, 24, 6413},
+	{0x000001bd0, 24, 6414}, {0x000001bd1, 24, 6415}, {0x000001bd2, 24, 6416}, {0x000001bd3, 24, 6417},
+	{0x000001bd4, 24, 3}, {0x000001bd5, 24, 3}, {0x000001bd6, 24, 6418}, {0x000001bd7, 24, 5670},
+	{0x000001bd8, 24, 6419}, {0x000001bd9, 24, 6420}, {0x000001bda, 24, 6421}, {0x000001bdb, 24, 6422},
+	{0x000001bdc, 24, 6423}, {0x000001bdd, 24, 185}, {0x000001bde, 24, 6424}, {0x000001bdf, 24, 6425},
+	{0x000001be0, 24, 6426}, {0x000001be1, 24, 6427}, {0x000001be2, 24, 6428}, {0x000001be3, 24, 6429},
+	{0x000001be4, 24, 6430}, {0x000001be5, 24, 6431}, {0x000001be6, 24, 6432}, {0x000001be7, 24, 6433},
+	{0x000001be8, 24, 6434}, {0x000001be9, 24, 3266}, {0x000001bea, 24, 2314}, {0x000001beb, 24, 6435},
+	{0x000001bec, 24, 6436}, {0x000001bed, 24, 1085}, {0x000001bee, 24, 709}, {0x000001bef, 24, 6437},
+	{0x000001bf0, 24, 6438}, {0x000001bf1, 24, 6439}, {0x000001bf2, 24, 6440}, {0x000001bf3, 24, 6441},
+	{0x000001bf4, 24, 6442}, {0x000001bf5, 24, 6443}, {0x000001bf6, 24, 6444}, {0x000001bf7, 24, 6445},
+	{0x000001bf8, 24, 6446}, {0x000001bf9, 24, 6447}, {0x000001bfa, 24, 6448}, {0x000001bfb, 24, 5373},
+	{0x000001bfc, 24, 4673}, {0x000001bfd, 24, 6449}, {0x000001bfe, 24, 6450}, {0x000001bff, 24, 6451},
+	{0x000001c00, 24, 6452}, {0x000001c01, 24, 6453}, {0x000001c02, 24, 6454}, {0x000001c03, 24, 6455},
+	{0x000001c04, 24, 6456}, {0x000001c05, 24, 6457}, {0x000001c06, 24, 6458}, {0x000001c07, 24, 6459},
+	{0x000001c08, 24, 6460}, {0x000001c09, 24, 6461}, {0x000001c0a, 24, 6462}, {0x000001c0b, 24, 6463},
+	{0x000001c0c, 24, 6464}, {0x000001c0d, 24, 6465}, {0x000001c0e, 24, 3}, {0x000001c0f, 24, 3},
+	{0x000001c10, 24, 4275}, {0x000001c11, 24, 185}, {0x000001c12, 24, 185}, {0x000001c13, 24, 6466},
+	{0x000001c14, 24, 6467}, {0x000001c15, 24, 6468}, {0x000001c16, 24, 6469}, {0x000001c17, 24, 4496},
+	{0x000001c18, 24, 6470}, {0x000001c19, 24, 6471}, {0x000001c1a, 24, 6472}, {0x000001c1b, 24, 6473},
+	{0x000001c1c, 24, 6474}, {0x000001c1d, 24, 6475}, {0x000001c1e, 24, 6476}, {0x000001c1f, 24, 6477},
+	{0x000001c20, 24, 6478}, {0x000001c21, 24, 6479}, {0x000001c22, 24, 6480}, {0x000001c23, 24, 2822},
+	{0x000001c24, 24, 6481}, {0x000001c25, 24, 6482}, {0x000001c26, 24, 6482}, {0x000001c27, 24, 6483},
+	{0x000001c28, 24, 6484}, {0x000001c29, 24, 6485}, {0x000001c2a, 24, 6486}, {0x000001c2b, 24, 6487},
+	{0x000001c2c, 24, 6488}, {0x000001c2d, 24, 6489}, {0x000001c2e, 24, 6490}, {0x000001c2f, 24, 6491},
+	{0x000001c30, 24, 6492}, {0x000001c31, 24, 6493}, {0x000001c32, 24, 6494}, {0x000001c33, 24, 6495},
+	{0x000001c34, 24, 6496}, {0x000001c35, 24, 709}, {0x000001c36, 24, 6497}, {0x000001c37, 24, 6498},
+	{0x000001c38, 24, 6499}, {0x000001c39, 24, 6500}, {0x000001c3a, 24, 6501}, {0x000001c3b, 24, 6502},
+	{0x000001c3c, 24, 6503}, {0x000001c3d, 24, 6504}, {0x000001c3e, 24, 6505}, {0x000001c3f, 24, 6506},
+	{0x000001c40, 24, 6507}, {0x000001c41, 24, 6508}, {0x000001c42, 24, 6509}, {0x000001c43, 24, 1842},
+	{0x000001c44, 24, 6510}, {0x000001c45, 24, 6511}, {0x000001c46, 24, 6512}, {0x000001c47, 24, 6513},
+	{0x000001c48, 24, 6514}, {0x000001c49, 24, 6515}, {0x000001c4a, 24, 980}, {0x000001c4b, 24, 6516},
+	{0x000001c4c, 24, 6517}, {0x000001c4d, 24, 6518}, {0x000001c4e, 24, 6519}, {0x000001c4f, 24, 6520},
+	{0x000001c50, 24, 6521}, {0x000001c51, 24, 6522}, {0x000001c52, 24, 6523}, {0x000001c53, 24, 6524},
+	{0x000001c54, 24, 6525}, {0x000001c55, 24, 6526}, {0x000001c56, 24, 6527}, {0x000001c57, 24, 3},
+	{0x000001c58, 24, 3}, {0x000001c59, 24, 6528}, {0x000001c5a, 24, 6529}, {0x000001c5b, 24, 6530},
+	{0x000001c5c, 24, 6531}, {0x000001c5d, 24, 6532}, {0x000001c5e, 24, 6533}, {0x000001c5f, 24, 6534},
+	{0x000001c60, 24, 6535}, {0x000001c61, 24, 6536}, {0x000001c62, 24, 6537}, {0x000001c63, 24, 6538},
+	{0x000001c64, 24, 6539}, {0x000001c65, 24, 6540}, {0x000001c66, 24, 6541}, {0x000001c67, 24, 6542},
+	{0x000001c68, 24, 6543}, {0x000001c69, 24, 6544}, {0x000001c6a, 24, 6545}, {0x000001c6b, 24, 6546},
+	{0x000001c6c, 24, 6547}, {0x000001c6d, 24, 6548}, {0x000001c6e, 24, 6549}, {0x000001c6f, 24, 6550},
+	{0x000001c70, 24, 6551}, {0x000001c71, 24, 6552}, {0x000001c72, 24, 6553}, {0x000001c73, 24, 6554},
+	{0x000001c74, 24, 6555}, {0x000001c75, 24, 6556}, {0x000001c76, 24, 6557}, {0x000001c77, 24, 6558},
+	{0x000001c78, 24, 6559}, {0x000001c79, 24, 6560}, {0x000001c7a, 24, 6561}, {0x000001c7b, 24, 6562},
+	{0x000001c7c, 24, 6563}, {0x000001c7d, 24, 6564}, {0x000001c7e, 24, 1934}, {0x000001c7f, 24, 4430},
+	{0x000001c80, 24, 6565}, {0x000001c81, 24, 6566}, {0x000001c82, 24, 6567}, {0x000001c83, 24, 6568},
+	{0x000001c84, 24, 6569}, {0x000001c85, 24, 6570}, {0x000001c86, 24, 6571}, {0x000001c87, 24, 6572},
+	{0x000001c88, 24, 6573}, {0x000001c89, 24, 6574}, {0x000001c8a, 24, 6575}, {0x000001c8b, 24, 6576},
+	{0x000001c8c, 24, 6577}, {0x000001c8d, 24, 6578}, {0x000001c8e, 24, 6579}, {0x000001c8f, 24, 6580},
+	{0x000001c90, 24, 6581}, {0x000001c91, 24, 6582}, {0x000001c92, 24, 6583}, {0x000001c93, 24, 6584},
+	{0x000001c94, 24, 6585}, {0x000001c95, 24, 6586}, {0x000001c96, 24, 6587}, {0x000001c97, 24, 6588},
+	{0x000001c98, 24, 6589}, {0x000001c99, 24, 6590}, {0x000001c9a, 24, 709}, {0x000001c9b, 24, 6591},
+	{0x000001c9c, 24, 4496}, {0x000001c9d, 24, 6592}, {0x000001c9e, 24, 6593}, {0x000001c9f, 24, 6594},
+	{0x000001ca0, 24, 6595}, {0x000001ca1, 24, 6596}, {0x000001ca2, 24, 1957}, {0x000001ca3, 24, 6597},
+	{0x000001ca4, 24, 5316}, {0x000001ca5, 24, 6598}, {0x000001ca6, 24, 6599}, {0x000001ca7, 24, 6600},
+	{0x000001ca8, 24, 6601}, {0x000001ca9, 24, 6602}, {0x000001caa, 24, 6603}, {0x000001cab, 24, 6604},
+	{0x000001cac, 24, 6605}, {0x000001cad, 24, 6606}, {0x000001cae, 24, 6607}, {0x000001caf, 24, 6608},
+	{0x000001cb0, 24, 3}, {0x000001cb1, 24, 3}, {0x000001cb2, 24, 6609}, {0x000001cb3, 24, 865},
+	{0x000001cb4, 24, 6610}, {0x000001cb5, 24, 6611}, {0x000001cb6, 24, 6612}, {0x000001cb7, 24, 6613},
+	{0x000001cb8, 24, 6614}, {0x000001cb9, 24, 6615}, {0x000001cba, 24, 6616}, {0x000001cbb, 24, 6617},
+	{0x000001cbc, 24, 6618}, {0x000001cbd, 24, 6619}, {0x000001cbe, 24, 2314}, {0x000001cbf, 24, 4472},
+	{0x000001cc0, 24, 4472}, {0x000001cc1, 24, 185}, {0x000001cc2, 24, 6620}, {0x000001cc3, 24, 6621},
+	{0x000001cc4, 24, 459}, {0x000001cc5, 24, 6622}, {0x000001cc6, 24, 6623}, {0x000001cc7, 24, 6624},
+	{0x000001cc8, 24, 6625}, {0x000001cc9, 24, 6626}, {0x000001cca, 24, 6627}, {0x000001ccb, 24, 6628},
+	{0x000001ccc, 24, 3686}, {0x000001ccd, 24, 6629}, {0x000001cce, 24, 6630}, {0x000001ccf, 24, 6631},
+	{0x000001cd0, 24, 6632}, {0x000001cd1, 24, 6633}, {0x000001cd2, 24, 6634}, {0x000001cd3, 24, 6635},
+	{0x000001cd4, 24, 709}, {0x000001cd5, 24, 6636}, {0x000001cd6, 24, 709}, {0x000001cd7, 24, 6637},
+	{0x000001cd8, 24, 6638}, {0x000001cd9, 24, 6639}, {0x000001cda, 24, 6640}, {0x000001cdb, 24, 6641},
+	{0x000001cdc, 24, 6642}, {0x000001cdd, 24, 6643}, {0x000001cde, 24, 6644}, {0x000001cdf, 24, 6645},
+	{0x000001ce0, 24, 6646}, {0x000001ce1, 24, 6647}, {0x000001ce2, 24, 6648}, {0x000001ce3, 24, 6649},
+	{0x000001ce4, 24, 6650}, {0x000001ce5, 24, 6651}, {0x000001ce6, 24, 6652}, {0x000001ce7, 24, 6653},
+	{0x000001ce8, 24, 6654}, {0x000001ce9, 24, 6655}, {0x000001cea, 24, 6183}, {0x000001ceb, 24, 4496},
+	{0x000001cec, 24, 6656}, {0x000001ced, 24, 6657}, {0x000001cee, 24, 6658}, {0x000001cef, 24, 6659},
+	{0x000001cf0, 24, 3223}, {0x000001cf1, 24, 6660}, {0x000001cf2, 24, 6661}, {0x000001cf3, 24, 6662},
+	{0x000001cf4, 24, 6663}, {0x000001cf5, 24, 6664}, {0x000001cf6, 24, 3}, {0x000001cf7, 24, 6665},
+	{0x000001cf8, 24, 6666}, {0x000001cf9, 24, 3}, {0x000001cfa, 24, 6667}, {0x000001cfb, 24, 185},
+	{0x000001cfc, 24, 6668}, {0x000001cfd, 24, 6669}, {0x000001cfe, 24, 6670}, {0x000001cff, 24, 6671},
+	{0x000001d00, 24, 6672}, {0x000001d01, 24, 6673}, {0x000001d02, 24, 6674}, {0x000001d03, 24, 6675},
+	{0x000001d04, 24, 6676}, {0x000001d05, 24, 4549}, {0x000001d06, 24, 6677}, {0x000001d07, 24, 6678},
+	{0x000001d08, 24, 6679}, {0x000001d09, 24, 2822}, {0x000001d0a, 24, 6680}, {0x000001d0b, 24, 6681},
+	{0x000001d0c, 24, 6682}, {0x000001d0d, 24, 6683}, {0x000001d0e, 24, 6684}, {0x000001d0f, 24, 5993},
+	{0x000001d10, 24, 6685}, {0x000001d11, 24, 6686}, {0x000001d12, 24, 6687}, {0x000001d13, 24, 6688},
+	{0x000001d14, 24, 6689}, {0x000001d15, 24, 6690}, {0x000001d16, 24, 5421}, {0x000001d17, 24, 6691},
+	{0x000001d18, 24, 6692}, {0x000001d19, 24, 4428}, {0x000001d1a, 24, 6693}, {0x000001d1b, 24, 6694},
+	{0x000001d1c, 24, 6695}, {0x000001d1d, 24, 6696}, {0x000001d1e, 24, 6697}, {0x000001d1f, 24, 6698},
+	{0x000001d20, 24, 6699}, {0x000001d21, 24, 6700}, {0x000001d22, 24, 6701}, {0x000001d23, 24, 6702},
+	{0x000001d24, 24, 6703}, {0x000001d25, 24, 1842}, {0x000001d26, 24, 6704}, {0x000001d27, 24, 6705},
+	{0x000001d28, 24, 2582}, {0x000001d29, 24, 6706}, {0x000001d2a, 24, 6707}, {0x000001d2b, 24, 6708},
+	{0x000001d2c, 24, 6709}, {0x000001d2d, 24, 6710}, {0x000001d2e, 24, 4614}, {0x000001d2f, 24, 6711},
+	{0x000001d30, 24, 6712}, {0x000001d31, 24, 6713}, {0x000001d32, 24, 6714}, {0x000001d33, 24, 6715},
+	{0x000001d34, 24, 6716}, {0x000001d35, 24, 6717}, {0x000001d36, 24, 6718}, {0x000001d37, 24, 6719},
+	{0x000001d38, 24, 1157}, {0x000001d39, 24, 6720}, {0x000001d3a, 24, 6721}, {0x000001d3b, 24, 709},
+	{0x000001d3c, 24, 6722}, {0x000001d3d, 24, 6723}, {0x000001d3e, 24, 6724}, {0x000001d3f, 24, 6725},
+	{0x000001d40, 24, 6726}, {0x000001d41, 24, 6727}, {0x000001d42, 24, 4496}, {0x000001d43, 24, 6728},
+	{0x000001d44, 24, 6729}, {0x000001d45, 24, 3}, {0x000001d46, 24, 3}, {0x000001d47, 24, 6730},
+	{0x000001d48, 24, 6731}, {0x000001d49, 24, 6732}, {0x000001d4a, 24, 6733}, {0x000001d4b, 24, 6734},
+	{0x000001d4c, 24, 5554}, {0x000001d4d, 24, 6735}, {0x000001d4e, 24, 6736}, {0x000001d4f, 24, 865},
+	{0x000001d50, 24, 6737}, {0x000001d51, 24, 6738}, {0x000001d52, 24, 6739}, {0x000001d53, 24, 6740},
+	{0x000001d54, 24, 6741}, {0x000001d55, 24, 6742}, {0x000001d56, 24, 6743}, {0x000001d57, 24, 6744},
+	{0x000001d58, 24, 6745}, {0x000001d59, 24, 6746}, {0x000001d5a, 24, 6747}, {0x000001d5b, 24, 6748},
+	{0x000001d5c, 24, 6749}, {0x000001d5d, 24, 6750}, {0x000001d5e, 24, 6751}, {0x000001d5f, 24, 6752},
+	{0x000001d60, 24, 4673}, {0x000001d61, 24, 6753}, {0x000001d62, 24, 6754}, {0x000001d63, 24, 6755},
+	{0x000001d64, 24, 6756}, {0x000001d65, 24, 6757}, {0x000001d66, 24, 6758}, {0x000001d67, 24, 6759},
+	{0x000001d68, 24, 3404}, {0x000001d69, 24, 6760}, {0x000001d6a, 24, 3710}, {0x000001d6b, 24, 185},
+	{0x000001d6c, 24, 6761}, {0x000001d6d, 24, 6762}, {0x000001d6e, 24, 709}, {0x000001d6f, 24, 6763},
+	{0x000001d70, 24, 3}, {0x000001d71, 24, 3}, {0x000001d72, 24, 5336}, {0x000001d73, 24, 1741},
+	{0x000001d74, 24, 6764}, {0x000001d75, 24, 6765}, {0x000001d76, 24, 6766}, {0x000001d77, 24, 6767},
+	{0x000001d78, 24, 6768}, {0x000001d79, 24, 6769}, {0x000001d7a, 24, 6770}, {0x000001d7b, 24, 6771},
+	{0x000001d7c, 24, 6772}, {0x000001d7d, 24, 5352}, {0x000001d7e, 24, 4275}, {0x000001d7f, 24, 6773},
+	{0x000001d80, 24, 6774}, {0x000001d81, 24, 6775}, {0x000001d82, 24, 6776}, {0x000001d83, 24, 6777},
+	{0x000001d84, 24, 6778}, {0x000001d85, 24, 6779}, {0x000001d86, 24, 6780}, {0x000001d87, 24, 6781},
+	{0x000001d88, 24, 6782}, {0x000001d89, 24, 6783}, {0x000001d8a, 24, 6784}, {0x000001d8b, 24, 1957},
+	{0x000001d8c, 24, 6785}, {0x000001d8d, 24, 6786}, {0x000001d8e, 24, 6787}, {0x000001d8f, 24, 6788},
+	{0x000001d90, 24, 6789}, {0x000001d91, 24, 6790}, {0x000001d92, 24, 6791}, {0x000001d93, 24, 6792},
+	{0x000001d94, 24, 6793}, {0x000001d95, 24, 6794}, {0x000001d96, 24, 6795}, {0x000001d97, 24, 6796},
+	{0x000001d98, 24, 709}, {0x000001d99, 24, 6797}, {0x000001d9a, 24, 6798}, {0x000001d9b, 24, 6799},
+	{0x000001d9c, 24, 177}, {0x000001d9d, 24, 6800}, {0x000001d9e, 24, 6801}, {0x000001d9f, 24, 6802},
+	{0x000001da0, 24, 6803}, {0x000001da1, 24, 3}, {0x000001da2, 24, 3}, {0x000001da3, 24, 6804},
+	{0x000001da4, 24, 6805}, {0x000001da5, 24, 6806}, {0x000001da6, 24, 6807}, {0x000001da7, 24, 6808},
+	{0x000001da8, 24, 6809}, {0x000001da9, 24, 6810}, {0x000001daa, 24, 6811}, {0x000001dab, 24, 6812},
+	{0x000001dac, 24, 6813}, {0x000001dad, 24, 6814}, {0x000001dae, 24, 6815}, {0x000001daf, 24, 4496},
+	{0x000001db0, 24, 6816}, {0x000001db1, 24, 6817}, {0x000001db2, 24, 6818}, {0x000001db3, 24, 6490},
+	{0x000001db4, 24, 6819}, {0x000001db5, 24, 6820}, {0x000001db6, 24, 6821}, {0x000001db7, 24, 6822},
+	{0x000001db8, 24, 6823}, {0x000001db9, 24, 6824}, {0x000001dba, 24, 311}, {0x000001dbb, 24, 6825},
+	{0x000001dbc, 24, 2314}, {0x000001dbd, 24, 6826}, {0x000001dbe, 24, 185}, {0x000001dbf, 24, 6827},
+	{0x000001dc0, 24, 6828}, {0x000001dc1, 24, 6829}, {0x000001dc2, 24, 6830}, {0x000001dc3, 24, 6831},
+	{0x000001dc4, 24, 6832}, {0x000001dc5, 24, 6833}, {0x000001dc6, 24, 6834}, {0x000001dc7, 24, 6835},
+	{0x000001dc8, 24, 6836}, {0x000001dc9, 24, 6837}, {0x000001dca, 24, 6838}, {0x000001dcb, 24, 6839},
+	{0x000001dcc, 24, 6840}, {0x000001dcd, 24, 185}, {0x000001dce, 24, 185}, {0x000001dcf, 24, 185},
+	{0x000001dd0, 24, 185}, {0x000001dd1, 24, 185}, {0x000001dd2, 24, 185}, {0x000001dd3, 24, 185},
+	{0x000001dd4, 24, 185}, {0x000001dd5, 24, 185}, {0x000001dd6, 24, 185}, {0x000001dd7, 24, 6841},
+	{0x000001dd8, 24, 965}, {0x000001dd9, 24, 6842}, {0x000001dda, 24, 6843}, {0x000001ddb, 24, 6844},
+	{0x000001ddc, 24, 6845}, {0x000001ddd, 24, 6846}, {0x000001dde, 24, 6847}, {0x000001ddf, 24, 6848},
+	{0x000001de0, 24, 4472}, {0x000001de1, 24, 4472}, {0x000001de2, 24, 6849}, {0x000001de3, 24, 6850},
+	{0x000001de4, 24, 6851}, {0x000001de5, 24, 3}, {0x000001de6, 24, 3}, {0x000001de7, 24, 6852},
+	{0x000001de8, 24, 6853}, {0x000001de9, 24, 709}, {0x000001dea, 24, 6854}, {0x000001deb, 24, 6855},
+	{0x000001dec, 24, 6856}, {0x000001ded, 24, 6857}, {0x000001dee, 24, 6858}, {0x000001def, 24, 6859},
+	{0x000001df0, 24, 6860}, {0x000001df1, 24, 6861}, {0x000001df2, 24, 6862}, {0x000001df3, 24, 6863},
+	{0x000001df4, 24, 6864}, {0x000001df5, 24, 6865}, {0x000001df6, 24, 1842}, {0x000001df7, 24, 6866},
+	{0x000001df8, 24, 6867}, {0x000001df9, 24, 6868}, {0x000001dfa, 24, 6869}, {0x000001dfb, 24, 6870},
+	{0x000001dfc, 24, 6871}, {0x000001dfd, 24, 709}, {0x000001dfe, 24, 6872}, {0x000001dff, 24, 6873},
+	{0x000001e00, 24, 6874}, {0x000001e01, 24, 6875}, {0x000001e02, 24, 6876}, {0x000001e03, 24, 6877},
+	{0x000001e04, 24, 6878}, {0x000001e05, 24, 6879}, {0x000001e06, 24, 6880}, {0x000001e07, 24, 6881},
+	{0x000001e08, 24, 6882}, {0x000001e09, 24, 6883}, {0x000001e0a, 24, 6884}, {0x000001e0b, 24, 459},
+	{0x000001e0c, 24, 6885}, {0x000001e0d, 24, 6886}, {0x000001e0e, 24, 6887}, {0x000001e0f, 24, 6888},
+	{0x000001e10, 24, 6889}, {0x000001e11, 24, 6890}, {0x000001e12, 24, 6891}, {0x000001e13, 24, 3},
+	{0x000001e14, 24, 3}, {0x000001e15, 24, 6892}, {0x000001e16, 24, 6893}, {0x000001e17, 24, 6894},
+	{0x000001e18, 24, 6895}, {0x000001e19, 24, 6896}, {0x000001e1a, 24, 6897}, {0x000001e1b, 24, 6898},
+	{0x000001e1c, 24, 6899}, {0x000001e1d, 24, 6900}, {0x000001e1e, 24, 6901}, {0x000001e1f, 24, 4496},
+	{0x000001e20, 24, 6902}, {0x000001e21, 24, 6903}, {0x000001e22, 24, 6904}, {0x000001e23, 24, 6905},
+	{0x000001e24, 24, 6906}, {0x000001e25, 24, 6907}, {0x000001e26, 24, 6908}, {0x000001e27, 24, 6909},
+	{0x000001e28, 24, 6910}, {0x000001e29, 24, 6911}, {0x000001e2a, 24, 4809}, {0x000001e2b, 24, 6912},
+	{0x000001e2c, 24, 6913}, {0x000001e2d, 24, 6914}, {0x000001e2e, 24, 6915}, {0x000001e2f, 24, 6916},
+	{0x000001e30, 24, 6917}, {0x000001e31, 24, 6918}, {0x000001e32, 24, 6919}, {0x000001e33, 24, 6920},
+	{0x000001e34, 24, 6921}, {0x000001e35, 24, 2314}, {0x000001e36, 24, 6922}, {0x000001e37, 24, 3976},
+	{0x000001e38, 24, 6923}, {0x000001e39, 24, 6924}, {0x000001e3a, 24, 709}, {0x000001e3b, 24, 709},
+	{0x000001e3c, 24, 6925}, {0x000001e3d, 24, 5373}, {0x000001e3e, 24, 6926}, {0x000001e3f, 24, 6927},
+	{0x000001e40, 24, 6928}, {0x000001e41, 24, 6929}, {0x000001e42, 24, 6930}, {0x000001e43, 24, 6931},
+	{0x000001e44, 24, 6932}, {0x000001e45, 24, 2582}, {0x000001e46, 24, 185}, {0x000001e47, 24, 6933},
+	{0x000001e48, 24, 6934}, {0x000001e49, 24, 3}, {0x000001e4a, 24, 3}, {0x000001e4b, 24, 6935},
+	{0x000001e4c, 24, 6936}, {0x000001e4d, 24, 6937}, {0x000001e4e, 24, 6938}, {0x000001e4f, 24, 5963},
+	{0x000001e50, 24, 6939}, {0x000001e51, 24, 6940}, {0x000001e52, 24, 865}, {0x000001e53, 24, 6941},
+	{0x000001e54, 24, 6942}, {0x000001e55, 24, 6943}, {0x000001e56, 24, 6944}, {0x000001e57, 24, 6945},
+	{0x000001e58, 24, 3223}, {0x000001e59, 24, 6946}, {0x000001e5a, 24, 185}, {0x000001e5b, 24, 6947},
+	{0x000001e5c, 24, 6948}, {0x000001e5d, 24, 6949}, {0x000001e5e, 24, 6950}, {0x000001e5f, 24, 6951},
+	{0x000001e60, 24, 6952}, {0x000001e61, 24, 6953}, {0x000001e62, 24, 6954}, {0x000001e63, 24, 6955},
+	{0x000001e64, 24, 4472}, {0x000001e65, 24, 4472}, {0x000001e66, 24, 6956}, {0x000001e67, 24, 4472},
+	{0x000001e68, 24, 6957}, {0x000001e69, 24, 3930}, {0x000001e6a, 24, 6958}, {0x000001e6b, 24, 6959},
+	{0x000001e6c, 24, 6960}, {0x000001e6d, 24, 6961}, {0x000001e6e, 24, 6962}, {0x000001e6f, 24, 6963},
+	{0x000001e70, 24, 6964}, {0x000001e71, 24, 6965}, {0x000001e72, 24, 6966}, {0x000001e73, 24, 5136},
+	{0x000001e74, 24, 5017}, {0x000001e75, 24, 6967}, {0x000001e76, 24, 6968}, {0x000001e77, 24, 6969},
+	{0x000001e78, 24, 6970}, {0x000001e79, 24, 3}, {0x000001e7a, 24, 3}, {0x000001e7b, 24, 6971},
+	{0x000001e7c, 24, 6972}, {0x000001e7d, 24, 1842}, {0x000001e7e, 24, 4496}, {0x000001e7f, 24, 6973},
+	{0x000001e80, 24, 6974}, {0x000001e81, 24, 6975}, {0x000001e82, 24, 6294}, {0x000001e83, 24, 6976},
+	{0x000001e84, 24, 6977}, {0x000001e85, 24, 6978}, {0x000001e86, 24, 6979}, {0x000001e87, 24, 6980},
+	{0x000001e88, 24, 6981}, {0x000001e89, 24, 6982}, {0x000001e8a, 24, 6983}, {0x000001e8b, 24, 6984},
+	{0x000001e8c, 24, 4673}, {0x000001e8d, 24, 185}, {0x000001e8e, 24, 6985}, {0x000001e8f, 24, 124},
+	{0x000001e90, 24, 6986}, {0x000001e91, 24, 6987}, {0x000001e92, 24, 6988}, {0x000001e93, 24, 6989},
+	{0x000001e94, 24, 6990}, {0x000001e95, 24, 6991}, {0x000001e96, 24, 6992}, {0x000001e97, 24, 6993},
+	{0x000001e98, 24, 6994}, {0x000001e99, 24, 6995}, {0x000001e9a, 24, 6996}, {0x000001e9b, 24, 6997},
+	{0x000001e9c, 24, 6998}, {0x000001e9d, 24, 6999}, {0x000001e9e, 24, 7000}, {0x000001e9f, 24, 7001},
+	{0x000001ea0, 24, 7002}, {0x000001ea1, 24, 7003}, {0x000001ea2, 24, 7004}, {0x000001ea3, 24, 709},
+	{0x000001ea4, 24, 709}, {0x000001ea5, 24, 7005}, {0x000001ea6, 24, 7006}, {0x000001ea7, 24, 7007},
+	{0x000001ea8, 24, 7008}, {0x000001ea9, 24, 2314}, {0x000001eaa, 24, 7009}, {0x000001eab, 24, 7010},
+	{0x000001eac, 24, 7011}, {0x000001ead, 24, 7012}, {0x000001eae, 24, 7013}, {0x000001eaf, 24, 7014},
+	{0x000001eb0, 24, 7015}, {0x000001eb1, 24, 7016}, {0x000001eb2, 24, 7017}, {0x000001eb3, 24, 7018},
+	{0x000001eb4, 24, 7019}, {0x000001eb5, 24, 7020}, {0x000001eb6, 24, 7021}, {0x000001eb7, 24, 7022},
+	{0x000001eb8, 24, 7023}, {0x000001eb9, 24, 7024}, {0x000001eba, 24, 7025}, {0x000001ebb, 24, 7026},
+	{0x000001ebc, 24, 7027}, {0x000001ebd, 24, 3}, {0x000001ebe, 24, 3}, {0x000001ebf, 24, 7028},
+	{0x000001ec0, 24, 7029}, {0x000001ec1, 24, 7030}, {0x000001ec2, 24, 865}, {0x000001ec3, 24, 7031},
+	{0x000001ec4, 24, 7032}, {0x000001ec5, 24, 7033}, {0x000001ec6, 24, 7034}, {0x000001ec7, 24, 4847},
+	{0x000001ec8, 24, 7035}, {0x000001ec9, 24, 2822}, {0x000001eca, 24, 4496}, {0x000001ecb, 24, 7036},
+	{0x000001ecc, 24, 7037}, {0x000001ecd, 24, 7038}, {0x000001ece, 24, 7039}, {0x000001ecf, 24, 7040},
+	{0x000001ed0, 24, 7041}, {0x000001ed1, 24, 7042}, {0x000001ed2, 24, 7043}, {0x000001ed3, 24, 7044},
+	{0x000001ed4, 24, 7045}, {0x000001ed5, 24, 7046}, {0x000001ed6, 24, 7047}, {0x000001ed7, 24, 7048},
+	{0x000001ed8, 24, 7049}, {0x000001ed9, 24, 7050}, {0x000001eda, 24, 7051}, {0x000001edb, 24, 7052},
+	{0x000001edc, 24, 2582}, {0x000001edd, 24, 7053}, {0x000001ede, 24, 7054}, {0x000001edf, 24, 7055},
+	{0x000001ee0, 24, 7056}, {0x000001ee1, 24, 1842}, {0x000001ee2, 24, 1842}, {0x000001ee3, 24, 7057},
+	{0x000001ee4, 24, 7058}, {0x000001ee5, 24, 4275}, {0x000001ee6, 24, 7059}, {0x000001ee7, 24, 7060},
+	{0x000001ee8, 24, 7061}, {0x000001ee9, 24, 7062}, {0x000001eea, 24, 7063}, {0x000001eeb, 24, 7064},
+	{0x000001eec, 24, 6282}, {0x000001eed, 24, 7065}, {0x000001eee, 24, 7066}, {0x000001eef, 24, 7067},
+	{0x000001ef0, 24, 7068}, {0x000001ef1, 24, 7069}, {0x000001ef2, 24, 7070}, {0x000001ef3, 24, 7071},
+	{0x000001ef4, 24, 7072}, {0x000001ef5, 24, 7073}, {0x000001ef6, 24, 3}, {0x000001ef7, 24, 3},
+	{0x000001ef8, 24, 7074}, {0x000001ef9, 24, 7075}, {0x000001efa, 24, 7076}, {0x000001efb, 24, 7077},
+	{0x000001efc, 24, 7078}, {0x000001efd, 24, 7079}, {0x000001efe, 24, 7080}, {0x000001eff, 24, 7081},
+	{0x000001f00, 24, 709}, {0x000001f01, 24, 709}, {0x000001f02, 24, 7082}, {0x000001f03, 24, 7083},
+	{0x000001f04, 24, 7084}, {0x000001f05, 24, 7085}, {0x000001f06, 24, 7086}, {0x000001f07, 24, 7087},
+	{0x000001f08, 24, 7088}, {0x000001f09, 24, 7089}, {0x000001f0a, 24, 4496}, {0x000001f0b, 24, 7090},
+	{0x000001f0c, 24, 7091}, {0x000001f0d, 24, 7092}, {0x000001f0e, 24, 7093}, {0x000001f0f, 24, 7094},
+	{0x000001f10, 24, 7095}, {0x000001f11, 24, 7096}, {0x000001f12, 24, 5553}, {0x000001f13, 24, 7097},
+	{0x000001f14, 24, 7098}, {0x000001f15, 24, 7099}, {0x000001f16, 24, 5336}, {0x000001f17, 24, 7100},
+	{0x000001f18, 24, 7101}, {0x000001f19, 24, 7102}, {0x000001f1a, 24, 7103}, {0x000001f1b, 24, 7104},
+	{0x000001f1c, 24, 7105}, {0x000001f1d, 24, 7106}, {0x000001f1e, 24, 7107}, {0x000001f1f, 24, 7108},
+	{0x000001f20, 24, 1772}, {0x000001f21, 24, 7109}, {0x000001f22, 24, 1611}, {0x000001f23, 24, 7110},
+	{0x000001f24, 24, 7111}, {0x000001f25, 24, 7112}, {0x000001f26, 24, 3}, {0x000001f27, 24, 3},
+	{0x000001f28, 24, 6490}, {0x000001f29, 24, 459}, {0x000001f2a, 24, 7113}, {0x000001f2b, 24, 7114},
+	{0x000001f2c, 24, 7115}, {0x000001f2d, 24, 7116}, {0x000001f2e, 24, 7117}, {0x000001f2f, 24, 7118},
+	{0x000001f30, 24, 7119}, {0x000001f31, 24, 7120}, {0x000001f32, 24, 2314}, {0x000001f33, 24, 4809},
+	{0x000001f34, 24, 7121}, {0x000001f35, 24, 7122}, {0x000001f36, 24, 7123}, {0x000001f37, 24, 7124},
+	{0x000001f38, 24, 7125}, {0x000001f39, 24, 7126}, {0x000001f3a, 24, 6936}, {0x000001f3b, 24, 4472},
+	{0x000001f3c, 24, 4472}, {0x000001f3d, 24, 7127}, {0x000001f3e, 24, 7128}, {0x000001f3f, 24, 980},
+	{0x000001f40, 24, 7129}, {0x000001f41, 24, 4614}, {0x000001f42, 24, 7130}, {0x000001f43, 24, 7131},
+	{0x000001f44, 24, 7132}, {0x000001f45, 24, 4146}, {0x000001f46, 24, 4496}, {0x000001f47, 24, 7133},
+	{0x000001f48, 24, 7134}, {0x000001f49, 24, 7135}, {0x000001f4a, 24, 7136}, {0x000001f4b, 24, 7137},
+	{0x000001f4c, 24, 7138}, {0x000001f4d, 24, 7139}, {0x000001f4e, 24, 7140}, {0x000001f4f, 24, 7141},
+	{0x000001f50, 24, 7142}, {0x000001f51, 24, 7143}, {0x000001f52, 24, 7144}, {0x000001f53, 24, 7145},
+	{0x000001f54, 24, 7146}, {0x000001f55, 24, 7147}, {0x000001f56, 24, 7148}, {0x000001f57, 24, 7149},
+	{0x000001f58, 24, 7150}, {0x000001f59, 24, 7151}, {0x000001f5a, 24, 7152}, {0x000001f5b, 24, 865},
+	{0x000001f5c, 24, 709}, {0x000001f5d, 24, 709}, {0x000001f5e, 24, 7153}, {0x000001f5f, 24, 7154},
+	{0x000001f60, 24, 7155}, {0x000001f61, 24, 7156}, {0x000001f62, 24, 7157}, {0x000001f63, 24, 7158},
+	{0x000001f64, 24, 7159}, {0x000001f65, 24, 7160}, {0x000001f66, 24, 7161}, {0x000001f67, 24, 7162},
+	{0x000001f68, 24, 7163}, {0x000001f69, 24, 7164}, {0x000001f6a, 24, 7165}, {0x000001f6b, 24, 6967},
+	{0x000001f6c, 24, 3}, {0x000001f6d, 24, 3}, {0x000001f6e, 24, 7166}, {0x000001f6f, 24, 7167},
+	{0x000001f70, 24, 7168}, {0x000001f71, 24, 7169}, {0x000001f72, 24, 7170}, {0x000001f73, 24, 7171},
+	{0x000001f74, 24, 7172}, {0x000001f75, 24, 7173}, {0x000001f76, 24, 7174}, {0x000001f77, 24, 7175},
+	{0x000001f78, 24, 7176}, {0x000001f79, 24, 7177}, {0x000001f7a, 24, 7178}, {0x000001f7b, 24, 7179},
+	{0x000001f7c, 24, 7180}, {0x000001f7d, 24, 7181}, {0x000001f7e, 24, 185}, {0x000001f7f, 24, 7182},
+	{0x000001f80, 24, 7183}, {0x000001f81, 24, 7184}, {0x000001f82, 24, 7185}, {0x000001f83, 24, 7186},
+	{0x000001f84, 24, 7187}, {0x000001f85, 24, 7188}, {0x000001f86, 24, 7189}, {0x000001f87, 24, 7190},
+	{0x000001f88, 24, 7191}, {0x000001f89, 24, 7192}, {0x000001f8a, 24, 7193}, {0x000001f8b, 24, 7194},
+	{0x000001f8c, 24, 7195}, {0x000001f8d, 24, 7196}, {0x000001f8e, 24, 7197}, {0x000001f8f, 24, 7198},
+	{0x000001f90, 24, 3726}, {0x000001f91, 24, 7199}, {0x000001f92, 24, 7200}, {0x000001f93, 24, 7201},
+	{0x000001f94, 24, 7202}, {0x000001f95, 24, 5017}, {0x000001f96, 24, 7203}, {0x000001f97, 24, 7204},
+	{0x000001f98, 24, 7205}, {0x000001f99, 24, 7206}, {0x000001f9a, 24, 108}, {0x000001f9b, 24, 7207},
+	{0x000001f9c, 24, 7208}, {0x000001f9d, 24, 3}, {0x000001f9e, 24, 3}, {0x000001f9f, 24, 3404},
+	{0x000001fa0, 24, 7209}, {0x000001fa1, 24, 7210}, {0x000001fa2, 24, 7211}, {0x000001fa3, 24, 7212},
+	{0x000001fa4, 24, 7213}, {0x000001fa5, 24, 7214}, {0x000001fa6, 24, 7215}, {0x000001fa7, 24, 7216},
+	{0x000001fa8, 24, 7217}, {0x000001fa9, 24, 7218}, {0x000001faa, 24, 7219}, {0x000001fab, 24, 7220},
+	{0x000001fac, 24, 7221}, {0x000001fad, 24, 7222}, {0x000001fae, 24, 7223}, {0x000001faf, 24, 7224},
+	{0x000001fb0, 24, 7225}, {0x000001fb1, 24, 7226}, {0x000001fb2, 24, 7227}, {0x000001fb3, 24, 7228},
+	{0x000001fb4, 24, 7229}, {0x000001fb5, 24, 7230}, {0x000001fb6, 24, 7231}, {0x000001fb7, 24, 7232},
+	{0x000001fb8, 24, 7233}, {0x000001fb9, 24, 7234}, {0x000001fba, 24, 7235}, {0x000001fbb, 24, 7236},
+	{0x000001fbc, 24, 7237}, {0x000001fbd, 24, 4799}, {0x000001fbe, 24, 7238}, {0x000001fbf, 24, 7239},
+	{0x000001fc0, 24, 7240}, {0x000001fc1, 24, 7241}, {0x000001fc2, 24, 7242}, {0x000001fc3, 24, 7243},
+	{0x000001fc4, 24, 185}, {0x000001fc5, 24, 2314}, {0x000001fc6, 24, 4673}, {0x000001fc7, 24, 7244},
+	{0x000001fc8, 24, 7245}, {0x000001fc9, 24, 3}, {0x000001fca, 24, 3}, {0x000001fcb, 24, 7246},
+	{0x000001fcc, 24, 1842}, {0x000001fcd, 24, 4482}, {0x000001fce, 24, 7247}, {0x000001fcf, 24, 7248},
+	{0x000001fd0, 24, 5352}, {0x000001fd1, 24, 7249}, {0x000001fd2, 24, 7250}, {0x000001fd3, 24, 7251},
+	{0x000001fd4, 24, 7252}, {0x000001fd5, 24, 7253}, {0x000001fd6, 24, 7254}, {0x000001fd7, 24, 7255},
+	{0x000001fd8, 24, 7256}, {0x000001fd9, 24, 7257}, {0x000001fda, 24, 108}, {0x000001fdb, 24, 7258},
+	{0x000001fdc, 24, 7259}, {0x000001fdd, 24, 7260}, {0x000001fde, 24, 709}, {0x000001fdf, 24, 709},
+	{0x000001fe0, 24, 7261}, {0x000001fe1, 24, 4861}, {0x000001fe2, 24, 4861}, {0x000001fe3, 24, 6967},
+	{0x000001fe4, 24, 5316}, {0x000001fe5, 24, 7262}, {0x000001fe6, 24, 7263}, {0x000001fe7, 24, 7264},
+	{0x000001fe8, 24, 7265}, {0x000001fe9, 24, 7266}, {0x000001fea, 24, 7267}, {0x000001feb, 24, 7268},
+	{0x000001fec, 24, 7269}, {0x000001fed, 24, 7270}, {0x000001fee, 24, 7271}, {0x000001fef, 24, 7272},
+	{0x000001ff0, 24, 7273}, {0x000001ff1, 24, 7274}, {0x000001ff2, 24, 7275}, {0x000001ff3, 24, 865},
+	{0x000001ff4, 24, 7276}, {0x000001ff5, 24, 7277}, {0x000001ff6, 24, 7278}, {0x000001ff7, 24, 7279},
+	{0x000001ff8, 24, 7280}, {0x000001ff9, 24, 7281}, {0x000001ffa, 24, 7282}, {0x000001ffb, 24, 7283},
+	{0x000001ffc, 24, 7284}, {0x000001ffd, 24, 7285}, {0x000001ffe, 24, 6490}, {0x000001fff, 24, 7286},
+	{0x000002000, 24, 966}, {0x000002001, 24, 7287}, {0x000002002, 24, 7288}, {0x000002003, 24, 7289},
+	{0x000002004, 24, 7290}, {0x000002005, 24, 7291}, {0x000002006, 24, 7292}, {0x000002007, 24, 7293},
+	{0x000002008, 24, 7294}, {0x000002009, 24, 7295}, {0x00000200a, 24, 7296}, {0x00000200b, 24, 7297},
+	{0x00000200c, 24, 7298}, {0x00000200d, 24, 7299}, {0x00000200e, 24, 7300}, {0x00000200f, 24, 7301},
+	{0x000002010, 24, 7302}, {0x000002011, 24, 7303}, {0x000002012, 24, 7304}, {0x000002013, 24, 7305},
+	{0x000002014, 24, 7306}, {0x000002015, 24, 7307}, {0x000002016, 24, 7308}, {0x000002017, 24, 7309},
+	{0x000002018, 24, 251}, {0x000002019, 24, 7310}, {0x00000201a, 24, 7311}, {0x00000201b, 24, 7312},
+	{0x00000201c, 24, 7313}, {0x00000201d, 24, 7314}, {0x00000201e, 24, 7315}, {0x00000201f, 24, 7316},
+	{0x000002020, 24, 7317}, {0x000002021, 24, 7318}, {0x000002022, 24, 7319}, {0x000002023, 24, 7320},
+	{0x000002024, 24, 7321}, {0x000002025, 24, 7322}, {0x000002026, 24, 7323}, {0x000002027, 24, 7324},
+	{0x000002028, 24, 7325}, {0x000002029, 24, 7326}, {0x00000202a, 24, 7327}, {0x00000202b, 24, 7328},
+	{0x00000202c, 24, 7329}, {0x00000202d, 24, 7330}, {0x00000202e, 24, 7331}, {0x00000202f, 24, 7332},
+	{0x000002030, 24, 7333}, {0x000002031, 24, 155}, {0x000002032, 24, 7334}, {0x000002033, 24, 7335},
+	{0x000002034, 24, 7336}, {0x000002035, 24, 564}, {0x000002036, 24, 7337}, {0x000002037, 24, 7338},
+	{0x000002038, 24, 7339}, {0x000002039, 24, 7340}, {0x00000203a, 24, 7341}, {0x00000203b, 24, 7342},
+	{0x00000203c, 24, 7343}, {0x00000203d, 24, 7344}, {0x00000203e, 24, 7345}, {0x00000203f, 24, 7346},
+	{0x000002040, 24, 185}, {0x000002041, 24, 7347}, {0x000002042, 24, 7348}, {0x000002043, 24, 7349},
+	{0x000002044, 24, 7350}, {0x000002045, 24, 7351}, {0x000002046, 24, 7352}, {0x000002047, 24, 7353},
+	{0x000002048, 24, 7354}, {0x000002049, 24, 7355}, {0x00000204a, 24, 7356}, {0x00000204b, 24, 7357},
+	{0x00000204c, 24, 7358}, {0x00000204d, 24, 7359}, {0x00000204e, 24, 7360}, {0x00000204f, 24, 7361},
+	{0x000002050, 24, 7362}, {0x000002051, 24, 7363}, {0x000002052, 24, 7364}, {0x000002053, 24, 7365},
+	{0x000002054, 24, 7366}, {0x000002055, 24, 7367}, {0x000002056, 24, 7368}, {0x000002057, 24, 7369},
+	{0x000002058, 24, 7370}, {0x000002059, 24, 7371}, {0x00000205a, 24, 7372}, {0x00000205b, 24, 7373},
+	{0x00000205c, 24, 7374}, {0x00000205d, 24, 7375}, {0x00000205e, 24, 7376}, {0x00000205f, 24, 7377},
+	{0x000002060, 24, 7378}, {0x000002061, 24, 7379}, {0x000002062, 24, 7380}, {0x000002063, 24, 7381},
+	{0x000002064, 24, 7382}, {0x000002065, 24, 7383}, {0x000002066, 24, 7384}, {0x000002067, 24, 99},
+	{0x000002068, 24, 7385}, {0x000002069, 24, 7386}, {0x00000206a, 24, 7387}, {0x00000206b, 24, 7388},
+	{0x00000206c, 24, 7389}, {0x00000206d, 24, 7390}, {0x00000206e, 24, 7391}, {0x00000206f, 24, 7392},
+	{0x000002070, 24, 7393}, {0x000002071, 24, 7394}, {0x000002072, 24, 7395}, {0x000002073, 24, 7396},
+	{0x000002074, 24, 7397}, {0x000002075, 24, 7398}, {0x000002076, 24, 7399}, {0x000002077, 24, 7400},
+	{0x000002078, 24, 7401}, {0x000002079, 24, 7402}, {0x00000207a, 24, 7403}, {0x00000207b, 24, 652},
+	{0x00000207c, 24, 7404}, {0x00000207d, 24, 7405}, {0x00000207e, 24, 7406}, {0x00000207f, 24, 7407},
+	{0x000002080, 24, 7408}, {0x000002081, 24, 7409}, {0x000002082, 24, 7410}, {0x000002083, 24, 7411},
+	{0x000002084, 24, 7412}, {0x000002085, 24, 4549}, {0x000002086, 24, 7413}, {0x000002087, 24, 7414},
+	{0x000002088, 24, 610}, {0x000002089, 24, 7415}, {0x00000208a, 24, 7416}, {0x00000208b, 24, 7417},
+	{0x00000208c, 24, 7418}, {0x00000208d, 24, 7419}, {0x00000208e, 24, 7420}, {0x00000208f, 24, 7421},
+	{0x000002090, 24, 7422}, {0x000002091, 24, 7423}, {0x000002092, 24, 7424}, {0x000002093, 24, 7425},
+	{0x000002094, 24, 7426}, {0x000002095, 24, 7427}, {0x000002096, 24, 7428}, {0x000002097, 24, 7429},
+	{0x000002098, 24, 7430}, {0x000002099, 24, 7431}, {0x00000209a, 24, 7432}, {0x00000209b, 24, 7433},
+	{0x00000209c, 24, 7434}, {0x00000209d, 24, 7435}, {0x00000209e, 24, 7436}, {0x00000209f, 24, 7437},
+	{0x0000020a0, 24, 7438}, {0x0000020a1, 24, 7439}, {0x0000020a2, 24, 7440}, {0x0000020a3, 24, 2480},
+	{0x0000020a4, 24, 7441}, {0x0000020a5, 24, 7442}, {0x0000020a6, 24, 7443}, {0x0000020a7, 24, 7444},
+	{0x0000020a8, 24, 7445}, {0x0000020a9, 24, 7446}, {0x0000020aa, 24, 7447}, {0x0000020ab, 24, 7448},
+	{0x0000020ac, 24, 7449}, {0x0000020ad, 24, 7450}, {0x0000020ae, 24, 7451}, {0x0000020af, 24, 244},
+	{0x0000020b0, 24, 7452}, {0x0000020b1, 24, 7453}, {0x0000020b2, 24, 7454}, {0x0000020b3, 24, 155},
+	{0x0000020b4, 24, 7455}, {0x0000020b5, 24, 7456}, {0x0000020b6, 24, 7457}, {0x0000020b7, 24, 7458},
+	{0x0000020b8, 24, 7459}, {0x0000020b9, 24, 7460}, {0x0000020ba, 24, 7461}, {0x0000020bb, 24, 7462},
+	{0x0000020bc, 24, 7463}, {0x0000020bd, 24, 7464}, {0x0000020be, 24, 7465}, {0x0000020bf, 24, 7466},
+	{0x0000020c0, 24, 7467}, {0x0000020c1, 24, 524}, {0x0000020c2, 24, 7468}, {0x0000020c3, 24, 7469},
+	{0x0000020c4, 24, 7470}, {0x0000020c5, 24, 7471}, {0x0000020c6, 24, 7472}, {0x0000020c7, 24, 7473},
+	{0x0000020c8, 24, 7474}, {0x0000020c9, 24, 7475}, {0x0000020ca, 24, 7476}, {0x0000020cb, 24, 7477},
+	{0x0000020cc, 24, 7478}, {0x0000020cd, 24, 7479}, {0x0000020ce, 24, 7480}, {0x0000020cf, 24, 7481},
+	{0x0000020d0, 24, 7482}, {0x0000020d1, 24, 7483}, {0x0000020d2, 24, 7484}, {0x0000020d3, 24, 7485},
+	{0x0000020d4, 24, 7486}, {0x0000020d5, 24, 7487}, {0x0000020d6, 24, 7488}, {0x0000020d7, 24, 7489},
+	{0x0000020d8, 24, 108}, {0x0000020d9, 24, 7490}, {0x0000020da, 24, 7491}, {0x0000020db, 24, 7492},
+	{0x0000020dc, 24, 7493}, {0x0000020dd, 24, 7494}, {0x0000020de, 24, 7495}, {0x0000020df, 24, 7496},
+	{0x0000020e0, 24, 7497}, {0x0000020e1, 24, 7498}, {0x0000020e2, 24, 7499}, {0x0000020e3, 24, 7500},
+	{0x0000020e4, 24, 7501}, {0x0000020e5, 24, 7502}, {0x0000020e6, 24, 7503}, {0x0000020e7, 24, 7504},
+	{0x0000020e8, 24, 7505}, {0x0000020e9, 24, 7506}, {0x0000020ea, 24, 7507}, {0x0000020eb, 24, 7508},
+	{0x0000020ec, 24, 7509}, {0x0000020ed, 24, 7510}, {0x0000020ee, 24, 7511}, {0x0000020ef, 24, 7512},
+	{0x0000020f0, 24, 7513}, {0x0000020f1, 24, 7514}, {0x0000020f2, 24, 116}, {0x0000020f3, 24, 7515},
+	{0x0000020f4, 24, 7516}, {0x0000020f5, 24, 7517}, {0x0000020f6, 24, 7518}, {0x0000020f7, 24, 7519},
+	{0x0000020f8, 24, 7520}, {0x0000020f9, 24, 7521}, {0x0000020fa, 24, 7522}, {0x0000020fb, 24, 7523},
+	{0x0000020fc, 24, 7524}, {0x0000020fd, 24, 7525}, {0x0000020fe, 24, 7526}, {0x0000020ff, 24, 7527},
+	{0x000002100, 24, 7528}, {0x000002101, 24, 7529}, {0x000002102, 24, 7530}, {0x000002103, 24, 7531},
+	{0x000002104, 24, 7532}, {0x000002105, 24, 5554}, {0x000002106, 24, 7533}, {0x000002107, 24, 7534},
+	{0x000002108, 24, 709}, {0x000002109, 24, 709}, {0x00000210a, 24, 7535}, {0x00000210b, 24, 7536},
+	{0x00000210c, 24, 7537}, {0x00000210d, 24, 7538}, {0x00000210e, 24, 7539}, {0x00000210f, 24, 7540},
+	{0x000002110, 24, 7541}, {0x000002111, 24, 7542}, {0x000002112, 24, 7543}, {0x000002113, 24, 7544},
+	{0x000002114, 24, 7545}, {0x000002115, 24, 7546}, {0x000002116, 24, 7547}, {0x000002117, 24, 7548},
+	{0x000002118, 24, 7549}, {0x000002119, 24, 7550}, {0x00000211a, 24, 7551}, {0x00000211b, 24, 3},
+	{0x00000211c, 24, 3}, {0x00000211d, 24, 7552}, {0x00000211e, 24, 185}, {0x00000211f, 24, 7553},
+	{0x000002120, 24, 7554}, {0x000002121, 24, 7555}, {0x000002122, 24, 7556}, {0x000002123, 24, 7557},
+	{0x000002124, 24, 7558}, {0x000002125, 24, 7559}, {0x000002126, 24, 7560}, {0x000002127, 24, 7561},
+	{0x000002128, 24, 116}, {0x000002129, 24, 4275}, {0x00000212a, 24, 7562}, {0x00000212b, 24, 7563},
+	{0x00000212c, 24, 7564}, {0x00000212d, 24, 7565}, {0x00000212e, 24, 7566}, {0x00000212f, 24, 7567},
+	{0x000002130, 24, 7568}, {0x000002131, 24, 7569}, {0x000002132, 24, 7570}, {0x000002133, 24, 7571},
+	{0x000002134, 24, 7572}, {0x000002135, 24, 7573}, {0x000002136, 24, 185}, {0x000002137, 24, 7574},
+	{0x000002138, 24, 7575}, {0x000002139, 24, 7576}, {0x00000213a, 24, 7577}, {0x00000213b, 24, 7578},
+	{0x00000213c, 24, 7579}, {0x00000213d, 24, 7580}, {0x00000213e, 24, 4583}, {0x00000213f, 24, 7581},
+	{0x000002140, 24, 7582}, {0x000002141, 24, 7583}, {0x000002142, 24, 7584}, {0x000002143, 24, 185},
+	{0x000002144, 24, 7585}, {0x000002145, 24, 7586}, {0x000002146, 24, 6068}, {0x000002147, 24, 2314},
+	{0x000002148, 24, 7587}, {0x000002149, 24, 7588}, {0x00000214a, 24, 7589}, {0x00000214b, 24, 7590},
+	{0x00000214c, 24, 227}, {0x00000214d, 24, 7591}, {0x00000214e, 24, 7592}, {0x00000214f, 24, 7593},
+	{0x000002150, 24, 7594}, {0x000002151, 24, 7595}, {0x000002152, 24, 7596}, {0x000002153, 24, 7597},
+	{0x000002154, 24, 7598}, {0x000002155, 24, 3}, {0x000002156, 24, 3}, {0x000002157, 24, 7599},
+	{0x000002158, 24, 7600}, {0x000002159, 24, 5553}, {0x00000215a, 24, 459}, {0x00000215b, 24, 7601},
+	{0x00000215c, 24, 4472}, {0x00000215d, 24, 4472}, {0x00000215e, 24, 564}, {0x00000215f, 24, 7602},
+	{0x000002160, 24, 7603}, {0x000002161, 24, 7604}, {0x000002162, 24, 4496}, {0x000002163, 24, 7605},
+	{0x000002164, 24, 7606}, {0x000002165, 24, 7607}, {0x000002166, 24, 7608}, {0x000002167, 24, 7609},
+	{0x000002168, 24, 7610}, {0x000002169, 24, 7611}, {0x00000216a, 24, 4472}, {0x00000216b, 24, 4472},
+	{0x00000216c, 24, 7612}, {0x00000216d, 24, 7613}, {0x00000216e, 24, 7614}, {0x00000216f, 24, 7615},
+	{0x000002170, 24, 2822}, {0x000002171, 24, 7616}, {0x000002172, 24, 7617}, {0x000002173, 24, 7618},
+	{0x000002174, 24, 7619}, {0x000002175, 24, 7620}, {0x000002176, 24, 7621}, {0x000002177, 24, 7622},
+	{0x000002178, 24, 7623}, {0x000002179, 24, 7624}, {0x00000217a, 24, 7625}, {0x00000217b, 24, 7626},
+	{0x00000217c, 24, 7228}, {0x00000217d, 24, 7627}, {0x00000217e, 24, 7628}, {0x00000217f, 24, 7629},
+	{0x000002180, 24, 185}, {0x000002181, 24, 7630}, {0x000002182, 24, 7631}, {0x000002183, 24, 7632},
+	{0x000002184, 24, 7633}, {0x000002185, 24, 6791}, {0x000002186, 24, 7634}, {0x000002187, 24, 7635},
+	{0x000002188, 24, 142}, {0x000002189, 24, 7636}, {0x00000218a, 24, 7637}, {0x00000218b, 24, 7638},
+	{0x00000218c, 24, 7639}, {0x00000218d, 24, 7640}, {0x00000218e, 24, 7641}, {0x00000218f, 24, 7642},
+	{0x000002190, 24, 7643}, {0x000002191, 24, 3223}, {0x000002192, 24, 7644}, {0x000002193, 24, 7645},
+	{0x000002194, 24, 7646}, {0x000002195, 24, 7647}, {0x000002196, 24, 7648}, {0x000002197, 24, 7649},
+	{0x000002198, 24, 7650}, {0x000002199, 24, 7651}, {0x00000219a, 24, 7652}, {0x00000219b, 24, 2822},
+	{0x00000219c, 24, 7653}, {0x00000219d, 24, 7654}, {0x00000219e, 24, 5316}, {0x00000219f, 24, 7655},
+	{0x0000021a0, 24, 3}, {0x0000021a1, 24, 3}, {0x0000021a2, 24, 7656}, {0x0000021a3, 24, 7657},
+	{0x0000021a4, 24, 7658}, {0x0000021a5, 24, 7659}, {0x0000021a6, 24, 7660}, {0x0000021a7, 24, 7661},
+	{0x0000021a8, 24, 7662}, {0x0000021a9, 24, 7663}, {0x0000021aa, 24, 709}, {0x0000021ab, 24, 709},
+	{0x0000021ac, 24, 7664}, {0x0000021ad, 24, 7665}, {0x0000021ae, 24, 7666}, {0x0000021af, 24, 7667},
+	{0x0000021b0, 24, 7668}, {0x0000021b1, 24, 7669}, {0x0000021b2, 24, 7670}, {0x0000021b3, 24, 7671},
+	{0x0000021b4, 24, 7672}, {0x0000021b5, 24, 7673}, {0x0000021b6, 24, 7674}, {0x0000021b7, 24, 7675},
+	{0x0000021b8, 24, 7676}, {0x0000021b9, 24, 7677}, {0x0000021ba, 24, 4305}, {0x0000021bb, 24, 7678},
+	{0x0000021bc, 24, 7679}, {0x0000021bd, 24, 2314}, {0x0000021be, 24, 7680}, {0x0000021bf, 24, 7681},
+	{0x0000021c0, 24, 7682}, {0x0000021c1, 24, 7683}, {0x0000021c2, 24, 7684}, {0x0000021c3, 24, 7685},
+	{0x0000021c4, 24, 7686}, {0x0000021c5, 24, 7687}, {0x0000021c6, 24, 7688}, {0x0000021c7, 24, 7689},
+	{0x0000021c8, 24, 7690}, {0x0000021c9, 24, 7691}, {0x0000021ca, 24, 7692}, {0x0000021cb, 24, 7693},
+	{0x0000021cc, 24, 7694}, {0x0000021cd, 24, 7695}, {0x0000021ce, 24, 7696}, {0x0000021cf, 24, 7697},
+	{0x0000021d0, 24, 7698}, {0x0000021d1, 24, 1842}, {0x0000021d2, 24, 1842}, {0x0000021d3, 24, 7699},
+	{0x0000021d4, 24, 7700}, {0x0000021d5, 24, 7701}, {0x0000021d6, 24, 7702}, {0x0000021d7, 24, 3},
+	{0x0000021d8, 24, 3}, {0x0000021d9, 24, 7703}, {0x0000021da, 24, 7704}, {0x0000021db, 24, 7705},
+	{0x0000021dc, 24, 7706}, {0x0000021dd, 24, 7707}, {0x0000021de, 24, 7708}, {0x0000021df, 24, 7709},
+	{0x0000021e0, 24, 7710}, {0x0000021e1, 24, 108}, {0x0000021e2, 24, 7711}, {0x0000021e3, 24, 7712},
+	{0x0000021e4, 24, 7713}, {0x0000021e5, 24, 7714}, {0x0000021e6, 24, 7715}, {0x0000021e7, 24, 7716},
+	{0x0000021e8, 24, 3430}, {0x0000021e9, 24, 865}, {0x0000021ea, 24, 7717}, {0x0000021eb, 24, 7718},
+	{0x0000021ec, 24, 7719}, {0x0000021ed, 24, 7720}, {0x0000021ee, 24, 7721}, {0x0000021ef, 24, 7722},
+	{0x0000021f0, 24, 7723}, {0x0000021f1, 24, 7724}, {0x0000021f2, 24, 7725}, {0x0000021f3, 24, 7726},
+	{0x0000021f4, 24, 7727}, {0x0000021f5, 24, 7728}, {0x0000021f6, 24, 116}, {0x0000021f7, 24, 6490},
+	{0x0000021f8, 24, 7729}, {0x0000021f9, 24, 7730}, {0x0000021fa, 24, 7731}, {0x0000021fb, 24, 6967},
+	{0x0000021fc, 24, 709}, {0x0000021fd, 24, 7732}, {0x0000021fe, 24, 709}, {0x0000021ff, 24, 7733},
+	{0x000002200, 24, 564}, {0x000002201, 24, 7734}, {0x000002202, 24, 7735}, {0x000002203, 24, 7736},
+	{0x000002204, 24, 7737}, {0x000002205, 24, 7738}, {0x000002206, 24, 7739}, {0x000002207, 24, 7740},
+	{0x000002208, 24, 7741}, {0x000002209, 24, 7742}, {0x00000220a, 24, 7743}, {0x00000220b, 24, 7744},
+	{0x00000220c, 24, 3}, {0x00000220d, 24, 3}, {0x00000220e, 24, 7745}, {0x00000220f, 24, 7746},
+	{0x000002210, 24, 185}, {0x000002211, 24, 7747}, {0x000002212, 24, 7748}, {0x000002213, 24, 7749},
+	{0x000002214, 24, 7750}, {0x000002215, 24, 4673}, {0x000002216, 24, 7751}, {0x000002217, 24, 7752},
+	{0x000002218, 24, 7753}, {0x000002219, 24, 2822}, {0x00000221a, 24, 7754}, {0x00000221b, 24, 7755},
+	{0x00000221c, 24, 99}, {0x00000221d, 24, 7756}, {0x00000221e, 24, 7757}, {0x00000221f, 24, 7758},
+	{0x000002220, 24, 7759}, {0x000002221, 24, 7760}, {0x000002222, 24, 7761}, {0x000002223, 24, 7762},
+	{0x000002224, 24, 7763}, {0x000002225, 24, 7764}, {0x000002226, 24, 7765}, {0x000002227, 24, 7766},
+	{0x000002228, 24, 7767}, {0x000002229, 24, 7768}, {0x00000222a, 24, 7769}, {0x00000222b, 24, 7770},
+	{0x00000222c, 24, 7771}, {0x00000222d, 24, 7772}, {0x00000222e, 24, 7773}, {0x00000222f, 24, 7774},
+	{0x000002230, 24, 7775}, {0x000002231, 24, 7776}, {0x000002232, 24, 7777}, {0x000002233, 24, 1957},
+	{0x000002234, 24, 7778}, {0x000002235, 24, 7779}, {0x000002236, 24, 7780}, {0x000002237, 24, 7781},
+	{0x000002238, 24, 7782}, {0x000002239, 24, 7783}, {0x00000223a, 24, 7784}, {0x00000223b, 24, 7785},
+	{0x00000223c, 24, 7786}, {0x00000223d, 24, 7787}, {0x00000223e, 24, 7788}, {0x00000223f, 24, 4809},
+	{0x000002240, 24, 7789}, {0x000002241, 24, 865}, {0x000002242, 24, 7790}, {0x000002243, 24, 5090},
+	{0x000002244, 24, 7791}, {0x000002245, 24, 7792}, {0x000002246, 24, 7793}, {0x000002247, 24, 7794},
+	{0x000002248, 24, 965}, {0x000002249, 24, 7795}, {0x00000224a, 24, 7796}, {0x00000224b, 24, 7797},
+	{0x00000224c, 24, 2314}, {0x00000224d, 24, 7798}, {0x00000224e, 24, 7799}, {0x00000224f, 24, 7800},
+	{0x000002250, 24, 7801}, {0x000002251, 24, 7802}, {0x000002252, 24, 7803}, {0x000002253, 24, 7804},
+	{0x000002254, 24, 7805}, {0x000002255, 24, 3}, {0x000002256, 24, 3}, {0x000002257, 24, 7806},
+	{0x000002258, 24, 840}, {0x000002259, 24, 7807}, {0x00000225a, 24, 7808}, {0x00000225b, 24, 7809},
+	{0x00000225c, 24, 7810}, {0x00000225d, 24, 7811}, {0x00000225e, 24, 7812}, {0x00000225f, 24, 7813},
+	{0x000002260, 24, 7814}, {0x000002261, 24, 7815}, {0x000002262, 24, 7816}, {0x000002263, 24, 7817},
+	{0x000002264, 24, 459}, {0x000002265, 24, 709}, {0x000002266, 24, 709}, {0x000002267, 24, 108},
+	{0x000002268, 24, 4861}, {0x000002269, 24, 4861}, {0x00000226a, 24, 7818}, {0x00000226b, 24, 4275},
+	{0x00000226c, 24, 7819}, {0x00000226d, 24, 7820}, {0x00000226e, 24, 7821}, {0x00000226f, 24, 7822},
+	{0x000002270, 24, 7823}, {0x000002271, 24, 7824}, {0x000002272, 24, 7825}, {0x000002273, 24, 7826},
+	{0x000002274, 24, 7827}, {0x000002275, 24, 6645}, {0x000002276, 24, 7828}, {0x000002277, 24, 7829},
+	{0x000002278, 24, 7830}, {0x000002279, 24, 7831}, {0x00000227a, 24, 1737}, {0x00000227b, 24, 7832},
+	{0x00000227c, 24, 7833}, {0x00000227d, 24, 7834}, {0x00000227e, 24, 7835}, {0x00000227f, 24, 4614},
+	{0x000002280, 24, 7836}, {0x000002281, 24, 7837}, {0x000002282, 24, 7838}, {0x000002283, 24, 5553},
+	{0x000002284, 24, 7839}, {0x000002285, 24, 7840}, {0x000002286, 24, 7841}, {0x000002287, 24, 7842},
+	{0x000002288, 24, 7843}, {0x000002289, 24, 7844}, {0x00000228a, 24, 7845}, {0x00000228b, 24, 7846},
+	{0x00000228c, 24, 7847}, {0x00000228d, 24, 7848}, {0x00000228e, 24, 7849}, {0x00000228f, 24, 7850},
+	{0x000002290, 24, 3}, {0x000002291, 24, 3}, {0x000002292, 24, 7851}, {0x000002293, 24, 5972},
+	{0x000002294, 24, 7852}, {0x000002295, 24, 7853}, {0x000002296, 24, 7854}, {0x000002297, 24, 7855},
+	{0x000002298, 24, 5316}, {0x000002299, 24, 7597}, {0x00000229a, 24, 7856}, {0x00000229b, 24, 7857},
+	{0x00000229c, 24, 7858}, {0x00000229d, 24, 7859}, {0x00000229e, 24, 7860}, {0x00000229f, 24, 7861},
+	{0x0000022a0, 24, 7862}, {0x0000022a1, 24, 7863}, {0x0000022a2, 24, 7864}, {0x0000022a3, 24, 7865},
+	{0x0000022a4, 24, 7228}, {0x0000022a5, 24, 4305}, {0x0000022a6, 24, 7866}, {0x0000022a7, 24, 7867},
+	{0x0000022a8, 24, 7868}, {0x0000022a9, 24, 6537}, {0x0000022aa, 24, 2314}, {0x0000022ab, 24, 7869},
+	{0x0000022ac, 24, 7870}, {0x0000022ad, 24, 7871}, {0x0000022ae, 24, 7872}, {0x0000022af, 24, 7873},
+	{0x0000022b0, 24, 3223}, {0x0000022b1, 24, 7874}, {0x0000022b2, 24, 7875}, {0x0000022b3, 24, 7876},
+	{0x0000022b4, 24, 185}, {0x0000022b5, 24, 7877}, {0x0000022b6, 24, 7878}, {0x0000022b7, 24, 7879},
+	{0x0000022b8, 24, 7880}, {0x0000022b9, 24, 7881}, {0x0000022ba, 24, 7882}, {0x0000022bb, 24, 7883},
+	{0x0000022bc, 24, 7884}, {0x0000022bd, 24, 3}, {0x0000022be, 24, 3}, {0x0000022bf, 24, 7885},
+	{0x0000022c0, 24, 7886}, {0x0000022c1, 24, 7887}, {0x0000022c2, 24, 7888}, {0x0000022c3, 24, 7889},
+	{0x0000022c4, 24, 7890}, {0x0000022c5, 24, 7891}, {0x0000022c6, 24, 7892}, {0x0000022c7, 24, 7893},
+	{0x0000022c8, 24, 7894}, {0x0000022c9, 24, 7895}, {0x0000022ca, 24, 7896}, {0x0000022cb, 24, 7897},
+	{0x0000022cc, 24, 7898}, {0x0000022cd, 24, 7899}, {0x0000022ce, 24, 7680}, {0x0000022cf, 24, 7900},
+	{0x0000022d0, 24, 7901}, {0x0000022d1, 24, 7902}, {0x0000022d2, 24, 7903}, {0x0000022d3, 24, 7904},
+	{0x0000022d4, 24, 7905}, {0x0000022d5, 24, 7906}, {0x0000022d6, 24, 7907}, {0x0000022d7, 24, 2314},
+	{0x0000022d8, 24, 7908}, {0x0000022d9, 24, 7909}, {0x0000022da, 24, 7910}, {0x0000022db, 24, 7911},
+	{0x0000022dc, 24, 7912}, {0x0000022dd, 24, 7913}, {0x0000022de, 24, 7914}, {0x0000022df, 24, 7915},
+	{0x0000022e0, 24, 7916}, {0x0000022e1, 24, 7917}, {0x0000022e2, 24, 7918}, {0x0000022e3, 24, 7919},
+	{0x0000022e4, 24, 7920}, {0x0000022e5, 24, 7921}, {0x0000022e6, 24, 7922}, {0x0000022e7, 24, 7923},
+	{0x0000022e8, 24, 7924}, {0x0000022e9, 24, 7925}, {0x0000022ea, 24, 7926}, {0x0000022eb, 24, 7927},
+	{0x0000022ec, 24, 7928}, {0x0000022ed, 24, 7929}, {0x0000022ee, 24, 7930}, {0x0000022ef, 24, 7931},
+	{0x0000022f0, 24, 7932}, {0x0000022f1, 24, 99}, {0x0000022f2, 24, 7933}, {0x0000022f3, 24, 6658},
+	{0x0000022f4, 24, 7934}, {0x0000022f5, 24, 7935}, {0x0000022f6, 24, 7936}, {0x0000022f7, 24, 7937},
+	{0x0000022f8, 24, 7938}, {0x0000022f9, 24, 7939}, {0x0000022fa, 24, 4472}, {0x0000022fb, 24, 4472},
+	{0x0000022fc, 24, 709}, {0x0000022fd, 24, 709}, {0x0000022fe, 24, 7940}, {0x0000022ff, 24, 7931},
+	{0x000002300, 24, 7941}, {0x000002301, 24, 7942}, {0x000002302, 24, 7943}, {0x000002303, 24, 7944},
+	{0x000002304, 24, 3}, {0x000002305, 24, 3}, {0x000002306, 24, 7593}, {0x000002307, 24, 7945},
+	{0x000002308, 24, 4428}, {0x000002309, 24, 7946}, {0x00000230a, 24, 7947}, {0x00000230b, 24, 185},
+	{0x00000230c, 24, 7948}, {0x00000230d, 24, 108}, {0x00000230e, 24, 7949}, {0x00000230f, 24, 7950},
+	{0x000002310, 24, 7951}, {0x000002311, 24, 7952}, {0x000002312, 24, 865}, {0x000002313, 24, 7953},
+	{0x000002314, 24, 4472}, {0x000002315, 24, 4472}, {0x000002316, 24, 7954}, {0x000002317, 24, 7955},
+	{0x000002318, 24, 1934}, {0x000002319, 24, 7956}, {0x00000231a, 24, 7957}, {0x00000231b, 24, 7958},
+	{0x00000231c, 24, 7959}, {0x00000231d, 24, 7960}, {0x00000231e, 24, 7961}, {0x00000231f, 24, 7962},
+	{0x000002320, 24, 7963}, {0x000002321, 24, 7964}, {0x000002322, 24, 7965}, {0x000002323, 24, 7966},
+	{0x000002324, 24, 3795}, {0x000002325, 24, 7967}, {0x000002326, 24, 7968}, {0x000002327, 24, 7969},
+	{0x000002328, 24, 7970}, {0x000002329, 24, 7971}, {0x00000232a, 24, 7972}, {0x00000232b, 24, 7973},
+	{0x00000232c, 24, 7974}, {0x00000232d, 24, 7975}, {0x00000232e, 24, 7976}, {0x00000232f, 24, 7977},
+	{0x000002330, 24, 7978}, {0x000002331, 24, 2314}, {0x000002332, 24, 865}, {0x000002333, 24, 3},
+	{0x000002334, 24, 3}, {0x000002335, 24, 7979}, {0x000002336, 24, 7980}, {0x000002337, 24, 7981},
+	{0x000002338, 24, 7982}, {0x000002339, 24, 4482}, {0x00000233a, 24, 1842}, {0x00000233b, 24, 7983},
+	{0x00000233c, 24, 7984}, {0x00000233d, 24, 7985}, {0x00000233e, 24, 7986}, {0x00000233f, 24, 7987},
+	{0x000002340, 24, 7988}, {0x000002341, 24, 1420}, {0x000002342, 24, 7989}, {0x000002343, 24, 7990},
+	{0x000002344, 24, 7991}, {0x000002345, 24, 5316}, {0x000002346, 24, 7992}, {0x000002347, 24, 6289},
+	{0x000002348, 24, 5017}, {0x000002349, 24, 7993}, {0x00000234a, 24, 99}, {0x00000234b, 24, 7994},
+	{0x00000234c, 24, 7995}, {0x00000234d, 24, 4861}, {0x00000234e, 24, 4861}, {0x00000234f, 24, 7996},
+	{0x000002350, 24, 7997}, {0x000002351, 24, 7228}, {0x000002352, 24, 7998}, {0x000002353, 24, 7999},
+	{0x000002354, 24, 4673}, {0x000002355, 24, 8000}, {0x000002356, 24, 8001}, {0x000002357, 24, 8002},
+	{0x000002358, 24, 8003}, {0x000002359, 24, 8004}, {0x00000235a, 24, 8005}, {0x00000235b, 24, 8006},
+	{0x00000235c, 24, 8007}, {0x00000235d, 24, 3}, {0x00000235e, 24, 3}, {0x00000235f, 24, 8008},
+	{0x000002360, 24, 8009}, {0x000002361, 24, 8010}, {0x000002362, 24, 8011}, {0x000002363, 24, 8012},
+	{0x000002364, 24, 8013}, {0x000002365, 24, 8014}, {0x000002366, 24, 8015}, {0x000002367, 24, 8016},
+	{0x000002368, 24, 1217}, {0x000002369, 24, 4275}, {0x00000236a, 24, 8017}, {0x00000236b, 24, 8018},
+	{0x00000236c, 24, 865}, {0x00000236d, 24, 8019}, {0x00000236e, 24, 8020}, {0x00000236f, 24, 8021},
+	{0x000002370, 24, 1315}, {0x000002371, 24, 8022}, {0x000002372, 24, 8023}, {0x000002373, 24, 8024},
+	{0x000002374, 24, 185}, {0x000002375, 24, 185}, {0x000002376, 24, 2176}, {0x000002377, 24, 8025},
+	{0x000002378, 24, 8026}, {0x000002379, 24, 8027}, {0x00000237a, 24, 8028}, {0x00000237b, 24, 8029},
+	{0x00000237c, 24, 8030}, {0x00000237d, 24, 459}, {0x00000237e, 24, 8031}, {0x00000237f, 24, 5910},
+	{0x000002380, 24, 8032}, {0x000002381, 24, 8033}, {0x000002382, 24, 8034}, {0x000002383, 24, 8035},
+	{0x000002384, 24, 8036}, {0x000002385, 24, 8037}, {0x000002386, 24, 8038}, {0x000002387, 24, 8039},
+	{0x000002388, 24, 8040}, {0x000002389, 24, 8041}, {0x00000238a, 24, 8042}, {0x00000238b, 24, 5203},
+	{0x00000238c, 24, 99}, {0x00000238d, 24, 8043}, {0x00000238e, 24, 8044}, {0x00000238f, 24, 8045},
+	{0x000002390, 24, 8046}, {0x000002391, 24, 8047}, {0x000002392, 24, 8048}, {0x000002393, 24, 8049},
+	{0x000002394, 24, 8050}, {0x000002395, 24, 185}, {0x000002396, 24, 8051}, {0x000002397, 24, 8052},
+	{0x000002398, 24, 8053}, {0x000002399, 24, 8054}, {0x00000239a, 24, 8055}, {0x00000239b, 24, 8056},
+	{0x00000239c, 24, 5553}, {0x00000239d, 24, 8057}, {0x00000239e, 24, 8058}, {0x00000239f, 24, 8059},
+	{0x0000023a0, 24, 8060}, {0x0000023a1, 24, 8061}, {0x0000023a2, 24, 185}, {0x0000023a3, 24, 185},
+	{0x0000023a4, 24, 8062}, {0x0000023a5, 24, 8063}, {0x0000023a6, 24, 8064}, {0x0000023a7, 24, 8065},
+	{0x0000023a8, 24, 8066}, {0x0000023a9, 24, 8067}, {0x0000023aa, 24, 8068}, {0x0000023ab, 24, 3},
+	{0x0000023ac, 24, 3}, {0x0000023ad, 24, 8069}, {0x0000023ae, 24, 5963}, {0x0000023af, 24, 185},
+	{0x0000023b0, 24, 8070}, {0x0000023b1, 24, 8071}, {0x0000023b2, 24, 8072}, {0x0000023b3, 24, 8073},
+	{0x0000023b4, 24, 709}, {0x0000023b5, 24, 8074}, {0x0000023b6, 24, 8075}, {0x0000023b7, 24, 8076},
+	{0x0000023b8, 24, 8077}, {0x0000023b9, 24, 8078}, {0x0000023ba, 24, 8079}, {0x0000023bb, 24, 8080},
+	{0x0000023bc, 24, 8081}, {0x0000023bd, 24, 8082}, {0x0000023be, 24, 6959}, {0x0000023bf, 24, 8083},
+	{0x0000023c0, 24, 8084}, {0x0000023c1, 24, 8085}, {0x0000023c2, 24, 8086}, {0x0000023c3, 24, 8087},
+	{0x0000023c4, 24, 8088}, {0x0000023c5, 24, 8089}, {0x0000023c6, 24, 8090}, {0x0000023c7, 24, 8091},
+	{0x0000023c8, 24, 8092}, {0x0000023c9, 24, 8093}, {0x0000023ca, 24, 8094}, {0x0000023cb, 24, 8095},
+	{0x0000023cc, 24, 2314}, {0x0000023cd, 24, 8096}, {0x0000023ce, 24, 8097}, {0x0000023cf, 24, 8098},
+	{0x0000023d0, 24, 8099}, {0x0000023d1, 24, 8100}, {0x0000023d2, 24, 8101}, {0x0000023d3, 24, 8102},
+	{0x0000023d4, 24, 4305}, {0x0000023d5, 24, 8103}, {0x0000023d6, 24, 8104}, {0x0000023d7, 24, 4482},
+	{0x0000023d8, 24, 8105}, {0x0000023d9, 24, 8106}, {0x0000023da, 24, 8107}, {0x0000023db, 24, 8108},
+	{0x0000023dc, 24, 8109}, {0x0000023dd, 24, 8110}, {0x0000023de, 24, 8111}, {0x0000023df, 24, 865},
+	{0x0000023e0, 24, 8112}, {0x0000023e1, 24, 8113}, {0x0000023e2, 24, 8114}, {0x0000023e3, 24, 8115},
+	{0x0000023e4, 24, 8116}, {0x0000023e5, 24, 8117}, {0x0000023e6, 24, 8118}, {0x0000023e7, 24, 8119},
+	{0x0000023e8, 24, 8120}, {0x0000023e9, 24, 444}, {0x0000023ea, 24, 3}, {0x0000023eb, 24, 3},
+	{0x0000023ec, 24, 8121}, {0x0000023ed, 24, 185}, {0x0000023ee, 24, 185}, {0x0000023ef, 24, 8122},
+	{0x0000023f0, 24, 8123}, {0x0000023f1, 24, 5316}, {0x0000023f2, 24, 8124}, {0x0000023f3, 24, 8125},
+	{0x0000023f4, 24, 8126}, {0x0000023f5, 24, 8127}, {0x0000023f6, 24, 8128}, {0x0000023f7, 24, 99},
+	{0x0000023f8, 24, 4551}, {0x0000023f9, 24, 8129}, {0x0000023fa, 24, 8130}, {0x0000023fb, 24, 8131},
+	{0x0000023fc, 24, 8132}, {0x0000023fd, 24, 8133}, {0x0000023fe, 24, 8134}, {0x0000023ff, 24, 8135},
+	{0x000002400, 24, 108}, {0x000002401, 24, 3223}, {0x000002402, 24, 8136}, {0x000002403, 24, 709},
+	{0x000002404, 24, 709}, {0x000002405, 24, 8137}, {0x000002406, 24, 8138}, {0x000002407, 24, 8139},
+	{0x000002408, 24, 8140}, {0x000002409, 24, 8141}, {0x00000240a, 24, 8142}, {0x00000240b, 24, 8143},
+	{0x00000240c, 24, 8144}, {0x00000240d, 24, 8145}, {0x00000240e, 24, 8146}, {0x00000240f, 24, 8147},
+	{0x000002410, 24, 8148}, {0x000002411, 24, 8149}, {0x000002412, 24, 8150}, {0x000002413, 24, 3},
+	{0x000002414, 24, 3}, {0x000002415, 24, 8151}, {0x000002416, 24, 8152}, {0x000002417, 24, 3404},
+	{0x000002418, 24, 8153}, {0x000002419, 24, 99}, {0x00000241a, 24, 8154}, {0x00000241b, 24, 8155},
+	{0x00000241c, 24, 8156}, {0x00000241d, 24, 5352}, {0x00000241e, 24, 2314}, {0x00000241f, 24, 8157},
+	{0x000002420, 24, 8158}, {0x000002421, 24, 8159}, {0x000002422, 24, 8160}, {0x000002423, 24, 8161},
+	{0x000002424, 24, 8162}, {0x000002425, 24, 8163}, {0x000002426, 24, 8164}, {0x000002427, 24, 8165},
+	{0x000002428, 24, 8166}, {0x000002429, 24, 8167}, {0x00000242a, 24, 8168}, {0x00000242b, 24, 6842},
+	{0x00000242c, 24, 4861}, {0x00000242e, 24, 8169}, {0x00000242f, 24, 8170}, {0x000002430, 24, 8171},
+	{0x000002431, 24, 8172}, {0x000002432, 24, 8173}, {0x000002433, 24, 5373}, {0x000002434, 24, 8174},
+	{0x000002435, 24, 8175}, {0x000002436, 24, 865}, {0x000002437, 24, 8176}, {0x000002438, 24, 1085},
+	{0x000002439, 24, 8177}, {0x00000243a, 24, 8178}, {0x00000243b, 24, 8179}, {0x00000243c, 24, 8180},
+	{0x00000243d, 24, 8181}, {0x00000243f, 24, 8182}, {0x000002440, 24, 8183}, {0x000002441, 24, 8184},
+	{0x000002442, 24, 8185}, {0x000002443, 24, 108}, {0x000002444, 24, 2314}, {0x000002445, 24, 8186},
+	{0x000002446, 24, 8187}, {0x000002447, 24, 8188}, {0x000002448, 24, 8189}, {0x000002449, 24, 8190},
+	{0x00000244a, 24, 8191}, {0x00000244b, 24, 8192}, {0x00000244c, 24, 8193}, {0x00000244d, 24, 8194},
+	{0x00000244e, 24, 8195}, {0x00000244f, 24, 8196}, {0x000002450, 24, 3}, {0x000002451, 24, 3},
+	{0x000002452, 24, 8197}, {0x000002453, 24, 8198}, {0x000002454, 24, 4590}, {0x000002455, 24, 8199},
+	{0x000002456, 24, 7228}, {0x000002458, 24, 8200}, {0x000002459, 24, 3068}, {0x00000245a, 24, 8201},
+	{0x00000245b, 24, 8202}, {0x00000245c, 24, 8203}, {0x00000245d, 24, 8204}, {0x00000245e, 24, 8205},
+	{0x00000245f, 24, 8206}, {0x000002460, 24, 8207}, {0x000002461, 24, 8208}, {0x000002462, 24, 8209},
+	{0x000002463, 24, 8210}, {0x000002464, 24, 8211}, {0x000002465, 24, 8212}, {0x000002466, 24, 8213},
+	{0x000002467, 24, 8214}, {0x000002468, 24, 8215}, {0x000002469, 24, 8216}, {0x00000246a, 24, 8217},
+	{0x00000246b, 24, 8218}, {0x00000246c, 24, 8219}, {0x00000246d, 24, 8220}, {0x00000246e, 24, 8221},
+	{0x00000246f, 24, 8222}, {0x000002470, 24, 8223}, {0x000002471, 24, 8224}, {0x000002472, 24, 8225},
+	{0x000002473, 24, 7806}, {0x000002474, 24, 8226}, {0x000002475, 24, 8227}, {0x000002476, 24, 8228},
+	{0x000002477, 24, 8229}, {0x000002478, 24, 8230}, {0x000002479, 24, 8231}, {0x00000247a, 24, 8232},
+	{0x00000247b, 24, 3726}, {0x00000247c, 24, 709}, {0x00000247d, 24, 709}, {0x00000247e, 24, 7634},
+	{0x00000247f, 24, 108}, {0x000002480, 24, 8233}, {0x000002481, 24, 459}, {0x000002482, 24, 4614},
+	{0x000002483, 24, 6967}, {0x000002484, 24, 8234}, {0x000002485, 24, 8235}, {0x000002486, 24, 8236},
+	{0x000002487, 24, 8237}, {0x000002488, 24, 8238}, {0x000002489, 24, 8239}, {0x00000248a, 24, 8240},
+	{0x00000248b, 24, 8241}, {0x00000248c, 24, 4673}, {0x00000248d, 24, 7216}, {0x00000248e, 24, 8242},
+	{0x00000248f, 24, 8243}, {0x000002490, 24, 8104}, {0x000002491, 24, 4482}, {0x000002492, 24, 8244},
+	{0x000002493, 24, 185}, {0x000002494, 24, 8245}, {0x000002495, 24, 185}, {0x000002496, 24, 8246},
+	{0x000002497, 24, 3}, {0x000002498, 24, 3}, {0x000002499, 24, 8247}, {0x00000249a, 24, 8248},
+	{0x00000249b, 24, 8249}, {0x00000249c, 24, 8250}, {0x00000249d, 24, 8251}, {0x00000249e, 24, 8252},
+	{0x00000249f, 24, 7533}, {0x0000024a0, 24, 185}, {0x0000024a1, 24, 185}, {0x0000024a2, 24, 8253},
+	{0x0000024a3, 24, 8254}, {0x0000024a4, 24, 8255}, {0x0000024a5, 24, 1741}, {0x0000024a6, 24, 8256},
+	{0x0000024a7, 24, 8257}, {0x0000024a8, 24, 6289}, {0x0000024a9, 24, 8258}, {0x0000024aa, 24, 8259},
+	{0x0000024ab, 24, 8260}, {0x0000024ac, 24, 8261}, {0x0000024ad, 24, 8262}, {0x0000024ae, 24, 8263},
+	{0x0000024af, 24, 8264}, {0x0000024b0, 24, 8265}, {0x0000024b1, 24, 8266}, {0x0000024b2, 24, 8267},
+	{0x0000024b3, 24, 8268}, {0x0000024b4, 24, 8269}, {0x0000024b5, 24, 108}, {0x0000024b6, 24, 1157},
+	{0x0000024b7, 24, 8270}, {0x0000024b8, 24, 8271}, {0x0000024b9, 24, 8272}, {0x0000024ba, 24, 4305},
+	{0x0000024bb, 24, 8273}, {0x0000024bc, 24, 8274}, {0x0000024bd, 24, 8275}, {0x0000024be, 24, 311},
+	{0x0000024bf, 24, 8276}, {0x0000024c0, 24, 8277}, {0x0000024c1, 24, 185}, {0x0000024c2, 24, 8278},
+	{0x0000024c3, 24, 3}, {0x0000024c4, 24, 3}, {0x0000024c5, 24, 8279}, {0x0000024c6, 24, 8280},
+	{0x0000024c7, 24, 8281}, {0x0000024c8, 24, 8282}, {0x0000024c9, 24, 8282}, {0x0000024ca, 24, 8283},
+	{0x0000024cb, 24, 8284}, {0x0000024cc, 24, 8285}, {0x0000024cd, 24, 8286}, {0x0000024ce, 24, 8287},
+	{0x0000024cf, 24, 8288}, {0x0000024d0, 24, 8289}, {0x0000024d1, 24, 3930}, {0x0000024d2, 24, 8290},
+	{0x0000024d3, 24, 8291}, {0x0000024d4, 24, 8292}, {0x0000024d5, 24, 8293}, {0x0000024d6, 24, 4472},
+	{0x0000024d7, 24, 4472}, {0x0000024d8, 24, 8294}, {0x0000024d9, 24, 8295}, {0x0000024da, 24, 8296},
+	{0x0000024db, 24, 8297}, {0x0000024dc, 24, 5553}, {0x0000024dd, 24, 8298}, {0x0000024de, 24, 8299},
+	{0x0000024df, 24, 8300}, {0x0000024e0, 24, 8301}, {0x0000024e1, 24, 8302}, {0x0000024e2, 24, 8303},
+	{0x0000024e3, 24, 8304}, {0x0000024e4, 24, 8305}, {0x0000024e5, 24, 8306}, {0x0000024e6, 24, 8307},
+	{0x0000024e7, 24, 8308}, {0x0000024e8, 24, 5963}, {0x0000024e9, 24, 8309}, {0x0000024ea, 24, 8310},
+	{0x0000024eb, 24, 8311}, {0x0000024ec, 24, 8312}, {0x0000024ed, 24, 8313}, {0x0000024ee, 24, 8314},
+	{0x0000024ef, 24, 5316}, {0x0000024f0, 24, 8315}, {0x0000024f1, 24, 8316}, {0x0000024f2, 24, 8317},
+	{0x0000024f3, 24, 2314}, {0x0000024f4, 24, 8318}, {0x0000024f5, 24, 8319}, {0x0000024f6, 24, 8320},
+	{0x0000024f7, 24, 3}, {0x0000024f8, 24, 8321}, {0x0000024f9, 24, 3}, {0x0000024fa, 24, 8322},
+	{0x0000024fb, 24, 99}, {0x0000024fc, 24, 8323}, {0x0000024fd, 24, 8324}, {0x0000024fe, 24, 980},
+	{0x0000024ff, 24, 3360}, {0x000002500, 24, 865}, {0x000002501, 24, 8325}, {0x000002502, 24, 8326},
+	{0x000002503, 24, 564}, {0x000002504, 24, 8327}, {0x000002505, 24, 8328}, {0x000002506, 24, 8329},
+	{0x000002507, 24, 8330}, {0x000002508, 24, 8331}, {0x000002509, 24, 8332}, {0x00000250a, 24, 8333},
+	{0x00000250b, 24, 8334}, {0x00000250c, 24, 8335}, {0x00000250d, 24, 8336}, {0x00000250e, 24, 8337},
+	{0x00000250f, 24, 8338}, {0x000002510, 24, 8339}, {0x000002511, 24, 8340}, {0x000002512, 24, 5972},
+	{0x000002513, 24, 8341}, {0x000002514, 24, 8342}, {0x000002515, 24, 5421}, {0x000002516, 24, 8343},
+	{0x000002517, 24, 8344}, {0x000002518, 24, 8345}, {0x000002519, 24, 8346}, {0x00000251a, 24, 8347},
+	{0x00000251b, 24, 8348}, {0x00000251c, 24, 8349}, {0x00000251d, 24, 8350}, {0x00000251e, 24, 8351},
+	{0x00000251f, 24, 8352}, {0x000002520, 24, 8353}, {0x000002521, 24, 8354}, {0x000002522, 24, 8355},
+	{0x000002523, 24, 8356}, {0x000002524, 24, 8357}, {0x000002525, 24, 8358}, {0x000002526, 24, 8359},
+	{0x000002527, 24, 8360}, {0x000002528, 24, 8361}, {0x000002529, 24, 8362}, {0x00000252a, 24, 8363},
+	{0x00000252b, 24, 8364}, {0x00000252c, 24, 8365}, {0x00000252d, 24, 8366}, {0x00000252e, 24, 6959},
+	{0x00000252f, 24, 8367}, {0x000002530, 24, 8368}, {0x000002531, 24, 8369}, {0x000002532, 24, 8370},
+	{0x000002533, 24, 8371}, {0x000002535, 24, 8372}, {0x000002536, 24, 8373}, {0x000002537, 24, 8374},
+	{0x000002538, 24, 8375}, {0x000002539, 24, 8376}, {0x00000253a, 24, 8377}, {0x00000253b, 24, 8378},
+	{0x00000253c, 24, 7228}, {0x00000253d, 24, 8379}, {0x00000253e, 24, 8380}, {0x000002540, 24, 8381},
+	{0x000002541, 24, 8382}, {0x000002542, 24, 8383}, {0x000002543, 24, 8384}, {0x000002544, 24, 8385},
+	{0x000002545, 24, 3}, {0x000002546, 24, 3}, {0x000002547, 24, 709}, {0x000002548, 24, 709},
+	{0x000002549, 24, 8386}, {0x00000254a, 24, 8387}, {0x00000254b, 24, 865}, {0x00000254c, 24, 8388},
+	{0x00000254d, 24, 8389}, {0x00000254e, 24, 8390}, {0x00000254f, 24, 8391}, {0x000002550, 24, 8392},
+	{0x000002551, 24, 8393}, {0x000002552, 24, 8394}, {0x000002553, 24, 8044}, {0x000002554, 24, 8395},
+	{0x000002555, 24, 8396}, {0x000002556, 24, 4861}, {0x000002557, 24, 8397}, {0x000002558, 24, 8398},
+	{0x000002559, 24, 8399}, {0x00000255a, 24, 8400}, {0x00000255b, 24, 8401}, {0x00000255c, 24, 5288},
+	{0x00000255d, 24, 8402}, {0x00000255e, 24, 8403}, {0x00000255f, 24, 8404}, {0x000002560, 24, 8405},
+	{0x000002561, 24, 6289}, {0x000002562, 24, 8406}, {0x000002563, 24, 8407}, {0x000002564, 24, 5963},
+	{0x000002565, 24, 8408}, {0x000002566, 24, 1842}, {0x000002567, 24, 4482}, {0x000002568, 24, 8409},
+	{0x000002569, 24, 5017}, {0x00000256a, 24, 8410}, {0x00000256b, 24, 8411}, {0x00000256c, 24, 8412},
+	{0x00000256d, 24, 8413}, {0x00000256e, 24, 8414}, {0x00000256f, 24, 8415}, {0x000002570, 24, 8416},
+	{0x000002571, 24, 8417}, {0x000002572, 24, 8418}, {0x000002573, 24, 8419}, {0x000002574, 24, 8420},
+	{0x000002575, 24, 8421}, {0x000002576, 24, 8422}, {0x000002577, 24, 8423}, {0x000002578, 24, 8424},
+	{0x000002579, 24, 8425}, {0x00000257a, 24, 8426}, {0x00000257b, 24, 8427}, {0x00000257c, 24, 8428},
+	{0x00000257d, 24, 8429}, {0x00000257e, 24, 8430}, {0x00000257f, 24, 8431}, {0x000002580, 24, 8432},
+	{0x000002581, 24, 8433}, {0x000002582, 24, 8434}, {0x000002583, 24, 3}, {0x000002584, 24, 3},
+	{0x000002585, 24, 8435}, {0x000002586, 24, 5993}, {0x000002587, 24, 8436}, {0x000002588, 24, 8437},
+	{0x000002589, 24, 8438}, {0x00000258a, 24, 8439}, {0x00000258b, 24, 8440}, {0x00000258c, 24, 8441},
+	{0x00000258d, 24, 8442}, {0x00000258e, 24, 8443}, {0x00000258f, 24, 8444}, {0x000002590, 24, 8445},
+	{0x000002591, 24, 8446}, {0x000002592, 24, 8447}, {0x000002593, 24, 8448}, {0x000002594, 24, 8449},
+	{0x000002595, 24, 8450}, {0x000002596, 24, 8451}, {0x000002597, 24, 8452}, {0x000002598, 24, 8453},
+	{0x000002599, 24, 8454}, {0x00000259a, 24, 8455}, {0x00000259b, 24, 8456}, {0x00000259c, 24, 4275},
+	{0x00000259d, 24, 99}, {0x00000259e, 24, 5692}, {0x00000259f, 24, 8457}, {0x0000025a0, 24, 2314},
+	{0x0000025a1, 24, 8458}, {0x0000025a2, 24, 8459}, {0x0000025a3, 24, 8460}, {0x0000025a4, 24, 8461},
+	{0x0000025a5, 24, 8462}, {0x0000025a6, 24, 8463}, {0x0000025a7, 24, 8464}, {0x0000025a8, 24, 8465},
+	{0x0000025a9, 24, 8466}, {0x0000025aa, 24, 8467}, {0x0000025ab, 24, 8468}, {0x0000025ac, 24, 8469},
+	{0x0000025ad, 24, 8470}, {0x0000025ae, 24, 965}, {0x0000025af, 24, 8471}, {0x0000025b0, 24, 8472},
+	{0x0000025b1, 24, 8473}, {0x0000025b2, 24, 8474}, {0x0000025b3, 24, 459}, {0x0000025b4, 24, 3},
+	{0x0000025b5, 24, 3}, {0x0000025b6, 24, 8475}, {0x0000025b7, 24, 8476}, {0x0000025b8, 24, 8477},
+	{0x0000025b9, 24, 8478}, {0x0000025ba, 24, 6579}, {0x0000025bb, 24, 8479}, {0x0000025bc, 24, 865},
+	{0x0000025bd, 24, 8480}, {0x0000025be, 24, 8481}, {0x0000025bf, 24, 8482}, {0x0000025c0, 24, 8483},
+	{0x0000025c1, 24, 8484}, {0x0000025c2, 24, 8485}, {0x0000025c3, 24, 108}, {0x0000025c4, 24, 4614},
+	{0x0000025c5, 24, 8486}, {0x0000025c6, 24, 8487}, {0x0000025c7, 24, 8488}, {0x0000025c8, 24, 8489},
+	{0x0000025c9, 24, 8490}, {0x0000025ca, 24, 8491}, {0x0000025cb, 24, 8492}, {0x0000025cc, 24, 8493},
+	{0x0000025cd, 24, 8494}, {0x0000025ce, 24, 8495}, {0x0000025cf, 24, 709}, {0x0000025d0, 24, 709},
+	{0x0000025d1, 24, 4814}, {0x0000025d2, 24, 8496}, {0x0000025d3, 24, 8497}, {0x0000025d4, 24, 4838},
+	{0x0000025d5, 24, 8498}, {0x0000025d6, 24, 8499}, {0x0000025d7, 24, 8500}, {0x0000025d8, 24, 8501},
+	{0x0000025d9, 24, 8502}, {0x0000025da, 24, 8503}, {0x0000025db, 24, 8504}, {0x0000025dc, 24, 2771},
+	{0x0000025dd, 24, 8505}, {0x0000025de, 24, 8506}, {0x0000025df, 24, 99}, {0x0000025e0, 24, 8507},
+	{0x0000025e1, 24, 8508}, {0x0000025e2, 24, 8509}, {0x0000025e3, 24, 8510}, {0x0000025e4, 24, 8511},
+	{0x0000025e5, 24, 6537}, {0x0000025e6, 24, 8512}, {0x0000025e7, 24, 5316}, {0x0000025e8, 24, 8513},
+	{0x0000025e9, 24, 8514}, {0x0000025ea, 24, 8515}, {0x0000025eb, 24, 8516}, {0x0000025ec, 24, 8517},
+	{0x0000025ed, 24, 8518}, {0x0000025ee, 24, 8519}, {0x0000025ef, 24, 8520}, {0x0000025f0, 24, 8521},
+	{0x0000025f1, 24, 185}, {0x0000025f2, 24, 185}, {0x0000025f3, 24, 8522}, {0x0000025f4, 24, 8523},
+	{0x0000025f5, 24, 8524}, {0x0000025f6, 24, 8525}, {0x0000025f7, 24, 8526}, {0x0000025f9, 24, 8527},
+	{0x0000025fa, 24, 8528}, {0x0000025fb, 24, 8529}, {0x0000025fc, 24, 8530}, {0x0000025fd, 24, 8531},
+	{0x0000025fe, 24, 8532}, {0x0000025ff, 24, 8533}, {0x000002600, 24, 8534}, {0x000002601, 24, 8535},
+	{0x000002602, 24, 8536}, {0x000002603, 24, 8537}, {0x000002604, 24, 8538}, {0x000002605, 24, 8539},
+	{0x000002606, 24, 8540}, {0x000002607, 24, 8541}, {0x000002608, 24, 865}, {0x000002609, 24, 8542},
+	{0x00000260a, 24, 3}, {0x00000260b, 24, 3}, {0x00000260c, 24, 8543}, {0x00000260d, 24, 8544},
+	{0x00000260e, 24, 8545}, {0x00000260f, 24, 8546}, {0x000002610, 24, 8547}, {0x000002611, 24, 8548},
+	{0x000002612, 24, 8549}, {0x000002613, 24, 8550}, {0x000002614, 24, 8551}, {0x000002615, 24, 8552},
+	{0x000002616, 24, 8553}, {0x000002617, 24, 8554}, {0x000002618, 24, 4673}, {0x000002619, 24, 8555},
+	{0x00000261a, 24, 8556}, {0x00000261b, 24, 8557}, {0x00000261c, 24, 8558}, {0x00000261d, 24, 8559},
+	{0x00000261e, 24, 8560}, {0x00000261f, 24, 8561}, {0x000002620, 24, 8562}, {0x000002621, 24, 8563},
+	{0x000002622, 24, 6282}, {0x000002623, 24, 8564}, {0x000002624, 24, 3930}, {0x000002625, 24, 8565},
+	{0x000002626, 24, 8566}, {0x000002627, 24, 8567}, {0x000002628, 24, 8568}, {0x000002629, 24, 8569},
+	{0x00000262a, 24, 8570}, {0x00000262b, 24, 8571}, {0x00000262c, 24, 8572}, {0x00000262d, 24, 5336},
+	{0x00000262e, 24, 8573}, {0x00000262f, 24, 8574}, {0x000002630, 24, 8575}, {0x000002631, 24, 8576},
+	{0x000002632, 24, 8577}, {0x000002633, 24, 8578}, {0x000002634, 24, 8579}, {0x000002635, 24, 8580},
+	{0x000002636, 24, 185}, {0x000002637, 24, 7550}, {0x000002638, 24, 8581}, {0x000002639, 24, 8582},
+	{0x00000263a, 24, 8583}, {0x00000263b, 24, 8584}, {0x00000263c, 24, 8585}, {0x00000263d, 24, 8586},
+	{0x00000263e, 24, 2631}, {0x00000263f, 24, 8587}, {0x000002640, 24, 8588}, {0x000002641, 24, 185},
+	{0x000002642, 24, 185}, {0x000002643, 24, 5373}, {0x000002644, 24, 3404}, {0x000002645, 24, 8589},
+	{0x000002646, 24, 8590}, {0x000002647, 24, 8591}, {0x000002648, 24, 8592}, {0x00000264a, 24, 865},
+	{0x00000264c, 24, 796}, {0x00000264d, 24, 4428}, {0x00000264e, 24, 8593}, {0x00000264f, 24, 8594},
+	{0x000002650, 24, 7228}, {0x000002651, 24, 3}, {0x000002652, 24, 3}, {0x000002653, 24, 8595},
+	{0x000002654, 24, 1570}, {0x000002655, 24, 459}, {0x000002656, 24, 8596}, {0x000002657, 24, 8597},
+	{0x000002658, 24, 8598}, {0x000002659, 24, 2314}, {0x00000265a, 24, 3223}, {0x00000265b, 24, 8599},
+	{0x00000265c, 24, 6482}, {0x00000265d, 24, 4482}, {0x00000265e, 24, 6482}, {0x00000265f, 24, 1842},
+	{0x000002660, 24, 8600}, {0x000002661, 24, 8601}, {0x000002662, 24, 3726}, {0x000002663, 24, 8602},
+	{0x000002664, 24, 8603}, {0x000002665, 24, 8604}, {0x000002666, 24, 2043}, {0x000002667, 24, 8605},
+	{0x000002668, 24, 709}, {0x000002669, 24, 709}, {0x00000266a, 24, 8606}, {0x00000266b, 24, 8607},
+	{0x00000266c, 24, 8608}, {0x00000266d, 24, 8609}, {0x00000266e, 24, 8610}, {0x00000266f, 24, 8611},
+	{0x000002670, 24, 8612}, {0x000002671, 24, 8613}, {0x000002672, 24, 8614}, {0x000002673, 24, 8615},
+	{0x000002674, 24, 8616}, {0x000002675, 24, 8617}, {0x000002676, 24, 8618}, {0x000002677, 24, 8619},
+	{0x000002678, 24, 8620}, {0x000002679, 24, 8621}, {0x00000267a, 24, 8622}, {0x00000267b, 24, 8623},
+	{0x00000267c, 24, 8624}, {0x00000267d, 24, 8625}, {0x00000267e, 24, 8626}, {0x00000267f, 24, 8627},
+	{0x000002680, 24, 8628}, {0x000002681, 24, 8629}, {0x000002682, 24, 2348}, {0x000002683, 24, 8630},
+	{0x000002684, 24, 8631}, {0x000002685, 24, 8632}, {0x000002686, 24, 8633}, {0x000002687, 24, 8634},
+	{0x000002688, 24, 5553}, {0x000002689, 24, 8635}, {0x00000268a, 24, 8636}, {0x00000268b, 24, 8637},
+	{0x00000268c, 24, 8638}, {0x00000268d, 24, 8639}, {0x00000268e, 24, 8640}, {0x00000268f, 24, 8641},
+	{0x000002690, 24, 8642}, {0x000002691, 24, 5017}, {0x000002692, 24, 8643}, {0x000002693, 24, 8644},
+	{0x000002694, 24, 8645}, {0x000002695, 24, 8646}, {0x000002696, 24, 8647}, {0x000002697, 24, 8648},
+	{0x000002698, 24, 3}, {0x000002699, 24, 3}, {0x00000269a, 24, 8649}, {0x00000269b, 24, 8650},
+	{0x00000269c, 24, 8651}, {0x00000269d, 24, 8652}, {0x00000269e, 24, 8653}, {0x00000269f, 24, 99},
+	{0x0000026a0, 24, 8654}, {0x0000026a1, 24, 8655}, {0x0000026a2, 24, 8656}, {0x0000026a3, 24, 8657},
+	{0x0000026a4, 24, 8658}, {0x0000026a5, 24, 8659}, {0x0000026a6, 24, 8660}, {0x0000026a7, 24, 8661},
+	{0x0000026a8, 24, 8662}, {0x0000026a9, 24, 8663}, {0x0000026aa, 24, 8664}, {0x0000026ab, 24, 63},
+	{0x0000026ac, 24, 8665}, {0x0000026ad, 24, 8666}, {0x0000026ae, 24, 8667}, {0x0000026af, 24, 8668},
+	{0x0000026b0, 24, 865}, {0x0000026b1, 24, 8669}, {0x0000026b2, 24, 8670}, {0x0000026b3, 24, 8671},
+	{0x0000026b4, 24, 8672}, {0x0000026b5, 24, 8673}, {0x0000026b6, 24, 8290}, {0x0000026b7, 24, 8674},
+	{0x0000026b8, 24, 3726}, {0x0000026b9, 24, 2822}, {0x0000026ba, 24, 185}, {0x0000026bb, 24, 865},
+	{0x0000026bc, 24, 8675}, {0x0000026bd, 24, 8676}, {0x0000026be, 24, 8677}, {0x0000026bf, 24, 8678},
+	{0x0000026c0, 24, 8679}, {0x0000026c1, 24, 8680}, {0x0000026c2, 24, 8681}, {0x0000026c3, 24, 8682},
+	{0x0000026c4, 24, 8683}, {0x0000026c5, 24, 8684}, {0x0000026c6, 24, 4472}, {0x0000026c7, 24, 4472},
+	{0x0000026c8, 24, 8685}, {0x0000026c9, 24, 8686}, {0x0000026ca, 24, 3}, {0x0000026cb, 24, 3},
+	{0x0000026cc, 24, 709}, {0x0000026cd, 24, 8687}, {0x0000026ce, 24, 8688}, {0x0000026cf, 24, 8689},
+	{0x0000026d0, 24, 8690}, {0x0000026d1, 24, 8691}, {0x0000026d2, 24, 8692}, {0x0000026d3, 24, 8693},
+	{0x0000026d4, 24, 8694}, {0x0000026d5, 24, 8695}, {0x0000026d6, 24, 8696}, {0x0000026d7, 24, 8697},
+	{0x0000026d8, 24, 8698}, {0x0000026d9, 24, 6621}, {0x0000026da, 24, 8699}, {0x0000026db, 24, 8700},
+	{0x0000026dc, 24, 8701}, {0x0000026dd, 24, 8702}, {0x0000026de, 24, 8703}, {0x0000026df, 24, 8704},
+	{0x0000026e0, 24, 8705}, {0x0000026e1, 24, 8706}, {0x0000026e2, 24, 6967}, {0x0000026e3, 24, 8707},
+	{0x0000026e4, 24, 8708}, {0x0000026e5, 24, 8709}, {0x0000026e6, 24, 8710}, {0x0000026e7, 24, 8711},
+	{0x0000026e8, 24, 3430}, {0x0000026e9, 24, 8712}, {0x0000026ea, 24, 8713}, {0x0000026eb, 24, 8714},
+	{0x0000026ec, 24, 8715}, {0x0000026ed, 24, 8716}, {0x0000026ee, 24, 8717}, {0x0000026ef, 24, 8718},
+	{0x0000026f0, 24, 8719}, {0x0000026f1, 24, 6289}, {0x0000026f2, 24, 8267}, {0x0000026f3, 24, 8720},
+	{0x0000026f4, 24, 8721}, {0x0000026f5, 24, 8722}, {0x0000026f6, 24, 8723}, {0x0000026f7, 24, 8724},
+	{0x0000026f8, 24, 8725}, {0x0000026f9, 24, 8726}, {0x0000026fa, 24, 8727}, {0x0000026fb, 24, 8728},
+	{0x0000026fc, 24, 8729}, {0x0000026fd, 24, 8730}, {0x0000026fe, 24, 8731}, {0x0000026ff, 24, 8397},
+	{0x000002700, 24, 8732}, {0x000002701, 24, 8733}, {0x000002702, 24, 8734}, {0x000002703, 24, 8735},
+	{0x000002704, 24, 8736}, {0x000002705, 24, 8737}, {0x000002706, 24, 8738}, {0x000002707, 24, 8739},
+	{0x000002708, 24, 8740}, {0x000002709, 24, 2314}, {0x00000270a, 24, 8741}, {0x00000270b, 24, 8742},
+	{0x00000270c, 24, 3}, {0x00000270d, 24, 3}, {0x00000270e, 24, 4472}, {0x00000270f, 24, 8743},
+	{0x000002710, 24, 4472}, {0x000002711, 24, 8744}, {0x000002712, 24, 8745}, {0x000002713, 24, 3976},
+	{0x000002714, 24, 8746}, {0x000002715, 24, 8747}, {0x000002716, 24, 8748}, {0x000002717, 24, 8749},
+	{0x000002718, 24, 8750}, {0x000002719, 24, 8096}, {0x00000271a, 24, 8751}, {0x00000271b, 24, 8752},
+	{0x00000271c, 24, 8753}, {0x00000271d, 24, 8754}, {0x00000271e, 24, 8755}, {0x00000271f, 24, 8756},
+	{0x000002720, 24, 8757}, {0x000002721, 24, 8758}, {0x000002722, 24, 8759}, {0x0000027f8, 24, 127},
+	{0x000002a6a, 24, 3}, {0x000002aaf, 24, 8760}, {0x000002d76, 24, 8761}, {0x000003000, 24, 8762},
+	{0x000003001, 24, 8763}, {0x000003002, 24, 8764}, {0x000003003, 24, 8765}, {0x000003004, 24, 8766},
+	{0x000003005, 24, 8767}, {0x000003006, 24, 8768}, {0x000003007, 24, 8769}, {0x000003008, 24, 8770},
+	{0x000003009, 24, 8771}, {0x00000300a, 24, 8772}, {0x00000300b, 24, 8773}, {0x00000300c, 24, 8774},
+	{0x00000300d, 24, 8775}, {0x00000300e, 24, 8776}, {0x00000300f, 24, 8777}, {0x000003010, 24, 8778},
+	{0x000003011, 24, 8779}, {0x000003012, 24, 8780}, {0x000003013, 24, 5288}, {0x000003014, 24, 8781},
+	{0x000003015, 24, 8782}, {0x000003016, 24, 8783}, {0x000003017, 24, 8784}, {0x000003018, 24, 8785},
+	{0x000003019, 24, 3}, {0x00000301a, 24, 8786}, {0x00000301b, 24, 8787}, {0x00000301c, 24, 8788},
+	{0x00000301d, 24, 8789}, {0x00000301e, 24, 8790}, {0x00000301f, 24, 8791}, {0x000003020, 24, 8792},
+	{0x000003021, 24, 8793}, {0x000003022, 24, 8794}, {0x000003023, 24, 8795}, {0x000003024, 24, 3},
+	{0x000003025, 24, 8796}, {0x000003026, 24, 8797}, {0x000003027, 24, 8798}, {0x000003028, 24, 8799},
+	{0x000003029, 24, 8800}, {0x00000302a, 24, 8801}, {0x00000302b, 24, 8802}, {0x00000302c, 24, 8803},
+	{0x00000302d, 24, 8804}, {0x00000302e, 24, 8805}, {0x00000302f, 24, 8806}, {0x000003030, 24, 8807},
+	{0x000003031, 24, 8808}, {0x000003032, 24, 8809}, {0x000003033, 24, 8810}, {0x000003034, 24, 8811},
+	{0x000003035, 24, 8812}, {0x000003036, 24, 8813}, {0x000003037, 24, 8814}, {0x000003038, 24, 8815},
+	{0x000003039, 24, 8816}, {0x00000303a, 24, 8817}, {0x00000303b, 24, 8818}, {0x00000303c, 24, 8819},
+	{0x00000303d, 24, 8820}, {0x00000303e, 24, 8821}, {0x00000303f, 24, 8822}, {0x000003040, 24, 3},
+	{0x000003041, 24, 8823}, {0x000003042, 24, 8824}, {0x000003043, 24, 8825}, {0x000003044, 24, 8826},
+	{0x000003045, 24, 8827}, {0x000003046, 24, 8828}, {0x000003047, 24, 8829}, {0x000003048, 24, 8830},
+	{0x000003049, 24, 8831}, {0x00000304a, 24, 8832}, {0x00000304b, 24, 8833}, {0x00000304c, 24, 8834},
+	{0x00000304d, 24, 8835}, {0x00000304e, 24, 8836}, {0x00000304f, 24, 8837}, {0x000003050, 24, 8838},
+	{0x000003051, 24, 8839}, {0x000003052, 24, 8840}, {0x000003053, 24, 8841}, {0x000003054, 24, 8842},
+	{0x000003055, 24, 8843}, {0x000003056, 24, 8844}, {0x000003057, 24, 8845}, {0x000003058, 24, 8846},
+	{0x000003059, 24, 8847}, {0x00000305a, 24, 8848}, {0x00000305b, 24, 8849}, {0x00000305c, 24, 8850},
+	{0x00000305d, 24, 8851}, {0x00000305e, 24, 8852}, {0x00000305f, 24, 8853}, {0x000003060, 24, 8854},
+	{0x000003061, 24, 8855}, {0x000003062, 24, 8856}, {0x000003063, 24, 8857}, {0x000003064, 24, 8858},
+	{0x000003065, 24, 865}, {0x000003066, 24, 8859}, {0x000003067, 24, 8860}, {0x000003068, 24, 8861},
+	{0x000003069, 24, 8862}, {0x00000306a, 24, 8863}, {0x00000306b, 24, 8864}, {0x00000306c, 24, 8865},
+	{0x00000306d, 24, 8866}, {0x00000306e, 24, 8867}, {0x00000306f, 24, 8868}, {0x000003070, 24, 8869},
+	{0x000003071, 24, 3}, {0x000003072, 24, 8870}, {0x000003073, 24, 8871}, {0x000003074, 24, 8872},
+	{0x000003075, 24, 8873}, {0x000003076, 24, 8874}, {0x000003077, 24, 8875}, {0x000003078, 24, 3},
+	{0x000003079, 24, 8876}, {0x00000307a, 24, 8877}, {0x00000307b, 24, 3}, {0x00000307c, 24, 8878},
+	{0x00000307d, 24, 8879}, {0x00000307e, 24, 8880}, {0x00000307f, 24, 8881}, {0x000003080, 24, 3},
+	{0x000003081, 24, 8882}, {0x000003082, 24, 8883}, {0x000003083, 24, 8884}, {0x000003084, 24, 8885},
+	{0x000003085, 24, 3}, {0x000003086, 24, 8886}, {0x000003087, 24, 8887}, {0x000003088, 24, 540},
+	{0x000003089, 24, 8888}, {0x00000308a, 24, 8889}, {0x00000308b, 24, 8890}, {0x00000308c, 24, 8891},
+	{0x00000308d, 24, 8892}, {0x00000308e, 24, 8893}, {0x00000308f, 24, 8894}, {0x000003090, 24, 8895},
+	{0x000003091, 24, 8896}, {0x000003092, 24, 8897}, {0x000003093, 24, 8898}, {0x000003094, 24, 3},
+	{0x000003095, 24, 8899}, {0x000003096, 24, 3}, {0x000003097, 24, 8900}, {0x000003098, 24, 8901},
+	{0x000003099, 24, 8902}, {0x00000309a, 24, 8903}, {0x00000309b, 24, 8904}, {0x00000309c, 24, 8905},
+	{0x00000309d, 24, 8906}, {0x00000309e, 24, 8907}, {0x00000309f, 24, 8908}, {0x0000030a0, 24, 8909},
+	{0x0000030a1, 24, 8910}, {0x0000030a2, 24, 8911}, {0x0000030a3, 24, 3}, {0x0000030a4, 24, 8912},
+	{0x0000030a5, 24, 8913}, {0x0000030a6, 24, 8914}, {0x0000030a7, 24, 8915}, {0x0000030a8, 24, 8916},
+	{0x0000030a9, 24, 8917}, {0x0000030aa, 24, 8918}, {0x0000030ab, 24, 8919}, {0x0000030ac, 24, 8920},
+	{0x0000030ad, 24, 8921}, {0x0000030ae, 24, 8922}, {0x0000030af, 24, 8923}, {0x0000030b0, 24, 8924},
+	{0x0000030b1, 24, 8925}, {0x0000030b2, 24, 8926}, {0x0000030b3, 24, 8927}, {0x0000030b4, 24, 8928},
+	{0x0000030b5, 24, 8929}, {0x0000030b6, 24, 3}, {0x0000030b7, 24, 8930}, {0x0000030b8, 24, 8931},
+	{0x0000030b9, 24, 8932}, {0x0000030ba, 24, 8933}, {0x0000030bb, 24, 8934}, {0x0000030bc, 24, 8935},
+	{0x0000030bd, 24, 8936}, {0x0000030be, 24, 8937}, {0x0000030bf, 24, 8938}, {0x0000030c0, 24, 8939},
+	{0x0000030c1, 24, 8940}, {0x0000030c2, 24, 8941}, {0x0000030c3, 24, 8942}, {0x0000030c4, 24, 8943},
+	{0x0000030c5, 24, 8944}, {0x0000030c6, 24, 8945}, {0x0000030c7, 24, 8946}, {0x0000030c8, 24, 8947},
+	{0x0000030c9, 24, 8948}, {0x0000030ca, 24, 8949}, {0x0000030cb, 24, 8950}, {0x0000030cc, 24, 8951},
+	{0x0000030cd, 24, 8952}, {0x0000030ce, 24, 8953}, {0x0000030cf, 24, 8954}, {0x0000030d0, 24, 8955},
+	{0x0000030d1, 24, 8956}, {0x0000030d2, 24, 8957}, {0x0000030d3, 24, 8958}, {0x0000030d4, 24, 8959},
+	{0x0000030d5, 24, 8960}, {0x0000030d6, 24, 8961}, {0x0000030d7, 24, 8962}, {0x0000030d8, 24, 8963},
+	{0x0000030d9, 24, 8964}, {0x0000030da, 24, 6699}, {0x0000030db, 24, 8965}, {0x0000030dc, 24, 8966},
+	{0x0000030dd, 24, 8967}, {0x0000030de, 24, 8968}, {0x0000030df, 24, 8969}, {0x0000030e0, 24, 8970},
+	{0x0000030e1, 24, 8971}, {0x0000030e2, 24, 8972}, {0x0000030e3, 24, 8973}, {0x0000030e4, 24, 8974},
+	{0x0000030e5, 24, 8975}, {0x0000030e6, 24, 8976}, {0x0000030e7, 24, 8977}, {0x0000030e8, 24, 8978},
+	{0x0000030e9, 24, 8979}, {0x0000030ea, 24, 8980}, {0x0000030eb, 24, 8981}, {0x0000030ec, 24, 8982},
+	{0x0000030ed, 24, 8983}, {0x0000030ee, 24, 8984}, {0x0000030ef, 24, 8985}, {0x0000030f0, 24, 8986},
+	{0x0000030f1, 24, 1174}, {0x0000030f2, 24, 3}, {0x0000030f3, 24, 8987}, {0x0000030f4, 24, 8988},
+	{0x0000030f5, 24, 8989}, {0x0000030f6, 24, 8990}, {0x0000030f7, 24, 8991}, {0x0000030f8, 24, 8992},
+	{0x0000030f9, 24, 8993}, {0x0000030fa, 24, 8994}, {0x0000030fb, 24, 8995}, {0x0000030fc, 24, 8996},
+	{0x0000030fd, 24, 8997}, {0x0000030fe, 24, 8998}, {0x0000030ff, 24, 8999}, {0x00000323a, 24, 9000},
+	{0x00000336c, 24, 9001}, {0x0000034f1, 24, 9002}, {0x000003532, 24, 9003}, {0x000003560, 24, 9004},
+	{0x0000036f8, 24, 9005}, {0x0000036fe, 24, 9006}, {0x00000376d, 24, 3430}, {0x0000037b7, 24, 9007},
+	{0x000003a98, 24, 3}, {0x000003a99, 24, 3}, {0x000003a9a, 24, 3}, {0x000003a9b, 24, 3},
+	{0x000003a9c, 24, 3}, {0x000003a9d, 24, 3100}, {0x000003aaf, 24, 9008}, {0x000003cc5, 24, 9009},
+	{0x000003d41, 24, 9010}, {0x000003ee1, 24, 865}, {0x000004000, 24, 9011}, {0x000004001, 24, 9012},
+	{0x000004002, 24, 9013}, {0x000004003, 24, 9014}, {0x000004004, 24, 9015}, {0x000004005, 24, 9016},
+	{0x000004006, 24, 9017}, {0x000004007, 24, 9018}, {0x000004008, 24, 9019}, {0x000004009, 24, 9020},
+	{0x00000400a, 24, 9021}, {0x00000400b, 24, 3}, {0x00000400c, 24, 9022}, {0x00000400d, 24, 9023},
+	{0x00000400e, 24, 7414}, {0x00000400f, 24, 9024}, {0x000004010, 24, 9025}, {0x000004011, 24, 9026},
+	{0x000004012, 24, 9027}, {0x000004013, 24, 9028}, {0x000004014, 24, 9029}, {0x000004015, 24, 9030},
+	{0x000004016, 24, 9031}, {0x000004017, 24, 9032}, {0x000004018, 24, 9033}, {0x000004019, 24, 9034},
+	{0x00000401a, 24, 9035}, {0x00000401b, 24, 9036}, {0x00000401c, 24, 9037}, {0x00000401d, 24, 9038},
+	{0x00000401e, 24, 9039}, {0x00000401f, 24, 9040}, {0x000004020, 24, 9041}, {0x000004021, 24, 9042},
+	{0x000004022, 24, 9043}, {0x000004023, 24, 9044}, {0x000004024, 24, 9045}, {0x000004025, 24, 9046},
+	{0x000004026, 24, 1741}, {0x000004027, 24, 9047}, {0x000004028, 24, 9048}, {0x000004029, 24, 9049},
+	{0x00000402a, 24, 9050}, {0x00000402b, 24, 9051}, {0x00000402c, 24, 9052}, {0x00000402d, 24, 9053},
+	{0x00000402e, 24, 9054}, {0x00000402f, 24, 9055}, {0x000004030, 24, 9056}, {0x000004031, 24, 9057},
+	{0x000004032, 24, 9058}, {0x000004033, 24, 9059}, {0x000004034, 24, 9060}, {0x000004035, 24, 9061},
+	{0x000004036, 24, 9062}, {0x000004037, 24, 9063}, {0x000004038, 24, 9064}, {0x000004039, 24, 9065},
+	{0x00000403a, 24, 9066}, {0x00000403b, 24, 9067}, {0x00000403c, 24, 9068}, {0x00000403d, 24, 9069},
+	{0x00000403e, 24, 9070}, {0x00000403f, 24, 9071}, {0x000004040, 24, 9072}, {0x000004041, 24, 9073},
+	{0x000004042, 24, 9074}, {0x000004043, 24, 3734}, {0x000004044, 24, 9075}, {0x000004045, 24, 9076},
+	{0x000004046, 24, 9077}, {0x000004047, 24, 9078}, {0x000004048, 24, 9079}, {0x000004049, 24, 9080},
+	{0x00000404a, 24, 9081}, {0x00000404b, 24, 9082}, {0x00000404c, 24, 9083}, {0x00000404d, 24, 9084},
+	{0x00000404e, 24, 9085}, {0x00000404f, 24, 9086}, {0x000004050, 24, 9087}, {0x000004051, 24, 9088},
+	{0x000004052, 24, 9089}, {0x000004053, 24, 9090}, {0x000004054, 24, 9091}, {0x000004055, 24, 9092},
+	{0x000004056, 24, 9093}, {0x000004057, 24, 9094}, {0x000004058, 24, 9095}, {0x000004059, 24, 9096},
+	{0x00000405a, 24, 9097}, {0x00000405b, 24, 9098}, {0x00000405c, 24, 9099}, {0x00000405d, 24, 9100},
+	{0x00000405e, 24, 9101}, {0x00000405f, 24, 9102}, {0x000004060, 24, 9103}, {0x000004061, 24, 9104},
+	{0x000004062, 24, 9105}, {0x000004063, 24, 9106}, {0x000004064, 24, 9107}, {0x000004065, 24, 9108},
+	{0x000004066, 24, 9109}, {0x000004067, 24, 9110}, {0x000004068, 24, 9111}, {0x000004069, 24, 9112},
+	{0x00000406a, 24, 9113}, {0x00000406b, 24, 9114}, {0x00000406c, 24, 9115}, {0x00000406d, 24, 9116},
+	{0x00000406e, 24, 9117}, {0x00000406f, 24, 9118}, {0x000004070, 24, 9119}, {0x000004071, 24, 9120},
+	{0x000004072, 24, 9121}, {0x000004073, 24, 9122}, {0x000004074, 24, 9123}, {0x000004075, 24, 155},
+	{0x000004076, 24, 9124}, {0x000004077, 24, 9125}, {0x000004078, 24, 9126}, {0x000004079, 24, 9127},
+	{0x00000407a, 24, 9128}, {0x00000407b, 24, 9129}, {0x00000407c, 24, 9130}, {0x00000407d, 24, 9131},
+	{0x00000407e, 24, 9132}, {0x00000407f, 24, 9133}, {0x000004080, 24, 9134}, {0x000004081, 24, 9135},
+	{0x000004082, 24, 9136}, {0x000004083, 24, 9137}, {0x000004084, 24, 9138}, {0x000004085, 24, 9139},
+	{0x000004086, 24, 9140}, {0x000004087, 24, 9141}, {0x000004088, 24, 9142}, {0x000004089, 24, 9143},
+	{0x00000408a, 24, 9144}, {0x00000408b, 24, 9145}, {0x00000408c, 24, 9146}, {0x00000408d, 24, 9147},
+	{0x00000408e, 24, 155}, {0x00000408f, 24, 9148}, {0x000004090, 24, 9149}, {0x000004091, 24, 9150},
+	{0x000004092, 24, 9151}, {0x000004093, 24, 9152}, {0x000004094, 24, 9153}, {0x000004095, 24, 9154},
+	{0x000004096, 24, 9155}, {0x000004097, 24, 9156}, {0x000004098, 24, 9157}, {0x000004099, 24, 9158},
+	{0x00000409a, 24, 9159}, {0x00000409b, 24, 9160}, {0x00000409c, 24, 9161}, {0x00000409d, 24, 9162},
+	{0x00000409e, 24, 9163}, {0x00000409f, 24, 22}, {0x0000040a0, 24, 9164}, {0x0000040a1, 24, 9165},
+	{0x0000040a2, 24, 9166}, {0x0000040a3, 24, 9167}, {0x0000040a4, 24, 9168}, {0x0000040a5, 24, 9169},
+	{0x0000040a6, 24, 9170}, {0x0000040a7, 24, 9171}, {0x0000040a8, 24, 9172}, {0x0000040a9, 24, 9173},
+	{0x0000040aa, 24, 9174}, {0x0000040ab, 24, 9175}, {0x0000040ac, 24, 9176}, {0x0000040ad, 24, 9177},
+	{0x0000040ae, 24, 9178}, {0x0000040af, 24, 9179}, {0x0000040b0, 24, 9180}, {0x0000040b1, 24, 9181},
+	{0x0000040b2, 24, 9182}, {0x0000040b3, 24, 9183}, {0x0000040b4, 24, 9184}, {0x0000040b5, 24, 9185},
+	{0x0000040b6, 24, 9186}, {0x0000040b7, 24, 9187}, {0x0000040b8, 24, 9188}, {0x0000040b9, 24, 9189},
+	{0x0000040ba, 24, 9190}, {0x0000040bb, 24, 9191}, {0x0000040bc, 24, 9192}, {0x0000040bd, 24, 9193},
+	{0x0000040be, 24, 9194}, {0x0000040bf, 24, 9195}, {0x0000040c0, 24, 9196}, {0x0000040c1, 24, 9197},
+	{0x0000040c2, 24, 9198}, {0x0000040c3, 24, 9199}, {0x0000040c4, 24, 9200}, {0x0000040c5, 24, 9201},
+	{0x0000040c6, 24, 9202}, {0x0000040c7, 24, 9203}, {0x0000040c8, 24, 9204}, {0x0000040c9, 24, 9205},
+	{0x0000040ca, 24, 9206}, {0x0000040cb, 24, 9207}, {0x0000040cc, 24, 9208}, {0x0000040cd, 24, 9209},
+	{0x0000040ce, 24, 9210}, {0x0000040cf, 24, 9211}, {0x0000040d0, 24, 7798}, {0x0000040d1, 24, 9212},
+	{0x0000040d2, 24, 9213}, {0x0000040d3, 24, 9214}, {0x0000040d4, 24, 9215}, {0x0000040d5, 24, 9216},
+	{0x0000040d6, 24, 9217}, {0x0000040d7, 24, 9218}, {0x0000040d8, 24, 9219}, {0x0000040d9, 24, 9220},
+	{0x0000040da, 24, 9221}, {0x0000040db, 24, 9222}, {0x0000040dc, 24, 9223}, {0x0000040dd, 24, 9224},
+	{0x0000040de, 24, 9225}, {0x0000040df, 24, 9226}, {0x0000040e0, 24, 9227}, {0x0000040e1, 24, 9228},
+	{0x0000040e2, 24, 9229}, {0x0000040e3, 24, 9230}, {0x0000040e4, 24, 9231}, {0x0000040e5, 24, 9232},
+	{0x0000040e6, 24, 9233}, {0x0000040e7, 24, 9234}, {0x0000040e8, 24, 9235}, {0x0000040e9, 24, 9236},
+	{0x0000040ea, 24, 9237}, {0x0000040eb, 24, 9238}, {0x0000040ec, 24, 9239}, {0x0000040ed, 24, 9240},
+	{0x0000040ee, 24, 9241}, {0x0000040ef, 24, 9242}, {0x0000040f0, 24, 9243}, {0x0000040f1, 24, 9244},
+	{0x0000040f2, 24, 9245}, {0x0000040f3, 24, 9246}, {0x0000040f4, 24, 9247}, {0x0000040f5, 24, 9248},
+	{0x0000040f6, 24, 9249}, {0x0000040f7, 24, 1034}, {0x0000040f8, 24, 9250}, {0x0000040f9, 24, 9251},
+	{0x0000040fa, 24, 9252}, {0x0000040fb, 24, 9253}, {0x0000040fc, 24, 9254}, {0x0000040fd, 24, 9255},
+	{0x0000040fe, 24, 9256}, {0x0000040ff, 24, 9257}, {0x0000041b4, 24, 9258}, {0x000004252, 24, 9259},
+	{0x0000043ff, 24, 9260}, {0x000004501, 24, 9261}, {0x00000464b, 24, 9262}, {0x000004d32, 24, 9263},
+	{0x000005000, 24, 9264}, {0x000005001, 24, 9265}, {0x000005002, 24, 9266}, {0x000005003, 24, 9267},
+	{0x000005004, 24, 244}, {0x000005006, 24, 9268}, {0x000005007, 24, 9269}, {0x000005008, 24, 9270},
+	{0x000005009, 24, 9271}, {0x00000500a, 24, 9272}, {0x00000500b, 24, 3}, {0x00000500c, 24, 9273},
+	{0x00000500d, 24, 9274}, {0x00000500e, 24, 9275}, {0x00000500f, 24, 3}, {0x000005010, 24, 9276},
+	{0x000005012, 24, 9277}, {0x000005013, 24, 9278}, {0x000005014, 24, 3}, {0x000005015, 24, 9279},
+	{0x000005016, 24, 9280}, {0x000005017, 24, 9281}, {0x000005018, 24, 9282}, {0x000005019, 24, 9283},
+	{0x00000501a, 24, 9284}, {0x00000501b, 24, 9285}, {0x00000501c, 24, 9286}, {0x00000501e, 24, 9287},
+	{0x00000501f, 24, 9288}, {0x000005020, 24, 9289}, {0x000005021, 24, 9290}, {0x000005022, 24, 9291},
+	{0x000005023, 24, 9292}, {0x000005024, 24, 9293}, {0x000005026, 24, 9294}, {0x000005027, 24, 9295},
+	{0x000005028, 24, 9296}, {0x000005029, 24, 9297}, {0x00000502a, 24, 3}, {0x00000502b, 24, 9298},
+	{0x00000502c, 24, 9299}, {0x00000502d, 24, 9300}, {0x00000502e, 24, 9301}, {0x00000502f, 24, 9302},
+	{0x000005030, 24, 9303}, {0x000005031, 24, 9304}, {0x000005032, 24, 9305}, {0x000005033, 24, 9306},
+	{0x000005036, 24, 9307}, {0x000005037, 24, 9308}, {0x000005038, 24, 9309}, {0x000005039, 24, 9310},
+	{0x00000503a, 24, 9311}, {0x00000503b, 24, 9312}, {0x00000503c, 24, 9313}, {0x00000503e, 24, 3},
+	{0x00000503f, 24, 9314}, {0x000005040, 24, 9315}, {0x000005041, 24, 9316}, {0x000005042, 24, 9317},
+	{0x000005043, 24, 9318}, {0x000005044, 24, 9319}, {0x000005045, 24, 9320}, {0x000005046, 24, 9321},
+	{0x000005047, 24, 99}, {0x000005048, 24, 9322}, {0x000005049, 24, 9323}, {0x00000504a, 24, 9324},
+	{0x00000504b, 24, 9325}, {0x00000504c, 24, 9326}, {0x00000504d, 24, 9327}, {0x00000504e, 24, 9328},
+	{0x00000504f, 24, 9329}, {0x000005050, 24, 3}, {0x000005051, 24, 9330}, {0x000005052, 24, 9331},
+	{0x000005053, 24, 3}, {0x000005054, 24, 3}, {0x000005055, 24, 9332}, {0x000005056, 24, 1294},
+	{0x000005057, 24, 9333}, {0x000005058, 24, 9334}, {0x000005059, 24, 9335}, {0x00000505a, 24, 9336},
+	{0x00000505b, 24, 9337}, {0x00000505c, 24, 9338}, {0x00000505e, 24, 9339}, {0x00000505f, 24, 9340},
+	{0x000005060, 24, 9341}, {0x000005062, 24, 9342}, {0x000005063, 24, 9343}, {0x000005064, 24, 9344},
+	{0x000005065, 24, 9345}, {0x000005066, 24, 9346}, {0x000005067, 24, 9347}, {0x000005068, 24, 9348},
+	{0x000005069, 24, 9349}, {0x00000506a, 24, 9350}, {0x00000506b, 24, 9351}, {0x00000506c, 24, 9352},
+	{0x00000506d, 24, 9353}, {0x00000506e, 24, 9354}, {0x00000506f, 24, 9355}, {0x000005070, 24, 9356},
+	{0x000005071, 24, 9357}, {0x000005072, 24, 9358}, {0x000005073, 24, 3}, {0x000005074, 24, 9359},
+	{0x000005075, 24, 9360}, {0x000005076, 24, 564}, {0x000005077, 24, 9361}, {0x000005078, 24, 9362},
+	{0x000005079, 24, 99}, {0x00000507a, 24, 9363}, {0x00000507b, 24, 9364}, {0x00000507c, 24, 9365},
+	{0x00000507d, 24, 9366}, {0x00000507e, 24, 9367}, {0x00000507f, 24, 6811}, {0x000005080, 24, 3},
+	{0x000005081, 24, 9368}, {0x000005082, 24, 9369}, {0x000005083, 24, 9370}, {0x000005084, 24, 9371},
+	{0x000005086, 24, 9372}, {0x000005087, 24, 9373}, {0x000005088, 24, 9374}, {0x000005089, 24, 9375},
+	{0x00000508b, 24, 459}, {0x00000508c, 24, 9376}, {0x00000508d, 24, 9377}, {0x00000508e, 24, 9378},
+	{0x00000508f, 24, 9379}, {0x000005090, 24, 9380}, {0x000005091, 24, 9381}, {0x000005092, 24, 9382},
+	{0x000005093, 24, 9383}, {0x000005094, 24, 9384}, {0x000005095, 24, 9385}, {0x000005096, 24, 9386},
+	{0x000005097, 24, 9387}, {0x000005098, 24, 9388}, {0x000005099, 24, 9389}, {0x00000509a, 24, 9390},
+	{0x00000509b, 24, 9391}, {0x00000509c, 24, 9392}, {0x00000509d, 24, 9393}, {0x00000509e, 24, 9394},
+	{0x00000509f, 24, 9395}, {0x0000050a0, 24, 9396}, {0x0000050a1, 24, 9397}, {0x0000050a2, 24, 3},
+	{0x0000050a3, 24, 9398}, {0x0000050a4, 24, 9399}, {0x0000050a5, 24, 9400}, {0x0000050a6, 24, 9401},
+	{0x0000050a7, 24, 3}, {0x0000050a8, 24, 9402}, {0x0000050a9, 24, 9403}, {0x0000050aa, 24, 7388},
+	{0x0000050ab, 24, 9404}, {0x0000050ac, 24, 9405}, {0x0000050ad, 24, 9406}, {0x0000050ae, 24, 9407},
+	{0x0000050af, 24, 9408}, {0x0000050b0, 24, 9409}, {0x0000050b1, 24, 9410}, {0x0000050b2, 24, 9411},
+	{0x0000050b3, 24, 9412}, {0x0000050b4, 24, 9413}, {0x0000050b5, 24, 9414}, {0x0000050b6, 24, 9415},
+	{0x0000050b7, 24, 9416}, {0x0000050b8, 24, 9417}, {0x0000050b9, 24, 9418}, {0x0000050ba, 24, 9419},
+	{0x0000050bb, 24, 9420}, {0x0000050bc, 24, 9421}, {0x0000050bd, 24, 3}, {0x0000050be, 24, 9422},
+	{0x0000050bf, 24, 2790}, {0x0000050c0, 24, 9423}, {0x0000050c1, 24, 9424}, {0x0000050c2, 24, 9425},
+	{0x0000050c4, 24, 9426}, {0x0000050c5, 24, 9427}, {0x0000050c6, 24, 9428}, {0x0000050c8, 24, 9429},
+	{0x0000050c9, 24, 9430}, {0x0000050ca, 24, 9431}, {0x0000050cb, 24, 9432}, {0x0000050cc, 24, 9433},
+	{0x0000050cd, 24, 9434}, {0x0000050ce, 24, 9435}, {0x0000050cf, 24, 9436}, {0x0000050d0, 24, 9437},
+	{0x0000050d1, 24, 3}, {0x0000050d2, 24, 9438}, {0x0000050d3, 24, 9439}, {0x0000050d4, 24, 9440},
+	{0x0000050d5, 24, 9441}, {0x0000050d6, 24, 9442}, {0x0000050d7, 24, 9443}, {0x0000050d8, 24, 9444},
+	{0x0000050d9, 24, 9445}, {0x0000050da, 24, 244}, {0x0000050db, 24, 9446}, {0x0000050dc, 24, 9447},
+	{0x0000050dd, 24, 9448}, {0x0000050de, 24, 9449}, {0x0000050df, 24, 9450}, {0x0000050e1, 24, 9451},
+	{0x0000050e2, 24, 3}, {0x0000050e3, 24, 185}, {0x0000050e4, 24, 865}, {0x0000050e6, 24, 9452},
+	{0x0000050e7, 24, 9453}, {0x0000050e8, 24, 9454}, {0x0000050ea, 24, 9455}, {0x0000050eb, 24, 9456},
+	{0x0000050ec, 24, 9457}, {0x0000050ed, 24, 9458}, {0x0000050ee, 24, 9459}, {0x0000050ef, 24, 9460},
+	{0x0000050f0, 24, 3}, {0x0000050f1, 24, 652}, {0x0000050f2, 24, 9461}, {0x0000050f3, 24, 9462},
+	{0x0000050f4, 24, 9463}, {0x0000050f6, 24, 9464}, {0x0000050f7, 24, 9465}, {0x0000050f8, 24, 9466},
+	{0x0000050f9, 24, 9467}, {0x0000050fa, 24, 9468}, {0x0000050fb, 24, 9469}, {0x0000050fc, 24, 9470},
+	{0x0000050fd, 24, 9471}, {0x0000050fe, 24, 9472}, {0x0000050ff, 24, 9473}, {0x000005218, 24, 9474},
+	{0x0000054af, 24, 9475}, {0x000005907, 24, 9476}, {0x000005a39, 24, 9477}, {0x000005cb1, 24, 9478},
+	{0x000005d03, 24, 9479}, {0x000006000, 24, 9480}, {0x000006001, 24, 9481}, {0x000006002, 24, 9482},
+	{0x000006003, 24, 9483}, {0x000006004, 24, 9484}, {0x000006005, 24, 9485}, {0x000006006, 24, 9486},
+	{0x000006007, 24, 9487}, {0x000006008, 24, 244}, {0x000006009, 24, 3}, {0x00000600a, 24, 9488},
+	{0x00000600b, 24, 9489}, {0x00000600c, 24, 9490}, {0x00000600d, 24, 9491}, {0x00000600e, 24, 9492},
+	{0x00000600f, 24, 9493}, {0x000006010, 24, 9494}, {0x000006011, 24, 9495}, {0x000006012, 24, 9496},
+	{0x000006013, 24, 9497}, {0x000006014, 24, 9498}, {0x000006015, 24, 9499}, {0x000006016, 24, 9500},
+	{0x000006017, 24, 9501}, {0x000006018, 24, 9502}, {0x000006019, 24, 9503}, {0x00000601a, 24, 9504},
+	{0x00000601b, 24, 9505}, {0x00000601c, 24, 9506}, {0x00000601d, 24, 8866}, {0x00000601e, 24, 9507},
+	{0x00000601f, 24, 9508}, {0x000006020, 24, 9509}, {0x000006021, 24, 9510}, {0x000006022, 24, 9511},
+	{0x000006023, 24, 9512}, {0x000006024, 24, 9513}, {0x000006025, 24, 9514}, {0x000006026, 24, 9515},
+	{0x000006027, 24, 9516}, {0x000006028, 24, 9517}, {0x000006029, 24, 9518}, {0x00000602a, 24, 9519},
+	{0x00000602b, 24, 9520}, {0x00000602c, 24, 9521}, {0x00000602d, 24, 9522}, {0x00000602e, 24, 9523},
+	{0x00000602f, 24, 3}, {0x000006030, 24, 9524}, {0x000006031, 24, 9525}, {0x000006032, 24, 9526},
+	{0x000006033, 24, 9527}, {0x000006034, 24, 9528}, {0x000006035, 24, 9529}, {0x000006036, 24, 9530},
+	{0x000006037, 24, 9531}, {0x000006038, 24, 108}, {0x000006039, 24, 9532}, {0x00000603a, 24, 9533},
+	{0x00000603b, 24, 9534}, {0x00000603c, 24, 9535}, {0x00000603d, 24, 9536}, {0x00000603e, 24, 3},
+	{0x00000603f, 24, 9537}, {0x000006040, 24, 9538}, {0x000006041, 24, 91}, {0x000006042, 24, 9539},
+	{0x000006043, 24, 9540}, {0x000006044, 24, 9541}, {0x000006045, 24, 9542}, {0x000006046, 24, 9543},
+	{0x000006047, 24, 3}, {0x000006048, 24, 9544}, {0x000006049, 24, 9545}, {0x00000604a, 24, 9546},
+	{0x00000604b, 24, 9547}, {0x00000604c, 24, 5017}, {0x00000604d, 24, 9548}, {0x00000604e, 24, 9549},
+	{0x00000604f, 24, 155}, {0x000006050, 24, 9550}, {0x000006051, 24, 9551}, {0x000006052, 24, 9552},
+	{0x000006053, 24, 9553}, {0x000006054, 24, 9554}, {0x000006055, 24, 9555}, {0x000006056, 24, 9556},
+	{0x000006057, 24, 9557}, {0x000006058, 24, 9558}, {0x000006059, 24, 9559}, {0x00000605a, 24, 9560},
+	{0x00000605b, 24, 9561}, {0x00000605c, 24, 3}, {0x00000605d, 24, 9562}, {0x00000605e, 24, 9563},
+	{0x00000605f, 24, 9564}, {0x000006060, 24, 9565}, {0x000006061, 24, 9566}, {0x000006062, 24, 9567},
+	{0x000006063, 24, 9568}, {0x000006064, 24, 9048}, {0x000006065, 24, 9569}, {0x000006066, 24, 9570},
+	{0x000006067, 24, 9571}, {0x000006068, 24, 9572}, {0x000006069, 24, 127}, {0x00000606a, 24, 9573},
+	{0x00000606b, 24, 9574}, {0x00000606c, 24, 9575}, {0x00000606d, 24, 9576}, {0x00000606e, 24, 9577},
+	{0x00000606f, 24, 9578}, {0x000006070, 24, 3}, {0x000006071, 24, 9579}, {0x000006072, 24, 9580},
+	{0x000006073, 24, 9581}, {0x000006074, 24, 9582}, {0x000006075, 24, 9583}, {0x000006076, 24, 9584},
+	{0x000006077, 24, 9585}, {0x000006078, 24, 9586}, {0x000006079, 24, 9587}, {0x00000607a, 24, 9588},
+	{0x00000607b, 24, 9589}, {0x00000607c, 24, 9590}, {0x00000607d, 24, 9591}, {0x00000607e, 24, 9592},
+	{0x00000607f, 24, 9593}, {0x000006080, 24, 9594}, {0x000006081, 24, 9595}, {0x000006082, 24, 9596},
+	{0x000006083, 24, 3}, {0x000006084, 24, 9597}, {0x000006085, 24, 9598}, {0x000006086, 24, 9599},
+	{0x000006087, 24, 9600}, {0x000006088, 24, 9601}, {0x000006089, 24, 9602}, {0x00000608a, 24, 9603},
+	{0x00000608b, 24, 9604}, {0x00000608c, 24, 244}, {0x00000608d, 24, 9605}, {0x00000608e, 24, 9606},
+	{0x00000608f, 24, 9607}, {0x000006090, 24, 9608}, {0x000006091, 24, 9609}, {0x000006092, 24, 9610},
+	{0x000006093, 24, 9611}, {0x000006094, 24, 564}, {0x000006095, 24, 9612}, {0x000006096, 24, 9613},
+	{0x000006097, 24, 244}, {0x000006098, 24, 9614}, {0x000006099, 24, 9615}, {0x00000609a, 24, 9616},
+	{0x00000609b, 24, 9617}, {0x00000609c, 24, 9618}, {0x00000609d, 24, 9619}, {0x00000609e, 24, 9620},
+	{0x00000609f, 24, 9621}, {0x0000060a0, 24, 9622}, {0x0000060a1, 24, 9623}, {0x0000060a2, 24, 9624},
+	{0x0000060a3, 24, 9625}, {0x0000060a4, 24, 9626}, {0x0000060a5, 24, 9627}, {0x0000060a6, 24, 9628},
+	{0x0000060a7, 24, 9629}, {0x0000060a8, 24, 9630}, {0x0000060a9, 24, 9631}, {0x0000060aa, 24, 9632},
+	{0x0000060ab, 24, 7474}, {0x0000060ac, 24, 9633}, {0x0000060ad, 24, 9634}, {0x0000060ae, 24, 9635},
+	{0x0000060af, 24, 9636}, {0x0000060b0, 24, 9637}, {0x0000060b1, 24, 9638}, {0x0000060b2, 24, 9639},
+	{0x0000060b3, 24, 9640}, {0x0000060b4, 24, 9641}, {0x0000060b5, 24, 9642}, {0x0000060b6, 24, 9643},
+	{0x0000060b7, 24, 9644}, {0x0000060b8, 24, 9645}, {0x0000060b9, 24, 9646}, {0x0000060ba, 24, 9647},
+	{0x0000060bb, 24, 9648}, {0x0000060bc, 24, 9649}, {0x0000060bd, 24, 9650}, {0x0000060be, 24, 9651},
+	{0x0000060bf, 24, 9652}, {0x0000060c0, 24, 9653}, {0x0000060c1, 24, 9654}, {0x0000060c2, 24, 9655},
+	{0x0000060c3, 24, 9656}, {0x0000060c4, 24, 9657}, {0x0000060c5, 24, 9658}, {0x0000060c6, 24, 9659},
+	{0x0000060c7, 24, 9660}, {0x0000060c8, 24, 9661}, {0x0000060c9, 24, 9662}, {0x0000060ca, 24, 9663},
+	{0x0000060cb, 24, 9664}, {0x0000060cc, 24, 9665}, {0x0000060cd, 24, 9666}, {0x0000060ce, 24, 9667},
+	{0x0000060cf, 24, 9668}, {0x0000060d0, 24, 9669}, {0x0000060d1, 24, 9670}, {0x0000060d2, 24, 9671},
+	{0x0000060d3, 24, 9672}, {0x0000060d4, 24, 9673}, {0x0000060d5, 24, 9674}, {0x0000060d6, 24, 9675},
+	{0x0000060d7, 24, 9676}, {0x0000060d8, 24, 9677}, {0x0000060d9, 24, 9678}, {0x0000060da, 24, 1846},
+	{0x0000060db, 24, 9679}, {0x0000060dc, 24, 9680}, {0x0000060dd, 24, 9681}, {0x0000060de, 24, 9682},
+	{0x0000060df, 24, 127}, {0x0000060e0, 24, 9683}, {0x0000060e1, 24, 9684}, {0x0000060e2, 24, 9685},
+	{0x0000060e3, 24, 9686}, {0x0000060e4, 24, 9687}, {0x0000060e5, 24, 9688}, {0x0000060e6, 24, 9689},
+	{0x0000060e7, 24, 9690}, {0x0000060e8, 24, 9691}, {0x0000060e9, 24, 9692}, {0x0000060ea, 24, 9693},
+	{0x0000060eb, 24, 9694}, {0x0000060ec, 24, 9695}, {0x0000060ed, 24, 9696}, {0x0000060ee, 24, 9697},
+	{0x0000060ef, 24, 9698}, {0x0000060f0, 24, 9699}, {0x0000060f1, 24, 9700}, {0x0000060f2, 24, 9701},
+	{0x0000060f3, 24, 3929}, {0x0000060f4, 24, 9702}, {0x0000060f5, 24, 9703}, {0x0000060f6, 24, 9704},
+	{0x0000060f7, 24, 9705}, {0x0000060f8, 24, 9706}, {0x0000060f9, 24, 9707}, {0x0000060fa, 24, 9708},
+	{0x0000060fb, 24, 9709}, {0x0000060fc, 24, 9710}, {0x0000060fd, 24, 9711}, {0x0000060fe, 24, 9712},
+	{0x0000060ff, 24, 9713}, {0x000006171, 24, 865}, {0x000006440, 24, 3}, {0x0000064a6, 24, 9714},
+	{0x00000664b, 24, 9715}, {0x000006b8e, 24, 9716}, {0x000006b9e, 24, 9717}, {0x000006ba0, 24, 9718},
+	{0x000006dfb, 24, 9719}, {0x0000070b0, 24, 9720}, {0x0000070b3, 24, 9721}, {0x0000071c2, 24, 9722},
+	{0x0000071cc, 24, 6482}, {0x00000738d, 24, 9723}, {0x0000073e0, 24, 1842}, {0x000007532, 24, 9724},
+	{0x0000075e1, 24, 9725}, {0x00000789e, 24, 9007}, {0x000007dfa, 24, 9726}, {0x000007e56, 24, 9727},
+	{0x000007f28, 24, 3726}, {0x000008000, 24, 1922}, {0x000008001, 24, 9728}, {0x000008002, 24, 9729},
+	{0x000008003, 24, 9730}, {0x000008004, 24, 9731}, {0x000008005, 24, 9732}, {0x000008006, 24, 9733},
+	{0x000008007, 24, 9734}, {0x000008008, 24, 9735}, {0x000008009, 24, 9736}, {0x00000800a, 24, 9737},
+	{0x00000800b, 24, 9738}, {0x00000800c, 24, 9739}, {0x00000800d, 24, 9740}, {0x00000800e, 24, 9741},
+	{0x00000800f, 24, 9742}, {0x000008010, 24, 9743}, {0x000008011, 24, 9744}, {0x000008012, 24, 9745},
+	{0x000008013, 24, 9746}, {0x000008014, 24, 9747}, {0x000008015, 24, 9748}, {0x000008016, 24, 9749},
+	{0x000008017, 24, 9750}, {0x000008018, 24, 9751}, {0x000008019, 24, 9752}, {0x00000801a, 24, 9753},
+	{0x00000801b, 24, 9754}, {0x00000801c, 24, 9755}, {0x00000801d, 24, 9756}, {0x00000801e, 24, 9757},
+	{0x00000801f, 24, 9758}, {0x000008020, 24, 9759}, {0x000008021, 24, 9760}, {0x000008022, 24, 9761},
+	{0x000008023, 24, 9762}, {0x000008024, 24, 9763}, {0x000008025, 24, 9764}, {0x000008026, 24, 9765},
+	{0x000008027, 24, 9766}, {0x000008028, 24, 9767}, {0x000008029, 24, 9768}, {0x00000802a, 24, 9769},
+	{0x00000802b, 24, 9770}, {0x00000802c, 24, 9771}, {0x00000802d, 24, 9772}, {0x00000802e, 24, 9773},
+	{0x00000802f, 24, 9774}, {0x000008030, 24, 9775}, {0x000008031, 24, 9776}, {0x000008032, 24, 9777},
+	{0x000008033, 24, 9778}, {0x000008034, 24, 9779}, {0x000008035, 24, 9780}, {0x000008036, 24, 9781},
+	{0x000008037, 24, 464}, {0x000008038, 24, 9782}, {0x000008039, 24, 9783}, {0x00000803a, 24, 9784},
+	{0x00000803b, 24, 9785}, {0x00000803c, 24, 9786}, {0x00000803d, 24, 9787}, {0x00000803e, 24, 9788},
+	{0x00000803f, 24, 9789}, {0x000008040, 24, 9790}, {0x000008041, 24, 9791}, {0x000008042, 24, 406},
+	{0x000008043, 24, 9792}, {0x000008044, 24, 9793}, {0x000008045, 24, 9794}, {0x000008046, 24, 155},
+	{0x000008047, 24, 9795}, {0x000008048, 24, 9796}, {0x000008049, 24, 9797}, {0x00000804a, 24, 9798},
+	{0x00000804b, 24, 9799}, {0x00000804c, 24, 9800}, {0x00000804d, 24, 9801}, {0x00000804e, 24, 9802},
+	{0x00000804f, 24, 9803}, {0x000008050, 24, 9804}, {0x000008051, 24, 9805}, {0x000008052, 24, 9806},
+	{0x000008053, 24, 9807}, {0x000008054, 24, 9808}, {0x000008055, 24, 9809}, {0x000008056, 24, 9810},
+	{0x000008057, 24, 9811}, {0x000008058, 24, 9812}, {0x000008059, 24, 9813}, {0x00000805a, 24, 9814},
+	{0x00000805b, 24, 9815}, {0x00000805c, 24, 9816}, {0x00000805d, 24, 9817}, {0x00000805e, 24, 9818},
+	{0x00000805f, 24, 459}, {0x000008060, 24, 9819}, {0x000008061, 24, 9820}, {0x000008062, 24, 9821},
+	{0x000008063, 24, 9822}, {0x000008064, 24, 9823}, {0x000008065, 24, 9824}, {0x000008066, 24, 9825},
+	{0x000008067, 24, 9826}, {0x000008068, 24, 9827}, {0x000008069, 24, 9828}, {0x00000806a, 24, 9829},
+	{0x00000806b, 24, 9830}, {0x00000806c, 24, 9831}, {0x00000806d, 24, 9832}, {0x00000806e, 24, 9833},
+	{0x00000806f, 24, 9834}, {0x000008070, 24, 9835}, {0x000008071, 24, 9836}, {0x000008072, 24, 9837},
+	{0x000008073, 24, 9838}, {0x000008074, 24, 9839}, {0x000008075, 24, 9840}, {0x000008076, 24, 9841},
+	{0x000008077, 24, 6381}, {0x000008078, 24, 9842}, {0x000008079, 24, 9843}, {0x00000807a, 24, 9844},
+	{0x00000807b, 24, 9845}, {0x00000807c, 24, 9846}, {0x00000807d, 24, 9847}, {0x00000807e, 24, 9848},
+	{0x00000807f, 24, 9849}, {0x000008080, 24, 9850}, {0x000008081, 24, 9851}, {0x000008082, 24, 9852},
+	{0x000008083, 24, 9853}, {0x000008084, 24, 9854}, {0x000008085, 24, 9855}, {0x000008086, 24, 9856},
+	{0x000008087, 24, 9857}, {0x000008088, 24, 9858}, {0x000008089, 24, 9859}, {0x00000808a, 24, 9860},
+	{0x00000808b, 24, 9861}, {0x00000808c, 24, 9862}, {0x00000808d, 24, 9863}, {0x00000808e, 24, 9864},
+	{0x00000808f, 24, 9865}, {0x000008090, 24, 9866}, {0x000008091, 24, 9867}, {0x000008092, 24, 9868},
+	{0x000008093, 24, 9869}, {0x000008094, 24, 9870}, {0x000008095, 24, 9871}, {0x000008096, 24, 9872},
+	{0x000008097, 24, 9873}, {0x000008098, 24, 9874}, {0x000008099, 24, 9875}, {0x00000809a, 24, 9876},
+	{0x00000809b, 24, 9877}, {0x00000809c, 24, 9878}, {0x00000809d, 24, 9879}, {0x00000809e, 24, 9880},
+	{0x00000809f, 24, 9881}, {0x0000080a0, 24, 9882}, {0x0000080a1, 24, 9883}, {0x0000080a2, 24, 9884},
+	{0x0000080a3, 24, 9885}, {0x0000080a4, 24, 9886}, {0x0000080a5, 24, 9887}, {0x0000080a6, 24, 9888},
+	{0x0000080a7, 24, 9889}, {0x0000080a8, 24, 9890}, {0x0000080a9, 24, 9891}, {0x0000080aa, 24, 9892},
+	{0x0000080ab, 24, 9893}, {0x0000080ac, 24, 9894}, {0x0000080ad, 24, 9895}, {0x0000080ae, 24, 9896},
+	{0x0000080af, 24, 9897}, {0x0000080b0, 24, 9898}, {0x0000080b1, 24, 9899}, {0x0000080b2, 24, 9900},
+	{0x0000080b3, 24, 9901}, {0x0000080b4, 24, 9902}, {0x0000080b5, 24, 9903}, {0x0000080b6, 24, 9904},
+	{0x0000080b7, 24, 9905}, {0x0000080b8, 24, 9906}, {0x0000080b9, 24, 9907}, {0x0000080ba, 24, 9908},
+	{0x0000080bb, 24, 9909}, {0x0000080bc, 24, 9910}, {0x0000080bd, 24, 9911}, {0x0000080be, 24, 9912},
+	{0x0000080bf, 24, 9913}, {0x0000080c0, 24, 9914}, {0x0000080c1, 24, 9915}, {0x0000080c2, 24, 9916},
+	{0x0000080c3, 24, 9917}, {0x0000080c4, 24, 9918}, {0x0000080c5, 24, 9919}, {0x0000080c6, 24, 9920},
+	{0x0000080c7, 24, 3944}, {0x0000080c8, 24, 9921}, {0x0000080c9, 24, 9922}, {0x0000080ca, 24, 9923},
+	{0x0000080cb, 24, 9924}, {0x0000080cc, 24, 9925}, {0x0000080cd, 24, 9926}, {0x0000080ce, 24, 9927},
+	{0x0000080cf, 24, 9928}, {0x0000080d0, 24, 9929}, {0x0000080d1, 24, 9930}, {0x0000080d2, 24, 9931},
+	{0x0000080d3, 24, 9932}, {0x0000080d4, 24, 9933}, {0x0000080d5, 24, 9934}, {0x0000080d6, 24, 9935},
+	{0x0000080d7, 24, 9936}, {0x0000080d8, 24, 9937}, {0x0000080d9, 24, 9938}, {0x0000080da, 24, 9939},
+	{0x0000080db, 24, 9940}, {0x0000080dc, 24, 9941}, {0x0000080dd, 24, 9942}, {0x0000080de, 24, 9943},
+	{0x0000080df, 24, 65}, {0x0000080e0, 24, 9944}, {0x0000080e1, 24, 9945}, {0x0000080e2, 24, 9946},
+	{0x0000080e3, 24, 9947}, {0x0000080e4, 24, 9948}, {0x0000080e5, 24, 9949}, {0x0000080e6, 24, 9950},
+	{0x0000080e7, 24, 9951}, {0x0000080e8, 24, 9952}, {0x0000080e9, 24, 102}, {0x0000080ea, 24, 2753},
+	{0x0000080eb, 24, 9953}, {0x0000080ec, 24, 9954}, {0x0000080ed, 24, 9955}, {0x0000080ee, 24, 9956},
+	{0x0000080ef, 24, 9957}, {0x0000080f0, 24, 9958}, {0x0000080f1, 24, 9959}, {0x0000080f2, 24, 9960},
+	{0x0000080f3, 24, 9961}, {0x0000080f4, 24, 9962}, {0x0000080f5, 24, 9963}, {0x0000080f6, 24, 9964},
+	{0x0000080f7, 24, 9965}, {0x0000080f8, 24, 9966}, {0x0000080f9, 24, 9967}, {0x0000080fa, 24, 9968},
+	{0x0000080fb, 24, 9969}, {0x0000080fc, 24, 9970}, {0x0000080fd, 24, 9971}, {0x0000080fe, 24, 9972},
+	{0x0000080ff, 24, 9973}, {0x0000086a0, 24, 99}, {0x000008865, 24, 865}, {0x000008b43, 24, 9974},
+	{0x000008c10, 24, 9975}, {0x000008c54, 24, 1957}, {0x000008cfa, 24, 6920}, {0x000008d4e, 24, 9976},
+	{0x000008dda, 24, 9977}, {0x000008ef2, 24, 9978}, {0x000009000, 24, 9979}, {0x000009001, 24, 9980},
+	{0x000009002, 24, 9981}, {0x000009003, 24, 9982}, {0x000009004, 24, 9983}, {0x000009005, 24, 9984},
+	{0x000009006, 24, 9985}, {0x000009007, 24, 9986}, {0x000009008, 24, 9987}, {0x000009009, 24, 9988},
+	{0x00000900a, 24, 9989}, {0x00000900b, 24, 9990}, {0x00000900c, 24, 3}, {0x00000900d, 24, 9991},
+	{0x00000900e, 24, 9992}, {0x00000900f, 24, 9993}, {0x000009010, 24, 9994}, {0x000009011, 24, 9995},
+	{0x000009012, 24, 9996}, {0x000009013, 24, 9997}, {0x000009014, 24, 9998}, {0x000009015, 24, 9999},
+	{0x000009016, 24, 10000}, {0x000009017, 24, 10001}, {0x000009018, 24, 10002}, {0x000009019, 24, 10003},
+	{0x00000901a, 24, 10004}, {0x00000901b, 24, 10005}, {0x00000901c, 24, 10006}, {0x00000901d, 24, 10007},
+	{0x00000901e, 24, 10008}, {0x00000901f, 24, 10009}, {0x000009020, 24, 10010}, {0x000009021, 24, 3},
+	{0x000009022, 24, 10011}, {0x000009023, 24, 10012}, {0x000009024, 24, 10013}, {0x000009025, 24, 10014},
+	{0x000009026, 24, 10015}, {0x000009027, 24, 10016}, {0x000009028, 24, 10017}, {0x000009029, 24, 10018},
+	{0x00000902a, 24, 10019}, {0x00000902b, 24, 3}, {0x00000902c, 24, 10020}, {0x00000902d, 24, 10021},
+	{0x00000902e, 24, 10022}, {0x00000902f, 24, 10023}, {0x000009030, 24, 10024}, {0x000009031, 24, 10025},
+	{0x000009032, 24, 10026}, {0x000009033, 24, 10027}, {0x000009034, 24, 10028}, {0x000009035, 24, 10029},
+	{0x000009036, 24, 10030}, {0x000009037, 24, 10031}, {0x000009038, 24, 10032}, {0x000009039, 24, 10033},
+	{0x00000903a, 24, 10034}, {0x00000903b, 24, 10035}, {0x00000903c, 24, 10036}, {0x00000903d, 24, 10037},
+	{0x00000903e, 24, 10038}, {0x00000903f, 24, 10039}, {0x000009040, 24, 10040}, {0x000009041, 24, 10041},
+	{0x000009042, 24, 10042}, {0x000009043, 24, 155}, {0x000009044, 24, 10043}, {0x000009045, 24, 7354},
+	{0x000009046, 24, 10044}, {0x000009047, 24, 10045}, {0x000009048, 24, 10046}, {0x000009049, 24, 10047},
+	{0x00000904a, 24, 10048}, {0x00000904b, 24, 7528}, {0x00000904c, 24, 10049}, {0x00000904d, 24, 10050},
+	{0x00000904e, 24, 10051}, {0x00000904f, 24, 10052}, {0x000009050, 24, 10053}, {0x000009051, 24, 10054},
+	{0x000009052, 24, 10055}, {0x000009053, 24, 10056}, {0x000009054, 24, 10057}, {0x000009055, 24, 10058},
+	{0x000009056, 24, 10059}, {0x000009057, 24, 10060}, {0x000009058, 24, 10061}, {0x000009059, 24, 10062},
+	{0x00000905a, 24, 10063}, {0x00000905b, 24, 10064}, {0x00000905c, 24, 10065}, {0x00000905d, 24, 10066},
+	{0x00000905e, 24, 10067}, {0x00000905f, 24, 3}, {0x000009060, 24, 10068}, {0x000009061, 24, 10069},
+	{0x000009062, 24, 10070}, {0x000009063, 24, 10071}, {0x000009064, 24, 3930}, {0x000009065, 24, 10072},
+	{0x000009066, 24, 10073}, {0x000009067, 24, 10074}, {0x000009068, 24, 10075}, {0x000009069, 24, 10076},
+	{0x00000906a, 24, 10077}, {0x00000906b, 24, 10078}, {0x00000906c, 24, 10079}, {0x00000906d, 24, 3},
+	{0x00000906e, 24, 10080}, {0x00000906f, 24, 3}, {0x000009070, 24, 10081}, {0x000009071, 24, 9121},
+	{0x000009072, 24, 10082}, {0x000009073, 24, 10083}, {0x000009074, 24, 10084}, {0x000009075, 24, 10085},
+	{0x000009076, 24, 10086}, {0x000009077, 24, 10087}, {0x000009078, 24, 10088}, {0x000009079, 24, 10089},
+	{0x00000907a, 24, 10090}, {0x00000907b, 24, 10091}, {0x00000907c, 24, 10092}, {0x00000907d, 24, 10093},
+	{0x00000907e, 24, 10094}, {0x00000907f, 24, 10095}, {0x000009080, 24, 10096}, {0x000009081, 24, 10097},
+	{0x000009082, 24, 10098}, {0x000009083, 24, 10099}, {0x000009084, 24, 10100}, {0x000009085, 24, 10101},
+	{0x000009086, 24, 3}, {0x000009087, 24, 10102}, {0x000009088, 24, 10103}, {0x000009089, 24, 10104},
+	{0x00000908a, 24, 10105}, {0x00000908b, 24, 155}, {0x00000908c, 24, 10106}, {0x00000908d, 24, 10107},
+	{0x00000908e, 24, 10108}, {0x00000908f, 24, 10109}, {0x000009090, 24, 10110}, {0x000009091, 24, 10111},
+	{0x000009092, 24, 3}, {0x000009093, 24, 10112}, {0x000009094, 24, 10113}, {0x000009095, 24, 10114},
+	{0x000009096, 24, 4244}, {0x000009097, 24, 7366}, {0x000009098, 24, 10115}, {0x000009099, 24, 10116},
+	{0x00000909a, 24, 10117}, {0x00000909b, 24, 10118}, {0x00000909c, 24, 185}, {0x00000909d, 24, 10119},
+	{0x00000909e, 24, 10120}, {0x00000909f, 24, 10121}, {0x0000090a0, 24, 10122}, {0x0000090a1, 24, 10123},
+	{0x0000090a2, 24, 10124}, {0x0000090a3, 24, 10125}, {0x0000090a4, 24, 10126}, {0x0000090a5, 24, 10127},
+	{0x0000090a6, 24, 3}, {0x0000090a7, 24, 10128}, {0x0000090a8, 24, 10129}, {0x0000090a9, 24, 10130},
+	{0x0000090aa, 24, 10131}, {0x0000090ab, 24, 3}, {0x0000090ac, 24, 10132}, {0x0000090ad, 24, 10133},
+	{0x0000090ae, 24, 10134}, {0x0000090af, 24, 10135}, {0x0000090b0, 24, 10136}, {0x0000090b1, 24, 3},
+	{0x0000090b2, 24, 10137}, {0x0000090b3, 24, 10138}, {0x0000090b4, 24, 10139}, {0x0000090b5, 24, 10140},
+	{0x0000090b6, 24, 10141}, {0x0000090b7, 24, 10142}, {0x0000090b8, 24, 10143}, {0x0000090b9, 24, 10144},
+	{0x0000090ba, 24, 10145}, {0x0000090bb, 24, 10146}, {0x0000090bc, 24, 10147}, {0x0000090bd, 24, 10148},
+	{0x0000090be, 24, 10149}, {0x0000090bf, 24, 3}, {0x0000090c0, 24, 10150}, {0x0000090c1, 24, 10151},
+	{0x0000090c2, 24, 10152}, {0x0000090c3, 24, 10153}, {0x0000090c4, 24, 10154}, {0x0000090c5, 24, 10155},
+	{0x0000090c6, 24, 10156}, {0x0000090c7, 24, 10157}, {0x0000090c8, 24, 10158}, {0x0000090c9, 24, 10159},
+	{0x0000090ca, 24, 10160}, {0x0000090cb, 24, 10161}, {0x0000090cc, 24, 10162}, {0x0000090cd, 24, 10163},
+	{0x0000090ce, 24, 10164}, {0x0000090cf, 24, 10165}, {0x0000090d0, 24, 3404}, {0x0000090d1, 24, 10166},
+	{0x0000090d2, 24, 10167}, {0x0000090d3, 24, 10168}, {0x0000090d4, 24, 10169}, {0x0000090d5, 24, 10170},
+	{0x0000090d6, 24, 10171}, {0x0000090d7, 24, 10172}, {0x0000090d8, 24, 10173}, {0x0000090d9, 24, 3},
+	{0x0000090da, 24, 10174}, {0x0000090db, 24, 10175}, {0x0000090dc, 24, 10176}, {0x0000090dd, 24, 10177},
+	{0x0000090de, 24, 10178}, {0x0000090df, 24, 10179}, {0x0000090e0, 24, 10180}, {0x0000090e1, 24, 10181},
+	{0x0000090e2, 24, 10182}, {0x0000090e3, 24, 10183}, {0x0000090e4, 24, 10184}, {0x0000090e5, 24, 10185},
+	{0x0000090e6, 24, 10186}, {0x0000090e7, 24, 10187}, {0x0000090e8, 24, 10188}, {0x0000090e9, 24, 10189},
+	{0x0000090ea, 24, 10190}, {0x0000090eb, 24, 10191}, {0x0000090ec, 24, 10192}, {0x0000090ed, 24, 10193},
+	{0x0000090ee, 24, 10194}, {0x0000090ef, 24, 10195}, {0x0000090f0, 24, 10196}, {0x0000090f1, 24, 10197},
+	{0x0000090f2, 24, 3}, {0x0000090f3, 24, 10198}, {0x0000090f4, 24, 10199}, {0x0000090f5, 24, 10200},
+	{0x0000090f6, 24, 10201}, {0x0000090f7, 24, 10202}, {0x0000090f8, 24, 10203}, {0x0000090f9, 24, 10204},
+	{0x0000090fa, 24, 189}, {0x0000090fb, 24, 10205}, {0x0000090fc, 24, 10206}, {0x0000090fd, 24, 10207},
+	{0x0000090fe, 24, 10208}, {0x0000090ff, 24, 10209}, {0x0000091d6, 24, 10210}, {0x0000091fa, 24, 10211},
+	{0x0000092fa, 24, 10212}, {0x000009363, 24, 10213}, {0x000009569, 24, 10214}, {0x0000097ff, 24, 10215},
+	{0x000009c02, 24, 459}, {0x000009d8e, 24, 10216}, {0x000009ec8, 24, 10217}, {0x00000a000, 24, 10218},
+	{0x00000a001, 24, 10219}, {0x00000a002, 24, 10220}, {0x00000a003, 24, 10221}, {0x00000a004, 24, 10222},
+	{0x00000a005, 24, 10223}, {0x00000a006, 24, 10224}, {0x00000a007, 24, 10225}, {0x00000a008, 24, 10226},
+	{0x00000a009, 24, 10227}, {0x00000a00a, 24, 10228}, {0x00000a00b, 24, 10229}, {0x00000a00c, 24, 10230},
+	{0x00000a00d, 24, 10231}, {0x00000a00e, 24, 10232}, {0x00000a00f, 24, 10233}, {0x00000a010, 24, 10234},
+	{0x00000a011, 24, 10235}, {0x00000a012, 24, 22}, {0x00000a013, 24, 10236}, {0x00000a014, 24, 10237},
+	{0x00000a015, 24, 10238}, {0x00000a016, 24, 10239}, {0x00000a017, 24, 10240}, {0x00000a018, 24, 10241},
+	{0x00000a019, 24, 10242}, {0x00000a01a, 24, 10243}, {0x00000a01b, 24, 10244}, {0x00000a01c, 24, 10245},
+	{0x00000a01d, 24, 1846}, {0x00000a01e, 24, 10246}, {0x00000a01f, 24, 10247}, {0x00000a020, 24, 10248},
+	{0x00000a021, 24, 10249}, {0x00000a022, 24, 10250}, {0x00000a023, 24, 10251}, {0x00000a024, 24, 244},
+	{0x00000a025, 24, 10252}, {0x00000a026, 24, 10253}, {0x00000a027, 24, 10254}, {0x00000a028, 24, 10255},
+	{0x00000a029, 24, 10256}, {0x00000a02a, 24, 10257}, {0x00000a02b, 24, 10258}, {0x00000a02c, 24, 10259},
+	{0x00000a02d, 24, 10260}, {0x00000a02e, 24, 10261}, {0x00000a02f, 24, 10262}, {0x00000a030, 24, 10263},
+	{0x00000a031, 24, 10264}, {0x00000a032, 24, 10265}, {0x00000a033, 24, 10266}, {0x00000a034, 24, 10267},
+	{0x00000a035, 24, 10268}, {0x00000a036, 24, 10269}, {0x00000a037, 24, 10270}, {0x00000a038, 24, 10271},
+	{0x00000a039, 24, 10272}, {0x00000a03a, 24, 10273}, {0x00000a03b, 24, 10274}, {0x00000a03c, 24, 10275},
+	{0x00000a03d, 24, 10276}, {0x00000a03e, 24, 10277}, {0x00000a03f, 24, 10278}, {0x00000a040, 24, 865},
+	{0x00000a041, 24, 10279}, {0x00000a042, 24, 10280}, {0x00000a043, 24, 10281}, {0x00000a044, 24, 10282},
+	{0x00000a045, 24, 10283}, {0x00000a046, 24, 10284}, {0x00000a047, 24, 10285}, {0x00000a048, 24, 10286},
+	{0x00000a049, 24, 10287}, {0x00000a04a, 24, 10288}, {0x00000a04b, 24, 10289}, {0x00000a04c, 24, 10290},
+	{0x00000a04d, 24, 10291}, {0x00000a04e, 24, 10292}, {0x00000a04f, 24, 10293}, {0x00000a050, 24, 10294},
+	{0x00000a051, 24, 10295}, {0x00000a052, 24, 10296}, {0x00000a053, 24, 10297}, {0x00000a054, 24, 99},
+	{0x00000a055, 24, 10298}, {0x00000a056, 24, 10299}, {0x00000a057, 24, 10300}, {0x00000a058, 24, 10301},
+	{0x00000a059, 24, 10302}, {0x00000a05a, 24, 10303}, {0x00000a05b, 24, 10304}, {0x00000a05c, 24, 10305},
+	{0x00000a05d, 24, 10306}, {0x00000a05e, 24, 10307}, {0x00000a05f, 24, 10308}, {0x00000a060, 24, 10309},
+	{0x00000a061, 24, 10310}, {0x00000a062, 24, 10311}, {0x00000a063, 24, 10312}, {0x00000a064, 24, 10313},
+	{0x00000a065, 24, 3293}, {0x00000a066, 24, 10314}, {0x00000a067, 24, 10315}, {0x00000a068, 24, 10316},
+	{0x00000a069, 24, 10317}, {0x00000a06a, 24, 7363}, {0x00000a06b, 24, 10318}, {0x00000a06c, 24, 10319},
+	{0x00000a06d, 24, 10320}, {0x00000a06e, 24, 10321}, {0x00000a06f, 24, 10322}, {0x00000a070, 24, 10323},
+	{0x00000a071, 24, 10324}, {0x00000a072, 24, 10325}, {0x00000a073, 24, 10326}, {0x00000a074, 24, 10327},
+	{0x00000a075, 24, 10328}, {0x00000a076, 24, 10329}, {0x00000a077, 24, 10330}, {0x00000a078, 24, 7354},
+	{0x00000a079, 24, 10331}, {0x00000a07a, 24, 10332}, {0x00000a07b, 24, 10333}, {0x00000a07c, 24, 10334},
+	{0x00000a07d, 24, 10335}, {0x00000a07e, 24, 10336}, {0x00000a07f, 24, 10337}, {0x00000a080, 24, 155},
+	{0x00000a081, 24, 10338}, {0x00000a082, 24, 10339}, {0x00000a083, 24, 10340}, {0x00000a084, 24, 10341},
+	{0x00000a085, 24, 99}, {0x00000a086, 24, 10342}, {0x00000a087, 24, 10343}, {0x00000a088, 24, 10344},
+	{0x00000a089, 24, 10345}, {0x00000a08a, 24, 10346}, {0x00000a08b, 24, 10347}, {0x00000a08c, 24, 10348},
+	{0x00000a08d, 24, 10349}, {0x00000a08e, 24, 4430}, {0x00000a08f, 24, 10350}, {0x00000a090, 24, 10351},
+	{0x00000a091, 24, 10352}, {0x00000a092, 24, 10353}, {0x00000a093, 24, 10354}, {0x00000a094, 24, 10355},
+	{0x00000a095, 24, 10356}, {0x00000a096, 24, 10357}, {0x00000a097, 24, 10358}, {0x00000a098, 24, 10359},
+	{0x00000a099, 24, 10360}, {0x00000a09a, 24, 10361}, {0x00000a09b, 24, 10362}, {0x00000a09c, 24, 10363},
+	{0x00000a09d, 24, 10364}, {0x00000a09e, 24, 10365}, {0x00000a09f, 24, 10366}, {0x00000a0a0, 24, 10367},
+	{0x00000a0a1, 24, 10368}, {0x00000a0a2, 24, 10369}, {0x00000a0a3, 24, 10370}, {0x00000a0a4, 24, 10371},
+	{0x00000a0a5, 24, 10372}, {0x00000a0a6, 24, 10373}, {0x00000a0a7, 24, 10374}, {0x00000a0a8, 24, 10375},
+	{0x00000a0a9, 24, 10376}, {0x00000a0aa, 24, 10377}, {0x00000a0ab, 24, 10378}, {0x00000a0ac, 24, 10379},
+	{0x00000a0ad, 24, 10380}, {0x00000a0ae, 24, 10381}, {0x00000a0af, 24, 10382}, {0x00000a0b0, 24, 10383},
+	{0x00000a0b1, 24, 10384}, {0x00000a0b2, 24, 10385}, {0x00000a0b3, 24, 10386}, {0x00000a0b4, 24, 10387},
+	{0x00000a0b5, 24, 10388}, {0x00000a0b6, 24, 10389}, {0x00000a0b7, 24, 10390}, {0x00000a0b8, 24, 10391},
+	{0x00000a0b9, 24, 9768}, {0x00000a0ba, 24, 10392}, {0x00000a0bb, 24, 10393}, {0x00000a0bc, 24, 10394},
+	{0x00000a0bd, 24, 10395}, {0x00000a0be, 24, 10396}, {0x00000a0bf, 24, 10397}, {0x00000a0c0, 24, 10398},
+	{0x00000a0c1, 24, 10399}, {0x00000a0c2, 24, 10400}, {0x00000a0c3, 24, 10401}, {0x00000a0c4, 24, 10402},
+	{0x00000a0c5, 24, 4551}, {0x00000a0c6, 24, 10403}, {0x00000a0c7, 24, 10404}, {0x00000a0c8, 24, 5929},
+	{0x00000a0c9, 24, 10405}, {0x00000a0ca, 24, 10406}, {0x00000a0cb, 24, 10407}, {0x00000a0cc, 24, 10408},
+	{0x00000a0cd, 24, 10409}, {0x00000a0ce, 24, 10410}, {0x00000a0cf, 24, 10411}, {0x00000a0d0, 24, 10412},
+	{0x00000a0d1, 24, 10413}, {0x00000a0d2, 24, 10414}, {0x00000a0d3, 24, 10415}, {0x00000a0d4, 24, 10416},
+	{0x00000a0d5, 24, 10417}, {0x00000a0d6, 24, 198}, {0x00000a0d7, 24, 10418}, {0x00000a0d8, 24, 10419},
+	{0x00000a0d9, 24, 10420}, {0x00000a0da, 24, 10421}, {0x00000a0db, 24, 10422}, {0x00000a0dc, 24, 10423},
+	{0x00000a0dd, 24, 10424}, {0x00000a0de, 24, 10425}, {0x00000a0df, 24, 10426}, {0x00000a0e0, 24, 10427},
+	{0x00000a0e1, 24, 10428}, {0x00000a0e2, 24, 10429}, {0x00000a0e3, 24, 10430}, {0x00000a0e4, 24, 10431},
+	{0x00000a0e5, 24, 10432}, {0x00000a0e6, 24, 10433}, {0x00000a0e7, 24, 10434}, {0x00000a0e8, 24, 10435},
+	{0x00000a0e9, 24, 10436}, {0x00000a0ea, 24, 10437}, {0x00000a0eb, 24, 10438}, {0x00000a0ec, 24, 10439},
+	{0x00000a0ed, 24, 10440}, {0x00000a0ee, 24, 10441}, {0x00000a0ef, 24, 10442}, {0x00000a0f0, 24, 10443},
+	{0x00000a0f1, 24, 10444}, {0x00000a0f2, 24, 10445}, {0x00000a0f3, 24, 10446}, {0x00000a0f4, 24, 10447},
+	{0x00000a0f5, 24, 10448}, {0x00000a0f6, 24, 10449}, {0x00000a0f7, 24, 10450}, {0x00000a0f8, 24, 1217},
+	{0x00000a0f9, 24, 10451}, {0x00000a0fa, 24, 10452}, {0x00000a0fb, 24, 10453}, {0x00000a0fc, 24, 10454},
+	{0x00000a0fd, 24, 10455}, {0x00000a0fe, 24, 10456}, {0x00000a0ff, 24, 10457}, {0x00000a1de, 24, 10458},
+	{0x00000a2da, 24, 10459}, {0x00000a2f5, 24, 10460}, {0x00000a2ff, 24, 10461}, {0x00000a509, 24, 10462},
+	{0x00000a784, 24, 10463}, {0x00000aa00, 24, 10016}, {0x00000aa01, 24, 10016}, {0x00000aa02, 24, 10016},
+	{0x00000aa3c, 24, 10464}, {0x00000aa70, 24, 6967}, {0x00000ace0, 24, 185}, {0x00000aefa, 24, 3430},
+	{0x00000b009, 24, 10465}, {0x00000b017, 24, 10466}, {0x00000b019, 24, 2126}, {0x00000b01c, 24, 10467},
+	{0x00000b01e, 24, 10468}, {0x00000b02a, 24, 10469}, {0x00000b02d, 24, 10470}, {0x00000b033, 24, 10471},
+	{0x00000b03b, 24, 10472}, {0x00000b048, 24, 10473}, {0x00000b04a, 24, 3}, {0x00000b052, 24, 10474},
+	{0x00000b064, 24, 3}, {0x00000b069, 24, 10475}, {0x00000b06d, 24, 10476}, {0x00000b080, 24, 10477},
+	{0x00000b086, 24, 10478}, {0x00000b08e, 24, 3}, {0x00000b091, 24, 10479}, {0x00000b094, 24, 10480},
+	{0x00000b09a, 24, 10481}, {0x00000b09d, 24, 10482}, {0x00000b0ac, 24, 10483}, {0x00000b0ae, 24, 10484},
+	{0x00000b0b3, 24, 3916}, {0x00000b0c2, 24, 3}, {0x00000b0c7, 24, 10485}, {0x00000b0ce, 24, 10486},
+	{0x00000b0d0, 24, 1522}, {0x00000b0db, 24, 10487}, {0x00000b0df, 24, 10488}, {0x00000b0e7, 24, 10489},
+	{0x00000b0ec, 24, 10490}, {0x00000b0ee, 24, 10491}, {0x00000b0f0, 24, 10492}, {0x00000b0f5, 24, 10493},
+	{0x00000b338, 24, 10494}, {0x00000b342, 24, 10495}, {0x00000b56d, 24, 10496}, {0x00000b5d6, 24, 10497},
+	{0x00000b78d, 24, 10498}, {0x00000b9f6, 24, 10499}, {0x00000bac0, 24, 10500}, {0x00000bb01, 24, 10501},
+	{0x00000bb3a, 24, 99}, {0x00000bb8e, 24, 10502}, {0x00000bbf0, 24, 10503}, {0x00000bd27, 24, 10504},
+	{0x00000bd3a, 24, 5842}, {0x00000bf15, 24, 10505}, {0x00000c000, 24, 10506}, {0x00000c001, 24, 10507},
+	{0x00000c002, 24, 10508}, {0x00000c003, 24, 10509}, {0x00000c004, 24, 10510}, {0x00000c005, 24, 10511},
+	{0x00000c006, 24, 10512}, {0x00000c007, 24, 10513}, {0x00000c008, 24, 10514}, {0x00000c009, 24, 10515},
+	{0x00000c00a, 24, 10516}, {0x00000c00b, 24, 10517}, {0x00000c00c, 24, 10518}, {0x00000c00d, 24, 10519},
+	{0x00000c00e, 24, 10520}, {0x00000c00f, 24, 10521}, {0x00000c010, 24, 10522}, {0x00000c011, 24, 10523},
+	{0x00000c012, 24, 10524}, {0x00000c013, 24, 10525}, {0x00000c014, 24, 10526}, {0x00000c015, 24, 10527},
+	{0x00000c016, 24, 10528}, {0x00000c017, 24, 10529}, {0x00000c018, 24, 10530}, {0x00000c019, 24, 10531},
+	{0x00000c01a, 24, 10532}, {0x00000c01b, 24, 10533}, {0x00000c01c, 24, 10534}, {0x00000c01d, 24, 10535},
+	{0x00000c01e, 24, 10536}, {0x00000c01f, 24, 10537}, {0x00000c020, 24, 10538}, {0x00000c021, 24, 10539},
+	{0x00000c022, 24, 10540}, {0x00000c023, 24, 10541}, {0x00000c024, 24, 10542}, {0x00000c025, 24, 10543},
+	{0x00000c026, 24, 10544}, {0x00000c027, 24, 10545}, {0x00000c028, 24, 10546}, {0x00000c029, 24, 10547},
+	{0x00000c02a, 24, 10548}, {0x00000c02b, 24, 10549}, {0x00000c02c, 24, 10550}, {0x00000c02d, 24, 10551},
+	{0x00000c02e, 24, 10552}, {0x00000c02f, 24, 10553}, {0x00000c030, 24, 10554}, {0x00000c031, 24, 10555},
+	{0x00000c032, 24, 10556}, {0x00000c033, 24, 10557}, {0x00000c034, 24, 10558}, {0x00000c035, 24, 10559},
+	{0x00000c036, 24, 10560}, {0x00000c037, 24, 10561}, {0x00000c038, 24, 10562}, {0x00000c039, 24, 10563},
+	{0x00000c03a, 24, 10564}, {0x00000c03b, 24, 10565}, {0x00000c03c, 24, 10566}, {0x00000c03d, 24, 10567},
+	{0x00000c03e, 24, 10568}, {0x00000c03f, 24, 10569}, {0x00000c040, 24, 10570}, {0x00000c041, 24, 10571},
+	{0x00000c042, 24, 10572}, {0x00000c043, 24, 10573}, {0x00000c044, 24, 10574}, {0x00000c045, 24, 10575},
+	{0x00000c046, 24, 10576}, {0x00000c047, 24, 10577}, {0x00000c048, 24, 10578}, {0x00000c049, 24, 10579},
+	{0x00000c04a, 24, 10580}, {0x00000c04b, 24, 10581}, {0x00000c04c, 24, 10582}, {0x00000c04d, 24, 10583},
+	{0x00000c04e, 24, 10584}, {0x00000c04f, 24, 10585}, {0x00000c050, 24, 10586}, {0x00000c051, 24, 10587},
+	{0x00000c052, 24, 10588}, {0x00000c053, 24, 10589}, {0x00000c054, 24, 10590}, {0x00000c055, 24, 10591},
+	{0x00000c056, 24, 10592}, {0x00000c057, 24, 10593}, {0x00000c058, 24, 10594}, {0x00000c059, 24, 10595},
+	{0x00000c05a, 24, 10596}, {0x00000c05b, 24, 10597}, {0x00000c05c, 24, 10598}, {0x00000c05d, 24, 10599},
+	{0x00000c05e, 24, 10600}, {0x00000c05f, 24, 10601}, {0x00000c060, 24, 10602}, {0x00000c061, 24, 10603},
+	{0x00000c062, 24, 10604}, {0x00000c063, 24, 10605}, {0x00000c064, 24, 10606}, {0x00000c065, 24, 10607},
+	{0x00000c066, 24, 10608}, {0x00000c067, 24, 10609}, {0x00000c068, 24, 3469}, {0x00000c069, 24, 3892},
+	{0x00000c06a, 24, 10610}, {0x00000c06b, 24, 10611}, {0x00000c06c, 24, 10612}, {0x00000c06d, 24, 10613},
+	{0x00000c06e, 24, 10614}, {0x00000c06f, 24, 10615}, {0x00000c070, 24, 10616}, {0x00000c071, 24, 10617},
+	{0x00000c072, 24, 10618}, {0x00000c073, 24, 10619}, {0x00000c074, 24, 10620}, {0x00000c075, 24, 10621},
+	{0x00000c076, 24, 10622}, {0x00000c077, 24, 10623}, {0x00000c078, 24, 10624}, {0x00000c079, 24, 10625},
+	{0x00000c07a, 24, 10626}, {0x00000c07b, 24, 10627}, {0x00000c07c, 24, 10628}, {0x00000c07d, 24, 10629},
+	{0x00000c07e, 24, 10630}, {0x00000c07f, 24, 10631}, {0x00000c080, 24, 10632}, {0x00000c081, 24, 10633},
+	{0x00000c082, 24, 10634}, {0x00000c083, 24, 10635}, {0x00000c084, 24, 10636}, {0x00000c085, 24, 10637},
+	{0x00000c086, 24, 10638}, {0x00000c087, 24, 10639}, {0x00000c088, 24, 10640}, {0x00000c089, 24, 10641},
+	{0x00000c08a, 24, 10642}, {0x00000c08b, 24, 10643}, {0x00000c08c, 24, 10644}, {0x00000c08d, 24, 10645},
+	{0x00000c08e, 24, 10646}, {0x00000c08f, 24, 9315}, {0x00000c090, 24, 10647}, {0x00000c091, 24, 10648},
+	{0x00000c092, 24, 10649}, {0x00000c093, 24, 10650}, {0x00000c094, 24, 10651}, {0x00000c095, 24, 10652},
+	{0x00000c096, 24, 10653}, {0x00000c097, 24, 10654}, {0x00000c098, 24, 10655}, {0x00000c099, 24, 10656},
+	{0x00000c09a, 24, 10657}, {0x00000c09b, 24, 10658}, {0x00000c09c, 24, 337}, {0x00000c09d, 24, 10659},
+	{0x00000c09e, 24, 10660}, {0x00000c09f, 24, 10661}, {0x00000c0a0, 24, 10662}, {0x00000c0a1, 24, 10663},
+	{0x00000c0a2, 24, 10664}, {0x00000c0a3, 24, 10665}, {0x00000c0a4, 24, 10666}, {0x00000c0a5, 24, 10667},
+	{0x00000c0a6, 24, 10668}, {0x00000c0a7, 24, 10669}, {0x00000c0a8, 24, 10670}, {0x00000c0a9, 24, 10671},
+	{0x00000c0aa, 24, 10672}, {0x00000c0ab, 24, 22}, {0x00000c0ac, 24, 10673}, {0x00000c0ad, 24, 10674},
+	{0x00000c0ae, 24, 10675}, {0x00000c0af, 24, 10676}, {0x00000c0b0, 24, 10677}, {0x00000c0b1, 24, 10678},
+	{0x00000c0b2, 24, 10679}, {0x00000c0b3, 24, 10680}, {0x00000c0b4, 24, 10681}, {0x00000c0b5, 24, 10682},
+	{0x00000c0b6, 24, 10683}, {0x00000c0b7, 24, 10684}, {0x00000c0b8, 24, 10685}, {0x00000c0b9, 24, 10686},
+	{0x00000c0ba, 24, 10687}, {0x00000c0bb, 24, 10688}, {0x00000c0bc, 24, 10689}, {0x00000c0bd, 24, 10690},
+	{0x00000c0be, 24, 10691}, {0x00000c0bf, 24, 10692}, {0x00000c0c0, 24, 10693}, {0x00000c0c1, 24, 10694},
+	{0x00000c0c2, 24, 10695}, {0x00000c0c3, 24, 10696}, {0x00000c0c4, 24, 10697}, {0x00000c0c5, 24, 10698},
+	{0x00000c0c6, 24, 10699}, {0x00000c0c7, 24, 10700}, {0x00000c0c8, 24, 10701}, {0x00000c0c9, 24, 10702},
+	{0x00000c0ca, 24, 10703}, {0x00000c0cb, 24, 10704}, {0x00000c0cc, 24, 10705}, {0x00000c0cd, 24, 10706},
+	{0x00000c0ce, 24, 10707}, {0x00000c0cf, 24, 10708}, {0x00000c0d0, 24, 10709}, {0x00000c0d1, 24, 10710},
+	{0x00000c0d2, 24, 10711}, {0x00000c0d3, 24, 10712}, {0x00000c0d4, 24, 10713}, {0x00000c0d5, 24, 10714},
+	{0x00000c0d6, 24, 10715}, {0x00000c0d7, 24, 10716}, {0x00000c0d8, 24, 10717}, {0x00000c0d9, 24, 10718},
+	{0x00000c0da, 24, 10719}, {0x00000c0db, 24, 10720}, {0x00000c0dc, 24, 10721}, {0x00000c0dd, 24, 3360},
+	{0x00000c0de, 24, 10722}, {0x00000c0df, 24, 10723}, {0x00000c0e0, 24, 10724}, {0x00000c0e1, 24, 10725},
+	{0x00000c0e2, 24, 10726}, {0x00000c0e3, 24, 10727}, {0x00000c0e4, 24, 10728}, {0x00000c0e5, 24, 10729},
+	{0x00000c0e6, 24, 7363}, {0x00000c0e7, 24, 10730}, {0x00000c0e8, 24, 10731}, {0x00000c0e9, 24, 10732},
+	{0x00000c0ea, 24, 10733}, {0x00000c0eb, 24, 10734}, {0x00000c0ec, 24, 10735}, {0x00000c0ed, 24, 10736},
+	{0x00000c0ee, 24, 10737}, {0x00000c0ef, 24, 10738}, {0x00000c0f0, 24, 10739}, {0x00000c0f1, 24, 10740},
+	{0x00000c0f2, 24, 10741}, {0x00000c0f3, 24, 10742}, {0x00000c0f4, 24, 10743}, {0x00000c0f5, 24, 10744},
+	{0x00000c0f6, 24, 10745}, {0x00000c0f7, 24, 10746}, {0x00000c0f8, 24, 10747}, {0x00000c0f9, 24, 406},
+	{0x00000c0fa, 24, 10748}, {0x00000c0fb, 24, 10749}, {0x00000c0fc, 24, 10750}, {0x00000c0fd, 24, 10751},
+	{0x00000c0fe, 24, 10752}, {0x00000c0ff, 24, 10197}, {0x00000c14f, 24, 10753}, {0x00000c2c6, 24, 4472},
+	{0x00000c5db, 24, 10754}, {0x00000c610, 24, 865}, {0x00000cbbd, 24, 10755}, {0x00000cd90, 24, 10756},
+	{0x00000cf1c, 24, 10757}, {0x00000d000, 24, 10758}, {0x00000d001, 24, 10759}, {0x00000d002, 24, 10760},
+	{0x00000d003, 24, 10761}, {0x00000d004, 24, 10762}, {0x00000d005, 24, 10763}, {0x00000d006, 24, 3},
+	{0x00000d007, 24, 10764}, {0x00000d008, 24, 10765}, {0x00000d009, 24, 10766}, {0x00000d00a, 24, 10767},
+	{0x00000d00b, 24, 10768}, {0x00000d00c, 24, 10769}, {0x00000d00d, 24, 10770}, {0x00000d00e, 24, 10771},
+	{0x00000d00f, 24, 10772}, {0x00000d010, 24, 10773}, {0x00000d011, 24, 10774}, {0x00000d012, 24, 10775},
+	{0x00000d013, 24, 10776}, {0x00000d014, 24, 10777}, {0x00000d015, 24, 10778}, {0x00000d016, 24, 10779},
+	{0x00000d017, 24, 10780}, {0x00000d018, 24, 10781}, {0x00000d019, 24, 10782}, {0x00000d01a, 24, 10783},
+	{0x00000d01b, 24, 10784}, {0x00000d01c, 24, 10785}, {0x00000d01d, 24, 10786}, {0x00000d01e, 24, 10787},
+	{0x00000d01f, 24, 10788}, {0x00000d020, 24, 10789}, {0x00000d021, 24, 10790}, {0x00000d022, 24, 10791},
+	{0x00000d023, 24, 10792}, {0x00000d024, 24, 10793}, {0x00000d025, 24, 10794}, {0x00000d026, 24, 10795},
+	{0x00000d027, 24, 10796}, {0x00000d028, 24, 2480}, {0x00000d029, 24, 10797}, {0x00000d02a, 24, 10798},
+	{0x00000d02b, 24, 10799}, {0x00000d02c, 24, 10800}, {0x00000d02d, 24, 10801}, {0x00000d02e, 24, 10802},
+	{0x00000d02f, 24, 10803}, {0x00000d030, 24, 10804}, {0x00000d031, 24, 10805}, {0x00000d032, 24, 10806},
+	{0x00000d033, 24, 10807}, {0x00000d034, 24, 10808}, {0x00000d035, 24, 10809}, {0x00000d036, 24, 10810},
+	{0x00000d037, 24, 10811}, {0x00000d038, 24, 10812}, {0x00000d039, 24, 10813}, {0x00000d03a, 24, 10814},
+	{0x00000d03b, 24, 10815}, {0x00000d03c, 24, 10816}, {0x00000d03d, 24, 10817}, {0x00000d03e, 24, 10818},
+	{0x00000d03f, 24, 10819}, {0x00000d040, 24, 10820}, {0x00000d041, 24, 10821}, {0x00000d042, 24, 10822},
+	{0x00000d043, 24, 10823}, {0x00000d044, 24, 10824}, {0x00000d045, 24, 10825}, {0x00000d046, 24, 10826},
+	{0x00000d047, 24, 10827}, {0x00000d048, 24, 10828}, {0x00000d049, 24, 10829}, {0x00000d04a, 24, 10830},
+	{0x00000d04b, 24, 10831}, {0x00000d04c, 24, 10832}, {0x00000d04d, 24, 10833}, {0x00000d04e, 24, 10834},
+	{0x00000d04f, 24, 10835}, {0x00000d050, 24, 10836}, {0x00000d051, 24, 10837}, {0x00000d052, 24, 10627},
+	{0x00000d053, 24, 10838}, {0x00000d054, 24, 10839}, {0x00000d055, 24, 10840}, {0x00000d056, 24, 10841},
+	{0x00000d057, 24, 10842}, {0x00000d058, 24, 3}, {0x00000d059, 24, 10843}, {0x00000d05a, 24, 10844},
+	{0x00000d05b, 24, 10845}, {0x00000d05c, 24, 10846}, {0x00000d05d, 24, 10847}, {0x00000d05e, 24, 10848},
+	{0x00000d05f, 24, 10849}, {0x00000d060, 24, 3581}, {0x00000d061, 24, 10850}, {0x00000d062, 24, 10851},
+	{0x00000d063, 24, 3}, {0x00000d064, 24, 10852}, {0x00000d065, 24, 10853}, {0x00000d066, 24, 10854},
+	{0x00000d067, 24, 10855}, {0x00000d068, 24, 10856}, {0x00000d069, 24, 10857}, {0x00000d06a, 24, 10858},
+	{0x00000d06b, 24, 10859}, {0x00000d06c, 24, 10860}, {0x00000d06d, 24, 10861}, {0x00000d06e, 24, 10862},
+	{0x00000d06f, 24, 10863}, {0x00000d070, 24, 10864}, {0x00000d071, 24, 10865}, {0x00000d072, 24, 10866},
+	{0x00000d073, 24, 10867}, {0x00000d074, 24, 10868}, {0x00000d075, 24, 10869}, {0x00000d076, 24, 10870},
+	{0x00000d077, 24, 8866}, {0x00000d078, 24, 10871}, {0x00000d079, 24, 3}, {0x00000d07a, 24, 10872},
+	{0x00000d07b, 24, 10873}, {0x00000d07c, 24, 10874}, {0x00000d07d, 24, 10875}, {0x00000d07e, 24, 10876},
+	{0x00000d07f, 24, 10877}, {0x00000d080, 24, 10878}, {0x00000d081, 24, 10879}, {0x00000d082, 24, 10880},
+	{0x00000d083, 24, 10881}, {0x00000d084, 24, 10882}, {0x00000d085, 24, 10883}, {0x00000d086, 24, 10884},
+	{0x00000d087, 24, 10885}, {0x00000d088, 24, 185}, {0x00000d089, 24, 10886}, {0x00000d08a, 24, 10887},
+	{0x00000d08b, 24, 2753}, {0x00000d08c, 24, 10888}, {0x00000d08d, 24, 10889}, {0x00000d08e, 24, 10890},
+	{0x00000d08f, 24, 10891}, {0x00000d090, 24, 3}, {0x00000d091, 24, 10892}, {0x00000d092, 24, 10893},
+	{0x00000d093, 24, 10894}, {0x00000d094, 24, 10895}, {0x00000d095, 24, 4149}, {0x00000d096, 24, 9983},
+	{0x00000d097, 24, 3}, {0x00000d098, 24, 10896}, {0x00000d099, 24, 10897}, {0x00000d09a, 24, 10898},
+	{0x00000d09b, 24, 10899}, {0x00000d09c, 24, 10900}, {0x00000d09d, 24, 10901}, {0x00000d09e, 24, 10902},
+	{0x00000d09f, 24, 10903}, {0x00000d0a0, 24, 10904}, {0x00000d0a1, 24, 10905}, {0x00000d0a2, 24, 10906},
+	{0x00000d0a3, 24, 10907}, {0x00000d0a4, 24, 10908}, {0x00000d0a5, 24, 10909}, {0x00000d0a6, 24, 10910},
+	{0x00000d0a7, 24, 10911}, {0x00000d0a8, 24, 10912}, {0x00000d0a9, 24, 10913}, {0x00000d0aa, 24, 10914},
+	{0x00000d0ab, 24, 10915}, {0x00000d0ac, 24, 10916}, {0x00000d0ad, 24, 10917}, {0x00000d0ae, 24, 10918},
+	{0x00000d0af, 24, 10919}, {0x00000d0b0, 24, 10920}, {0x00000d0b1, 24, 10921}, {0x00000d0b2, 24, 10922},
+	{0x00000d0b3, 24, 10923}, {0x00000d0b4, 24, 10924}, {0x00000d0b5, 24, 10925}, {0x00000d0b6, 24, 10926},
+	{0x00000d0b7, 24, 10016}, {0x00000d0b8, 24, 10927}, {0x00000d0b9, 24, 9866}, {0x00000d0ba, 24, 3},
+	{0x00000d0bb, 24, 3}, {0x00000d0bc, 24, 3}, {0x00000d0bd, 24, 10928}, {0x00000d0be, 24, 10929},
+	{0x00000d0bf, 24, 10930}, {0x00000d0c0, 24, 3}, {0x00000d0c1, 24, 10931}, {0x00000d0c2, 24, 10932},
+	{0x00000d0c3, 24, 10933}, {0x00000d0c4, 24, 10934}, {0x00000d0c5, 24, 10935}, {0x00000d0c6, 24, 10936},
+	{0x00000d0c7, 24, 10937}, {0x00000d0c8, 24, 10938}, {0x00000d0c9, 24, 10939}, {0x00000d0ca, 24, 1493},
+	{0x00000d0cb, 24, 10940}, {0x00000d0cc, 24, 10941}, {0x00000d0cd, 24, 10942}, {0x00000d0ce, 24, 10943},
+	{0x00000d0cf, 24, 10944}, {0x00000d0d0, 24, 10945}, {0x00000d0d1, 24, 7366}, {0x00000d0d2, 24, 10946},
+	{0x00000d0d3, 24, 3}, {0x00000d0d4, 24, 10947}, {0x00000d0d5, 24, 10948}, {0x00000d0d6, 24, 10949},
+	{0x00000d0d7, 24, 10950}, {0x00000d0d8, 24, 1570}, {0x00000d0d9, 24, 10951}, {0x00000d0da, 24, 10952},
+	{0x00000d0db, 24, 10953}, {0x00000d0dc, 24, 10954}, {0x00000d0dd, 24, 10955}, {0x00000d0de, 24, 10956},
+	{0x00000d0df, 24, 10957}, {0x00000d0e0, 24, 10958}, {0x00000d0e1, 24, 10959}, {0x00000d0e2, 24, 10960},
+	{0x00000d0e3, 24, 10961}, {0x00000d0e4, 24, 3}, {0x00000d0e5, 24, 10962}, {0x00000d0e6, 24, 10963},
+	{0x00000d0e7, 24, 10964}, {0x00000d0e8, 24, 10965}, {0x00000d0e9, 24, 10966}, {0x00000d0ea, 24, 10967},
+	{0x00000d0eb, 24, 10968}, {0x00000d0ec, 24, 10969}, {0x00000d0ed, 24, 10970}, {0x00000d0ee, 24, 10971},
+	{0x00000d0ef, 24, 10972}, {0x00000d0f0, 24, 10973}, {0x00000d0f1, 24, 10974}, {0x00000d0f2, 24, 10975},
+	{0x00000d0f3, 24, 10976}, {0x00000d0f4, 24, 10977}, {0x00000d0f5, 24, 10978}, {0x00000d0f6, 24, 10979},
+	{0x00000d0f7, 24, 10980}, {0x00000d0f8, 24, 10981}, {0x00000d0f9, 24, 10982}, {0x00000d0fa, 24, 10983},
+	{0x00000d0fb, 24, 10984}, {0x00000d0fc, 24, 10985}, {0x00000d0fd, 24, 10986}, {0x00000d0fe, 24, 10987},
+	{0x00000d0ff, 24, 3}, {0x00000d11c, 24, 10988}, {0x00000d38d, 24, 10989}, {0x00000d632, 24, 4385},
+	{0x00000d9d1, 24, 7216}, {0x00000db1e, 24, 10990}, {0x00000db45, 24, 10991}, {0x00000dbdf, 24, 4472},
+	{0x00000dd00, 24, 10503}, {0x00000dd01, 24, 10503}, {0x00000dd02, 24, 10503}, {0x00000dd03, 24, 10503},
+	{0x00000dd04, 24, 10503}, {0x00000dd05, 24, 10503}, {0x00000dd06, 24, 10503}, {0x00000dd07, 24, 10503},
+	{0x00000dd08, 24, 10503}, {0x00000dd09, 24, 10503}, {0x00000dd0a, 24, 10503}, {0x00000dd0b, 24, 10503},
+	{0x00000dd0c, 24, 10503}, {0x00000dd0d, 24, 10503}, {0x00000dd0e, 24, 10503}, {0x00000dd0f, 24, 10503},
+	{0x00000defb, 24, 3}, {0x00000e000, 24, 7968}, {0x00000e001, 24, 10992}, {0x00000e002, 24, 10993},
+	{0x00000e003, 24, 10994}, {0x00000e004, 24, 10995}, {0x00000e005, 24, 10996}, {0x00000e006, 24, 10997},
+	{0x00000e007, 24, 10998}, {0x00000e008, 24, 10999}, {0x00000e009, 24, 11000}, {0x00000e00a, 24, 11001},
+	{0x00000e00b, 24, 11002}, {0x00000e00c, 24, 11003}, {0x00000e00d, 24, 11004}, {0x00000e00e, 24, 11005},
+	{0x00000e00f, 24, 11006}, {0x00000e010, 24, 11007}, {0x00000e011, 24, 11008}, {0x00000e012, 24, 11009},
+	{0x00000e013, 24, 11010}, {0x00000e014, 24, 3}, {0x00000e015, 24, 11011}, {0x00000e016, 24, 11012},
+	{0x00000e017, 24, 11013}, {0x00000e018, 24, 2961}, {0x00000e019, 24, 11014}, {0x00000e01a, 24, 11015},
+	{0x00000e01b, 24, 11016}, {0x00000e01c, 24, 11017}, {0x00000e01d, 24, 11018}, {0x00000e01e, 24, 3},
+	{0x00000e01f, 24, 11019}, {0x00000e020, 24, 11020}, {0x00000e021, 24, 11021}, {0x00000e022, 24, 11022},
+	{0x00000e023, 24, 11023}, {0x00000e024, 24, 11024}, {0x00000e025, 24, 11025}, {0x00000e026, 24, 11026},
+	{0x00000e027, 24, 11027}, {0x00000e028, 24, 11028}, {0x00000e029, 24, 11029}, {0x00000e02a, 24, 11030},
+	{0x00000e02b, 24, 11031}, {0x00000e02c, 24, 11032}, {0x00000e02d, 24, 11033}, {0x00000e02e, 24, 11034},
+	{0x00000e02f, 24, 11035}, {0x00000e030, 24, 11036}, {0x00000e031, 24, 11037}, {0x00000e032, 24, 11038},
+	{0x00000e033, 24, 11039}, {0x00000e034, 24, 3}, {0x00000e035, 24, 406}, {0x00000e036, 24, 11040},
+	{0x00000e037, 24, 11041}, {0x00000e038, 24, 11042}, {0x00000e039, 24, 11043}, {0x00000e03a, 24, 20},
+	{0x00000e03b, 24, 11044}, {0x00000e03c, 24, 11045}, {0x00000e03d, 24, 11046}, {0x00000e03e, 24, 11047},
+	{0x00000e03f, 24, 11048}, {0x00000e040, 24, 11049}, {0x00000e041, 24, 11050}, {0x00000e042, 24, 11051},
+	{0x00000e043, 24, 11052}, {0x00000e044, 24, 11053}, {0x00000e045, 24, 11054}, {0x00000e046, 24, 11055},
+	{0x00000e047, 24, 11056}, {0x00000e048, 24, 11057}, {0x00000e049, 24, 11058}, {0x00000e04a, 24, 11059},
+	{0x00000e04b, 24, 11060}, {0x00000e04c, 24, 11061}, {0x00000e04d, 24, 11062}, {0x00000e04e, 24, 11063},
+	{0x00000e04f, 24, 3}, {0x00000e050, 24, 11064}, {0x00000e051, 24, 11065}, {0x00000e052, 24, 1085},
+	{0x00000e053, 24, 11066}, {0x00000e054, 24, 11067}, {0x00000e055, 24, 11068}, {0x00000e056, 24, 11069},
+	{0x00000e057, 24, 11070}, {0x00000e058, 24, 11071}, {0x00000e059, 24, 11072}, {0x00000e05a, 24, 11073},
+	{0x00000e05b, 24, 11074}, {0x00000e05c, 24, 11075}, {0x00000e05d, 24, 11076}, {0x00000e05e, 24, 11077},
+	{0x00000e05f, 24, 11078}, {0x00000e060, 24, 11079}, {0x00000e061, 24, 11080}, {0x00000e062, 24, 11081},
+	{0x00000e063, 24, 11082}, {0x00000e064, 24, 11083}, {0x00000e065, 24, 11084}, {0x00000e066, 24, 11085},
+	{0x00000e067, 24, 11086}, {0x00000e068, 24, 11087}, {0x00000e069, 24, 11088}, {0x00000e06a, 24, 11089},
+	{0x00000e06b, 24, 11090}, {0x00000e06c, 24, 11091}, {0x00000e06d, 24, 11092}, {0x00000e06e, 24, 11093},
+	{0x00000e06f, 24, 185}, {0x00000e070, 24, 11094}, {0x00000e071, 24, 11095}, {0x00000e072, 24, 11096},
+	{0x00000e073, 24, 11097}, {0x00000e074, 24, 11098}, {0x00000e075, 24, 7363}, {0x00000e076, 24, 11099},
+	{0x00000e077, 24, 11100}, {0x00000e078, 24, 11101}, {0x00000e079, 24, 11102}, {0x00000e07a, 24, 11103},
+	{0x00000e07b, 24, 120}, {0x00000e07c, 24, 11104}, {0x00000e07d, 24, 11105}, {0x00000e07e, 24, 11106},
+	{0x00000e07f, 24, 11107}, {0x00000e080, 24, 11108}, {0x00000e081, 24, 11109}, {0x00000e082, 24, 11110},
+	{0x00000e083, 24, 11111}, {0x00000e084, 24, 11112}, {0x00000e085, 24, 11113}, {0x00000e086, 24, 11114},
+	{0x00000e087, 24, 11115}, {0x00000e088, 24, 11116}, {0x00000e089, 24, 7351}, {0x00000e08a, 24, 11117},
+	{0x00000e08b, 24, 11118}, {0x00000e08c, 24, 11119}, {0x00000e08d, 24, 11120}, {0x00000e08e, 24, 11121},
+	{0x00000e08f, 24, 3}, {0x00000e090, 24, 11122}, {0x00000e091, 24, 11123}, {0x00000e092, 24, 11124},
+	{0x00000e093, 24, 11125}, {0x00000e094, 24, 11126}, {0x00000e095, 24, 11127}, {0x00000e096, 24, 11128},
+	{0x00000e097, 24, 11129}, {0x00000e098, 24, 5901}, {0x00000e099, 24, 11130}, {0x00000e09a, 24, 11131},
+	{0x00000e09b, 24, 11132}, {0x00000e09c, 24, 11133}, {0x00000e09d, 24, 11134}, {0x00000e09e, 24, 11135},
+	{0x00000e09f, 24, 11136}, {0x00000e0a0, 24, 11137}, {0x00000e0a1, 24, 11138}, {0x00000e0a2, 24, 11139},
+	{0x00000e0a3, 24, 3}, {0x00000e0a4, 24, 11140}, {0x00000e0a5, 24, 11141}, {0x00000e0a6, 24, 11142},
+	{0x00000e0a7, 24, 11143}, {0x00000e0a8, 24, 11144}, {0x00000e0a9, 24, 11145}, {0x00000e0aa, 24, 11146},
+	{0x00000e0ab, 24, 11147}, {0x00000e0ac, 24, 11148}, {0x00000e0ad, 24, 11149}, {0x00000e0ae, 24, 11150},
+	{0x00000e0af, 24, 11151}, {0x00000e0b0, 24, 3}, {0x00000e0b1, 24, 4149}, {0x00000e0b2, 24, 11152},
+	{0x00000e0b3, 24, 11153}, {0x00000e0b4, 24, 11154}, {0x00000e0b5, 24, 11155}, {0x00000e0b6, 24, 11156},
+	{0x00000e0b7, 24, 11157}, {0x00000e0b8, 24, 11158}, {0x00000e0b9, 24, 11159}, {0x00000e0ba, 24, 11160},
+	{0x00000e0bb, 24, 11161}, {0x00000e0bc, 24, 11162}, {0x00000e0bd, 24, 11163}, {0x00000e0be, 24, 11164},
+	{0x00000e0bf, 24, 11165}, {0x00000e0c0, 24, 11166}, {0x00000e0c1, 24, 11167}, {0x00000e0c2, 24, 11168},
+	{0x00000e0c3, 24, 11169}, {0x00000e0c4, 24, 11170}, {0x00000e0c5, 24, 11171}, {0x00000e0c6, 24, 11172},
+	{0x00000e0c7, 24, 11173}, {0x00000e0c8, 24, 11174}, {0x00000e0c9, 24, 11175}, {0x00000e0ca, 24, 11176},
+	{0x00000e0cb, 24, 11177}, {0x00000e0cc, 24, 11178}, {0x00000e0cd, 24, 11179}, {0x00000e0ce, 24, 11180},
+	{0x00000e0cf, 24, 11181}, {0x00000e0d0, 24, 11182}, {0x00000e0d1, 24, 11183}, {0x00000e0d2, 24, 11184},
+	{0x00000e0d3, 24, 11185}, {0x00000e0d4, 24, 11186}, {0x00000e0d5, 24, 189}, {0x00000e0d6, 24, 11187},
+	{0x00000e0d7, 24, 11188}, {0x00000e0d8, 24, 11189}, {0x00000e0d9, 24, 11190}, {0x00000e0da, 24, 7491},
+	{0x00000e0db, 24, 11191}, {0x00000e0dc, 24, 11192}, {0x00000e0dd, 24, 11193}, {0x00000e0de, 24, 11194},
+	{0x00000e0df, 24, 11195}, {0x00000e0e0, 24, 11196}, {0x00000e0e1, 24, 11197}, {0x00000e0e2, 24, 11198},
+	{0x00000e0e3, 24, 11199}, {0x00000e0e4, 24, 11200}, {0x00000e0e5, 24, 11201}, {0x00000e0e6, 24, 11202},
+	{0x00000e0e7, 24, 11203}, {0x00000e0e8, 24, 11204}, {0x00000e0e9, 24, 11205}, {0x00000e0ea, 24, 11206},
+	{0x00000e0eb, 24, 11207}, {0x00000e0ec, 24, 11208}, {0x00000e0ed, 24, 11209}, {0x00000e0ee, 24, 11210},
+	{0x00000e0ef, 24, 11211}, {0x00000e0f0, 24, 11212}, {0x00000e0f1, 24, 11213}, {0x00000e0f2, 24, 11214},
+	{0x00000e0f3, 24, 11215}, {0x00000e0f4, 24, 11216}, {0x00000e0f5, 24, 11217}, {0x00000e0f6, 24, 11218},
+	{0x00000e0f7, 24, 3}, {0x00000e0f8, 24, 11219}, {0x00000e0f9, 24, 3}, {0x00000e0fa, 24, 11220},
+	{0x00000e0fb, 24, 11221}, {0x00000e0fc, 24, 11222}, {0x00000e0fd, 24, 11223}, {0x00000e0fe, 24, 3},
+	{0x00000e0ff, 24, 11224}, {0x00000e16d, 24, 1965}, {0x00000e175, 24, 11225}, {0x00000e3b2, 24, 1842},
+	{0x00000e666, 24, 11226}, {0x00000e6d3, 24, 11227}, {0x00000e6e8, 24, 11228}, {0x00000e8ab, 24, 11229},
+	{0x00000eb2d, 24, 11230}, {0x00000eebd, 24, 2176}, {0x00000f051, 24, 11231}, {0x00000f3db, 24, 11232},
+	{0x00000f403, 24, 11233}, {0x00000f46f, 24, 11234}, {0x00000f4b9, 24, 865}, {0x00000f76f, 24, 865},
+	{0x00000f860, 24, 11235}, {0x00000fa3b, 24, 11236}, {0x00000fc58, 24, 11237}, {0x00000fc70, 24, 11238},
+	{0x00000fc8d, 24, 8599}, {0x00000fd4c, 24, 11239}, {0x000020701, 24, 1681}, {0x000021c7c, 24, 6563},
+	{0x000026086, 24, 9599}, {0x00002608c, 24, 244}, {0x000027001, 24, 1681}, {0x0000270b0, 24, 9720},
+	{0x0000270b3, 24, 11240}, {0x000029d8e, 24, 11241}, {0x00002aa3c, 24, 11242}, {0x00002bb01, 24, 10501},
+	{0x00002c08c, 24, 244}, {0x00002cf1c, 24, 10757}, {0x00002e6d3, 24, 11243}, {0x000040a83, 24, 5554},
+	{0x000040ae0, 24, 11244}, {0x000040cce, 24, 865}, {0x000040ec2, 24, 11245}, {0x000041552, 24, 865},
+	{0x00004180f, 24, 1842}, {0x0000418b6, 24, 99}, {0x0000418d6, 24, 8759}, {0x000041a04, 24, 11246},
+	{0x000041b94, 24, 11247}, {0x000041bba, 24, 1842}, {0x000041d10, 24, 11248}, {0x000041e64, 24, 865},
+	{0x00004209a, 24, 11249}, {0x000042234, 24, 11250}, {0x000042605, 24, 11251}, {0x000042665, 24, 865},
+	{0x000042bbb, 24, 11252}, {0x000042f56, 24, 11253}, {0x0000432f4, 24, 11254}, {0x000043604, 24, 11255},
+	{0x000043d98, 24, 11256}, {0x0000444a1, 24, 11257}, {0x000044665, 24, 3430}, {0x00004489a, 24, 865},
+	{0x000044a50, 24, 11258}, {0x000044bff, 24, 11259}, {0x000044cef, 24, 11260}, {0x000044e06, 24, 11261},
+	{0x000044f8b, 24, 11262}, {0x000044faa, 24, 4614}, {0x000045453, 24, 865}, {0x0000455ca, 24, 11263},
+	{0x00004572f, 24, 11264}, {0x00004586f, 24, 11265}, {0x000045a95, 24, 5842}, {0x000045c06, 24, 11266},
+	{0x000045c8e, 24, 11267}, {0x000045d56, 24, 11268}, {0x000045fa7, 24, 11269}, {0x000046169, 24, 3974},
+	{0x0000462d7, 24, 11270}, {0x0000463e0, 24, 11271}, {0x000046785, 24, 11272}, {0x000046d42, 24, 11273},
+	{0x000046e49, 24, 11274}, {0x0000470bc, 24, 11275}, {0x0000474a1, 24, 11276}, {0x0000475f5, 24, 11277},
+	{0x00004766e, 24, 1671}, {0x000047d7b, 24, 5203}, {0x0000481ae, 24, 11278}, {0x00004848a, 24, 11279},
+	{0x00004888c, 24, 11280}, {0x0000488e2, 24, 11281}, {0x000048a15, 24, 11282}, {0x000048b42, 24, 11283},
+	{0x000048c03, 24, 11284}, {0x000048d38, 24, 11285}, {0x0000492ee, 24, 11286}, {0x0000494a1, 24, 11287},
+	{0x0000498f3, 24, 11288}, {0x0000499e6, 24, 11289}, {0x000049b9c, 24, 11290}, {0x000049c62, 24, 11291},
+	{0x000049f06, 24, 11292}, {0x000049f81, 24, 11293}, {0x00004a151, 24, 9978}, {0x00004a3f3, 24, 11294},
+	{0x00004a82a, 24, 5842}, {0x00004b3b6, 24, 11295}, {0x00004b466, 24, 11296}, {0x00004bd70, 24, 9262},
+	{0x00004bd88, 24, 2743}, {0x00004bfa8, 24, 11297}, {0x00004c05b, 24, 11298}, {0x00004c06f, 24, 8409},
+	{0x00004c09c, 24, 11299}, {0x00004c1b9, 24, 11300}, {0x00004c5a4, 24, 3}, {0x00004c880, 24, 11301},
+	{0x00004c991, 24, 11302}, {0x00004c9d9, 24, 11303}, {0x00004cb1d, 24, 11304}, {0x00004ce14, 24, 11305},
+	{0x00004cf25, 24, 11306}, {0x00004d437, 24, 11307}, {0x00004d783, 24, 11308}, {0x00004dad2, 24, 1965},
+	{0x00004db56, 24, 11309}, {0x00004db8a, 24, 11310}, {0x00004dd4c, 24, 11311}, {0x00004dedb, 24, 11312},
+	{0x00004df69, 24, 11313}, {0x00004e0c4, 24, 11314}, {0x00004e1c8, 24, 11315}, {0x00004e2f8, 24, 11316},
+	{0x00004e451, 24, 4305}, {0x00004e536, 24, 865}, {0x00004e548, 24, 11317}, {0x00004e662, 24, 11318},
+	{0x00004e676, 24, 11319}, {0x00004e9e5, 24, 11320}, {0x00004ee91, 24, 11321}, {0x00004f021, 24, 11322},
+	{0x00004f13e, 24, 865}, {0x00004f17d, 24, 11323}, {0x00004f4bc, 24, 11324}, {0x00004f7e4, 24, 865},
+	{0x00004f8c2, 24, 11325}, {0x00004f938, 24, 9262}, {0x00004fe31, 24, 1842}, {0x00004fe7f, 24, 3},
+	{0x00004ff51, 24, 11326}, {0x000080001, 24, 11327}, {0x000080002, 24, 11328}, {0x000080003, 24, 11329},
+	{0x000080004, 24, 11330}, {0x000080005, 24, 11331}, {0x000080006, 24, 11332}, {0x000080007, 24, 865},
+	{0x000080008, 24, 11333}, {0x000080009, 24, 8867}, {0x00008000a, 24, 11334}, {0x00008000b, 24, 11335},
+	{0x00008000c, 24, 11336}, {0x00008000d, 24, 11337}, {0x00008000e, 24, 11338}, {0x00008000f, 24, 11339},
+	{0x000080011, 24, 11340}, {0x000080012, 24, 11341}, {0x000080013, 24, 11342}, {0x000080014, 24, 11343},
+	{0x000080015, 24, 11344}, {0x000080016, 24, 11345}, {0x000080017, 24, 11346}, {0x000080018, 24, 11347},
+	{0x000080019, 24, 11348}, {0x00008001a, 24, 11349}, {0x00008001b, 24, 142}, {0x00008001c, 24, 11350},
+	{0x00008001d, 24, 11351}, {0x00008001e, 24, 11352}, {0x00008001f, 24, 5455}, {0x000080020, 24, 116},
+	{0x000080021, 24, 11353}, {0x000080022, 24, 11354}, {0x000080023, 24, 9958}, {0x000080024, 24, 11355},
+	{0x000080025, 24, 11356}, {0x000080026, 24, 11357}, {0x000080027, 24, 11358}, {0x000080028, 24, 4305},
+	{0x000080029, 24, 11359}, {0x00008002a, 24, 11360}, {0x00008002b, 24, 235}, {0x00008002c, 24, 11361},
+	{0x00008002d, 24, 11362}, {0x00008002e, 24, 11363}, {0x00008002f, 24, 11364}, {0x000080030, 24, 11365},
+	{0x000080031, 24, 11366}, {0x000080032, 24, 11367}, {0x000080033, 24, 11368}, {0x000080034, 24, 11369},
+	{0x000080035, 24, 11370}, {0x000080036, 24, 11371}, {0x000080037, 24, 11372}, {0x000080038, 24, 11373},
+	{0x000080039, 24, 11374}, {0x00008003a, 24, 11375}, {0x00008003b, 24, 11376}, {0x00008003c, 24, 11377},
+	{0x00008003d, 24, 11378}, {0x00008003e, 24, 11379}, {0x00008003f, 24, 11380}, {0x000080040, 24, 11381},
+	{0x000080041, 24, 11382}, {0x000080042, 24, 11383}, {0x000080043, 24, 11384}, {0x000080044, 24, 11385},
+	{0x000080045, 24, 11386}, {0x000080046, 24, 311}, {0x000080047, 24, 11387}, {0x000080048, 24, 11388},
+	{0x000080049, 24, 11389}, {0x00008004a, 24, 11390}, {0x00008004b, 24, 11391}, {0x00008004c, 24, 11392},
+	{0x00008004d, 24, 11393}, {0x00008004e, 24, 9983}, {0x00008004f, 24, 11394}, {0x000080050, 24, 11395},
+	{0x000080051, 24, 11396}, {0x000080052, 24, 11397}, {0x000080053, 24, 11398}, {0x000080055, 24, 11399},
+	{0x000080056, 24, 11400}, {0x000080057, 24, 11401}, {0x000080058, 24, 11402}, {0x000080059, 24, 11403},
+	{0x00008005a, 24, 564}, {0x00008005b, 24, 11404}, {0x00008005c, 24, 11405}, {0x00008005d, 24, 11406},
+	{0x00008005e, 24, 11407}, {0x00008005f, 24, 11408}, {0x000080060, 24, 11409}, {0x000080061, 24, 11410},
+	{0x000080062, 24, 11411}, {0x000080063, 24, 11412}, {0x000080064, 24, 11413}, {0x000080065, 24, 11414},
+	{0x000080066, 24, 11415}, {0x000080067, 24, 11416}, {0x000080068, 24, 11417}, {0x000080069, 24, 11418},
+	{0x00008006a, 24, 11419}, {0x00008006b, 24, 11420}, {0x00008006c, 24, 11421}, {0x00008006d, 24, 11422},
+	{0x00008006e, 24, 11423}, {0x00008006f, 24, 11424}, {0x000080070, 24, 11425}, {0x000080071, 24, 11426},
+	{0x000080072, 24, 11427}, {0x000080073, 24, 11428}, {0x000080074, 24, 11429}, {0x000080075, 24, 11430},
+	{0x000080076, 24, 11431}, {0x000080077, 24, 11432}, {0x000080078, 24, 11433}, {0x000080079, 24, 11434},
+	{0x00008007a, 24, 11435}, {0x00008007b, 24, 11436}, {0x00008007c, 24, 11437}, {0x00008007e, 24, 11438},
+	{0x00008007f, 24, 11439}, {0x000080080, 24, 11440}, {0x000080081, 24, 11441}, {0x000080082, 24, 11442},
+	{0x000080083, 24, 11443}, {0x000080084, 24, 11444}, {0x000080085, 24, 11445}, {0x000080086, 24, 7388},
+	{0x000080087, 24, 11446}, {0x000080088, 24, 127}, {0x000080089, 24, 11447}, {0x00008008a, 24, 11448},
+	{0x00008008b, 24, 11449}, {0x00008008c, 24, 11450}, {0x00008008d, 24, 11451}, {0x00008008e, 24, 11452},
+	{0x00008008f, 24, 11453}, {0x000080090, 24, 11454}, {0x000080371, 24, 11455}, {0x0000805cd, 24, 11456},
+	{0x0000808c2, 24, 4482}, {0x0000808ea, 24, 11457}, {0x0000809b6, 24, 11458}, {0x000080c0b, 24, 11459},
+	{0x000080cc9, 24, 11460}, {0x000080d84, 24, 11461}, {0x000080ea8, 24, 11462}, {0x000080ffa, 24, 11463},
+	{0x00008115e, 24, 11464}, {0x000081196, 24, 4472}, {0x000081443, 24, 11465}, {0x000081651, 24, 11466},
+	{0x000081735, 24, 3}, {0x0000817f4, 24, 564}, {0x00008181a, 24, 8716}, {0x00008184c, 24, 11467},
+	{0x0000819a6, 24, 9262}, {0x000081dfb, 24, 11468}, {0x000081f3f, 24, 11469}, {0x000081feb, 24, 11470},
+	{0x000081ff3, 24, 3}, {0x000082522, 24, 11471}, {0x000082719, 24, 11472}, {0x000082ad0, 24, 11473},
+	{0x000082cb0, 24, 11474}, {0x000082e5f, 24, 11475}, {0x000083571, 24, 11476}, {0x00008373d, 24, 1842},
+	{0x00008379c, 24, 11477}, {0x0000838a5, 24, 11478}, {0x000083a5c, 24, 11479}, {0x000083ab8, 24, 11480},
+	{0x000083d88, 24, 1842}, {0x000083e0c, 24, 185}, {0x000083e8e, 24, 11481}, {0x000083f3e, 24, 11482},
+	{0x000083f76, 24, 11483}, {0x000084027, 24, 11484}, {0x000084656, 24, 11485}, {0x00008482c, 24, 11486},
+	{0x000084e1c, 24, 11487}, {0x000084ebf, 24, 11488}, {0x00008512e, 24, 11489}, {0x000085240, 24, 11490},
+	{0x000085700, 24, 11491}, {0x000085ae0, 24, 11492}, {0x000085b0e, 24, 11493}, {0x000085ddd, 24, 11494},
+	{0x00008606e, 24, 4673}, {0x000086266, 24, 4673}, {0x000086361, 24, 9715}, {0x0000868d0, 24, 11495},
+	{0x0000868ea, 24, 11496}, {0x000086df2, 24, 11497}, {0x000087045, 24, 865}, {0x000087402, 24, 11309},
+	{0x0000874f6, 24, 11498}, {0x000087572, 24, 11499}, {0x000087618, 24, 11500}, {0x000087695, 24, 11501},
+	{0x0000876ff, 24, 3404}, {0x000087999, 24, 11502}, {0x000087a4c, 24, 9715}, {0x000087baa, 24, 11503},
+	{0x000087cbe, 24, 11504}, {0x000087d21, 24, 11505}, {0x000088039, 24, 6959}, {0x0000881bc, 24, 11506},
+	{0x0000881f4, 24, 5553}, {0x00008863b, 24, 11507}, {0x000088dc8, 24, 11508}, {0x000088e4f, 24, 11509},
+	{0x000088f2c, 24, 11510}, {0x0000894ef, 24, 11511}, {0x00008952a, 24, 11512}, {0x0000896d7, 24, 980},
+	{0x000089758, 24, 11513}, {0x000089e01, 24, 11514}, {0x000089f97, 24, 11515}, {0x00008a12b, 24, 11516},
+	{0x00008a5c8, 24, 11517}, {0x00008a95a, 24, 11518}, {0x00008aca5, 24, 11519}, {0x00008af78, 24, 11520},
+	{0x00008b2a3, 24, 11521}, {0x00008b4cf, 24, 11522}, {0x00008b738, 24, 11523}, {0x00008b7ec, 24, 11524},
+	{0x00008bbcc, 24, 11525}, {0x00008bd43, 24, 9978}, {0x00008be09, 24, 11526}, {0x00008ca45, 24, 11527},
+	{0x00008cc68, 24, 1965}, {0x00008cd9b, 24, 11528}, {0x00008d09f, 24, 3}, {0x00008d0b7, 24, 11529},
+	{0x00008d29a, 24, 11530}, {0x00008d34b, 24, 11531}, {0x00008d40c, 24, 4472}, {0x00008d42b, 24, 4482},
+	{0x00008d5c0, 24, 11532}, {0x00008d833, 24, 11533}, {0x00008df1f, 24, 2987}, {0x00008e5da, 24, 11534},
+	{0x00008e672, 24, 11535}, {0x00008e84f, 24, 9262}, {0x00008ea44, 24, 5904}, {0x00008eb29, 24, 11536},
+	{0x00008eb74, 24, 11537}, {0x00008ebed, 24, 11538}, {0x00008eca9, 24, 1842}, {0x00008edb9, 24, 6482},
+	{0x00008ee8b, 24, 11234}, {0x00008ef3b, 24, 7133}, {0x00008efab, 24, 11539}, {0x00008f1b7, 24, 11540},
+	{0x00008f2f4, 24, 11541}, {0x00008f6f8, 24, 11542}, {0x00008f728, 24, 11543}, {0x00008fae0, 24, 11544},
+	{0x00008fc52, 24, 11545}, {0x00008fc88, 24, 1842}, {0x00008fd0e, 24, 1842}, {0x0000c0400, 24, 11546},
+	{0x0000c0535, 24, 11547}, {0x0000c1105, 24, 11548}, {0x0000c1262, 24, 8716}, {0x0000c130b, 24, 11549},
+	{0x0000c1420, 24, 1842}, {0x0000c1539, 24, 865}, {0x0000c15c5, 24, 11550}, {0x0000c17f1, 24, 11551},
+	{0x0000c191f, 24, 11552}, {0x0000c1daf, 24, 11553}, {0x0000c1dc2, 24, 11554}, {0x0000c2026, 24, 11555},
+	{0x0000c2724, 24, 1965}, {0x0000c2755, 24, 11556}, {0x0000c2a69, 24, 11557}, {0x0000c2ae7, 24, 11558},
+	{0x0000c2d89, 24, 11559}, {0x0000c3021, 24, 865}, {0x0000c37dc, 24, 9715}, {0x0000c383e, 24, 11560},
+	{0x0000c3956, 24, 11561}, {0x0000c3c65, 24, 11562}, {0x0000c3e9f, 24, 11563}, {0x0000c413e, 24, 965},
+	{0x0000c469d, 24, 11564}, {0x0000c473d, 24, 8599}, {0x0000c4885, 24, 6967}, {0x0000c4c39, 24, 11565},
+	{0x0000c4de9, 24, 865}, {0x0000c4f5a, 24, 11566}, {0x0000c51f7, 24, 11567}, {0x0000c54a5, 24, 9722},
+	{0x0000c5521, 24, 11568}, {0x0000c565c, 24, 11569}, {0x0000c57eb, 24, 11570}, {0x0000c5a19, 24, 11571},
+	{0x0000c5cd8, 24, 11572}, {0x0000c6076, 24, 6482}, {0x0000c63fc, 24, 11573}, {0x0000c6803, 24, 1965},
+	{0x0000c6e4f, 24, 11574}, {0x0000c715d, 24, 1842}, {0x0000c722c, 24, 11491}, {0x0000c74c2, 24, 865},
+	{0x0000c7523, 24, 11575}, {0x0000c756c, 24, 11576}, {0x0000c771a, 24, 865}, {0x0000c7d7c, 24, 11577},
+	{0x0000c8112, 24, 99}, {0x0000c8230, 24, 11578}, {0x0000c8268, 24, 11491}, {0x0000c826a, 24, 11579},
+	{0x0000c8411, 24, 11580}, {0x0000c8484, 24, 11581}, {0x0000c84dc, 24, 6482}, {0x0000c8525, 24, 3},
+	{0x0000c8910, 24, 8104}, {0x0000c8bfd, 24, 4472}, {0x0000c8c8f, 24, 11582}, {0x0000c8cdc, 24, 11583},
+	{0x0000c8d98, 24, 11584}, {0x0000c9160, 24, 11585}, {0x0000c924e, 24, 11586}, {0x0000c9301, 24, 11587},
+	{0x0000c93fb, 24, 11588}, {0x0000c96bf, 24, 9715}, {0x0000c9b13, 24, 11589}, {0x0000c9d56, 24, 11590},
+	{0x0000c9e91, 24, 11591}, {0x0000ca138, 24, 11592}, {0x0000ca2f4, 24, 11593}, {0x0000ca402, 24, 11594},
+	{0x0000ca42a, 24, 11595}, {0x0000ca694, 24, 11596}, {0x0000cac05, 24, 11597}, {0x0000caf5a, 24, 11598},
+	{0x0000cb319, 24, 11234}, {0x0000cb4ef, 24, 11599}, {0x0000cb5de, 24, 11600}, {0x0000cbc9f, 24, 865},
+	{0x0000cbd51, 24, 11601}, {0x0000cbf15, 24, 11602}, {0x0000cc0c0, 24, 11603}, {0x0000cc3a7, 24, 11604},
+	{0x0000cc47a, 24, 8445}, {0x0000cc47e, 24, 11605}, {0x0000cc655, 24, 11606}, {0x0000cc66a, 24, 5842},
+	{0x0000cc6ac, 24, 11607}, {0x0000cc81f, 24, 11608}, {0x0000cc9c6, 24, 11609}, {0x0000ccb8d, 24, 11610},
+	{0x0000ccdd3, 24, 11611}, {0x0000ccdfb, 24, 11612}, {0x0000ccfd1, 24, 11613}, {0x0000cd292, 24, 4472},
+	{0x0000cd2b5, 24, 11614}, {0x0000cd502, 24, 11615}, {0x0000cd696, 24, 11616}, {0x0000cd7c2, 24, 11617},
+	{0x0000cd996, 24, 3}, {0x0000cd9c1, 24, 2273}, {0x0000cda41, 24, 11618}, {0x0000cdccc, 24, 11619},
+	{0x0000cddef, 24, 5842}, {0x0000cdfa4, 24, 1842}, {0x0000ce0e4, 24, 11620}, {0x0000ce5d3, 24, 11621},
+	{0x0000ce709, 24, 11622}, {0x0000ce725, 24, 965}, {0x0000ce82f, 24, 11623}, {0x0000ce936, 24, 11624},
+	{0x0000ceee6, 24, 6482}, {0x0000cef7c, 24, 11625}, {0x0000cefaf, 24, 11626}, {0x0000cf019, 24, 11627},
+	{0x0000cf0b4, 24, 11628}, {0x0000cf361, 24, 11629}, {0x0000cf3ee, 24, 11630}, {0x0000cf405, 24, 11631},
+	{0x0000cf5a4, 24, 1965}, {0x0000cf893, 24, 185}, {0x0000cfc83, 24, 11632}, {0x0000cfe45, 24, 7216},
+	{0x000100000, 24, 99}, {0x00010005a, 24, 564}, {0x0001000e8, 24, 11346}, {0x0001000fd, 24, 11633},
+	{0x0001001ca, 24, 11634}, {0x0001005b1, 24, 185}, {0x0001005ca, 24, 1965}, {0x000100723, 24, 11626},
+	{0x0001008b1, 24, 6482}, {0x00010090c, 24, 11635}, {0x000100ba9, 24, 4472}, {0x000100c24, 24, 11636},
+	{0x000100d2f, 24, 11637}, {0x000100d32, 24, 11638}, {0x000100d7f, 24, 9978}, {0x000100e2b, 24, 11639},
+	{0x000100e7e, 24, 6820}, {0x000100f18, 24, 11640}, {0x0001010b6, 24, 11641}, {0x000101212, 24, 11642},
+	{0x000101218, 24, 11643}, {0x000101248, 24, 11644}, {0x0001013ee, 24, 11645}, {0x00010189e, 24, 11646},
+	{0x000101b54, 24, 9262}, {0x000101c0c, 24, 865}, {0x000101d51, 24, 11647}, {0x000101dc0, 24, 1842},
+	{0x000101f74, 24, 459}, {0x000102279, 24, 11648}, {0x0001027be, 24, 11649}, {0x000102831, 24, 11650},
+	{0x000102c83, 24, 11651}, {0x000102d96, 24, 11652}, {0x000102eaf, 24, 4305}, {0x000102f6b, 24, 965},
+	{0x000103047, 24, 1842}, {0x000103378, 24, 11653}, {0x000103711, 24, 11654}, {0x000103b59, 24, 1842},
+	{0x000103dea, 24, 11655}, {0x0001040f3, 24, 865}, {0x00010417f, 24, 11656}, {0x000104369, 24, 11657},
+	{0x00010445a, 24, 11658}, {0x0001045be, 24, 11659}, {0x0001045f8, 24, 11660}, {0x000104780, 24, 9262},
+	{0x0001048b1, 24, 11661}, {0x000104a7d, 24, 4472}, {0x000104b46, 24, 11662}, {0x000104d77, 24, 11663},
+	{0x000104e07, 24, 11664}, {0x000105172, 24, 9262}, {0x0001056ca, 24, 11665}, {0x000105c3b, 24, 11666},
+	{0x000105cbf, 24, 11667}, {0x000105f06, 24, 3726}, {0x000105f49, 24, 6959}, {0x00010604b, 24, 11475},
+	{0x0001062c9, 24, 11668}, {0x0001064e2, 24, 11669}, {0x0001065a3, 24, 11670}, {0x0001065cf, 24, 11671},
+	{0x000106682, 24, 3100}, {0x00010683f, 24, 6967}, {0x000106f3f, 24, 1741}, {0x000106fef, 24, 11672},
+	{0x0001071f9, 24, 11673}, {0x00010768a, 24, 11674}, {0x0001077b1, 24, 8104}, {0x000107873, 24, 11675},
+	{0x0001078ce, 24, 11676}, {0x0001078d2, 24, 8340}, {0x000107a86, 24, 11677}, {0x000107bef, 24, 4551},
+	{0x0001083d2, 24, 11678}, {0x00010880f, 24, 11679}, {0x0001088ce, 24, 11300}, {0x000108a1b, 24, 11680},
+	{0x000108ccf, 24, 3}, {0x000109266, 24, 1842}, {0x0001093e9, 24, 865}, {0x000109836, 24, 5963},
+	{0x000109ab9, 24, 11681}, {0x000109add, 24, 865}, {0x000109fa9, 24, 3726}, {0x00010a13b, 24, 11682},
+	{0x00010a5d0, 24, 11683}, {0x00010a659, 24, 11684}, {0x00010a743, 24, 11685}, {0x00010a932, 24, 11686},
+	{0x00010ae60, 24, 99}, {0x00010af78, 24, 11687}, {0x00010b26b, 24, 11688}, {0x00010b713, 24, 99},
+	{0x00010b7f6, 24, 11689}, {0x00010b9fe, 24, 11690}, {0x00010baa5, 24, 11691}, {0x00010bd18, 24, 3},
+	{0x00010bf48, 24, 2961}, {0x00010c07c, 24, 11692}, {0x00010c2ba, 24, 11693}, {0x00010c37b, 24, 4673},
+	{0x00010c586, 24, 11694}, {0x00010c61f, 24, 9715}, {0x00010c67e, 24, 11695}, {0x00010c6fc, 24, 11696},
+	{0x00010c73f, 24, 11697}, {0x00010ca81, 24, 11698}, {0x00010ccdb, 24, 11699}, {0x00010cdae, 24, 11282},
+	{0x00010d1dc, 24, 11700}, {0x00010d38a, 24, 1842}, {0x00010d542, 24, 1842}, {0x00010ddb1, 24, 865},
+	{0x00010ddf4, 24, 11701}, {0x00010dee4, 24, 11702}, {0x00010df8b, 24, 11703}, {0x00010e2d5, 24, 11704},
+	{0x00010e3c7, 24, 11705}, {0x00010e4af, 24, 11706}, {0x00010e6ae, 24, 11707}, {0x00010e878, 24, 5554},
+	{0x00010e8ee, 24, 11708}, {0x00010ea59, 24, 6959}, {0x00010eed9, 24, 11709}, {0x00010f311, 24, 1965},
+	{0x00010f3db, 24, 11710}, {0x00010f49a, 24, 11711}, {0x00010f681, 24, 11712}, {0x00010f96f, 24, 6967},
+	{0x00010f9ee, 24, 5842}, {0x00010face, 24, 11713}, {0x00010fbf0, 24, 11714}, {0x00010fc54, 24, 11715},
+	{0x00010feed, 24, 8096}, {0x0001100aa, 24, 99}, {0x000140708, 24, 99}, {0x0001407e0, 24, 11716},
+	{0x000140c76, 24, 11717}, {0x000140d4f, 24, 7694}, {0x00014109f, 24, 865}, {0x000141330, 24, 11718},
+	{0x00014144b, 24, 6160}, {0x00014157c, 24, 11719}, {0x000141a51, 24, 11720}, {0x000141aa3, 24, 11721},
+	{0x000141bbd, 24, 11722}, {0x000141bf0, 24, 11723}, {0x000141fba, 24, 11626}, {0x0001422db, 24, 11724},
+	{0x0001423d7, 24, 11725}, {0x000142971, 24, 11726}, {0x000142bd2, 24, 11727}, {0x000142bd6, 24, 11728},
+	{0x000142d27, 24, 6482}, {0x000142d8b, 24, 11729}, {0x000142df5, 24, 11730}, {0x00014307a, 24, 11731},
+	{0x0001430c6, 24, 11721}, {0x0001432d1, 24, 1842}, {0x00014358b, 24, 11732}, {0x0001435b3, 24, 11733},
+	{0x000143605, 24, 5842}, {0x0001436c6, 24, 4485}, {0x00014373b, 24, 11734}, {0x000143aea, 24, 11735},
+	{0x000143df2, 24, 11736}, {0x000143e60, 24, 5554}, {0x000144146, 24, 11737}, {0x0001441e2, 24, 11738},
+	{0x000144319, 24, 11739}, {0x0001446e4, 24, 11740}, {0x00014488b, 24, 11741}, {0x000144978, 24, 11742},
+	{0x0001449e0, 24, 11743}, {0x000144c1a, 24, 11744}, {0x000145412, 24, 11745}, {0x000145645, 24, 11746},
+	{0x0001458d0, 24, 11475}, {0x000145a05, 24, 865}, {0x000145a83, 24, 11747}, {0x000145bd1, 24, 185},
+	{0x000146080, 24, 8716}, {0x000146308, 24, 11748}, {0x000146a0b, 24, 11749}, {0x000146b72, 24, 11750},
+	{0x000146e0a, 24, 99}, {0x000147373, 24, 11751}, {0x000147411, 24, 8028}, {0x000147590, 24, 11491},
+	{0x000147db3, 24, 11752}, {0x000147dc5, 24, 3430}, {0x00014825b, 24, 11753}, {0x000148692, 24, 11491},
+	{0x00014893e, 24, 11754}, {0x0001489fd, 24, 4482}, {0x000148a70, 24, 11755}, {0x000148f21, 24, 11696},
+	{0x000148fc6, 24, 865}, {0x000149090, 24, 11756}, {0x000149448, 24, 11757}, {0x0001499e2, 24, 11563},
+	{0x000149a10, 24, 965}, {0x000149fe8, 24, 4485}, {0x00014a364, 24, 1842}, {0x00014a62c, 24, 11758},
+	{0x00014a86b, 24, 11759}, {0x00014a9e3, 24, 11760}, {0x00014abf0, 24, 185}, {0x00014aedb, 24, 4292},
+	{0x00014b126, 24, 11761}, {0x00014b1c8, 24, 11762}, {0x00014b484, 24, 1842}, {0x00014b73d, 24, 11763},
+	{0x00014b968, 24, 9715}, {0x00014c089, 24, 11764}, {0x00014c126, 24, 5842}, {0x00014c21d, 24, 11765},
+	{0x00014cc20, 24, 11766}, {0x00014cf8d, 24, 11767}, {0x00014cf92, 24, 8096}, {0x00014cfe2, 24, 185},
+	{0x00014d4fe, 24, 6621}, {0x00014d64d, 24, 11768}, {0x00014d76e, 24, 11769}, {0x00014dae9, 24, 4673},
+	{0x00014db85, 24, 11770}, {0x00014dda9, 24, 4673}, {0x00014e4ec, 24, 11771}, {0x00014e6e4, 24, 8096},
+	{0x00014eb33, 24, 11772}, {0x00014eda5, 24, 11773}, {0x00014ede4, 24, 11774}, {0x00014ee9d, 24, 11775},
+	{0x00014f0c5, 24, 11776}, {0x00014f28e, 24, 11777}, {0x00014f42a, 24, 4482}, {0x00014f65a, 24, 11778},
+	{0x00014f893, 24, 11779}, {0x00014feaf, 24, 11780}, {0x00014feb5, 24, 2822}, {0x00018002d, 24, 11230},
+	{0x0001800db, 24, 11781}, {0x0001801e3, 24, 11782}, {0x000180373, 24, 2822}, {0x0001803fa, 24, 11783},
+	{0x000180675, 24, 11784}, {0x000180b52, 24, 11785}, {0x000180c14, 24, 11786}, {0x000180c77, 24, 11787},
+	{0x000180cac, 24, 124}, {0x00018104e, 24, 11788}, {0x000181420, 24, 11789}, {0x000181456, 24, 5842},
+	{0x0001816c9, 24, 1842}, {0x000181714, 24, 11790}, {0x000181725, 24, 11791}, {0x00018193f, 24, 11792},
+	{0x000181beb, 24, 3726}, {0x000181e78, 24, 9007}, {0x000181eb0, 24, 1842}, {0x000182012, 24, 11793},
+	{0x000182032, 24, 865}, {0x0001820a6, 24, 11794}, {0x00018227e, 24, 1842}, {0x000182666, 24, 1842},
+	{0x000182861, 24, 6601}, {0x000182a7b, 24, 2314}, {0x000182b05, 24, 11795}, {0x000182c91, 24, 11796},
+	{0x000183009, 24, 11797}, {0x0001832a2, 24, 11798}, {0x00018339d, 24, 3}, {0x000183451, 24, 865},
+	{0x0001836fc, 24, 11799}, {0x000183825, 24, 11800}, {0x000183864, 24, 11801}, {0x000183919, 24, 11802},
+	{0x000183a2d, 24, 1842}, {0x000183bd2, 24, 11803}, {0x000183da2, 24, 4472}, {0x000183f47, 24, 1842},
+	{0x00018421d, 24, 99}, {0x00018422f, 24, 11600}, {0x000184462, 24, 11804}, {0x0001844e6, 24, 8716},
+	{0x000184617, 24, 4482}, {0x0001848d8, 24, 11805}, {0x000184a6f, 24, 11806}, {0x000184e94, 24, 11807},
+	{0x000184f32, 24, 6482}, {0x000185253, 24, 1004}, {0x0001853e0, 24, 11808}, {0x00018550f, 24, 6959},
+	{0x000185933, 24, 6959}, {0x000185936, 24, 11809}, {0x000185ae8, 24, 11810}, {0x000185d9a, 24, 11811},
+	{0x000185e0f, 24, 4472}, {0x00018622c, 24, 11812}, {0x000186472, 24, 2743}, {0x000186571, 24, 11813},
+	{0x0001866e3, 24, 11814}, {0x00018673f, 24, 11815}, {0x000186751, 24, 11816}, {0x0001867b0, 24, 8104},
+	{0x000186882, 24, 11817}, {0x000186d99, 24, 11818}, {0x000187117, 24, 11819}, {0x0001879a2, 24, 11820},
+	{0x000187a93, 24, 11821}, {0x000187c81, 24, 11822}, {0x000187ed5, 24, 11823}, {0x0001880ce, 24, 11824},
+	{0x0001880f5, 24, 11806}, {0x000188219, 24, 11825}, {0x000188331, 24, 1842}, {0x0001883bf, 24, 4428},
+	{0x000188410, 24, 11826}, {0x00018863a, 24, 11827}, {0x0001886ac, 24, 709}, {0x000188796, 24, 2176},
+	{0x000188857, 24, 11828}, {0x0001889df, 24, 11829}, {0x000188ed5, 24, 11830}, {0x000188ef9, 24, 11831},
+	{0x00018922c, 24, 11832}, {0x0001897ff, 24, 11833}, {0x000189a67, 24, 11834}, {0x000189c5d, 24, 1965},
+	{0x000189efc, 24, 865}, {0x00018a3e8, 24, 11300}, {0x00018a905, 24, 459}, {0x00018a958, 24, 11835},
+	{0x00018a99b, 24, 2822}, {0x00018aa45, 24, 11836}, {0x00018abf5, 24, 11837}, {0x00018ad4d, 24, 11838},
+	{0x00018aebb, 24, 11839}, {0x00018af61, 24, 11563}, {0x00018af8f, 24, 865}, {0x00018af9f, 24, 11840},
+	{0x00018b169, 24, 1607}, {0x00018b209, 24, 11841}, {0x00018b3ba, 24, 11842}, {0x00018b430, 24, 11843},
+	{0x00018b591, 24, 11844}, {0x00018b79e, 24, 11845}, {0x00018bdad, 24, 11846}, {0x00018c086, 24, 3266},
+	{0x00018c451, 24, 11847}, {0x00018c58a, 24, 9262}, {0x00018c8e7, 24, 11848}, {0x00018cc23, 24, 11849},
+	{0x00018cf5e, 24, 7813}, {0x00018d071, 24, 10940}, {0x00018d5b6, 24, 11850}, {0x00018d66a, 24, 11851},
+	{0x00018d6cf, 24, 11852}, {0x00018d949, 24, 11853}, {0x00018dc56, 24, 5250}, {0x00018e288, 24, 11854},
+	{0x00018e2c2, 24, 4482}, {0x00018e728, 24, 1965}, {0x00018e7f4, 24, 865}, {0x00018e80f, 24, 11855},
+	{0x00018e8dd, 24, 11856}, {0x00018ee69, 24, 865}, {0x00018ef63, 24, 3}, {0x00018f145, 24, 11857},
+	{0x00018f46a, 24, 6482}, {0x00018f643, 24, 865}, {0x00018f650, 24, 11858}, {0x00018f87a, 24, 11859},
+	{0x00018fa6f, 24, 11860}, {0x00018fb7b, 24, 2822}, {0x00018fc9f, 24, 11861}, {0x00018fe34, 24, 11862},
+	{0x00018ff0f, 24, 4472}, {0x00018ff2e, 24, 11863}, {0x0001c0656, 24, 11864}, {0x0001c08c1, 24, 11865},
+	{0x0001c0b52, 24, 11866}, {0x0001c0fcf, 24, 11867}, {0x0001c11e1, 24, 11868}, {0x0001c129d, 24, 11869},
+	{0x0001c1448, 24, 185}, {0x0001c14b3, 24, 11870}, {0x0001c17d3, 24, 3}, {0x0001c184a, 24, 11871},
+	{0x0001c19de, 24, 11872}, {0x0001c1ac0, 24, 865}, {0x0001c1b68, 24, 185}, {0x0001c1cfd, 24, 11873},
+	{0x0001c1d67, 24, 8409}, {0x0001c1d86, 24, 1965}, {0x0001c334d, 24, 11874}, {0x0001c3477, 24, 11875},
+	{0x0001c35f1, 24, 11876}, {0x0001c37bf, 24, 11877}, {0x0001c3947, 24, 6282}, {0x0001c3a4f, 24, 11878},
+	{0x0001c3de7, 24, 11879}, {0x0001c3e84, 24, 6482}, {0x0001c4024, 24, 2822}, {0x0001c4158, 24, 11880},
+	{0x0001c43ec, 24, 11881}, {0x0001c4593, 24, 4305}, {0x0001c4840, 24, 11882}, {0x0001c48f9, 24, 8026},
+	{0x0001c4af7, 24, 11883}, {0x0001c4bb9, 24, 11884}, {0x0001c4bd6, 24, 11885}, {0x0001c51b5, 24, 11886},
+	{0x0001c5216, 24, 11887}, {0x0001c52d6, 24, 11888}, {0x0001c56fe, 24, 11889}, {0x0001c5a3e, 24, 11890},
+	{0x0001c5a6b, 24, 11891}, {0x0001c5c55, 24, 11892}, {0x0001c5c60, 24, 11893}, {0x0001c5fff, 24, 11894},
+	{0x0001c62b8, 24, 1842}, {0x0001c63b7, 24, 11895}, {0x0001c659d, 24, 7813}, {0x0001c666d, 24, 11481},
+	{0x0001c66aa, 24, 4482}, {0x0001c69a5, 24, 8397}, {0x0001c6a7a, 24, 1965}, {0x0001c6bca, 24, 11896},
+	{0x0001c6f65, 24, 5352}, {0x0001c7508, 24, 6282}, {0x0001c76ca, 24, 11897}, {0x0001c7839, 24, 11898},
+	{0x0001c7b21, 24, 11230}, {0x0001c7c11, 24, 11899}, {0x0001c7c45, 24, 11900}, {0x0001c7cc7, 24, 11901},
+	{0x0001c7d22, 24, 11902}, {0x0001c7e51, 24, 11903}, {0x0001c7ee5, 24, 11768}, {0x0001c8341, 24, 11904},
+	{0x0001c83b0, 24, 11905}, {0x0001c8464, 24, 11906}, {0x0001c86ad, 24, 11907}, {0x0001c872c, 24, 99},
+	{0x0001c8e5c, 24, 9715}, {0x0001c8e8e, 24, 11908}, {0x0001c8f8a, 24, 11909}, {0x0001c9179, 24, 11910},
+	{0x0001c9492, 24, 11911}, {0x0001c955d, 24, 11912}, {0x0001c959f, 24, 11913}, {0x0001c965a, 24, 11914},
+	{0x0001c973d, 24, 11915}, {0x0001c994c, 24, 11916}, {0x0001c9c26, 24, 11917}, {0x0001c9ecb, 24, 11918},
+	{0x0001ca2b1, 24, 11919}, {0x0001ca532, 24, 11920}, {0x0001ca770, 24, 11921}, {0x0001caa07, 24, 3},
+	{0x0001cab01, 24, 11922}, {0x0001caba7, 24, 865}, {0x0001cadd1, 24, 11923}, {0x0001caf05, 24, 1842},
+	{0x0001caff7, 24, 11924}, {0x0001cb094, 24, 2176}, {0x0001cb17f, 24, 3100}, {0x0001cb243, 24, 11925},
+	{0x0001cb72c, 24, 4673}, {0x0001cba8c, 24, 4305}, {0x0001cbba8, 24, 11926}, {0x0001cbd0e, 24, 11927},
+	{0x0001cbdb9, 24, 11924}, {0x0001cc11a, 24, 11928}, {0x0001cc1de, 24, 459}, {0x0001cc316, 24, 11929},
+	{0x0001cc586, 24, 11930}, {0x0001cc63c, 24, 4428}, {0x0001cc72d, 24, 11931}, {0x0001ccae3, 24, 11626},
+	{0x0001cd40c, 24, 11932}, {0x0001cdea7, 24, 1965}, {0x0001cdf0f, 24, 3}, {0x0001ce165, 24, 11933},
+	{0x0001ce192, 24, 875}, {0x0001ce2cc, 24, 4305}, {0x0001ce62b, 24, 865}, {0x0001ce6c7, 24, 1965},
+	{0x0001ce85d, 24, 1965}, {0x0001ceee8, 24, 11934}, {0x0001cf03e, 24, 11935}, {0x0001cf061, 24, 11936},
+	{0x0001cf4ca, 24, 99}, {0x0001cf5e7, 24, 11937}, {0x0001cfa68, 24, 11491}, {0x0001cfcbb, 24, 11938},
+	{0x0001cfea7, 24, 11939}, {0x00020014f, 24, 11940}, {0x0002002af, 24, 11916}, {0x000200505, 24, 11941},
+	{0x0002005e8, 24, 11942}, {0x0002008ed, 24, 9715}, {0x000200a5e, 24, 11943}, {0x000200bc7, 24, 9262},
+	{0x000200cc8, 24, 9978}, {0x000200e95, 24, 11944}, {0x00020107a, 24, 2348}, {0x000201257, 24, 11945},
+	{0x0002012d5, 24, 11946}, {0x0002013e0, 24, 1842}, {0x0002016d8, 24, 7813}, {0x00020180e, 24, 11947},
+	{0x000201a06, 24, 6282}, {0x000201d03, 24, 11948}, {0x0002021a5, 24, 6537}, {0x000202564, 24, 9722},
+	{0x000202598, 24, 11949}, {0x0002028bc, 24, 11950}, {0x000202bc1, 24, 8409}, {0x000202cb7, 24, 11951},
+	{0x0002031eb, 24, 11952}, {0x000203706, 24, 3}, {0x0002037bc, 24, 11953}, {0x000203a07, 24, 1965},
+	{0x000204005, 24, 11954}, {0x00020415a, 24, 11955}, {0x00020443a, 24, 11956}, {0x0002046a1, 24, 11957},
+	{0x0002046f9, 24, 11958}, {0x000204747, 24, 5963}, {0x000204aaa, 24, 11959}, {0x000204c6d, 24, 11960},
+	{0x000204c9e, 24, 1965}, {0x000204e6b, 24, 11961}, {0x000204e7f, 24, 11962}, {0x0002053ca, 24, 11963},
+	{0x000205476, 24, 11230}, {0x000205721, 24, 11964}, {0x0002059a0, 24, 11965}, {0x000205a00, 24, 11966},
+	{0x000205b2a, 24, 99}, {0x000205b5e, 24, 11967}, {0x000205cfa, 24, 11968}, {0x000206274, 24, 965},
+	{0x00020635f, 24, 11969}, {0x000206432, 24, 11970}, {0x0002067b1, 24, 11971}, {0x00020689d, 24, 7813},
+	{0x000206a8a, 24, 11972}, {0x000206aff, 24, 11973}, {0x000206e9c, 24, 1842}, {0x000206fec, 24, 11974},
+	{0x000207355, 24, 185}, {0x0002074cf, 24, 11975}, {0x000207600, 24, 3726}, {0x000207693, 24, 11976},
+	{0x0002078f0, 24, 11309}, {0x000207c8f, 24, 11977}, {0x000207d74, 24, 865}, {0x00020858c, 24, 11978},
+	{0x0002087ac, 24, 11979}, {0x000208984, 24, 11980}, {0x000208986, 24, 8716}, {0x000209148, 24, 4305},
+	{0x00020918a, 24, 11981}, {0x0002091d9, 24, 11982}, {0x00020934d, 24, 11983}, {0x000209ae9, 24, 11984},
+	{0x000209ba5, 24, 11985}, {0x00020a2e4, 24, 865}, {0x00020a2e7, 24, 11986}, {0x00020a787, 24, 11987},
+	{0x00020a99b, 24, 965}, {0x00020aa25, 24, 11988}, {0x00020aa4b, 24, 4275}, {0x00020b0f7, 24, 11989},
+	{0x00020b399, 24, 4146}, {0x00020b5c6, 24, 11990}, {0x00020b7c0, 24, 11991}, {0x00020bbc0, 24, 1965},
+	{0x00020bbc6, 24, 11992}, {0x00020bfdb, 24, 11993}, {0x00020c06d, 24, 11994}, {0x00020c1af, 24, 11995},
+	{0x00020c38f, 24, 4445}, {0x00020c60d, 24, 11996}, {0x00020c6eb, 24, 11997}, {0x00020c8b3, 24, 6707},
+	{0x00020c9d0, 24, 865}, {0x00020cd39, 24, 11998}, {0x00020cec4, 24, 11999}, {0x00020cf30, 24, 4673},
+	{0x00020d21f, 24, 12000}, {0x00020d390, 24, 1842}, {0x00020d5ab, 24, 12001}, {0x00020d5bf, 24, 12002},
+	{0x00020d607, 24, 5842}, {0x00020d75a, 24, 12003}, {0x00020d906, 24, 12004}, {0x00020dc93, 24, 12005},
+	{0x00020dce6, 24, 8096}, {0x00020df3f, 24, 12006}, {0x00020e407, 24, 12007}, {0x00020e52a, 24, 9978},
+	{0x00020e564, 24, 185}, {0x00020e791, 24, 12008}, {0x00020eac7, 24, 12009}, {0x00020ed74, 24, 12010},
+	{0x00020eec6, 24, 12011}, {0x00020f002, 24, 12012}, {0x00020f3a3, 24, 9715}, {0x00020f41b, 24, 12013},
+	{0x00020f510, 24, 12014}, {0x00020f85e, 24, 12015}, {0x00020fabb, 24, 12016}, {0x00020fdf1, 24, 7030},
+	{0x00020fecd, 24, 12017}, {0x00020fedb, 24, 12018}, {0x0002401c7, 24, 1965}, {0x00024050f, 24, 12019},
+	{0x000240917, 24, 12020}, {0x000240995, 24, 9715}, {0x000240a11, 24, 11601}, {0x000240a64, 24, 12021},
+	{0x000240b2a, 24, 12022}, {0x000240bb1, 24, 12023}, {0x000241064, 24, 12024}, {0x000241125, 24, 12025},
+	{0x000241148, 24, 12026}, {0x0002411d0, 24, 12027}, {0x000241a8c, 24, 12028}, {0x000241b13, 24, 12029},
+	{0x000241b44, 24, 12030}, {0x000241c04, 24, 12031}, {0x000241f2c, 24, 12032}, {0x0002421ab, 24, 5316},
+	{0x00024240e, 24, 865}, {0x000242642, 24, 12033}, {0x000242ffa, 24, 12034}, {0x00024336c, 24, 99},
+	{0x00024374c, 24, 6959}, {0x0002437ef, 24, 12035}, {0x000243c20, 24, 12036}, {0x0002442bc, 24, 12037},
+	{0x000244597, 24, 12038}, {0x00024470e, 24, 12039}, {0x00024497b, 24, 12040}, {0x000244b03, 24, 1842},
+	{0x000244b81, 24, 1842}, {0x000244f1d, 24, 12041}, {0x000245fdf, 24, 12042}, {0x000246278, 24, 12043},
+	{0x0002464ef, 24, 12044}, {0x000246511, 24, 980}, {0x00024693e, 24, 12045}, {0x00024694a, 24, 12046},
+	{0x0002469a5, 24, 9715}, {0x000246aab, 24, 12047}, {0x000246e96, 24, 5963}, {0x000247189, 24, 4305},
+	{0x000247260, 24, 12048}, {0x000247656, 24, 12049}, {0x00024767d, 24, 6959}, {0x000247703, 24, 4472},
+	{0x000247f3c, 24, 9715}, {0x000248000, 24, 12050}, {0x0002481aa, 24, 12051}, {0x00024828a, 24, 12052},
+	{0x0002486f4, 24, 12053}, {0x000248707, 24, 12054}, {0x0002493ca, 24, 12055}, {0x000249442, 24, 12056},
+	{0x000249504, 24, 5421}, {0x0002497ed, 24, 12057}, {0x000249eab, 24, 9262}, {0x00024a074, 24, 865},
+	{0x00024a2e1, 24, 11563}, {0x00024a42c, 24, 12058}, {0x00024a43c, 24, 12059}, {0x00024a495, 24, 12060},
+	{0x00024a87d, 24, 12061}, {0x00024a937, 24, 12062}, {0x00024ab81, 24, 865}, {0x00024af4a, 24, 7986},
+	{0x00024af54, 24, 12063}, {0x00024b0a9, 24, 12064}, {0x00024b657, 24, 3}, {0x00024b6b8, 24, 12065},
+	{0x00024b6fd, 24, 2822}, {0x00024b88c, 24, 12066}, {0x00024b8d2, 24, 12067}, {0x00024ba30, 24, 12068},
+	{0x00024bbc1, 24, 12069}, {0x00024bc82, 24, 12070}, {0x00024be05, 24, 11475}, {0x00024bf74, 24, 99},
+	{0x00024c0b3, 24, 12071}, {0x00024c696, 24, 1842}, {0x00024c848, 24, 12072}, {0x00024c86e, 24, 12073},
+	{0x00024c9a1, 24, 4614}, {0x00024c9de, 24, 12074}, {0x00024cbe7, 24, 12075}, {0x00024cf21, 24, 12076},
+	{0x00024d13f, 24, 12077}, {0x00024d2cc, 24, 12078}, {0x00024d921, 24, 11282}, {0x00024dab6, 24, 12079},
+	{0x00024dbac, 24, 8409}, {0x00024dbad, 24, 12080}, {0x00024dbed, 24, 1842}, {0x00024dec6, 24, 2743},
+	{0x00024e271, 24, 12081}, {0x00024e314, 24, 865}, {0x00024e5aa, 24, 12082}, {0x00024e6ba, 24, 12083},
+	{0x00024e9b3, 24, 1965}, {0x00024ea40, 24, 12084}, {0x00024eb65, 24, 12085}, {0x00024ec99, 24, 12086},
+	{0x00024ecd6, 24, 12087}, {0x00024ee3a, 24, 12088}, {0x00024f0ff, 24, 12089}, {0x00024f2dd, 24, 12090},
+	{0x00024f5aa, 24, 8104}, {0x00024fd52, 24, 7813}, {0x0002804e0, 24, 12091}, {0x00028061e, 24, 12092},
+	{0x00028068d, 24, 12093}, {0x000280b5c, 24, 865}, {0x000280cb8, 24, 12094}, {0x000280dfc, 24, 7216},
+	{0x00028107b, 24, 11768}, {0x000281471, 24, 12095}, {0x00028162e, 24, 7228}, {0x0002817ce, 24, 12096},
+	{0x000281878, 24, 965}, {0x0002818fd, 24, 12097}, {0x000282246, 24, 12098}, {0x0002826a6, 24, 12099},
+	{0x00028285d, 24, 4551}, {0x0002829cc, 24, 12100}, {0x0002829d9, 24, 12101}, {0x000282cb2, 24, 11491},
+	{0x000283152, 24, 9262}, {0x0002832c5, 24, 12102}, {0x000283410, 24, 12103}, {0x0002834a2, 24, 1965},
+	{0x000283713, 24, 12104}, {0x000283737, 24, 865}, {0x0002838cf, 24, 12105}, {0x0002839e7, 24, 12106},
+	{0x000283b96, 24, 12107}, {0x000283ce4, 24, 9715}, {0x00028401a, 24, 12108}, {0x000284121, 24, 12109},
+	{0x000284430, 24, 12110}, {0x0002847aa, 24, 5842}, {0x000284846, 24, 12111}, {0x000284c53, 24, 12112},
+	{0x000284d92, 24, 12113}, {0x000284ed7, 24, 12114}, {0x000284fce, 24, 12115}, {0x000285132, 24, 12116},
+	{0x0002852e0, 24, 12117}, {0x000285767, 24, 2021}, {0x000285aeb, 24, 865}, {0x000285fdb, 24, 8409},
+	{0x000286046, 24, 12118}, {0x000286094, 24, 12119}, {0x000286336, 24, 12120}, {0x00028656b, 24, 12121},
+	{0x000286ab8, 24, 865}, {0x000286aba, 24, 865}, {0x000286d97, 24, 12122}, {0x000286ed4, 24, 9262},
+	{0x000287184, 24, 12123}, {0x0002872c5, 24, 12124}, {0x0002872f0, 24, 12125}, {0x000287610, 24, 12126},
+	{0x000287994, 24, 12127}, {0x000288023, 24, 11475}, {0x0002884fa, 24, 6658}, {0x00028852d, 24, 12128},
+	{0x000288915, 24, 12129}, {0x000288a1c, 24, 6820}, {0x0002891d0, 24, 12130}, {0x00028924a, 24, 11475},
+	{0x0002893fe, 24, 3}, {0x00028940f, 24, 3}, {0x0002894af, 24, 12131}, {0x00028987b, 24, 1842},
+	{0x000289a4b, 24, 12132}, {0x000289afa, 24, 11601}, {0x000289edf, 24, 12133}, {0x00028a186, 24, 12134},
+	{0x00028a192, 24, 12135}, {0x00028a1eb, 24, 12136}, {0x00028a241, 24, 12137}, {0x00028a574, 24, 12138},
+	{0x00028a5ee, 24, 12139}, {0x00028af0a, 24, 12140}, {0x00028b0cc, 24, 12141}, {0x00028b2bd, 24, 4472},
+	{0x00028b3ab, 24, 12142}, {0x00028b9d9, 24, 12143}, {0x00028ba18, 24, 12144}, {0x00028bab5, 24, 1842},
+	{0x00028bb59, 24, 12145}, {0x00028be9b, 24, 12146}, {0x00028c0da, 24, 5553}, {0x00028c2dd, 24, 5090},
+	{0x00028c671, 24, 12147}, {0x00028c68e, 24, 9978}, {0x00028c718, 24, 12148}, {0x00028c7ce, 24, 1965},
+	{0x00028c825, 24, 12149}, {0x00028c914, 24, 12150}, {0x00028cbeb, 24, 12151}, {0x00028cc01, 24, 1842},
+	{0x00028ccff, 24, 12152}, {0x00028cd1c, 24, 12153}, {0x00028cd4c, 24, 12154}, {0x00028cd9c, 24, 12155},
+	{0x00028cfda, 24, 865}, {0x00028cfe9, 24, 865}, {0x00028d1af, 24, 5842}, {0x00028d244, 24, 12156},
+	{0x00028d576, 24, 12157}, {0x00028d93e, 24, 12158}, {0x00028d98a, 24, 12159}, {0x00028d997, 24, 12160},
+	{0x00028db81, 24, 12161}, {0x00028def6, 24, 12162}, {0x00028e02c, 24, 865}, {0x00028e14c, 24, 11309},
+	{0x00028e297, 24, 12163}, {0x00028e31f, 24, 11778}, {0x00028e347, 24, 7813}, {0x00028e476, 24, 12164},
+	{0x00028e608, 24, 12165}, {0x00028e6e9, 24, 12166}, {0x00028e794, 24, 12167}, {0x00028e7cf, 24, 865},
+	{0x00028ed58, 24, 12168}, {0x00028ee2c, 24, 12169}, {0x00028ef01, 24, 99}, {0x00028f076, 24, 865},
+	{0x00028f358, 24, 12170}, {0x00028f532, 24, 12171}, {0x00028f606, 24, 12172}, {0x00028fbd3, 24, 12173},
+	{0x00028fc51, 24, 12174}, {0x00028fcf6, 24, 12175}, {0x00028fd80, 24, 11626}, {0x0002c002c, 24, 12176},
+	{0x0002c0033, 24, 12177}, {0x0002c00f7, 24, 12178}, {0x0002c010b, 24, 12179}, {0x0002c0623, 24, 12180},
+	{0x0002c073c, 24, 12181}, {0x0002c10c1, 24, 2314}, {0x0002c18ae, 24, 12182}, {0x0002c1984, 24, 12183},
+	{0x0002c1a31, 24, 12184}, {0x0002c1eea, 24, 12185}, {0x0002c1f23, 24, 865}, {0x0002c2172, 24, 5553},
+	{0x0002c245f, 24, 12186}, {0x0002c265f, 24, 11626}, {0x0002c26c5, 24, 8716}, {0x0002c27d7, 24, 459},
+	{0x0002c282d, 24, 12187}, {0x0002c2997, 24, 965}, {0x0002c2d48, 24, 12188}, {0x0002c3068, 24, 3771},
+	{0x0002c337a, 24, 6482}, {0x0002c3427, 24, 12189}, {0x0002c3557, 24, 12190}, {0x0002c36a0, 24, 12191},
+	{0x0002c36f8, 24, 3}, {0x0002c3731, 24, 12192}, {0x0002c3796, 24, 12193}, {0x0002c3996, 24, 9007},
+	{0x0002c39c1, 24, 8042}, {0x0002c3a28, 24, 12194}, {0x0002c3bfd, 24, 12195}, {0x0002c3ecf, 24, 1965},
+	{0x0002c3f38, 24, 3}, {0x0002c3f3e, 24, 12196}, {0x0002c4138, 24, 459}, {0x0002c4401, 24, 1842},
+	{0x0002c441b, 24, 12197}, {0x0002c44fd, 24, 11475}, {0x0002c5089, 24, 12198}, {0x0002c534a, 24, 12199},
+	{0x0002c542d, 24, 3}, {0x0002c54cf, 24, 6967}, {0x0002c553c, 24, 12200}, {0x0002c59e5, 24, 11475},
+	{0x0002c5a05, 24, 5842}, {0x0002c5aa3, 24, 12201}, {0x0002c5be1, 24, 12202}, {0x0002c5d93, 24, 4614},
+	{0x0002c5ff3, 24, 12203}, {0x0002c600c, 24, 11514}, {0x0002c625a, 24, 12204}, {0x0002c6289, 24, 12205},
+	{0x0002c67fb, 24, 12206}, {0x0002c69ba, 24, 12207}, {0x0002c6a6f, 24, 11626}, {0x0002c6bf5, 24, 6820},
+	{0x0002c7155, 24, 12208}, {0x0002c72c3, 24, 12209}, {0x0002c750f, 24, 12210}, {0x0002c768a, 24, 459},
+	{0x0002c7b5a, 24, 12211}, {0x0002c7b84, 24, 12212}, {0x0002c7ecf, 24, 12213}, {0x0002c8065, 24, 12214},
+	{0x0002c8158, 24, 12215}, {0x0002c8a72, 24, 2176}, {0x0002c8bf2, 24, 12216}, {0x0002c9127, 24, 12217},
+	{0x0002c922c, 24, 12218}, {0x0002c9464, 24, 12219}, {0x0002c957f, 24, 8716}, {0x0002c9717, 24, 12220},
+	{0x0002c9aa4, 24, 12221}, {0x0002c9e5f, 24, 185}, {0x0002c9efc, 24, 124}, {0x0002ca157, 24, 12222},
+	{0x0002ca2b4, 24, 12223}, {0x0002ca30e, 24, 12224}, {0x0002ca780, 24, 12225}, {0x0002ca835, 24, 8028},
+	{0x0002cab25, 24, 11920}, {0x0002caba4, 24, 6959}, {0x0002cad13, 24, 12226}, {0x0002cb05d, 24, 11962},
+	{0x0002cb0df, 24, 12227}, {0x0002cb43a, 24, 865}, {0x0002cb693, 24, 894}, {0x0002cb69d, 24, 12228},
+	{0x0002cbe08, 24, 865}, {0x0002cbe97, 24, 12229}, {0x0002cc260, 24, 12230}, {0x0002cc548, 24, 12231},
+	{0x0002cc5d3, 24, 4614}, {0x0002ccc15, 24, 5842}, {0x0002ccd27, 24, 12232}, {0x0002ccd43, 24, 12233},
+	{0x0002ccd69, 24, 12234}, {0x0002cd05a, 24, 7813}, {0x0002cd141, 24, 11626}, {0x0002cd1da, 24, 12235},
+	{0x0002cd2e7, 24, 5842}, {0x0002cd444, 24, 7968}, {0x0002cdd0c, 24, 12236}, {0x0002ce2a8, 24, 12237},
+	{0x0002ce412, 24, 11812}, {0x0002ce6cc, 24, 4614}, {0x0002ce871, 24, 12238}, {0x0002cedeb, 24, 12239},
+	{0x0002cee26, 24, 12240}, {0x0002cf0ee, 24, 865}, {0x0002cf203, 24, 12241}, {0x0002cf4c5, 24, 11282},
+	{0x0002cf7f1, 24, 12242}, {0x0002cfaa2, 24, 5554}, {0x00030055c, 24, 12243}, {0x000300b9c, 24, 12244},
+	{0x000300c23, 24, 8716}, {0x000300d2a, 24, 12245}, {0x000300d43, 24, 12246}, {0x000300ed5, 24, 11481},
+	{0x000300ee3, 24, 12247}, {0x0003010b3, 24, 7813}, {0x0003010e4, 24, 11309}, {0x00030142d, 24, 12248},
+	{0x00030144a, 24, 2718}, {0x000301518, 24, 12249}, {0x00030168d, 24, 12250}, {0x0003017c8, 24, 2582},
+	{0x0003018cf, 24, 12251}, {0x000301966, 24, 1842}, {0x000301a28, 24, 12252}, {0x00030215b, 24, 12253},
+	{0x000302de8, 24, 12254}, {0x000303294, 24, 12255}, {0x0003032d4, 24, 12256}, {0x000303335, 24, 12257},
+	{0x0003037a6, 24, 3}, {0x000303855, 24, 5842}, {0x000303926, 24, 2582}, {0x000303955, 24, 12258},
+	{0x0003039f2, 24, 1957}, {0x000303a64, 24, 4472}, {0x000303d08, 24, 12259}, {0x000303ead, 24, 12260},
+	{0x000304174, 24, 12261}, {0x000304225, 24, 12262}, {0x000304449, 24, 12263}, {0x000304487, 24, 11753},
+	{0x00030469a, 24, 11962}, {0x00030493b, 24, 12264}, {0x000304c7e, 24, 12265}, {0x000304ec3, 24, 12266},
+	{0x0003051f8, 24, 12267}, {0x00030525a, 24, 12268}, {0x0003055ed, 24, 12269}, {0x0003057ac, 24, 12270},
+	{0x00030595b, 24, 12271}, {0x0003059b7, 24, 12272}, {0x000305d38, 24, 12273}, {0x000306023, 24, 185},
+	{0x000306112, 24, 12274}, {0x000306118, 24, 12275}, {0x0003065ec, 24, 12276}, {0x00030688c, 24, 12277},
+	{0x00030694b, 24, 8028}, {0x000306cbe, 24, 12278}, {0x000306e5c, 24, 12279}, {0x0003071b2, 24, 12280},
+	{0x000307350, 24, 12281}, {0x000307512, 24, 11230}, {0x00030766f, 24, 6967}, {0x0003077cb, 24, 12282},
+	{0x00030786b, 24, 12283}, {0x0003078c2, 24, 12284}, {0x000307c30, 24, 8028}, {0x000307c5e, 24, 5553},
+	{0x000307ecb, 24, 5421}, {0x0003085a9, 24, 12285}, {0x000308730, 24, 8409}, {0x000308999, 24, 12286},
+	{0x0003089d3, 24, 12287}, {0x000308cfb, 24, 12288}, {0x000308d99, 24, 11475}, {0x0003090ab, 24, 865},
+	{0x00030918f, 24, 12289}, {0x0003092f6, 24, 12290}, {0x000309bad, 24, 12291}, {0x00030a8db, 24, 11230},
+	{0x00030aabd, 24, 12292}, {0x00030ae7b, 24, 12293}, {0x00030aef6, 24, 12294}, {0x00030b216, 24, 12295},
+	{0x00030b3a2, 24, 12296}, {0x00030b5c2, 24, 11491}, {0x00030b5f1, 24, 12297}, {0x00030c750, 24, 12298},
+	{0x00030c7ae, 24, 1842}, {0x00030c82a, 24, 12299}, {0x00030cda7, 24, 12300}, {0x00030d17e, 24, 9262},
+	{0x00030d357, 24, 12301}, {0x00030d46a, 24, 12302}, {0x00030d587, 24, 1842}, {0x00030d6c9, 24, 1842},
+	{0x00030de86, 24, 12303}, {0x00030e48e, 24, 12304}, {0x00030e4db, 24, 3}, {0x00030eb25, 24, 12305},
+	{0x00030efd1, 24, 12306}, {0x00030f31d, 24, 8716}, {0x00030f335, 24, 9262}, {0x00030f33a, 24, 12307},
+	{0x00030f42f, 24, 12308}, {0x00030f70d, 24, 9155}, {0x00030f7c5, 24, 865}, {0x00030f7d7, 24, 12309},
+	{0x00030f9ed, 24, 311}, {0x00030fab7, 24, 12310}, {0x00030fd11, 24, 12311}, {0x0003400a3, 24, 9262},
+	{0x000340286, 24, 4472}, {0x00034029b, 24, 12312}, {0x0003407fb, 24, 11261}, {0x000340804, 24, 3223},
+	{0x000340a22, 24, 12313}, {0x000340aff, 24, 12081}, {0x000340b40, 24, 12314}, {0x000341298, 24, 865},
+	{0x0003413a8, 24, 12315}, {0x0003413e8, 24, 4472}, {0x00034159e, 24, 865}, {0x0003417eb, 24, 2822},
+	{0x000341a4c, 24, 12316}, {0x000341b22, 24, 12317}, {0x000342109, 24, 12318}, {0x000342387, 24, 6482},
+	{0x0003423ba, 24, 11743}, {0x00034255d, 24, 12319}, {0x0003428f0, 24, 12320}, {0x0003429ea, 24, 12321},
+	{0x000342f6e, 24, 12322}, {0x000343111, 24, 1842}, {0x0003431c4, 24, 980}, {0x00034363b, 24, 865},
+	{0x0003438af, 24, 12323}, {0x000343d98, 24, 12324}, {0x0003440b5, 24, 12325}, {0x00034466f, 24, 12326},
+	{0x000344b3d, 24, 11300}, {0x000344b50, 24, 5972}, {0x000344ca4, 24, 12327}, {0x000344dea, 24, 8716},
+	{0x000344df7, 24, 6967}, {0x000344f3f, 24, 12328}, {0x000344f5c, 24, 12329}, {0x000344f69, 24, 12330},
+	{0x0003451aa, 24, 12331}, {0x0003451c9, 24, 865}, {0x000345b11, 24, 12332}, {0x000345c40, 24, 12333},
+	{0x000345d10, 24, 12334}, {0x000346178, 24, 1672}, {0x000346288, 24, 1965}, {0x0003464a9, 24, 11475},
+	{0x00034684a, 24, 12335}, {0x000346895, 24, 6482}, {0x000346bd3, 24, 9715}, {0x000346c0f, 24, 12336},
+	{0x000346e8a, 24, 12337}, {0x000346f90, 24, 1965}, {0x000346f92, 24, 12338}, {0x0003475c7, 24, 11282},
+	{0x0003476c5, 24, 10383}, {0x000347877, 24, 12339}, {0x000347a60, 24, 6621}, {0x000347e39, 24, 709},
+	{0x0003480b3, 24, 11809}, {0x000348137, 24, 12340}, {0x0003481c4, 24, 980}, {0x0003481f4, 24, 12341},
+	{0x0003482de, 24, 12342}, {0x000348302, 24, 12343}, {0x000348446, 24, 11261}, {0x00034862a, 24, 12344},
+	{0x00034873d, 24, 12345}, {0x00034885d, 24, 12346}, {0x000348aae, 24, 11812}, {0x0003495db, 24, 375},
+	{0x0003497fb, 24, 12347}, {0x00034996f, 24, 12348}, {0x0003499d7, 24, 12349}, {0x000349a0d, 24, 12350},
+	{0x000349b5b, 24, 12351}, {0x000349d90, 24, 12352}, {0x000349e34, 24, 12353}, {0x00034a183, 24, 12354},
+	{0x00034a395, 24, 865}, {0x00034a3bf, 24, 12355}, {0x00034a55d, 24, 12356}, {0x00034a5e1, 24, 12357},
+	{0x00034a68c, 24, 12358}, {0x00034a709, 24, 12359}, {0x00034a7ba, 24, 12360}, {0x00034a843, 24, 12361},
+	{0x00034a84e, 24, 1965}, {0x00034aa8b, 24, 1842}, {0x00034aa99, 24, 5554}, {0x00034aaee, 24, 12362},
+	{0x00034ade4, 24, 12363}, {0x00034af2c, 24, 2314}, {0x00034b1f7, 24, 4305}, {0x00034b571, 24, 12364},
+	{0x00034b7fd, 24, 12365}, {0x00034ba51, 24, 12366}, {0x00034ba9a, 24, 12367}, {0x00034bb1f, 24, 8397},
+	{0x00034bb26, 24, 11721}, {0x00034bca6, 24, 12368}, {0x00034bdc8, 24, 9155}, {0x00034bdf9, 24, 12369},
+	{0x00034bdfa, 24, 6959}, {0x00034be00, 24, 1842}, {0x00034bf90, 24, 11300}, {0x00034c059, 24, 865},
+	{0x00034c3ac, 24, 4482}, {0x00034c5d0, 24, 12370}, {0x00034c69a, 24, 12371}, {0x00034c731, 24, 1671},
+	{0x00034c803, 24, 5842}, {0x00034c99d, 24, 12372}, {0x00034cc28, 24, 12373}, {0x00034cd6d, 24, 12374},
+	{0x00034cdbe, 24, 9715}, {0x00034ce94, 24, 12375}, {0x00034d09b, 24, 12376}, {0x00034d2c4, 24, 12377},
+	{0x00034d7b4, 24, 12378}, {0x00034dbfd, 24, 1965}, {0x00034de1a, 24, 4472}, {0x00034de34, 24, 8716},
+	{0x00034df2a, 24, 12379}, {0x00034e0cf, 24, 8716}, {0x00034e0d7, 24, 12380}, {0x00034e2fd, 24, 865},
+	{0x00034e42a, 24, 12381}, {0x00034e6ad, 24, 4472}, {0x00034e6d7, 24, 5963}, {0x00034ef44, 24, 7228},
+	{0x00034ef8b, 24, 12382}, {0x00034f0ca, 24, 12383}, {0x00034f39b, 24, 12384}, {0x00034f62d, 24, 6658},
+	{0x00034f6d2, 24, 12385}, {0x00034f968, 24, 12386}, {0x00034fa40, 24, 12387}, {0x00034fc6f, 24, 12388},
+	{0x00034fcef, 24, 6967}, {0x000380195, 24, 1842}, {0x000380197, 24, 12389}, {0x0003806b4, 24, 12390},
+	{0x0003808fd, 24, 12391}, {0x0003809a4, 24, 12392}, {0x000380a0a, 24, 12393}, {0x000380a94, 24, 1842},
+	{0x000380b40, 24, 1842}, {0x000380dd4, 24, 12394}, {0x000380e7b, 24, 12395}, {0x000380f4a, 24, 865},
+	{0x000380fe4, 24, 12396}, {0x0003816d1, 24, 1842}, {0x000381766, 24, 12397}, {0x00038192f, 24, 5842},
+	{0x000381c1a, 24, 1965}, {0x000381c4a, 24, 12398}, {0x00038229d, 24, 8044}, {0x0003822d6, 24, 12399},
+	{0x00038262b, 24, 12400}, {0x0003826cd, 24, 12401}, {0x0003828ea, 24, 12402}, {0x000382b78, 24, 12403},
+	{0x000382c4a, 24, 4673}, {0x000382dd1, 24, 1842}, {0x0003831ac, 24, 12404}, {0x000383bc8, 24, 12405},
+	{0x000383f10, 24, 12406}, {0x000384233, 24, 12407}, {0x0003842a6, 24, 12408}, {0x000384369, 24, 12409},
+	{0x00038458c, 24, 12410}, {0x000384608, 24, 5972}, {0x00038484c, 24, 865}, {0x000384b76, 24, 12411},
+	{0x000384ff0, 24, 12412}, {0x00038521a, 24, 12413}, {0x00038580c, 24, 12414}, {0x0003859f8, 24, 12415},
+	{0x0003859f9, 24, 6482}, {0x000385aa8, 24, 12416}, {0x000385fc3, 24, 12417}, {0x000386077, 24, 9722},
+	{0x0003863bb, 24, 11475}, {0x0003863f6, 24, 12418}, {0x000386645, 24, 12419}, {0x000386793, 24, 12420},
+	{0x000386bbb, 24, 185}, {0x000386c9b, 24, 12421}, {0x000386e21, 24, 12422}, {0x0003872c0, 24, 12423},
+	{0x000387b47, 24, 12424}, {0x000388345, 24, 8096}, {0x0003889dc, 24, 12425}, {0x000388ab7, 24, 12426},
+	{0x000388ee7, 24, 12427}, {0x0003891fb, 24, 12428}, {0x000389496, 24, 11234}, {0x000389592, 24, 12429},
+	{0x000389f83, 24, 12430}, {0x00038a53c, 24, 12431}, {0x00038a5b6, 24, 12432}, {0x00038a851, 24, 12433},
+	{0x00038a86b, 24, 12434}, {0x00038a95f, 24, 12435}, {0x00038aa3c, 24, 12436}, {0x00038b12d, 24, 12437},
+	{0x00038b1db, 24, 6482}, {0x00038b5bd, 24, 12438}, {0x00038b74d, 24, 12439}, {0x00038bb23, 24, 12440},
+	{0x00038bb3c, 24, 11282}, {0x00038bc1a, 24, 12441}, {0x00038bf2f, 24, 12442}, {0x00038bf33, 24, 11639},
+	{0x00038c096, 24, 12443}, {0x00038c70a, 24, 12444}, {0x00038c7ba, 24, 12445}, {0x00038c85c, 24, 6959},
+	{0x00038c9a9, 24, 12446}, {0x00038ca97, 24, 12447}, {0x00038d135, 24, 12448}, {0x00038d82f, 24, 8716},
+	{0x00038dbbb, 24, 12449}, {0x00038de60, 24, 12450}, {0x00038e08e, 24, 11662}, {0x00038e595, 24, 11920},
+	{0x00038e7d8, 24, 2176}, {0x00038e8df, 24, 12451}, {0x00038e98c, 24, 12452}, {0x00038eaa7, 24, 11475},
+	{0x00038ec11, 24, 12453}, {0x00038ece4, 24, 4482}, {0x00038ee9d, 24, 12454}, {0x00038f098, 24, 12455},
+	{0x00038f33f, 24, 12456}, {0x00038f597, 24, 12457}, {0x00038f708, 24, 12458}, {0x00038f889, 24, 9715},
+	{0x00038f8b7, 24, 12459}, {0x00038faca, 24, 12460}, {0x00038fec5, 24, 12461}, {0x0003c02b1, 24, 12462},
+	{0x0003c04bf, 24, 12463}, {0x0003c05ab, 24, 12464}, {0x0003c0754, 24, 865}, {0x0003c0771, 24, 311},
+	{0x0003c081e, 24, 12465}, {0x0003c08f6, 24, 1965}, {0x0003c096d, 24, 12466}, {0x0003c0c48, 24, 12467},
+	{0x0003c0e23, 24, 1965}, {0x0003c0fc1, 24, 12468}, {0x0003c1040, 24, 12469}, {0x0003c106f, 24, 12470},
+	{0x0003c15c2, 24, 865}, {0x0003c15ea, 24, 12471}, {0x0003c189f, 24, 5842}, {0x0003c18a0, 24, 12472},
+	{0x0003c1915, 24, 12473}, {0x0003c197d, 24, 11261}, {0x0003c1a0f, 24, 12474}, {0x0003c1a57, 24, 12475},
+	{0x0003c1a79, 24, 12476}, {0x0003c1cbe, 24, 12477}, {0x0003c1e04, 24, 11768}, {0x0003c1e13, 24, 12478},
+	{0x0003c25d7, 24, 5842}, {0x0003c26d5, 24, 12479}, {0x0003c2763, 24, 12480}, {0x0003c2c94, 24, 12481},
+	{0x0003c2db7, 24, 4305}, {0x0003c2f3a, 24, 12482}, {0x0003c300c, 24, 12483}, {0x0003c3178, 24, 12484},
+	{0x0003c363d, 24, 5842}, {0x0003c36e4, 24, 12485}, {0x0003c3888, 24, 12486}, {0x0003c39c3, 24, 12487},
+	{0x0003c39e7, 24, 11626}, {0x0003c3a73, 24, 11282}, {0x0003c404f, 24, 12488}, {0x0003c438e, 24, 185},
+	{0x0003c46d8, 24, 11491}, {0x0003c4711, 24, 9262}, {0x0003c4937, 24, 12489}, {0x0003c4a92, 24, 459},
+	{0x0003c4c69, 24, 12490}, {0x0003c4e47, 24, 12491}, {0x0003c57bd, 24, 12492}, {0x0003c57d5, 24, 12493},
+	{0x0003c5a37, 24, 4482}, {0x0003c5ab4, 24, 12494}, {0x0003c5cc3, 24, 12495}, {0x0003c5ec3, 24, 1965},
+	{0x0003c5f01, 24, 12496}, {0x0003c6104, 24, 5553}, {0x0003c6200, 24, 12497}, {0x0003c6278, 24, 12498},
+	{0x0003c672c, 24, 12499}, {0x0003c6a7d, 24, 12500}, {0x0003c6a9d, 24, 12501}, {0x0003c6e63, 24, 12502},
+	{0x0003c6f45, 24, 12503}, {0x0003c6ff7, 24, 12504}, {0x0003c7059, 24, 12505}, {0x0003c7437, 24, 8028},
+	{0x0003c754a, 24, 185}, {0x0003c77e6, 24, 6482}, {0x0003c7db1, 24, 4305}, {0x0003c81d8, 24, 11812},
+	{0x0003c8375, 24, 965}, {0x0003c83b5, 24, 12506}, {0x0003c86a8, 24, 12507}, {0x0003c89a6, 24, 12508},
+	{0x0003c8ab0, 24, 5553}, {0x0003c8ae5, 24, 12509}, {0x0003c8bfe, 24, 4482}, {0x0003c8c40, 24, 11618},
+	{0x0003c912b, 24, 12510}, {0x0003c9157, 24, 12511}, {0x0003c9174, 24, 12512}, {0x0003c94d5, 24, 5553},
+	{0x0003c970e, 24, 12513}, {0x0003c977e, 24, 12514}, {0x0003c98bf, 24, 12515}, {0x0003c99f7, 24, 12516},
+	{0x0003c9f81, 24, 12517}, {0x0003ca10d, 24, 1842}, {0x0003ca315, 24, 12518}, {0x0003ca31a, 24, 12519},
+	{0x0003ca72b, 24, 3736}, {0x0003ca82a, 24, 11475}, {0x0003ca9f4, 24, 4472}, {0x0003caa3f, 24, 12520},
+	{0x0003cab8e, 24, 865}, {0x0003cae69, 24, 12521}, {0x0003cb15b, 24, 11282}, {0x0003cb17f, 24, 12522},
+	{0x0003cb792, 24, 12523}, {0x0003cb87a, 24, 99}, {0x0003cb9a6, 24, 12524}, {0x0003cbdd8, 24, 12525},
+	{0x0003cc0c6, 24, 12526}, {0x0003cc12c, 24, 12527}, {0x0003cc1f6, 24, 12528}, {0x0003cc243, 24, 5842},
+	{0x0003cc2e1, 24, 12529}, {0x0003cc99e, 24, 12530}, {0x0003cca87, 24, 12531}, {0x0003ccb7c, 24, 12532},
+	{0x0003ccd5a, 24, 12533}, {0x0003ccd93, 24, 12525}, {0x0003cce15, 24, 12534}, {0x0003cce73, 24, 3},
+	{0x0003cd0f8, 24, 865}, {0x0003cd16e, 24, 12535}, {0x0003cd4d6, 24, 12536}, {0x0003cd7da, 24, 12537},
+	{0x0003cd92b, 24, 459}, {0x0003cd9ce, 24, 12538}, {0x0003cda2a, 24, 8716}, {0x0003cdf1e, 24, 3},
+	{0x0003cdfa9, 24, 185}, {0x0003cdfbd, 24, 9715}, {0x0003ce072, 24, 865}, {0x0003ce5a6, 24, 3769},
+	{0x0003ce5b4, 24, 12539}, {0x0003ce624, 24, 12540}, {0x0003cea4f, 24, 7228}, {0x0003ceafb, 24, 12541},
+	{0x0003cf392, 24, 12542}, {0x0003cf52c, 24, 12543}, {0x0003cf72a, 24, 5842}, {0x0003cf748, 24, 12544},
+	{0x0003cf808, 24, 9262}, {0x0003cfb96, 24, 12545}, {0x0003cfdfe, 24, 4472}, {0x000400107, 24, 12546},
+	{0x0004001c6, 24, 7030}, {0x00040040c, 24, 12547}, {0x0004007c0, 24, 12548}, {0x000400e67, 24, 12549},
+	{0x000400e85, 24, 11743}, {0x0004011dc, 24, 12550}, {0x0004012e4, 24, 12551}, {0x0004013d9, 24, 12552},
+	{0x000401597, 24, 12553}, {0x00040167e, 24, 4673}, {0x00040169f, 24, 8096}, {0x0004016fa, 24, 12554},
+	{0x0004018b1, 24, 12555}, {0x0004018d7, 24, 1230}, {0x000401d59, 24, 12556}, {0x0004022ed, 24, 12557},
+	{0x0004025c2, 24, 4472}, {0x00040270b, 24, 12558}, {0x000402814, 24, 12559}, {0x000402ba1, 24, 2582},
+	{0x000402cf4, 24, 3976}, {0x000403004, 24, 865}, {0x000403067, 24, 12560}, {0x00040331a, 24, 11309},
+	{0x00040336c, 24, 12561}, {0x0004037ad, 24, 12562}, {0x000403cfc, 24, 865}, {0x000404022, 24, 12563},
+	{0x00040406b, 24, 12564}, {0x0004045da, 24, 12565}, {0x000404a03, 24, 4551}, {0x000404a18, 24, 12566},
+	{0x000404d8e, 24, 8409}, {0x000404eeb, 24, 12567}, {0x0004050e0, 24, 12568}, {0x00040516c, 24, 12569},
+	{0x00040520d, 24, 12570}, {0x000405539, 24, 3}, {0x00040560c, 24, 12571}, {0x000405a9b, 24, 12572},
+	{0x000405fbe, 24, 8028}, {0x000405fc2, 24, 4305}, {0x00040605a, 24, 12573}, {0x000406186, 24, 12574},
+	{0x00040618e, 24, 12575}, {0x0004062b6, 24, 12576}, {0x00040667a, 24, 12577}, {0x000406826, 24, 12578},
+	{0x000406aab, 24, 8028}, {0x000406c8f, 24, 865}, {0x000406f2a, 24, 8397}, {0x000407009, 24, 185},
+	{0x00040704a, 24, 12579}, {0x000407074, 24, 12580}, {0x000407496, 24, 12581}, {0x00040786a, 24, 11721},
+	{0x000407875, 24, 12582}, {0x000407a80, 24, 5842}, {0x000407b1b, 24, 12583}, {0x000407fe0, 24, 12584},
+	{0x000408256, 24, 12585}, {0x0004083de, 24, 1217}, {0x000408493, 24, 12586}, {0x0004088e0, 24, 12587},
+	{0x000408a9a, 24, 12588}, {0x000408b07, 24, 3726}, {0x000408bf6, 24, 12589}, {0x000408d5c, 24, 5352},
+	{0x000409558, 24, 12590}, {0x0004097d1, 24, 12591}, {0x00040984c, 24, 12592}, {0x00040984e, 24, 4305},
+	{0x00040987b, 24, 12590}, {0x000409b0d, 24, 12593}, {0x000409fc7, 24, 12594}, {0x00040a5ef, 24, 12595},
+	{0x00040a677, 24, 5553}, {0x00040a6a4, 24, 12596}, {0x00040a6d9, 24, 865}, {0x00040a6e8, 24, 1965},
+	{0x00040a8f0, 24, 11475}, {0x00040ac8d, 24, 12597}, {0x00040b0fa, 24, 6967}, {0x00040b2c8, 24, 108},
+	{0x00040b395, 24, 865}, {0x00040b3cd, 24, 12598}, {0x00040b3fc, 24, 12599}, {0x00040b4f0, 24, 5553},
+	{0x00040b6b1, 24, 12600}, {0x00040b7f3, 24, 185}, {0x00040b837, 24, 11230}, {0x00040b89a, 24, 6482},
+	{0x00040ba61, 24, 12601}, {0x00040bc73, 24, 12602}, {0x00040bc8b, 24, 12603}, {0x00040bd9e, 24, 12604},
+	{0x00040bf17, 24, 12605}, {0x00040c245, 24, 12606}, {0x00040c4d6, 24, 12607}, {0x00040c62a, 24, 12608},
+	{0x00040c7c9, 24, 12609}, {0x00040cba8, 24, 9715}, {0x00040cd3a, 24, 12610}, {0x00040d28a, 24, 2314},
+	{0x00040d32d, 24, 865}, {0x00040d40e, 24, 12611}, {0x00040d559, 24, 12612}, {0x00040d855, 24, 12613},
+	{0x00040e230, 24, 5090}, {0x00040e730, 24, 12614}, {0x00040e793, 24, 12615}, {0x00040eace, 24, 12616},
+	{0x00040ecf8, 24, 456}, {0x00040ef4c, 24, 12617}, {0x00040f02f, 24, 7813}, {0x00040f14c, 24, 12618},
+	{0x00040f201, 24, 9007}, {0x00040f2e9, 24, 12325}, {0x00040f308, 24, 11916}, {0x00040f407, 24, 2314},
+	{0x00040f4ec, 24, 3}, {0x00040f52e, 24, 12619}, {0x00040fc89, 24, 185}, {0x0004403a7, 24, 1965},
+	{0x000440cfd, 24, 12620}, {0x0004411c2, 24, 12621}, {0x000441319, 24, 12622}, {0x00044184f, 24, 12623},
+	{0x0004419b6, 24, 12624}, {0x000441e91, 24, 12625}, {0x000441ea1, 24, 459}, {0x0004423aa, 24, 12626},
+	{0x0004425bb, 24, 12627}, {0x000442938, 24, 12628}, {0x000442a60, 24, 865}, {0x000442aff, 24, 12629},
+	{0x000442b03, 24, 3}, {0x000443192, 24, 11475}, {0x00044322a, 24, 11282}, {0x0004432c8, 24, 12146},
+	{0x00044334c, 24, 12013}, {0x00044348f, 24, 12630}, {0x00044356f, 24, 12631}, {0x000443719, 24, 12632},
+	{0x00044376f, 24, 12633}, {0x0004437e6, 24, 11481}, {0x000443839, 24, 12634}, {0x0004439c4, 24, 12635},
+	{0x000443c9c, 24, 12636}, {0x000443d21, 24, 12637}, {0x000443eb2, 24, 12638}, {0x000444891, 24, 2859},
+	{0x000444a65, 24, 12639}, {0x000444c0c, 24, 865}, {0x000444e1a, 24, 1842}, {0x000444f5e, 24, 12640},
+	{0x0004451db, 24, 12641}, {0x0004454c0, 24, 12642}, {0x0004455b1, 24, 9262}, {0x00044568d, 24, 12643},
+	{0x0004456b7, 24, 12644}, {0x000445829, 24, 6959}, {0x00044599f, 24, 12645}, {0x000445ecd, 24, 12646},
+	{0x000445ef3, 24, 12647}, {0x000445f7a, 24, 12648}, {0x000445f8c, 24, 12649}, {0x000446132, 24, 12650},
+	{0x00044619c, 24, 12651}, {0x00044656a, 24, 12652}, {0x00044666e, 24, 12653}, {0x000446755, 24, 12654},
+	{0x0004468ab, 24, 12655}, {0x000446c24, 24, 12656}, {0x000446d57, 24, 7813}, {0x000446d6c, 24, 11234},
+	{0x00044700b, 24, 12657}, {0x000447098, 24, 12658}, {0x00044746c, 24, 11230}, {0x000447bc4, 24, 12659},
+	{0x000447c7f, 24, 12660}, {0x000447da5, 24, 12661}, {0x000447e76, 24, 12662}, {0x000447e95, 24, 12663},
+	{0x0004480eb, 24, 11889}, {0x000448312, 24, 12664}, {0x000448500, 24, 4472}, {0x0004486c1, 24, 12665},
+	{0x0004487fc, 24, 8340}, {0x0004488cb, 24, 12666}, {0x000448a5b, 24, 12667}, {0x000448c52, 24, 12668},
+	{0x000448e12, 24, 12669}, {0x000448e81, 24, 12670}, {0x0004491db, 24, 12671}, {0x0004494fc, 24, 9978},
+	{0x0004495fa, 24, 12672}, {0x00044962b, 24, 12673}, {0x00044975a, 24, 12674}, {0x000449b78, 24, 12675},
+	{0x000449cb5, 24, 12676}, {0x00044a42d, 24, 11601}, {0x00044a689, 24, 12677}, {0x00044a6e5, 24, 12678},
+	{0x00044a7cf, 24, 3430}, {0x00044a842, 24, 5963}, {0x00044a8c2, 24, 12679}, {0x00044aa27, 24, 12680},
+	{0x00044aae8, 24, 12681}, {0x00044add9, 24, 1965}, {0x00044b382, 24, 12682}, {0x00044c15c, 24, 4305},
+	{0x00044c233, 24, 12683}, {0x00044c306, 24, 12684}, {0x00044c39b, 24, 12685}, {0x00044c4a9, 24, 12686},
+	{0x00044c56f, 24, 12687}, {0x00044c69b, 24, 12688}, {0x00044c9a2, 24, 12689}, {0x00044ce7d, 24, 5421},
+	{0x00044d15e, 24, 12690}, {0x00044d244, 24, 12691}, {0x00044d2ca, 24, 12692}, {0x00044d3ca, 24, 3},
+	{0x00044d4e0, 24, 11230}, {0x00044d63d, 24, 12693}, {0x00044d832, 24, 12412}, {0x00044d884, 24, 865},
+	{0x00044d9e7, 24, 12694}, {0x00044dc91, 24, 12695}, {0x00044dccb, 24, 12696}, {0x00044e08e, 24, 6959},
+	{0x00044e137, 24, 185}, {0x00044e49a, 24, 12697}, {0x00044e4d9, 24, 3}, {0x00044e8a5, 24, 12698},
+	{0x00044e9dd, 24, 11812}, {0x00044ed57, 24, 12699}, {0x00044ee30, 24, 12700}, {0x00044f436, 24, 8716},
+	{0x00044f459, 24, 4482}, {0x00044f477, 24, 5553}, {0x00044f849, 24, 12701}, {0x00044fb42, 24, 865},
+	{0x00048022a, 24, 12702}, {0x000480362, 24, 12703}, {0x00048066a, 24, 12704}, {0x000480c49, 24, 12705},
+	{0x000480fcf, 24, 11475}, {0x000481249, 24, 12706}, {0x0004813f3, 24, 12707}, {0x00048174c, 24, 12708},
+	{0x000481842, 24, 12709}, {0x000481a84, 24, 12710}, {0x000481bd2, 24, 12711}, {0x000481d70, 24, 6959},
+	{0x0004826e8, 24, 12712}, {0x00048282f, 24, 5972}, {0x000482cea, 24, 12713}, {0x0004833dd, 24, 12714},
+	{0x00048343d, 24, 12715}, {0x000483d32, 24, 12716}, {0x00048437c, 24, 865}, {0x000484487, 24, 6959},
+	{0x0004844f7, 24, 5076}, {0x0004846f1, 24, 12717}, {0x0004846fb, 24, 9262}, {0x000485073, 24, 965},
+	{0x0004851b7, 24, 4472}, {0x000485261, 24, 12718}, {0x000485415, 24, 12719}, {0x00048555f, 24, 11300},
+	{0x0004857dd, 24, 12720}, {0x000485929, 24, 6967}, {0x000485a3f, 24, 12721}, {0x000485ab6, 24, 6482},
+	{0x000485b39, 24, 4673}, {0x000485d36, 24, 12722}, {0x000485d60, 24, 12412}, {0x0004860bc, 24, 865},
+	{0x0004861a3, 24, 12723}, {0x000486276, 24, 9262}, {0x000486b2c, 24, 12291}, {0x000486b91, 24, 12724},
+	{0x000486e73, 24, 12725}, {0x000486efb, 24, 12726}, {0x000486fd2, 24, 12727}, {0x000487119, 24, 12728},
+	{0x00048746e, 24, 865}, {0x000487604, 24, 99}, {0x000488244, 24, 12729}, {0x0004886e8, 24, 965},
+	{0x000488e42, 24, 12730}, {0x000489153, 24, 12731}, {0x0004891f6, 24, 12732}, {0x000489be2, 24, 12733},
+	{0x000489d18, 24, 12734}, {0x000489d24, 24, 8397}, {0x00048a22d, 24, 12735}, {0x00048a2b7, 24, 12736},
+	{0x00048a6d2, 24, 12737}, {0x00048a9d2, 24, 2718}, {0x00048aa5d, 24, 12738}, {0x00048b253, 24, 12739},
+	{0x00048b5a7, 24, 12740}, {0x00048b8de, 24, 12741}, {0x00048b977, 24, 12742}, {0x00048b9c2, 24, 12743},
+	{0x00048be2d, 24, 12744}, {0x00048c093, 24, 12745}, {0x00048c1ac, 24, 5910}, {0x00048c862, 24, 12746},
+	{0x00048c8b6, 24, 12747}, {0x00048cb6e, 24, 12748}, {0x00048d0cf, 24, 12749}, {0x00048d18e, 24, 12750},
+	{0x00048d224, 24, 7813}, {0x00048d54c, 24, 12751}, {0x00048d705, 24, 865}, {0x00048d7ff, 24, 12752},
+	{0x00048d855, 24, 12753}, {0x00048d8fe, 24, 12754}, {0x00048dcfb, 24, 5842}, {0x00048df1c, 24, 12755},
+	{0x00048e1af, 24, 12756}, {0x00048e9f1, 24, 865}, {0x00048ea63, 24, 12757}, {0x00048eb30, 24, 12758},
+	{0x00048ed80, 24, 12759}, {0x00048ee07, 24, 12760}, {0x00048ee0c, 24, 11768}, {0x00048ee86, 24, 12761},
+	{0x00048f230, 24, 12762}, {0x00048f317, 24, 99}, {0x00048f47d, 24, 12763}, {0x00048f7f1, 24, 5554},
+	{0x00048f8b3, 24, 4275}, {0x00048f8e1, 24, 12764}, {0x00048f925, 24, 12765}, {0x00048fcb8, 24, 12766},
+	{0x00048feea, 24, 12767}, {0x0004c0082, 24, 1965}, {0x0004c022e, 24, 12768}, {0x0004c0289, 24, 12769},
+	{0x0004c068a, 24, 12770}, {0x0004c07c9, 24, 12771}, {0x0004c09b4, 24, 8716}, {0x0004c09d4, 24, 4428},
+	{0x0004c0b3a, 24, 11601}, {0x0004c0bbe, 24, 12272}, {0x0004c0dee, 24, 11748}, {0x0004c0f6e, 24, 6482},
+	{0x0004c0fc7, 24, 12772}, {0x0004c11bf, 24, 12773}, {0x0004c1480, 24, 12774}, {0x0004c14a3, 24, 12775},
+	{0x0004c16f1, 24, 8716}, {0x0004c17eb, 24, 9007}, {0x0004c1a3a, 24, 12776}, {0x0004c1a95, 24, 12777},
+	{0x0004c1fcc, 24, 9262}, {0x0004c21d0, 24, 11230}, {0x0004c2258, 24, 12778}, {0x0004c2578, 24, 5842},
+	{0x0004c26e7, 24, 12779}, {0x0004c2c80, 24, 12780}, {0x0004c2c83, 24, 12781}, {0x0004c2f9d, 24, 12782},
+	{0x0004c3089, 24, 12783}, {0x0004c322d, 24, 12784}, {0x0004c32d9, 24, 12785}, {0x0004c3488, 24, 4472},
+	{0x0004c3909, 24, 12786}, {0x0004c3910, 24, 12787}, {0x0004c3b74, 24, 12788}, {0x0004c3c16, 24, 1842},
+	{0x0004c48da, 24, 12789}, {0x0004c4b68, 24, 12790}, {0x0004c4e35, 24, 1965}, {0x0004c5427, 24, 12791},
+	{0x0004c5499, 24, 8409}, {0x0004c5585, 24, 12792}, {0x0004c55b8, 24, 12793}, {0x0004c55cc, 24, 12794},
+	{0x0004c5dcd, 24, 12795}, {0x0004c5e0c, 24, 2919}, {0x0004c5fd2, 24, 5554}, {0x0004c60d5, 24, 12796},
+	{0x0004c60de, 24, 11962}, {0x0004c6255, 24, 12797}, {0x0004c63eb, 24, 12798}, {0x0004c64d9, 24, 12799},
+	{0x0004c6e6e, 24, 12800}, {0x0004c72b9, 24, 12801}, {0x0004c7367, 24, 12802}, {0x0004c73a5, 24, 12803},
+	{0x0004c7403, 24, 12804}, {0x0004c7625, 24, 5963}, {0x0004c774f, 24, 12805}, {0x0004c7897, 24, 12806},
+	{0x0004c79ba, 24, 4472}, {0x0004c7c5f, 24, 865}, {0x0004c7f62, 24, 5842}, {0x0004c804f, 24, 12807},
+	{0x0004c8093, 24, 4472}, {0x0004c82cf, 24, 12808}, {0x0004c83de, 24, 6959}, {0x0004c8b30, 24, 3726},
+	{0x0004c8b55, 24, 12809}, {0x0004c8bef, 24, 9715}, {0x0004c8d79, 24, 865}, {0x0004c8fa5, 24, 12810},
+	{0x0004c9614, 24, 5553}, {0x0004c98ef, 24, 12811}, {0x0004c9e80, 24, 12812}, {0x0004c9ee4, 24, 12813},
+	{0x0004c9eff, 24, 4551}, {0x0004ca515, 24, 12814}, {0x0004ca56d, 24, 1842}, {0x0004ca74b, 24, 11600},
+	{0x0004ca928, 24, 12815}, {0x0004caa16, 24, 8161}, {0x0004cab33, 24, 12816}, {0x0004cac0a, 24, 5972},
+	{0x0004cae31, 24, 12817}, {0x0004cb16c, 24, 9262}, {0x0004cb199, 24, 865}, {0x0004cb4ea, 24, 12818},
+	{0x0004cb76d, 24, 12819}, {0x0004cb81c, 24, 12820}, {0x0004cb82c, 24, 12821}, {0x0004cb9c8, 24, 12822},
+	{0x0004cbaa3, 24, 12823}, {0x0004cbb58, 24, 12824}, {0x0004cbc42, 24, 12825}, {0x0004cbca5, 24, 1842},
+	{0x0004cc452, 24, 12826}, {0x0004cc602, 24, 12827}, {0x0004cc681, 24, 12828}, {0x0004cc94f, 24, 5554},
+	{0x0004cca53, 24, 12829}, {0x0004ccbf5, 24, 8716}, {0x0004ccc34, 24, 12830}, {0x0004cd08a, 24, 12831},
+	{0x0004cd637, 24, 12832}, {0x0004cd7b6, 24, 12833}, {0x0004cd9c4, 24, 12834}, {0x0004cdf3d, 24, 12835},
+	{0x0004ce1bb, 24, 12836}, {0x0004ce2f1, 24, 12837}, {0x0004ce676, 24, 1741}, {0x0004ce933, 24, 12838},
+	{0x0004ceb42, 24, 4472}, {0x0004cedde, 24, 12086}, {0x0004ceeb0, 24, 12839}, {0x0004cf02e, 24, 12840},
+	{0x0004cf2bf, 24, 5973}, {0x0004cf45b, 24, 12841}, {0x0004cf5a0, 24, 12842}, {0x0004cf737, 24, 12843},
+	{0x00050008c, 24, 12844}, {0x0005001bb, 24, 4482}, {0x00050053d, 24, 12845}, {0x000500604, 24, 1965},
+	{0x000500b32, 24, 12846}, {0x000500e6d, 24, 12847}, {0x0005011eb, 24, 12848}, {0x0005014b5, 24, 12849},
+	{0x0005017ff, 24, 1965}, {0x000501aa5, 24, 8026}, {0x000501ac5, 24, 12272}, {0x000501cbf, 24, 1965},
+	{0x00050206b, 24, 12850}, {0x000502267, 24, 12851}, {0x00050252b, 24, 12852}, {0x000502690, 24, 7968},
+	{0x0005027c7, 24, 12853}, {0x00050294d, 24, 12854}, {0x000502a7e, 24, 12855}, {0x000502a8b, 24, 12856},
+	{0x000502d1d, 24, 5842}, {0x000502da2, 24, 4472}, {0x000502df4, 24, 12857}, {0x000502e5c, 24, 2176},
+	{0x000502ece, 24, 12858}, {0x000503275, 24, 1842}, {0x000503955, 24, 6959}, {0x000503cc4, 24, 4485},
+	{0x000503de5, 24, 3}, {0x000503f56, 24, 12859}, {0x0005045f7, 24, 12860}, {0x00050465d, 24, 4673},
+	{0x0005048eb, 24, 12861}, {0x000504a5e, 24, 12862}, {0x000504a6e, 24, 9978}, {0x000504f94, 24, 12863},
+	{0x00050502a, 24, 12864}, {0x000505065, 24, 12865}, {0x000505527, 24, 6967}, {0x000505663, 24, 4305},
+	{0x0005056a8, 24, 12866}, {0x0005056bf, 24, 8104}, {0x0005057a8, 24, 3}, {0x000505800, 24, 12867},
+	{0x000505ac6, 24, 12868}, {0x000506028, 24, 12869}, {0x000506184, 24, 11282}, {0x0005061d6, 24, 12870},
+	{0x000506313, 24, 6482}, {0x000506441, 24, 12871}, {0x0005065f3, 24, 11475}, {0x000506787, 24, 12872},
+	{0x0005067ae, 24, 1965}, {0x0005067f0, 24, 4551}, {0x000506a03, 24, 8267}, {0x000506f9a, 24, 12873},
+	{0x0005070e5, 24, 12874}, {0x000507224, 24, 4305}, {0x00050724d, 24, 12875}, {0x000507691, 24, 12876},
+	{0x0005076a6, 24, 12877}, {0x00050795b, 24, 12878}, {0x000507a55, 24, 11309}, {0x000507d02, 24, 12879},
+	{0x000507e5d, 24, 4428}, {0x000508569, 24, 8104}, {0x000508789, 24, 1965}, {0x0005087b8, 24, 12880},
+	{0x000508a42, 24, 12881}, {0x000508acb, 24, 12882}, {0x000508c77, 24, 12883}, {0x000508d6f, 24, 12884},
+	{0x00050934f, 24, 12885}, {0x000509772, 24, 12886}, {0x000509871, 24, 12887}, {0x000509f27, 24, 9715},
+	{0x00050a054, 24, 12888}, {0x00050a0bf, 24, 12889}, {0x00050a4c8, 24, 1842}, {0x00050a6e3, 24, 12890},
+	{0x00050a715, 24, 12891}, {0x00050a733, 24, 4614}, {0x00050abbf, 24, 12892}, {0x00050add5, 24, 12893},
+	{0x00050af73, 24, 12894}, {0x00050b695, 24, 12895}, {0x00050b7c3, 24, 4590}, {0x00050b888, 24, 12896},
+	{0x00050b8a2, 24, 12897}, {0x00050bd5f, 24, 11491}, {0x00050c006, 24, 12898}, {0x00050c271, 24, 12899},
+	{0x00050c58d, 24, 5553}, {0x00050c7bf, 24, 11491}, {0x00050c971, 24, 8026}, {0x00050c9a0, 24, 12900},
+	{0x00050ccf8, 24, 12901}, {0x00050cd32, 24, 12902}, {0x00050ce75, 24, 12903}, {0x00050d274, 24, 12904},
+	{0x00050d6d7, 24, 12905}, {0x00050e0c7, 24, 12906}, {0x00050e14a, 24, 99}, {0x00050e549, 24, 5352},
+	{0x00050ead6, 24, 865}, {0x00050eb1a, 24, 127}, {0x00050ed78, 24, 12907}, {0x00050ed94, 24, 12908},
+	{0x00050f003, 24, 12909}, {0x00050f0d3, 24, 1842}, {0x00050f43c, 24, 12910}, {0x00050f520, 24, 1842},
+	{0x00050f61a, 24, 12911}, {0x00050faab, 24, 12912}, {0x00050fc30, 24, 12913}, {0x00050fc9f, 24, 1842},
+	{0x00050fef2, 24, 12914}, {0x0005403f5, 24, 12915}, {0x000540496, 24, 12916}, {0x0005404a6, 24, 4673},
+	{0x000540536, 24, 12917}, {0x00054055f, 24, 11600}, {0x00054098d, 24, 12918}, {0x00054112f, 24, 12919},
+	{0x00054115f, 24, 12920}, {0x000541473, 24, 12921}, {0x000541b5d, 24, 12922}, {0x000541dfb, 24, 12923},
+	{0x000541fd5, 24, 12924}, {0x000542018, 24, 12925}, {0x000542160, 24, 12926}, {0x0005422f8, 24, 8716},
+	{0x000542696, 24, 865}, {0x00054271e, 24, 12927}, {0x000542a9c, 24, 12928}, {0x000542aa2, 24, 3710},
+	{0x000542cea, 24, 12929}, {0x000542f89, 24, 12930}, {0x000543131, 24, 12931}, {0x000543530, 24, 6482},
+	{0x0005435df, 24, 12932}, {0x00054369b, 24, 12933}, {0x000543968, 24, 12934}, {0x0005439df, 24, 9262},
+	{0x000543d37, 24, 4614}, {0x0005440ad, 24, 1842}, {0x000544249, 24, 311}, {0x000544408, 24, 5842},
+	{0x00054466b, 24, 12935}, {0x000544a00, 24, 1965}, {0x000544a05, 24, 12936}, {0x000544a16, 24, 4305},
+	{0x000544e45, 24, 99}, {0x000545146, 24, 12937}, {0x0005453ed, 24, 311}, {0x000545414, 24, 12938},
+	{0x000545ebd, 24, 12939}, {0x000545fa9, 24, 8552}, {0x000546009, 24, 12940}, {0x000546172, 24, 12941},
+	{0x0005461ea, 24, 12942}, {0x0005464d9, 24, 12943}, {0x0005465de, 24, 185}, {0x00054724f, 24, 865},
+	{0x000547398, 24, 12944}, {0x0005474e6, 24, 12945}, {0x0005475d0, 24, 3}, {0x00054781a, 24, 1965},
+	{0x000547975, 24, 5842}, {0x000547c69, 24, 1965}, {0x000547f54, 24, 12946}, {0x000547fa8, 24, 12947},
+	{0x000547fee, 24, 3}, {0x0005481ad, 24, 12948}, {0x00054847b, 24, 12949}, {0x00054880e, 24, 12950},
+	{0x000548922, 24, 12951}, {0x000548998, 24, 9262}, {0x0005492be, 24, 1842}, {0x000549359, 24, 12952},
+	{0x000549478, 24, 12953}, {0x000549a11, 24, 11626}, {0x000549a16, 24, 12954}, {0x000549b12, 24, 4482},
+	{0x000549d85, 24, 12955}, {0x000549f13, 24, 865}, {0x000549f35, 24, 5963}, {0x00054a04f, 24, 12956},
+	{0x00054a050, 24, 4673}, {0x00054a274, 24, 9155}, {0x00054a31b, 24, 12957}, {0x00054a51b, 24, 8409},
+	{0x00054a54b, 24, 12958}, {0x00054a619, 24, 11806}, {0x00054a9d4, 24, 12959}, {0x00054ae27, 24, 865},
+	{0x00054b620, 24, 12960}, {0x00054b753, 24, 12961}, {0x00054b80a, 24, 11768}, {0x00054bef7, 24, 9722},
+	{0x00054c80f, 24, 11491}, {0x00054cd10, 24, 12962}, {0x00054cda7, 24, 12963}, {0x00054cdee, 24, 12964},
+	{0x00054d0ed, 24, 12965}, {0x00054d163, 24, 12966}, {0x00054d1b0, 24, 12967}, {0x00054d46f, 24, 6959},
+	{0x00054df00, 24, 12968}, {0x00054df63, 24, 12969}, {0x00054e032, 24, 5553}, {0x00054e140, 24, 12946},
+	{0x00054e2c8, 24, 12970}, {0x00054e2e0, 24, 6621}, {0x00054e3b0, 24, 12971}, {0x00054e43a, 24, 11309},
+	{0x00054e4bd, 24, 12972}, {0x00054e63f, 24, 12973}, {0x00054e6fc, 24, 8096}, {0x00054eaa8, 24, 11309},
+	{0x00054ee75, 24, 12513}, {0x00054ef92, 24, 12974}, {0x00054f5b6, 24, 12975}, {0x00054f666, 24, 12976},
+	{0x00054f876, 24, 12977}, {0x00054fa3e, 24, 8104}, {0x00054fb58, 24, 12978}, {0x00054fdbf, 24, 12979},
+	{0x00054ffcf, 24, 12980}, {0x0005804cb, 24, 12981}, {0x000580528, 24, 12982}, {0x000580556, 24, 12983},
+	{0x0005808fa, 24, 12984}, {0x000580943, 24, 99}, {0x0005809e5, 24, 12985}, {0x000580a20, 24, 1965},
+	{0x00058108c, 24, 12986}, {0x000581243, 24, 8729}, {0x000581626, 24, 11282}, {0x00058170c, 24, 2582},
+	{0x000581cbd, 24, 12987}, {0x000581d91, 24, 12988}, {0x000581f28, 24, 9715}, {0x000581f67, 24, 12989},
+	{0x000581faa, 24, 865}, {0x000581fef, 24, 12990}, {0x000582136, 24, 12991}, {0x00058238c, 24, 12992},
+	{0x000582af7, 24, 9715}, {0x000582efe, 24, 12993}, {0x000582f42, 24, 12994}, {0x00058343b, 24, 12995},
+	{0x0005835d9, 24, 3}, {0x000583cc6, 24, 12996}, {0x000583f54, 24, 12997}, {0x0005842e4, 24, 12998},
+	{0x00058468f, 24, 12999}, {0x0005846e1, 24, 13000}, {0x000584704, 24, 13001}, {0x000584822, 24, 11230},
+	{0x0005848c0, 24, 13002}, {0x00058493b, 24, 6256}, {0x0005849ba, 24, 13003}, {0x000584c19, 24, 13004},
+	{0x000584cee, 24, 13005}, {0x000585076, 24, 13006}, {0x0005850ab, 24, 13007}, {0x0005850e6, 24, 13008},
+	{0x0005855ca, 24, 865}, {0x0005856e8, 24, 185}, {0x00058570d, 24, 13009}, {0x00058639a, 24, 13010},
+	{0x0005865e6, 24, 13011}, {0x0005866ba, 24, 11618}, {0x00058671a, 24, 13012}, {0x00058677f, 24, 13013},
+	{0x00058696c, 24, 13014}, {0x0005869f9, 24, 13015}, {0x000586ab1, 24, 11618}, {0x000586d8f, 24, 4275},
+	{0x000586ed6, 24, 99}, {0x0005870c6, 24, 13016}, {0x000587521, 24, 13017}, {0x000587675, 24, 13018},
+	{0x0005876c5, 24, 13019}, {0x000587a4d, 24, 13020}, {0x000587be9, 24, 13021}, {0x000587e61, 24, 13022},
+	{0x000587f66, 24, 9715}, {0x000587fb7, 24, 13023}, {0x000587fc8, 24, 13024}, {0x0005884e4, 24, 13025},
+	{0x00058856e, 24, 13026}, {0x00058874c, 24, 13027}, {0x0005887e2, 24, 13028}, {0x000588d09, 24, 3},
+	{0x0005891cf, 24, 4472}, {0x00058920d, 24, 13029}, {0x000589396, 24, 4614}, {0x00058946b, 24, 4472},
+	{0x0005894cf, 24, 13030}, {0x00058971e, 24, 1965}, {0x000589835, 24, 12289}, {0x00058986f, 24, 13031},
+	{0x000589b0b, 24, 13032}, {0x000589cfc, 24, 13033}, {0x00058a2b5, 24, 6967}, {0x00058a76f, 24, 13034},
+	{0x00058a839, 24, 4472}, {0x00058b035, 24, 865}, {0x00058b0d4, 24, 13035}, {0x00058b961, 24, 13036},
+	{0x00058b9e1, 24, 13037}, {0x00058bc27, 24, 3}, {0x00058bda3, 24, 2314}, {0x00058bdf9, 24, 13038},
+	{0x00058bfea, 24, 3}, {0x00058c232, 24, 5288}, {0x00058c38b, 24, 4482}, {0x00058cf4b, 24, 13039},
+	{0x00058d071, 24, 13040}, {0x00058d08f, 24, 13041}, {0x00058d6d3, 24, 13042}, {0x00058db8d, 24, 13043},
+	{0x00058e02c, 24, 13044}, {0x00058e326, 24, 13045}, {0x00058e476, 24, 13046}, {0x00058e636, 24, 13047},
+	{0x00058e747, 24, 13048}, {0x00058e808, 24, 13049}, {0x00058eb14, 24, 13050}, {0x00058ece1, 24, 13051},
+	{0x00058eece, 24, 13052}, {0x00058f102, 24, 13053}, {0x00058f387, 24, 13054}, {0x00058f39c, 24, 1965},
+	{0x00058f67b, 24, 13055}, {0x00058f6bf, 24, 13056}, {0x00058f98e, 24, 13057}, {0x00058fcdb, 24, 11626},
+	{0x00058fd20, 24, 13058}, {0x0005c026a, 24, 13059}, {0x0005c076f, 24, 13060}, {0x0005c0a5b, 24, 13061},
+	{0x0005c0cbb, 24, 13062}, {0x0005c0e8b, 24, 1217}, {0x0005c1193, 24, 13063}, {0x0005c1437, 24, 13064},
+	{0x0005c1515, 24, 13065}, {0x0005c15e1, 24, 13066}, {0x0005c16c7, 24, 13067}, {0x0005c1737, 24, 13068},
+	{0x0005c17d3, 24, 13069}, {0x0005c18b5, 24, 13070}, {0x0005c20d0, 24, 13071}, {0x0005c22c4, 24, 13072},
+	{0x0005c2479, 24, 13073}, {0x0005c254c, 24, 13074}, {0x0005c260a, 24, 5963}, {0x0005c2aef, 24, 13075},
+	{0x0005c2bf5, 24, 13076}, {0x0005c2e59, 24, 1842}, {0x0005c2ed2, 24, 13077}, {0x0005c313e, 24, 4305},
+	{0x0005c3327, 24, 13078}, {0x0005c335c, 24, 13079}, {0x0005c338e, 24, 13080}, {0x0005c353b, 24, 13081},
+	{0x0005c35da, 24, 13082}, {0x0005c36b8, 24, 13083}, {0x0005c38e0, 24, 13084}, {0x0005c3b35, 24, 13085},
+	{0x0005c3c27, 24, 1842}, {0x0005c4058, 24, 13086}, {0x0005c41e7, 24, 13087}, {0x0005c43d2, 24, 13088},
+	{0x0005c4527, 24, 5553}, {0x0005c4a26, 24, 13089}, {0x0005c4ca9, 24, 8409}, {0x0005c5015, 24, 3},
+	{0x0005c514f, 24, 4472}, {0x0005c5188, 24, 11889}, {0x0005c56ed, 24, 13090}, {0x0005c571a, 24, 185},
+	{0x0005c57c8, 24, 5842}, {0x0005c5948, 24, 865}, {0x0005c5b35, 24, 13091}, {0x0005c5bc2, 24, 13092},
+	{0x0005c5eab, 24, 5553}, {0x0005c63bf, 24, 8096}, {0x0005c6984, 24, 13093}, {0x0005c6a7d, 24, 13094},
+	{0x0005c6b32, 24, 4305}, {0x0005c6b4f, 24, 99}, {0x0005c6d20, 24, 6482}, {0x0005c6f4f, 24, 13095},
+	{0x0005c7757, 24, 13096}, {0x0005c7d5e, 24, 9715}, {0x0005c8486, 24, 13097}, {0x0005c864a, 24, 13098},
+	{0x0005c8778, 24, 13099}, {0x0005c899a, 24, 11766}, {0x0005c89d4, 24, 13100}, {0x0005c8a38, 24, 11475},
+	{0x0005c8d4e, 24, 865}, {0x0005c8fe0, 24, 185}, {0x0005c93a2, 24, 7813}, {0x0005c95ae, 24, 865},
+	{0x0005c966a, 24, 13101}, {0x0005c969d, 24, 865}, {0x0005c97f3, 24, 865}, {0x0005c9ad8, 24, 7968},
+	{0x0005ca178, 24, 13102}, {0x0005ca39d, 24, 13061}, {0x0005ca3eb, 24, 13103}, {0x0005ca48a, 24, 1965},
+	{0x0005caafd, 24, 3412}, {0x0005cac4c, 24, 6482}, {0x0005cb395, 24, 9262}, {0x0005cb43e, 24, 9262},
+	{0x0005cb524, 24, 2582}, {0x0005cb6cc, 24, 13104}, {0x0005cb8cb, 24, 13105}, {0x0005cb901, 24, 11475},
+	{0x0005cbd9e, 24, 13106}, {0x0005cc213, 24, 13107}, {0x0005cc5d4, 24, 4472}, {0x0005cc6d0, 24, 6148},
+	{0x0005cc9d3, 24, 13108}, {0x0005cca32, 24, 13109}, {0x0005cccff, 24, 13110}, {0x0005ccead, 24, 13111},
+	{0x0005cd135, 24, 13112}, {0x0005cd2e4, 24, 4472}, {0x0005cd41b, 24, 13113}, {0x0005cd4ab, 24, 13114},
+	{0x0005cd61f, 24, 13115}, {0x0005cd998, 24, 3223}, {0x0005cdad4, 24, 3430}, {0x0005cdc96, 24, 4428},
+	{0x0005cdd70, 24, 11618}, {0x0005ce0c5, 24, 4472}, {0x0005ce0ca, 24, 13116}, {0x0005ce0f6, 24, 13117},
+	{0x0005ce223, 24, 13118}, {0x0005ce286, 24, 108}, {0x0005ce2f4, 24, 8729}, {0x0005ce7bf, 24, 13119},
+	{0x0005ce8eb, 24, 4482}, {0x0005ceb4e, 24, 13120}, {0x0005ceb68, 24, 13121}, {0x0005cee79, 24, 13122},
+	{0x0005cf207, 24, 13123}, {0x0005cf370, 24, 13124}, {0x0005cf3fc, 24, 564}, {0x0005cf4ab, 24, 4551},
+	{0x0005cf50d, 24, 13125}, {0x0005cf5da, 24, 865}, {0x0005cf6dc, 24, 8104}, {0x0005cf7c3, 24, 13126},
+	{0x0005cf8a1, 24, 11916}, {0x0005cf938, 24, 11563}, {0x0005cf96a, 24, 9715}, {0x0005cf9dd, 24, 2822},
+	{0x0005cf9f0, 24, 13127}, {0x0005cfc66, 24, 1965}, {0x0005cff35, 24, 5336}, {0x0005cffff, 24, 13128},
+	{0x000600292, 24, 9722}, {0x0006002b4, 24, 13129}, {0x000600308, 24, 865}, {0x000600347, 24, 13130},
+	{0x000600417, 24, 13131}, {0x000600f77, 24, 13132}, {0x000601199, 24, 13133}, {0x000601283, 24, 13134},
+	{0x00060128b, 24, 124}, {0x0006015c7, 24, 13135}, {0x00060190c, 24, 13136}, {0x000601929, 24, 13137},
+	{0x000601970, 24, 13138}, {0x000601d0f, 24, 13139}, {0x000601e02, 24, 13140}, {0x000602103, 24, 13141},
+	{0x0006021c0, 24, 11916}, {0x0006024c1, 24, 13142}, {0x000602a54, 24, 13143}, {0x000602ad0, 24, 6959},
+	{0x0006032f0, 24, 13144}, {0x00060334b, 24, 865}, {0x000603553, 24, 13145}, {0x000603696, 24, 13146},
+	{0x0006036dd, 24, 4472}, {0x00060380e, 24, 13147}, {0x00060391f, 24, 13148}, {0x000603fc5, 24, 13149},
+	{0x0006044f5, 24, 13150}, {0x00060455e, 24, 13151}, {0x0006045bd, 24, 12272}, {0x000604616, 24, 13152},
+	{0x0006047d4, 24, 13153}, {0x000604826, 24, 13154}, {0x000604a1c, 24, 13155}, {0x0006050c1, 24, 13156},
+	{0x00060512c, 24, 13157}, {0x0006052d0, 24, 13158}, {0x000605464, 24, 13159}, {0x000605718, 24, 4472},
+	{0x000605bb4, 24, 5090}, {0x00060601f, 24, 13160}, {0x0006063fd, 24, 13161}, {0x0006064a1, 24, 13162},
+	{0x000606720, 24, 4472}, {0x000606944, 24, 11563}, {0x00060699b, 24, 13163}, {0x000606bbd, 24, 5076},
+	{0x000606c66, 24, 4472}, {0x000606dc7, 24, 6482}, {0x00060735c, 24, 1965}, {0x00060748d, 24, 13164},
+	{0x000607688, 24, 13165}, {0x0006077e2, 24, 1842}, {0x00060812b, 24, 13166}, {0x0006081f9, 24, 13167},
+	{0x0006083b2, 24, 13168}, {0x00060843b, 24, 13169}, {0x000608645, 24, 13170}, {0x00060893c, 24, 13171},
+	{0x0006089b1, 24, 13172}, {0x0006089b7, 24, 13173}, {0x000608c2b, 24, 13174}, {0x000608d17, 24, 13175},
+	{0x000608f5c, 24, 1842}, {0x000609084, 24, 13176}, {0x000609217, 24, 865}, {0x000609620, 24, 99},
+	{0x0006099d1, 24, 13177}, {0x000609aa4, 24, 13178}, {0x000609c9f, 24, 127}, {0x000609e64, 24, 13179},
+	{0x000609f9d, 24, 13180}, {0x00060a10a, 24, 1842}, {0x00060a44c, 24, 4673}, {0x00060a8fe, 24, 13181},
+	{0x00060a9b0, 24, 13182}, {0x00060af6d, 24, 1842}, {0x00060b185, 24, 13183}, {0x00060b3c4, 24, 13184},
+	{0x00060b606, 24, 13185}, {0x00060b617, 24, 11300}, {0x00060b933, 24, 13186}, {0x00060b982, 24, 13187},
+	{0x00060bb0c, 24, 13188}, {0x00060bc4c, 24, 13189}, {0x00060bd91, 24, 13190}, {0x00060beb5, 24, 11721},
+	{0x00060c1cb, 24, 13191}, {0x00060c397, 24, 13192}, {0x00060c547, 24, 865}, {0x00060c5a8, 24, 13193},
+	{0x00060c798, 24, 13194}, {0x00060c980, 24, 13195}, {0x00060cbfb, 24, 13196}, {0x00060cda9, 24, 13197},
+	{0x00060cdc5, 24, 13198}, {0x00060d0a9, 24, 1842}, {0x00060d1aa, 24, 13199}, {0x00060d2b9, 24, 13200},
+	{0x00060d30a, 24, 13201}, {0x00060d819, 24, 6482}, {0x00060d9a0, 24, 4485}, {0x00060d9c7, 24, 865},
+	{0x00060da23, 24, 13202}, {0x00060db2a, 24, 13203}, {0x00060de44, 24, 9262}, {0x00060e00e, 24, 13204},
+	{0x00060e327, 24, 11491}, {0x00060e6bc, 24, 13205}, {0x00060e701, 24, 9715}, {0x00060e956, 24, 13206},
+	{0x00060eb69, 24, 13207}, {0x00060f13d, 24, 13208}, {0x00060f189, 24, 3430}, {0x00060f281, 24, 13209},
+	{0x00060f2ef, 24, 13210}, {0x00060f3da, 24, 13211}, {0x00060f494, 24, 6482}, {0x00060f59c, 24, 13212},
+	{0x00060f673, 24, 13213}, {0x00060f81d, 24, 865}, {0x00060facd, 24, 865}, {0x00060fb42, 24, 865},
+	{0x00060fe1e, 24, 13214}, {0x00060fe20, 24, 13215}, {0x00060fec5, 24, 865}, {0x00060fef9, 24, 13216},
+	{0x00060ffdd, 24, 13217}, {0x00064002d, 24, 13218}, {0x00064006a, 24, 5963}, {0x0006400f1, 24, 3},
+	{0x0006405be, 24, 13219}, {0x00064094c, 24, 13220}, {0x000640980, 24, 13221}, {0x000640b4a, 24, 13222},
+	{0x000640de6, 24, 13223}, {0x000640e36, 24, 13224}, {0x000640e94, 24, 13225}, {0x000640f28, 24, 12405},
+	{0x000641084, 24, 13226}, {0x000641225, 24, 1965}, {0x00064167f, 24, 1188}, {0x00064168d, 24, 3},
+	{0x0006416f0, 24, 13227}, {0x000641a22, 24, 13228}, {0x000641c67, 24, 13229}, {0x000641e81, 24, 13230},
+	{0x00064200c, 24, 865}, {0x000642184, 24, 13231}, {0x000642216, 24, 13232}, {0x000642400, 24, 13233},
+	{0x000642737, 24, 6482}, {0x000642db7, 24, 13234}, {0x000643150, 24, 459}, {0x00064317e, 24, 13235},
+	{0x000643409, 24, 13236}, {0x000643ab1, 24, 13237}, {0x000643e8c, 24, 9262}, {0x000643f5f, 24, 13238},
+	{0x000644214, 24, 13239}, {0x000644346, 24, 13240}, {0x000644bc3, 24, 13241}, {0x000644bf0, 24, 13242},
+	{0x000644d70, 24, 13243}, {0x000644f74, 24, 13244}, {0x000644fb0, 24, 13245}, {0x000645106, 24, 11475},
+	{0x00064517e, 24, 13246}, {0x000645299, 24, 13247}, {0x00064535d, 24, 13248}, {0x000645422, 24, 13249},
+	{0x000645563, 24, 13250}, {0x00064557f, 24, 13251}, {0x0006455b1, 24, 185}, {0x000645601, 24, 11766},
+	{0x0006459f8, 24, 13252}, {0x000645a04, 24, 12824}, {0x000645dd7, 24, 13253}, {0x000645ebe, 24, 13254},
+	{0x000645fff, 24, 13255}, {0x000646223, 24, 13256}, {0x00064649b, 24, 13257}, {0x0006465c0, 24, 13258},
+	{0x0006466b3, 24, 8096}, {0x000646707, 24, 13259}, {0x00064680c, 24, 12423}, {0x0006469bc, 24, 13260},
+	{0x000646a52, 24, 11282}, {0x000646cb2, 24, 1842}, {0x000646e6c, 24, 13261}, {0x000646eea, 24, 13262},
+	{0x000647002, 24, 8096}, {0x0006472d8, 24, 13263}, {0x0006473e2, 24, 13264}, {0x000647657, 24, 13265},
+	{0x0006476ba, 24, 865}, {0x000647791, 24, 1842}, {0x000647bd4, 24, 4305}, {0x000647c34, 24, 13266},
+	{0x000647d81, 24, 13267}, {0x000647fda, 24, 13268}, {0x00064808b, 24, 13269}, {0x000648099, 24, 4472},
+	{0x000648125, 24, 13270}, {0x000648788, 24, 5553}, {0x0006487d7, 24, 8044}, {0x0006488ff, 24, 4779},
+	{0x00064899a, 24, 6967}, {0x000648d9e, 24, 13271}, {0x00064995d, 24, 13069}, {0x000649968, 24, 8212},
+	{0x0006499a0, 24, 13272}, {0x000649abe, 24, 865}, {0x000649b24, 24, 13273}, {0x000649c81, 24, 13274},
+	{0x000649c8e, 24, 4305}, {0x000649ef3, 24, 3}, {0x000649ff7, 24, 13275}, {0x00064a0e7, 24, 3},
+	{0x00064a232, 24, 13276}, {0x00064a341, 24, 13277}, {0x00064a3cb, 24, 865}, {0x00064a769, 24, 2176},
+	{0x00064a7dd, 24, 11282}, {0x00064a837, 24, 13278}, {0x00064ae0c, 24, 3}, {0x00064ae88, 24, 13279},
+	{0x00064b21d, 24, 13280}, {0x00064b310, 24, 1842}, {0x00064b370, 24, 13281}, {0x00064b473, 24, 11778},
+	{0x00064b64a, 24, 13282}, {0x00064b853, 24, 11234}, {0x00064b9e8, 24, 865}, {0x00064babd, 24, 13283},
+	{0x00064bc11, 24, 13284}, {0x00064c5aa, 24, 13285}, {0x00064c667, 24, 13286}, {0x00064c6af, 24, 13287},
+	{0x00064c944, 24, 13288}, {0x00064d02d, 24, 13289}, {0x00064d1a3, 24, 3090}, {0x00064d241, 24, 13290},
+	{0x00064d4bd, 24, 12443}, {0x00064d4da, 24, 4472}, {0x00064d814, 24, 3}, {0x00064d912, 24, 13291},
+	{0x00064d954, 24, 13292}, {0x00064d989, 24, 3}, {0x00064db18, 24, 13293}, {0x00064db81, 24, 13294},
+	{0x00064dc01, 24, 13295}, {0x00064de1c, 24, 13296}, {0x00064e161, 24, 13297}, {0x00064e599, 24, 2043},
+	{0x00064e625, 24, 13298}, {0x00064e682, 24, 865}, {0x00064e84f, 24, 13299}, {0x00064e892, 24, 13300},
+	{0x00064e8e6, 24, 13301}, {0x00064e950, 24, 1965}, {0x00064eac5, 24, 13302}, {0x00064eb8c, 24, 12691},
+	{0x00064ed57, 24, 185}, {0x00064ed62, 24, 13303}, {0x00064f242, 24, 13304}, {0x00064f50e, 24, 13305},
+	{0x00064f69d, 24, 1965}, {0x00064f970, 24, 13306}, {0x00064f987, 24, 13307}, {0x00064fb81, 24, 11626},
+	{0x00064fc8c, 24, 13308}, {0x000680571, 24, 1842}, {0x0006805ca, 24, 4472}, {0x000680927, 24, 865},
+	{0x000680ad7, 24, 13309}, {0x00068122d, 24, 13310}, {0x000681590, 24, 11812}, {0x0006815d3, 24, 13311},
+	{0x000681605, 24, 13312}, {0x000681729, 24, 4472}, {0x00068193f, 24, 13313}, {0x000681ab2, 24, 8716},
+	{0x000681ca2, 24, 13314}, {0x000681d64, 24, 13315}, {0x000681e8b, 24, 13316}, {0x000681fd8, 24, 13317},
+	{0x00068234b, 24, 13318}, {0x0006828ba, 24, 13319}, {0x0006828f6, 24, 13320}, {0x000682ddc, 24, 13321},
+	{0x0006836b5, 24, 13322}, {0x000683b1e, 24, 13323}, {0x000683c7d, 24, 13324}, {0x000683eec, 24, 13325},
+	{0x000684352, 24, 13326}, {0x000684898, 24, 1842}, {0x000684b88, 24, 13327}, {0x000684ca8, 24, 13328},
+	{0x0006851b7, 24, 13329}, {0x0006854ed, 24, 13330}, {0x0006854f5, 24, 13331}, {0x00068597f, 24, 11600},
+	{0x000685b35, 24, 865}, {0x000685b36, 24, 13332}, {0x000685d43, 24, 4472}, {0x000685e6b, 24, 13333},
+	{0x000686359, 24, 13334}, {0x00068644b, 24, 865}, {0x00068692e, 24, 13335}, {0x0006869f2, 24, 13336},
+	{0x000686e23, 24, 13337}, {0x000686e48, 24, 13338}, {0x000687251, 24, 8759}, {0x0006872dc, 24, 13339},
+	{0x00068764f, 24, 11230}, {0x000687848, 24, 13340}, {0x00068784c, 24, 108}, {0x000687924, 24, 13341},
+	{0x0006879ed, 24, 6658}, {0x000687cc8, 24, 13342}, {0x000687cd5, 24, 13343}, {0x000687f74, 24, 4275},
+	{0x00068831a, 24, 13344}, {0x000688470, 24, 13345}, {0x000688540, 24, 13346}, {0x00068856a, 24, 13347},
+	{0x0006886a7, 24, 1965}, {0x0006886e7, 24, 13348}, {0x00068876b, 24, 13349}, {0x000688ab5, 24, 13350},
+	{0x000688f84, 24, 9262}, {0x000689234, 24, 4614}, {0x000689423, 24, 6482}, {0x00068967b, 24, 865},
+	{0x00068974b, 24, 13351}, {0x0006897e8, 24, 13352}, {0x0006899cd, 24, 1965}, {0x000689c5e, 24, 8729},
+	{0x000689c70, 24, 865}, {0x000689ce2, 24, 1965}, {0x000689e19, 24, 4305}, {0x00068a0f6, 24, 9715},
+	{0x00068a1b7, 24, 13353}, {0x00068a378, 24, 11717}, {0x00068a3c4, 24, 7813}, {0x00068a40e, 24, 13354},
+	{0x00068a86d, 24, 865}, {0x00068aad2, 24, 13355}, {0x00068ab8a, 24, 13356}, {0x00068ae20, 24, 865},
+	{0x00068af13, 24, 13357}, {0x00068b094, 24, 13358}, {0x00068b43a, 24, 13359}, {0x00068b599, 24, 459},
+	{0x00068b6fc, 24, 8599}, {0x00068b8d9, 24, 13360}, {0x00068b983, 24, 13361}, {0x00068bc0c, 24, 3},
+	{0x00068bdab, 24, 3}, {0x00068c90b, 24, 4305}, {0x00068ca00, 24, 13362}, {0x00068cc9c, 24, 13363},
+	{0x00068cd0f, 24, 13364}, {0x00068ce4e, 24, 13365}, {0x00068d1fd, 24, 13366}, {0x00068d247, 24, 13367},
+	{0x00068d925, 24, 13368}, {0x00068d93c, 24, 865}, {0x00068db67, 24, 13369}, {0x00068db96, 24, 13370},
+	{0x00068dce8, 24, 13371}, {0x00068dfdd, 24, 11778}, {0x00068e166, 24, 99}, {0x00068e41f, 24, 13372},
+	{0x00068ebae, 24, 1842}, {0x00068ebc5, 24, 13373}, {0x00068ec62, 24, 13374}, {0x00068ed43, 24, 8397},
+	{0x00068eda4, 24, 13375}, {0x00068ee96, 24, 6959}, {0x00068efbd, 24, 3}, {0x00068f06d, 24, 13376},
+	{0x00068f0bc, 24, 13377}, {0x00068f125, 24, 13378}, {0x00068f728, 24, 13379}, {0x00068f895, 24, 13380},
+	{0x00068fb95, 24, 13381}, {0x00068fcb3, 24, 13382}, {0x0006c0273, 24, 13383}, {0x0006c0460, 24, 13384},
+	{0x0006c09d6, 24, 13385}, {0x0006c0b84, 24, 13386}, {0x0006c0e0d, 24, 2582}, {0x0006c0f6a, 24, 13387},
+	{0x0006c14f7, 24, 13388}, {0x0006c15f9, 24, 13389}, {0x0006c1811, 24, 13390}, {0x0006c198f, 24, 11768},
+	{0x0006c1e70, 24, 13391}, {0x0006c2056, 24, 1965}, {0x0006c22ab, 24, 13392}, {0x0006c23b9, 24, 2582},
+	{0x0006c25b9, 24, 12291}, {0x0006c2995, 24, 4472}, {0x0006c2c06, 24, 13393}, {0x0006c2e33, 24, 13394},
+	{0x0006c2e72, 24, 13395}, {0x0006c2e85, 24, 9007}, {0x0006c2f2c, 24, 1842}, {0x0006c32de, 24, 13396},
+	{0x0006c33a9, 24, 13397}, {0x0006c391d, 24, 13398}, {0x0006c3a84, 24, 13399}, {0x0006c3be5, 24, 11475},
+	{0x0006c3c53, 24, 13400}, {0x0006c3e6d, 24, 865}, {0x0006c3e9c, 24, 13401}, {0x0006c4008, 24, 865},
+	{0x0006c40c6, 24, 13402}, {0x0006c416a, 24, 1965}, {0x0006c4418, 24, 13403}, {0x0006c4598, 24, 13404},
+	{0x0006c4b7f, 24, 13405}, {0x0006c504d, 24, 3}, {0x0006c5779, 24, 13406}, {0x0006c5940, 24, 13407},
+	{0x0006c5a34, 24, 13408}, {0x0006c5ab5, 24, 12775}, {0x0006c5cde, 24, 13409}, {0x0006c5d63, 24, 13410},
+	{0x0006c5e7a, 24, 13411}, {0x0006c5f1c, 24, 4485}, {0x0006c6126, 24, 13412}, {0x0006c626d, 24, 13413},
+	{0x0006c641a, 24, 13414}, {0x0006c6efe, 24, 13415}, {0x0006c6f18, 24, 13416}, {0x0006c7039, 24, 13417},
+	{0x0006c709f, 24, 865}, {0x0006c71d9, 24, 8497}, {0x0006c7660, 24, 12042}, {0x0006c81fe, 24, 13418},
+	{0x0006c8336, 24, 1842}, {0x0006c8366, 24, 12006}, {0x0006c8686, 24, 13419}, {0x0006c8814, 24, 4472},
+	{0x0006c8b2f, 24, 8716}, {0x0006c8cdb, 24, 13420}, {0x0006c8d65, 24, 13421}, {0x0006c90b1, 24, 13422},
+	{0x0006c92bf, 24, 13423}, {0x0006c94f8, 24, 865}, {0x0006c98eb, 24, 13424}, {0x0006c9989, 24, 1965},
+	{0x0006c9ac9, 24, 13425}, {0x0006c9b02, 24, 5842}, {0x0006c9ce9, 24, 13426}, {0x0006c9ced, 24, 3},
+	{0x0006ca100, 24, 4472}, {0x0006ca682, 24, 13427}, {0x0006ca75f, 24, 8716}, {0x0006ca780, 24, 5842},
+	{0x0006ca7fa, 24, 13428}, {0x0006ca849, 24, 11282}, {0x0006ca906, 24, 13429}, {0x0006ca96f, 24, 13430},
+	{0x0006caab3, 24, 4614}, {0x0006cab4d, 24, 13431}, {0x0006cac60, 24, 13432}, {0x0006cad3f, 24, 13433},
+	{0x0006cadef, 24, 13434}, {0x0006cadf8, 24, 12412}, {0x0006cae8b, 24, 13435}, {0x0006cb0ce, 24, 11962},
+	{0x0006cb311, 24, 13436}, {0x0006cb350, 24, 13437}, {0x0006cb56b, 24, 12831}, {0x0006cb7f4, 24, 1842},
+	{0x0006cbee9, 24, 7986}, {0x0006cbfb5, 24, 13438}, {0x0006cc1d2, 24, 185}, {0x0006cc217, 24, 11475},
+	{0x0006cc26b, 24, 865}, {0x0006cca08, 24, 185}, {0x0006cd032, 24, 6967}, {0x0006cd146, 24, 13439},
+	{0x0006cd1b0, 24, 13440}, {0x0006cd68a, 24, 6537}, {0x0006cdc6a, 24, 13441}, {0x0006ce01e, 24, 13442},
+	{0x0006ce0b0, 24, 13443}, {0x0006ce4ce, 24, 13444}, {0x0006ce873, 24, 8096}, {0x0006ce907, 24, 5842},
+	{0x0006ce983, 24, 13445}, {0x0006ceca1, 24, 13446}, {0x0006ceceb, 24, 4305}, {0x0006cf049, 24, 5352},
+	{0x0006cf373, 24, 1842}, {0x0006cf37f, 24, 2743}, {0x0006cf5e8, 24, 13447}, {0x0006cf97c, 24, 13448},
+	{0x0006cfa58, 24, 11282}, {0x0006cfa89, 24, 1965}, {0x0006cfaa7, 24, 11319}, {0x0006cfdb9, 24, 13449},
+	{0x0006cffbe, 24, 13450}, {0x000700136, 24, 13451}, {0x000700258, 24, 13452}, {0x000700514, 24, 6967},
+	{0x000700bc0, 24, 13453}, {0x000700fc7, 24, 13454}, {0x000700fec, 24, 13455}, {0x00070105c, 24, 1965},
+	{0x000701124, 24, 865}, {0x000701404, 24, 13456}, {0x0007014a6, 24, 11309}, {0x00070188b, 24, 6482},
+	{0x000701a04, 24, 13457}, {0x000701aed, 24, 13458}, {0x000701d7f, 24, 13459}, {0x000702393, 24, 13460},
+	{0x000702526, 24, 5554}, {0x000702559, 24, 294}, {0x000702a7d, 24, 13461}, {0x000702b1d, 24, 13462},
+	{0x000702c1f, 24, 13463}, {0x000702dd1, 24, 13464}, {0x000702f4b, 24, 13465}, {0x000702f97, 24, 13466},
+	{0x000703018, 24, 11282}, {0x00070305d, 24, 1787}, {0x00070305e, 24, 13467}, {0x000703187, 24, 13468},
+	{0x0007032d5, 24, 13469}, {0x000703811, 24, 13470}, {0x0007038b4, 24, 13471}, {0x0007038ee, 24, 11282},
+	{0x000703ad8, 24, 13472}, {0x000703c39, 24, 13473}, {0x000703eac, 24, 865}, {0x0007041b7, 24, 13474},
+	{0x000704642, 24, 13475}, {0x000704aae, 24, 13476}, {0x000704ae4, 24, 13477}, {0x000704ced, 24, 13478},
+	{0x000704e01, 24, 13479}, {0x000704e66, 24, 12674}, {0x0007052c5, 24, 979}, {0x00070533f, 24, 13480},
+	{0x0007054d2, 24, 9722}, {0x0007054f5, 24, 9262}, {0x000705681, 24, 865}, {0x000705812, 24, 11249},
+	{0x000705957, 24, 13481}, {0x000705986, 24, 13482}, {0x000705ab6, 24, 6282}, {0x000705b2e, 24, 13483},
+	{0x000705cad, 24, 13484}, {0x000705eaa, 24, 13485}, {0x0007060de, 24, 13486}, {0x000706173, 24, 13487},
+	{0x0007062b8, 24, 11768}, {0x000706417, 24, 13488}, {0x000706582, 24, 13489}, {0x000706879, 24, 13490},
+	{0x000706f81, 24, 99}, {0x00070704c, 24, 13491}, {0x0007071b3, 24, 13492}, {0x0007071bc, 24, 9722},
+	{0x00070720d, 24, 4485}, {0x00070723c, 24, 9715}, {0x0007072cf, 24, 13493}, {0x0007073cb, 24, 865},
+	{0x000707630, 24, 13494}, {0x0007076dd, 24, 13495}, {0x0007076f0, 24, 13496}, {0x0007076ff, 24, 13497},
+	{0x000707781, 24, 6482}, {0x000707be8, 24, 9262}, {0x000707c18, 24, 13498}, {0x000707e43, 24, 185},
+	{0x000707ede, 24, 13499}, {0x000708105, 24, 3}, {0x00070820e, 24, 13500}, {0x00070828e, 24, 13501},
+	{0x0007085c6, 24, 13494}, {0x000708b78, 24, 13502}, {0x000708d09, 24, 5842}, {0x000709383, 24, 13503},
+	{0x0007093f8, 24, 13504}, {0x000709756, 24, 13505}, {0x000709a0b, 24, 13506}, {0x000709ba5, 24, 13507},
+	{0x000709bfc, 24, 13508}, {0x000709c8f, 24, 13509}, {0x000709e29, 24, 7216}, {0x000709e86, 24, 13510},
+	{0x000709f2d, 24, 8716}, {0x00070a191, 24, 13511}, {0x00070a41c, 24, 13512}, {0x00070a66a, 24, 13513},
+	{0x00070a8e3, 24, 9262}, {0x00070aab2, 24, 8397}, {0x00070ad54, 24, 13514}, {0x00070af25, 24, 13515},
+	{0x00070b035, 24, 13516}, {0x00070b08c, 24, 13517}, {0x00070b14e, 24, 6621}, {0x00070b265, 24, 13518},
+	{0x00070b3d5, 24, 13519}, {0x00070b599, 24, 13520}, {0x00070b921, 24, 2559}, {0x00070baef, 24, 11618},
+	{0x00070c6ac, 24, 13521}, {0x00070c76f, 24, 13522}, {0x00070ca9b, 24, 3}, {0x00070cd60, 24, 865},
+	{0x00070d4f2, 24, 8028}, {0x00070d57e, 24, 13523}, {0x00070d5e7, 24, 13524}, {0x00070d6b6, 24, 13525},
+	{0x00070d880, 24, 13526}, {0x00070d931, 24, 13527}, {0x00070da9c, 24, 13528}, {0x00070dda1, 24, 8955},
+	{0x00070dee2, 24, 865}, {0x00070e027, 24, 13529}, {0x00070e139, 24, 13530}, {0x00070e24c, 24, 13531},
+	{0x00070e284, 24, 13532}, {0x00070e72c, 24, 865}, {0x00070e843, 24, 13533}, {0x00070ee50, 24, 13534},
+	{0x00070f176, 24, 13535}, {0x00070f196, 24, 3726}, {0x00070f1a1, 24, 7813}, {0x00070f1e5, 24, 13536},
+	{0x00070f395, 24, 3976}, {0x00070f927, 24, 4482}, {0x00070f96d, 24, 11618}, {0x00070fc8c, 24, 4471},
+	{0x00070ff5c, 24, 13537}, {0x00070ff76, 24, 4305}, {0x0007403bd, 24, 1741}, {0x00074042b, 24, 13538},
+	{0x000740abc, 24, 13539}, {0x000740edb, 24, 13540}, {0x000741489, 24, 13541}, {0x0007415e2, 24, 13542},
+	{0x0007419f8, 24, 11626}, {0x000741e93, 24, 11300}, {0x000741f4a, 24, 11618}, {0x00074258a, 24, 11618},
+	{0x0007426ac, 24, 1965}, {0x00074273c, 24, 13543}, {0x0007427ea, 24, 5356}, {0x0007429af, 24, 6482},
+	{0x000742b0f, 24, 13544}, {0x000742b62, 24, 7968}, {0x000742d0a, 24, 13545}, {0x000742efc, 24, 13546},
+	{0x000742f68, 24, 12412}, {0x000743170, 24, 4428}, {0x000743256, 24, 13547}, {0x00074372f, 24, 13548},
+	{0x000743889, 24, 13549}, {0x000743ecb, 24, 13550}, {0x000744401, 24, 11962}, {0x00074458a, 24, 1842},
+	{0x0007446a0, 24, 11475}, {0x000744be9, 24, 13551}, {0x000744d79, 24, 13552}, {0x0007451ba, 24, 11809},
+	{0x000745327, 24, 13553}, {0x00074547d, 24, 6959}, {0x000745612, 24, 185}, {0x000745798, 24, 13554},
+	{0x000745c9f, 24, 13555}, {0x000745e1c, 24, 11040}, {0x000745f00, 24, 13556}, {0x000745fae, 24, 13557},
+	{0x0007463df, 24, 13558}, {0x0007465d1, 24, 13559}, {0x000746630, 24, 13560}, {0x000746a3a, 24, 13561},
+	{0x000746a89, 24, 13562}, {0x000746a8f, 24, 13563}, {0x000746b82, 24, 13564}, {0x000746f3d, 24, 13565},
+	{0x0007472f2, 24, 13566}, {0x000747336, 24, 13567}, {0x000747548, 24, 13568}, {0x000747818, 24, 13569},
+	{0x000747b7a, 24, 13570}, {0x000747db6, 24, 13571}, {0x000747e1a, 24, 13572}, {0x000747e2d, 24, 13573},
+	{0x000748114, 24, 865}, {0x00074852a, 24, 9722}, {0x00074867a, 24, 2822}, {0x00074882a, 24, 9262},
+	{0x00074888b, 24, 1957}, {0x000748e08, 24, 13574}, {0x000748ef8, 24, 127}, {0x000748f1b, 24, 13575},
+	{0x000748f4d, 24, 13576}, {0x000749050, 24, 13577}, {0x00074911a, 24, 4614}, {0x0007491bd, 24, 13578},
+	{0x0007493a4, 24, 1754}, {0x00074943d, 24, 13579}, {0x000749637, 24, 13580}, {0x000749975, 24, 13435},
+	{0x000749c52, 24, 13581}, {0x000749ce3, 24, 13582}, {0x000749ddc, 24, 7228}, {0x00074a02f, 24, 1965},
+	{0x00074a063, 24, 9262}, {0x00074a2e6, 24, 1965}, {0x00074a34a, 24, 13583}, {0x00074a4a7, 24, 13584},
+	{0x00074a4b5, 24, 13585}, {0x00074a722, 24, 6967}, {0x00074adb7, 24, 13586}, {0x00074ae76, 24, 13587},
+	{0x00074b00c, 24, 13588}, {0x00074b9eb, 24, 13589}, {0x00074badb, 24, 13590}, {0x00074be08, 24, 12386},
+	{0x00074bfa1, 24, 13591}, {0x00074c246, 24, 13568}, {0x00074c621, 24, 13592}, {0x00074c99a, 24, 11261},
+	{0x00074ca25, 24, 13593}, {0x00074cd0c, 24, 13594}, {0x00074ce56, 24, 13595}, {0x00074d02b, 24, 4673},
+	{0x00074d0dc, 24, 13596}, {0x00074d435, 24, 5352}, {0x00074d675, 24, 13597}, {0x00074d6ea, 24, 4305},
+	{0x00074d850, 24, 13598}, {0x00074da38, 24, 7108}, {0x00074dbd1, 24, 13599}, {0x00074de2b, 24, 7813},
+	{0x00074e06e, 24, 13600}, {0x00074e14a, 24, 11626}, {0x00074e1b6, 24, 865}, {0x00074e277, 24, 13601},
+	{0x00074e28c, 24, 965}, {0x00074e2f5, 24, 865}, {0x00074e424, 24, 13602}, {0x00074e50b, 24, 4472},
+	{0x00074e537, 24, 13603}, {0x00074e543, 24, 7813}, {0x00074e6e2, 24, 5963}, {0x00074e7c6, 24, 185},
+	{0x00074ea3a, 24, 13604}, {0x00074ecf1, 24, 13605}, {0x00074f06d, 24, 5090}, {0x00074f07d, 24, 13606},
+	{0x00074f102, 24, 13607}, {0x00074f413, 24, 13608}, {0x00074f612, 24, 185}, {0x00074f726, 24, 13609},
+	{0x00074f85d, 24, 13610}, {0x00074f8db, 24, 11626}, {0x00074fda0, 24, 13611}, {0x00074fe48, 24, 10939},
+	{0x00074ff7d, 24, 13612}, {0x00078028f, 24, 13613}, {0x000780738, 24, 13614}, {0x000780cb8, 24, 4472},
+	{0x000781185, 24, 13615}, {0x0007812b8, 24, 13616}, {0x000781881, 24, 5090}, {0x00078192e, 24, 13617},
+	{0x0007819f7, 24, 5553}, {0x000781c5a, 24, 6658}, {0x000781dba, 24, 9262}, {0x000781dfd, 24, 13618},
+	{0x000781fdb, 24, 1842}, {0x00078223d, 24, 13619}, {0x0007824af, 24, 4673}, {0x000782544, 24, 13620},
+	{0x0007825ad, 24, 227}, {0x000782bcb, 24, 2822}, {0x000782eef, 24, 5842}, {0x00078303b, 24, 13621},
+	{0x0007830e1, 24, 13622}, {0x00078312b, 24, 8716}, {0x0007831c1, 24, 865}, {0x00078324f, 24, 13623},
+	{0x000783a84, 24, 865}, {0x000783ce3, 24, 13624}, {0x000783d5b, 24, 13625}, {0x000783e53, 24, 6014},
+	{0x000783f15, 24, 13626}, {0x0007840e4, 24, 1842}, {0x000784405, 24, 13627}, {0x000784476, 24, 13628},
+	{0x000784561, 24, 13629}, {0x0007845c4, 24, 2822}, {0x0007846c4, 24, 8662}, {0x00078471d, 24, 1842},
+	{0x000784859, 24, 11475}, {0x00078491d, 24, 13630}, {0x000784b08, 24, 13631}, {0x000784b87, 24, 11683},
+	{0x00078510c, 24, 13632}, {0x00078521a, 24, 1842}, {0x000785262, 24, 13633}, {0x00078542e, 24, 11768},
+	{0x000785517, 24, 13634}, {0x000785712, 24, 13635}, {0x0007858f3, 24, 13636}, {0x00078593e, 24, 13637},
+	{0x00078595e, 24, 1842}, {0x000785968, 24, 6842}, {0x000785c72, 24, 13638}, {0x000785f4c, 24, 13639},
+	{0x00078617c, 24, 13640}, {0x0007866ae, 24, 13641}, {0x000786a89, 24, 9715}, {0x000786c1c, 24, 865},
+	{0x00078719c, 24, 185}, {0x000787e61, 24, 865}, {0x000787f62, 24, 13642}, {0x00078818f, 24, 13643},
+	{0x00078843c, 24, 311}, {0x0007884ee, 24, 13644}, {0x000788973, 24, 13645}, {0x000788c54, 24, 13646},
+	{0x000788df7, 24, 8599}, {0x00078923e, 24, 5842}, {0x00078929c, 24, 4472}, {0x000789684, 24, 185},
+	{0x0007898fd, 24, 13647}, {0x00078995c, 24, 13648}, {0x000789966, 24, 13649}, {0x00078998f, 24, 13650},
+	{0x000789ce7, 24, 13651}, {0x000789ed0, 24, 4482}, {0x000789f4c, 24, 13652}, {0x000789f70, 24, 11656},
+	{0x000789f87, 24, 13653}, {0x00078a051, 24, 13654}, {0x00078a106, 24, 11491}, {0x00078a183, 24, 13655},
+	{0x00078a2a0, 24, 2314}, {0x00078a351, 24, 13656}, {0x00078a3e4, 24, 865}, {0x00078a504, 24, 4305},
+	{0x00078a5dd, 24, 13657}, {0x00078a683, 24, 13658}, {0x00078a6bd, 24, 13659}, {0x00078a714, 24, 13660},
+	{0x00078a873, 24, 1842}, {0x00078ab60, 24, 13661}, {0x00078abbb, 24, 8104}, {0x00078acbf, 24, 13662},
+	{0x00078acc0, 24, 459}, {0x00078ae0c, 24, 13663}, {0x00078b3b9, 24, 13664}, {0x00078b3ce, 24, 13665},
+	{0x00078b5d2, 24, 13666}, {0x00078b6c1, 24, 13667}, {0x00078b81a, 24, 13668}, {0x00078bad0, 24, 13669},
+	{0x00078baf9, 24, 1965}, {0x00078bdbc, 24, 1842}, {0x00078beb6, 24, 13670}, {0x00078bebd, 24, 13671},
+	{0x00078c40e, 24, 13672}, {0x00078c4ab, 24, 13673}, {0x00078c5e5, 24, 4305}, {0x00078c6bb, 24, 13674},
+	{0x00078ca04, 24, 5842}, {0x00078ca39, 24, 865}, {0x00078ca5e, 24, 13675}, {0x00078cb33, 24, 13676},
+	{0x00078cd8e, 24, 13677}, {0x00078d004, 24, 13678}, {0x00078d129, 24, 13679}, {0x00078d34f, 24, 13680},
+	{0x00078d38d, 24, 13681}, {0x00078d5b5, 24, 13682}, {0x00078d66f, 24, 13683}, {0x00078d6f0, 24, 12901},
+	{0x00078d752, 24, 9262}, {0x00078d75f, 24, 11309}, {0x00078d99f, 24, 13684}, {0x00078da6e, 24, 1965},
+	{0x00078dab3, 24, 13685}, {0x00078dd08, 24, 6482}, {0x00078ddd6, 24, 13686}, {0x00078dee4, 24, 4305},
+	{0x00078e3b5, 24, 459}, {0x00078e400, 24, 6482}, {0x00078e7d1, 24, 459}, {0x00078e8b6, 24, 8716},
+	{0x00078e980, 24, 13687}, {0x00078eb14, 24, 12674}, {0x00078eb39, 24, 13688}, {0x00078ec22, 24, 13689},
+	{0x00078ec74, 24, 13690}, {0x00078ef4c, 24, 13691}, {0x00078f5e5, 24, 13692}, {0x00078f5fd, 24, 9715},
+	{0x00078f7be, 24, 1842}, {0x00078f7d0, 24, 13693}, {0x00078f944, 24, 99}, {0x00078fc14, 24, 13694},
+	{0x00078fd94, 24, 865}, {0x00078fe3d, 24, 5553}, {0x00078fe41, 24, 13695}, {0x00078fee2, 24, 13696},
+	{0x00078ff57, 24, 4472}, {0x0007c0187, 24, 13697}, {0x0007c02bc, 24, 13698}, {0x0007c034c, 24, 9007},
+	{0x0007c03d8, 24, 11812}, {0x0007c0507, 24, 9722}, {0x0007c051e, 24, 13699}, {0x0007c0623, 24, 13700},
+	{0x0007c08d9, 24, 13701}, {0x0007c092b, 24, 13702}, {0x0007c0a50, 24, 13703}, {0x0007c0ece, 24, 1965},
+	{0x0007c11be, 24, 865}, {0x0007c11cd, 24, 13704}, {0x0007c1476, 24, 13705}, {0x0007c160d, 24, 13706},
+	{0x0007c1a03, 24, 13707}, {0x0007c1afc, 24, 13708}, {0x0007c1dd9, 24, 13709}, {0x0007c1e52, 24, 12272},
+	{0x0007c1eb3, 24, 13710}, {0x0007c2048, 24, 13711}, {0x0007c2064, 24, 11594}, {0x0007c2587, 24, 13712},
+	{0x0007c2cf3, 24, 13713}, {0x0007c2e0d, 24, 13714}, {0x0007c2f80, 24, 7532}, {0x0007c336e, 24, 13715},
+	{0x0007c386c, 24, 13716}, {0x0007c3920, 24, 13717}, {0x0007c3bd5, 24, 13718}, {0x0007c3cb6, 24, 13719},
+	{0x0007c3e9d, 24, 13720}, {0x0007c438f, 24, 13721}, {0x0007c444c, 24, 13722}, {0x0007c49b9, 24, 13723},
+	{0x0007c4a82, 24, 13724}, {0x0007c4aa8, 24, 13725}, {0x0007c4b78, 24, 13726}, {0x0007c4c58, 24, 13727},
+	{0x0007c4ca5, 24, 6014}, {0x0007c4fb5, 24, 4428}, {0x0007c534a, 24, 13728}, {0x0007c55e7, 24, 13729},
+	{0x0007c5cf8, 24, 4472}, {0x0007c6097, 24, 9262}, {0x0007c6193, 24, 2176}, {0x0007c669d, 24, 4305},
+	{0x0007c69f6, 24, 1965}, {0x0007c6ab3, 24, 13730}, {0x0007c6ac3, 24, 13731}, {0x0007c6adb, 24, 13732},
+	{0x0007c6b33, 24, 13733}, {0x0007c6b52, 24, 13734}, {0x0007c6c39, 24, 13735}, {0x0007c6c8f, 24, 13736},
+	{0x0007c6d62, 24, 865}, {0x0007c6df8, 24, 865}, {0x0007c6f06, 24, 13737}, {0x0007c6ff8, 24, 13738},
+	{0x0007c70bc, 24, 11626}, {0x0007c72e4, 24, 13739}, {0x0007c7673, 24, 13740}, {0x0007c7a53, 24, 13741},
+	{0x0007c7a91, 24, 4472}, {0x0007c7be4, 24, 13742}, {0x0007c7d41, 24, 13743}, {0x0007c822d, 24, 13744},
+	{0x0007c8274, 24, 13745}, {0x0007c8306, 24, 13746}, {0x0007c8d91, 24, 13747}, {0x0007c8ee4, 24, 4305},
+	{0x0007c94b2, 24, 13748}, {0x0007c95f3, 24, 1965}, {0x0007c9763, 24, 13749}, {0x0007c9a9b, 24, 13750},
+	{0x0007ca15d, 24, 13751}, {0x0007ca237, 24, 13752}, {0x0007ca23e, 24, 9262}, {0x0007ca29b, 24, 13753},
+	{0x0007ca61d, 24, 13754}, {0x0007cab25, 24, 13755}, {0x0007cacb2, 24, 13756}, {0x0007cad74, 24, 1965},
+	{0x0007cb03e, 24, 13757}, {0x0007cb177, 24, 13758}, {0x0007cb21b, 24, 6959}, {0x0007cb232, 24, 11585},
+	{0x0007cb25c, 24, 13759}, {0x0007cb542, 24, 13760}, {0x0007cb733, 24, 7605}, {0x0007cb77b, 24, 13761},
+	{0x0007cbb6f, 24, 13762}, {0x0007cbd06, 24, 13763}, {0x0007cbf88, 24, 13764}, {0x0007cbfb1, 24, 185},
+	{0x0007cc3a1, 24, 865}, {0x0007cc4ef, 24, 13765}, {0x0007cc537, 24, 865}, {0x0007cc709, 24, 13766},
+	{0x0007cc8ab, 24, 13767}, {0x0007cc8d0, 24, 13768}, {0x0007cc8d7, 24, 13769}, {0x0007cc95a, 24, 13770},
+	{0x0007ccb0d, 24, 13771}, {0x0007cccb8, 24, 4472}, {0x0007ccd11, 24, 13772}, {0x0007ccd3c, 24, 13773},
+	{0x0007ccfcf, 24, 13774}, {0x0007cd1c3, 24, 865}, {0x0007cd30a, 24, 13775}, {0x0007cd762, 24, 13776},
+	{0x0007cd844, 24, 13777}, {0x0007cd9fe, 24, 13778}, {0x0007cda84, 24, 13779}, {0x0007cdd11, 24, 13780},
+	{0x0007cdd20, 24, 13781}, {0x0007cdd90, 24, 13782}, {0x0007ce044, 24, 13783}, {0x0007ce1ff, 24, 13784},
+	{0x0007ce4aa, 24, 99}, {0x0007ce524, 24, 13785}, {0x0007ce56b, 24, 13786}, {0x0007ce9d3, 24, 6482},
+	{0x0007cebea, 24, 13787}, {0x0007cec79, 24, 4305}, {0x0007ced8d, 24, 13788}, {0x0007cef18, 24, 13789},
+	{0x0007cef8a, 24, 13790}, {0x0007cf05f, 24, 865}, {0x0007cf098, 24, 13791}, {0x0007cf0ba, 24, 13792},
+	{0x0007cf429, 24, 13793}, {0x0007cf854, 24, 4482}, {0x0007cf90e, 24, 1842}, {0x0007cfadf, 24, 865},
+	{0x0007cfe28, 24, 13794}, {0x0007cfe4e, 24, 13795}, {0x0007cff62, 24, 13796}, {0x00080000b, 24, 4472},
+	{0x000800010, 24, 11419}, {0x00080006e, 24, 865}, {0x000800184, 24, 2176}, {0x0008005df, 24, 13797},
+	{0x0008007a2, 24, 13798}, {0x000800902, 24, 13799}, {0x000800a06, 24, 13800}, {0x000800b51, 24, 13801},
+	{0x000800e24, 24, 13802}, {0x000801440, 24, 13803}, {0x0008014a8, 24, 13804}, {0x0008016b7, 24, 13805},
+	{0x00080177d, 24, 108}, {0x0008018a7, 24, 12002}, {0x000801934, 24, 4472}, {0x000801967, 24, 13806},
+	{0x000801daa, 24, 13807}, {0x000801f02, 24, 7108}, {0x0008020af, 24, 13808}, {0x000802275, 24, 13809},
+	{0x000802aa8, 24, 12694}, {0x000802afa, 24, 13810}, {0x000802de1, 24, 13811}, {0x000802e14, 24, 13812},
+	{0x000802fde, 24, 13813}, {0x000803457, 24, 13814}, {0x000803773, 24, 13815}, {0x0008038bc, 24, 9715},
+	{0x0008038fd, 24, 13816}, {0x0008039e5, 24, 13817}, {0x000803b2a, 24, 13818}, {0x000803b9a, 24, 13819},
+	{0x000803f5d, 24, 13820}, {0x000803fd6, 24, 13821}, {0x00080414e, 24, 12291}, {0x00080427c, 24, 13822},
+	{0x000804731, 24, 13823}, {0x0008048a5, 24, 13824}, {0x000804971, 24, 865}, {0x000804b20, 24, 13825},
+	{0x000804e81, 24, 1842}, {0x000804f58, 24, 13826}, {0x00080501b, 24, 5842}, {0x000805067, 24, 13827},
+	{0x0008056f2, 24, 6482}, {0x000805719, 24, 1842}, {0x0008058c5, 24, 13828}, {0x0008059fd, 24, 13829},
+	{0x000806007, 24, 8028}, {0x00080618f, 24, 13830}, {0x000806459, 24, 13831}, {0x0008065e9, 24, 13832},
+	{0x000806629, 24, 13833}, {0x000806ab0, 24, 9723}, {0x000806c1b, 24, 11721}, {0x000806c8b, 24, 13834},
+	{0x000806cbc, 24, 13835}, {0x00080711f, 24, 5553}, {0x00080717a, 24, 9715}, {0x000807459, 24, 13836},
+	{0x000807693, 24, 13837}, {0x0008079ae, 24, 13838}, {0x000807a7f, 24, 13839}, {0x000807b1e, 24, 13840},
+	{0x000807b85, 24, 11626}, {0x000807d1b, 24, 13841}, {0x000807de3, 24, 13842}, {0x0008081a5, 24, 13843},
+	{0x000808287, 24, 13844}, {0x000808698, 24, 13845}, {0x0008086f2, 24, 4472}, {0x000808917, 24, 11766},
+	{0x000808b5c, 24, 13846}, {0x00080912a, 24, 13847}, {0x0008091c0, 24, 13848}, {0x00080929f, 24, 865},
+	{0x000809393, 24, 13849}, {0x00080946c, 24, 13850}, {0x0008096b1, 24, 185}, {0x0008096ca, 24, 13851},
+	{0x00080971b, 24, 13852}, {0x000809b20, 24, 4472}, {0x000809fab, 24, 13853}, {0x00080a1d7, 24, 13854},
+	{0x00080a85d, 24, 13855}, {0x00080aaa4, 24, 13856}, {0x00080ad67, 24, 3553}, {0x00080b219, 24, 13857},
+	{0x00080b289, 24, 13858}, {0x00080b32a, 24, 13859}, {0x00080b686, 24, 9715}, {0x00080b95c, 24, 13860},
+	{0x00080baac, 24, 13861}, {0x00080bae6, 24, 13862}, {0x00080bbeb, 24, 13863}, {0x00080be05, 24, 865},
+	{0x00080c16e, 24, 11475}, {0x00080c5e6, 24, 965}, {0x00080c63f, 24, 13864}, {0x00080c6ab, 24, 12146},
+	{0x00080c6ca, 24, 13865}, {0x00080c862, 24, 13866}, {0x00080ceb1, 24, 13867}, {0x00080cf41, 24, 4485},
+	{0x00080d019, 24, 13868}, {0x00080d09b, 24, 9715}, {0x00080d18b, 24, 13869}, {0x00080d21d, 24, 8497},
+	{0x00080d433, 24, 13870}, {0x00080d733, 24, 13871}, {0x00080db31, 24, 13872}, {0x00080e4da, 24, 11626},
+	{0x00080e650, 24, 865}, {0x00080ea96, 24, 865}, {0x00080eaca, 24, 13873}, {0x00080eb77, 24, 5336},
+	{0x00080ee73, 24, 13874}, {0x00080f25e, 24, 13875}, {0x00080f503, 24, 6621}, {0x00080f593, 24, 13876},
+	{0x00080f62e, 24, 11618}, {0x00080f8eb, 24, 13877}, {0x00080fa5b, 24, 10200}, {0x00080fb06, 24, 9262},
+	{0x00080ffa8, 24, 13878}, {0x0008400d2, 24, 2582}, {0x0008401a7, 24, 13879}, {0x000840b2d, 24, 13880},
+	{0x000840f45, 24, 13881}, {0x00084119e, 24, 1842}, {0x000841715, 24, 13882}, {0x000841766, 24, 13883},
+	{0x000841826, 24, 13884}, {0x00084183a, 24, 4614}, {0x000841888, 24, 5553}, {0x000841b38, 24, 13885},
+	{0x000841b5e, 24, 11962}, {0x000841e26, 24, 13886}, {0x000842141, 24, 13887}, {0x00084248d, 24, 1217},
+	{0x00084253f, 24, 13888}, {0x0008425a4, 24, 13889}, {0x0008425db, 24, 1842}, {0x000842615, 24, 1957},
+	{0x00084262b, 24, 5554}, {0x000842690, 24, 13890}, {0x0008427ce, 24, 13891}, {0x000842914, 24, 13892},
+	{0x000842999, 24, 865}, {0x000842b2b, 24, 5963}, {0x000842b50, 24, 13893}, {0x000842bbc, 24, 13894},
+	{0x000842e27, 24, 1842}, {0x000842f75, 24, 13895}, {0x0008430e5, 24, 13896}, {0x0008432ea, 24, 13897},
+	{0x000843497, 24, 11475}, {0x000843611, 24, 13898}, {0x000843835, 24, 865}, {0x000843838, 24, 12950},
+	{0x000843a4b, 24, 4472}, {0x000843f4e, 24, 13899}, {0x000844464, 24, 13900}, {0x000844823, 24, 13901},
+	{0x000844915, 24, 13902}, {0x000844bb7, 24, 13903}, {0x000844bf5, 24, 6482}, {0x000844f03, 24, 13904},
+	{0x000845181, 24, 1842}, {0x0008455a5, 24, 11234}, {0x00084569c, 24, 13905}, {0x000845787, 24, 13906},
+	{0x000845c93, 24, 13907}, {0x000845dd7, 24, 13908}, {0x0008461a0, 24, 185}, {0x000846223, 24, 13028},
+	{0x0008462a6, 24, 13909}, {0x0008463d6, 24, 965}, {0x000846aed, 24, 13910}, {0x000846eb1, 24, 13911},
+	{0x000847207, 24, 13912}, {0x000847303, 24, 13913}, {0x00084742a, 24, 8716}, {0x000847616, 24, 13914},
+	{0x00084788b, 24, 865}, {0x0008478ac, 24, 1965}, {0x000847a88, 24, 2176}, {0x000847e40, 24, 4305},
+	{0x00084802d, 24, 1965}, {0x0008482f4, 24, 13915}, {0x000848336, 24, 13916}, {0x000848371, 24, 11282},
+	{0x000848433, 24, 13917}, {0x000848506, 24, 865}, {0x00084850a, 24, 13918}, {0x0008486f3, 24, 13919},
+	{0x000848d84, 24, 13920}, {0x000848dc7, 24, 6959}, {0x000848e0c, 24, 865}, {0x000848e96, 24, 13921},
+	{0x000848edf, 24, 11230}, {0x000848f69, 24, 5963}, {0x000849000, 24, 13922}, {0x00084930c, 24, 13923},
+	{0x00084948c, 24, 8599}, {0x000849681, 24, 13924}, {0x0008496d8, 24, 6621}, {0x0008497b8, 24, 13925},
+	{0x000849ca6, 24, 4428}, {0x000849dc5, 24, 13926}, {0x00084a466, 24, 1842}, {0x00084a6c8, 24, 4472},
+	{0x00084a783, 24, 11600}, {0x00084a8e4, 24, 8409}, {0x00084a991, 24, 13927}, {0x00084aca4, 24, 13928},
+	{0x00084af1f, 24, 13929}, {0x00084b153, 24, 865}, {0x00084b59c, 24, 6820}, {0x00084b802, 24, 1965},
+	{0x00084c2e4, 24, 13930}, {0x00084c3e8, 24, 13931}, {0x00084c727, 24, 13932}, {0x00084c7a9, 24, 13933},
+	{0x00084c8b1, 24, 13934}, {0x00084c9b2, 24, 11768}, {0x00084cfbf, 24, 13935}, {0x00084d32a, 24, 13936},
+	{0x00084d9c8, 24, 13937}, {0x00084db2f, 24, 13938}, {0x00084dbac, 24, 9715}, {0x00084dbfc, 24, 5554},
+	{0x00084dd20, 24, 4305}, {0x00084ddb7, 24, 13939}, {0x00084de3d, 24, 13940}, {0x00084df0c, 24, 13941},
+	{0x00084df19, 24, 13942}, {0x00084e058, 24, 6621}, {0x00084e4d9, 24, 13943}, {0x00084e629, 24, 13944},
+	{0x00084e714, 24, 13945}, {0x00084ea99, 24, 13946}, {0x00084eb18, 24, 4305}, {0x00084ed33, 24, 13947},
+	{0x00084f129, 24, 13948}, {0x00084f493, 24, 13949}, {0x00084f64c, 24, 13950}, {0x00084fcfe, 24, 865},
+	{0x00084fe9e, 24, 13951}, {0x000880355, 24, 13952}, {0x000880905, 24, 13953}, {0x000880f10, 24, 13954},
+	{0x000880fb6, 24, 13955}, {0x000881036, 24, 13956}, {0x00088124e, 24, 13957}, {0x00088142b, 24, 13958},
+	{0x000881544, 24, 5595}, {0x0008818ae, 24, 13959}, {0x000881dfc, 24, 1965}, {0x000881fa1, 24, 865},
+	{0x000882012, 24, 13960}, {0x0008821e3, 24, 13961}, {0x000882364, 24, 13962}, {0x0008823fe, 24, 13963},
+	{0x00088252c, 24, 4428}, {0x000882593, 24, 11766}, {0x000882950, 24, 13964}, {0x000882e5a, 24, 13965},
+	{0x00088308a, 24, 11916}, {0x00088329b, 24, 11743}, {0x000883314, 24, 4305}, {0x0008833be, 24, 13966},
+	{0x00088354c, 24, 13967}, {0x000883612, 24, 13968}, {0x000883b8b, 24, 13969}, {0x000884157, 24, 13970},
+	{0x0008841c1, 24, 13971}, {0x0008841fc, 24, 13972}, {0x0008843e1, 24, 3}, {0x0008844f6, 24, 5842},
+	{0x00088462a, 24, 13973}, {0x000884b39, 24, 13974}, {0x0008851fb, 24, 11475}, {0x00088532e, 24, 4472},
+	{0x000885395, 24, 865}, {0x0008853d4, 24, 9715}, {0x00088576d, 24, 13975}, {0x000885a92, 24, 1965},
+	{0x000885bdd, 24, 12555}, {0x000885c47, 24, 11600}, {0x000885d90, 24, 11626}, {0x00088615a, 24, 13976},
+	{0x0008863df, 24, 865}, {0x00088685c, 24, 13977}, {0x000886b76, 24, 13978}, {0x000887033, 24, 13979},
+	{0x00088708c, 24, 4485}, {0x0008870ef, 24, 13980}, {0x000887384, 24, 1934}, {0x000887398, 24, 13981},
+	{0x000887556, 24, 1965}, {0x00088789c, 24, 13982}, {0x000888603, 24, 9262}, {0x0008886a0, 24, 13983},
+	{0x000888717, 24, 124}, {0x0008887dd, 24, 13984}, {0x000888914, 24, 13985}, {0x000888964, 24, 13986},
+	{0x000888b5d, 24, 13987}, {0x000888c19, 24, 13988}, {0x00088908d, 24, 1965}, {0x000889166, 24, 13989},
+	{0x0008891dd, 24, 13990}, {0x000889471, 24, 127}, {0x0008894f9, 24, 13991}, {0x0008895b9, 24, 13992},
+	{0x000889676, 24, 13993}, {0x0008897df, 24, 13994}, {0x000889821, 24, 13995}, {0x000889b39, 24, 1842},
+	{0x000889ca6, 24, 13996}, {0x000889ffa, 24, 6482}, {0x00088a25e, 24, 13257}, {0x00088a2d7, 24, 9262},
+	{0x00088a3cc, 24, 13997}, {0x00088a5bd, 24, 13998}, {0x00088a73c, 24, 12173}, {0x00088acc1, 24, 13999},
+	{0x00088ae1d, 24, 14000}, {0x00088b168, 24, 14001}, {0x00088b1e1, 24, 14002}, {0x00088b627, 24, 14003},
+	{0x00088ba7f, 24, 14004}, {0x00088bfd5, 24, 14005}, {0x00088c36e, 24, 14006}, {0x00088c626, 24, 14007},
+	{0x00088c663, 24, 865}, {0x00088c9d0, 24, 6967}, {0x00088cb87, 24, 865}, {0x00088cba5, 24, 14008},
+	{0x00088cefa, 24, 9715}, {0x00088d37b, 24, 14009}, {0x00088d7bc, 24, 14010}, {0x00088d962, 24, 14011},
+	{0x00088dc96, 24, 14012}, {0x00088dd79, 24, 2119}, {0x00088e0a0, 24, 14013}, {0x00088e0f3, 24, 5553},
+	{0x00088e161, 24, 14014}, {0x00088e3ab, 24, 9715}, {0x00088e603, 24, 14015}, {0x00088e712, 24, 14016},
+	{0x00088e7a6, 24, 14017}, {0x00088e8f8, 24, 14018}, {0x00088e917, 24, 14019}, {0x00088ed1c, 24, 14020},
+	{0x00088f031, 24, 1965}, {0x00088f077, 24, 3}, {0x00088f488, 24, 14021}, {0x00088f490, 24, 14022},
+	{0x00088f7c7, 24, 12146}, {0x00088fd15, 24, 14023}, {0x00088fed6, 24, 14024}, {0x0008c006d, 24, 865},
+	{0x0008c04ff, 24, 12146}, {0x0008c0551, 24, 14025}, {0x0008c078c, 24, 14026}, {0x0008c088b, 24, 14027},
+	{0x0008c09f4, 24, 185}, {0x0008c0c90, 24, 4614}, {0x0008c0ca3, 24, 14028}, {0x0008c0ee3, 24, 14029},
+	{0x0008c11cb, 24, 14030}, {0x0008c18d9, 24, 14031}, {0x0008c1f94, 24, 14032}, {0x0008c210a, 24, 8096},
+	{0x0008c271d, 24, 14033}, {0x0008c278a, 24, 14034}, {0x0008c2937, 24, 865}, {0x0008c2daa, 24, 865},
+	{0x0008c2f39, 24, 14035}, {0x0008c3330, 24, 14036}, {0x0008c3357, 24, 14037}, {0x0008c34fd, 24, 9715},
+	{0x0008c3ae3, 24, 6967}, {0x0008c3c07, 24, 14038}, {0x0008c3c4a, 24, 14039}, {0x0008c41f2, 24, 14040},
+	{0x0008c4435, 24, 14041}, {0x0008c4aee, 24, 14042}, {0x0008c4b59, 24, 14043}, {0x0008c4cdc, 24, 12695},
+	{0x0008c4db9, 24, 14044}, {0x0008c4dea, 24, 14045}, {0x0008c5105, 24, 14046}, {0x0008c53f7, 24, 14047},
+	{0x0008c541d, 24, 13069}, {0x0008c569d, 24, 14048}, {0x0008c56c5, 24, 2314}, {0x0008c57fd, 24, 14049},
+	{0x0008c5877, 24, 865}, {0x0008c598b, 24, 14050}, {0x0008c5af0, 24, 14051}, {0x0008c5ca1, 24, 14052},
+	{0x0008c5d60, 24, 14053}, {0x0008c5fdf, 24, 14054}, {0x0008c604f, 24, 3}, {0x0008c640b, 24, 14055},
+	{0x0008c6422, 24, 2582}, {0x0008c6878, 24, 14056}, {0x0008c6ae4, 24, 14057}, {0x0008c705a, 24, 4472},
+	{0x0008c71f8, 24, 1842}, {0x0008c736e, 24, 7968}, {0x0008c76c1, 24, 14058}, {0x0008c7712, 24, 1842},
+	{0x0008c7716, 24, 14059}, {0x0008c7967, 24, 8716}, {0x0008c7b9d, 24, 865}, {0x0008c7c92, 24, 865},
+	{0x0008c7cb5, 24, 6482}, {0x0008c7cff, 24, 127}, {0x0008c7eb3, 24, 14060}, {0x0008c7f3b, 24, 185},
+	{0x0008c82a8, 24, 14061}, {0x0008c8401, 24, 99}, {0x0008c873b, 24, 14062}, {0x0008c89a5, 24, 13413},
+	{0x0008c8a6e, 24, 14063}, {0x0008c8b83, 24, 4305}, {0x0008c8e76, 24, 14064}, {0x0008c90d3, 24, 11600},
+	{0x0008c9109, 24, 14065}, {0x0008c9236, 24, 14066}, {0x0008c94cf, 24, 14067}, {0x0008ca048, 24, 14068},
+	{0x0008ca982, 24, 4472}, {0x0008cae4c, 24, 14069}, {0x0008cae89, 24, 14070}, {0x0008cb094, 24, 14071},
+	{0x0008cb64f, 24, 3}, {0x0008cb7f7, 24, 14072}, {0x0008cb82c, 24, 14073}, {0x0008cb864, 24, 8729},
+	{0x0008cbebe, 24, 14074}, {0x0008cbf9d, 24, 14075}, {0x0008cbfa6, 24, 1842}, {0x0008cc121, 24, 11997},
+	{0x0008cc5e1, 24, 14076}, {0x0008cc7aa, 24, 14077}, {0x0008cc7d0, 24, 14078}, {0x0008cc8cd, 24, 5076},
+	{0x0008ccda2, 24, 14079}, {0x0008ccde8, 24, 2314}, {0x0008ccf5c, 24, 14080}, {0x0008cd17b, 24, 14081},
+	{0x0008cd3a2, 24, 14082}, {0x0008cd628, 24, 14083}, {0x0008cdb25, 24, 14084}, {0x0008cdcd4, 24, 11475},
+	{0x0008cdd8d, 24, 14085}, {0x0008cde52, 24, 14086}, {0x0008cde99, 24, 14087}, {0x0008cdf9d, 24, 5288},
+	{0x0008ce081, 24, 8716}, {0x0008ce748, 24, 99}, {0x0008ce78c, 24, 14088}, {0x0008ce7b3, 24, 14089},
+	{0x0008ceec6, 24, 14090}, {0x0008cf228, 24, 13407}, {0x0008cf813, 24, 14091}, {0x0008cf945, 24, 14092},
+	{0x0008cf9c9, 24, 14093}, {0x0008cfaba, 24, 865}, {0x0008cfdf0, 24, 14094}, {0x00090004e, 24, 6482},
+	{0x0009000db, 24, 1842}, {0x00090013b, 24, 9007}, {0x00090028a, 24, 14095}, {0x0009002a9, 24, 14096},
+	{0x0009003b7, 24, 14097}, {0x000900917, 24, 14098}, {0x000900a39, 24, 14099}, {0x000900a3a, 24, 14100},
+	{0x000900cb4, 24, 14101}, {0x000900d66, 24, 14102}, {0x000900dcb, 24, 185}, {0x00090179b, 24, 14103},
+	{0x0009017ac, 24, 9262}, {0x00090185e, 24, 14104}, {0x00090187c, 24, 12950}, {0x0009018ae, 24, 14105},
+	{0x000901900, 24, 14106}, {0x000901aca, 24, 185}, {0x000901b0e, 24, 14107}, {0x000901d27, 24, 8716},
+	{0x000901edd, 24, 14108}, {0x00090203a, 24, 14109}, {0x000902083, 24, 14110}, {0x000902106, 24, 6014},
+	{0x000902155, 24, 2176}, {0x000902181, 24, 12671}, {0x0009027e4, 24, 865}, {0x000902b34, 24, 5352},
+	{0x000902cc7, 24, 14111}, {0x000902e1c, 24, 4472}, {0x000902e87, 24, 14112}, {0x0009031cd, 24, 14113},
+	{0x00090342b, 24, 14114}, {0x0009034fc, 24, 6482}, {0x00090356e, 24, 8239}, {0x0009038df, 24, 14115},
+	{0x000903aa0, 24, 5554}, {0x000903c92, 24, 865}, {0x000903cae, 24, 14116}, {0x000903d5a, 24, 14117},
+	{0x000903d6b, 24, 14118}, {0x000903eab, 24, 185}, {0x000904506, 24, 14119}, {0x0009046b7, 24, 14120},
+	{0x000904716, 24, 14121}, {0x00090489a, 24, 6482}, {0x0009049fa, 24, 652}, {0x000904ce5, 24, 6482},
+	{0x000904e2b, 24, 9715}, {0x00090507b, 24, 14122}, {0x00090513f, 24, 14123}, {0x000905446, 24, 14124},
+	{0x0009055ae, 24, 14125}, {0x000905682, 24, 14126}, {0x000905692, 24, 14127}, {0x0009059af, 24, 4305},
+	{0x000905f2e, 24, 11601}, {0x000905f8d, 24, 14128}, {0x00090610c, 24, 14129}, {0x000906717, 24, 14130},
+	{0x00090671c, 24, 9715}, {0x0009067b5, 24, 5554}, {0x0009067f3, 24, 11600}, {0x0009068c3, 24, 11721},
+	{0x000906cac, 24, 11493}, {0x000906dc8, 24, 14131}, {0x000906ebb, 24, 6482}, {0x000907025, 24, 14132},
+	{0x000907240, 24, 865}, {0x000907990, 24, 14133}, {0x000907a0a, 24, 14134}, {0x000907a28, 24, 14135},
+	{0x000907af1, 24, 14136}, {0x000907eba, 24, 14137}, {0x000907f61, 24, 12824}, {0x000908260, 24, 13041},
+	{0x00090837a, 24, 14138}, {0x00090840d, 24, 865}, {0x0009088a2, 24, 14139}, {0x000908c09, 24, 14140},
+	{0x000908c44, 24, 14141}, {0x000908c63, 24, 14142}, {0x000908d1d, 24, 14143}, {0x000908d6c, 24, 865},
+	{0x000908fcf, 24, 14144}, {0x00090903c, 24, 14145}, {0x000909060, 24, 14146}, {0x0009092b4, 24, 14147},
+	{0x0009094e4, 24, 11768}, {0x000909864, 24, 14148}, {0x000909916, 24, 14149}, {0x000909de0, 24, 14150},
+	{0x000909f33, 24, 2043}, {0x000909f43, 24, 14151}, {0x00090a210, 24, 14152}, {0x00090a2da, 24, 14153},
+	{0x00090a4de, 24, 2718}, {0x00090a783, 24, 14154}, {0x00090a7c1, 24, 14155}, {0x00090ac3f, 24, 14156},
+	{0x00090ae1b, 24, 11491}, {0x00090b11c, 24, 5963}, {0x00090b134, 24, 185}, {0x00090b21f, 24, 865},
+	{0x00090b686, 24, 3430}, {0x00090b8d0, 24, 14157}, {0x00090b931, 24, 11563}, {0x00090b97d, 24, 14158},
+	{0x00090c115, 24, 2582}, {0x00090c35f, 24, 14159}, {0x00090c682, 24, 11626}, {0x00090c792, 24, 185},
+	{0x00090cc24, 24, 14160}, {0x00090cf15, 24, 5842}, {0x00090cf6f, 24, 14161}, {0x00090cf7d, 24, 14162},
+	{0x00090d11b, 24, 14163}, {0x00090d74f, 24, 14164}, {0x00090d7eb, 24, 4305}, {0x00090d852, 24, 14165},
+	{0x00090d92c, 24, 14166}, {0x00090da4e, 24, 14167}, {0x00090da6a, 24, 14168}, {0x00090db46, 24, 14169},
+	{0x00090dfb7, 24, 14170}, {0x00090e0f0, 24, 14171}, {0x00090e2ba, 24, 4472}, {0x00090e6ba, 24, 4673},
+	{0x00090e7c4, 24, 2176}, {0x00090ea60, 24, 14172}, {0x00090ef68, 24, 4551}, {0x00090f1aa, 24, 8104},
+	{0x00090f1b0, 24, 14173}, {0x00090f278, 24, 14174}, {0x00090f3b7, 24, 14175}, {0x00090f4c1, 24, 14176},
+	{0x00090f652, 24, 8096}, {0x00090f72f, 24, 14177}, {0x00090fb5b, 24, 11282}, {0x00090fba6, 24, 11481},
+	{0x00090fd61, 24, 865}, {0x00090ff79, 24, 14178}, {0x000940070, 24, 5842}, {0x000940149, 24, 14179},
+	{0x0009401c2, 24, 1842}, {0x00094049c, 24, 9262}, {0x0009405b6, 24, 14180}, {0x000940937, 24, 838},
+	{0x000940b2d, 24, 14181}, {0x000940bd5, 24, 14182}, {0x000940c6d, 24, 13604}, {0x00094103e, 24, 6645},
+	{0x0009411da, 24, 14183}, {0x000941673, 24, 14184}, {0x000941d1c, 24, 14185}, {0x000942053, 24, 5842},
+	{0x000942197, 24, 14186}, {0x00094236e, 24, 14187}, {0x000942e17, 24, 14188}, {0x000942e63, 24, 14189},
+	{0x00094319b, 24, 14190}, {0x0009433dd, 24, 14191}, {0x00094350a, 24, 1842}, {0x0009436e0, 24, 14192},
+	{0x0009439e5, 24, 6482}, {0x000943af0, 24, 5842}, {0x000943bb1, 24, 14193}, {0x0009440a2, 24, 14194},
+	{0x000944444, 24, 14195}, {0x000944452, 24, 6645}, {0x000944696, 24, 4531}, {0x000944a09, 24, 14196},
+	{0x000944a0c, 24, 14197}, {0x000945047, 24, 14198}, {0x000945103, 24, 4482}, {0x0009451bf, 24, 14199},
+	{0x000945493, 24, 14200}, {0x00094592d, 24, 14201}, {0x000945b7e, 24, 14202}, {0x000946124, 24, 14203},
+	{0x000946269, 24, 12485}, {0x0009463d1, 24, 1842}, {0x00094659c, 24, 4472}, {0x0009470d2, 24, 14204},
+	{0x0009471ac, 24, 11601}, {0x00094756e, 24, 14205}, {0x00094772b, 24, 9715}, {0x000947c3e, 24, 14206},
+	{0x0009481a4, 24, 14207}, {0x00094857a, 24, 14208}, {0x0009486d4, 24, 14209}, {0x00094877c, 24, 185},
+	{0x000948854, 24, 4305}, {0x000948b03, 24, 14210}, {0x000948d50, 24, 14211}, {0x000948e89, 24, 14212},
+	{0x000948fee, 24, 14213}, {0x000949426, 24, 865}, {0x0009498a2, 24, 14214}, {0x000949bfd, 24, 14215},
+	{0x000949c55, 24, 14216}, {0x000949f3e, 24, 3412}, {0x000949f3f, 24, 14217}, {0x000949fb4, 24, 14218},
+	{0x00094a1a2, 24, 11319}, {0x00094a7b7, 24, 8716}, {0x00094a7bc, 24, 14219}, {0x00094aab8, 24, 14220},
+	{0x00094acca, 24, 14221}, {0x00094ae61, 24, 11600}, {0x00094aee3, 24, 14222}, {0x00094b40f, 24, 2743},
+	{0x00094b8c5, 24, 2585}, {0x00094b9b4, 24, 14223}, {0x00094ba31, 24, 14224}, {0x00094ba56, 24, 13028},
+	{0x00094bf1e, 24, 14225}, {0x00094bf95, 24, 14226}, {0x00094c014, 24, 14227}, {0x00094c038, 24, 14228},
+	{0x00094c150, 24, 13192}, {0x00094c3e4, 24, 14229}, {0x00094c4e9, 24, 14230}, {0x00094c6eb, 24, 14231},
+	{0x00094c7af, 24, 14232}, {0x00094c962, 24, 14233}, {0x00094ca0f, 24, 14234}, {0x00094ccb9, 24, 185},
+	{0x00094cdac, 24, 14235}, {0x00094ce2c, 24, 11230}, {0x00094ce31, 24, 14236}, {0x00094d019, 24, 14237},
+	{0x00094d417, 24, 14238}, {0x00094d60e, 24, 14239}, {0x00094d723, 24, 14240}, {0x00094d771, 24, 1842},
+	{0x00094d93c, 24, 14241}, {0x00094db49, 24, 14242}, {0x00094dbc9, 24, 11518}, {0x00094dd3f, 24, 14243},
+	{0x00094de0e, 24, 14244}, {0x00094de80, 24, 5352}, {0x00094df4e, 24, 12513}, {0x00094df58, 24, 14245},
+	{0x00094e0d0, 24, 14246}, {0x00094e226, 24, 14247}, {0x00094e2fd, 24, 14248}, {0x00094e711, 24, 14249},
+	{0x00094e848, 24, 14250}, {0x00094e96a, 24, 11656}, {0x00094e98c, 24, 5554}, {0x00094eb2c, 24, 6035},
+	{0x00094ebcd, 24, 3686}, {0x00094f19e, 24, 14251}, {0x00094f665, 24, 4614}, {0x00094f692, 24, 14252},
+	{0x00094f6a3, 24, 11309}, {0x00094f720, 24, 14253}, {0x00094fae8, 24, 14254}, {0x00094fbb2, 24, 11920},
+	{0x00094fd1d, 24, 14255}, {0x00094fd2e, 24, 14256}, {0x00094fef4, 24, 9007}, {0x000980284, 24, 14257},
+	{0x0009802d8, 24, 11626}, {0x0009803a0, 24, 14258}, {0x0009803d8, 24, 865}, {0x000980c82, 24, 12901},
+	{0x000980d2e, 24, 2176}, {0x000980ee4, 24, 99}, {0x000981094, 24, 14259}, {0x0009816ec, 24, 14260},
+	{0x000981dfa, 24, 1842}, {0x00098208e, 24, 14261}, {0x00098262a, 24, 14262}, {0x00098291d, 24, 14263},
+	{0x00098293f, 24, 14264}, {0x000982cbe, 24, 7228}, {0x000982d56, 24, 14265}, {0x000982f3c, 24, 4779},
+	{0x000983000, 24, 14266}, {0x000983071, 24, 14267}, {0x00098349d, 24, 14268}, {0x000983571, 24, 14269},
+	{0x0009835b8, 24, 14270}, {0x000983713, 24, 14271}, {0x000983b16, 24, 14272}, {0x000983f9f, 24, 14273},
+	{0x000984246, 24, 14274}, {0x0009843da, 24, 14275}, {0x00098473c, 24, 12290}, {0x000984a47, 24, 14276},
+	{0x000984b4a, 24, 185}, {0x000984be1, 24, 459}, {0x000984c04, 24, 14277}, {0x000984cd3, 24, 14278},
+	{0x000984e97, 24, 14279}, {0x000984fee, 24, 4472}, {0x0009852b1, 24, 4482}, {0x0009857d3, 24, 14280},
+	{0x00098588a, 24, 14281}, {0x000985945, 24, 4305}, {0x000985aeb, 24, 11309}, {0x000985c93, 24, 14282},
+	{0x000985d46, 24, 14283}, {0x000985e1b, 24, 14284}, {0x000986022, 24, 14285}, {0x0009866ea, 24, 14286},
+	{0x000986b3d, 24, 185}, {0x000986cf5, 24, 8716}, {0x000986dc8, 24, 14287}, {0x0009870e8, 24, 14288},
+	{0x0009873c4, 24, 14289}, {0x0009876b6, 24, 14290}, {0x000987770, 24, 14291}, {0x000987bf3, 24, 4305},
+	{0x000987e46, 24, 14292}, {0x000988217, 24, 14293}, {0x000988389, 24, 8104}, {0x0009886b1, 24, 14294},
+	{0x0009889ed, 24, 14295}, {0x000988b5d, 24, 5017}, {0x000988bad, 24, 14296}, {0x000988e34, 24, 14297},
+	{0x000988e4a, 24, 14298}, {0x000988edd, 24, 14299}, {0x000989080, 24, 14300}, {0x000989096, 24, 2822},
+	{0x0009893cc, 24, 14301}, {0x000989449, 24, 14302}, {0x00098a7b0, 24, 14303}, {0x00098aad7, 24, 14304},
+	{0x00098b039, 24, 5554}, {0x00098b8e3, 24, 865}, {0x00098bc57, 24, 14305}, {0x00098bc99, 24, 14306},
+	{0x00098be94, 24, 12325}, {0x00098c0eb, 24, 14307}, {0x00098c845, 24, 14308}, {0x00098cdb4, 24, 14309},
+	{0x00098d331, 24, 14310}, {0x00098d686, 24, 14311}, {0x00098d6bb, 24, 865}, {0x00098d6f7, 24, 6967},
+	{0x00098d88c, 24, 108}, {0x00098da92, 24, 14312}, {0x00098dcd9, 24, 14313}, {0x00098e0d9, 24, 865},
+	{0x00098e165, 24, 14314}, {0x00098e79a, 24, 14315}, {0x00098ec65, 24, 14316}, {0x00098eecb, 24, 14317},
+	{0x00098f0ab, 24, 865}, {0x00098f170, 24, 3430}, {0x00098f537, 24, 8716}, {0x00098f5a9, 24, 14318},
+	{0x00098f8c1, 24, 14319}, {0x00098f8db, 24, 14320}, {0x00098fae3, 24, 11778}, {0x00098fb12, 24, 14321},
+	{0x00098fc11, 24, 4275}, {0x00098fe03, 24, 14322}, {0x00098fe94, 24, 865}, {0x00098ff6a, 24, 14323},
+	{0x00098ffd0, 24, 4485}, {0x0009c0111, 24, 14324}, {0x0009c0298, 24, 1842}, {0x0009c039e, 24, 14325},
+	{0x0009c0473, 24, 14326}, {0x0009c04eb, 24, 865}, {0x0009c066e, 24, 14327}, {0x0009c0dac, 24, 14328},
+	{0x0009c1465, 24, 14329}, {0x0009c1874, 24, 709}, {0x0009c1c12, 24, 2743}, {0x0009c1fdd, 24, 14330},
+	{0x0009c207b, 24, 865}, {0x0009c216a, 24, 11491}, {0x0009c220e, 24, 14331}, {0x0009c2840, 24, 14332},
+	{0x0009c28bf, 24, 14333}, {0x0009c28ef, 24, 9262}, {0x0009c2a70, 24, 6482}, {0x0009c3066, 24, 14334},
+	{0x0009c3178, 24, 14335}, {0x0009c31b6, 24, 14336}, {0x0009c3583, 24, 14337}, {0x0009c35eb, 24, 11309},
+	{0x0009c37f4, 24, 9262}, {0x0009c3aaf, 24, 1842}, {0x0009c3eaa, 24, 14338}, {0x0009c417c, 24, 14339},
+	{0x0009c443d, 24, 14340}, {0x0009c44a6, 24, 14341}, {0x0009c4563, 24, 14342}, {0x0009c4a7b, 24, 5842},
+	{0x0009c4cae, 24, 14343}, {0x0009c4e20, 24, 3}, {0x0009c4e36, 24, 4472}, {0x0009c4e8e, 24, 14344},
+	{0x0009c4ebf, 24, 14345}, {0x0009c53cd, 24, 14346}, {0x0009c541c, 24, 14347}, {0x0009c54ca, 24, 14348},
+	{0x0009c55b4, 24, 14349}, {0x0009c5711, 24, 14350}, {0x0009c5b96, 24, 14351}, {0x0009c5c8d, 24, 14352},
+	{0x0009c5d12, 24, 14353}, {0x0009c5d95, 24, 14354}, {0x0009c5e73, 24, 14355}, {0x0009c611d, 24, 14356},
+	{0x0009c645e, 24, 14357}, {0x0009c65b0, 24, 1842}, {0x0009c65f9, 24, 8729}, {0x0009c6650, 24, 14358},
+	{0x0009c685b, 24, 14359}, {0x0009c6abe, 24, 14360}, {0x0009c6c15, 24, 965}, {0x0009c7514, 24, 14361},
+	{0x0009c77aa, 24, 14362}, {0x0009c79ac, 24, 14363}, {0x0009c7bd2, 24, 14364}, {0x0009c807d, 24, 14365},
+	{0x0009c80df, 24, 4428}, {0x0009c86da, 24, 14366}, {0x0009c8888, 24, 14367}, {0x0009c8bf1, 24, 14368},
+	{0x0009c8d1a, 24, 14369}, {0x0009c8e99, 24, 459}, {0x0009c8edc, 24, 8552}, {0x0009c934e, 24, 14370},
+	{0x0009c93e4, 24, 99}, {0x0009c95f8, 24, 14371}, {0x0009c9726, 24, 12289}, {0x0009c9811, 24, 14372},
+	{0x0009c99a0, 24, 11809}, {0x0009c9c1d, 24, 14373}, {0x0009ca10a, 24, 14374}, {0x0009ca134, 24, 14375},
+	{0x0009ca3ba, 24, 14376}, {0x0009ca577, 24, 14377}, {0x0009ca9e4, 24, 8716}, {0x0009cad97, 24, 6482},
+	{0x0009cadef, 24, 14378}, {0x0009cafca, 24, 3}, {0x0009cb008, 24, 14379}, {0x0009cb206, 24, 14380},
+	{0x0009cb654, 24, 11475}, {0x0009cb6d0, 24, 14381}, {0x0009cb70d, 24, 7813}, {0x0009cb793, 24, 14382},
+	{0x0009cbb98, 24, 14383}, {0x0009cbd9d, 24, 14384}, {0x0009cbee0, 24, 14385}, {0x0009cc077, 24, 14386},
+	{0x0009cc0d2, 24, 14387}, {0x0009cc172, 24, 9715}, {0x0009cc7a6, 24, 980}, {0x0009cc7d1, 24, 6658},
+	{0x0009ccad9, 24, 5842}, {0x0009ccd82, 24, 14388}, {0x0009cd21e, 24, 6482}, {0x0009cd24b, 24, 8716},
+	{0x0009cd35b, 24, 1842}, {0x0009cd36d, 24, 9978}, {0x0009cd643, 24, 11768}, {0x0009cd917, 24, 11721},
+	{0x0009cdf03, 24, 6637}, {0x0009ce10e, 24, 14389}, {0x0009ce1d6, 24, 14390}, {0x0009ce230, 24, 14391},
+	{0x0009ce635, 24, 2314}, {0x0009ce6e7, 24, 1842}, {0x0009ce7bd, 24, 14392}, {0x0009cebe8, 24, 14393},
+	{0x0009cf387, 24, 865}, {0x0009cf61a, 24, 14394}, {0x0009cf67d, 24, 14395}, {0x0009cf8db, 24, 14396},
+	{0x0009cf938, 24, 14397}, {0x0009cfbf1, 24, 14398}, {0x0009cfc01, 24, 11656}, {0x0009cffbe, 24, 14399},
+	{0x000a002dc, 24, 13568}, {0x000a00363, 24, 14400}, {0x000a00627, 24, 14401}, {0x000a00798, 24, 4482},
+	{0x000a007b6, 24, 14402}, {0x000a00abf, 24, 14403}, {0x000a00bba, 24, 12436}, {0x000a00ca1, 24, 14404},
+	{0x000a01290, 24, 11282}, {0x000a012db, 24, 14405}, {0x000a0133b, 24, 14406}, {0x000a013cb, 24, 13853},
+	{0x000a0143d, 24, 14407}, {0x000a0165c, 24, 14408}, {0x000a01828, 24, 11656}, {0x000a01859, 24, 14409},
+	{0x000a01917, 24, 14410}, {0x000a01c05, 24, 14411}, {0x000a01d48, 24, 11475}, {0x000a02195, 24, 14412},
+	{0x000a021b7, 24, 11962}, {0x000a0231b, 24, 14413}, {0x000a02bb8, 24, 11475}, {0x000a02ef3, 24, 14414},
+	{0x000a0369f, 24, 4472}, {0x000a036f0, 24, 14415}, {0x000a036fa, 24, 14416}, {0x000a03a75, 24, 14417},
+	{0x000a03b1b, 24, 14418}, {0x000a04025, 24, 14419}, {0x000a04041, 24, 14420}, {0x000a041a7, 24, 14421},
+	{0x000a0423f, 24, 14422}, {0x000a0481c, 24, 11475}, {0x000a04cc1, 24, 14423}, {0x000a04e04, 24, 5842},
+	{0x000a04fd4, 24, 1957}, {0x000a051c6, 24, 11282}, {0x000a0554f, 24, 1965}, {0x000a055de, 24, 6621},
+	{0x000a056b2, 24, 6637}, {0x000a0593a, 24, 14424}, {0x000a05aa4, 24, 14425}, {0x000a05b21, 24, 14426},
+	{0x000a05dc1, 24, 14427}, {0x000a05de7, 24, 14428}, {0x000a05e6b, 24, 14429}, {0x000a06391, 24, 4809},
+	{0x000a06518, 24, 14430}, {0x000a067be, 24, 14431}, {0x000a06986, 24, 14432}, {0x000a06a00, 24, 7363},
+	{0x000a06cec, 24, 8028}, {0x000a06d09, 24, 14433}, {0x000a06e50, 24, 14434}, {0x000a071a9, 24, 5842},
+	{0x000a07332, 24, 14435}, {0x000a073fc, 24, 14436}, {0x000a07591, 24, 1842}, {0x000a07771, 24, 14437},
+	{0x000a078ba, 24, 14438}, {0x000a0821f, 24, 1842}, {0x000a082c7, 24, 14439}, {0x000a0861d, 24, 14440},
+	{0x000a086c6, 24, 11809}, {0x000a086ec, 24, 14441}, {0x000a08869, 24, 4472}, {0x000a088b4, 24, 4472},
+	{0x000a089e4, 24, 12460}, {0x000a08a87, 24, 14442}, {0x000a08c15, 24, 14443}, {0x000a08c9b, 24, 14444},
+	{0x000a090de, 24, 14445}, {0x000a09347, 24, 14029}, {0x000a09805, 24, 14446}, {0x000a098ed, 24, 14447},
+	{0x000a0999b, 24, 865}, {0x000a09a5a, 24, 14448}, {0x000a09bbd, 24, 14449}, {0x000a0a130, 24, 14450},
+	{0x000a0a23c, 24, 14451}, {0x000a0a3e2, 24, 3726}, {0x000a0a65c, 24, 14452}, {0x000a0a763, 24, 14453},
+	{0x000a0a8cd, 24, 4472}, {0x000a0aafd, 24, 14454}, {0x000a0ada1, 24, 14455}, {0x000a0b100, 24, 14456},
+	{0x000a0b3cc, 24, 11475}, {0x000a0b437, 24, 14457}, {0x000a0b4a5, 24, 11234}, {0x000a0b5da, 24, 14458},
+	{0x000a0b662, 24, 14459}, {0x000a0b9ed, 24, 14460}, {0x000a0bab8, 24, 14461}, {0x000a0bb3e, 24, 11626},
+	{0x000a0bf50, 24, 14462}, {0x000a0bfa5, 24, 14463}, {0x000a0c2de, 24, 14464}, {0x000a0c3de, 24, 14465},
+	{0x000a0c562, 24, 6621}, {0x000a0c6ec, 24, 14466}, {0x000a0cec8, 24, 14467}, {0x000a0cf5b, 24, 3},
+	{0x000a0d12a, 24, 14468}, {0x000a0d3c1, 24, 11475}, {0x000a0da92, 24, 14469}, {0x000a0dc04, 24, 14470},
+	{0x000a0dd97, 24, 14471}, {0x000a0dde5, 24, 6658}, {0x000a0de05, 24, 14472}, {0x000a0e201, 24, 14473},
+	{0x000a0e25a, 24, 14474}, {0x000a0e295, 24, 14475}, {0x000a0e453, 24, 11230}, {0x000a0e4cb, 24, 4551},
+	{0x000a0e534, 24, 14476}, {0x000a0e5e9, 24, 14477}, {0x000a0e6f8, 24, 4445}, {0x000a0e9db, 24, 14478},
+	{0x000a0eb76, 24, 14479}, {0x000a0ec80, 24, 8716}, {0x000a0ecf9, 24, 1965}, {0x000a0edcd, 24, 865},
+	{0x000a0ef84, 24, 14480}, {0x000a0f217, 24, 14481}, {0x000a0f3c1, 24, 8096}, {0x000a0f3e4, 24, 11594},
+	{0x000a0f419, 24, 5842}, {0x000a0f450, 24, 2176}, {0x000a0f459, 24, 12972}, {0x000a0f6fd, 24, 4305},
+	{0x000a0f849, 24, 1965}, {0x000a0fc6e, 24, 14482}, {0x000a0fe91, 24, 14483}, {0x000a40130, 24, 14484},
+	{0x000a4059e, 24, 14485}, {0x000a408ea, 24, 3430}, {0x000a409cb, 24, 14486}, {0x000a40bed, 24, 14487},
+	{0x000a40cc3, 24, 3}, {0x000a41242, 24, 3100}, {0x000a4134e, 24, 14488}, {0x000a41566, 24, 14489},
+	{0x000a41731, 24, 6482}, {0x000a41875, 24, 3}, {0x000a41bc0, 24, 14490}, {0x000a41f72, 24, 5963},
+	{0x000a4218a, 24, 108}, {0x000a42305, 24, 14491}, {0x000a424b3, 24, 14492}, {0x000a424dd, 24, 14493},
+	{0x000a4251b, 24, 11282}, {0x000a42940, 24, 14494}, {0x000a429b7, 24, 14495}, {0x000a42b8c, 24, 13815},
+	{0x000a42c08, 24, 14496}, {0x000a43135, 24, 11656}, {0x000a433d1, 24, 14497}, {0x000a434d9, 24, 4472},
+	{0x000a438fc, 24, 14498}, {0x000a43a69, 24, 14499}, {0x000a43bfa, 24, 11626}, {0x000a43d78, 24, 14500},
+	{0x000a4466b, 24, 14501}, {0x000a446fa, 24, 14502}, {0x000a44ad3, 24, 14503}, {0x000a44b15, 24, 14504},
+	{0x000a44c11, 24, 3}, {0x000a44e2d, 24, 14505}, {0x000a44e31, 24, 4472}, {0x000a44f29, 24, 11626},
+	{0x000a45055, 24, 14506}, {0x000a4526f, 24, 1957}, {0x000a45602, 24, 14507}, {0x000a4561b, 24, 14508},
+	{0x000a45630, 24, 3}, {0x000a45a1c, 24, 14509}, {0x000a45c27, 24, 2314}, {0x000a45d36, 24, 11475},
+	{0x000a45da1, 24, 1957}, {0x000a45e60, 24, 865}, {0x000a46032, 24, 3736}, {0x000a46706, 24, 865},
+	{0x000a46c2a, 24, 1965}, {0x000a46cc1, 24, 14510}, {0x000a46e79, 24, 14511}, {0x000a470d6, 24, 11721},
+	{0x000a47733, 24, 12494}, {0x000a47760, 24, 5842}, {0x000a479e4, 24, 14512}, {0x000a47aa4, 24, 185},
+	{0x000a47acf, 24, 14513}, {0x000a47b2c, 24, 5554}, {0x000a47b85, 24, 14514}, {0x000a47c14, 24, 14515},
+	{0x000a47c1f, 24, 14516}, {0x000a47e39, 24, 8716}, {0x000a481ee, 24, 5842}, {0x000a4856b, 24, 14517},
+	{0x000a4895b, 24, 14518}, {0x000a48cdb, 24, 14519}, {0x000a48d3b, 24, 14520}, {0x000a49005, 24, 14521},
+	{0x000a4934c, 24, 3}, {0x000a497bb, 24, 14522}, {0x000a49947, 24, 9715}, {0x000a49981, 24, 14523},
+	{0x000a49a58, 24, 1842}, {0x000a49b13, 24, 14524}, {0x000a49d49, 24, 14525}, {0x000a49edb, 24, 14526},
+	{0x000a49f85, 24, 14527}, {0x000a49f89, 24, 14528}, {0x000a4a1c2, 24, 14529}, {0x000a4a1e4, 24, 14530},
+	{0x000a4a24a, 24, 6959}, {0x000a4a4d3, 24, 14531}, {0x000a4a80f, 24, 13028}, {0x000a4ad00, 24, 14532},
+	{0x000a4adb8, 24, 14533}, {0x000a4ae9a, 24, 14534}, {0x000a4b121, 24, 14535}, {0x000a4b197, 24, 865},
+	{0x000a4b1e9, 24, 12289}, {0x000a4b1ee, 24, 14536}, {0x000a4b2a7, 24, 14537}, {0x000a4b36a, 24, 14538},
+	{0x000a4b818, 24, 14539}, {0x000a4b980, 24, 14540}, {0x000a4badb, 24, 5963}, {0x000a4bbaf, 24, 14541},
+	{0x000a4be61, 24, 14542}, {0x000a4c0c7, 24, 14543}, {0x000a4c0e1, 24, 2314}, {0x000a4c2ab, 24, 14544},
+	{0x000a4c361, 24, 865}, {0x000a4c494, 24, 4472}, {0x000a4c7de, 24, 5973}, {0x000a4d094, 24, 14545},
+	{0x000a4d18f, 24, 14546}, {0x000a4d1d1, 24, 14547}, {0x000a4d1d2, 24, 865}, {0x000a4d3b5, 24, 14548},
+	{0x000a4d856, 24, 14549}, {0x000a4da3f, 24, 14550}, {0x000a4db2e, 24, 14551}, {0x000a4db30, 24, 7813},
+	{0x000a4de50, 24, 14552}, {0x000a4e0e6, 24, 14553}, {0x000a4e32e, 24, 14554}, {0x000a4e391, 24, 14555},
+	{0x000a4e4b8, 24, 14556}, {0x000a4e731, 24, 5842}, {0x000a4e7e4, 24, 14557}, {0x000a4e991, 24, 14558},
+	{0x000a4e9a3, 24, 14559}, {0x000a4ebd3, 24, 1842}, {0x000a4ed4e, 24, 185}, {0x000a4ee57, 24, 63},
+	{0x000a4ef52, 24, 14560}, {0x000a4f3c1, 24, 14561}, {0x000a4f522, 24, 14562}, {0x000a4f7d0, 24, 14563},
+	{0x000a4fb8d, 24, 14564}, {0x000a4fcce, 24, 14565}, {0x000a80180, 24, 14566}, {0x000a80600, 24, 1842},
+	{0x000a80c0d, 24, 1965}, {0x000a81374, 24, 11997}, {0x000a8154d, 24, 11491}, {0x000a816b2, 24, 6967},
+	{0x000a81758, 24, 14567}, {0x000a81b18, 24, 14568}, {0x000a81b5a, 24, 14029}, {0x000a81b5d, 24, 14569},
+	{0x000a81d16, 24, 8497}, {0x000a81faf, 24, 14570}, {0x000a82066, 24, 865}, {0x000a824eb, 24, 14571},
+	{0x000a826d9, 24, 2176}, {0x000a8294c, 24, 14572}, {0x000a82bd6, 24, 14573}, {0x000a830ad, 24, 14489},
+	{0x000a8329a, 24, 14574}, {0x000a83944, 24, 3726}, {0x000a84041, 24, 14575}, {0x000a84481, 24, 5842},
+	{0x000a845e9, 24, 14576}, {0x000a849a5, 24, 14577}, {0x000a854b2, 24, 2718}, {0x000a8556a, 24, 14578},
+	{0x000a8574e, 24, 11491}, {0x000a85b78, 24, 865}, {0x000a85bb0, 24, 14579}, {0x000a85bf3, 24, 14580},
+	{0x000a861aa, 24, 14581}, {0x000a862a2, 24, 14582}, {0x000a863df, 24, 14583}, {0x000a863f2, 24, 4305},
+	{0x000a86405, 24, 14584}, {0x000a865b2, 24, 14585}, {0x000a8667f, 24, 11309}, {0x000a86a6f, 24, 8028},
+	{0x000a870a5, 24, 14586}, {0x000a8741d, 24, 14587}, {0x000a875d6, 24, 14588}, {0x000a875e2, 24, 14589},
+	{0x000a8776f, 24, 14590}, {0x000a87b39, 24, 5842}, {0x000a87c01, 24, 11234}, {0x000a87e33, 24, 709},
+	{0x000a88038, 24, 14591}, {0x000a881f1, 24, 14592}, {0x000a8827f, 24, 14593}, {0x000a886dd, 24, 11309},
+	{0x000a88792, 24, 14594}, {0x000a887ed, 24, 14595}, {0x000a88808, 24, 865}, {0x000a88cee, 24, 14596},
+	{0x000a88d7b, 24, 14597}, {0x000a88e24, 24, 865}, {0x000a89008, 24, 14598}, {0x000a8922c, 24, 6967},
+	{0x000a893e6, 24, 14599}, {0x000a895b0, 24, 14600}, {0x000a8968a, 24, 865}, {0x000a897dc, 24, 12325},
+	{0x000a898c6, 24, 14601}, {0x000a8995c, 24, 14602}, {0x000a89b10, 24, 14603}, {0x000a89d21, 24, 1965},
+	{0x000a89dd2, 24, 14240}, {0x000a89fba, 24, 1842}, {0x000a8a668, 24, 8716}, {0x000a8ad3d, 24, 11806},
+	{0x000a8b0ae, 24, 14604}, {0x000a8b1d4, 24, 3}, {0x000a8b9b3, 24, 14605}, {0x000a8bbcf, 24, 865},
+	{0x000a8bd1a, 24, 14606}, {0x000a8bd3a, 24, 6003}, {0x000a8c222, 24, 14607}, {0x000a8cb95, 24, 14608},
+	{0x000a8ccc5, 24, 14609}, {0x000a8ce90, 24, 14610}, {0x000a8d0e3, 24, 14611}, {0x000a8d0e5, 24, 5553},
+	{0x000a8d236, 24, 14612}, {0x000a8d3c8, 24, 14613}, {0x000a8d409, 24, 14614}, {0x000a8d828, 24, 14615},
+	{0x000a8d88a, 24, 14616}, {0x000a8e018, 24, 5842}, {0x000a8e3ee, 24, 7216}, {0x000a8e539, 24, 14617},
+	{0x000a8ef26, 24, 14618}, {0x000a8f038, 24, 14619}, {0x000a8f274, 24, 4482}, {0x000a8f470, 24, 14620},
+	{0x000a8f7e0, 24, 8837}, {0x000a8f94b, 24, 14621}, {0x000a8fad8, 24, 865}, {0x000a8fb70, 24, 14622},
+	{0x000a8fcb7, 24, 14623}, {0x000aa0000, 24, 235}, {0x000aa0001, 24, 235}, {0x000aa0002, 24, 235},
+	{0x000aa0003, 24, 235}, {0x000aa0004, 24, 235}, {0x000ac0142, 24, 14624}, {0x000ac02ca, 24, 14625},
+	{0x000ac02cf, 24, 14626}, {0x000ac02ef, 24, 14627}, {0x000ac0613, 24, 14628}, {0x000ac0a61, 24, 14629},
+	{0x000ac0dfe, 24, 14630}, {0x000ac11d3, 24, 14631}, {0x000ac1461, 24, 14632}, {0x000ac14d2, 24, 14633},
+	{0x000ac162d, 24, 11475}, {0x000ac1702, 24, 14634}, {0x000ac1826, 24, 63}, {0x000ac199f, 24, 14635},
+	{0x000ac20aa, 24, 14636}, {0x000ac220b, 24, 4673}, {0x000ac293a, 24, 11656}, {0x000ac2da3, 24, 14637},
+	{0x000ac2fa8, 24, 14638}, {0x000ac319d, 24, 14639}, {0x000ac34cb, 24, 14640}, {0x000ac3613, 24, 1842},
+	{0x000ac3870, 24, 4485}, {0x000ac3a7a, 24, 14641}, {0x000ac3c0b, 24, 865}, {0x000ac3cb4, 24, 14642},
+	{0x000ac3d05, 24, 14643}, {0x000ac3d75, 24, 14644}, {0x000ac3fa4, 24, 14645}, {0x000ac40ea, 24, 14646},
+	{0x000ac4122, 24, 14647}, {0x000ac44f2, 24, 14648}, {0x000ac4723, 24, 14649}, {0x000ac4afe, 24, 14650},
+	{0x000ac4bc8, 24, 5553}, {0x000ac4e91, 24, 9262}, {0x000ac4ffc, 24, 14651}, {0x000ac5036, 24, 14652},
+	{0x000ac5135, 24, 14653}, {0x000ac51ee, 24, 14654}, {0x000ac54ec, 24, 14655}, {0x000ac562c, 24, 14656},
+	{0x000ac583b, 24, 14657}, {0x000ac5a14, 24, 1842}, {0x000ac5d10, 24, 14658}, {0x000ac5e8c, 24, 14659},
+	{0x000ac6123, 24, 14660}, {0x000ac620d, 24, 14661}, {0x000ac6706, 24, 4614}, {0x000ac676f, 24, 14662},
+	{0x000ac6bac, 24, 14663}, {0x000ac6e1a, 24, 11920}, {0x000ac6f4f, 24, 14664}, {0x000ac6fbb, 24, 14665},
+	{0x000ac6fd9, 24, 14666}, {0x000ac7236, 24, 14667}, {0x000ac7289, 24, 4472}, {0x000ac7a42, 24, 14668},
+	{0x000ac7a4d, 24, 12443}, {0x000ac7ba1, 24, 4472}, {0x000ac7f3e, 24, 865}, {0x000ac80d6, 24, 14669},
+	{0x000ac8112, 24, 2348}, {0x000ac81f3, 24, 5842}, {0x000ac8317, 24, 14670}, {0x000ac83f0, 24, 14671},
+	{0x000ac853d, 24, 9262}, {0x000ac8674, 24, 14672}, {0x000ac867e, 24, 14673}, {0x000ac87a3, 24, 865},
+	{0x000ac8acd, 24, 14674}, {0x000ac8d14, 24, 14675}, {0x000ac932f, 24, 5842}, {0x000ac9403, 24, 14676},
+	{0x000ac9a96, 24, 14677}, {0x000ac9b0a, 24, 311}, {0x000ac9b84, 24, 14678}, {0x000ac9ce4, 24, 11806},
+	{0x000ac9e17, 24, 4673}, {0x000aca016, 24, 3}, {0x000aca213, 24, 12013}, {0x000aca22c, 24, 14679},
+	{0x000aca31e, 24, 2743}, {0x000aca430, 24, 14680}, {0x000aca919, 24, 14681}, {0x000aca9a0, 24, 14682},
+	{0x000acab8d, 24, 14683}, {0x000acabbf, 24, 14684}, {0x000acb313, 24, 185}, {0x000acb57d, 24, 7813},
+	{0x000acb74f, 24, 7980}, {0x000acb859, 24, 14685}, {0x000acbd0b, 24, 14686}, {0x000acbe75, 24, 14687},
+	{0x000acbeb6, 24, 14688}, {0x000acc2ec, 24, 14689}, {0x000acc595, 24, 14690}, {0x000acc698, 24, 14691},
+	{0x000acc73f, 24, 14692}, {0x000acc935, 24, 14693}, {0x000acca54, 24, 14694}, {0x000acca8e, 24, 14695},
+	{0x000accaab, 24, 14696}, {0x000accaba, 24, 14697}, {0x000accb09, 24, 14698}, {0x000accc8e, 24, 14699},
+	{0x000acce8f, 24, 14700}, {0x000accf23, 24, 14701}, {0x000accf5c, 24, 865}, {0x000acd074, 24, 11862},
+	{0x000acd180, 24, 14702}, {0x000acd1b8, 24, 6482}, {0x000acd364, 24, 14703}, {0x000acd657, 24, 14704},
+	{0x000acd9d6, 24, 14705}, {0x000acdbda, 24, 14706}, {0x000acde48, 24, 99}, {0x000ace010, 24, 7813},
+	{0x000ace069, 24, 14707}, {0x000ace215, 24, 9715}, {0x000ace348, 24, 14708}, {0x000ace42e, 24, 14709},
+	{0x000ace64b, 24, 14710}, {0x000ace87b, 24, 9715}, {0x000ace87e, 24, 14711}, {0x000ace97f, 24, 14712},
+	{0x000ace9aa, 24, 14713}, {0x000acea6a, 24, 14714}, {0x000acee3b, 24, 14715}, {0x000acf0b2, 24, 14716},
+	{0x000acf1df, 24, 11768}, {0x000acf2c5, 24, 1965}, {0x000acf7f3, 24, 14717}, {0x000acf97e, 24, 14718},
+	{0x000acfd93, 24, 14719}, {0x000acfdce, 24, 4472}, {0x000acfdec, 24, 11563}, {0x000b000b4, 24, 1965},
+	{0x000b00594, 24, 7813}, {0x000b008bf, 24, 14720}, {0x000b009d3, 24, 14721}, {0x000b01041, 24, 6482},
+	{0x000b01203, 24, 14722}, {0x000b01266, 24, 14723}, {0x000b01408, 24, 14724}, {0x000b01743, 24, 14725},
+	{0x000b01b7c, 24, 14726}, {0x000b01c91, 24, 14727}, {0x000b01f81, 24, 11626}, {0x000b024f3, 24, 14728},
+	{0x000b025aa, 24, 99}, {0x000b03495, 24, 865}, {0x000b0358d, 24, 5842}, {0x000b03829, 24, 14729},
+	{0x000b03850, 24, 14730}, {0x000b0411d, 24, 14731}, {0x000b0435d, 24, 14732}, {0x000b04515, 24, 14733},
+	{0x000b04519, 24, 12532}, {0x000b04545, 24, 14734}, {0x000b046fc, 24, 14735}, {0x000b0487a, 24, 8096},
+	{0x000b0495f, 24, 14736}, {0x000b04c05, 24, 14737}, {0x000b050bc, 24, 14738}, {0x000b0518e, 24, 14739},
+	{0x000b05706, 24, 14740}, {0x000b058c4, 24, 14741}, {0x000b05b1f, 24, 14742}, {0x000b05b67, 24, 9715},
+	{0x000b05ce5, 24, 5842}, {0x000b061c7, 24, 14743}, {0x000b06563, 24, 14744}, {0x000b065bd, 24, 865},
+	{0x000b068b6, 24, 14745}, {0x000b06971, 24, 14746}, {0x000b06cbf, 24, 14747}, {0x000b0750c, 24, 14748},
+	{0x000b0754d, 24, 5554}, {0x000b075d5, 24, 5972}, {0x000b077ac, 24, 185}, {0x000b07908, 24, 14749},
+	{0x000b0793c, 24, 14750}, {0x000b07994, 24, 11721}, {0x000b07d47, 24, 9155}, {0x000b07d62, 24, 14751},
+	{0x000b0808c, 24, 14752}, {0x000b081d8, 24, 14753}, {0x000b083fe, 24, 2822}, {0x000b0869e, 24, 14754},
+	{0x000b08807, 24, 14755}, {0x000b08991, 24, 13069}, {0x000b08e1a, 24, 14756}, {0x000b09074, 24, 14757},
+	{0x000b09134, 24, 14758}, {0x000b09137, 24, 14759}, {0x000b0966c, 24, 14760}, {0x000b0973a, 24, 14761},
+	{0x000b0989f, 24, 14762}, {0x000b09928, 24, 7968}, {0x000b09ae2, 24, 14763}, {0x000b09bd4, 24, 14764},
+	{0x000b09fba, 24, 865}, {0x000b0a10a, 24, 14765}, {0x000b0a37e, 24, 14766}, {0x000b0a72a, 24, 14767},
+	{0x000b0a737, 24, 14768}, {0x000b0a86e, 24, 5553}, {0x000b0aa36, 24, 14029}, {0x000b0aa77, 24, 1965},
+	{0x000b0acfa, 24, 7968}, {0x000b0adaa, 24, 11282}, {0x000b0b2dc, 24, 4551}, {0x000b0b32b, 24, 14769},
+	{0x000b0b448, 24, 4305}, {0x000b0b8d5, 24, 14770}, {0x000b0bd6d, 24, 14771}, {0x000b0bda1, 24, 14772},
+	{0x000b0bf99, 24, 14773}, {0x000b0c287, 24, 11512}, {0x000b0c4e7, 24, 4482}, {0x000b0c554, 24, 11768},
+	{0x000b0c559, 24, 1842}, {0x000b0c69a, 24, 5553}, {0x000b0c745, 24, 1741}, {0x000b0c83f, 24, 14774},
+	{0x000b0c8ad, 24, 14775}, {0x000b0c95b, 24, 14776}, {0x000b0ce18, 24, 14777}, {0x000b0cf4d, 24, 14778},
+	{0x000b0d09c, 24, 1842}, {0x000b0d2f5, 24, 14779}, {0x000b0d59d, 24, 13516}, {0x000b0d7c5, 24, 14780},
+	{0x000b0da00, 24, 14781}, {0x000b0df3a, 24, 1842}, {0x000b0e03c, 24, 12532}, {0x000b0e2e5, 24, 11300},
+	{0x000b0e39d, 24, 14782}, {0x000b0e50e, 24, 14783}, {0x000b0e754, 24, 7228}, {0x000b0e892, 24, 63},
+	{0x000b0e97e, 24, 14784}, {0x000b0ec71, 24, 1842}, {0x000b0ec8f, 24, 14785}, {0x000b0ece1, 24, 99},
+	{0x000b0ee45, 24, 5090}, {0x000b0f1bc, 24, 14786}, {0x000b0faeb, 24, 1965}, {0x000b0febd, 24, 99},
+	{0x000b4009c, 24, 14787}, {0x000b40142, 24, 14788}, {0x000b40418, 24, 14789}, {0x000b40566, 24, 14790},
+	{0x000b407f9, 24, 12436}, {0x000b40832, 24, 14791}, {0x000b40ac6, 24, 14792}, {0x000b40b44, 24, 14793},
+	{0x000b40b7a, 24, 14794}, {0x000b40c25, 24, 6256}, {0x000b40e96, 24, 14795}, {0x000b40edc, 24, 14796},
+	{0x000b41489, 24, 3}, {0x000b41513, 24, 9262}, {0x000b41780, 24, 14797}, {0x000b418d1, 24, 865},
+	{0x000b41def, 24, 14798}, {0x000b4211d, 24, 14799}, {0x000b4218a, 24, 14800}, {0x000b424e7, 24, 14801},
+	{0x000b428f1, 24, 14802}, {0x000b42a39, 24, 14803}, {0x000b42c92, 24, 14804}, {0x000b42cbe, 24, 14805},
+	{0x000b43052, 24, 9715}, {0x000b431b8, 24, 14806}, {0x000b4346c, 24, 14807}, {0x000b43564, 24, 14808},
+	{0x000b435f7, 24, 14809}, {0x000b43741, 24, 14810}, {0x000b437d1, 24, 11626}, {0x000b43934, 24, 14811},
+	{0x000b439d6, 24, 6289}, {0x000b43a28, 24, 1842}, {0x000b43db2, 24, 14812}, {0x000b43e3b, 24, 14813},
+	{0x000b4417a, 24, 7213}, {0x000b4430d, 24, 14814}, {0x000b4475e, 24, 11282}, {0x000b44cc2, 24, 14815},
+	{0x000b451f9, 24, 14816}, {0x000b45253, 24, 1157}, {0x000b4527d, 24, 11230}, {0x000b4527e, 24, 11230},
+	{0x000b45570, 24, 14817}, {0x000b45861, 24, 14818}, {0x000b45ca4, 24, 14819}, {0x000b461ff, 24, 14820},
+	{0x000b46238, 24, 14821}, {0x000b46293, 24, 1842}, {0x000b462ad, 24, 14822}, {0x000b46698, 24, 14823},
+	{0x000b467e9, 24, 14824}, {0x000b46d35, 24, 14825}, {0x000b46d83, 24, 4472}, {0x000b47356, 24, 14826},
+	{0x000b4749f, 24, 14827}, {0x000b4750e, 24, 6645}, {0x000b479a7, 24, 12950}, {0x000b47c29, 24, 14828},
+	{0x000b47f5e, 24, 14829}, {0x000b48255, 24, 14830}, {0x000b4827b, 24, 14831}, {0x000b482c5, 24, 14832},
+	{0x000b482fe, 24, 12086}, {0x000b48547, 24, 14833}, {0x000b48910, 24, 14834}, {0x000b4944e, 24, 14835},
+	{0x000b49842, 24, 8716}, {0x000b4994c, 24, 4305}, {0x000b499ba, 24, 459}, {0x000b49db4, 24, 14836},
+	{0x000b49eac, 24, 14837}, {0x000b49ee6, 24, 14838}, {0x000b4a4b5, 24, 14839}, {0x000b4a4e3, 24, 3},
+	{0x000b4a5a9, 24, 14840}, {0x000b4a828, 24, 14841}, {0x000b4a82b, 24, 14842}, {0x000b4a95a, 24, 11282},
+	{0x000b4a9fe, 24, 14843}, {0x000b4aa4d, 24, 14844}, {0x000b4ab2c, 24, 14845}, {0x000b4ae2b, 24, 12272},
+	{0x000b4ae6f, 24, 14846}, {0x000b4b017, 24, 11282}, {0x000b4b265, 24, 14847}, {0x000b4b362, 24, 5972},
+	{0x000b4b52f, 24, 11475}, {0x000b4b542, 24, 14848}, {0x000b4b5af, 24, 14849}, {0x000b4b676, 24, 4472},
+	{0x000b4b859, 24, 14850}, {0x000b4b88d, 24, 14851}, {0x000b4c44e, 24, 14852}, {0x000b4c799, 24, 1217},
+	{0x000b4c810, 24, 14853}, {0x000b4cce9, 24, 14854}, {0x000b4cef6, 24, 2176}, {0x000b4cfdb, 24, 14855},
+	{0x000b4d8a9, 24, 14856}, {0x000b4d8de, 24, 14857}, {0x000b4dd15, 24, 14858}, {0x000b4df3b, 24, 14859},
+	{0x000b4dffa, 24, 14860}, {0x000b4e0cd, 24, 14861}, {0x000b4e1eb, 24, 99}, {0x000b4e9b0, 24, 1965},
+	{0x000b4ed19, 24, 14862}, {0x000b4ed54, 24, 14863}, {0x000b4eeb4, 24, 7605}, {0x000b4eed4, 24, 4305},
+	{0x000b4ef39, 24, 1842}, {0x000b4f0ab, 24, 865}, {0x000b4f2e8, 24, 6621}, {0x000b4f323, 24, 14864},
+	{0x000b4fc75, 24, 14865}, {0x000b4fe8c, 24, 14866}, {0x000b80305, 24, 4472}, {0x000b80415, 24, 14867},
+	{0x000b808cf, 24, 4472}, {0x000b8098a, 24, 865}, {0x000b80b9d, 24, 14868}, {0x000b81413, 24, 14869},
+	{0x000b81619, 24, 185}, {0x000b817c2, 24, 865}, {0x000b8186f, 24, 14870}, {0x000b81999, 24, 14871},
+	{0x000b820e7, 24, 14872}, {0x000b82410, 24, 14873}, {0x000b8241a, 24, 14874}, {0x000b8266c, 24, 14875},
+	{0x000b826d4, 24, 14876}, {0x000b827eb, 24, 14877}, {0x000b8288b, 24, 14878}, {0x000b829f7, 24, 14879},
+	{0x000b82a72, 24, 2822}, {0x000b82adc, 24, 14880}, {0x000b82ca0, 24, 14881}, {0x000b830a8, 24, 14882},
+	{0x000b836d8, 24, 14883}, {0x000b83861, 24, 1965}, {0x000b838ca, 24, 14884}, {0x000b83a7b, 24, 14885},
+	{0x000b83d4e, 24, 14886}, {0x000b83e59, 24, 14887}, {0x000b8415f, 24, 14888}, {0x000b843e4, 24, 14889},
+	{0x000b847c6, 24, 14890}, {0x000b84fd5, 24, 965}, {0x000b85510, 24, 14891}, {0x000b856bd, 24, 14892},
+	{0x000b85810, 24, 14893}, {0x000b85a73, 24, 1842}, {0x000b85af7, 24, 14894}, {0x000b85afe, 24, 14895},
+	{0x000b85e7b, 24, 1842}, {0x000b86091, 24, 14896}, {0x000b8616f, 24, 14897}, {0x000b8621f, 24, 3},
+	{0x000b863bc, 24, 14898}, {0x000b86491, 24, 14899}, {0x000b8653b, 24, 14900}, {0x000b869c2, 24, 6848},
+	{0x000b86b23, 24, 1934}, {0x000b86ce8, 24, 1842}, {0x000b870f4, 24, 6282}, {0x000b87424, 24, 14901},
+	{0x000b87447, 24, 14902}, {0x000b875c0, 24, 14903}, {0x000b8763f, 24, 6482}, {0x000b877c3, 24, 14904},
+	{0x000b8782e, 24, 865}, {0x000b87879, 24, 3375}, {0x000b8797e, 24, 14905}, {0x000b87ac9, 24, 14906},
+	{0x000b87cf2, 24, 12555}, {0x000b8871e, 24, 14907}, {0x000b887a8, 24, 14908}, {0x000b888e3, 24, 11980},
+	{0x000b88981, 24, 14909}, {0x000b889ca, 24, 14910}, {0x000b88a60, 24, 4472}, {0x000b88d12, 24, 865},
+	{0x000b88e3a, 24, 14911}, {0x000b88ec6, 24, 14912}, {0x000b88f14, 24, 14913}, {0x000b8921d, 24, 14914},
+	{0x000b894d2, 24, 14915}, {0x000b89674, 24, 14916}, {0x000b8975a, 24, 14917}, {0x000b898b0, 24, 14918},
+	{0x000b898f7, 24, 14919}, {0x000b89919, 24, 14920}, {0x000b899b0, 24, 14921}, {0x000b89acd, 24, 14922},
+	{0x000b89aed, 24, 14923}, {0x000b89bc9, 24, 13677}, {0x000b89be4, 24, 14924}, {0x000b8a386, 24, 11768},
+	{0x000b8a3e0, 24, 14925}, {0x000b8a8af, 24, 14926}, {0x000b8ac6f, 24, 2822}, {0x000b8ad3e, 24, 14927},
+	{0x000b8ae6e, 24, 2314}, {0x000b8aeed, 24, 5356}, {0x000b8af67, 24, 459}, {0x000b8b1c7, 24, 14928},
+	{0x000b8b3dc, 24, 14929}, {0x000b8b42e, 24, 14919}, {0x000b8b7d7, 24, 14930}, {0x000b8b81e, 24, 4472},
+	{0x000b8b94e, 24, 14931}, {0x000b8ba68, 24, 14932}, {0x000b8ba72, 24, 14933}, {0x000b8bb6d, 24, 14934},
+	{0x000b8bc1b, 24, 9715}, {0x000b8bd79, 24, 14935}, {0x000b8bebf, 24, 3}, {0x000b8bf83, 24, 4472},
+	{0x000b8c1a2, 24, 14936}, {0x000b8c3bf, 24, 14937}, {0x000b8c46f, 24, 14938}, {0x000b8c68e, 24, 1842},
+	{0x000b8c716, 24, 13853}, {0x000b8c75d, 24, 865}, {0x000b8c855, 24, 14939}, {0x000b8ca3a, 24, 2822},
+	{0x000b8cd93, 24, 14940}, {0x000b8cda7, 24, 14941}, {0x000b8d06f, 24, 14942}, {0x000b8d49d, 24, 14943},
+	{0x000b8d812, 24, 11626}, {0x000b8d9ce, 24, 4482}, {0x000b8daf1, 24, 14944}, {0x000b8daf7, 24, 14945},
+	{0x000b8dc87, 24, 14946}, {0x000b8df6b, 24, 14947}, {0x000b8e589, 24, 14948}, {0x000b8e625, 24, 7228},
+	{0x000b8e779, 24, 14949}, {0x000b8e856, 24, 865}, {0x000b8e937, 24, 3412}, {0x000b8ee65, 24, 7813},
+	{0x000b8ee79, 24, 14950}, {0x000b8f080, 24, 14951}, {0x000b8f317, 24, 14952}, {0x000b8f4d0, 24, 14953},
+	{0x000b8f5e7, 24, 14954}, {0x000b8f6b1, 24, 865}, {0x000b8f732, 24, 14955}, {0x000b8f828, 24, 14956},
+	{0x000b8f934, 24, 2582}, {0x000b8fd32, 24, 14957}, {0x000b8ff61, 24, 865}, {0x000b8ff6f, 24, 14958},
+	{0x000b8fffe, 24, 4305}, {0x000bc0200, 24, 14959}, {0x000bc0543, 24, 980}, {0x000bc0da5, 24, 4305},
+	{0x000bc0f2b, 24, 14960}, {0x000bc0f64, 24, 4472}, {0x000bc125e, 24, 14961}, {0x000bc1401, 24, 8599},
+	{0x000bc1485, 24, 1842}, {0x000bc14ef, 24, 14962}, {0x000bc15a6, 24, 14963}, {0x000bc1665, 24, 1965},
+	{0x000bc16f5, 24, 1965}, {0x000bc1a67, 24, 14964}, {0x000bc20a4, 24, 4482}, {0x000bc20ba, 24, 14965},
+	{0x000bc25e0, 24, 9715}, {0x000bc25f0, 24, 14966}, {0x000bc261d, 24, 14967}, {0x000bc2846, 24, 14968},
+	{0x000bc28d6, 24, 14969}, {0x000bc2b6b, 24, 14970}, {0x000bc2bd7, 24, 14971}, {0x000bc2c55, 24, 14972},
+	{0x000bc2d98, 24, 14973}, {0x000bc305b, 24, 5963}, {0x000bc307d, 24, 2718}, {0x000bc3400, 24, 11626},
+	{0x000bc35e5, 24, 14974}, {0x000bc38d2, 24, 14975}, {0x000bc39a6, 24, 14976}, {0x000bc3aea, 24, 14029},
+	{0x000bc3baf, 24, 865}, {0x000bc3e13, 24, 14977}, {0x000bc4100, 24, 14978}, {0x000bc4377, 24, 14979},
+	{0x000bc4486, 24, 1842}, {0x000bc4760, 24, 1842}, {0x000bc4b79, 24, 14980}, {0x000bc4cc4, 24, 865},
+	{0x000bc4dfb, 24, 8599}, {0x000bc4e3c, 24, 14981}, {0x000bc4e5d, 24, 14982}, {0x000bc51fe, 24, 14983},
+	{0x000bc52b4, 24, 5554}, {0x000bc52b7, 24, 865}, {0x000bc54f9, 24, 14984}, {0x000bc5c4c, 24, 14985},
+	{0x000bc5ff4, 24, 8355}, {0x000bc5ff6, 24, 13407}, {0x000bc6010, 24, 12081}, {0x000bc629f, 24, 14986},
+	{0x000bc6641, 24, 11626}, {0x000bc671c, 24, 1965}, {0x000bc6778, 24, 865}, {0x000bc6784, 24, 14987},
+	{0x000bc6a16, 24, 14988}, {0x000bc6a29, 24, 4305}, {0x000bc6a2f, 24, 14989}, {0x000bc6b4d, 24, 5554},
+	{0x000bc6e64, 24, 11230}, {0x000bc6e76, 24, 14990}, {0x000bc71c1, 24, 14991}, {0x000bc72b1, 24, 1842},
+	{0x000bc74d7, 24, 14992}, {0x000bc764e, 24, 14993}, {0x000bc7670, 24, 8409}, {0x000bc7737, 24, 4472},
+	{0x000bc779f, 24, 14994}, {0x000bc79ad, 24, 1842}, {0x000bc7dd1, 24, 14995}, {0x000bc811f, 24, 14996},
+	{0x000bc8199, 24, 14997}, {0x000bc83a7, 24, 11921}, {0x000bc851f, 24, 4482}, {0x000bc8556, 24, 6482},
+	{0x000bc8893, 24, 14998}, {0x000bc8b55, 24, 14999}, {0x000bc8ccd, 24, 11743}, {0x000bc8d0e, 24, 5554},
+	{0x000bc926b, 24, 865}, {0x000bc9680, 24, 11920}, {0x000bc9889, 24, 11300}, {0x000bc99bc, 24, 15000},
+	{0x000bc9cc5, 24, 15001}, {0x000bc9da5, 24, 15002}, {0x000bca4e1, 24, 15003}, {0x000bca9d6, 24, 15004},
+	{0x000bcaec5, 24, 4673}, {0x000bcb181, 24, 5455}, {0x000bcb1f3, 24, 4482}, {0x000bcb308, 24, 15005},
+	{0x000bcb852, 24, 15006}, {0x000bcbae1, 24, 15007}, {0x000bcbbc9, 24, 15008}, {0x000bcbc46, 24, 15009},
+	{0x000bcc168, 24, 15010}, {0x000bcc23a, 24, 15011}, {0x000bcc342, 24, 15012}, {0x000bcc493, 24, 1965},
+	{0x000bcc61a, 24, 15013}, {0x000bcc6db, 24, 5842}, {0x000bcc810, 24, 6959}, {0x000bccab5, 24, 185},
+	{0x000bccd45, 24, 15014}, {0x000bccfcc, 24, 2176}, {0x000bcd165, 24, 6959}, {0x000bcd177, 24, 11491},
+	{0x000bcd1d3, 24, 9723}, {0x000bcd5b6, 24, 15015}, {0x000bcd940, 24, 15016}, {0x000bce09d, 24, 15017},
+	{0x000bce59f, 24, 15018}, {0x000bce767, 24, 15019}, {0x000bcea2b, 24, 15020}, {0x000bceafa, 24, 11475},
+	{0x000bceb5f, 24, 15021}, {0x000bcec23, 24, 15022}, {0x000bcee7b, 24, 4673}, {0x000bcf1f2, 24, 1965},
+	{0x000bcf2af, 24, 2675}, {0x000bcf5ac, 24, 6967}, {0x000bcf61c, 24, 15023}, {0x000bcf685, 24, 11768},
+	{0x000bcf811, 24, 15024}, {0x000bcfe8c, 24, 15025}, {0x000bcffac, 24, 15026}, {0x000c005c2, 24, 185},
+	{0x000c00d7e, 24, 15027}, {0x000c011a6, 24, 15028}, {0x000c01242, 24, 15029}, {0x000c0143d, 24, 6482},
+	{0x000c01885, 24, 6482}, {0x000c01ada, 24, 865}, {0x000c01e9b, 24, 15030}, {0x000c02250, 24, 99},
+	{0x000c02506, 24, 980}, {0x000c0255c, 24, 1965}, {0x000c02567, 24, 15031}, {0x000c027b9, 24, 15032},
+	{0x000c02973, 24, 15033}, {0x000c029f3, 24, 15034}, {0x000c02bfc, 24, 15035}, {0x000c02c7a, 24, 15036},
+	{0x000c0335e, 24, 12272}, {0x000c034b4, 24, 15037}, {0x000c03580, 24, 15038}, {0x000c035bd, 24, 15039},
+	{0x000c035c5, 24, 15040}, {0x000c03896, 24, 6482}, {0x000c038f9, 24, 709}, {0x000c03b8f, 24, 15041},
+	{0x000c03d46, 24, 15042}, {0x000c03e0f, 24, 6014}, {0x000c03f0e, 24, 11962}, {0x000c03f2a, 24, 15043},
+	{0x000c03fd5, 24, 15044}, {0x000c041f6, 24, 6537}, {0x000c04301, 24, 15045}, {0x000c044e3, 24, 15046},
+	{0x000c0493d, 24, 15047}, {0x000c04a00, 24, 11491}, {0x000c04df7, 24, 15048}, {0x000c05627, 24, 11507},
+	{0x000c056e3, 24, 12624}, {0x000c057bc, 24, 11282}, {0x000c058a7, 24, 15049}, {0x000c05e6f, 24, 15050},
+	{0x000c05e79, 24, 15051}, {0x000c06118, 24, 11491}, {0x000c0626b, 24, 3}, {0x000c06394, 24, 865},
+	{0x000c064c6, 24, 5842}, {0x000c06599, 24, 1842}, {0x000c067af, 24, 1965}, {0x000c06c0f, 24, 15052},
+	{0x000c06c6d, 24, 15053}, {0x000c07009, 24, 9715}, {0x000c07bbc, 24, 1965}, {0x000c07e40, 24, 15054},
+	{0x000c08170, 24, 15055}, {0x000c0830a, 24, 7228}, {0x000c0847a, 24, 865}, {0x000c0885b, 24, 15056},
+	{0x000c08ade, 24, 4614}, {0x000c08b6f, 24, 15057}, {0x000c08c60, 24, 1965}, {0x000c09132, 24, 15058},
+	{0x000c09134, 24, 6289}, {0x000c09879, 24, 15059}, {0x000c098e5, 24, 15060}, {0x000c09a71, 24, 15061},
+	{0x000c09c92, 24, 15062}, {0x000c09d26, 24, 15063}, {0x000c09f42, 24, 865}, {0x000c0a0bb, 24, 11768},
+	{0x000c0a0c7, 24, 15064}, {0x000c0a0de, 24, 15065}, {0x000c0a0e2, 24, 15066}, {0x000c0a26d, 24, 15067},
+	{0x000c0a364, 24, 15068}, {0x000c0a39e, 24, 15069}, {0x000c0aa68, 24, 15070}, {0x000c0ac54, 24, 9007},
+	{0x000c0b339, 24, 15071}, {0x000c0b357, 24, 15072}, {0x000c0b713, 24, 15073}, {0x000c0b8b1, 24, 15074},
+	{0x000c0bae6, 24, 12798}, {0x000c0bd42, 24, 15075}, {0x000c0bdd1, 24, 12950}, {0x000c0c1c0, 24, 4275},
+	{0x000c0c3b6, 24, 15076}, {0x000c0c520, 24, 4614}, {0x000c0c569, 24, 15077}, {0x000c0c687, 24, 6959},
+	{0x000c0c946, 24, 15078}, {0x000c0cb38, 24, 6482}, {0x000c0cecd, 24, 865}, {0x000c0cfa3, 24, 15079},
+	{0x000c0d044, 24, 9007}, {0x000c0d962, 24, 15080}, {0x000c0da74, 24, 15081}, {0x000c0dc6a, 24, 15082},
+	{0x000c0df77, 24, 15083}, {0x000c0e422, 24, 4305}, {0x000c0e54e, 24, 15084}, {0x000c0eae4, 24, 1607},
+	{0x000c0ee40, 24, 15085}, {0x000c0eefb, 24, 15086}, {0x000c0f1c4, 24, 15087}, {0x000c0f2fb, 24, 865},
+	{0x000c0f79d, 24, 15088}, {0x000c0f8da, 24, 6482}, {0x000c0f991, 24, 15089}, {0x000c0ffd4, 24, 13815},
+	{0x000c40006, 24, 15090}, {0x000c40142, 24, 15091}, {0x000c4017c, 24, 4614}, {0x000c401b1, 24, 15092},
+	{0x000c401ce, 24, 15093}, {0x000c40415, 24, 9978}, {0x000c40528, 24, 9715}, {0x000c4072f, 24, 9715},
+	{0x000c4084a, 24, 5554}, {0x000c40880, 24, 15094}, {0x000c40938, 24, 11983}, {0x000c40acb, 24, 3},
+	{0x000c40e45, 24, 15095}, {0x000c40f09, 24, 15096}, {0x000c4108a, 24, 4614}, {0x000c412f5, 24, 11768},
+	{0x000c413e2, 24, 12555}, {0x000c4143c, 24, 1965}, {0x000c416fa, 24, 15097}, {0x000c417fe, 24, 6482},
+	{0x000c4198b, 24, 15098}, {0x000c419ec, 24, 15099}, {0x000c41ece, 24, 15100}, {0x000c421c8, 24, 12042},
+	{0x000c4237a, 24, 15101}, {0x000c4242e, 24, 15102}, {0x000c42628, 24, 15103}, {0x000c42795, 24, 12146},
+	{0x000c4291d, 24, 15104}, {0x000c42c03, 24, 865}, {0x000c42f90, 24, 12624}, {0x000c4346b, 24, 11475},
+	{0x000c4366c, 24, 14195}, {0x000c436da, 24, 15105}, {0x000c438d3, 24, 15106}, {0x000c4393a, 24, 13677},
+	{0x000c43a9f, 24, 15107}, {0x000c43abe, 24, 11230}, {0x000c43c3c, 24, 15108}, {0x000c43dc7, 24, 11962},
+	{0x000c44202, 24, 1842}, {0x000c4438f, 24, 6967}, {0x000c44567, 24, 15109}, {0x000c445ec, 24, 15110},
+	{0x000c44619, 24, 6482}, {0x000c44838, 24, 15111}, {0x000c44ad0, 24, 15112}, {0x000c44b44, 24, 15113},
+	{0x000c44bd1, 24, 15114}, {0x000c44e1f, 24, 15115}, {0x000c44eac, 24, 15116}, {0x000c45006, 24, 1842},
+	{0x000c45444, 24, 11514}, {0x000c455a6, 24, 15117}, {0x000c455c2, 24, 15118}, {0x000c45600, 24, 15119},
+	{0x000c456fe, 24, 15120}, {0x000c4576e, 24, 8104}, {0x000c458c2, 24, 15121}, {0x000c45976, 24, 15122},
+	{0x000c45dd8, 24, 15123}, {0x000c46044, 24, 15124}, {0x000c4626b, 24, 15125}, {0x000c462ea, 24, 1842},
+	{0x000c46354, 24, 15126}, {0x000c46413, 24, 3}, {0x000c467b5, 24, 15127}, {0x000c4693e, 24, 15128},
+	{0x000c46ab7, 24, 15129}, {0x000c46bb4, 24, 15130}, {0x000c46df1, 24, 15131}, {0x000c46e1f, 24, 11766},
+	{0x000c47130, 24, 5694}, {0x000c471fe, 24, 3}, {0x000c47295, 24, 1965}, {0x000c4731e, 24, 12002},
+	{0x000c47b2f, 24, 15132}, {0x000c47ba3, 24, 15133}, {0x000c47d46, 24, 7968}, {0x000c47d4f, 24, 3},
+	{0x000c47dcc, 24, 1217}, {0x000c47dfe, 24, 15134}, {0x000c47f51, 24, 15135}, {0x000c4823f, 24, 15136},
+	{0x000c4824e, 24, 15137}, {0x000c48508, 24, 4472}, {0x000c488e5, 24, 1842}, {0x000c48e8f, 24, 6482},
+	{0x000c4913a, 24, 15138}, {0x000c4924c, 24, 15139}, {0x000c49300, 24, 15140}, {0x000c49313, 24, 15141},
+	{0x000c49380, 24, 15142}, {0x000c495a2, 24, 15143}, {0x000c49805, 24, 15144}, {0x000c49a02, 24, 15145},
+	{0x000c4a81d, 24, 11768}, {0x000c4aaa1, 24, 15146}, {0x000c4ad21, 24, 15147}, {0x000c4b512, 24, 15148},
+	{0x000c4ba99, 24, 15149}, {0x000c4baa3, 24, 15150}, {0x000c4bd6a, 24, 15151}, {0x000c4be84, 24, 15152},
+	{0x000c4c0ae, 24, 15153}, {0x000c4c19f, 24, 15154}, {0x000c4c755, 24, 15155}, {0x000c4c919, 24, 15156},
+	{0x000c4c9ec, 24, 15157}, {0x000c4cad9, 24, 11618}, {0x000c4cd45, 24, 15158}, {0x000c4d489, 24, 15159},
+	{0x000c4d655, 24, 15160}, {0x000c4d987, 24, 4472}, {0x000c4da26, 24, 15161}, {0x000c4e032, 24, 13041},
+	{0x000c4e17c, 24, 15162}, {0x000c4e7be, 24, 15163}, {0x000c4e92f, 24, 15164}, {0x000c4e984, 24, 11491},
+	{0x000c4ea1d, 24, 12289}, {0x000c4ebe3, 24, 15165}, {0x000c4edba, 24, 4305}, {0x000c4eeae, 24, 15166},
+	{0x000c4eef5, 24, 15167}, {0x000c4f464, 24, 15168}, {0x000c4f57c, 24, 127}, {0x000c4fce4, 24, 15169},
+	{0x000c80084, 24, 1965}, {0x000c80210, 24, 14195}, {0x000c80258, 24, 15170}, {0x000c802a6, 24, 15171},
+	{0x000c80718, 24, 15172}, {0x000c808e9, 24, 6967}, {0x000c80aa9, 24, 5203}, {0x000c80e14, 24, 15173},
+	{0x000c80e77, 24, 15174}, {0x000c80e95, 24, 15175}, {0x000c81479, 24, 1842}, {0x000c816bd, 24, 11529},
+	{0x000c819f7, 24, 1842}, {0x000c81afe, 24, 15176}, {0x000c81b6b, 24, 15177}, {0x000c81e8e, 24, 15178},
+	{0x000c81ee7, 24, 11656}, {0x000c81f66, 24, 2822}, {0x000c8208e, 24, 15179}, {0x000c8292a, 24, 15180},
+	{0x000c82a14, 24, 865}, {0x000c82e94, 24, 15181}, {0x000c83168, 24, 15182}, {0x000c83232, 24, 15183},
+	{0x000c8334b, 24, 865}, {0x000c8348e, 24, 4472}, {0x000c835b8, 24, 14125}, {0x000c83a35, 24, 15184},
+	{0x000c83b45, 24, 15185}, {0x000c83d97, 24, 5842}, {0x000c83dfc, 24, 15186}, {0x000c83e99, 24, 4305},
+	{0x000c83ea7, 24, 15187}, {0x000c83fb4, 24, 185}, {0x000c84529, 24, 15188}, {0x000c84544, 24, 15189},
+	{0x000c8458f, 24, 15190}, {0x000c848f5, 24, 15191}, {0x000c84c75, 24, 3}, {0x000c85645, 24, 15192},
+	{0x000c85663, 24, 15193}, {0x000c86000, 24, 4673}, {0x000c864c7, 24, 8716}, {0x000c86c1e, 24, 15194},
+	{0x000c86c87, 24, 4551}, {0x000c86cb6, 24, 15195}, {0x000c86f1d, 24, 865}, {0x000c87248, 24, 15196},
+	{0x000c87b5b, 24, 8716}, {0x000c87cbc, 24, 15197}, {0x000c87d77, 24, 15198}, {0x000c87e75, 24, 1842},
+	{0x000c88439, 24, 15199}, {0x000c88447, 24, 15200}, {0x000c88550, 24, 865}, {0x000c8873b, 24, 15201},
+	{0x000c88a83, 24, 15202}, {0x000c88b47, 24, 15203}, {0x000c8903e, 24, 15204}, {0x000c891f9, 24, 9007},
+	{0x000c89346, 24, 15205}, {0x000c89383, 24, 15206}, {0x000c894d2, 24, 15207}, {0x000c8979f, 24, 5842},
+	{0x000c89c1d, 24, 3}, {0x000c89cdc, 24, 8340}, {0x000c89f1d, 24, 15208}, {0x000c89f42, 24, 15209},
+	{0x000c8a030, 24, 4305}, {0x000c8a1b6, 24, 15210}, {0x000c8a1ba, 24, 15211}, {0x000c8a620, 24, 15212},
+	{0x000c8a70a, 24, 15213}, {0x000c8a729, 24, 15214}, {0x000c8a823, 24, 1842}, {0x000c8aa21, 24, 185},
+	{0x000c8aacc, 24, 99}, {0x000c8ae9c, 24, 15215}, {0x000c8af40, 24, 15216}, {0x000c8b373, 24, 4275},
+	{0x000c8b5b7, 24, 865}, {0x000c8ba94, 24, 12950}, {0x000c8bbd3, 24, 15217}, {0x000c8bcc8, 24, 865},
+	{0x000c8be19, 24, 11768}, {0x000c8c126, 24, 15218}, {0x000c8c13c, 24, 15219}, {0x000c8c50e, 24, 15220},
+	{0x000c8c791, 24, 15221}, {0x000c8cbb8, 24, 11475}, {0x000c8cd72, 24, 9007}, {0x000c8d019, 24, 15222},
+	{0x000c8d10b, 24, 5842}, {0x000c8d15e, 24, 9715}, {0x000c8d1d1, 24, 15223}, {0x000c8d2c1, 24, 15224},
+	{0x000c8d3a3, 24, 11768}, {0x000c8d429, 24, 15225}, {0x000c8d590, 24, 15226}, {0x000c8d5fe, 24, 13516},
+	{0x000c8d719, 24, 15227}, {0x000c8d779, 24, 15228}, {0x000c8ddc9, 24, 4485}, {0x000c8de51, 24, 15229},
+	{0x000c8df7c, 24, 5842}, {0x000c8e0eb, 24, 865}, {0x000c8e130, 24, 15230}, {0x000c8e1a7, 24, 15231},
+	{0x000c8e42f, 24, 15232}, {0x000c8e7d8, 24, 13407}, {0x000c8ee08, 24, 15233}, {0x000c8ee75, 24, 15234},
+	{0x000c8eea6, 24, 15235}, {0x000c8ef2e, 24, 15236}, {0x000c8f36b, 24, 15237}, {0x000c8f386, 24, 15238},
+	{0x000c8f406, 24, 11282}, {0x000c8f650, 24, 865}, {0x000c8f68d, 24, 15239}, {0x000c8f704, 24, 15240},
+	{0x000c8f733, 24, 4472}, {0x000c8f981, 24, 15241}, {0x000c8f9c8, 24, 15242}, {0x000c8f9f9, 24, 3},
+	{0x000c8fb26, 24, 6959}, {0x000c8fe30, 24, 15243}, {0x000c8ff77, 24, 15244}, {0x000cc0080, 24, 15245},
+	{0x000cc03fa, 24, 12992}, {0x000cc047c, 24, 15246}, {0x000cc04b4, 24, 15247}, {0x000cc051b, 24, 1842},
+	{0x000cc07ab, 24, 1842}, {0x000cc07e4, 24, 4485}, {0x000cc08e0, 24, 865}, {0x000cc09c8, 24, 15248},
+	{0x000cc0cda, 24, 15249}, {0x000cc0dec, 24, 6959}, {0x000cc10a3, 24, 15250}, {0x000cc14a6, 24, 15251},
+	{0x000cc187b, 24, 15252}, {0x000cc19a8, 24, 15253}, {0x000cc1afa, 24, 8716}, {0x000cc1eff, 24, 15254},
+	{0x000cc2218, 24, 15255}, {0x000cc262d, 24, 15256}, {0x000cc29f5, 24, 865}, {0x000cc2a80, 24, 15257},
+	{0x000cc2d8c, 24, 12525}, {0x000cc3080, 24, 15258}, {0x000cc33bb, 24, 11812}, {0x000cc3429, 24, 11491},
+	{0x000cc34d7, 24, 15259}, {0x000cc3540, 24, 12146}, {0x000cc37ab, 24, 15260}, {0x000cc398c, 24, 15261},
+	{0x000cc3a61, 24, 15262}, {0x000cc3c3f, 24, 15263}, {0x000cc3d82, 24, 4472}, {0x000cc3e5f, 24, 11475},
+	{0x000cc3f1d, 24, 15264}, {0x000cc43e3, 24, 15265}, {0x000cc4703, 24, 15266}, {0x000cc4ae1, 24, 15267},
+	{0x000cc4bfb, 24, 15268}, {0x000cc4e24, 24, 127}, {0x000cc4eec, 24, 838}, {0x000cc501c, 24, 15269},
+	{0x000cc5076, 24, 15270}, {0x000cc52af, 24, 3976}, {0x000cc53b5, 24, 9262}, {0x000cc5459, 24, 15271},
+	{0x000cc55ad, 24, 8028}, {0x000cc593e, 24, 15272}, {0x000cc5c75, 24, 15273}, {0x000cc5d4e, 24, 4551},
+	{0x000cc5d57, 24, 15274}, {0x000cc5fbf, 24, 15275}, {0x000cc60bb, 24, 15276}, {0x000cc65ad, 24, 185},
+	{0x000cc69b0, 24, 15277}, {0x000cc6b98, 24, 15278}, {0x000cc6bf1, 24, 15279}, {0x000cc6da0, 24, 14768},
+	{0x000cc6def, 24, 15280}, {0x000cc720f, 24, 15281}, {0x000cc7498, 24, 15282}, {0x000cc7669, 24, 15283},
+	{0x000cc785f, 24, 865}, {0x000cc7a30, 24, 15284}, {0x000cc7b35, 24, 8716}, {0x000cc7d37, 24, 185},
+	{0x000cc7ee7, 24, 11249}, {0x000cc856c, 24, 15285}, {0x000cc89fd, 24, 5842}, {0x000cc8ce3, 24, 4305},
+	{0x000cc9093, 24, 15286}, {0x000cc912b, 24, 15287}, {0x000cc944a, 24, 15288}, {0x000cc95d7, 24, 15289},
+	{0x000cc9635, 24, 15290}, {0x000cc96a0, 24, 8409}, {0x000cc9e00, 24, 2314}, {0x000cc9f35, 24, 15291},
+	{0x000cca0e5, 24, 15292}, {0x000cca223, 24, 9715}, {0x000cca374, 24, 15293}, {0x000cca462, 24, 185},
+	{0x000cca4af, 24, 15294}, {0x000cca614, 24, 15295}, {0x000ccaf78, 24, 6482}, {0x000ccb255, 24, 11768},
+	{0x000ccb3f8, 24, 15296}, {0x000ccb55a, 24, 15297}, {0x000ccb691, 24, 15298}, {0x000ccb888, 24, 15299},
+	{0x000ccb8f1, 24, 15300}, {0x000ccbd35, 24, 15301}, {0x000ccbdd3, 24, 15302}, {0x000ccbe71, 24, 15303},
+	{0x000ccc104, 24, 15304}, {0x000ccc3ea, 24, 11721}, {0x000ccc50a, 24, 15305}, {0x000ccc62b, 24, 15306},
+	{0x000ccc760, 24, 11309}, {0x000ccc8d7, 24, 15307}, {0x000cccc4e, 24, 15308}, {0x000cccc81, 24, 9262},
+	{0x000cccd64, 24, 15309}, {0x000ccce40, 24, 15310}, {0x000ccd29b, 24, 15311}, {0x000ccd539, 24, 1965},
+	{0x000ccd811, 24, 15312}, {0x000ccd8c1, 24, 1965}, {0x000ccd9e9, 24, 15313}, {0x000cce17f, 24, 13257},
+	{0x000cce1d5, 24, 1741}, {0x000cce798, 24, 15314}, {0x000cce7df, 24, 15315}, {0x000cce8ac, 24, 15316},
+	{0x000ccea1c, 24, 15317}, {0x000cceed9, 24, 15318}, {0x000ccef48, 24, 3}, {0x000ccf3a5, 24, 15319},
+	{0x000ccf407, 24, 15320}, {0x000ccf538, 24, 15321}, {0x000ccf67a, 24, 15322}, {0x000ccf841, 24, 15323},
+	{0x000ccf8f0, 24, 15324}, {0x000ccf954, 24, 11282}, {0x000ccf9e8, 24, 1842}, {0x000ccfa00, 24, 6967},
+	{0x000ccfb65, 24, 2314}, {0x000ccfc6d, 24, 15325}, {0x000ccfcb1, 24, 15326}, {0x000ccfe3c, 24, 4482},
+	{0x000d0034b, 24, 11656}, {0x000d00492, 24, 13853}, {0x000d00790, 24, 4305}, {0x000d00aab, 24, 15327},
+	{0x000d00ea4, 24, 15328}, {0x000d00ed9, 24, 15329}, {0x000d01242, 24, 15330}, {0x000d0131e, 24, 15331},
+	{0x000d0154a, 24, 8716}, {0x000d0176a, 24, 1842}, {0x000d01aa7, 24, 15332}, {0x000d01cbb, 24, 15333},
+	{0x000d02212, 24, 11626}, {0x000d022be, 24, 11743}, {0x000d023db, 24, 865}, {0x000d02516, 24, 13407},
+	{0x000d02598, 24, 11309}, {0x000d02788, 24, 11481}, {0x000d02c45, 24, 15334}, {0x000d02db3, 24, 9715},
+	{0x000d03110, 24, 15335}, {0x000d03761, 24, 4305}, {0x000d03972, 24, 4305}, {0x000d039b3, 24, 185},
+	{0x000d0431e, 24, 5963}, {0x000d046dc, 24, 15336}, {0x000d048f3, 24, 15337}, {0x000d04cc1, 24, 15338},
+	{0x000d04d2c, 24, 14887}, {0x000d04f7e, 24, 865}, {0x000d05099, 24, 8355}, {0x000d05162, 24, 11230},
+	{0x000d052a8, 24, 15339}, {0x000d05349, 24, 15340}, {0x000d0542d, 24, 5973}, {0x000d0574c, 24, 3},
+	{0x000d05785, 24, 14438}, {0x000d057a1, 24, 15341}, {0x000d05875, 24, 15342}, {0x000d059c3, 24, 15343},
+	{0x000d059e4, 24, 1842}, {0x000d05a0f, 24, 15344}, {0x000d05af1, 24, 15345}, {0x000d05ba8, 24, 8716},
+	{0x000d05c7a, 24, 15346}, {0x000d05fb8, 24, 4305}, {0x000d05fce, 24, 15347}, {0x000d062a0, 24, 15348},
+	{0x000d0634d, 24, 15349}, {0x000d063b4, 24, 15350}, {0x000d0667b, 24, 5076}, {0x000d067e5, 24, 2822},
+	{0x000d0699e, 24, 15351}, {0x000d069d0, 24, 15352}, {0x000d06a1f, 24, 15353}, {0x000d06f4a, 24, 15354},
+	{0x000d072dc, 24, 1965}, {0x000d0737f, 24, 15355}, {0x000d0738e, 24, 15356}, {0x000d073d5, 24, 15357},
+	{0x000d075be, 24, 15358}, {0x000d07650, 24, 11626}, {0x000d07ab5, 24, 9715}, {0x000d07de5, 24, 15359},
+	{0x000d07e28, 24, 11475}, {0x000d07e35, 24, 4472}, {0x000d083d4, 24, 15360}, {0x000d084b0, 24, 15361},
+	{0x000d08999, 24, 15362}, {0x000d08a55, 24, 15363}, {0x000d08b7e, 24, 15364}, {0x000d08cb5, 24, 4305},
+	{0x000d08cff, 24, 15365}, {0x000d0929e, 24, 965}, {0x000d093f8, 24, 15366}, {0x000d095c7, 24, 14438},
+	{0x000d09b05, 24, 15367}, {0x000d09c30, 24, 15368}, {0x000d09d0a, 24, 15369}, {0x000d09dab, 24, 12532},
+	{0x000d0a0d6, 24, 15370}, {0x000d0a311, 24, 15371}, {0x000d0a5a6, 24, 1965}, {0x000d0a637, 24, 865},
+	{0x000d0aeec, 24, 3710}, {0x000d0afb6, 24, 15372}, {0x000d0b33f, 24, 15373}, {0x000d0b498, 24, 15374},
+	{0x000d0b523, 24, 15375}, {0x000d0b53d, 24, 15376}, {0x000d0b5c2, 24, 4305}, {0x000d0bb80, 24, 15377},
+	{0x000d0bd01, 24, 15378}, {0x000d0be2c, 24, 15379}, {0x000d0bf9c, 24, 11475}, {0x000d0c0bf, 24, 15380},
+	{0x000d0c1b1, 24, 1842}, {0x000d0c282, 24, 3}, {0x000d0c42f, 24, 15381}, {0x000d0c789, 24, 1965},
+	{0x000d0c7c0, 24, 11491}, {0x000d0cde1, 24, 15382}, {0x000d0cf5e, 24, 15383}, {0x000d0d0fd, 24, 3},
+	{0x000d0d212, 24, 15384}, {0x000d0d286, 24, 15385}, {0x000d0d3fc, 24, 15386}, {0x000d0d412, 24, 1957},
+	{0x000d0d471, 24, 15387}, {0x000d0d6cc, 24, 15388}, {0x000d0db32, 24, 5842}, {0x000d0df9a, 24, 7813},
+	{0x000d0dfb2, 24, 15389}, {0x000d0dfc7, 24, 1842}, {0x000d0e140, 24, 11563}, {0x000d0e347, 24, 15390},
+	{0x000d0e40b, 24, 15391}, {0x000d0e54d, 24, 6621}, {0x000d0e782, 24, 12412}, {0x000d0eb03, 24, 15392},
+	{0x000d0eb9e, 24, 15393}, {0x000d0f0db, 24, 540}, {0x000d0f27f, 24, 15394}, {0x000d0f73b, 24, 15395},
+	{0x000d0fa1d, 24, 15396}, {0x000d0ff50, 24, 11998}, {0x000d4000d, 24, 15397}, {0x000d40057, 24, 15398},
+	{0x000d40129, 24, 3266}, {0x000d4016d, 24, 11491}, {0x000d4024a, 24, 15399}, {0x000d404cd, 24, 185},
+	{0x000d40598, 24, 185}, {0x000d40b1a, 24, 2176}, {0x000d40bb9, 24, 15400}, {0x000d40fb2, 24, 15401},
+	{0x000d41090, 24, 15402}, {0x000d410cf, 24, 15403}, {0x000d411d6, 24, 15404}, {0x000d41296, 24, 15405},
+	{0x000d412bb, 24, 15406}, {0x000d4136f, 24, 15407}, {0x000d41c1c, 24, 15408}, {0x000d41e35, 24, 15409},
+	{0x000d41f0c, 24, 15410}, {0x000d4206d, 24, 2176}, {0x000d42122, 24, 14197}, {0x000d4223f, 24, 4485},
+	{0x000d4224e, 24, 11600}, {0x000d42751, 24, 15411}, {0x000d428b2, 24, 15412}, {0x000d429ea, 24, 15413},
+	{0x000d42c3d, 24, 15414}, {0x000d42f23, 24, 15415}, {0x000d4319d, 24, 15416}, {0x000d43266, 24, 15417},
+	{0x000d437d7, 24, 8716}, {0x000d43a65, 24, 15418}, {0x000d43ae9, 24, 15419}, {0x000d43d67, 24, 15420},
+	{0x000d43d7e, 24, 15421}, {0x000d443a8, 24, 15422}, {0x000d44b5e, 24, 15423}, {0x000d44c24, 24, 15424},
+	{0x000d44c9c, 24, 15425}, {0x000d44ca7, 24, 15426}, {0x000d44f80, 24, 15427}, {0x000d4507a, 24, 15428},
+	{0x000d4522a, 24, 15429}, {0x000d45251, 24, 15430}, {0x000d45297, 24, 15431}, {0x000d453af, 24, 15432},
+	{0x000d45556, 24, 15433}, {0x000d45ab2, 24, 15434}, {0x000d45c70, 24, 12873}, {0x000d45d42, 24, 5842},
+	{0x000d46132, 24, 15435}, {0x000d464f7, 24, 15436}, {0x000d466a8, 24, 15437}, {0x000d46761, 24, 15438},
+	{0x000d467e7, 24, 11300}, {0x000d4684d, 24, 4614}, {0x000d46867, 24, 15439}, {0x000d468ba, 24, 15440},
+	{0x000d46a91, 24, 15441}, {0x000d46aa8, 24, 9262}, {0x000d46cbf, 24, 15442}, {0x000d46cda, 24, 15443},
+	{0x000d46d50, 24, 1965}, {0x000d46e5c, 24, 9715}, {0x000d46f42, 24, 15444}, {0x000d479c3, 24, 15445},
+	{0x000d47b35, 24, 15446}, {0x000d47b75, 24, 15447}, {0x000d47bb0, 24, 7605}, {0x000d481ca, 24, 15448},
+	{0x000d4823e, 24, 15449}, {0x000d48564, 24, 459}, {0x000d487d8, 24, 4482}, {0x000d48890, 24, 1842},
+	{0x000d48cb5, 24, 3}, {0x000d48dd9, 24, 15450}, {0x000d48f33, 24, 965}, {0x000d48faa, 24, 15451},
+	{0x000d491af, 24, 15452}, {0x000d49398, 24, 5842}, {0x000d493a0, 24, 15453}, {0x000d4945a, 24, 15454},
+	{0x000d494a1, 24, 4305}, {0x000d49524, 24, 15455}, {0x000d496df, 24, 15456}, {0x000d4970b, 24, 14717},
+	{0x000d49a20, 24, 865}, {0x000d49c28, 24, 15457}, {0x000d49c8e, 24, 15458}, {0x000d49e6d, 24, 15459},
+	{0x000d4a02a, 24, 3}, {0x000d4a425, 24, 15460}, {0x000d4a499, 24, 15461}, {0x000d4a928, 24, 15462},
+	{0x000d4aaff, 24, 15463}, {0x000d4ac4e, 24, 15464}, {0x000d4ad2d, 24, 11300}, {0x000d4ae52, 24, 2822},
+	{0x000d4b110, 24, 9262}, {0x000d4b43e, 24, 15465}, {0x000d4bed9, 24, 2822}, {0x000d4bf2d, 24, 15466},
+	{0x000d4bf7f, 24, 15467}, {0x000d4c1fc, 24, 5842}, {0x000d4c766, 24, 15468}, {0x000d4c9ef, 24, 11475},
+	{0x000d4ca6d, 24, 2919}, {0x000d4ca6e, 24, 15469}, {0x000d4cbaf, 24, 5842}, {0x000d4ceb8, 24, 15470},
+	{0x000d4cff9, 24, 15471}, {0x000d4d184, 24, 1957}, {0x000d4d249, 24, 15472}, {0x000d4d50d, 24, 15473},
+	{0x000d4d748, 24, 3}, {0x000d4d7a9, 24, 15474}, {0x000d4d898, 24, 15475}, {0x000d4d919, 24, 15476},
+	{0x000d4df57, 24, 15477}, {0x000d4e08e, 24, 15478}, {0x000d4e32c, 24, 15479}, {0x000d4e33f, 24, 5554},
+	{0x000d4e8b2, 24, 4482}, {0x000d4ea0e, 24, 11282}, {0x000d4ec0c, 24, 15480}, {0x000d4ec86, 24, 15481},
+	{0x000d4ee07, 24, 15482}, {0x000d4f027, 24, 15483}, {0x000d4f0b4, 24, 15484}, {0x000d4f143, 24, 15485},
+	{0x000d4f46f, 24, 865}, {0x000d4f513, 24, 4305}, {0x000d4f63f, 24, 15486}, {0x000d4f9a1, 24, 9262},
+	{0x000d8004d, 24, 865}, {0x000d8052e, 24, 15487}, {0x000d806d1, 24, 15488}, {0x000d808f5, 24, 15489},
+	{0x000d809c3, 24, 15490}, {0x000d80ccf, 24, 15491}, {0x000d80de3, 24, 15492}, {0x000d8150d, 24, 11491},
+	{0x000d8160a, 24, 15493}, {0x000d8182b, 24, 15494}, {0x000d819ce, 24, 15495}, {0x000d81bfe, 24, 15496},
+	{0x000d81c14, 24, 15497}, {0x000d81d72, 24, 865}, {0x000d81ede, 24, 15498}, {0x000d824bd, 24, 3},
+	{0x000d82522, 24, 6621}, {0x000d826b9, 24, 15499}, {0x000d8270c, 24, 15500}, {0x000d828c9, 24, 15501},
+	{0x000d82916, 24, 15502}, {0x000d82986, 24, 15503}, {0x000d82a15, 24, 15504}, {0x000d82a7e, 24, 5842},
+	{0x000d82d9b, 24, 15505}, {0x000d82de1, 24, 15506}, {0x000d83062, 24, 865}, {0x000d831cf, 24, 1842},
+	{0x000d8337f, 24, 15507}, {0x000d83c69, 24, 9723}, {0x000d842ac, 24, 9716}, {0x000d84606, 24, 15508},
+	{0x000d8490b, 24, 9262}, {0x000d8492f, 24, 124}, {0x000d84a87, 24, 15509}, {0x000d84b2a, 24, 15510},
+	{0x000d850e6, 24, 4673}, {0x000d8543a, 24, 4305}, {0x000d854a2, 24, 12555}, {0x000d855a3, 24, 8716},
+	{0x000d857ef, 24, 4482}, {0x000d858d7, 24, 15511}, {0x000d85d4c, 24, 13604}, {0x000d85d84, 24, 15512},
+	{0x000d85de2, 24, 6482}, {0x000d85def, 24, 15513}, {0x000d85dfb, 24, 99}, {0x000d86194, 24, 15514},
+	{0x000d862db, 24, 15515}, {0x000d86595, 24, 15516}, {0x000d866c6, 24, 15517}, {0x000d866ee, 24, 15518},
+	{0x000d867d9, 24, 3}, {0x000d86960, 24, 15519}, {0x000d86bf7, 24, 2314}, {0x000d86ce9, 24, 11812},
+	{0x000d87157, 24, 4485}, {0x000d87495, 24, 8716}, {0x000d87533, 24, 5842}, {0x000d8760a, 24, 15520},
+	{0x000d878e5, 24, 15521}, {0x000d87988, 24, 4861}, {0x000d87cdd, 24, 15522}, {0x000d87eb1, 24, 15523},
+	{0x000d88039, 24, 7029}, {0x000d881ce, 24, 15524}, {0x000d88466, 24, 288}, {0x000d88a3b, 24, 15525},
+	{0x000d88d5c, 24, 8212}, {0x000d890e8, 24, 1842}, {0x000d89341, 24, 15526}, {0x000d8952f, 24, 4305},
+	{0x000d89685, 24, 15476}, {0x000d89695, 24, 865}, {0x000d896e0, 24, 11825}, {0x000d8973b, 24, 406},
+	{0x000d89760, 24, 15527}, {0x000d8977c, 24, 15528}, {0x000d897ba, 24, 9722}, {0x000d89a34, 24, 15529},
+	{0x000d89d67, 24, 11475}, {0x000d89db9, 24, 15530}, {0x000d89e3f, 24, 865}, {0x000d8a25e, 24, 865},
+	{0x000d8addd, 24, 15531}, {0x000d8ae90, 24, 15532}, {0x000d8af3b, 24, 15533}, {0x000d8aff1, 24, 15534},
+	{0x000d8b02e, 24, 15535}, {0x000d8b04c, 24, 15536}, {0x000d8b12a, 24, 5918}, {0x000d8b190, 24, 9155},
+	{0x000d8b377, 24, 2176}, {0x000d8b6b7, 24, 15537}, {0x000d8b6c1, 24, 15538}, {0x000d8b6d6, 24, 15539},
+	{0x000d8b8f6, 24, 15540}, {0x000d8b90e, 24, 15541}, {0x000d8bb2c, 24, 865}, {0x000d8bf4c, 24, 15542},
+	{0x000d8c068, 24, 15543}, {0x000d8c3fb, 24, 15544}, {0x000d8c691, 24, 15545}, {0x000d8c7c8, 24, 2743},
+	{0x000d8c99d, 24, 15546}, {0x000d8cb8a, 24, 15547}, {0x000d8cf9c, 24, 865}, {0x000d8d1cb, 24, 865},
+	{0x000d8d27c, 24, 15548}, {0x000d8d385, 24, 459}, {0x000d8d43c, 24, 311}, {0x000d8d5b9, 24, 15549},
+	{0x000d8d67e, 24, 15550}, {0x000d8da52, 24, 15551}, {0x000d8dce9, 24, 15552}, {0x000d8dd5f, 24, 15553},
+	{0x000d8ddfd, 24, 4305}, {0x000d8dece, 24, 15554}, {0x000d8df0d, 24, 15555}, {0x000d8e3ae, 24, 15556},
+	{0x000d8e56d, 24, 11601}, {0x000d8e72b, 24, 9862}, {0x000d8e743, 24, 15557}, {0x000d8e952, 24, 15558},
+	{0x000d8eb97, 24, 15559}, {0x000d8ee78, 24, 15560}, {0x000d8efcd, 24, 15561}, {0x000d8f0f2, 24, 15562},
+	{0x000d8f710, 24, 15563}, {0x000d8fb11, 24, 15564}, {0x000d8fc93, 24, 4472}, {0x000d8fe8f, 24, 15565},
+	{0x000d8fee3, 24, 11768}, {0x000dc0265, 24, 15566}, {0x000dc028e, 24, 8716}, {0x000dc052f, 24, 15567},
+	{0x000dc0575, 24, 15568}, {0x000dc05ed, 24, 15569}, {0x000dc07c1, 24, 15570}, {0x000dc0914, 24, 7064},
+	{0x000dc0b1a, 24, 1957}, {0x000dc0ea1, 24, 11980}, {0x000dc15db, 24, 15571}, {0x000dc16a2, 24, 15572},
+	{0x000dc175a, 24, 15573}, {0x000dc1792, 24, 15574}, {0x000dc1d9f, 24, 15575}, {0x000dc1dd4, 24, 15576},
+	{0x000dc1ea3, 24, 15577}, {0x000dc2008, 24, 15578}, {0x000dc2a14, 24, 15579}, {0x000dc2b61, 24, 865},
+	{0x000dc2b66, 24, 15580}, {0x000dc2bca, 24, 15581}, {0x000dc2c26, 24, 15582}, {0x000dc2e6a, 24, 15583},
+	{0x000dc2f03, 24, 15584}, {0x000dc309c, 24, 15585}, {0x000dc3350, 24, 15586}, {0x000dc3714, 24, 11309},
+	{0x000dc37d2, 24, 15587}, {0x000dc38e1, 24, 6820}, {0x000dc3979, 24, 15588}, {0x000dc3a5e, 24, 14887},
+	{0x000dc3c2e, 24, 15589}, {0x000dc3c84, 24, 15590}, {0x000dc3e51, 24, 15591}, {0x000dc3ef8, 24, 5842},
+	{0x000dc4517, 24, 185}, {0x000dc49c9, 24, 15592}, {0x000dc4ede, 24, 15593}, {0x000dc5360, 24, 4472},
+	{0x000dc537c, 24, 15594}, {0x000dc56e6, 24, 15595}, {0x000dc5726, 24, 15596}, {0x000dc5e36, 24, 15597},
+	{0x000dc60a1, 24, 15598}, {0x000dc647c, 24, 15599}, {0x000dc663a, 24, 15600}, {0x000dc6f00, 24, 15601},
+	{0x000dc6f08, 24, 15602}, {0x000dc7014, 24, 99}, {0x000dc7144, 24, 12901}, {0x000dc7b94, 24, 3},
+	{0x000dc7fa4, 24, 7228}, {0x000dc825b, 24, 15603}, {0x000dc85de, 24, 15604}, {0x000dc86d8, 24, 11563},
+	{0x000dc9b1e, 24, 15605}, {0x000dc9b9c, 24, 865}, {0x000dc9c52, 24, 15606}, {0x000dc9fa4, 24, 5842},
+	{0x000dc9fdb, 24, 12694}, {0x000dca3ac, 24, 15607}, {0x000dca5f4, 24, 1965}, {0x000dca6bd, 24, 15608},
+	{0x000dca7d9, 24, 15609}, {0x000dca8cf, 24, 15610}, {0x000dca971, 24, 4472}, {0x000dca989, 24, 15611},
+	{0x000dcad9e, 24, 15612}, {0x000dcae04, 24, 15613}, {0x000dcb058, 24, 15614}, {0x000dcb4c4, 24, 15615},
+	{0x000dcbf90, 24, 15616}, {0x000dcc0db, 24, 15617}, {0x000dcc101, 24, 15618}, {0x000dcc422, 24, 15619},
+	{0x000dcc622, 24, 15620}, {0x000dcc793, 24, 5842}, {0x000dccba8, 24, 15621}, {0x000dcce41, 24, 15622},
+	{0x000dccebc, 24, 15623}, {0x000dccf94, 24, 15624}, {0x000dcd0f7, 24, 15625}, {0x000dcd2fc, 24, 9262},
+	{0x000dcd321, 24, 15626}, {0x000dcd52a, 24, 15627}, {0x000dcd87f, 24, 15628}, {0x000dcda4f, 24, 15629},
+	{0x000dcdb70, 24, 15630}, {0x000dcdeca, 24, 15631}, {0x000dce026, 24, 15632}, {0x000dce1ad, 24, 15633},
+	{0x000dce2ac, 24, 15634}, {0x000dce578, 24, 15635}, {0x000dce71c, 24, 15636}, {0x000dceb94, 24, 9155},
+	{0x000dcec06, 24, 15637}, {0x000dcf05d, 24, 15638}, {0x000dcf110, 24, 5842}, {0x000dcf755, 24, 15639},
+	{0x000dcf858, 24, 15640}, {0x000dcfad5, 24, 15641}, {0x000dcfb02, 24, 1741}, {0x000e00370, 24, 15642},
+	{0x000e005c5, 24, 13604}, {0x000e006e6, 24, 6482}, {0x000e00b28, 24, 15643}, {0x000e00c7f, 24, 2314},
+	{0x000e00db9, 24, 99}, {0x000e0107f, 24, 4614}, {0x000e0143e, 24, 15644}, {0x000e01877, 24, 7968},
+	{0x000e0191d, 24, 9715}, {0x000e01c41, 24, 12555}, {0x000e01cee, 24, 15645}, {0x000e01d38, 24, 15155},
+	{0x000e01d3b, 24, 15646}, {0x000e01e07, 24, 15647}, {0x000e01f0a, 24, 15648}, {0x000e0247f, 24, 9262},
+	{0x000e02538, 24, 15649}, {0x000e02630, 24, 15650}, {0x000e02636, 24, 108}, {0x000e0271a, 24, 15651},
+	{0x000e02a82, 24, 3976}, {0x000e02cb2, 24, 13538}, {0x000e02f6d, 24, 1965}, {0x000e03005, 24, 11806},
+	{0x000e0319e, 24, 15652}, {0x000e031d0, 24, 15653}, {0x000e03560, 24, 15654}, {0x000e036e3, 24, 15655},
+	{0x000e039d7, 24, 15656}, {0x000e03c5b, 24, 15657}, {0x000e03e44, 24, 3266}, {0x000e03e4a, 24, 15658},
+	{0x000e03e7d, 24, 15659}, {0x000e03f49, 24, 4673}, {0x000e04136, 24, 14735}, {0x000e0469a, 24, 11962},
+	{0x000e04b45, 24, 15660}, {0x000e0553d, 24, 15661}, {0x000e05597, 24, 15662}, {0x000e056f4, 24, 15663},
+	{0x000e0589e, 24, 15664}, {0x000e05b70, 24, 15665}, {0x000e05da6, 24, 15666}, {0x000e05fb9, 24, 3},
+	{0x000e061b2, 24, 15667}, {0x000e06290, 24, 15668}, {0x000e063e5, 24, 11230}, {0x000e064bb, 24, 15669},
+	{0x000e06678, 24, 865}, {0x000e067b3, 24, 15670}, {0x000e06995, 24, 9722}, {0x000e0750a, 24, 15671},
+	{0x000e0757d, 24, 11721}, {0x000e076d0, 24, 7934}, {0x000e07c62, 24, 15672}, {0x000e07f53, 24, 15673},
+	{0x000e07f88, 24, 15674}, {0x000e08177, 24, 15675}, {0x000e087b1, 24, 15676}, {0x000e0885d, 24, 11512},
+	{0x000e0899d, 24, 1965}, {0x000e08a7e, 24, 15677}, {0x000e08e3c, 24, 8617}, {0x000e08fec, 24, 15678},
+	{0x000e09153, 24, 296}, {0x000e091f5, 24, 11962}, {0x000e09467, 24, 4472}, {0x000e09579, 24, 15679},
+	{0x000e09796, 24, 9262}, {0x000e097f2, 24, 15680}, {0x000e09971, 24, 1842}, {0x000e09d31, 24, 4472},
+	{0x000e09db8, 24, 12695}, {0x000e0a198, 24, 15681}, {0x000e0a1d7, 24, 5421}, {0x000e0a30f, 24, 15682},
+	{0x000e0a670, 24, 5842}, {0x000e0aab0, 24, 15683}, {0x000e0abfe, 24, 15684}, {0x000e0accb, 24, 11309},
+	{0x000e0acf1, 24, 1965}, {0x000e0ae5e, 24, 1671}, {0x000e0aeb2, 24, 15685}, {0x000e0aeed, 24, 15686},
+	{0x000e0af4b, 24, 13225}, {0x000e0b2f1, 24, 12972}, {0x000e0b52d, 24, 865}, {0x000e0b70a, 24, 185},
+	{0x000e0b7b1, 24, 6621}, {0x000e0b9a5, 24, 11518}, {0x000e0b9ba, 24, 865}, {0x000e0bc43, 24, 15687},
+	{0x000e0c286, 24, 15688}, {0x000e0c2b7, 24, 12862}, {0x000e0c3f3, 24, 5972}, {0x000e0c6b3, 24, 15689},
+	{0x000e0c79d, 24, 4305}, {0x000e0c86a, 24, 15690}, {0x000e0c922, 24, 15691}, {0x000e0c97a, 24, 865},
+	{0x000e0ca4d, 24, 15692}, {0x000e0ca94, 24, 8290}, {0x000e0cb1d, 24, 99}, {0x000e0cb4e, 24, 4673},
+	{0x000e0cbee, 24, 1842}, {0x000e0cec3, 24, 7605}, {0x000e0cf2d, 24, 15693}, {0x000e0d10a, 24, 15694},
+	{0x000e0d173, 24, 1965}, {0x000e0d1e6, 24, 15695}, {0x000e0d31a, 24, 15696}, {0x000e0d7ba, 24, 4305},
+	{0x000e0d9a2, 24, 15697}, {0x000e0dadc, 24, 15698}, {0x000e0db10, 24, 1842}, {0x000e0db55, 24, 2822},
+	{0x000e0db88, 24, 15699}, {0x000e0dca0, 24, 15700}, {0x000e0e5cf, 24, 4305}, {0x000e0e631, 24, 15701},
+	{0x000e0e751, 24, 2314}, {0x000e0e8e8, 24, 15702}, {0x000e0ed1a, 24, 15703}, {0x000e0edc7, 24, 15704},
+	{0x000e0ee1b, 24, 15705}, {0x000e0ef25, 24, 15706}, {0x000e0f211, 24, 15707}, {0x000e0f379, 24, 15708},
+	{0x000e0f5c6, 24, 865}, {0x000e0f5ca, 24, 15709}, {0x000e0f847, 24, 865}, {0x000e0f9be, 24, 15710},
+	{0x000e0faec, 24, 15711}, {0x000e0fff7, 24, 15712}, {0x000e40439, 24, 15713}, {0x000e4115b, 24, 11475},
+	{0x000e41218, 24, 13410}, {0x000e4121d, 24, 1842}, {0x000e41289, 24, 15714}, {0x000e41c4b, 24, 15715},
+	{0x000e41d2d, 24, 15716}, {0x000e41f13, 24, 564}, {0x000e42354, 24, 15717}, {0x000e425e7, 24, 865},
+	{0x000e425e9, 24, 15718}, {0x000e42771, 24, 15719}, {0x000e42ad3, 24, 15720}, {0x000e42c56, 24, 15721},
+	{0x000e42d02, 24, 11601}, {0x000e42f26, 24, 11300}, {0x000e42ff6, 24, 15722}, {0x000e432cb, 24, 1842},
+	{0x000e43593, 24, 15723}, {0x000e435fb, 24, 15724}, {0x000e437d7, 24, 15725}, {0x000e438f2, 24, 15726},
+	{0x000e43fa2, 24, 15727}, {0x000e440e2, 24, 1842}, {0x000e441e6, 24, 15728}, {0x000e446bd, 24, 15729},
+	{0x000e448c7, 24, 6959}, {0x000e44c6c, 24, 15730}, {0x000e44e18, 24, 15731}, {0x000e44f29, 24, 15732},
+	{0x000e44f5f, 24, 15733}, {0x000e455ea, 24, 15734}, {0x000e45614, 24, 15735}, {0x000e457a8, 24, 15736},
+	{0x000e458e7, 24, 1842}, {0x000e45aa2, 24, 11712}, {0x000e45d52, 24, 11282}, {0x000e46449, 24, 185},
+	{0x000e467ba, 24, 15737}, {0x000e468a3, 24, 9262}, {0x000e4695a, 24, 15738}, {0x000e46c21, 24, 15739},
+	{0x000e47185, 24, 15740}, {0x000e4751e, 24, 15741}, {0x000e47723, 24, 8716}, {0x000e4776b, 24, 15742},
+	{0x000e477d4, 24, 15743}, {0x000e47cf9, 24, 5076}, {0x000e47d5a, 24, 15744}, {0x000e47fb2, 24, 7968},
+	{0x000e48184, 24, 5554}, {0x000e481b3, 24, 15745}, {0x000e48399, 24, 185}, {0x000e48501, 24, 15746},
+	{0x000e48ad5, 24, 15747}, {0x000e48b7f, 24, 865}, {0x000e48c0f, 24, 15748}, {0x000e48d8c, 24, 2919},
+	{0x000e49069, 24, 177}, {0x000e4907e, 24, 11889}, {0x000e492e7, 24, 15749}, {0x000e492fb, 24, 1842},
+	{0x000e4956e, 24, 11626}, {0x000e496ae, 24, 15750}, {0x000e497f0, 24, 15751}, {0x000e498d6, 24, 11563},
+	{0x000e4a32f, 24, 15752}, {0x000e4a5ef, 24, 15753}, {0x000e4a7fd, 24, 15754}, {0x000e4ab46, 24, 15755},
+	{0x000e4ad7d, 24, 15756}, {0x000e4afa1, 24, 15757}, {0x000e4b021, 24, 1842}, {0x000e4bad9, 24, 15758},
+	{0x000e4c146, 24, 15759}, {0x000e4c62b, 24, 15760}, {0x000e4c63d, 24, 11309}, {0x000e4c6e6, 24, 15761},
+	{0x000e4c722, 24, 1965}, {0x000e4c806, 24, 15762}, {0x000e4ce70, 24, 15763}, {0x000e4ce8f, 24, 865},
+	{0x000e4d332, 24, 11491}, {0x000e4d3f1, 24, 1965}, {0x000e4d53d, 24, 6482}, {0x000e4d71d, 24, 15764},
+	{0x000e4dd79, 24, 15765}, {0x000e4e0c5, 24, 5076}, {0x000e4e409, 24, 15766}, {0x000e4ec10, 24, 5842},
+	{0x000e4eefd, 24, 15767}, {0x000e4f365, 24, 15768}, {0x000e4f3e3, 24, 15769}, {0x000e4f4c6, 24, 11962},
+	{0x000e4f7a1, 24, 15770}, {0x000e4f89c, 24, 4472}, {0x000e4f8ef, 24, 11234}, {0x000e4f939, 24, 15771},
+	{0x000e4fa1d, 24, 15772}, {0x000e4fafd, 24, 4472}, {0x000e4fed9, 24, 15773}, {0x000e4ffdd, 24, 15774},
+	{0x000e8039a, 24, 4590}, {0x000e8040b, 24, 865}, {0x000e80410, 24, 99}, {0x000e80462, 24, 3},
+	{0x000e804f3, 24, 15775}, {0x000e8056d, 24, 108}, {0x000e80688, 24, 865}, {0x000e807bf, 24, 15776},
+	{0x000e8088b, 24, 9715}, {0x000e80b13, 24, 15777}, {0x000e80c38, 24, 15778}, {0x000e80c75, 24, 15779},
+	{0x000e8102e, 24, 15780}, {0x000e81132, 24, 4590}, {0x000e81324, 24, 15781}, {0x000e8150e, 24, 5842},
+	{0x000e8162b, 24, 15782}, {0x000e817fc, 24, 15783}, {0x000e81863, 24, 11626}, {0x000e82877, 24, 15784},
+	{0x000e828d5, 24, 15785}, {0x000e82aea, 24, 4472}, {0x000e82e24, 24, 15786}, {0x000e83381, 24, 185},
+	{0x000e8377a, 24, 4551}, {0x000e83935, 24, 11475}, {0x000e839df, 24, 8290}, {0x000e83a97, 24, 15787},
+	{0x000e83eb6, 24, 8028}, {0x000e83efb, 24, 15788}, {0x000e83efc, 24, 185}, {0x000e84040, 24, 3},
+	{0x000e840f2, 24, 9722}, {0x000e843b6, 24, 15789}, {0x000e8447e, 24, 15790}, {0x000e8481f, 24, 15791},
+	{0x000e84e06, 24, 15792}, {0x000e84e84, 24, 1842}, {0x000e84ece, 24, 2314}, {0x000e8508b, 24, 12950},
+	{0x000e8516e, 24, 15793}, {0x000e8519d, 24, 15794}, {0x000e85484, 24, 15795}, {0x000e856d6, 24, 14389},
+	{0x000e85aa7, 24, 15796}, {0x000e85b5b, 24, 12525}, {0x000e85bf0, 24, 15797}, {0x000e85d6b, 24, 15798},
+	{0x000e85e53, 24, 15799}, {0x000e8611f, 24, 15800}, {0x000e8617e, 24, 7813}, {0x000e86183, 24, 15801},
+	{0x000e861be, 24, 15802}, {0x000e86549, 24, 9155}, {0x000e866c4, 24, 15803}, {0x000e86cda, 24, 15804},
+	{0x000e86d52, 24, 185}, {0x000e86d54, 24, 15805}, {0x000e86d6e, 24, 15806}, {0x000e8718d, 24, 15807},
+	{0x000e874e6, 24, 15808}, {0x000e8757f, 24, 15809}, {0x000e878a1, 24, 15810}, {0x000e87af3, 24, 15811},
+	{0x000e8802e, 24, 865}, {0x000e880d8, 24, 15812}, {0x000e887a3, 24, 15813}, {0x000e8892c, 24, 185},
+	{0x000e88d28, 24, 865}, {0x000e88df5, 24, 15814}, {0x000e88e60, 24, 15815}, {0x000e89120, 24, 11889},
+	{0x000e89218, 24, 15816}, {0x000e892a4, 24, 6967}, {0x000e8944c, 24, 15817}, {0x000e894f6, 24, 11491},
+	{0x000e89606, 24, 15818}, {0x000e8995a, 24, 15819}, {0x000e899c4, 24, 2176}, {0x000e89a8f, 24, 5203},
+	{0x000e89aff, 24, 15820}, {0x000e89d87, 24, 1934}, {0x000e8a364, 24, 15821}, {0x000e8a4c1, 24, 15822},
+	{0x000e8abfa, 24, 15823}, {0x000e8b1fc, 24, 4472}, {0x000e8b4ae, 24, 15824}, {0x000e8b748, 24, 3},
+	{0x000e8ba70, 24, 3}, {0x000e8bb3d, 24, 15825}, {0x000e8bba8, 24, 14029}, {0x000e8be81, 24, 9007},
+	{0x000e8c229, 24, 15826}, {0x000e8c320, 24, 15827}, {0x000e8c74f, 24, 7813}, {0x000e8cba1, 24, 5842},
+	{0x000e8cc18, 24, 11768}, {0x000e8cc32, 24, 15828}, {0x000e8cd2d, 24, 9715}, {0x000e8ce06, 24, 15829},
+	{0x000e8d0fa, 24, 15830}, {0x000e8d483, 24, 15831}, {0x000e8d4e0, 24, 15832}, {0x000e8da96, 24, 15833},
+	{0x000e8daaa, 24, 15834}, {0x000e8de27, 24, 11491}, {0x000e8dff2, 24, 15835}, {0x000e8e08f, 24, 15836},
+	{0x000e8e0b7, 24, 1934}, {0x000e8e1e2, 24, 15837}, {0x000e8e5d6, 24, 1842}, {0x000e8e732, 24, 5554},
+	{0x000e8e770, 24, 15838}, {0x000e8e776, 24, 15839}, {0x000e8e875, 24, 15840}, {0x000e8ea6a, 24, 15841},
+	{0x000e8eada, 24, 15842}, {0x000e8ed05, 24, 185}, {0x000e8edf3, 24, 1965}, {0x000e8ef89, 24, 15843},
+	{0x000e8f1b0, 24, 11812}, {0x000e8f226, 24, 15844}, {0x000e8f2e2, 24, 14195}, {0x000e8f2e3, 24, 15845},
+	{0x000e8f928, 24, 15846}, {0x000e8fc60, 24, 15847}, {0x000e8fcaf, 24, 9978}, {0x000ec0133, 24, 15848},
+	{0x000ec0ec4, 24, 6482}, {0x000ec0ed6, 24, 15849}, {0x000ec1120, 24, 15850}, {0x000ec1127, 24, 4305},
+	{0x000ec13b2, 24, 15851}, {0x000ec14f6, 24, 15852}, {0x000ec172f, 24, 8096}, {0x000ec1766, 24, 15853},
+	{0x000ec1a59, 24, 6645}, {0x000ec1d7f, 24, 8716}, {0x000ec1f72, 24, 12950}, {0x000ec219f, 24, 15854},
+	{0x000ec2257, 24, 15855}, {0x000ec2280, 24, 11768}, {0x000ec233d, 24, 9715}, {0x000ec2368, 24, 15856},
+	{0x000ec24b8, 24, 4305}, {0x000ec26ca, 24, 11766}, {0x000ec2af0, 24, 15857}, {0x000ec2c49, 24, 15858},
+	{0x000ec2e4e, 24, 15859}, {0x000ec3091, 24, 3}, {0x000ec3586, 24, 865}, {0x000ec3bf0, 24, 15860},
+	{0x000ec3c5a, 24, 15861}, {0x000ec3c88, 24, 15862}, {0x000ec3e09, 24, 15863}, {0x000ec3f05, 24, 15864},
+	{0x000ec42f0, 24, 15865}, {0x000ec43e6, 24, 15866}, {0x000ec43f6, 24, 4551}, {0x000ec4476, 24, 3},
+	{0x000ec4644, 24, 15867}, {0x000ec4670, 24, 15868}, {0x000ec473c, 24, 15869}, {0x000ec4993, 24, 15870},
+	{0x000ec4c4d, 24, 15871}, {0x000ec4f82, 24, 15872}, {0x000ec542e, 24, 15873}, {0x000ec55f9, 24, 6482},
+	{0x000ec59e7, 24, 965}, {0x000ec5a86, 24, 15874}, {0x000ec5c69, 24, 15875}, {0x000ec60e0, 24, 15876},
+	{0x000ec6264, 24, 15877}, {0x000ec63e5, 24, 15878}, {0x000ec66d1, 24, 15879}, {0x000ec6c9f, 24, 15880},
+	{0x000ec71db, 24, 15881}, {0x000ec74ba, 24, 9822}, {0x000ec7c74, 24, 15882}, {0x000ec7d9d, 24, 15883},
+	{0x000ec8009, 24, 15884}, {0x000ec836c, 24, 15885}, {0x000ec852f, 24, 865}, {0x000ec888f, 24, 8096},
+	{0x000ec8892, 24, 11721}, {0x000ec89f5, 24, 4485}, {0x000ec8a4c, 24, 8716}, {0x000ec8ead, 24, 15886},
+	{0x000ec9233, 24, 15887}, {0x000ec9327, 24, 15888}, {0x000ec9681, 24, 15889}, {0x000ec986c, 24, 15890},
+	{0x000ec98c1, 24, 15891}, {0x000ec9a74, 24, 11475}, {0x000ec9b5b, 24, 5842}, {0x000ec9ecd, 24, 406},
+	{0x000eca29b, 24, 15892}, {0x000eca86b, 24, 15893}, {0x000eca9fa, 24, 15894}, {0x000ecb106, 24, 15895},
+	{0x000ecb1d7, 24, 11475}, {0x000ecb541, 24, 15896}, {0x000ecb907, 24, 15897}, {0x000ecbafe, 24, 15898},
+	{0x000ecbbae, 24, 15899}, {0x000ecbd09, 24, 15900}, {0x000ecc38a, 24, 15901}, {0x000ecc882, 24, 3},
+	{0x000eccb30, 24, 9715}, {0x000eccd6d, 24, 15902}, {0x000ecd00e, 24, 15903}, {0x000ecd040, 24, 15904},
+	{0x000ecd19a, 24, 15905}, {0x000ecd925, 24, 15906}, {0x000ecd950, 24, 15907}, {0x000ecd9d1, 24, 14639},
+	{0x000ecde3d, 24, 15908}, {0x000ece09b, 24, 12497}, {0x000ece1a9, 24, 1965}, {0x000ece2fd, 24, 15909},
+	{0x000ece512, 24, 15910}, {0x000ece555, 24, 15911}, {0x000ece744, 24, 15912}, {0x000ece90b, 24, 15913},
+	{0x000ece915, 24, 15914}, {0x000ece9f8, 24, 15915}, {0x000ecea03, 24, 15916}, {0x000eceed8, 24, 15917},
+	{0x000ecf00e, 24, 15918}, {0x000ecf236, 24, 15919}, {0x000ecf35b, 24, 5842}, {0x000ecf4bb, 24, 2822},
+	{0x000ecf72b, 24, 15920}, {0x000ecfaaa, 24, 15921}, {0x000ecfc55, 24, 15922}, {0x000ecfe7e, 24, 15923},
+	{0x000f0007f, 24, 15924}, {0x000f0022b, 24, 15925}, {0x000f00248, 24, 15926}, {0x000f00786, 24, 15927},
+	{0x000f008f1, 24, 1842}, {0x000f013c3, 24, 15928}, {0x000f015a0, 24, 15929}, {0x000f0182b, 24, 15930},
+	{0x000f01c13, 24, 6967}, {0x000f01c2d, 24, 5553}, {0x000f01e34, 24, 15931}, {0x000f01faf, 24, 2822},
+	{0x000f0219d, 24, 15932}, {0x000f0224e, 24, 15933}, {0x000f02329, 24, 15934}, {0x000f02405, 24, 15935},
+	{0x000f02408, 24, 15936}, {0x000f02475, 24, 865}, {0x000f02572, 24, 3}, {0x000f025b7, 24, 12950},
+	{0x000f02624, 24, 15937}, {0x000f0264c, 24, 15938}, {0x000f02765, 24, 11916}, {0x000f02929, 24, 1965},
+	{0x000f02a23, 24, 15939}, {0x000f02a61, 24, 15940}, {0x000f02fd8, 24, 15941}, {0x000f0321a, 24, 3195},
+	{0x000f037a1, 24, 15942}, {0x000f03a4b, 24, 15943}, {0x000f03a55, 24, 15944}, {0x000f03d29, 24, 15945},
+	{0x000f03ff8, 24, 15946}, {0x000f04335, 24, 15947}, {0x000f04a2b, 24, 15948}, {0x000f04b6a, 24, 15949},
+	{0x000f04bf2, 24, 15950}, {0x000f04da2, 24, 5963}, {0x000f04f7c, 24, 99}, {0x000f05849, 24, 15951},
+	{0x000f05a09, 24, 1842}, {0x000f05d89, 24, 15952}, {0x000f05dc8, 24, 15953}, {0x000f05f5a, 24, 15954},
+	{0x000f06130, 24, 15955}, {0x000f0620d, 24, 15956}, {0x000f06281, 24, 6289}, {0x000f065dd, 24, 595},
+	{0x000f06853, 24, 15957}, {0x000f06bca, 24, 1842}, {0x000f0728c, 24, 1842}, {0x000f073ae, 24, 15958},
+	{0x000f0761c, 24, 6282}, {0x000f07765, 24, 15959}, {0x000f077d0, 24, 15960}, {0x000f07816, 24, 1965},
+	{0x000f07959, 24, 4673}, {0x000f07bcb, 24, 6482}, {0x000f07d68, 24, 3223}, {0x000f07f06, 24, 1965},
+	{0x000f07f0c, 24, 15961}, {0x000f081af, 24, 15962}, {0x000f08261, 24, 9007}, {0x000f0842f, 24, 1957},
+	{0x000f084c9, 24, 8716}, {0x000f08a28, 24, 15963}, {0x000f08bfe, 24, 15964}, {0x000f08cfb, 24, 11300},
+	{0x000f08edb, 24, 15965}, {0x000f0921c, 24, 11475}, {0x000f0933a, 24, 15966}, {0x000f093c5, 24, 15967},
+	{0x000f099bf, 24, 865}, {0x000f09a51, 24, 15968}, {0x000f09cbb, 24, 15969}, {0x000f09ce9, 24, 14353},
+	{0x000f09e63, 24, 1965}, {0x000f09fc2, 24, 12694}, {0x000f0a225, 24, 99}, {0x000f0a764, 24, 15970},
+	{0x000f0ab54, 24, 15971}, {0x000f0aca4, 24, 15972}, {0x000f0ad4e, 24, 15973}, {0x000f0ae51, 24, 15974},
+	{0x000f0b052, 24, 4614}, {0x000f0b2e5, 24, 9155}, {0x000f0b429, 24, 13221}, {0x000f0b479, 24, 865},
+	{0x000f0b6eb, 24, 15975}, {0x000f0bcc8, 24, 15976}, {0x000f0bdf1, 24, 15977}, {0x000f0bf97, 24, 311},
+	{0x000f0c1f1, 24, 11309}, {0x000f0c24c, 24, 15978}, {0x000f0c27c, 24, 15979}, {0x000f0c88c, 24, 15980},
+	{0x000f0cba1, 24, 865}, {0x000f0d14f, 24, 15981}, {0x000f0d1a9, 24, 865}, {0x000f0d3a7, 24, 15982},
+	{0x000f0d3e7, 24, 15983}, {0x000f0d657, 24, 15984}, {0x000f0d767, 24, 15985}, {0x000f0da7c, 24, 15986},
+	{0x000f0db30, 24, 15987}, {0x000f0dbe2, 24, 865}, {0x000f0dbf8, 24, 865}, {0x000f0dce2, 24, 865},
+	{0x000f0de71, 24, 15988}, {0x000f0deb9, 24, 15989}, {0x000f0def1, 24, 15990}, {0x000f0e5c3, 24, 15991},
+	{0x000f0e77e, 24, 1842}, {0x000f0ebd0, 24, 9716}, {0x000f0ec39, 24, 15992}, {0x000f0ed1e, 24, 15993},
+	{0x000f0eebb, 24, 15994}, {0x000f0f002, 24, 6482}, {0x000f0f260, 24, 15995}, {0x000f0f336, 24, 11766},
+	{0x000f0f5ae, 24, 15996}, {0x000f0f61c, 24, 865}, {0x000f0f644, 24, 15997}, {0x000f0f669, 24, 15998},
+	{0x000f0f755, 24, 3}, {0x000f0f7b3, 24, 15999}, {0x000f0f842, 24, 16000}, {0x000f0f9f7, 24, 16001},
+	{0x000f0fda0, 24, 16002}, {0x000f0fe6b, 24, 16003}, {0x000f40304, 24, 12494}, {0x000f40321, 24, 16004},
+	{0x000f4032f, 24, 16005}, {0x000f4044c, 24, 16006}, {0x000f40669, 24, 4472}, {0x000f4068d, 24, 2675},
+	{0x000f406a5, 24, 16007}, {0x000f409d8, 24, 12950}, {0x000f40b93, 24, 8397}, {0x000f40e11, 24, 11626},
+	{0x000f40f1b, 24, 1965}, {0x000f40f9b, 24, 16008}, {0x000f41563, 24, 444}, {0x000f415fd, 24, 16009},
+	{0x000f41ba1, 24, 865}, {0x000f41e26, 24, 16010}, {0x000f41f0b, 24, 16011}, {0x000f41fc2, 24, 1965},
+	{0x000f42012, 24, 16012}, {0x000f42833, 24, 16013}, {0x000f42853, 24, 14891}, {0x000f42896, 24, 16014},
+	{0x000f42981, 24, 11712}, {0x000f42c56, 24, 16015}, {0x000f436e1, 24, 16016}, {0x000f437b7, 24, 865},
+	{0x000f43814, 24, 16017}, {0x000f43d80, 24, 16018}, {0x000f43e61, 24, 16019}, {0x000f43e9d, 24, 16020},
+	{0x000f44227, 24, 16021}, {0x000f44450, 24, 16022}, {0x000f445ed, 24, 16023}, {0x000f44713, 24, 16024},
+	{0x000f4472a, 24, 16025}, {0x000f44848, 24, 16026}, {0x000f44e05, 24, 1965}, {0x000f44efd, 24, 16027},
+	{0x000f450eb, 24, 16028}, {0x000f45214, 24, 15716}, {0x000f45433, 24, 177}, {0x000f45595, 24, 16029},
+	{0x000f4559c, 24, 9715}, {0x000f455e0, 24, 16030}, {0x000f4573e, 24, 13853}, {0x000f45842, 24, 16031},
+	{0x000f45f69, 24, 16032}, {0x000f45fd4, 24, 6959}, {0x000f45ff7, 24, 16033}, {0x000f4600d, 24, 16034},
+	{0x000f46349, 24, 16035}, {0x000f4645d, 24, 1934}, {0x000f4672d, 24, 16036}, {0x000f46a92, 24, 12674},
+	{0x000f46abc, 24, 16037}, {0x000f46d04, 24, 4673}, {0x000f46de2, 24, 8716}, {0x000f473ca, 24, 16038},
+	{0x000f47626, 24, 16039}, {0x000f47a4e, 24, 16040}, {0x000f47acc, 24, 16041}, {0x000f47b5e, 24, 12002},
+	{0x000f47f35, 24, 3}, {0x000f48139, 24, 124}, {0x000f48771, 24, 16042}, {0x000f48e09, 24, 5842},
+	{0x000f490ca, 24, 16043}, {0x000f490ea, 24, 16044}, {0x000f49461, 24, 16045}, {0x000f49466, 24, 16046},
+	{0x000f499ac, 24, 16047}, {0x000f49f54, 24, 4482}, {0x000f49ff3, 24, 9715}, {0x000f4a294, 24, 16048},
+	{0x000f4a52a, 24, 16049}, {0x000f4acc1, 24, 3}, {0x000f4b164, 24, 16050}, {0x000f4b381, 24, 16051},
+	{0x000f4b52f, 24, 6820}, {0x000f4b549, 24, 16052}, {0x000f4b6e5, 24, 16053}, {0x000f4b72a, 24, 16054},
+	{0x000f4b7e2, 24, 6482}, {0x000f4b85e, 24, 16055}, {0x000f4b8a7, 24, 8716}, {0x000f4bd7c, 24, 16056},
+	{0x000f4c447, 24, 16057}, {0x000f4c613, 24, 11806}, {0x000f4c6d7, 24, 16058}, {0x000f4c714, 24, 8409},
+	{0x000f4c795, 24, 16059}, {0x000f4cae5, 24, 8292}, {0x000f4cd90, 24, 16060}, {0x000f4ce46, 24, 459},
+	{0x000f4cfe2, 24, 1965}, {0x000f4d032, 24, 16061}, {0x000f4d261, 24, 16062}, {0x000f4d9fb, 24, 4590},
+	{0x000f4dc4d, 24, 16063}, {0x000f4dcda, 24, 16064}, {0x000f4dcf9, 24, 9715}, {0x000f4dd9e, 24, 15476},
+	{0x000f4e142, 24, 16065}, {0x000f4e3fb, 24, 9715}, {0x000f4e6d7, 24, 16066}, {0x000f4e9d4, 24, 3360},
+	{0x000f4ea67, 24, 3}, {0x000f4eb38, 24, 16067}, {0x000f4ec38, 24, 8096}, {0x000f4ee14, 24, 13407},
+	{0x000f4f15a, 24, 865}, {0x000f4f1e1, 24, 11721}, {0x000f4f26d, 24, 11491}, {0x000f4f5a5, 24, 16068},
+	{0x000f4f5e8, 24, 12494}, {0x000f4f646, 24, 16069}, {0x000f4f951, 24, 865}, {0x000f4fc32, 24, 4305},
+	{0x000f4fd2b, 24, 16070}, {0x000f80113, 24, 9715}, {0x000f80278, 24, 11626}, {0x000f80332, 24, 16071},
+	{0x000f8042e, 24, 12950}, {0x000f8051c, 24, 16072}, {0x000f80bbe, 24, 185}, {0x000f80bd0, 24, 16073},
+	{0x000f80cf3, 24, 6967}, {0x000f80d43, 24, 4861}, {0x000f80d60, 24, 124}, {0x000f80dea, 24, 16074},
+	{0x000f80f41, 24, 16075}, {0x000f80f84, 24, 16076}, {0x000f81037, 24, 16077}, {0x000f81547, 24, 11282},
+	{0x000f81654, 24, 4472}, {0x000f81897, 24, 7228}, {0x000f81a67, 24, 8096}, {0x000f81ce5, 24, 16078},
+	{0x000f81d93, 24, 16079}, {0x000f81edf, 24, 865}, {0x000f82285, 24, 16080}, {0x000f82441, 24, 16081},
+	{0x000f82793, 24, 11563}, {0x000f82bc8, 24, 16082}, {0x000f82edb, 24, 16083}, {0x000f82f5b, 24, 16084},
+	{0x000f82fa8, 24, 6482}, {0x000f83094, 24, 16085}, {0x000f8313e, 24, 16086}, {0x000f83376, 24, 16087},
+	{0x000f83553, 24, 16088}, {0x000f835dd, 24, 2348}, {0x000f83d4e, 24, 16089}, {0x000f83dff, 24, 9715},
+	{0x000f842fb, 24, 16090}, {0x000f84360, 24, 99}, {0x000f845ad, 24, 6064}, {0x000f8462d, 24, 16091},
+	{0x000f8472d, 24, 16092}, {0x000f84897, 24, 16093}, {0x000f84a73, 24, 16094}, {0x000f84a7f, 24, 16095},
+	{0x000f84abf, 24, 9262}, {0x000f84f57, 24, 1965}, {0x000f85063, 24, 16096}, {0x000f8516d, 24, 16097},
+	{0x000f852df, 24, 16098}, {0x000f854af, 24, 5369}, {0x000f8572e, 24, 16099}, {0x000f85b9c, 24, 16100},
+	{0x000f85bc9, 24, 16101}, {0x000f85c45, 24, 16102}, {0x000f85f2a, 24, 5842}, {0x000f862aa, 24, 16103},
+	{0x000f86601, 24, 16104}, {0x000f866d1, 24, 4861}, {0x000f866f2, 24, 3}, {0x000f86971, 24, 16105},
+	{0x000f86ecf, 24, 16106}, {0x000f871fe, 24, 16107}, {0x000f872ea, 24, 1965}, {0x000f87394, 24, 9978},
+	{0x000f8769b, 24, 16108}, {0x000f87aef, 24, 16109}, {0x000f87b62, 24, 16110}, {0x000f87b7a, 24, 185},
+	{0x000f87b8c, 24, 16111}, {0x000f8811a, 24, 16112}, {0x000f88479, 24, 16113}, {0x000f884f2, 24, 1842},
+	{0x000f88c1c, 24, 16114}, {0x000f88def, 24, 16115}, {0x000f88e85, 24, 16116}, {0x000f88fca, 24, 16117},
+	{0x000f8912a, 24, 16118}, {0x000f893f3, 24, 16119}, {0x000f89550, 24, 16120}, {0x000f895c7, 24, 12997},
+	{0x000f897cf, 24, 16121}, {0x000f89955, 24, 16122}, {0x000f89d0d, 24, 16123}, {0x000f89fb8, 24, 16124},
+	{0x000f8a03d, 24, 16125}, {0x000f8a2b4, 24, 16126}, {0x000f8a45f, 24, 11553}, {0x000f8a963, 24, 6282},
+	{0x000f8a9d0, 24, 6967}, {0x000f8a9de, 24, 16127}, {0x000f8aa8a, 24, 16128}, {0x000f8ac6d, 24, 16129},
+	{0x000f8b156, 24, 2822}, {0x000f8b2f3, 24, 16130}, {0x000f8b599, 24, 16131}, {0x000f8bc12, 24, 2822},
+	{0x000f8bc41, 24, 16132}, {0x000f8bf09, 24, 9262}, {0x000f8c001, 24, 5553}, {0x000f8c091, 24, 16133},
+	{0x000f8c288, 24, 1965}, {0x000f8c397, 24, 16134}, {0x000f8c678, 24, 16135}, {0x000f8c96c, 24, 11300},
+	{0x000f8cfc5, 24, 11889}, {0x000f8d0ac, 24, 7216}, {0x000f8d0bd, 24, 1842}, {0x000f8d111, 24, 8096},
+	{0x000f8d3a9, 24, 16136}, {0x000f8d462, 24, 16137}, {0x000f8d756, 24, 16138}, {0x000f8d7bf, 24, 16139},
+	{0x000f8dadf, 24, 16140}, {0x000f8dae2, 24, 16141}, {0x000f8daf4, 24, 16142}, {0x000f8db4c, 24, 16143},
+	{0x000f8db7f, 24, 2176}, {0x000f8db88, 24, 2822}, {0x000f8dc7a, 24, 16144}, {0x000f8dfa8, 24, 5972},
+	{0x000f8e079, 24, 11721}, {0x000f8e4fb, 24, 3726}, {0x000f8e7b5, 24, 16145}, {0x000f8e811, 24, 9262},
+	{0x000f8e903, 24, 11768}, {0x000f8e968, 24, 16146}, {0x000f8ea0a, 24, 16147}, {0x000f8eda5, 24, 185},
+	{0x000f8f005, 24, 16148}, {0x000f8f014, 24, 16149}, {0x000f8f082, 24, 16150}, {0x000f8f1b6, 24, 11721},
+	{0x000f8f25a, 24, 16151}, {0x000f8f464, 24, 16152}, {0x000f8f7d3, 24, 16153}, {0x000f8f7ff, 24, 16154},
+	{0x000f8fb2f, 24, 16155}, {0x000f8fe5c, 24, 16156}, {0x000f8fea8, 24, 16157}, {0x000f8ff5f, 24, 16158},
+	{0x000fc0012, 24, 12389}, {0x000fc019e, 24, 16159}, {0x000fc01cd, 24, 16160}, {0x000fc0647, 24, 16161},
+	{0x000fc07a0, 24, 16162}, {0x000fc0877, 24, 16163}, {0x000fc09d8, 24, 16164}, {0x000fc09f6, 24, 16165},
+	{0x000fc0a81, 24, 1217}, {0x000fc0fe6, 24, 7216}, {0x000fc10bd, 24, 16166}, {0x000fc1186, 24, 16167},
+	{0x000fc1349, 24, 16168}, {0x000fc15b4, 24, 11475}, {0x000fc1607, 24, 16169}, {0x000fc1794, 24, 16170},
+	{0x000fc1910, 24, 1842}, {0x000fc19d0, 24, 16171}, {0x000fc1bff, 24, 16172}, {0x000fc1d59, 24, 16173},
+	{0x000fc1d84, 24, 16174}, {0x000fc1e16, 24, 16175}, {0x000fc1f19, 24, 13061}, {0x000fc1fc0, 24, 16176},
+	{0x000fc229c, 24, 16177}, {0x000fc2325, 24, 16178}, {0x000fc253f, 24, 865}, {0x000fc27a2, 24, 16179},
+	{0x000fc2a54, 24, 16180}, {0x000fc2e2d, 24, 16181}, {0x000fc2f40, 24, 13593}, {0x000fc3288, 24, 16182},
+	{0x000fc3598, 24, 16183}, {0x000fc35e6, 24, 16184}, {0x000fc3d93, 24, 14059}, {0x000fc3fab, 24, 16185},
+	{0x000fc4463, 24, 16186}, {0x000fc4499, 24, 16187}, {0x000fc455f, 24, 16188}, {0x000fc48ef, 24, 9262},
+	{0x000fc4ae9, 24, 6562}, {0x000fc4b1c, 24, 16189}, {0x000fc4bbc, 24, 4030}, {0x000fc4dd4, 24, 3976},
+	{0x000fc5090, 24, 16190}, {0x000fc52ce, 24, 16191}, {0x000fc58fa, 24, 16192}, {0x000fc5b24, 24, 16193},
+	{0x000fc5b26, 24, 16194}, {0x000fc5b39, 24, 1965}, {0x000fc6018, 24, 16195}, {0x000fc6198, 24, 16196},
+	{0x000fc626e, 24, 16197}, {0x000fc62b9, 24, 16198}, {0x000fc683e, 24, 16199}, {0x000fc6c31, 24, 16200},
+	{0x000fc6dc0, 24, 16201}, {0x000fc6fb7, 24, 6621}, {0x000fc7516, 24, 11768}, {0x000fc75e6, 24, 6224},
+	{0x000fc790b, 24, 16202}, {0x000fc7ce7, 24, 16203}, {0x000fc8329, 24, 16204}, {0x000fc8399, 24, 11282},
+	{0x000fc8b97, 24, 11920}, {0x000fc8e7e, 24, 6621}, {0x000fc8f90, 24, 1842}, {0x000fc8fc4, 24, 16205},
+	{0x000fc923b, 24, 5842}, {0x000fc946c, 24, 16206}, {0x000fc94e3, 24, 12146}, {0x000fc9947, 24, 1965},
+	{0x000fc9afa, 24, 16207}, {0x000fc9fae, 24, 16208}, {0x000fc9fe1, 24, 16209}, {0x000fca13e, 24, 4482},
+	{0x000fca22a, 24, 16210}, {0x000fca841, 24, 11282}, {0x000fca9b0, 24, 16211}, {0x000fcaa14, 24, 5352},
+	{0x000fcad0f, 24, 16212}, {0x000fcaf6a, 24, 16213}, {0x000fcafac, 24, 16214}, {0x000fcb0c4, 24, 14240},
+	{0x000fcb4e6, 24, 4244}, {0x000fcb698, 24, 5973}, {0x000fcbba1, 24, 16215}, {0x000fcc23d, 24, 1002},
+	{0x000fcc2de, 24, 3430}, {0x000fcc734, 24, 1842}, {0x000fcc897, 24, 5972}, {0x000fccce4, 24, 16216},
+	{0x000fccf62, 24, 564}, {0x000fcd4f2, 24, 16217}, {0x000fcd4f6, 24, 16218}, {0x000fcd5d9, 24, 16219},
+	{0x000fcd6bd, 24, 16220}, {0x000fcd733, 24, 11766}, {0x000fcd817, 24, 16221}, {0x000fcdb96, 24, 16222},
+	{0x000fcdbb3, 24, 3430}, {0x000fcdc4a, 24, 16223}, {0x000fcdd55, 24, 16224}, {0x000fce186, 24, 16225},
+	{0x000fce192, 24, 16226}, {0x000fce1d9, 24, 16227}, {0x000fce1fb, 24, 16228}, {0x000fce23f, 24, 16229},
+	{0x000fce33c, 24, 9262}, {0x000fce557, 24, 5842}, {0x000fce892, 24, 16230}, {0x000fce998, 24, 865},
+	{0x000fcedb9, 24, 16231}, {0x000fcf152, 24, 311}, {0x000fcf1cd, 24, 16232}, {0x000fcf528, 24, 4551},
+	{0x000fcf647, 24, 11300}, {0x000fcf8ae, 24, 4472}, {0x000fcf8b7, 24, 16233}, {0x000fcfaf7, 24, 16234},
+	{0x000fcfbfb, 24, 3}, {0x000fcfe77, 24, 16235}, {0x000fcffaa, 24, 16236},
+}
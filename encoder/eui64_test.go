@@ -2,6 +2,7 @@ package encoder
 
 import (
 	"bytes"
+	"math/big"
 	"net"
 	"strings"
 	"testing"
@@ -12,11 +13,10 @@ func TestEUI64Encode(t *testing.T) {
 		"fe80::216:3eff:fe83:f111":  "00-16-3e-83-f1-11",
 		"fe80::5074:f2ff:feb1:a87f": "52-74-f2-b1-a8-7f",
 		"fe80::608b:ccff:fe6b:82a9": "62-8b-cc-6b-82-a9",
-		"::1": "",
+		"::1": "host-1",
 	}
 
 	e := NewEUI64()
-	e.ParseOUI("./testdata/oui.txt")
 
 	for test, want := range tests {
 		got, err := e.Encode(net.ParseIP(test))
@@ -78,7 +78,6 @@ func TestEUI64Vendors(t *testing.T) {
 	}
 
 	e := NewEUI64()
-	e.ParseOUI("./testdata/oui.txt")
 
 	for test, want := range tests {
 		got := e.Vendor(test)
@@ -89,3 +88,208 @@ func TestEUI64Vendors(t *testing.T) {
 		}
 	}
 }
+
+func TestEUI64Classes(t *testing.T) {
+	tests := map[string]string{
+		"fe80::216:3eff:fe83:f111":      "xensource-00-16-3e-83-f1-11",
+		"fe80::200:5efe:8.8.8.8":        "isatap-u-8-8-8-8",
+		"fe80::5efe:8.8.4.4":            "isatap-8-8-4-4",
+		"fe80::200:5efe:172.23.40.5":    "isatap-u-172-23-40-5",
+		"fe80::5efe:172.23.40.5":        "isatap-172-23-40-5",
+		"2001:470:d510:40::ac17:2805":   "ipv4-172-23-40-5",
+		"2001:470:d510:40::53":          "host-53",
+		"2001:470:d510:40:a1b2::c3d4:1": "rnd-k6p00063qg002",
+	}
+
+	e := NewEUI64()
+	if err := e.Config(map[string]interface{}{
+		"isatap": map[string]interface{}{"template": map[string]interface{}{"format": "{octet1}-{octet2}-{octet3}-{octet4}"}},
+		"ipv4":   map[interface{}]interface{}{"template": map[interface{}]interface{}{"format": "{octet1}-{octet2}-{octet3}-{octet4}"}},
+		"manual": map[string]interface{}{"template": map[string]interface{}{"format": "{offset:hex}"}},
+		"random": "base32",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for test, want := range tests {
+		got, err := e.Encode(net.ParseIP(test))
+		if err != nil {
+			t.Error(err)
+			continue
+		} else if got != want {
+			t.Errorf("got %q, want %q for %q", got, want, test)
+			continue
+		}
+		t.Logf("test %q encoded to %q", test, got)
+
+		data, err := e.Decode(got)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if ip := net.ParseIP(test); !bytes.Equal(data, ip[8:]) {
+			t.Errorf("got %x, want %x decoding %q", data, []byte(ip[8:]), got)
+		}
+	}
+}
+
+func TestEUI64ClassOffsets(t *testing.T) {
+	// The auto backend encodes the offset in the network, without leading
+	// zero octets
+	tests := map[string]string{
+		"2001:470:d510:40::53":         "host-53",
+		"2001:470:d510:40::c000:201":   "ipv4-192-0-2-1",
+		"2001:470:d510:40:0:5efe::808": "isatap-0-0-8-8",
+		"2001:470:d510:40::":           "host-0",
+	}
+
+	e := NewEUI64()
+	if err := e.Config(map[string]interface{}{
+		"isatap": map[string]interface{}{"template": map[string]interface{}{"format": "{octet1}-{octet2}-{octet3}-{octet4}"}},
+		"ipv4":   map[string]interface{}{"template": map[string]interface{}{"format": "{octet1}-{octet2}-{octet3}-{octet4}"}},
+		"manual": map[string]interface{}{"template": map[string]interface{}{"format": "{offset:hex}"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, network, _ := net.ParseCIDR("2001:470:d510:40::/64")
+	for test, want := range tests {
+		ip := new(big.Int).SetBytes(net.ParseIP(test))
+		offset := ip.Xor(ip, new(big.Int).SetBytes(network.IP)).Bytes()
+		got, err := e.Encode(offset)
+		if err != nil {
+			t.Errorf("%q (%x): %v", test, offset, err)
+		} else if got != want {
+			t.Errorf("got %q, want %q for %q (%x)", got, want, test, offset)
+		} else {
+			t.Logf("test %q (%x) encoded to %q", test, offset, got)
+		}
+	}
+}
+
+func TestEUI64ClassTags(t *testing.T) {
+	e := NewEUI64()
+	if err := e.Config(map[string]interface{}{
+		"random": "base32",
+		"tags":   map[string]interface{}{"random": "priv"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := e.Encode(net.ParseIP("2001:db8::a1b2:0:c3d4:1"))
+	if err != nil {
+		t.Fatal(err)
+	} else if !strings.HasPrefix(got, "priv-") {
+		t.Errorf("got %q, want priv- prefix", got)
+	}
+
+	// Unconfigured classes use their default sub encoder
+	if got, err = e.Encode(net.ParseIP("2001:db8::1")); err != nil {
+		t.Fatal(err)
+	} else if got != "host-1" {
+		t.Errorf("got %q, want %q", got, "host-1")
+	}
+	if data, err := e.Decode(got); err != nil {
+		t.Error(err)
+	} else if want := net.ParseIP("2001:db8::1")[8:]; !bytes.Equal(data, want) {
+		t.Errorf("got %x, want %x decoding %q", data, []byte(want), got)
+	}
+}
+
+func TestEUI64VendorRegistries(t *testing.T) {
+	tests := map[string]string{
+		"00163e000000": "xensource",
+		"f8b568100000": "whizpace-pte",
+		"f8b568a12345": "sinclair-research",
+		"f8b568f00000": "ieee-registration-authority",
+		"70b3d5123456": "acme-lighting",
+		"70b3d5124000": "ieee-registration-authority",
+		"0a3e4c000001": "mazenet",
+		"f8b568":       "ieee-registration-authority",
+		"ffffff000000": "unknown",
+	}
+
+	for _, files := range [][]interface{}{
+		{"./testdata/oui.csv", "./testdata/mam.csv", "./testdata/oui36.csv", "./testdata/cid.csv"},
+		{"./testdata/oui.csv", "./testdata/mam.txt", "./testdata/oui36.csv", "./testdata/cid.csv"},
+	} {
+		e := NewEUI64()
+		if err := e.Config(map[string]interface{}{"oui": files}); err != nil {
+			t.Fatal(err)
+		}
+
+		for test, want := range tests {
+			got := e.Vendor(test)
+			if got != want {
+				t.Errorf("got %q, want %q for %q", got, want, test)
+			} else {
+				t.Logf("test %q decoded to %q", test, got)
+			}
+		}
+	}
+}
+
+func TestEUI64ConfigError(t *testing.T) {
+	e := NewEUI64()
+	if err := e.Config(map[string]interface{}{"oui": "./testdata/missing.txt"}); err == nil {
+		t.Error("expected error for missing OUI file")
+	}
+}
+
+func TestEUI64VendorOverride(t *testing.T) {
+	tests := map[string]string{
+		"00163e": "citrix",
+		"00003c": "auspex",
+	}
+
+	e := NewEUI64()
+	if err := e.ParseOUI("./testdata/override.csv"); err != nil {
+		t.Fatal(err)
+	}
+
+	for test, want := range tests {
+		got := e.Vendor(test)
+		if got != want {
+			t.Errorf("got %q, want %q for %q", got, want, test)
+		} else {
+			t.Logf("test %q decoded to %q", test, got)
+		}
+	}
+}
+
+func TestEUI64VendorNames(t *testing.T) {
+	tests := []struct {
+		opt  map[string]interface{}
+		oui  string
+		want string
+	}{
+		{nil, "000001", "hewlett-packard"},
+		{map[string]interface{}{"aliases": map[interface{}]interface{}{"hewlett-packard": "hp"}}, "000001", "hp"},
+		{nil, "000002", "societe-generale-electronique"},
+		{map[string]interface{}{"idn": "strip"}, "000002", "socit-gnrale-lectronique"},
+		{map[string]interface{}{"idn": "punycode"}, "000002", "xn--socit-gnrale-lectronique-efcbcbf"},
+		{map[string]interface{}{"idn": "punycode", "maxlength": 20}, "000002", "xn--socit-esab"},
+		{nil, "000003", "shanghai-baud-data"},
+		{map[string]interface{}{"stopwords": []interface{}{"Data", "baud"}}, "000003", "shanghai"},
+		{map[string]interface{}{"maxlength": 13}, "000003", "shanghai-baud"},
+		{map[string]interface{}{"maxlength": 5}, "000003", "shang"},
+		{map[string]interface{}{"stopwords": []interface{}{"networking", "solutions"}}, "000004", "mazenet"},
+	}
+
+	for _, test := range tests {
+		e := NewEUI64()
+		if err := e.Config(test.opt); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.ParseOUI("./testdata/vendors.csv"); err != nil {
+			t.Fatal(err)
+		}
+
+		got := e.Vendor(test.oui)
+		if got != test.want {
+			t.Errorf("got %q, want %q for %q with %v", got, test.want, test.oui, test.opt)
+		} else {
+			t.Logf("test %q decoded to %q", test.oui, got)
+		}
+	}
+}
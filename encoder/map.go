@@ -0,0 +1,273 @@
+package encoder
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Map encodes offsets using a static table of offset to label mappings, read
+// from an /etc/hosts style or CSV file. The file is reloaded when it changes.
+type Map struct {
+	filename string
+	format   string
+	network  *net.IPNet
+	reload   time.Duration
+
+	mu      sync.Mutex
+	checked time.Time
+	modTime time.Time
+	size    int64
+	labels  map[string]string
+	offsets map[string]*big.Int
+}
+
+func NewMap() *Map {
+	return &Map{
+		reload:  time.Second,
+		labels:  map[string]string{},
+		offsets: map[string]*big.Int{},
+	}
+}
+
+func (e *Map) Config(opt map[string]interface{}) (err error) {
+	for k, v := range opt {
+		switch k {
+		case "file":
+			if e.filename, err = optString(k, v); err != nil {
+				return
+			}
+		case "format":
+			if e.format, err = optString(k, v); err != nil {
+				return
+			}
+			e.format = strings.ToLower(e.format)
+			if e.format != "hosts" && e.format != "csv" {
+				return fmt.Errorf("Unknown map format %q", e.format)
+			}
+		case "network":
+			var s string
+			if s, err = optString(k, v); err != nil {
+				return
+			}
+			if _, e.network, err = net.ParseCIDR(s); err != nil {
+				return
+			}
+		case "reload":
+			if e.reload, err = optDuration(k, v); err != nil {
+				return
+			}
+		default:
+			return fmt.Errorf("Unknown map option %q", k)
+		}
+	}
+
+	if e.filename == "" {
+		return errors.New("map: no file configured")
+	}
+	if e.format == "" {
+		e.format = "hosts"
+		if strings.ToLower(filepath.Ext(e.filename)) == ".csv" {
+			e.format = "csv"
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.load()
+}
+
+func (e *Map) Encode(src []byte) (out string, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.check()
+
+	key := new(big.Int).SetBytes(src).Text(16)
+	if out = e.labels[key]; out == "" {
+		return "", fmt.Errorf("No mapping for offset 0x%s", key)
+	}
+	return
+}
+
+func (e *Map) Decode(src string) (out []byte, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.check()
+
+	offset, found := e.offsets[strings.ToLower(src)]
+	if !found {
+		return nil, fmt.Errorf("No mapping for label %q", src)
+	}
+	return offset.Bytes(), nil
+}
+
+// check reloads the mapping file if it has changed since it was last read.
+func (e *Map) check() {
+	if time.Since(e.checked) < e.reload {
+		return
+	}
+	e.checked = time.Now()
+
+	i, err := os.Stat(e.filename)
+	if err != nil {
+		logger.Warn("checking mapping", "encoder", "map", "error", err)
+		return
+	}
+	if i.ModTime().Equal(e.modTime) && i.Size() == e.size {
+		return
+	}
+	if err = e.load(); err != nil {
+		logger.Error("reloading mapping failed", "encoder", "map", "file", e.filename, "error", err)
+	}
+}
+
+func (e *Map) load() error {
+	logger.Info("parsing mapping", "encoder", "map", "file", e.filename)
+
+	f, err := os.Open(e.filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	i, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	labels := map[string]string{}
+	offsets := map[string]*big.Int{}
+	add := func(line int, addr string, names []string) error {
+		offset, err := e.parseOffset(addr)
+		if err != nil {
+			return fmt.Errorf("%s:%d: %v", e.filename, line, err)
+		}
+		key := offset.Text(16)
+		for _, name := range names {
+			label := strings.ToLower(strings.SplitN(name, ".", 2)[0])
+			if label == "" {
+				continue
+			}
+			if _, found := labels[key]; !found {
+				labels[key] = label
+			}
+			if _, found := offsets[label]; !found {
+				offsets[label] = offset
+			}
+		}
+		return nil
+	}
+
+	switch e.format {
+	case "csv":
+		err = parseMapCSV(f, add)
+	default:
+		err = parseMapHosts(f, add)
+	}
+	if err != nil {
+		return err
+	}
+
+	e.labels = labels
+	e.offsets = offsets
+	e.modTime = i.ModTime()
+	e.size = i.Size()
+	e.checked = time.Now()
+	return nil
+}
+
+// parseOffset parses either an integer offset or an address within the
+// configured network.
+func (e *Map) parseOffset(s string) (*big.Int, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		if e.network == nil {
+			return nil, fmt.Errorf("address %s requires a network option", s)
+		}
+		if !e.network.Contains(ip) {
+			return nil, fmt.Errorf("address %s not in network %s", s, e.network)
+		}
+		if ip4 := ip.To4(); ip4 != nil && e.network.IP.To4() != nil {
+			ip = ip4
+		}
+		offset := new(big.Int).SetBytes(ip)
+		return offset.Xor(offset, new(big.Int).SetBytes(e.network.IP)), nil
+	}
+
+	offset, ok := new(big.Int).SetString(s, 0)
+	if !ok || offset.Sign() < 0 {
+		return nil, fmt.Errorf("invalid offset %q", s)
+	}
+
+	// Offsets outside of the network can never be served
+	bits := net.IPv6len * 8
+	if e.network != nil {
+		ones, size := e.network.Mask.Size()
+		bits = size - ones
+	}
+	if offset.BitLen() > bits {
+		if e.network != nil {
+			return nil, fmt.Errorf("offset %s out of range for network %s", s, e.network)
+		}
+		return nil, fmt.Errorf("offset %s out of range", s)
+	}
+	return offset, nil
+}
+
+// 10.0.0.1  db1 db1.example.com
+// 0x12      web3
+func parseMapHosts(r io.Reader, add func(int, string, []string) error) error {
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.SplitN(s.Text(), "#", 2)[0]
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		if err := add(n, fields[0], fields[1:]); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
+
+// offset,label
+// 10.0.0.1,db1
+func parseMapCSV(r io.Reader, add func(int, string, []string) error) error {
+	c := csv.NewReader(r)
+	c.Comment = '#'
+	c.FieldsPerRecord = -1
+	c.TrimLeadingSpace = true
+
+	for {
+		record, err := c.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(record) < 2 {
+			continue
+		}
+		n, _ := c.FieldPos(0)
+		if err = add(n, record[0], record[1:2]); err != nil {
+			if n == 1 {
+				// Header line
+				continue
+			}
+			return err
+		}
+	}
+}
+
+// Interface completeness validation
+var _ Encoder = (*Map)(nil)
@@ -0,0 +1,157 @@
+package encoder
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMapEncode(t *testing.T) {
+	tests := map[string]map[int64]string{
+		"./testdata/hosts": {
+			1:    "gw1",
+			10:   "db1",
+			11:   "db2",
+			0x20: "web3",
+			12:   "",
+		},
+		"./testdata/hosts.csv": {
+			1:  "gw1",
+			10: "db1",
+			11: "db2",
+			12: "",
+		},
+	}
+
+	for filename, tests := range tests {
+		e := NewMap()
+		if err := e.Config(map[string]interface{}{
+			"file":    filename,
+			"network": "172.23.40.0/24",
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		for test, want := range tests {
+			got, err := e.Encode(big.NewInt(test).Bytes())
+			if want == "" {
+				if err == nil {
+					t.Errorf("got %q, want error", got)
+				}
+			} else if err != nil {
+				t.Error(err)
+			} else if got != want {
+				t.Errorf("got %q, want %q for %d in %s", got, want, test, filename)
+			} else {
+				t.Logf("test %d encoded to %q", test, got)
+			}
+		}
+	}
+}
+
+func TestMapDecode(t *testing.T) {
+	tests := map[string]int64{
+		"gw1":  1,
+		"GW1":  1,
+		"db2":  11,
+		"web3": 0x20,
+		"db9":  -1,
+	}
+
+	e := NewMap()
+	if err := e.Config(map[string]interface{}{
+		"file":    "./testdata/hosts",
+		"network": "172.23.40.0/24",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for test, want := range tests {
+		got, err := e.Decode(test)
+		if want < 0 {
+			if err == nil {
+				t.Errorf("got %v, want error for %q", got, test)
+			}
+		} else if err != nil {
+			t.Error(err)
+		} else if n := new(big.Int).SetBytes(got).Int64(); n != want {
+			t.Errorf("got %d, want %d for %q", n, want, test)
+		} else {
+			t.Logf("test %q decoded to %d", test, n)
+		}
+	}
+}
+
+func TestMapReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "map")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "hosts")
+	if err = ioutil.WriteFile(filename, []byte("1 db1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	e := NewMap()
+	if err = e.Config(map[string]interface{}{
+		"file":   filename,
+		"reload": "0s",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := e.Encode([]byte{1}); got != "db1" {
+		t.Fatalf("got %q, want %q", got, "db1")
+	}
+
+	if err = ioutil.WriteFile(filename, []byte("1 db2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err = os.Chtimes(filename, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := e.Encode([]byte{1}); got != "db2" {
+		t.Fatalf("got %q, want %q after reload", got, "db2")
+	}
+}
+
+func TestMapOffsetRange(t *testing.T) {
+	dir, err := ioutil.TempDir("", "map")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		Name, Data, Network, Want string
+	}{
+		{"hosts", "1 db1\n0xff db2\n", "172.23.40.0/24", ""},
+		{"hosts", "# offsets\n1 db1\n256 db2\n", "172.23.40.0/24", "hosts:3: offset 256 out of range for network 172.23.40.0/24"},
+		{"hosts.csv", "offset,label\n1,db1\n0x100,db2\n", "172.23.40.0/24", "hosts.csv:3: offset 0x100 out of range"},
+		{"hosts", "0x1ffffffffffffffffffffffffffffffff db1\n", "", "hosts:1: offset 0x1ffffffffffffffffffffffffffffffff out of range"},
+	}
+	for _, test := range tests {
+		filename := filepath.Join(dir, test.Name)
+		if err = ioutil.WriteFile(filename, []byte(test.Data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		opt := map[string]interface{}{"file": filename}
+		if test.Network != "" {
+			opt["network"] = test.Network
+		}
+		err = NewMap().Config(opt)
+		if test.Want == "" {
+			if err != nil {
+				t.Errorf("%q: %v", test.Data, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), test.Want) {
+			t.Errorf("%q: expected error %q, got %v", test.Data, test.Want, err)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package encoder
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The built in registry is generated from the IEEE MA-L registry, which is
+// downloaded by oui2go; pass local copies instead to generate offline.
+//
+//go:generate go run ../oui2go -o eui64_oui.go https://standards-oui.ieee.org/oui/oui.txt
+
+// OUI is an assignment from one of the IEEE registries; MA-L and CID
+// assignments are 24 bits, MA-M 28 bits and MA-S (and the former IAB) 36 bits.
+type OUI struct {
+	Prefix       uint64
+	Bits         int
+	Organization string
+}
+
+// Prefix lengths in the IEEE registries, longest first
+var ouiPrefixLengths = []int{36, 28, 24}
+
+var (
+	// 00-00-00   (hex)		XEROX CORPORATION
+	ouiHexLine = regexp.MustCompile(`^\s*([0-9A-Fa-f]{2})-([0-9A-Fa-f]{2})-([0-9A-Fa-f]{2})\s+\(hex\)\s+(.*)$`)
+	// 000000-0FFFFF     (base 16)		XEROX CORPORATION
+	ouiBase16Line = regexp.MustCompile(`^\s*([0-9A-Fa-f]{6})-([0-9A-Fa-f]{6})\s+\(base 16\)`)
+)
+
+// ReadOUI reads IEEE registry assignments, either from the text (oui.txt,
+// mam.txt, oui36.txt) or the CSV (oui.csv, mam.csv, oui36.csv, cid.csv)
+// exports.
+func ReadOUI(r io.Reader) ([]OUI, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("Registry,")) {
+		return readOUICSV(bytes.NewReader(data))
+	}
+	return readOUIText(bytes.NewReader(data))
+}
+
+// Registry,Assignment,Organization Name,Organization Address
+// MA-L,00163E,"Xensource, Inc.",...
+func readOUICSV(r io.Reader) (ouis []OUI, err error) {
+	c := csv.NewReader(r)
+	c.FieldsPerRecord = -1
+	c.LazyQuotes = true
+
+	// Header
+	if _, err = c.Read(); err != nil {
+		return
+	}
+
+	for {
+		record, err := c.Read()
+		if err == io.EOF {
+			return ouis, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 3 {
+			continue
+		}
+
+		assignment := strings.TrimSpace(record[1])
+		prefix, err := strconv.ParseUint(assignment, 16, 48)
+		if err != nil {
+			continue
+		}
+		ouis = append(ouis, OUI{
+			Prefix:       prefix,
+			Bits:         len(assignment) * 4,
+			Organization: strings.TrimSpace(record[2]),
+		})
+	}
+}
+
+func readOUIText(r io.Reader) (ouis []OUI, err error) {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		if m := ouiHexLine.FindStringSubmatch(line); m != nil {
+			prefix, _ := strconv.ParseUint(m[1]+m[2]+m[3], 16, 24)
+			ouis = append(ouis, OUI{
+				Prefix:       prefix,
+				Bits:         24,
+				Organization: strings.TrimSpace(m[4]),
+			})
+		} else if m := ouiBase16Line.FindStringSubmatch(line); m != nil && len(ouis) > 0 {
+			// MA-M and MA-S blocks list the assigned range below the
+			// 24 bit prefix, narrow down the last assignment
+			lo, _ := strconv.ParseUint(m[1], 16, 24)
+			hi, _ := strconv.ParseUint(m[2], 16, 24)
+			free := 0
+			for (lo^hi)>>uint(free) != 0 {
+				free++
+			}
+			last := &ouis[len(ouis)-1]
+			if last.Bits == 24 && free < 24 {
+				last.Prefix = ((last.Prefix << 24) | lo) >> uint(free)
+				last.Bits = 48 - free
+			}
+		}
+	}
+	return ouis, s.Err()
+}
+
+// ouiRegistry maps MAC address prefixes to organizations, by prefix length.
+type ouiRegistry map[int]map[uint64]string
+
+func (r ouiRegistry) add(oui OUI, org string) {
+	if r[oui.Bits] == nil {
+		r[oui.Bits] = map[uint64]string{}
+	}
+	r[oui.Bits][oui.Prefix] = org
+}
+
+func (r ouiRegistry) get(prefix uint64, bits int) (string, bool) {
+	v, found := r[bits][prefix]
+	return v, found
+}
+
+// ouiEntry is an assignment in the compiled in registry, see eui64_oui.go.
+type ouiEntry struct {
+	prefix uint64
+	bits   uint8
+	name   uint32
+}
+
+// builtinOUI looks up the organization for an assignment in the compiled in
+// registry, which is sorted by descending prefix length and prefix.
+func builtinOUI(prefix uint64, bits int) string {
+	i := sort.Search(len(ouiBuiltin), func(i int) bool {
+		e := ouiBuiltin[i]
+		if int(e.bits) != bits {
+			return int(e.bits) < bits
+		}
+		return e.prefix >= prefix
+	})
+	if i < len(ouiBuiltin) && int(ouiBuiltin[i].bits) == bits && ouiBuiltin[i].prefix == prefix {
+		return ouiBuiltinNames[ouiBuiltin[i].name]
+	}
+	return ""
+}
@@ -0,0 +1,378 @@
+package encoder
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Template composes names from named address fields, such as
+// "{octet3}-{octet4}" or "{vendor}-{mac}". Fields are formatted from the
+// offset passed to Encode, and a parser is generated from the same template,
+// so names can be decoded as long as the template contains enough bits. The
+// bits default to the trailing bits covered by the fields.
+type Template struct {
+	format string
+	width  int
+	bits   int
+	fields []*templateField
+	parser *regexp.Regexp
+	eui64  *EUI64
+}
+
+type templateField struct {
+	name  string
+	verb  string
+	index int
+}
+
+var templateFieldPatterns = map[string]map[string]string{
+	"octet": {
+		"":    `[0-9]{1,3}`,
+		"dec": `[0-9]{1,3}`,
+		"hex": `[0-9a-f]{1,2}`,
+	},
+	"hextet": {
+		"":    `[0-9a-f]{1,4}`,
+		"hex": `[0-9a-f]{1,4}`,
+		"dec": `[0-9]{1,5}`,
+	},
+	"mac": {
+		"":    `[0-9a-f]{2}(?:-[0-9a-f]{2}){5}`,
+		"hex": `[0-9a-f]{12}`,
+	},
+	"vendor": {
+		"": `[a-z0-9-]+?`,
+	},
+	"offset": {
+		"":       `[0-9a-v]+`,
+		"base32": `[0-9a-v]+`,
+		"hex":    `[0-9a-f]+`,
+		"dec":    `[0-9]+`,
+	},
+}
+
+func NewTemplate() *Template {
+	return &Template{eui64: NewEUI64()}
+}
+
+func (e *Template) Config(opt map[string]interface{}) (err error) {
+	for k, v := range opt {
+		switch k {
+		case "format":
+			if e.format, err = optString(k, v); err != nil {
+				return
+			}
+		case "width":
+			if e.width, err = optInt(k, v); err != nil {
+				return
+			}
+			if e.width != 4 && e.width != 16 {
+				return fmt.Errorf("Template width must be 4 or 16, got %d", e.width)
+			}
+		case "bits":
+			if e.bits, err = optInt(k, v); err != nil {
+				return
+			}
+		case "oui":
+			if err = e.eui64.Config(map[string]interface{}{k: v}); err != nil {
+				return
+			}
+		default:
+			// Vendor name options are passed to the vendor lookup
+			handled, err := e.eui64.names.config(k, v)
+			if !handled {
+				return fmt.Errorf("Unknown template option %q", k)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return e.compile()
+}
+
+// compile parses the format string into fields and builds the parser.
+func (e *Template) compile() error {
+	if e.format == "" {
+		return errors.New("template: no format configured")
+	}
+
+	var (
+		format  = e.format
+		pattern = []string{"^(?i)"}
+		width   = 4
+	)
+	e.fields = nil
+	for len(format) > 0 {
+		i := strings.IndexByte(format, '{')
+		if i < 0 {
+			pattern = append(pattern, regexp.QuoteMeta(format))
+			break
+		}
+		pattern = append(pattern, regexp.QuoteMeta(format[:i]))
+		format = format[i+1:]
+
+		j := strings.IndexByte(format, '}')
+		if j < 0 {
+			return fmt.Errorf("template: unterminated field in %q", e.format)
+		}
+		f, err := parseTemplateField(format[:j])
+		if err != nil {
+			return err
+		}
+		if f.name != "octet" && f.name != "offset" {
+			width = 16
+		}
+		pattern = append(pattern, "("+templateFieldPatterns[f.name][f.verb]+")")
+		e.fields = append(e.fields, f)
+		format = format[j+1:]
+	}
+	pattern = append(pattern, "$")
+
+	if e.width == 0 {
+		e.width = width
+	} else if e.width < width {
+		return fmt.Errorf("template: %q requires a width of %d", e.format, width)
+	}
+
+	// The fields recover the bits of the trailing bytes they cover
+	covered := make([]bool, e.width)
+	for _, f := range e.fields {
+		switch f.name {
+		case "octet":
+			covered[e.width-4+f.index-1] = true
+		case "hextet":
+			covered[(f.index-1)*2] = true
+			covered[(f.index-1)*2+1] = true
+		case "mac":
+			for i := 8; i < 16; i++ {
+				covered[i] = true
+			}
+		case "offset":
+			for i := range covered {
+				covered[i] = true
+			}
+		}
+	}
+	bits := 0
+	for i := e.width - 1; i >= 0 && covered[i]; i-- {
+		bits += 8
+	}
+	if e.bits <= 0 {
+		e.bits = bits
+	} else if e.bits > bits {
+		return fmt.Errorf("template: %q contains %d of %d bits", e.format, bits, e.bits)
+	}
+
+	var err error
+	e.parser, err = regexp.Compile(strings.Join(pattern, ""))
+	return err
+}
+
+func parseTemplateField(s string) (*templateField, error) {
+	f := &templateField{}
+	p := strings.SplitN(strings.ToLower(s), ":", 2)
+	f.name = p[0]
+	if len(p) > 1 {
+		f.verb = p[1]
+	}
+
+	var limit int
+	switch {
+	case strings.HasPrefix(f.name, "octet"):
+		limit = 4
+	case strings.HasPrefix(f.name, "hextet"):
+		limit = 8
+	}
+	if limit > 0 {
+		n := strings.TrimLeft(f.name, "abcdefghijklmnopqrstuvwxyz")
+		i, err := strconv.Atoi(n)
+		if err != nil || i < 1 || i > limit {
+			return nil, fmt.Errorf("template: invalid field {%s}", s)
+		}
+		f.name = f.name[:len(f.name)-len(n)]
+		f.index = i
+	}
+
+	verbs, found := templateFieldPatterns[f.name]
+	if !found {
+		return nil, fmt.Errorf("template: unknown field {%s}", s)
+	}
+	if _, found = verbs[f.verb]; !found {
+		return nil, fmt.Errorf("template: unknown format %q for field {%s}", f.verb, s)
+	}
+	return f, nil
+}
+
+func (e *Template) Encode(src []byte) (out string, err error) {
+	if e.parser == nil {
+		if err = e.compile(); err != nil {
+			return
+		}
+	}
+
+	// Align the offset to the template width
+	for len(src) > e.width {
+		if src[0] != 0x00 {
+			return "", fmt.Errorf("Offset too large for template %q", e.format)
+		}
+		src = src[1:]
+	}
+	b := make([]byte, e.width)
+	copy(b[e.width-len(src):], src)
+
+	var (
+		format = e.format
+		parts  []string
+	)
+	for _, f := range e.fields {
+		i := strings.IndexByte(format, '{')
+		parts = append(parts, format[:i])
+		format = format[strings.IndexByte(format, '}')+1:]
+
+		var v string
+		switch f.name {
+		case "octet":
+			o := b[e.width-4+f.index-1]
+			if f.verb == "hex" {
+				v = fmt.Sprintf("%02x", o)
+			} else {
+				v = strconv.Itoa(int(o))
+			}
+		case "hextet":
+			h := binary.BigEndian.Uint16(b[(f.index-1)*2:])
+			if f.verb == "dec" {
+				v = strconv.Itoa(int(h))
+			} else {
+				v = strconv.FormatUint(uint64(h), 16)
+			}
+		case "mac", "vendor":
+			mac, err := eui64ToMAC(b)
+			if err != nil {
+				return "", err
+			}
+			if f.name == "vendor" {
+				v = e.eui64.Vendor(fmt.Sprintf("%012x", mac))
+			} else if f.verb == "hex" {
+				v = fmt.Sprintf("%012x", mac)
+			} else {
+				v = formatMAC(mac)
+			}
+		case "offset":
+			switch f.verb {
+			case "hex":
+				v = new(big.Int).SetBytes(b).Text(16)
+			case "dec":
+				v = new(big.Int).SetBytes(b).Text(10)
+			default:
+				v, _ = NewBase32().Encode(b)
+				if v == "" {
+					v = "0"
+				}
+			}
+		}
+		parts = append(parts, v)
+	}
+	parts = append(parts, format)
+
+	return strings.Join(parts, ""), nil
+}
+
+func (e *Template) Decode(src string) (out []byte, err error) {
+	if e.parser == nil {
+		if err = e.compile(); err != nil {
+			return
+		}
+	}
+
+	m := e.parser.FindStringSubmatch(src)
+	if m == nil {
+		return nil, fmt.Errorf("Name %q does not match template %q", src, e.format)
+	}
+
+	out = make([]byte, e.width)
+	have := make([]bool, e.width)
+	set := func(offset int, b []byte) {
+		copy(out[offset:], b)
+		for i := range b {
+			have[offset+i] = true
+		}
+	}
+
+	for i, f := range e.fields {
+		v := strings.ToLower(m[i+1])
+		switch f.name {
+		case "octet":
+			base := 10
+			if f.verb == "hex" {
+				base = 16
+			}
+			o, err := strconv.ParseUint(v, base, 8)
+			if err != nil {
+				return nil, err
+			}
+			set(e.width-4+f.index-1, []byte{uint8(o)})
+		case "hextet":
+			base := 16
+			if f.verb == "dec" {
+				base = 10
+			}
+			h, err := strconv.ParseUint(v, base, 16)
+			if err != nil {
+				return nil, err
+			}
+			set((f.index-1)*2, []byte{uint8(h >> 8), uint8(h)})
+		case "mac":
+			mac, err := strconv.ParseUint(strings.Replace(v, "-", "", -1), 16, 48)
+			if err != nil {
+				return nil, err
+			}
+			set(8, macToEUI64(mac))
+		case "offset":
+			var n *big.Int
+			switch f.verb {
+			case "hex":
+				n, _ = new(big.Int).SetString(v, 16)
+			case "dec":
+				n, _ = new(big.Int).SetString(v, 10)
+			default:
+				var b []byte
+				if v == "0" {
+					n = new(big.Int)
+					break
+				}
+				if b, err = NewBase32().Decode(v); err != nil {
+					return nil, err
+				}
+				n = new(big.Int).SetBytes(b)
+			}
+			if n == nil || len(n.Bytes()) > e.width {
+				return nil, fmt.Errorf("Invalid offset %q", v)
+			}
+			b := n.Bytes()
+			set(0, make([]byte, e.width-len(b)))
+			set(e.width-len(b), b)
+		}
+	}
+
+	// Make sure all the host bits are recovered
+	for i := e.width - (e.bits+7)/8; i < e.width; i++ {
+		if !have[i] {
+			return nil, fmt.Errorf("Template %q does not contain enough bits", e.format)
+		}
+	}
+	if e.bits == 0 {
+		return nil, fmt.Errorf("Template %q does not contain any address bits", e.format)
+	}
+
+	return out, nil
+}
+
+// Interface completeness validation
+var _ Encoder = (*Template)(nil)
@@ -0,0 +1,148 @@
+package encoder
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func TestTemplateEncode(t *testing.T) {
+	tests := []struct {
+		format string
+		ip     string
+		want   string
+	}{
+		{"{octet3}-{octet4}", "172.23.40.5", "40-5"},
+		{"host-{octet4:hex}", "172.23.40.10", "host-0a"},
+		{"{offset}", "172.23.42.69", "lgbikh8"},
+		{"{offset:dec}", "0.0.1.1", "257"},
+		{"{offset:hex}", "0.0.1.1", "101"},
+		{"{hextet7}-{hextet8}", "2001:470:d510:40::dead:beef", "dead-beef"},
+		{"{vendor}-{mac}", "fe80::216:3eff:fe83:f111", "xensource-00-16-3e-83-f1-11"},
+		{"{mac:hex}", "fe80::5074:f2ff:feb1:a87f", "5274f2b1a87f"},
+	}
+
+	for _, test := range tests {
+		e := NewTemplate()
+		if err := e.Config(map[string]interface{}{
+			"format": test.format,
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		ip := net.ParseIP(test.ip)
+		if ip.To4() != nil && e.width == 4 {
+			ip = ip.To4()
+		}
+		got, err := e.Encode(ip)
+		if err != nil {
+			t.Error(err)
+		} else if got != test.want {
+			t.Errorf("got %q, want %q for %q with %q", got, test.want, test.ip, test.format)
+		} else {
+			t.Logf("test %q encoded to %q", test.ip, got)
+		}
+	}
+}
+
+func TestTemplateDecode(t *testing.T) {
+	tests := []struct {
+		format string
+		bits   int
+		name   string
+		want   string
+	}{
+		{"{octet3}-{octet4}", 16, "40-5", "0.0.40.5"},
+		{"{octet1}-{octet2}-{octet3}-{octet4}", 0, "172-23-40-5", "172.23.40.5"},
+		{"{offset}", 0, "lgbikh8", "172.23.42.69"},
+		{"{offset:dec}", 0, "257", "0.0.1.1"},
+		{"{vendor}-{mac}", 64, "xensource-00-16-3e-83-f1-11", "::216:3eff:fe83:f111"},
+		{"{octet3}-{octet4}", 0, "40-5", "0.0.40.5"},
+		{"host-{octet4:hex}", 0, "host-0a", "0.0.0.10"},
+		{"{hextet7}-{hextet8}", 0, "dead-beef", "::dead:beef"},
+		{"{vendor}", 0, "xensource", ""},
+		{"{octet4}", 8, "foo", ""},
+		{"{octet4}", 8, "256", ""},
+	}
+
+	for _, test := range tests {
+		e := NewTemplate()
+		if err := e.Config(map[string]interface{}{
+			"format": test.format,
+			"bits":   test.bits,
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := e.Decode(test.name)
+		if test.want == "" {
+			if err == nil {
+				t.Errorf("got %v, want error for %q with %q", got, test.name, test.format)
+			} else {
+				t.Logf("test %q returned error %v (expected)", test.name, err)
+			}
+			continue
+		}
+
+		want := net.ParseIP(test.want)
+		if len(got) == 4 {
+			want = want.To4()
+		}
+		if err != nil {
+			t.Error(err)
+		} else if !bytes.Equal(got, want) {
+			t.Errorf("got %v, want %v for %q with %q", net.IP(got), want, test.name, test.format)
+		} else {
+			t.Logf("test %q decoded to %v", test.name, net.IP(got))
+		}
+	}
+}
+
+func TestTemplateVendor(t *testing.T) {
+	e := NewTemplate()
+	if err := e.Config(map[string]interface{}{
+		"format":    "{vendor}-{octet4}",
+		"aliases":   map[string]interface{}{"xensource": "xen"},
+		"maxlength": 2,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := e.Encode(net.ParseIP("fe80::216:3eff:fe83:f111")); err != nil {
+		t.Error(err)
+	} else if got != "xe-17" {
+		t.Errorf("got %q, want %q", got, "xe-17")
+	}
+
+	if err := NewTemplate().Config(map[string]interface{}{"format": "{vendor}", "idn": "bogus"}); err == nil {
+		t.Error("expected error for unknown idn mode")
+	}
+}
+
+func TestTemplateConfig(t *testing.T) {
+	tests := []string{
+		"",
+		"{octet5}",
+		"{hextet0}",
+		"{foo}",
+		"{offset:oct}",
+		"{octet4",
+	}
+
+	for _, test := range tests {
+		if err := NewTemplate().Config(map[string]interface{}{"format": test}); err == nil {
+			t.Errorf("expected error for format %q", test)
+		}
+	}
+
+	// The fields have to cover the configured bits
+	bits := map[string]int{
+		"{octet3}-{octet4}":  24,
+		"{octet2}-{octet4}":  16,
+		"{vendor}-{hextet8}": 24,
+	}
+	for format, bits := range bits {
+		if err := NewTemplate().Config(map[string]interface{}{"format": format, "bits": bits}); err == nil {
+			t.Errorf("expected error for format %q with %d bits", format, bits)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package encoder
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// newEncoderSpec loads an encoder from either an encoder name, or a map with
+// a single encoder name to options mapping.
+func newEncoderSpec(v interface{}) (Encoder, error) {
+	if name, found := v.(string); found {
+		return NewEncoder(name, nil)
+	}
+
+	m, err := optMap("encoder", v)
+	if err != nil {
+		return nil, err
+	}
+	if len(m) != 1 {
+		return nil, fmt.Errorf("Expected one encoder, got %d", len(m))
+	}
+	for name, value := range m {
+		var opt map[string]interface{}
+		if value != nil {
+			if opt, err = optMap(name, value); err != nil {
+				return nil, err
+			}
+		}
+		return NewEncoder(name, opt)
+	}
+	return nil, nil
+}
+
+func optMap(k string, v interface{}) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return v, nil
+	case map[interface{}]interface{}:
+		for key, value := range v {
+			s, found := key.(string)
+			if !found {
+				return nil, fmt.Errorf("Unknown key type %T in %q", key, k)
+			}
+			m[s] = value
+		}
+	case yaml.MapSlice:
+		for _, item := range v {
+			s, found := item.Key.(string)
+			if !found {
+				return nil, fmt.Errorf("Unknown key type %T in %q", item.Key, k)
+			}
+			m[s] = item.Value
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("Option %q expects a map, got %T", k, v)
+	}
+	return m, nil
+}
+
+func optString(k string, v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "", nil
+	default:
+		return "", fmt.Errorf("Option %q expects a string, got %T", k, v)
+	}
+}
+
+func optInt(k string, v interface{}) (int, error) {
+	switch v := v.(type) {
+	case int:
+		return v, nil
+	case nil:
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("Option %q expects an integer, got %T", k, v)
+	}
+}
+
+func optDuration(k string, v interface{}) (time.Duration, error) {
+	switch v := v.(type) {
+	case int:
+		return time.Duration(v) * time.Second, nil
+	case string:
+		return time.ParseDuration(v)
+	default:
+		return 0, fmt.Errorf("Option %q expects a duration, got %T", k, v)
+	}
+}
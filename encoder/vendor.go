@@ -0,0 +1,211 @@
+package encoder
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/unicode/norm"
+)
+
+var vendorStrip = regexp.MustCompile(`[^- a-z0-9]`)
+var vendorStripIDN = regexp.MustCompile(`[^- \pL\pN]`)
+var vendorDashes = regexp.MustCompile(`-[-]*`)
+var vendorStopWords = map[string]bool{
+	"bv":            true,
+	"company":       true,
+	"co":            true,
+	"communication": true,
+	"corp":          true,
+	"corporate":     true,
+	"corporation":   true,
+	"coltd":         true,
+	"devices":       true,
+	"electronica":   true,
+	"electronics":   true,
+	"gmbh":          true,
+	"inc":           true,
+	"int":           true,
+	"international": true,
+	"limited":       true,
+	"llg":           true,
+	"ltd":           true,
+	"manufacturing": true,
+	"srl":           true,
+	"systemes":      true,
+	"systems":       true,
+	"technologies":  true,
+	"technology":    true,
+	"the":           true,
+}
+
+// Letters that do not decompose into an ASCII base letter
+var vendorTransliterate = strings.NewReplacer(
+	"ß", "ss", "æ", "ae", "ø", "o", "œ", "oe", "đ", "d", "ł", "l", "þ", "th",
+)
+
+// IDN handling modes for non-ASCII vendor names
+const (
+	vendorIDNStrip    = "strip"
+	vendorIDNASCII    = "ascii"
+	vendorIDNPunycode = "punycode"
+)
+
+// vendorNames turns IEEE organization names into host name labels.
+type vendorNames struct {
+	stopWords map[string]bool
+	aliases   map[string]string
+	maxLength int
+	idn       string
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+func newVendorNames() *vendorNames {
+	return &vendorNames{
+		stopWords: map[string]bool{},
+		aliases:   map[string]string{},
+		idn:       vendorIDNASCII,
+		cache:     map[string]string{},
+	}
+}
+
+// config handles the vendor name options, it returns false for options that
+// are not related to vendor names.
+func (n *vendorNames) config(k string, v interface{}) (handled bool, err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.cache = map[string]string{}
+
+	switch k {
+	case "stopwords":
+		words, found := v.([]interface{})
+		if !found {
+			return true, fmt.Errorf("Option %q expects a list of words", k)
+		}
+		for _, word := range words {
+			s, err := optString(k, word)
+			if err != nil {
+				return true, err
+			}
+			n.stopWords[strings.ToLower(s)] = true
+		}
+	case "aliases":
+		aliases, err := optMap(k, v)
+		if err != nil {
+			return true, err
+		}
+		for name, alias := range aliases {
+			s, err := optString(name, alias)
+			if err != nil {
+				return true, err
+			}
+			n.aliases[strings.ToLower(name)] = strings.ToLower(s)
+		}
+	case "maxlength":
+		if n.maxLength, err = optInt(k, v); err != nil {
+			return true, err
+		}
+	case "idn":
+		if n.idn, err = optString(k, v); err != nil {
+			return true, err
+		}
+		switch n.idn {
+		case vendorIDNStrip, vendorIDNASCII, vendorIDNPunycode:
+		default:
+			return true, fmt.Errorf("Unknown idn mode %q", n.idn)
+		}
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (n *vendorNames) normalize(org string) string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if v, found := n.cache[org]; found {
+		return v
+	}
+	v := n.parse(org)
+	n.cache[org] = v
+	return v
+}
+
+func (n *vendorNames) parse(v string) string {
+	var o, p []string
+
+	v = strings.ToLower(v)
+	v = strings.SplitN(v, "&", 2)[0]
+	switch n.idn {
+	case vendorIDNPunycode:
+		v = vendorStripIDN.ReplaceAllString(v, "")
+	case vendorIDNASCII:
+		v = vendorStrip.ReplaceAllString(transliterate(v), "")
+	default:
+		v = vendorStrip.ReplaceAllString(v, "")
+	}
+	p = strings.Split(v, " ")
+	for _, s := range p {
+		if vendorStopWords[s] || n.stopWords[s] {
+			continue
+		}
+		o = append(o, s)
+	}
+	v = strings.Join(o, "-")
+	v = vendorDashes.ReplaceAllString(v, "-")
+	v = strings.Trim(v, "-")
+
+	if alias, found := n.aliases[v]; found {
+		v = alias
+	}
+
+	return n.shorten(v)
+}
+
+// shorten drops trailing words until the label fits the maximum length,
+// applying punycode where needed.
+func (n *vendorNames) shorten(v string) string {
+	words := strings.Split(v, "-")
+	for {
+		label := strings.Join(words, "-")
+		if n.idn == vendorIDNPunycode {
+			if s, err := idna.ToASCII(label); err == nil {
+				label = s
+			} else {
+				label = vendorStrip.ReplaceAllString(label, "")
+			}
+		}
+		if n.maxLength <= 0 || len(label) <= n.maxLength {
+			return label
+		}
+		if len(words) == 1 {
+			// Cutting punycode leaves an invalid label, fall back to ASCII
+			if strings.HasPrefix(label, "xn--") {
+				label = vendorStrip.ReplaceAllString(transliterate(words[0]), "")
+			}
+			if len(label) > n.maxLength {
+				label = label[:n.maxLength]
+			}
+			return strings.Trim(label, "-")
+		}
+		words = words[:len(words)-1]
+	}
+}
+
+// transliterate strips diacritics from letters.
+func transliterate(v string) string {
+	v = vendorTransliterate.Replace(v)
+	return strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, norm.NFD.String(v))
+}
@@ -0,0 +1,253 @@
+// Package logging provides leveled, structured loggers per subsystem. Output
+// is logfmt or JSON, on stderr or to syslog:
+//
+//	log:
+//	  level: info
+//	  format: json
+//	  levels:
+//	    geo: debug
+//	    auto: warn
+//
+// Loggers can be created before Setup is called, they pick up the
+// configuration once it is applied.
+package logging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"log/syslog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Output formats
+const (
+	FormatLogfmt = "logfmt"
+	FormatJSON   = "json"
+)
+
+type Config struct {
+	Level  string            `yaml:"level,omitempty"`
+	Format string            `yaml:"format,omitempty"`
+	Levels map[string]string `yaml:"levels,omitempty"`
+}
+
+var (
+	mu     sync.RWMutex
+	stderr io.Writer    = os.Stderr
+	output slog.Handler = newHandler(stderr, FormatLogfmt, true)
+	config              = &Config{}
+	levels              = map[string]*slog.LevelVar{}
+	writer *syslog.Writer
+)
+
+func init() {
+	slog.SetDefault(For("main"))
+}
+
+// For returns the logger for a subsystem.
+func For(subsystem string) *slog.Logger {
+	mu.Lock()
+	defer mu.Unlock()
+	level, found := levels[subsystem]
+	if !found {
+		level = new(slog.LevelVar)
+		level.Set(levelFor(config, subsystem))
+		levels[subsystem] = level
+	}
+	return slog.New(&handler{level: level}).With("subsystem", subsystem)
+}
+
+// Setup applies the configuration, logging to w if it's not nil. A nil
+// configuration logs at info level in logfmt.
+func Setup(c *Config, w *syslog.Writer) error {
+	if c == nil {
+		c = &Config{}
+	}
+	if err := c.check(); err != nil {
+		return err
+	}
+
+	var h slog.Handler
+	if w != nil {
+		state := &syslogState{w: w}
+		h = &syslogHandler{
+			Handler: newHandler(state, c.Format, false),
+			state:   state,
+		}
+	} else {
+		h = newHandler(stderr, c.Format, true)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if writer != nil && writer != w {
+		writer.Close()
+	}
+	writer, output, config = w, h, c
+	for subsystem, level := range levels {
+		level.Set(levelFor(c, subsystem))
+	}
+	return nil
+}
+
+func (c *Config) check() error {
+	switch strings.ToLower(c.Format) {
+	case "", FormatLogfmt, "text":
+		c.Format = FormatLogfmt
+	case FormatJSON:
+		c.Format = FormatJSON
+	default:
+		return fmt.Errorf("Unknown log format %q", c.Format)
+	}
+	if _, err := parseLevel(c.Level); err != nil {
+		return err
+	}
+	for subsystem, level := range c.Levels {
+		if _, err := parseLevel(level); err != nil {
+			return fmt.Errorf("%s: %v", subsystem, err)
+		}
+	}
+	return nil
+}
+
+func parseLevel(s string) (slog.Level, error) {
+	var l slog.Level
+	if s == "" {
+		return slog.LevelInfo, nil
+	}
+	if err := l.UnmarshalText([]byte(s)); err != nil {
+		return l, fmt.Errorf("Unknown log level %q", s)
+	}
+	return l, nil
+}
+
+// levelFor returns the level of a subsystem, which defaults to the global
+// level. The configuration must have been checked.
+func levelFor(c *Config, subsystem string) slog.Level {
+	if s, found := c.Levels[subsystem]; found {
+		l, _ := parseLevel(s)
+		return l
+	}
+	l, _ := parseLevel(c.Level)
+	return l
+}
+
+func newHandler(w io.Writer, format string, timestamps bool) slog.Handler {
+	// Levels are checked per subsystem
+	opts := &slog.HandlerOptions{Level: slog.Level(-100)}
+	if !timestamps {
+		opts.ReplaceAttr = func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		}
+	}
+	if format == FormatJSON {
+		return slog.NewJSONHandler(w, opts)
+	}
+	return slog.NewTextHandler(w, opts)
+}
+
+// handler filters on the subsystem level, and passes records to the
+// configured output.
+type handler struct {
+	level *slog.LevelVar
+	ops   []func(slog.Handler) slog.Handler
+}
+
+func (h *handler) Enabled(_ context.Context, l slog.Level) bool {
+	return l >= h.level.Level()
+}
+
+func (h *handler) Handle(ctx context.Context, r slog.Record) error {
+	mu.RLock()
+	out := output
+	mu.RUnlock()
+	for _, op := range h.ops {
+		out = op(out)
+	}
+	return out.Handle(ctx, r)
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(out slog.Handler) slog.Handler { return out.WithAttrs(attrs) })
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	return h.with(func(out slog.Handler) slog.Handler { return out.WithGroup(name) })
+}
+
+func (h *handler) with(op func(slog.Handler) slog.Handler) slog.Handler {
+	ops := make([]func(slog.Handler) slog.Handler, len(h.ops), len(h.ops)+1)
+	copy(ops, h.ops)
+	return &handler{level: h.level, ops: append(ops, op)}
+}
+
+// syslogHandler formats records and writes them to syslog with the priority
+// mapped from the level.
+type syslogHandler struct {
+	slog.Handler
+	state *syslogState
+}
+
+type syslogState struct {
+	mu  sync.Mutex
+	w   *syslog.Writer
+	buf bytes.Buffer
+}
+
+// Write collects the output of the formatting handler, Handle holds the lock.
+func (s *syslogState) Write(p []byte) (int, error) {
+	return s.buf.Write(p)
+}
+
+func (h *syslogHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+	h.state.buf.Reset()
+	if err := h.Handler.Handle(ctx, r); err != nil {
+		return err
+	}
+	return writeSyslog(h.state.w, r.Level, strings.TrimSuffix(h.state.buf.String(), "\n"))
+}
+
+func (h *syslogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &syslogHandler{Handler: h.Handler.WithAttrs(attrs), state: h.state}
+}
+
+func (h *syslogHandler) WithGroup(name string) slog.Handler {
+	return &syslogHandler{Handler: h.Handler.WithGroup(name), state: h.state}
+}
+
+// Priority returns the syslog severity for a level.
+func Priority(l slog.Level) syslog.Priority {
+	switch {
+	case l < slog.LevelInfo:
+		return syslog.LOG_DEBUG
+	case l < slog.LevelWarn:
+		return syslog.LOG_INFO
+	case l < slog.LevelError:
+		return syslog.LOG_WARNING
+	default:
+		return syslog.LOG_ERR
+	}
+}
+
+func writeSyslog(w *syslog.Writer, l slog.Level, m string) error {
+	switch Priority(l) {
+	case syslog.LOG_DEBUG:
+		return w.Debug(m)
+	case syslog.LOG_INFO:
+		return w.Info(m)
+	case syslog.LOG_WARNING:
+		return w.Warning(m)
+	default:
+		return w.Err(m)
+	}
+}
@@ -0,0 +1,108 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"log/syslog"
+	"os"
+	"strings"
+	"testing"
+)
+
+func testSetup(t *testing.T, c *Config) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	stderr = buf
+	t.Cleanup(func() {
+		stderr = os.Stderr
+		Setup(nil, nil)
+	})
+	if err := Setup(c, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
+
+func TestLevels(t *testing.T) {
+	geo := For("geo")
+	auto := For("auto")
+	buf := testSetup(t, &Config{
+		Level:  "warn",
+		Levels: map[string]string{"geo": "debug"},
+	})
+	pipe := For("pipe")
+
+	geo.Debug("geo debug")
+	auto.Info("auto info")
+	auto.Warn("auto warn")
+	pipe.Info("pipe info")
+	pipe.Error("pipe error", "error", "boom")
+
+	output := buf.String()
+	t.Log(output)
+	for _, want := range []string{
+		`level=DEBUG msg="geo debug" subsystem=geo`,
+		`level=WARN msg="auto warn" subsystem=auto`,
+		`level=ERROR msg="pipe error" subsystem=pipe error=boom`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output", want)
+		}
+	}
+	for _, unwanted := range []string{"auto info", "pipe info"} {
+		if strings.Contains(output, unwanted) {
+			t.Errorf("unexpected %q in output", unwanted)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	buf := testSetup(t, &Config{Format: "json"})
+
+	For("encoder").With("file", "oui.txt").Info("parsing")
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("%v: %s", err, buf)
+	}
+	for k, want := range map[string]string{
+		"level":     "INFO",
+		"msg":       "parsing",
+		"subsystem": "encoder",
+		"file":      "oui.txt",
+	} {
+		if got[k] != want {
+			t.Errorf("%s: expected %q, got %v", k, want, got[k])
+		}
+	}
+}
+
+func TestConfigCheck(t *testing.T) {
+	for _, c := range []*Config{
+		{Format: "xml"},
+		{Level: "loud"},
+		{Levels: map[string]string{"geo": "chatty"}},
+	} {
+		if err := Setup(c, nil); err == nil {
+			t.Errorf("expected error for %+v", c)
+		}
+	}
+}
+
+func TestPriority(t *testing.T) {
+	var tests = []struct {
+		Level slog.Level
+		Want  syslog.Priority
+	}{
+		{slog.LevelDebug, syslog.LOG_DEBUG},
+		{slog.LevelInfo, syslog.LOG_INFO},
+		{slog.LevelWarn, syslog.LOG_WARNING},
+		{slog.LevelError, syslog.LOG_ERR},
+		{slog.LevelError + 4, syslog.LOG_ERR},
+	}
+	for _, test := range tests {
+		if got := Priority(test.Level); got != test.Want {
+			t.Errorf("%s: expected %d, got %d", test.Level, test.Want, got)
+		}
+	}
+}
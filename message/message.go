@@ -10,4 +10,17 @@ type Message struct {
 	ID                    []byte
 	Content               []byte
 	RemoteAddr, LocalAddr net.IP
+	ClientSubnet          *net.IPNet
+	ScopeBits             int
+}
+
+// Client returns the EDNS client subnet address if known, or else the
+// remote address.
+func (m *Message) Client() net.IP {
+	if m.ClientSubnet != nil {
+		if ones, _ := m.ClientSubnet.Mask.Size(); ones > 0 {
+			return m.ClientSubnet.IP
+		}
+	}
+	return m.RemoteAddr
 }
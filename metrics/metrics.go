@@ -0,0 +1,285 @@
+// Package metrics exports Prometheus metrics, over HTTP on a TCP or unix
+// socket listener, or by writing to a node exporter textfile collector
+// directory.
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/tehmaze-labs/dns/logging"
+)
+
+const namespace = "dns"
+
+var logger = logging.For("metrics")
+
+// Registry holds all metrics
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+var (
+	mu        sync.Mutex
+	listeners []net.Listener
+	files     []string
+	writers   sync.WaitGroup
+	done      = make(chan struct{})
+)
+
+var (
+	Queries = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "queries_total",
+		Help:      "Queries by query type and backend.",
+	}, []string{"type", "backend"})
+
+	Responses = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "responses_total",
+		Help:      "Backend responses by result: answer, empty, refused or error.",
+	}, []string{"backend", "result"})
+
+	BackendDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "backend_duration_seconds",
+		Help:      "Backend query latency.",
+		Buckets:   []float64{.00001, .000025, .00005, .0001, .00025, .0005, .001, .0025, .005, .01, .025, .1},
+	}, []string{"backend"})
+
+	DecodeFailures = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "encoder_decode_failures_total",
+		Help:      "Names that an encoder failed to decode.",
+	}, []string{"encoder"})
+
+	GeoRuleHits = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "geo_rule_hits_total",
+		Help:      "Geo answers by matched rule and client location.",
+	}, []string{"rule", "continent", "country"})
+
+	Reloads = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "config_reloads_total",
+		Help:      "Configuration reloads by result.",
+	}, []string{"result"})
+
+	LastReload = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "config_last_reload_success_timestamp_seconds",
+		Help:      "Time of the last successful configuration (re)load.",
+	})
+
+	PipeErrors = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pipe_errors_total",
+		Help:      "PowerDNS pipe protocol errors by kind.",
+	}, []string{"kind"})
+)
+
+func init() {
+	Registry.MustRegister(collectors.NewGoCollector())
+	Registry.MustRegister(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+}
+
+// Reloaded records the result of a configuration (re)load.
+func Reloaded(err error) {
+	if err != nil {
+		Reloads.WithLabelValues("failure").Inc()
+		return
+	}
+	Reloads.WithLabelValues("success").Inc()
+	LastReload.SetToCurrentTime()
+}
+
+// CounterFunc registers a counter that reads its value from fn.
+func CounterFunc(name, help string, fn func() float64) {
+	factory.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+	}, fn)
+}
+
+// GaugeFunc registers a gauge that reads its value from fn.
+func GaugeFunc(name, help string, fn func() float64) {
+	factory.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+	}, fn)
+}
+
+// Config for exporting metrics. With one pipe process per PowerDNS thread,
+// use a socket name with %p, which is replaced by the process ID. Textfiles
+// are written per process, with a pid label on all metrics. Files of
+// processes that are no longer running are removed on start. Only the first
+// process binds the listen address, the others export through the socket or
+// textfile only:
+//
+//	metrics:
+//	  listen: 127.0.0.1:9153
+//	  socket: /run/dns-pdns/metrics-%p.sock
+//	  textfile: /var/lib/prometheus/node-exporter
+//	  interval: 15s
+type Config struct {
+	Listen   string        `yaml:"listen,omitempty"`
+	Socket   string        `yaml:"socket,omitempty"`
+	Textfile string        `yaml:"textfile,omitempty"`
+	Interval time.Duration `yaml:"interval,omitempty"`
+}
+
+// Start exports the metrics in the background, until Stop is called.
+func Start(c *Config) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+
+	if c.Listen != "" {
+		l, err := net.Listen("tcp", c.Listen)
+		if errors.Is(err, syscall.EADDRINUSE) {
+			// Another pipe process holds the listener
+			logger.Warn("metrics listener in use, not listening", "listen", c.Listen)
+		} else if err != nil {
+			return err
+		} else {
+			listeners = append(listeners, l)
+			go serve(l, mux)
+		}
+	}
+
+	if c.Socket != "" {
+		removeStale(c.Socket)
+		name := expandPID(c.Socket)
+		os.Remove(name)
+		l, err := net.Listen("unix", name)
+		if err != nil {
+			return err
+		}
+		listeners = append(listeners, l)
+		files = append(files, name)
+		go serve(l, mux)
+	}
+
+	if c.Textfile != "" {
+		if i, err := os.Stat(c.Textfile); err != nil {
+			return err
+		} else if !i.IsDir() {
+			return fmt.Errorf("textfile %s is not a directory", c.Textfile)
+		}
+		pattern := filepath.Join(c.Textfile, "dns-pdns-%p.prom")
+		removeStale(pattern)
+		name := expandPID(pattern)
+		interval := c.Interval
+		if interval <= 0 {
+			interval = 15 * time.Second
+		}
+		files = append(files, name)
+		writers.Add(1)
+		go writeTextfile(name, interval, done)
+	}
+
+	return nil
+}
+
+// Stop stops exporting metrics, and removes the sockets and textfiles.
+func Stop() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	close(done)
+	writers.Wait()
+	done = make(chan struct{})
+	for _, l := range listeners {
+		l.Close()
+	}
+	for _, name := range files {
+		os.Remove(name)
+	}
+	listeners, files = nil, nil
+}
+
+func serve(l net.Listener, h http.Handler) {
+	if err := http.Serve(l, h); err != nil && !errors.Is(err, net.ErrClosed) {
+		logger.Error("serving metrics failed", "error", err)
+	}
+}
+
+func writeTextfile(name string, interval time.Duration, done chan struct{}) {
+	defer writers.Done()
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		if err := prometheus.WriteToTextfile(name, pidGatherer{Registry}); err != nil {
+			logger.Error("writing textfile failed", "file", name, "error", err)
+		}
+		select {
+		case <-done:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// pidGatherer adds a pid label to all metrics, the textfile collector
+// rejects identical series from different files.
+type pidGatherer struct {
+	prometheus.Gatherer
+}
+
+func (g pidGatherer) Gather() ([]*dto.MetricFamily, error) {
+	families, err := g.Gatherer.Gather()
+	name, pid := "pid", strconv.Itoa(os.Getpid())
+	for _, f := range families {
+		for _, m := range f.Metric {
+			m.Label = append(m.Label, &dto.LabelPair{Name: &name, Value: &pid})
+			sort.Slice(m.Label, func(i, j int) bool {
+				return m.Label[i].GetName() < m.Label[j].GetName()
+			})
+		}
+	}
+	return families, err
+}
+
+// removeStale removes the files matching name, with %p as the process ID,
+// of processes that are no longer running.
+func removeStale(name string) {
+	i := strings.Index(name, "%p")
+	if i < 0 {
+		return
+	}
+	prefix, suffix := name[:i], name[i+2:]
+	matches, _ := filepath.Glob(prefix + "*" + suffix)
+	for _, match := range matches {
+		pid, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(match, prefix), suffix))
+		if err != nil || pid <= 0 || pid == os.Getpid() {
+			continue
+		}
+		if syscall.Kill(pid, 0) == syscall.ESRCH {
+			logger.Info("removing stale file", "file", match, "pid", pid)
+			os.Remove(match)
+		}
+	}
+}
+
+func expandPID(s string) string {
+	return strings.Replace(s, "%p", strconv.Itoa(os.Getpid()), -1)
+}
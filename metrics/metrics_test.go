@@ -0,0 +1,132 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	Queries.WithLabelValues("A", "auto").Inc()
+	Reloaded(nil)
+	Reloaded(errors.New("test"))
+
+	// Files of processes that are gone are removed, running ones are kept
+	stale := filepath.Join(dir, "dns-pdns-2147483646.prom")
+	running := filepath.Join(dir, "dns-pdns-1.prom")
+	for _, name := range []string{stale, running} {
+		if err = ioutil.WriteFile(name, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := &Config{
+		Socket:   filepath.Join(dir, "metrics-%p.sock"),
+		Textfile: dir,
+		Interval: time.Millisecond,
+	}
+	if err = Start(c); err != nil {
+		t.Fatal(err)
+	}
+
+	socket := filepath.Join(dir, "metrics-"+strconv.Itoa(os.Getpid())+".sock")
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return net.Dial("unix", socket)
+		},
+	}}
+	res, err := client.Get("http://localhost/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`dns_queries_total{backend="auto",type="A"} 1`,
+		`dns_config_reloads_total{result="failure"} 1`,
+		`dns_config_reloads_total{result="success"} 1`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("metrics missing %q", want)
+		}
+	}
+
+	textfile := filepath.Join(dir, "dns-pdns-"+strconv.Itoa(os.Getpid())+".prom")
+	for i := 0; i < 100; i++ {
+		if _, err = os.Stat(textfile); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != nil {
+		t.Errorf("textfile not written: %v", err)
+	}
+	if data, err := ioutil.ReadFile(textfile); err != nil {
+		t.Error(err)
+	} else if want := `dns_queries_total{backend="auto",pid="` + strconv.Itoa(os.Getpid()) + `",type="A"} 1`; !strings.Contains(string(data), want) {
+		t.Errorf("textfile missing %q", want)
+	}
+	if _, err = os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("stale textfile not removed: %v", err)
+	}
+	if _, err = os.Stat(running); err != nil {
+		t.Errorf("textfile of running process removed: %v", err)
+	}
+
+	Stop()
+	for _, name := range []string{socket, textfile} {
+		if _, err = os.Stat(name); !os.IsNotExist(err) {
+			t.Errorf("%s not removed on stop: %v", name, err)
+		}
+	}
+
+	if err = Start(&Config{Textfile: filepath.Join(dir, "missing")}); err == nil {
+		t.Error("expected error for missing textfile directory")
+	}
+}
+
+func TestStartListenInUse(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// Processes after the first one export through the socket only
+	c := &Config{
+		Listen: l.Addr().String(),
+		Socket: filepath.Join(dir, "metrics-%p.sock"),
+	}
+	if err = Start(c); err != nil {
+		t.Fatal(err)
+	}
+	defer Stop()
+
+	socket := filepath.Join(dir, "metrics-"+strconv.Itoa(os.Getpid())+".sock")
+	if _, err = os.Stat(socket); err != nil {
+		t.Errorf("socket not created: %v", err)
+	}
+}
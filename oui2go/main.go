@@ -0,0 +1,128 @@
+// Command oui2go compiles IEEE registry exports into a Go source file, so the
+// vendor table does not have to be parsed at startup.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tehmaze-labs/dns/encoder"
+)
+
+func main() {
+	var output, pkg string
+
+	flag.StringVar(&output, "o", "eui64_oui.go", "output file")
+	flag.StringVar(&pkg, "p", "encoder", "package name")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintf(os.Stderr, "usage: %s [-o output] [-p package] <oui.txt|oui.csv|url>...\n", filepath.Base(os.Args[0]))
+		os.Exit(1)
+	}
+
+	type key struct {
+		prefix uint64
+		bits   int
+	}
+	seen := map[key]int{}
+	ouis := []encoder.OUI{}
+	for _, filename := range flag.Args() {
+		f, err := open(filename)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		parsed, err := encoder.ReadOUI(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("error parsing %s: %v\n", filename, err)
+		}
+
+		// Later files override earlier assignments
+		for _, oui := range parsed {
+			k := key{oui.Prefix, oui.Bits}
+			if i, found := seen[k]; found {
+				ouis[i] = oui
+				continue
+			}
+			seen[k] = len(ouis)
+			ouis = append(ouis, oui)
+		}
+	}
+
+	// Longest prefixes first, then by prefix, as expected by the lookup
+	sort.Slice(ouis, func(i, j int) bool {
+		if ouis[i].Bits != ouis[j].Bits {
+			return ouis[i].Bits > ouis[j].Bits
+		}
+		return ouis[i].Prefix < ouis[j].Prefix
+	})
+
+	names := []string{}
+	index := map[string]int{}
+	for _, oui := range ouis {
+		if _, found := index[oui.Organization]; !found {
+			index[oui.Organization] = len(names)
+			names = append(names, oui.Organization)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by oui2go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "var ouiBuiltinNames = [...]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q,\n", name)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+	fmt.Fprintf(&buf, "var ouiBuiltin = [...]ouiEntry{\n")
+	for i, oui := range ouis {
+		if i%4 == 0 {
+			buf.WriteByte('\t')
+		}
+		fmt.Fprintf(&buf, "{0x%09x, %d, %d},", oui.Prefix, oui.Bits, index[oui.Organization])
+		if i%4 == 3 || i == len(ouis)-1 {
+			buf.WriteByte('\n')
+		} else {
+			buf.WriteByte(' ')
+		}
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err = ioutil.WriteFile(output, src, 0644); err != nil {
+		log.Fatalln(err)
+	}
+	log.Printf("oui2go: wrote %d assignments from %d organizations to %s\n", len(ouis), len(names), output)
+}
+
+// open opens a registry export, either a local file or one fetched over HTTP.
+func open(name string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(name, "http://") && !strings.HasPrefix(name, "https://") {
+		return os.Open(name)
+	}
+
+	log.Printf("oui2go: fetching %s\n", name)
+	r, err := http.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != http.StatusOK {
+		r.Body.Close()
+		return nil, fmt.Errorf("%s: %s", name, r.Status)
+	}
+	return r.Body, nil
+}
@@ -3,15 +3,24 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
+	"github.com/tehmaze-labs/dns/backend"
 	"github.com/tehmaze-labs/dns/config"
+	"github.com/tehmaze-labs/dns/dnstap"
+	"github.com/tehmaze-labs/dns/metrics"
+	"github.com/tehmaze-labs/dns/rrl"
 )
 
 func main() {
-	var filename string
+	var filename, dump string
 
-	flag.StringVar(&filename, "config", "testdata/dns.yaml", "configuration file")
+	flag.StringVar(&filename, "config", "testdata/dns.yaml", "configuration file (yaml, json or toml)")
+	flag.StringVar(&dump, "dump-config", "", "print the resolved configuration as yaml, json or toml and exit")
 	flag.Parse()
 
 	c, err := config.NewConfig(filename)
@@ -19,12 +28,105 @@ func main() {
 		fmt.Printf("error parsing %q: %v\n", filename, err)
 		os.Exit(1)
 	}
+	if dump != "" {
+		dumpConfig(c, dump)
+		return
+	}
 
-	r, err := c.Backends()
-	if err != nil {
+	p := New(nil)
+	if c.Options.Cache != nil {
+		if p.cache, err = backend.NewCache(c.Options.Cache); err != nil {
+			fmt.Printf("error parsing %q: %v\n", filename, err)
+			os.Exit(1)
+		}
+	}
+	if c.Options.RRL != nil {
+		if p.limiter, err = rrl.New(c.Options.RRL); err != nil {
+			fmt.Printf("error parsing %q: %v\n", filename, err)
+			os.Exit(1)
+		}
+	}
+
+	if c.Options.Dnstap != nil {
+		if p.tap, err = dnstap.New(c.Options.Dnstap); err != nil {
+			fmt.Printf("error starting dnstap: %v\n", err)
+			os.Exit(1)
+		}
+		defer p.tap.Close()
+	}
+
+	// Only the backends are reloaded, changes to the options need a restart
+	p.load = func() ([]backend.Backend, error) {
+		c, err := config.NewConfig(filename)
+		if err != nil {
+			return nil, err
+		}
+		return c.Backends()
+	}
+	if err = p.Reload(); err != nil {
 		panic(err)
 	}
 
-	p := New(r)
+	if c.Options.Metrics != nil {
+		p.exportMetrics()
+		if err = metrics.Start(c.Options.Metrics); err != nil {
+			fmt.Printf("error starting metrics: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	go handleSignals(p)
+
 	p.Serve(os.Stdin, os.Stdout)
+	metrics.Stop()
+}
+
+// dumpConfig prints the configuration after the backends applied their
+// defaults.
+func dumpConfig(c *config.Config, format string) {
+	backends, err := c.Backends()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error checking configuration: %v\n", err)
+		os.Exit(1)
+	}
+	for _, b := range backends {
+		if c, ok := b.(io.Closer); ok {
+			c.Close()
+		}
+	}
+
+	out, err := c.Dump(strings.ToLower(format))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error dumping configuration: %v\n", err)
+		os.Exit(1)
+	}
+	os.Stdout.Write(out)
+}
+
+// handleSignals reloads the configuration on SIGHUP, flushes the answer
+// cache on SIGUSR1, and cleans up on SIGINT and SIGTERM.
+func handleSignals(p *Pdns) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP, syscall.SIGUSR1, syscall.SIGINT, syscall.SIGTERM)
+	for s := range c {
+		switch s {
+		case syscall.SIGINT, syscall.SIGTERM:
+			logger.Info("terminating", "signal", s.String())
+			metrics.Stop()
+			if p.tap != nil {
+				p.tap.Close()
+			}
+			os.Exit(0)
+		case syscall.SIGHUP:
+			logger.Info("reloading configuration")
+			if err := p.Reload(); err != nil {
+				logger.Error("reload failed", "error", err)
+			}
+		case syscall.SIGUSR1:
+			if p.cache != nil {
+				logger.Info("flushing answer cache")
+				p.cache.Flush()
+			}
+		}
+	}
 }
@@ -6,49 +6,172 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/tehmaze-labs/dns/backend"
+	"github.com/tehmaze-labs/dns/dnstap"
+	"github.com/tehmaze-labs/dns/logging"
 	"github.com/tehmaze-labs/dns/message"
+	"github.com/tehmaze-labs/dns/metrics"
+	"github.com/tehmaze-labs/dns/rrl"
 )
 
 var (
-	HELLO_ABI_VERSION_2 = []byte("HELO\t2")
-	HELLO_REPLY         = "OK\tdns-pdns\n"
-	END_REPLY           = "END\n"
-	FAIL_REPLY          = "FAIL\n"
-	NL                  = []byte("\n")
+	HELLO       = []byte("HELO\t")
+	HELLO_REPLY = "OK\tdns-pdns\n"
+	END_REPLY   = "END\n"
+	FAIL_REPLY  = "FAIL\n"
+	NL          = []byte("\n")
 )
 
+var errLimited = errors.New("rate limited")
+
 const (
 	RTYPE_AXRF = "AXFR"
 	RTYPE_Q    = "Q"
 	RTYPE_PING = "PING"
+	RTYPE_CMD  = "CMD"
+)
+
+// Supported pipe backend ABI versions
+const (
+	ABI_VERSION_MIN = 1
+	ABI_VERSION_MAX = 5
 )
 
+var logger = logging.For("pipe")
+
 type Pdns struct {
+	mu       sync.RWMutex
 	backends []backend.Backend
+	active   *sync.WaitGroup
+	load     func() ([]backend.Backend, error)
+	abi      int
+	limiter  *rrl.Limiter
+	cache    *backend.Cache
+	tap      *dnstap.Logger
 }
 
 type pdnsRequest struct {
 	rtype   string
 	message *message.Message
+	command []string
 }
 
 func New(backends []backend.Backend) *Pdns {
-	return &Pdns{backends}
+	return &Pdns{backends: backends, active: new(sync.WaitGroup)}
+}
+
+// acquire returns the current backends, release must be called when they are
+// no longer used.
+func (p *Pdns) acquire() (backends []backend.Backend, release func()) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	p.active.Add(1)
+	return p.backends, p.active.Done
+}
+
+// Reload loads new backends, and closes the previous backends once the
+// queries using them are done.
+func (p *Pdns) Reload() error {
+	if p.load == nil {
+		return errors.New("reload not supported")
+	}
+	backends, err := p.load()
+	metrics.Reloaded(err)
+	if err != nil {
+		return err
+	}
+	if p.cache != nil {
+		backends = p.cache.WrapAll(backends)
+	}
+
+	p.mu.Lock()
+	old, active := p.backends, p.active
+	p.backends, p.active = backends, new(sync.WaitGroup)
+	p.mu.Unlock()
+
+	active.Wait()
+	if p.cache != nil {
+		p.cache.Flush()
+	}
+	for _, b := range old {
+		if c, ok := b.(io.Closer); ok {
+			c.Close()
+		}
+	}
+	return nil
+}
+
+// exportMetrics registers the rate limiter and cache counters.
+func (p *Pdns) exportMetrics() {
+	if p.limiter != nil {
+		l := p.limiter
+		metrics.CounterFunc("rrl_allowed_total", "Responses allowed by the rate limiter.", func() float64 {
+			return float64(l.Stats().Allowed)
+		})
+		metrics.CounterFunc("rrl_dropped_total", "Responses dropped by the rate limiter.", func() float64 {
+			return float64(l.Stats().Dropped)
+		})
+		metrics.CounterFunc("rrl_slipped_total", "Responses slipped by the rate limiter.", func() float64 {
+			return float64(l.Stats().Slipped)
+		})
+	}
+	if p.cache != nil {
+		c := p.cache
+		metrics.CounterFunc("cache_hits_total", "Answer cache hits.", func() float64 {
+			return float64(c.Stats().Hits)
+		})
+		metrics.CounterFunc("cache_misses_total", "Answer cache misses.", func() float64 {
+			return float64(c.Stats().Misses)
+		})
+		metrics.CounterFunc("cache_evictions_total", "Answer cache evictions.", func() float64 {
+			return float64(c.Stats().Evictions)
+		})
+		metrics.GaugeFunc("cache_entries", "Answer cache entries.", func() float64 {
+			return float64(c.Stats().Entries)
+		})
+	}
+	if p.tap != nil {
+		t := p.tap
+		metrics.CounterFunc("dnstap_dropped_total", "Dnstap messages dropped.", func() float64 {
+			return float64(t.Dropped())
+		})
+	}
 }
 
-func parseRequest(line []byte) (*pdnsRequest, error) {
+// parseHello returns the ABI version requested in the handshake.
+func parseHello(line []byte) (int, error) {
+	if !bytes.HasPrefix(line, HELLO) {
+		return 0, errors.New("bad handshake")
+	}
+	abi, err := strconv.Atoi(string(line[len(HELLO):]))
+	if err != nil {
+		return 0, fmt.Errorf("bad ABI version %q", line[len(HELLO):])
+	}
+	if abi < ABI_VERSION_MIN || abi > ABI_VERSION_MAX {
+		return 0, fmt.Errorf("unsupported ABI version %d", abi)
+	}
+	return abi, nil
+}
+
+func parseRequest(line []byte, abi int) (*pdnsRequest, error) {
 	tokens := bytes.Split(line, []byte("\t"))
 	kind := string(tokens[0])
 	switch kind {
 	case RTYPE_Q:
-		if len(tokens) < 7 {
+		fields := 7
+		if abi == 1 {
+			fields = 6
+		} else if abi >= 3 {
+			fields = 8
+		}
+		if len(tokens) < fields {
 			return nil, errors.New("bad request line")
 		}
 
@@ -62,37 +185,57 @@ func parseRequest(line []byte) (*pdnsRequest, error) {
 			return nil, errors.New("bad query type")
 		}
 
-		return &pdnsRequest{
-			kind,
-			&message.Message{
-				Name:       tokens[1],
-				Class:      c,
-				Type:       t,
-				ID:         tokens[4],
-				RemoteAddr: net.ParseIP(string(tokens[5])),
-				LocalAddr:  net.ParseIP(string(tokens[6])),
-			},
-		}, nil
+		m := &message.Message{
+			Name:       tokens[1],
+			Class:      c,
+			Type:       t,
+			ID:         tokens[4],
+			RemoteAddr: net.ParseIP(string(tokens[5])),
+		}
+		if abi >= 2 {
+			m.LocalAddr = net.ParseIP(string(tokens[6]))
+		}
+		if abi >= 3 {
+			m.ClientSubnet = parseSubnet(string(tokens[7]))
+		}
+		return &pdnsRequest{rtype: kind, message: m}, nil
+	case RTYPE_CMD:
+		if abi < 5 || len(tokens) < 2 {
+			return nil, errors.New("bad request line")
+		}
+		return &pdnsRequest{rtype: kind, command: strings.Fields(string(tokens[1]))}, nil
 	case RTYPE_AXRF, RTYPE_PING:
-		return &pdnsRequest{
-			kind,
-			nil,
-		}, nil
+		return &pdnsRequest{rtype: kind}, nil
 	default:
 		return nil, errors.New("bad request line")
 	}
 }
 
+// parseSubnet parses the EDNS client subnet, which is either an address or a
+// network in CIDR notation.
+func parseSubnet(s string) *net.IPNet {
+	if _, ipnet, err := net.ParseCIDR(s); err == nil {
+		return ipnet
+	}
+	if ip := net.ParseIP(s); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+	}
+	return nil
+}
+
 func write(w io.Writer, line string) {
 	//fmt.Fprintf(os.Stderr, ">>> %q\n", line)
 	_, err := io.WriteString(w, line)
 	if err != nil {
-		log.Printf("write failed: %v", err)
+		logger.Error("write failed", "error", err)
 	}
 }
 
 func (p *Pdns) Serve(r io.Reader, w io.Writer) {
-	log.Print("starting mazenet-pdns backend")
+	logger.Info("starting mazenet-pdns backend")
 	buf := bufio.NewReader(r)
 	handshake := true
 
@@ -102,10 +245,11 @@ func (p *Pdns) Serve(r io.Reader, w io.Writer) {
 			err = errors.New("pdns line too long")
 		}
 		if err == io.EOF {
-			log.Print("terminating mazenet-pdns backend")
+			logger.Info("terminating mazenet-pdns backend")
 			return
 		}
 		if err != nil {
+			metrics.PipeErrors.WithLabelValues("read").Inc()
 			write(w, fmt.Sprintf("LOG failed reading request: %v\n", err))
 			//log.Printf("failed reading request: %v", err)
 			continue
@@ -114,8 +258,9 @@ func (p *Pdns) Serve(r io.Reader, w io.Writer) {
 		//fmt.Fprintf(os.Stderr, "<<< %s\n", line)
 
 		if handshake {
-			if !bytes.Equal(line, HELLO_ABI_VERSION_2) {
-				log.Printf("handshake failed: %v != %v", line, HELLO_ABI_VERSION_2)
+			if p.abi, err = parseHello(line); err != nil {
+				metrics.PipeErrors.WithLabelValues("handshake").Inc()
+				logger.Warn("handshake failed", "error", err)
 				write(w, FAIL_REPLY)
 			} else {
 				handshake = false
@@ -124,20 +269,31 @@ func (p *Pdns) Serve(r io.Reader, w io.Writer) {
 			continue
 		}
 
-		req, err := parseRequest(line)
+		req, err := parseRequest(line, p.abi)
 		if err != nil {
-			log.Printf("failed parsing request: %v", err)
+			metrics.PipeErrors.WithLabelValues("parse").Inc()
+			logger.Warn("failed parsing request", "line", string(line), "error", err)
 			write(w, FAIL_REPLY)
 			continue
 		}
 
 		switch req.rtype {
 		case RTYPE_Q:
+			start := time.Now()
 			answers, err := p.handleRequest(req)
-			if err != nil {
-				log.Printf("failed handling request: %v", err)
+			if err == errLimited {
+				// The pipe can neither drop nor truncate, and a failure
+				// makes the resolver retry, so answer empty
+				write(w, END_REPLY)
+				continue
+			} else if err != nil {
+				logger.Error("failed handling request", "error", err)
+				write(w, FAIL_REPLY)
 				continue
 			}
+			if p.tap != nil {
+				p.tap.Log(req.message, answers, start)
+			}
 
 			for _, answer := range answers {
 				if answer == nil {
@@ -145,35 +301,151 @@ func (p *Pdns) Serve(r io.Reader, w io.Writer) {
 				}
 				m, err := p.marshal(answer)
 				if err != nil {
-					log.Printf("failed to marshal answer: %v", err)
+					metrics.PipeErrors.WithLabelValues("marshal").Inc()
+					logger.Error("failed to marshal answer", "error", err)
 					continue
 				}
 				write(w, m)
 			}
 			write(w, END_REPLY)
+
+		case RTYPE_CMD:
+			for _, line := range p.handleCommand(req.command) {
+				write(w, "DATA\t"+line+"\n")
+			}
+			write(w, END_REPLY)
 		}
 	}
 
 }
 
+// handleCommand passes a command to all backends that implement
+// backend.Commander, and returns the combined output.
+func (p *Pdns) handleCommand(command []string) (lines []string) {
+	if len(command) == 0 {
+		return []string{"no command"}
+	}
+	switch command[0] {
+	case "reload":
+		if err := p.Reload(); err != nil {
+			return []string{fmt.Sprintf("reload failed: %v", err)}
+		}
+		return []string{"reloaded"}
+	case "rrl":
+		return p.rrlStats()
+	case "cache":
+		return p.cacheCommand(command[1:])
+	}
+
+	backends, release := p.acquire()
+	defer release()
+
+	var handled bool
+	for _, b := range backends {
+		c, ok := b.(backend.Commander)
+		if !ok {
+			continue
+		}
+		output, err := c.Command(command[0], command[1:])
+		if err == backend.ErrUnknownCommand {
+			continue
+		}
+		handled = true
+		if err != nil {
+			lines = append(lines, fmt.Sprintf("%s failed: %v", command[0], err))
+			continue
+		}
+		lines = append(lines, output...)
+	}
+	if !handled {
+		return []string{fmt.Sprintf("unknown command %q", command[0])}
+	}
+	return
+}
+
 func (p *Pdns) handleRequest(req *pdnsRequest) ([]*message.Message, error) {
 	if req.message == nil {
 		return nil, errors.New("no dns request message")
 	}
 
+	// Buckets are keyed on the resolver, any client can claim a client
+	// subnet. Clients that are already limited don't reach the backends.
+	if p.limiter != nil && p.limiter.Limited(req.message.RemoteAddr) != rrl.Allow {
+		return nil, errLimited
+	}
+
+	backends, release := p.acquire()
+	defer release()
+
+	var failed bool
+	qtype := dns.TypeToString[req.message.Type]
 	messages := make([]*message.Message, 0)
-	for _, backend := range p.backends {
-		answers, err := backend.Query(req.message)
-		if err != nil {
-			log.Printf("backend returned error: %v", err)
+	for _, b := range backends {
+		name := backend.Name(b)
+		metrics.Queries.WithLabelValues(qtype, name).Inc()
+
+		start := time.Now()
+		answers, err := b.Query(req.message)
+		metrics.BackendDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+
+		if err == backend.ErrRefused {
+			// The pipe protocol can't signal REFUSED, answer empty
+			metrics.Responses.WithLabelValues(name, "refused").Inc()
+			continue
+		} else if err != nil {
+			metrics.Responses.WithLabelValues(name, "error").Inc()
+			logger.Error("backend returned error", "backend", name, "error", err)
+			failed = true
 			continue
 		}
+		if len(answers) == 0 {
+			metrics.Responses.WithLabelValues(name, "empty").Inc()
+		} else {
+			metrics.Responses.WithLabelValues(name, "answer").Inc()
+		}
 		messages = append(messages, answers...)
 	}
 
+	if p.limiter != nil {
+		class := rrl.ClassAnswer
+		if len(messages) == 0 {
+			class = rrl.ClassEmpty
+			if failed {
+				class = rrl.ClassError
+			}
+		}
+		if p.limiter.Check(req.message.RemoteAddr, class) != rrl.Allow {
+			return nil, errLimited
+		}
+	}
+
 	return messages, nil
 }
 
+func (p *Pdns) rrlStats() []string {
+	if p.limiter == nil {
+		return []string{"rrl disabled"}
+	}
+	s := p.limiter.Stats()
+	return []string{fmt.Sprintf("rrl allowed %d dropped %d slipped %d buckets %d",
+		s.Allowed, s.Dropped, s.Slipped, s.Buckets)}
+}
+
+func (p *Pdns) cacheCommand(args []string) []string {
+	if p.cache == nil {
+		return []string{"cache disabled"}
+	}
+	if len(args) > 0 {
+		if args[0] != "flush" {
+			return []string{fmt.Sprintf("unknown cache command %q", args[0])}
+		}
+		p.cache.Flush()
+	}
+	s := p.cache.Stats()
+	return []string{fmt.Sprintf("cache hits %d misses %d evictions %d entries %d",
+		s.Hits, s.Misses, s.Evictions, s.Entries)}
+}
+
 func (p *Pdns) marshal(message *message.Message) (string, error) {
 	var c, t string
 	var ok bool
@@ -186,6 +458,9 @@ func (p *Pdns) marshal(message *message.Message) (string, error) {
 	}
 
 	m := []string{"DATA"}
+	if p.abi >= 3 {
+		m = append(m, strconv.Itoa(message.ScopeBits), "1")
+	}
 	m = append(m, string(message.Name))
 	m = append(m, c)
 	m = append(m, t)
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/tehmaze-labs/dns/backend"
+	"github.com/tehmaze-labs/dns/message"
+	"github.com/tehmaze-labs/dns/rrl"
+)
+
+// testBackend answers every query with a TXT record holding the client
+// address, and records the queries it has seen.
+type testBackend struct {
+	queries []*message.Message
+}
+
+func (b *testBackend) Check() error { return nil }
+
+func (b *testBackend) Query(m *message.Message) ([]*message.Message, error) {
+	b.queries = append(b.queries, m)
+	return []*message.Message{{
+		Name:      m.Name,
+		Class:     dns.ClassINET,
+		Type:      dns.TypeTXT,
+		TTL:       60,
+		ID:        m.ID,
+		Content:   []byte(m.Client().String()),
+		ScopeBits: 24,
+	}}, nil
+}
+
+// testClosingBackend signals when it is closed.
+type testClosingBackend struct {
+	testBackend
+	closed chan struct{}
+}
+
+func (b *testClosingBackend) Close() error {
+	close(b.closed)
+	return nil
+}
+
+func TestParseHello(t *testing.T) {
+	tests := map[string]int{
+		"HELO\t1": 1,
+		"HELO\t2": 2,
+		"HELO\t3": 3,
+		"HELO\t4": 4,
+		"HELO\t5": 5,
+		"HELO\t0": 0,
+		"HELO\t6": 0,
+		"HELO\tx": 0,
+		"HELO":    0,
+		"HELLO":   0,
+	}
+
+	for test, want := range tests {
+		abi, err := parseHello([]byte(test))
+		if want == 0 {
+			if err == nil {
+				t.Errorf("%q: expected error, got ABI %d", test, abi)
+			}
+		} else if err != nil {
+			t.Errorf("%q: %v", test, err)
+		} else if abi != want {
+			t.Errorf("%q: got ABI %d, want %d", test, abi, want)
+		}
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	tests := []struct {
+		abi    int
+		line   string
+		remote string
+		local  string
+		subnet string
+	}{
+		{1, "Q\tfoo.example.com\tIN\tA\t-1\t192.0.2.1", "192.0.2.1", "<nil>", "<nil>"},
+		{2, "Q\tfoo.example.com\tIN\tA\t-1\t192.0.2.1\t198.51.100.53", "192.0.2.1", "198.51.100.53", "<nil>"},
+		{3, "Q\tfoo.example.com\tIN\tA\t-1\t192.0.2.1\t198.51.100.53\t203.0.113.0/24", "192.0.2.1", "198.51.100.53", "203.0.113.0/24"},
+		{4, "Q\tfoo.example.com\tIN\tA\t-1\t192.0.2.1\t198.51.100.53\t203.0.113.7", "192.0.2.1", "198.51.100.53", "203.0.113.7/32"},
+		{5, "Q\tfoo.example.com\tIN\tA\t-1\t192.0.2.1\t198.51.100.53\t2001:db8::/56", "192.0.2.1", "198.51.100.53", "2001:db8::/56"},
+		{5, "Q\tfoo.example.com\tIN\tA\t-1\t192.0.2.1\t198.51.100.53\t0.0.0.0/0", "192.0.2.1", "198.51.100.53", "0.0.0.0/0"},
+	}
+
+	for _, test := range tests {
+		req, err := parseRequest([]byte(test.line), test.abi)
+		if err != nil {
+			t.Errorf("ABI %d %q: %v", test.abi, test.line, err)
+			continue
+		}
+		m := req.message
+		if req.rtype != RTYPE_Q || m == nil {
+			t.Errorf("ABI %d %q: got %q request", test.abi, test.line, req.rtype)
+			continue
+		}
+		if string(m.Name) != "foo.example.com" || m.Class != dns.ClassINET || m.Type != dns.TypeA || string(m.ID) != "-1" {
+			t.Errorf("ABI %d %q: got %s %d %d %s", test.abi, test.line, m.Name, m.Class, m.Type, m.ID)
+		}
+		if got := m.RemoteAddr.String(); got != test.remote {
+			t.Errorf("ABI %d %q: got remote %s, want %s", test.abi, test.line, got, test.remote)
+		}
+		if got := m.LocalAddr.String(); got != test.local {
+			t.Errorf("ABI %d %q: got local %s, want %s", test.abi, test.line, got, test.local)
+		}
+		if got := m.ClientSubnet.String(); got != test.subnet {
+			t.Errorf("ABI %d %q: got subnet %s, want %s", test.abi, test.line, got, test.subnet)
+		}
+	}
+}
+
+func TestParseRequestError(t *testing.T) {
+	tests := []struct {
+		abi  int
+		line string
+	}{
+		{1, "Q\tfoo.example.com\tIN\tA\t-1"},
+		{2, "Q\tfoo.example.com\tIN\tA\t-1\t192.0.2.1"},
+		{3, "Q\tfoo.example.com\tIN\tA\t-1\t192.0.2.1\t198.51.100.53"},
+		{2, "Q\tfoo.example.com\tXX\tA\t-1\t192.0.2.1\t198.51.100.53"},
+		{2, "Q\tfoo.example.com\tIN\tXX\t-1\t192.0.2.1\t198.51.100.53"},
+		{4, "CMD\tstats"},
+		{5, "CMD"},
+		{5, "BOGUS\tfoo"},
+		{5, ""},
+	}
+
+	for _, test := range tests {
+		if req, err := parseRequest([]byte(test.line), test.abi); err == nil {
+			t.Errorf("ABI %d %q: expected error, got %q request", test.abi, test.line, req.rtype)
+		}
+	}
+}
+
+func TestParseCommand(t *testing.T) {
+	tests := map[string]string{
+		"CMD\thealth":         "health",
+		"CMD\tcache flush":    "cache,flush",
+		"CMD\t  reload  ":     "reload",
+		"CMD\tcache  flush  ": "cache,flush",
+	}
+
+	for test, want := range tests {
+		req, err := parseRequest([]byte(test), 5)
+		if err != nil {
+			t.Errorf("%q: %v", test, err)
+		} else if req.rtype != RTYPE_CMD {
+			t.Errorf("%q: got %q request", test, req.rtype)
+		} else if got := strings.Join(req.command, ","); got != want {
+			t.Errorf("%q: got command %q, want %q", test, got, want)
+		}
+	}
+}
+
+func TestServe(t *testing.T) {
+	tests := []struct {
+		abi  int
+		in   string
+		want string
+	}{
+		{1, "Q\tfoo.example.com\tIN\tTXT\t-1\t192.0.2.1\n",
+			"DATA\tfoo.example.com\tIN\tTXT\t60\t-1\t192.0.2.1\n"},
+		{2, "Q\tfoo.example.com\tIN\tTXT\t-1\t192.0.2.1\t198.51.100.53\n",
+			"DATA\tfoo.example.com\tIN\tTXT\t60\t-1\t192.0.2.1\n"},
+		{3, "Q\tfoo.example.com\tIN\tTXT\t-1\t192.0.2.1\t198.51.100.53\t203.0.113.0/24\n",
+			"DATA\t24\t1\tfoo.example.com\tIN\tTXT\t60\t-1\t203.0.113.0\n"},
+		{5, "Q\tfoo.example.com\tIN\tTXT\t-1\t192.0.2.1\t198.51.100.53\t0.0.0.0/0\n",
+			"DATA\t24\t1\tfoo.example.com\tIN\tTXT\t60\t-1\t192.0.2.1\n"},
+		{5, "CMD\tbogus\n",
+			"DATA\tunknown command \"bogus\"\n"},
+	}
+
+	for _, test := range tests {
+		p := New([]backend.Backend{new(testBackend)})
+		r := strings.NewReader(fmt.Sprintf("HELO\t%d\n", test.abi) + test.in)
+		w := new(bytes.Buffer)
+		p.Serve(r, w)
+
+		want := HELLO_REPLY + test.want + END_REPLY
+		if got := w.String(); got != want {
+			t.Errorf("ABI %d %q: got %q, want %q", test.abi, test.in, got, want)
+		}
+	}
+}
+
+func TestServeHandshake(t *testing.T) {
+	p := New(nil)
+	w := new(bytes.Buffer)
+	p.Serve(strings.NewReader("HELO\t9\nHELO\t2\nPING\n"), w)
+	if got, want := w.String(), FAIL_REPLY+HELLO_REPLY; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestServeLimited(t *testing.T) {
+	b := new(testBackend)
+	p := New([]backend.Backend{b})
+	var err error
+	if p.limiter, err = rrl.New(&rrl.Config{Rate: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	q := "Q\tfoo.example.com\tIN\tTXT\t-1\t192.0.2.1\t198.51.100.53\n"
+	w := new(bytes.Buffer)
+	p.Serve(strings.NewReader("HELO\t2\n"+q+q+q), w)
+
+	// Limited queries get an empty answer, and once the client is limited
+	// the backends are no longer queried
+	want := HELLO_REPLY +
+		"DATA\tfoo.example.com\tIN\tTXT\t60\t-1\t192.0.2.1\n" + END_REPLY +
+		END_REPLY +
+		END_REPLY
+	if got := w.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if len(b.queries) != 2 {
+		t.Errorf("backend got %d queries, want 2", len(b.queries))
+	}
+}
+
+func TestReloadDrain(t *testing.T) {
+	old := &testClosingBackend{closed: make(chan struct{})}
+	reloaded := &testClosingBackend{closed: make(chan struct{})}
+	p := New([]backend.Backend{old})
+	p.load = func() ([]backend.Backend, error) {
+		return []backend.Backend{reloaded}, nil
+	}
+
+	// A query in flight holds the old backends
+	_, release := p.acquire()
+	done := make(chan error)
+	go func() {
+		done <- p.Reload()
+	}()
+	for i := 0; ; i++ {
+		backends, release := p.acquire()
+		release()
+		if backends[0] == reloaded {
+			break
+		} else if i == 100 {
+			t.Fatal("backends not reloaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// New queries go to the new backends, the old ones stay open
+	req, err := parseRequest([]byte("Q\tfoo.example.com\tIN\tTXT\t-1\t192.0.2.1\t198.51.100.53"), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = p.handleRequest(req); err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.queries) != 1 || len(old.queries) != 0 {
+		t.Errorf("got %d new and %d old backend queries, want 1 and 0", len(reloaded.queries), len(old.queries))
+	}
+	select {
+	case <-old.closed:
+		t.Fatal("old backend closed while in use")
+	case err = <-done:
+		t.Fatalf("reload returned while a query is in flight: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Once released, the old backends are closed
+	release()
+	select {
+	case err = <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("reload did not return after release")
+	}
+	select {
+	case <-old.closed:
+	default:
+		t.Error("old backend not closed after release")
+	}
+	select {
+	case <-reloaded.closed:
+		t.Error("new backend closed")
+	default:
+	}
+}
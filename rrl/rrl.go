@@ -0,0 +1,264 @@
+// Package rrl implements response rate limiting, using token buckets keyed
+// by client network and response class.
+package rrl
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Response classes
+const (
+	ClassAnswer = "answer"
+	ClassEmpty  = "empty"
+	ClassError  = "error"
+)
+
+var classes = []string{ClassAnswer, ClassEmpty, ClassError}
+
+// Action is the outcome of a rate limit check.
+type Action int
+
+const (
+	// Allow the response
+	Allow Action = iota
+	// Drop the response
+	Drop
+	// Slip sends a truncated response, so legitimate clients can retry
+	// over TCP
+	Slip
+)
+
+func (a Action) String() string {
+	switch a {
+	case Allow:
+		return "allow"
+	case Drop:
+		return "drop"
+	case Slip:
+		return "slip"
+	default:
+		return fmt.Sprintf("action(%d)", int(a))
+	}
+}
+
+// Config for the rate limiter:
+//
+//	rrl:
+//	  rate: 10
+//	  rates: {empty: 5, error: 5}
+//	  window: 15
+//	  slip: 2
+//	  ipv4prefix: 24
+//	  ipv6prefix: 56
+type Config struct {
+	// Rate is the number of responses per second per bucket
+	Rate int `yaml:"rate,omitempty"`
+	// Rates overrides the rate per response class
+	Rates map[string]int `yaml:"rates,omitempty"`
+	// Window in seconds, a client that exceeds the rate stays limited for
+	// up to the window after it slows down
+	Window int `yaml:"window,omitempty"`
+	// Slip every Nth limited response instead of dropping it, 0 drops all
+	Slip int `yaml:"slip,omitempty"`
+	// Prefix lengths used to group clients
+	IPv4Prefix int `yaml:"ipv4prefix,omitempty"`
+	IPv6Prefix int `yaml:"ipv6prefix,omitempty"`
+}
+
+// Stats are the counters of a Limiter.
+type Stats struct {
+	Allowed uint64
+	Dropped uint64
+	Slipped uint64
+	Buckets int
+}
+
+type bucket struct {
+	balance float64
+	updated time.Time
+	limited uint64
+}
+
+// Limiter is a response rate limiter.
+type Limiter struct {
+	rates    map[string]float64
+	window   time.Duration
+	slip     uint64
+	ipv4Mask net.IPMask
+	ipv6Mask net.IPMask
+	now      func() time.Time
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	swept    time.Time
+	allowed  uint64
+	dropped  uint64
+	slipped  uint64
+}
+
+// New returns a Limiter for the configuration, with defaults for unset
+// values.
+func New(c *Config) (*Limiter, error) {
+	if c.Rate < 0 || c.Window < 0 || c.Slip < 0 {
+		return nil, fmt.Errorf("rrl: negative rate, window or slip")
+	}
+	if c.IPv4Prefix < 0 || c.IPv4Prefix > 32 {
+		return nil, fmt.Errorf("rrl: invalid ipv4prefix %d", c.IPv4Prefix)
+	}
+	if c.IPv6Prefix < 0 || c.IPv6Prefix > 128 {
+		return nil, fmt.Errorf("rrl: invalid ipv6prefix %d", c.IPv6Prefix)
+	}
+
+	l := &Limiter{
+		rates:    map[string]float64{},
+		window:   time.Duration(pickInt(c.Window, 15)) * time.Second,
+		slip:     uint64(c.Slip),
+		ipv4Mask: net.CIDRMask(pickInt(c.IPv4Prefix, 24), 32),
+		ipv6Mask: net.CIDRMask(pickInt(c.IPv6Prefix, 56), 128),
+		now:      time.Now,
+		buckets:  map[string]*bucket{},
+	}
+	for _, class := range classes {
+		l.rates[class] = float64(pickInt(c.Rate, 10))
+	}
+	for class, rate := range c.Rates {
+		class = strings.ToLower(class)
+		if _, ok := l.rates[class]; !ok {
+			return nil, fmt.Errorf("rrl: unknown response class %q", class)
+		}
+		if rate <= 0 {
+			return nil, fmt.Errorf("rrl: invalid rate %d for %s", rate, class)
+		}
+		l.rates[class] = float64(rate)
+	}
+	return l, nil
+}
+
+// Check accounts a response of the class to the client, and returns the
+// action to take.
+func (l *Limiter) Check(client net.IP, class string) Action {
+	rate, ok := l.rates[class]
+	if !ok || client == nil {
+		atomic.AddUint64(&l.allowed, 1)
+		return Allow
+	}
+	key := l.prefix(client) + "/" + class
+
+	l.mu.Lock()
+	now := l.now()
+	l.sweep(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{balance: rate, updated: now}
+		l.buckets[key] = b
+	} else {
+		l.credit(b, rate, now)
+	}
+	return l.charge(b, rate)
+}
+
+// Limited checks if the client is already limited for any response class,
+// before the response is known. A limited client is accounted to that class
+// and gets the action to take, otherwise nothing is accounted and Allow is
+// returned; the response should be passed to Check.
+func (l *Limiter) Limited(client net.IP) Action {
+	if client == nil {
+		return Allow
+	}
+	prefix := l.prefix(client)
+
+	l.mu.Lock()
+	now := l.now()
+	for _, class := range classes {
+		b, ok := l.buckets[prefix+"/"+class]
+		if !ok {
+			continue
+		}
+		rate := l.rates[class]
+		l.credit(b, rate, now)
+		if b.balance < 0 {
+			return l.charge(b, rate)
+		}
+	}
+	l.mu.Unlock()
+	return Allow
+}
+
+// credit adds the tokens accumulated since the last response. Must be called
+// with the lock held.
+func (l *Limiter) credit(b *bucket, rate float64, now time.Time) {
+	b.balance += now.Sub(b.updated).Seconds() * rate
+	if b.balance > rate {
+		b.balance = rate
+	}
+	b.updated = now
+}
+
+// charge takes a token for a response and returns the action. Must be called
+// with the lock held, which is released.
+func (l *Limiter) charge(b *bucket, rate float64) Action {
+	b.balance--
+	if min := -rate * l.window.Seconds(); b.balance < min {
+		b.balance = min
+	}
+	if b.balance >= 0 {
+		l.mu.Unlock()
+		atomic.AddUint64(&l.allowed, 1)
+		return Allow
+	}
+	b.limited++
+	limited := b.limited
+	l.mu.Unlock()
+
+	if l.slip > 0 && limited%l.slip == 0 {
+		atomic.AddUint64(&l.slipped, 1)
+		return Slip
+	}
+	atomic.AddUint64(&l.dropped, 1)
+	return Drop
+}
+
+// Stats returns the current counters.
+func (l *Limiter) Stats() Stats {
+	l.mu.Lock()
+	buckets := len(l.buckets)
+	l.mu.Unlock()
+	return Stats{
+		Allowed: atomic.LoadUint64(&l.allowed),
+		Dropped: atomic.LoadUint64(&l.dropped),
+		Slipped: atomic.LoadUint64(&l.slipped),
+		Buckets: buckets,
+	}
+}
+
+func (l *Limiter) prefix(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(l.ipv4Mask).String()
+	}
+	return ip.Mask(l.ipv6Mask).String()
+}
+
+// sweep removes idle buckets, at most once per window. Must be called with
+// the lock held.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < l.window {
+		return
+	}
+	l.swept = now
+	for key, b := range l.buckets {
+		if now.Sub(b.updated) > 2*l.window {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func pickInt(a, b int) int {
+	if a > 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,152 @@
+package rrl
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func testLimiter(t *testing.T, c *Config) (*Limiter, *time.Time) {
+	l, err := New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestLimiter(t *testing.T) {
+	l, now := testLimiter(t, &Config{Rate: 5, Window: 2})
+	client := net.ParseIP("192.0.2.1")
+
+	for i := 0; i < 5; i++ {
+		if a := l.Check(client, ClassAnswer); a != Allow {
+			t.Fatalf("response %d: got %s, want allow", i, a)
+		}
+	}
+	if a := l.Check(client, ClassAnswer); a != Drop {
+		t.Errorf("got %s, want drop", a)
+	}
+
+	// Same prefix shares the bucket, other classes and prefixes don't
+	if a := l.Check(net.ParseIP("192.0.2.200"), ClassAnswer); a != Drop {
+		t.Errorf("same prefix: got %s, want drop", a)
+	}
+	if a := l.Check(client, ClassEmpty); a != Allow {
+		t.Errorf("other class: got %s, want allow", a)
+	}
+	if a := l.Check(net.ParseIP("192.0.3.1"), ClassAnswer); a != Allow {
+		t.Errorf("other prefix: got %s, want allow", a)
+	}
+
+	// Tokens are credited over time
+	*now = now.Add(time.Second)
+	if a := l.Check(client, ClassAnswer); a != Allow {
+		t.Errorf("after 1s: got %s, want allow", a)
+	}
+
+	// Flooding clients go into debt for at most the window
+	for i := 0; i < 100; i++ {
+		l.Check(client, ClassAnswer)
+	}
+	*now = now.Add(time.Second)
+	if a := l.Check(client, ClassAnswer); a != Drop {
+		t.Errorf("in window: got %s, want drop", a)
+	}
+	*now = now.Add(2 * time.Second)
+	if a := l.Check(client, ClassAnswer); a != Allow {
+		t.Errorf("after window: got %s, want allow", a)
+	}
+
+	s := l.Stats()
+	t.Logf("stats: %+v", s)
+	if s.Allowed != 11 || s.Dropped != 101 || s.Slipped != 0 || s.Buckets != 3 {
+		t.Errorf("got %+v", s)
+	}
+
+	// Idle buckets are removed
+	*now = now.Add(time.Minute)
+	l.Check(client, ClassError)
+	if s = l.Stats(); s.Buckets != 1 {
+		t.Errorf("got %d buckets, want 1", s.Buckets)
+	}
+}
+
+func TestLimiterSlip(t *testing.T) {
+	l, _ := testLimiter(t, &Config{Rate: 1, Slip: 2, Rates: map[string]int{"Error": 3}})
+	client := net.ParseIP("2001:db8::1")
+
+	var got []Action
+	for i := 0; i < 5; i++ {
+		got = append(got, l.Check(client, ClassAnswer))
+	}
+	want := []Action{Allow, Drop, Slip, Drop, Slip}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+
+	// IPv6 clients are grouped by /56
+	if a := l.Check(net.ParseIP("2001:db8:0:ff::1"), ClassAnswer); a == Allow {
+		t.Errorf("same /56: got %s, want limited", a)
+	}
+	for i := 0; i < 3; i++ {
+		if a := l.Check(client, ClassError); a != Allow {
+			t.Errorf("error %d: got %s, want allow", i, a)
+		}
+	}
+}
+
+func TestLimiterLimited(t *testing.T) {
+	l, now := testLimiter(t, &Config{Rate: 2, Window: 2})
+	client := net.ParseIP("192.0.2.1")
+
+	if a := l.Limited(client); a != Allow {
+		t.Errorf("new client: got %s, want allow", a)
+	}
+	for i := 0; i < 2; i++ {
+		if a := l.Check(client, ClassEmpty); a != Allow {
+			t.Fatalf("response %d: got %s, want allow", i, a)
+		}
+	}
+	if a := l.Limited(client); a != Allow {
+		t.Errorf("exhausted: got %s, want allow", a)
+	}
+	if a := l.Check(client, ClassEmpty); a != Drop {
+		t.Errorf("got %s, want drop", a)
+	}
+
+	// Limited in any class limits the client before the response is known
+	if a := l.Limited(client); a != Drop {
+		t.Errorf("limited: got %s, want drop", a)
+	}
+	if a := l.Limited(net.ParseIP("192.0.3.1")); a != Allow {
+		t.Errorf("other prefix: got %s, want allow", a)
+	}
+	if s := l.Stats(); s.Allowed != 2 || s.Dropped != 2 {
+		t.Errorf("got %+v", s)
+	}
+
+	*now = now.Add(2 * time.Second)
+	if a := l.Limited(client); a != Allow {
+		t.Errorf("after 2s: got %s, want allow", a)
+	}
+}
+
+func TestConfig(t *testing.T) {
+	tests := []*Config{
+		{Rate: -1},
+		{IPv4Prefix: 33},
+		{IPv6Prefix: 129},
+		{Rates: map[string]int{"nxdomain": 1}},
+		{Rates: map[string]int{"empty": -1}},
+	}
+	for _, test := range tests {
+		if _, err := New(test); err == nil {
+			t.Errorf("expected error for %+v", test)
+		}
+	}
+}